@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/auth"
+)
+
+// selectAccount points the GitHub API clients, and the gh commands run by
+// the dashboard, at host and the token of account on it. Empty values keep
+// the ones gh is logged in with.
+func selectAccount(host, account string) error {
+	if host != "" {
+		host = auth.NormalizeHostname(host)
+		if err := os.Setenv("GH_HOST", host); err != nil {
+			return err
+		}
+	}
+	if account == "" {
+		return nil
+	}
+
+	if host == "" {
+		host, _ = auth.DefaultHost()
+	}
+	out, err := exec.Command("gh", "auth", "token", "--hostname", host, "--user", account).Output()
+	if err != nil {
+		return fmt.Errorf("no token of %s on %s, log in with gh auth login: %w", account, host, err)
+	}
+	// the enterprise hosts read their own variable, see auth.TokenForHost
+	env := "GH_TOKEN"
+	if auth.IsEnterprise(host) {
+		env = "GH_ENTERPRISE_TOKEN"
+	}
+	return os.Setenv(env, strings.TrimSpace(string(out)))
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/ical"
+)
+
+// calendarCmd exports milestones and releases as an iCalendar file
+var calendarCmd = &cobra.Command{
+	Use:   "calendar",
+	Short: "Export milestone due dates and releases as an iCalendar file",
+	Long: `Export the due dates of the open milestones and the releases of repos as all-day events in an
+iCalendar (.ics) file, to import into or subscribe to from a calendar app.
+
+The repos default to the ones in the configuration, the keys of repoPaths and the repos the PR and
+issues sections filter by with repo:. GitHub doesn't schedule releases, so plan them with
+milestones to see them coming.`,
+	Example: `
+# Print the calendar of the configured repos
+gh dash calendar
+
+# Export the calendar of a couple of repos to a file, e.g. from a cron job, for a calendar app to
+# subscribe to
+gh dash calendar --repo dlvhdr/gh-dash --repo charmbracelet/bubbletea -o ~/calendars/github.ics
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		repos, err := cmd.Flags().GetStringSlice("repo")
+		if err != nil {
+			return err
+		}
+		output, err := cmd.Flags().GetString("output")
+		if err != nil {
+			return err
+		}
+		since, err := cmd.Flags().GetDuration("since")
+		if err != nil {
+			return err
+		}
+
+		if len(repos) == 0 {
+			cfg, err := config.ParseConfig(config.Location{ConfigFlag: cfgFlag})
+			if err != nil {
+				return err
+			}
+			repos = cfg.Repos()
+		}
+		if len(repos) == 0 {
+			return errors.New("no repos to export, pass them with --repo or add them to repoPaths")
+		}
+
+		now := time.Now()
+		cal := ical.Calendar{Name: "gh-dash"}
+		failed := 0
+		for _, repo := range repos {
+			schedule, err := data.FetchSchedule(repo)
+			if err != nil {
+				log.Error("Failed fetching milestones and releases", "repo", repo, "err", err)
+				failed++
+				continue
+			}
+			cal.Events = append(cal.Events, scheduleEvents(schedule, now.Add(-since))...)
+		}
+		if failed == len(repos) {
+			return errors.New("failed fetching the milestones and releases of every repo")
+		}
+		slices.SortStableFunc(cal.Events, func(a, b ical.Event) int {
+			return a.Day.Compare(b.Day)
+		})
+
+		var w io.Writer = os.Stdout
+		if output != "" {
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		return ical.Write(w, cal, now)
+	},
+}
+
+// scheduleEvents returns the events of the milestones due and the releases
+// published after from
+func scheduleEvents(schedule data.Schedule, from time.Time) []ical.Event {
+	var events []ical.Event
+	for _, m := range schedule.Milestones {
+		if m.DueOn.Before(from) {
+			continue
+		}
+		description := fmt.Sprintf("%d of %d issues closed", m.ClosedIssues, m.OpenIssues+m.ClosedIssues)
+		if m.Description != "" {
+			description += "\n\n" + m.Description
+		}
+		events = append(events, ical.Event{
+			UID:         m.Url,
+			Summary:     fmt.Sprintf("%s: %s due", m.Repo, m.Title),
+			Description: description,
+			Url:         m.Url,
+			Day:         m.DueOn,
+			Modified:    m.UpdatedAt,
+		})
+	}
+	for _, r := range schedule.Releases {
+		if r.PublishedAt.Before(from) {
+			continue
+		}
+		name := r.TagName
+		if r.Name != "" && r.Name != r.TagName {
+			name = fmt.Sprintf("%s (%s)", r.Name, r.TagName)
+		}
+		kind := "release"
+		if r.IsPrerelease {
+			kind = "pre-release"
+		}
+		events = append(events, ical.Event{
+			UID:      r.Url,
+			Summary:  fmt.Sprintf("%s: %s %s", r.Repo, name, kind),
+			Url:      r.Url,
+			Day:      r.PublishedAt,
+			Modified: r.UpdatedAt,
+		})
+	}
+	return events
+}
+
+func init() {
+	calendarCmd.Flags().StringSlice(
+		"repo",
+		nil,
+		"repo to export, as owner/name, can be repeated (default the configured repos)",
+	)
+	calendarCmd.Flags().StringP(
+		"output",
+		"o",
+		"",
+		"file to write the calendar to (default stdout)",
+	)
+	calendarCmd.Flags().Duration(
+		"since",
+		30*24*time.Hour,
+		"how far back to include overdue milestones and past releases",
+	)
+	err := calendarCmd.MarkFlagFilename("output", "ics")
+	if err != nil {
+		log.Fatal("Cannot mark output flag as filename", err)
+	}
+
+	rootCmd.AddCommand(calendarCmd)
+}
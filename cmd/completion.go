@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cli/go-gh/v2/pkg/auth"
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// completionCmd generates the completion scripts of the gh-dash binary,
+// gh doesn't complete the flags of its extensions
+var completionCmd = &cobra.Command{
+	Use:   "completion <bash|zsh|fish>",
+	Short: "Generate the completion script for a shell",
+	Long: `Generate the completion script of the gh-dash binary for bash, zsh or fish.
+The script completes the views, the configured section titles and profiles, and the hosts gh is logged in to.
+As gh doesn't complete the flags of its extensions, it completes gh-dash rather than gh dash, e.g. aliased with alias ghd=gh-dash.`,
+	Example: `
+# Load the completions of the current bash session
+source <(gh dash completion bash)
+
+# Load the completions of every zsh session
+gh dash completion zsh > "${fpath[1]}/_gh-dash"
+
+# Load the completions of every fish session
+gh dash completion fish > ~/.config/fish/completions/gh-dash.fish
+	`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"bash", "zsh", "fish"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// the scripts complete the command named by the root's Use
+		use := rootCmd.Use
+		rootCmd.Use = "gh-dash"
+		defer func() { rootCmd.Use = use }()
+
+		out := cmd.OutOrStdout()
+		switch args[0] {
+		case "bash":
+			return rootCmd.GenBashCompletionV2(out, true)
+		case "zsh":
+			return rootCmd.GenZshCompletion(out)
+		case "fish":
+			return rootCmd.GenFishCompletion(out, true)
+		default:
+			return fmt.Errorf("unsupported shell %q, expected bash, zsh or fish", args[0])
+		}
+	},
+}
+
+// completionConfig parses the config picked with --config, the --view flag
+// of cmd, or the view argument of list, overrides its default view
+func completionConfig(cmd *cobra.Command, args []string) (config.Config, bool) {
+	location := config.Location{ConfigFlag: cfgFlag}
+	v, _ := cmd.Flags().GetString("view")
+	if cmd == listCmd && len(args) > 0 {
+		v = args[0]
+	}
+	if v != "" {
+		if view, err := config.ParseViewType(v); err == nil {
+			location.View = view
+		}
+	}
+	cfg, err := config.ParseConfig(location)
+	return cfg, err == nil
+}
+
+func completeViews(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	views := make([]string, 0, len(config.ViewTypes))
+	for _, view := range config.ViewTypes {
+		views = append(views, string(view))
+	}
+	return views, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeSections completes the titles of the sections of the view shown
+// first, described by their position
+func completeSections(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+	cfg, ok := completionConfig(cmd, args)
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var titles []string
+	for i, title := range cfg.SectionTitles(cfg.Defaults.View) {
+		titles = append(titles, cobra.CompletionWithDesc(title, strconv.Itoa(i+1)))
+	}
+	return titles, cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeProfiles(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+	cfg, ok := completionConfig(cmd, args)
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var names []string
+	for _, p := range cfg.Profiles {
+		names = append(names, p.Name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeHosts(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return auth.KnownHosts(), cobra.ShellCompDirectiveNoFileComp
+}
+
+func init() {
+	rootCmd.AddCommand(completionCmd)
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/export"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	dctx "github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// exportedRow is a fetched PR or issue
+type exportedRow struct {
+	id     string
+	url    string
+	fields func() map[string]any
+}
+
+// exportSection writes the rows of a section of the default view, PRs or
+// issues, to output or stdout without launching the dashboard. The section
+// is picked with --section, the first one by default.
+func exportSection(location config.Location, format export.Format, output string) error {
+	table, err := sectionTable(location)
+	if err != nil {
+		return err
+	}
+	return writeTable(table, format, output)
+}
+
+// sectionTable fetches the rows of the section of location, in the view of
+// location or the default one
+func sectionTable(location config.Location) (export.Table, error) {
+	cfg, err := config.ParseConfig(location)
+	if err != nil {
+		return export.Table{}, err
+	}
+
+	var sections []config.SectionConfig
+	var limit int
+	var fieldNames []string
+	switch cfg.Defaults.View {
+	case config.PRsView:
+		for _, s := range cfg.PRSections {
+			sections = append(sections, s.ToSectionConfig())
+		}
+		limit, fieldNames = cfg.Defaults.PrsLimit, data.PullRequestFieldNames
+	case config.IssuesView:
+		for _, s := range cfg.IssuesSections {
+			sections = append(sections, s.ToSectionConfig())
+		}
+		limit, fieldNames = cfg.Defaults.IssuesLimit, data.IssueFieldNames
+	default:
+		return export.Table{}, fmt.Errorf("only PR and issue sections can be exported, the default view is %s", cfg.Defaults.View)
+	}
+	index := max(location.Section, 1)
+	if index > len(sections) {
+		return export.Table{}, fmt.Errorf("no section %d, the %s view has %d", index, cfg.Defaults.View, len(sections))
+	}
+	s := sections[index-1]
+	if s.Limit != nil {
+		limit = *s.Limit
+	}
+
+	// PR and issue sections merge the filters of the repos block, like on the
+	// dashboard
+	filters, sprint, sizes := section.SearchFilters(&cfg, s, true, expandFilters(s, location))
+	if sprint != section.SprintAll {
+		log.Warn("The sprint: qualifier isn't applied when exporting", "section", s.Title)
+	}
+	rows, err := fetchExportedRows(cfg, s, filters, sizes, limit)
+	if err != nil {
+		return export.Table{}, err
+	}
+
+	var projectFields map[string]data.ProjectFields
+	if len(s.ProjectFields) > 0 && s.IsGitHub() && len(rows) > 0 {
+		ids := make([]string, 0, len(rows))
+		for _, row := range rows {
+			ids = append(ids, row.id)
+		}
+		projectFields, err = data.FetchProjectFields(ids, data.ProjectFromSearch(filters))
+		if err != nil {
+			log.Error("Failed fetching project fields, the token may lack the read:project scope",
+				"err", err)
+		}
+	}
+	templates, user := computedTemplates(s.ComputedColumns)
+
+	table := export.Table{Columns: slices.Concat(fieldNames, s.ProjectFields, computedTitles(s))}
+	for _, row := range rows {
+		values := row.fields()
+		for _, field := range s.ProjectFields {
+			values[field] = projectFields[row.url][field]
+		}
+		for i, tmpl := range templates {
+			values[s.ComputedColumns[i].Title] = computedValue(tmpl, row, user)
+		}
+		table.Append(values)
+	}
+	return table, nil
+}
+
+// expandFilters executes the template of the filters of s, with the
+// variables the dashboard would give them in the repo of location
+func expandFilters(s config.SectionConfig, location config.Location) string {
+	// the variables run gh and git, only look them up for filters using them
+	if !strings.Contains(s.Filters, "{{") {
+		return utils.ExpandSearchTemplate(s.Filters)
+	}
+	user, err := data.CurrentLoginName()
+	if err != nil {
+		log.Error("Failed fetching the current user, {{ .CurrentUser }} is empty", "err", err)
+	}
+	ctx := &dctx.ProgramContext{User: user, RepoPath: location.RepoPath}
+	return utils.ExpandFiltersTemplate(s.Filters, s.Filters, ctx.SearchVars())
+}
+
+// writeTable writes table in format to output, or stdout when it's empty
+func writeTable(table export.Table, format export.Format, output string) error {
+	var w io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return export.Write(w, table, format)
+}
+
+// fetchExportedRows fetches the rows of section s of the default view, from
+// the host of the section or the default one. The PRs are then filtered by
+// sizes, GitHub's search doesn't know them.
+func fetchExportedRows(
+	cfg config.Config,
+	s config.SectionConfig,
+	filters string,
+	sizes section.SizeFilter,
+	limit int,
+) ([]exportedRow, error) {
+	provider, err := data.GetProvider(s.Provider, cfg.SectionHost(s))
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []exportedRow
+	if cfg.Defaults.View == config.PRsView {
+		res, err := provider.FetchPullRequests(filters, limit, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range sizes.Filter(res.Prs, cfg.PrSize) {
+			rows = append(rows, exportedRow{id: pr.Id, url: pr.Url, fields: pr.ColumnFields})
+		}
+		return rows, nil
+	}
+
+	res, err := provider.FetchIssues(filters, limit, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, issue := range res.Issues {
+		rows = append(rows, exportedRow{id: issue.Id, url: issue.Url, fields: issue.ColumnFields})
+	}
+	return rows, nil
+}
+
+// computedTemplates parses the templates of the computed columns, the ones
+// that fail parsing are nil. user is the current user for their Mine field.
+func computedTemplates(columns []config.ComputedColumn) (templates []*template.Template, user string) {
+	if len(columns) == 0 {
+		return nil, ""
+	}
+	for _, c := range columns {
+		tmpl, err := utils.ParseColumnTemplate(c.Title, c.Template)
+		if err != nil {
+			log.Error("Failed parsing computed column template", "column", c.Title, "err", err)
+		}
+		templates = append(templates, tmpl)
+	}
+	user, err := data.CurrentLoginName()
+	if err != nil {
+		log.Error("Failed fetching the current user, Mine is false", "err", err)
+	}
+	return templates, user
+}
+
+func computedTitles(s config.SectionConfig) []string {
+	titles := make([]string, 0, len(s.ComputedColumns))
+	for _, c := range s.ComputedColumns {
+		titles = append(titles, c.Title)
+	}
+	return titles
+}
+
+// computedValue executes tmpl with the fields of row, it's empty when the
+// template fails
+func computedValue(tmpl *template.Template, row exportedRow, user string) string {
+	if tmpl == nil {
+		return ""
+	}
+	fields := row.fields()
+	fields["Mine"] = user != "" && fields["Author"] == user
+	value, err := utils.ExecuteColumnTemplate(tmpl, fields)
+	if err != nil {
+		log.Error("Failed executing computed column template", "column", tmpl.Name(),
+			"url", row.url, "err", err)
+		return ""
+	}
+	return value
+}
+
+// exportFormat returns the format picked with the --json or --csv flags, ok
+// is false when neither is set
+func exportFormat(json, csv bool) (format export.Format, ok bool, err error) {
+	switch {
+	case json && csv:
+		return "", false, errors.New("pass either --json or --csv")
+	case json:
+		return export.JSON, true, nil
+	case csv:
+		return export.CSV, true, nil
+	default:
+		return "", false, nil
+	}
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/workflowlint"
+)
+
+// lintCmd checks the local workflow files, the same way the file view does
+var lintCmd = &cobra.Command{
+	Use:   "lint [file...]",
+	Short: "Check GitHub Actions workflow files before pushing them",
+	Long: `Check GitHub Actions workflow files for invalid YAML, unknown keys and events, jobs without a
+runner, steps doing nothing, unknown needs and unclosed expressions. These are the checks run on
+workflows viewed with g v in the dashboard.
+
+Without files, the workflows of .github/workflows in the current directory are checked. The command
+exits with a non-zero status when a problem is found, so it can run from a pre-push hook.`,
+	Example: `
+# Check the workflows of the current repo
+gh dash lint
+
+# Check a workflow being edited
+gh dash lint .github/workflows/ci.yml
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		files := args
+		if len(files) == 0 {
+			for _, pattern := range []string{"*.yml", "*.yaml"} {
+				matches, err := filepath.Glob(filepath.Join(".github", "workflows", pattern))
+				if err != nil {
+					return err
+				}
+				files = append(files, matches...)
+			}
+			if len(files) == 0 {
+				return errors.New("no workflow found in .github/workflows")
+			}
+		}
+
+		found := 0
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			for _, problem := range workflowlint.Lint(content) {
+				fmt.Printf("%s:%s\n", file, problem)
+				found++
+			}
+		}
+		if found > 0 {
+			cmd.SilenceUsage = true
+			return fmt.Errorf("%d problem(s) found", found)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/configlint"
+)
+
+// lintConfigCmd estimates the API cost of the sections of the config and
+// suggests how to lower it
+var lintConfigCmd = &cobra.Command{
+	Use:   "lint-config",
+	Short: "Estimate the API cost of your sections and find overlapping ones",
+	Long: `Estimate the GraphQL rate limit points each PR and issue section of your configuration costs per
+refresh and per hour, from its limit, its refetch interval and the fields fetched for its rows.
+
+Sections searching the same rows, sections whose rows are all listed by another one, limits past
+what fits a screen or two and refreshes taking too much of the hourly quota are pointed out, with a
+suggestion to merge the sections, lower the limit or refresh less often. The command exits with a
+non-zero status when there's something to improve.`,
+	Example: `
+# Check the configuration gh-dash would launch with
+gh dash lint-config
+
+# Check another configuration
+gh dash lint-config --config ~/work/gh-dash.yml
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.ParseConfig(config.Location{ConfigFlag: cfgFlag})
+		if err != nil {
+			return err
+		}
+
+		report := configlint.Lint(cfg)
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "VIEW\tSECTION\tLIMIT\tREFRESH\tPOINTS/REFRESH\tPOINTS/HOUR")
+		for _, s := range report.Sections {
+			refresh := "never"
+			if s.IntervalMinutes > 0 {
+				refresh = fmt.Sprintf("%dm", s.IntervalMinutes)
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%d\t%d\n", s.View, s.Title, s.Limit, refresh,
+				s.PointsPerRefresh, s.PointsPerHour())
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		fmt.Printf("\n%d points an hour of the %d the GraphQL API allows\n", report.PointsPerHour(),
+			configlint.GraphQLPointsPerHour)
+
+		if len(report.Findings) == 0 {
+			return nil
+		}
+		fmt.Println()
+		for _, finding := range report.Findings {
+			fmt.Println(finding)
+		}
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d suggestion(s)", len(report.Findings))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(lintConfigCmd)
+}
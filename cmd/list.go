@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/charmbracelet/log"
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/export"
+)
+
+// listColumns are the columns of the rows printed as a table, followed by
+// the section's project field and computed columns
+var listColumns = []string{"Number", "RepoName", "Title", "Author", "UpdatedAt"}
+
+// listCmd prints the rows of a PR or issues section, like the dashboard
+// would show them
+var listCmd = &cobra.Command{
+	Use:   "list <prs|issues>",
+	Short: "Print the rows of a PR or issues section",
+	Long: `Run the query of a section of your configuration and print its rows, without launching the dashboard.
+The rows are printed as a table, or as JSON or CSV to pipe them into other tools, e.g. from a cron job.`,
+	Example: `
+# Print the PRs of the first section
+gh dash list prs
+
+# Print the issues of the section titled "Assigned" as JSON
+gh dash list issues --section Assigned --json | jq -r '.[].Url'
+
+# Save the PRs of the second section with other filters as CSV
+gh dash list prs --section 2 --filters "is:open repo:dlvhdr/gh-dash" --csv --output prs.csv
+	`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{string(config.PRsView), string(config.IssuesView)},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		view, err := config.ParseViewType(args[0])
+		if err != nil {
+			return err
+		}
+		if view != config.PRsView && view != config.IssuesView {
+			return fmt.Errorf("only PR and issue sections can be listed, not %s", view)
+		}
+		section, err := cmd.Flags().GetString("section")
+		if err != nil {
+			return err
+		}
+		filters, err := cmd.Flags().GetString("filters")
+		if err != nil {
+			return err
+		}
+		output, err := cmd.Flags().GetString("output")
+		if err != nil {
+			return err
+		}
+		asJson, err := cmd.Flags().GetBool("json")
+		if err != nil {
+			return err
+		}
+		asCsv, err := cmd.Flags().GetBool("csv")
+		if err != nil {
+			return err
+		}
+		format, ok, err := exportFormat(asJson, asCsv)
+		if err != nil {
+			return err
+		}
+
+		location := config.Location{ConfigFlag: cfgFlag, View: view, Filters: filters}
+		if location.Section, err = sectionFlag(location, section); err != nil {
+			return err
+		}
+		table, err := sectionTable(location)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			format = export.Text
+			table = table.Select(slices.Concat(listColumns, extraColumns(view, table))...)
+		}
+		return writeTable(table, format, output)
+	},
+}
+
+// extraColumns returns the columns of table that aren't fields of the rows
+// of view, the section's project field and computed columns
+func extraColumns(view config.ViewType, table export.Table) []string {
+	fields := data.PullRequestFieldNames
+	if view == config.IssuesView {
+		fields = data.IssueFieldNames
+	}
+	return slices.DeleteFunc(slices.Clone(table.Columns), func(column string) bool {
+		return slices.Contains(fields, column)
+	})
+}
+
+func init() {
+	listCmd.Flags().String(
+		"section",
+		"",
+		"the section to list, by title or position starting at 1",
+	)
+	listCmd.Flags().String(
+		"filters",
+		"",
+		"replace the filters of the section",
+	)
+	listCmd.Flags().Bool(
+		"json",
+		false,
+		"print the rows as JSON instead of a table",
+	)
+	listCmd.Flags().Bool(
+		"csv",
+		false,
+		"print the rows as CSV instead of a table",
+	)
+	listCmd.Flags().String(
+		"output",
+		"",
+		"write the rows to a file instead of stdout",
+	)
+	if err := listCmd.RegisterFlagCompletionFunc("section", completeSections); err != nil {
+		log.Fatal("Cannot register flag completion", "flag", "section", "err", err)
+	}
+
+	rootCmd.AddCommand(listCmd)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+)
+
+// openCmd launches the dashboard showing a PR or issue
+var openCmd = &cobra.Command{
+	Use:   "open <url>",
+	Short: "Launch the dashboard showing a PR or issue",
+	Long: `Launch the dashboard with the PR or issue at url open in the preview pane, in the PRs or issues view.
+Useful for opening the dashboard from a notification, e.g. from a terminal notification handler.`,
+	Example: `
+# Open a PR
+gh dash open https://github.com/dlvhdr/gh-dash/pull/123
+
+# Open an issue
+gh dash open https://github.com/dlvhdr/gh-dash/issues/456
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		link, err := data.ParseItemUrl(args[0])
+		if err != nil {
+			return err
+		}
+
+		var repo string
+		r, err := git.GetRepoInPwd()
+		if err == nil && r != nil {
+			repo = r.Path()
+		}
+
+		runDashboard(cmd, config.Location{RepoPath: repo, ConfigFlag: cfgFlag, OpenUrl: link.Url})
+		return nil
+	},
+}
+
+func init() {
+	openCmd.Flags().Bool(
+		"debug",
+		false,
+		"passing this flag will allow writing debug output to debug.log",
+	)
+	openCmd.Flags().String(
+		"cpuprofile",
+		"",
+		"write cpu profile to file",
+	)
+
+	rootCmd.AddCommand(openCmd)
+}
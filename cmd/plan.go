@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+)
+
+// planCmd prints the review requests planned in the dashboard
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Print the reviews planned for today",
+	Long: `Print the review requests planned for today with the dashboard's planner, the ones planned for
+an earlier day that are still planned included. Reviews are planned from the PRs view by pressing S.
+
+Nothing is printed when no review is planned for today, so it can remind you from a cron job or a
+shell startup file.`,
+	Example: `
+# Print today's reviews
+gh dash plan
+
+# Print the reviews of every bucket
+gh dash plan --all
+
+# Get a desktop notification every morning, from a crontab
+0 9 * * 1-5 out="$(gh dash plan)" && [ -n "$out" ] && notify-send "Reviews for today" "$out"
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		all, err := cmd.Flags().GetBool("all")
+		if err != nil {
+			return err
+		}
+
+		dir, err := state.Dir()
+		if err != nil {
+			return err
+		}
+		plan := state.LoadReviewPlan(dir)
+
+		buckets := []state.PlanBucket{state.PlanToday}
+		if all {
+			buckets = state.PlanBuckets
+		}
+		printPlan(os.Stdout, plan, buckets, all, time.Now())
+		return nil
+	},
+}
+
+// printPlan writes the reviews of buckets, under a heading per bucket when
+// withHeadings is set
+func printPlan(w io.Writer, plan *state.ReviewPlan, buckets []state.PlanBucket, withHeadings bool, now time.Time) {
+	for i, bucket := range buckets {
+		reviews := plan.Reviews(bucket, now)
+		if withHeadings {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "%s (%d)\n", bucket, len(reviews))
+		}
+		for _, review := range reviews {
+			fmt.Fprintf(w, "%s#%d %s\n  %s\n", review.Repo, review.Number, review.Title, review.Url)
+		}
+	}
+}
+
+func init() {
+	planCmd.Flags().Bool(
+		"all",
+		false,
+		"print the reviews planned for tomorrow and later too",
+	)
+
+	rootCmd.AddCommand(planCmd)
+}
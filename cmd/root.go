@@ -4,6 +4,7 @@ Copyright © 2022 NAME HERE <EMAIL ADDRESS>
 package cmd
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	slog "log"
@@ -11,18 +12,21 @@ import (
 	"runtime"
 	"runtime/debug"
 	"runtime/pprof"
+	"strconv"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/fang"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
-	zone "github.com/lrstanley/bubblezone"
 	"github.com/muesli/termenv"
 	"github.com/spf13/cobra"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	dctx "github.com/dlvhdr/gh-dash/v4/internal/tui/context"
@@ -60,6 +64,21 @@ gh dash --config /path/to/configuration/file.yml
 # Run with debug logging to debug.log
 gh dash --debug
 
+# Show the second section in a compact layout, e.g. in a narrow tmux pane
+gh dash --mini --section 2
+
+# Print the rows of the second section as JSON, e.g. to pipe them into jq
+gh dash --json --section 2 | jq -r '.[].Url'
+
+# Start in the issues view, on the section titled "Assigned" with other filters
+gh dash --view issues --section Assigned --filters "is:open assignee:@me label:bug"
+
+# Use the work account on a GitHub Enterprise host
+gh dash --host github.example.com --account octocat-work
+
+# Apply the "small" profile of the configuration whatever the terminal
+gh dash --profile small
+
 # Print version
 gh dash -v
 	`,
@@ -87,7 +106,9 @@ func setDebugLogLevel() {
 	}
 }
 
-func createModel(location config.Location, debug bool) (tui.Model, *os.File) {
+// setupLogging logs to debug.log if debug is set, the returned file must be
+// closed when it isn't nil
+func setupLogging(location config.Location, debug bool) *os.File {
 	var loggerFile *os.File
 
 	if debug {
@@ -112,7 +133,7 @@ func createModel(location config.Location, debug bool) (tui.Model, *os.File) {
 		log.SetLevel(log.FatalLevel)
 	}
 
-	return tui.NewModel(location), loggerFile
+	return loggerFile
 }
 
 func buildVersion(version, commit, date, builtBy string) string {
@@ -134,6 +155,19 @@ func buildVersion(version, commit, date, builtBy string) string {
 	return result
 }
 
+// currentVersion returns the version of the running gh-dash, the one it was
+// released as or else the one of its module when it was built with go
+// install, "dev" for local builds
+func currentVersion() string {
+	if Version != "" && Version != "dev" {
+		return Version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Sum != "" {
+		return info.Main.Version
+	}
+	return "dev"
+}
+
 func init() {
 	rootCmd.PersistentFlags().StringVarP(
 		&cfgFlag,
@@ -152,6 +186,32 @@ func init() {
 		log.Fatal("Cannot mark config flag as filename", err)
 	}
 
+	rootCmd.PersistentFlags().String(
+		"host",
+		"",
+		"the GitHub host to use instead of the default one of gh, e.g. a GitHub Enterprise host",
+	)
+	rootCmd.PersistentFlags().String(
+		"account",
+		"",
+		"the account logged in to gh on the host to use instead of the active one",
+	)
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		host, err := cmd.Flags().GetString("host")
+		if err != nil {
+			return err
+		}
+		account, err := cmd.Flags().GetString("account")
+		if err != nil {
+			return err
+		}
+		if err := selectAccount(host, account); err != nil {
+			return err
+		}
+		configureRequests()
+		return nil
+	}
+
 	rootCmd.Version = buildVersion(Version, Commit, Date, BuiltBy)
 	rootCmd.SetVersionTemplate(`gh-dash {{printf "version %s\n" .Version}}`)
 
@@ -161,6 +221,54 @@ func init() {
 		"passing this flag will allow writing debug output to debug.log",
 	)
 
+	rootCmd.Flags().Bool(
+		"mini",
+		false,
+		"show a single section without tabs, with dense rows and a minimal footer",
+	)
+
+	rootCmd.Flags().String(
+		"view",
+		"",
+		"show this view first instead of the default one: prs, issues, workflows, feeds, discussions, releases, dependencies or archive",
+	)
+
+	rootCmd.Flags().String(
+		"section",
+		"",
+		"show this section of the view first, by title or position starting at 1",
+	)
+
+	rootCmd.Flags().String(
+		"filters",
+		"",
+		"replace the filters of the section shown first",
+	)
+
+	rootCmd.Flags().String(
+		"profile",
+		"",
+		"apply the named config profile instead of the ones matching the terminal",
+	)
+
+	rootCmd.Flags().Bool(
+		"json",
+		false,
+		"print the rows of the section picked with --section as JSON instead of launching the dashboard",
+	)
+
+	rootCmd.Flags().Bool(
+		"csv",
+		false,
+		"print the rows of the section picked with --section as CSV instead of launching the dashboard",
+	)
+
+	rootCmd.Flags().String(
+		"output",
+		"",
+		"write the rows printed with --json or --csv to a file instead of stdout",
+	)
+
 	rootCmd.Flags().String(
 		"cpuprofile",
 		"",
@@ -174,7 +282,51 @@ func init() {
 		"help for gh-dash",
 	)
 
-	rootCmd.Run = func(_ *cobra.Command, args []string) {
+	for flag, complete := range map[string]cobra.CompletionFunc{
+		"view":    completeViews,
+		"section": completeSections,
+		"profile": completeProfiles,
+		"host":    completeHosts,
+	} {
+		if err := rootCmd.RegisterFlagCompletionFunc(flag, complete); err != nil {
+			log.Fatal("Cannot register flag completion", "flag", flag, "err", err)
+		}
+	}
+
+	rootCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		mini, err := cmd.Flags().GetBool("mini")
+		if err != nil {
+			return err
+		}
+		view, err := cmd.Flags().GetString("view")
+		if err != nil {
+			return err
+		}
+		section, err := cmd.Flags().GetString("section")
+		if err != nil {
+			return err
+		}
+		filters, err := cmd.Flags().GetString("filters")
+		if err != nil {
+			return err
+		}
+		profile, err := cmd.Flags().GetString("profile")
+		if err != nil {
+			return err
+		}
+		asJson, err := cmd.Flags().GetBool("json")
+		if err != nil {
+			return err
+		}
+		asCsv, err := cmd.Flags().GetBool("csv")
+		if err != nil {
+			return err
+		}
+		format, headless, err := exportFormat(asJson, asCsv)
+		if err != nil {
+			return err
+		}
+
 		var repo string
 		repos := config.IsFeatureEnabled(config.FF_REPO_VIEW)
 		if repos && len(args) > 0 {
@@ -187,43 +339,162 @@ func init() {
 				repo = r.Path()
 			}
 		}
-		debug, err := rootCmd.Flags().GetBool("debug")
-		if err != nil {
-			log.Fatal("Cannot parse debug flag", err)
+
+		location := config.Location{
+			RepoPath:   repo,
+			ConfigFlag: cfgFlag,
+			Mini:       mini,
+			Profile:    profile,
+			Filters:    filters,
+		}
+		if view != "" {
+			if location.View, err = config.ParseViewType(view); err != nil {
+				return err
+			}
+		}
+		if location.Section, err = sectionFlag(location, section); err != nil {
+			return err
+		}
+		if headless {
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			return exportSection(location, format, output)
 		}
 
-		zone.NewGlobal()
+		runDashboard(rootCmd, location)
+		return nil
+	}
+}
 
-		// see https://github.com/charmbracelet/lipgloss/issues/73
-		lipgloss.SetHasDarkBackground(termenv.HasDarkBackground())
-		markdown.InitializeMarkdownStyle(termenv.HasDarkBackground())
+// configureRequests sets up the requests of every command with the config,
+// e.g. their GraphQL options and the hosts authenticating as a GitHub App. A
+// config that fails parsing is reported by the command reading it, the
+// requests then use the defaults.
+func configureRequests() {
+	cfg, err := config.ParseConfig(config.Location{ConfigFlag: cfgFlag})
+	if err != nil {
+		log.Debug("Failed parsing the config, requests use the defaults", "err", err)
+		return
+	}
+	data.Configure(cfg)
+}
 
-		model, logger := createModel(config.Location{RepoPath: repo, ConfigFlag: cfgFlag}, debug)
-		if logger != nil {
-			defer logger.Close()
+// sectionFlag returns the position of the section passed with --section,
+// from 1, resolving a title against the sections of the view shown first
+func sectionFlag(location config.Location, section string) (int, error) {
+	if section == "" {
+		return 0, nil
+	}
+	if n, err := strconv.Atoi(section); err == nil {
+		if n < 1 {
+			return 0, fmt.Errorf("invalid section %d, sections start at 1", n)
 		}
+		return n, nil
+	}
+
+	// the filters apply to the section being resolved
+	location.Filters = ""
+	cfg, err := config.ParseConfig(location)
+	if err != nil {
+		return 0, err
+	}
+	n := cfg.SectionIndex(cfg.Defaults.View, section)
+	if n == 0 {
+		return 0, fmt.Errorf("no section titled %q in the %s view", section, cfg.Defaults.View)
+	}
+	return n, nil
+}
+
+// runDashboard runs the TUI until it's quit, cmd holds the debugging flags
+func runDashboard(cmd *cobra.Command, location config.Location) {
+	debug, err := cmd.Flags().GetBool("debug")
+	if err != nil {
+		log.Fatal("Cannot parse debug flag", err)
+	}
+
+	location.Version = currentVersion()
+
+	// see https://github.com/charmbracelet/lipgloss/issues/73
+	lipgloss.SetHasDarkBackground(termenv.HasDarkBackground())
+	markdown.InitializeMarkdownStyle(termenv.HasDarkBackground())
 
-		cpuprofile, err := rootCmd.Flags().GetString("cpuprofile")
+	logger := setupLogging(location, debug)
+	if logger != nil {
+		defer logger.Close()
+	}
+
+	cpuprofile, err := cmd.Flags().GetString("cpuprofile")
+	if err != nil {
+		log.Fatal("Cannot parse cpuprofile flag", err)
+	}
+	if cpuprofile != "" {
+		f, err := os.Create(cpuprofile)
 		if err != nil {
-			log.Fatal("Cannot parse cpuprofile flag", err)
-		}
-		if cpuprofile != "" {
-			f, err := os.Create(cpuprofile)
-			if err != nil {
-				log.Fatal(err)
-			}
-			_ = pprof.StartCPUProfile(f)
-			defer pprof.StopCPUProfile()
+			log.Fatal(err)
 		}
+		_ = pprof.StartCPUProfile(f)
+		defer pprof.StopCPUProfile()
+	}
 
+	for {
+		m := tui.NewModel(location)
 		p := tea.NewProgram(
-			model,
+			m,
 			tea.WithAltScreen(),
 			tea.WithReportFocus(),
 			tea.WithMouseCellMotion(),
 		)
-		if _, err := p.Run(); err != nil {
-			log.Fatal("Failed starting the TUI", err)
+		final, err := p.Run()
+		report, path := m.Crash(err)
+		if report == nil {
+			if err != nil {
+				log.Fatal("Failed starting the TUI", err)
+			}
+			if final, ok := final.(tui.Model); ok {
+				final.SaveSession()
+			}
+			return
 		}
+		if !offerRestart(report, path) {
+			return
+		}
+		location = restartLocation(location, report.Session)
+	}
+}
+
+// offerRestart tells where the report of the crash was saved and asks
+// whether to restart, the terminal is restored by then
+func offerRestart(report *state.CrashReport, path string) bool {
+	fmt.Fprintf(os.Stderr, "\ngh-dash crashed: %s\n", report.Panic)
+	if path != "" {
+		fmt.Fprintf(os.Stderr, "A crash report was saved to %s, please attach it when reporting the issue\n", path)
+	}
+
+	if info, err := os.Stdin.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	fmt.Fprint(os.Stderr, "Restart where you left off? [Y/n] ")
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "", "y", "yes":
+		return true
+	}
+	return false
+}
+
+// restartLocation is location moved to the session the dashboard crashed
+// in, a link opened at launch isn't opened again
+func restartLocation(location config.Location, session state.Session) config.Location {
+	location.OpenUrl = ""
+	if session.RepoPath != "" {
+		location.RepoPath = session.RepoPath
+	}
+	if view, err := config.ParseViewType(session.View); err == nil {
+		location.View = view
 	}
+	location.Section = session.Section
+	location.Filters = session.Filters
+	return location
 }
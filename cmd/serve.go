@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	"github.com/charmbracelet/wish/activeterm"
+	"github.com/charmbracelet/wish/bubbletea"
+	"github.com/charmbracelet/wish/logging"
+	"github.com/muesli/termenv"
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/metrics"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+)
+
+// hostKeyFile is the name of the host key generated in the state dir when
+// --host-key isn't passed
+const hostKeyFile = "ssh_host_ed25519"
+
+// serveCmd shares a read-only dashboard over SSH
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Share a read-only dashboard over SSH",
+	Long: `Serve the dashboard over SSH so a team can browse it without installing anything.
+Every connection gets its own dashboard, built from the same configuration and authenticated as the
+user running the server. Only keys that browse the dashboard work, anything that acts on GitHub or
+on the server, like merging, commenting, opening a browser or running custom commands, is blocked.
+
+With --metrics, the number of items and the age of the oldest one in every PR and issues section, along
+with the remaining API rate limit, are also served in the Prometheus text format on /metrics.`,
+	Example: `
+# Serve on port 2222 to the keys in ~/.ssh/authorized_keys
+gh dash serve --ssh :2222 --authorized-keys ~/.ssh/authorized_keys
+
+# Connect to it
+ssh -p 2222 dashboard.example.com
+
+# Also export the sections' counts to Prometheus, refreshed every 5 minutes
+gh dash serve --ssh :2222 --authorized-keys ~/.ssh/authorized_keys --metrics :9464
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		addr, err := cmd.Flags().GetString("ssh")
+		if err != nil {
+			return err
+		}
+		authorizedKeys, err := cmd.Flags().GetString("authorized-keys")
+		if err != nil {
+			return err
+		}
+		hostKey, err := cmd.Flags().GetString("host-key")
+		if err != nil {
+			return err
+		}
+		debug, err := cmd.Flags().GetBool("debug")
+		if err != nil {
+			return err
+		}
+		metricsAddr, err := cmd.Flags().GetString("metrics")
+		if err != nil {
+			return err
+		}
+		metricsInterval, err := cmd.Flags().GetDuration("metrics-interval")
+		if err != nil {
+			return err
+		}
+		if metricsInterval <= 0 {
+			return fmt.Errorf("--metrics-interval must be positive, got %s", metricsInterval)
+		}
+
+		location := config.Location{ConfigFlag: cfgFlag, ReadOnly: true, Shared: true}
+		// a bad config would take down the server on the first connection
+		cfg, err := config.ParseConfig(location)
+		if err != nil {
+			return err
+		}
+		// the sessions share the keybindings and the settings of git and of
+		// the requests, they're set once rather than by each session
+		if err := tui.Configure(cfg); err != nil {
+			return err
+		}
+
+		if hostKey == "" {
+			dir, err := state.Dir()
+			if err != nil {
+				return fmt.Errorf("resolving the host key path: %w", err)
+			}
+			if err := os.MkdirAll(dir, 0o700); err != nil {
+				return err
+			}
+			hostKey = filepath.Join(dir, hostKeyFile)
+		}
+
+		logger := setupLogging(location, debug)
+		if logger != nil {
+			defer logger.Close()
+		}
+
+		var exporter *metrics.Exporter
+		if metricsAddr != "" {
+			exporter = metrics.NewExporter(metrics.SectionsFromConfig(cfg), metricsInterval)
+		}
+
+		return serve(addr, hostKey, authorizedKeys, location, metricsAddr, exporter)
+	},
+}
+
+// serve runs the SSH server until it's interrupted, each session gets its own
+// dashboard. When exporter isn't nil, its metrics are served on metricsAddr.
+func serve(
+	addr, hostKey, authorizedKeys string,
+	location config.Location,
+	metricsAddr string,
+	exporter *metrics.Exporter,
+) error {
+	serverLog := log.NewWithOptions(os.Stderr, log.Options{
+		ReportTimestamp: true,
+		Prefix:          "serve",
+	})
+
+	// sessions render to the clients' terminals, not ours, so there's nothing
+	// to detect
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	lipgloss.SetHasDarkBackground(true)
+	markdown.InitializeMarkdownStyle(true)
+
+	handler := func(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+		m := tui.NewModel(location)
+		go func() {
+			<-s.Context().Done()
+			m.Close()
+		}()
+		return m, []tea.ProgramOption{
+			tea.WithAltScreen(),
+			tea.WithReportFocus(),
+			tea.WithMouseCellMotion(),
+		}
+	}
+
+	srv, err := wish.NewServer(
+		wish.WithAddress(addr),
+		wish.WithHostKeyPath(hostKey),
+		wish.WithAuthorizedKeys(authorizedKeys),
+		wish.WithMiddleware(
+			bubbletea.Middleware(handler),
+			activeterm.Middleware(),
+			logging.StructuredMiddlewareWithLogger(serverLog, log.InfoLevel),
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("creating the SSH server: %w", err)
+	}
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
+
+	errs := make(chan error, 2)
+	go func() {
+		serverLog.Info("Serving the dashboard", "addr", addr)
+		errs <- srv.ListenAndServe()
+	}()
+
+	var metricsSrv *http.Server
+	stopMetrics := make(chan struct{})
+	defer close(stopMetrics)
+	if exporter != nil {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", exporter)
+		metricsSrv = &http.Server{
+			Addr:              metricsAddr,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go exporter.Run(stopMetrics)
+		go func() {
+			serverLog.Info("Serving metrics", "addr", metricsAddr)
+			errs <- metricsSrv.ListenAndServe()
+		}()
+	}
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, ssh.ErrServerClosed) && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	case <-done:
+	}
+
+	serverLog.Info("Stopping the server")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if metricsSrv != nil {
+		if err := metricsSrv.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+	}
+	if err := srv.Shutdown(ctx); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func init() {
+	serveCmd.Flags().String(
+		"ssh",
+		":2222",
+		"address to listen for SSH connections on",
+	)
+	serveCmd.Flags().String(
+		"authorized-keys",
+		"",
+		"authorized_keys file listing the public keys allowed to connect",
+	)
+	serveCmd.Flags().String(
+		"host-key",
+		"",
+		"private key identifying the server, generated if missing (default $XDG_STATE_HOME/gh-dash/"+hostKeyFile+")",
+	)
+	serveCmd.Flags().String(
+		"metrics",
+		"",
+		"address to serve Prometheus metrics about the sections on, like :9464 (disabled by default)",
+	)
+	serveCmd.Flags().Duration(
+		"metrics-interval",
+		5*time.Minute,
+		"how often to refresh the metrics",
+	)
+	serveCmd.Flags().Bool(
+		"debug",
+		false,
+		"passing this flag will allow writing debug output to debug.log",
+	)
+	err := serveCmd.MarkFlagRequired("authorized-keys")
+	if err != nil {
+		log.Fatal("Cannot mark authorized-keys flag as required", err)
+	}
+	err = serveCmd.MarkFlagFilename("authorized-keys")
+	if err != nil {
+		log.Fatal("Cannot mark authorized-keys flag as filename", err)
+	}
+
+	rootCmd.AddCommand(serveCmd)
+}
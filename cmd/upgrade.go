@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// installMethod is how gh-dash was installed, it tells how to upgrade it
+type installMethod int
+
+const (
+	installUnknown installMethod = iota
+	installGhExtension
+	installHomebrew
+	installGo
+)
+
+// upgradeCmd upgrades gh-dash to its latest release, or tells how to
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade gh-dash to its latest release",
+	Long: `Check for a newer release of gh-dash and upgrade to it.
+
+When gh-dash was installed as a gh extension, it's upgraded with gh extension upgrade. Otherwise the
+command tells how to upgrade it the way it was installed, with Homebrew, go install or from the
+release's binaries.`,
+	Example: `
+# Upgrade to the latest release
+gh dash upgrade
+
+# Only check whether a newer release is out, and print its notes
+gh dash upgrade --check --notes
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		check, err := cmd.Flags().GetBool("check")
+		if err != nil {
+			return err
+		}
+		notes, err := cmd.Flags().GetBool("notes")
+		if err != nil {
+			return err
+		}
+
+		release, err := data.FetchLatestDashRelease()
+		if err != nil {
+			return fmt.Errorf("fetching the latest release: %w", err)
+		}
+		current := currentVersion()
+		if notes {
+			fmt.Printf("%s\n\n%s\n\n", release.Version, strings.TrimSpace(release.Notes))
+		}
+		if !data.IsNewerVersion(current, release.Version) {
+			if current == "dev" {
+				fmt.Printf("This is a development build, the latest release is %s\n", release.Version)
+			} else {
+				fmt.Printf("gh-dash %s is the latest release\n", current)
+			}
+			return nil
+		}
+
+		fmt.Printf("gh-dash %s is out, you're on %s\nRelease notes: %s\n", release.Version,
+			current, release.Url)
+		if check {
+			return nil
+		}
+
+		switch detectInstallMethod() {
+		case installGhExtension:
+			upgrade := exec.Command("gh", "extension", "upgrade", "dash")
+			upgrade.Stdin, upgrade.Stdout, upgrade.Stderr = os.Stdin, os.Stdout, os.Stderr
+			return upgrade.Run()
+		case installHomebrew:
+			fmt.Println("Upgrade it with: brew upgrade gh-dash")
+		case installGo:
+			fmt.Println("Upgrade it with: go install github.com/dlvhdr/gh-dash/v4@latest")
+		default:
+			fmt.Printf("Download it from %s\n", release.Url)
+		}
+		return nil
+	},
+}
+
+// detectInstallMethod tells how gh-dash was installed from the path of its
+// executable and from how it was built
+func detectInstallMethod() installMethod {
+	exe, err := os.Executable()
+	if err != nil {
+		return installUnknown
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+	exe = filepath.ToSlash(exe)
+
+	switch {
+	case strings.Contains(exe, "/gh/extensions/gh-dash/"):
+		return installGhExtension
+	case strings.Contains(exe, "/Cellar/") || strings.Contains(exe, "/homebrew/") ||
+		strings.Contains(exe, "/linuxbrew/"):
+		return installHomebrew
+	case Version == "dev" && currentVersion() != "dev":
+		// only go install stamps the module version into a build
+		return installGo
+	}
+	return installUnknown
+}
+
+func init() {
+	upgradeCmd.Flags().Bool(
+		"check",
+		false,
+		"only check whether a newer release is out",
+	)
+	upgradeCmd.Flags().Bool(
+		"notes",
+		false,
+		"print the notes of the latest release",
+	)
+	rootCmd.AddCommand(upgradeCmd)
+}
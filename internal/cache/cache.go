@@ -0,0 +1,137 @@
+// Package cache persists fetched section rows on disk so they can be shown
+// right away on the next launch, while fresh data is being fetched.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const (
+	dashDir                            = "gh-dash"
+	defaultXdgCacheDirName             = ".cache"
+	responsesDir                       = "responses"
+	fileMode               os.FileMode = 0o600
+)
+
+// ErrMiss is returned when there's no cached entry for a key, or when it's
+// too old to be used
+var ErrMiss = errors.New("cache miss")
+
+type entry[T any] struct {
+	Key     string    `json:"key"`
+	SavedAt time.Time `json:"savedAt"`
+	Data    T         `json:"data"`
+}
+
+// Dir returns the directory cached responses are stored in, dir overrides
+// the default of $XDG_CACHE_HOME/gh-dash or ~/.cache/gh-dash
+func Dir(dir string) (string, error) {
+	if dir != "" {
+		return filepath.Join(dir, responsesDir), nil
+	}
+
+	cacheDir := os.Getenv("XDG_CACHE_HOME")
+	if cacheDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		cacheDir = filepath.Join(homeDir, defaultXdgCacheDirName)
+	}
+
+	return filepath.Join(cacheDir, dashDir, responsesDir), nil
+}
+
+// Key builds a cache key out of the parts identifying a response, e.g. the
+// view and search query of a section
+func Key(parts ...string) string {
+	return strings.Join(parts, "\x00")
+}
+
+func path(dir string, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Read returns the data stored under key and when it was saved. Entries older
+// than maxAge are treated as missing, a maxAge of 0 accepts any age.
+func Read[T any](dir string, key string, maxAge time.Duration) (T, time.Time, error) {
+	var e entry[T]
+
+	b, err := os.ReadFile(path(dir, key))
+	if errors.Is(err, os.ErrNotExist) {
+		return e.Data, time.Time{}, ErrMiss
+	}
+	if err != nil {
+		return e.Data, time.Time{}, err
+	}
+
+	if err := json.Unmarshal(b, &e); err != nil {
+		return e.Data, time.Time{}, err
+	}
+
+	// guards against hash collisions and entries written by older versions
+	if e.Key != key {
+		return e.Data, time.Time{}, ErrMiss
+	}
+
+	if maxAge > 0 && time.Since(e.SavedAt) > maxAge {
+		return e.Data, e.SavedAt, ErrMiss
+	}
+
+	return e.Data, e.SavedAt, nil
+}
+
+// Write stores data under key, replacing any previous entry
+func Write[T any](dir string, key string, data T) error {
+	b, err := json.Marshal(entry[T]{Key: key, SavedAt: time.Now(), Data: data})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	// write to a temp file first so a concurrent read never sees a partial entry
+	tmp, err := os.CreateTemp(dir, "*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(fileMode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	p := path(dir, key)
+	log.Debug("Writing cached response", "key", key, "path", p)
+	return os.Rename(tmp.Name(), p)
+}
+
+// Remove deletes the entry stored under key, removing a missing entry isn't
+// an error
+func Remove(dir string, key string) error {
+	err := os.Remove(path(dir, key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type row struct {
+	Number int
+	Title  string
+}
+
+func TestReadWrite(t *testing.T) {
+	tests := []struct {
+		name     string
+		writeKey string
+		readKey  string
+		maxAge   time.Duration
+		wait     time.Duration
+		wantErr  error
+	}{
+		{
+			name:     "hit",
+			writeKey: Key("prs", "is:open"),
+			readKey:  Key("prs", "is:open"),
+		},
+		{
+			name:     "different query",
+			writeKey: Key("prs", "is:open"),
+			readKey:  Key("prs", "is:closed"),
+			wantErr:  ErrMiss,
+		},
+		{
+			name:     "different view",
+			writeKey: Key("prs", "is:open"),
+			readKey:  Key("issues", "is:open"),
+			wantErr:  ErrMiss,
+		},
+		{
+			name:     "expired",
+			writeKey: Key("prs", "is:open"),
+			readKey:  Key("prs", "is:open"),
+			maxAge:   time.Millisecond,
+			wait:     5 * time.Millisecond,
+			wantErr:  ErrMiss,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			want := []row{{Number: 1, Title: "Fix typo"}, {Number: 2, Title: "Add cache"}}
+
+			if err := Write(dir, tt.writeKey, want); err != nil {
+				t.Fatalf("Write() err = %v", err)
+			}
+			time.Sleep(tt.wait)
+
+			got, savedAt, err := Read[[]row](dir, tt.readKey, tt.maxAge)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Read() err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if savedAt.IsZero() {
+				t.Errorf("Read() savedAt is zero")
+			}
+			if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+				t.Errorf("Read() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	dir := t.TempDir()
+	key := Key("prs", "is:open")
+	if err := Write(dir, key, []row{{Number: 1, Title: "Fix typo"}}); err != nil {
+		t.Fatalf("Write() err = %v", err)
+	}
+
+	if err := Remove(dir, key); err != nil {
+		t.Fatalf("Remove() err = %v", err)
+	}
+	if _, _, err := Read[[]row](dir, key, 0); !errors.Is(err, ErrMiss) {
+		t.Errorf("Read() after Remove() err = %v, want %v", err, ErrMiss)
+	}
+	if err := Remove(dir, key); err != nil {
+		t.Errorf("Remove() of a missing entry err = %v, want nil", err)
+	}
+}
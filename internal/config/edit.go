@@ -0,0 +1,369 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	yamlmarshaller "gopkg.in/yaml.v3"
+)
+
+// SectionDraft is a PR or issues section as created or edited from the
+// dashboard, the settings it doesn't cover are kept as configured
+type SectionDraft struct {
+	// View is the view the section is in, PRsView or IssuesView
+	View    ViewType
+	Title   string
+	Filters string
+	Limit   *int
+	// Hidden are the columns of the layout the section hides
+	Hidden []string
+	// Provider and Host are the section's forge, they can't be edited but
+	// the rows previewed are fetched from it
+	Provider string
+	Host     string
+}
+
+// Validate returns why the draft can't be saved
+func (d SectionDraft) Validate() error {
+	if d.View != PRsView && d.View != IssuesView {
+		return fmt.Errorf("the type should be %s or %s", PRsView, IssuesView)
+	}
+	if strings.TrimSpace(d.Title) == "" {
+		return errors.New("a title is required")
+	}
+	if strings.TrimSpace(d.Filters) == "" {
+		return errors.New("filters are required")
+	}
+	if d.Limit != nil && *d.Limit <= 0 {
+		return errors.New("the limit should be positive")
+	}
+	columns := LayoutColumns(d.View)
+	for _, column := range d.Hidden {
+		if !slices.Contains(columns, column) {
+			return fmt.Errorf("unknown column %q, expected one of %s", column, strings.Join(columns, ", "))
+		}
+	}
+	return nil
+}
+
+// SectionDrafts returns the PR or issues sections of cfg as drafts, in order
+func (cfg Config) SectionDrafts(view ViewType) []SectionDraft {
+	var drafts []SectionDraft
+	switch view {
+	case PRsView:
+		for _, s := range cfg.PRSections {
+			drafts = append(drafts, SectionDraft{
+				View: view, Title: s.Title, Filters: s.Filters, Limit: s.Limit,
+				Hidden: hiddenColumns(s.Layout), Provider: s.Provider, Host: s.Host,
+			})
+		}
+	case IssuesView:
+		for _, s := range cfg.IssuesSections {
+			drafts = append(drafts, SectionDraft{
+				View: view, Title: s.Title, Filters: s.Filters, Limit: s.Limit,
+				Hidden: hiddenColumns(s.Layout), Provider: s.Provider, Host: s.Host,
+			})
+		}
+	}
+	return drafts
+}
+
+// LayoutColumns returns the names of the columns of the layout of the PR or
+// issues sections, as they're configured
+func LayoutColumns(view ViewType) []string {
+	var layout reflect.Type
+	switch view {
+	case PRsView:
+		layout = reflect.TypeFor[PrsLayoutConfig]()
+	case IssuesView:
+		layout = reflect.TypeFor[IssuesLayoutConfig]()
+	default:
+		return nil
+	}
+	columns := make([]string, 0, layout.NumField())
+	for i := range layout.NumField() {
+		name, _, _ := strings.Cut(layout.Field(i).Tag.Get("yaml"), ",")
+		columns = append(columns, name)
+	}
+	return columns
+}
+
+// hiddenColumns returns the names of the columns layout hides
+func hiddenColumns(layout any) []string {
+	var hidden []string
+	v := reflect.ValueOf(layout)
+	for i := range v.NumField() {
+		column, ok := v.Field(i).Interface().(ColumnConfig)
+		if ok && column.Hidden != nil && *column.Hidden {
+			name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("yaml"), ",")
+			hidden = append(hidden, name)
+		}
+	}
+	return hidden
+}
+
+// sectionsKey returns the key of the sections of view in the config file
+func sectionsKey(view ViewType) (string, error) {
+	switch view {
+	case PRsView:
+		return "prSections", nil
+	case IssuesView:
+		return "issuesSections", nil
+	default:
+		return "", fmt.Errorf("only PR and issue sections can be edited, not %s", view)
+	}
+}
+
+// ConfigFile is a config file whose sections are edited from the dashboard.
+// The edits keep the file's comments and the settings they don't cover.
+type ConfigFile struct {
+	path string
+	root *yamlmarshaller.Node
+	// cfg seeds the section lists missing from the file, the dashboard
+	// shows the default ones then
+	cfg Config
+}
+
+// ConfigPath returns the path of the config file of location, the one whose
+// sections the dashboard shows
+func ConfigPath(location Location) (string, error) {
+	parser := initParser()
+	if path := parser.getProvidedConfigPath(location); path != "" {
+		return path, nil
+	}
+	return parser.getGlobalConfigPathOrCreateIfMissing()
+}
+
+// OpenConfigFile reads the config file at path, cfg is the config parsed
+// from it
+func OpenConfigFile(path string, cfg Config) (*ConfigFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc yamlmarshaller.Node
+	if err := yamlmarshaller.Unmarshal(content, &doc); err != nil {
+		return nil, parsingError{path: path, err: err}
+	}
+	f := &ConfigFile{path: path, cfg: cfg}
+	switch {
+	case len(doc.Content) == 0:
+		f.root = &yamlmarshaller.Node{Kind: yamlmarshaller.MappingNode}
+	case doc.Content[0].Kind == yamlmarshaller.MappingNode:
+		f.root = doc.Content[0]
+	default:
+		return nil, parsingError{path: path, err: errors.New("the config isn't a mapping")}
+	}
+	return f, nil
+}
+
+func (f *ConfigFile) Path() string {
+	return f.path
+}
+
+// Bytes returns the edited content of the file
+func (f *ConfigFile) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yamlmarshaller.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(f.root); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Write saves the edits to the file
+func (f *ConfigFile) Write() error {
+	content, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(f.path, content, 0o644)
+}
+
+// sections returns the list of the sections of view, adding the sections of
+// the parsed config to the file when it has none
+func (f *ConfigFile) sections(view ViewType) (*yamlmarshaller.Node, error) {
+	key, err := sectionsKey(view)
+	if err != nil {
+		return nil, err
+	}
+	if node := mappingValue(f.root, key); node != nil && node.Kind == yamlmarshaller.SequenceNode {
+		return node, nil
+	}
+
+	var seed any = f.cfg.PRSections
+	if view == IssuesView {
+		seed = f.cfg.IssuesSections
+	}
+	node := &yamlmarshaller.Node{}
+	if err := node.Encode(seed); err != nil {
+		return nil, err
+	}
+	if node.Kind != yamlmarshaller.SequenceNode {
+		node = &yamlmarshaller.Node{Kind: yamlmarshaller.SequenceNode}
+	}
+	setMappingValue(f.root, key, node)
+	return node, nil
+}
+
+// SaveSection replaces the section of view at index with draft, or appends
+// it when index is -1. The section moves to the end of the draft's view
+// when it's another one. It returns the index of the saved section.
+func (f *ConfigFile) SaveSection(view ViewType, index int, draft SectionDraft) (int, error) {
+	if err := draft.Validate(); err != nil {
+		return 0, err
+	}
+	from, err := f.sections(view)
+	if err != nil {
+		return 0, err
+	}
+	if index >= len(from.Content) {
+		return 0, fmt.Errorf("no section %d in the %s view", index+1, view)
+	}
+
+	node := &yamlmarshaller.Node{Kind: yamlmarshaller.MappingNode}
+	if index >= 0 {
+		node = from.Content[index]
+	}
+	if draft.View != view && index >= 0 {
+		from.Content = slices.Delete(from.Content, index, index+1)
+		// the layouts and types of the PR and issues sections differ
+		deleteMappingValue(node, "layout")
+		deleteMappingValue(node, "type")
+		index = -1
+	}
+	to, err := f.sections(draft.View)
+	if err != nil {
+		return 0, err
+	}
+	if index < 0 {
+		to.Content = append(to.Content, node)
+		index = len(to.Content) - 1
+	}
+
+	setMappingValue(node, "title", scalar(draft.Title))
+	setMappingValue(node, "filters", scalar(draft.Filters))
+	if draft.Limit != nil {
+		setMappingValue(node, "limit", &yamlmarshaller.Node{
+			Kind: yamlmarshaller.ScalarNode, Tag: "!!int", Value: strconv.Itoa(*draft.Limit),
+		})
+	} else {
+		deleteMappingValue(node, "limit")
+	}
+	setHiddenColumns(node, draft.View, draft.Hidden)
+	return index, nil
+}
+
+// DeleteSection removes the section of view at index
+func (f *ConfigFile) DeleteSection(view ViewType, index int) error {
+	sections, err := f.sections(view)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(sections.Content) {
+		return fmt.Errorf("no section %d in the %s view", index+1, view)
+	}
+	sections.Content = slices.Delete(sections.Content, index, index+1)
+	return nil
+}
+
+// MoveSection moves the section of view at from to the index to
+func (f *ConfigFile) MoveSection(view ViewType, from, to int) error {
+	sections, err := f.sections(view)
+	if err != nil {
+		return err
+	}
+	n := len(sections.Content)
+	if from < 0 || from >= n || to < 0 || to >= n {
+		return fmt.Errorf("can't move section %d to %d in the %s view", from+1, to+1, view)
+	}
+	node := sections.Content[from]
+	sections.Content = slices.Insert(slices.Delete(sections.Content, from, from+1), to, node)
+	return nil
+}
+
+// setHiddenColumns hides the columns of the layout of section, the others
+// are shown as the default layout configures them
+func setHiddenColumns(section *yamlmarshaller.Node, view ViewType, hidden []string) {
+	layout := mappingValue(section, "layout")
+	if layout == nil || layout.Kind != yamlmarshaller.MappingNode {
+		if len(hidden) == 0 {
+			return
+		}
+		layout = &yamlmarshaller.Node{Kind: yamlmarshaller.MappingNode}
+		setMappingValue(section, "layout", layout)
+	}
+
+	for _, column := range LayoutColumns(view) {
+		node := mappingValue(layout, column)
+		if slices.Contains(hidden, column) {
+			if node == nil || node.Kind != yamlmarshaller.MappingNode {
+				node = &yamlmarshaller.Node{Kind: yamlmarshaller.MappingNode}
+				setMappingValue(layout, column, node)
+			}
+			setMappingValue(node, "hidden", &yamlmarshaller.Node{
+				Kind: yamlmarshaller.ScalarNode, Tag: "!!bool", Value: "true",
+			})
+			continue
+		}
+		if node == nil || node.Kind != yamlmarshaller.MappingNode {
+			continue
+		}
+		deleteMappingValue(node, "hidden")
+		if len(node.Content) == 0 {
+			deleteMappingValue(layout, column)
+		}
+	}
+	if len(layout.Content) == 0 {
+		deleteMappingValue(section, "layout")
+	}
+}
+
+func scalar(value string) *yamlmarshaller.Node {
+	return &yamlmarshaller.Node{Kind: yamlmarshaller.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// mappingIndex returns the index of the key node of key in mapping, ignoring
+// case like the parser does, or -1
+func mappingIndex(mapping *yamlmarshaller.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if strings.EqualFold(mapping.Content[i].Value, key) {
+			return i
+		}
+	}
+	return -1
+}
+
+func mappingValue(mapping *yamlmarshaller.Node, key string) *yamlmarshaller.Node {
+	if i := mappingIndex(mapping, key); i >= 0 {
+		return mapping.Content[i+1]
+	}
+	return nil
+}
+
+func setMappingValue(mapping *yamlmarshaller.Node, key string, value *yamlmarshaller.Node) {
+	if i := mappingIndex(mapping, key); i >= 0 {
+		// keep the comments of the value replaced
+		value.HeadComment = mapping.Content[i+1].HeadComment
+		value.LineComment = mapping.Content[i+1].LineComment
+		mapping.Content[i+1] = value
+		return
+	}
+	mapping.Content = append(mapping.Content, scalar(key), value)
+}
+
+func deleteMappingValue(mapping *yamlmarshaller.Node, key string) {
+	if i := mappingIndex(mapping, key); i >= 0 {
+		mapping.Content = slices.Delete(mapping.Content, i, i+2)
+	}
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const editedConfig = `# my sections
+prSections:
+  - title: Mine # the ones I opened
+    filters: is:open author:@me
+    layout:
+      repo:
+        hidden: true
+        width: 20
+  - title: Review
+    filters: is:open review-requested:@me
+theme:
+  ui:
+    table:
+      compact: true
+`
+
+func TestConfigFileEdits(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(f *ConfigFile) error
+		want string
+	}{
+		{
+			name: "edit a section",
+			edit: func(f *ConfigFile) error {
+				_, err := f.SaveSection(PRsView, 0, SectionDraft{
+					View: PRsView, Title: "Mine", Filters: "is:open author:@me draft:false",
+					Limit: utils.IntPtr(10), Hidden: []string{"author"},
+				})
+				return err
+			},
+			want: `# my sections
+prSections:
+  - title: Mine # the ones I opened
+    filters: is:open author:@me draft:false
+    layout:
+      repo:
+        width: 20
+      author:
+        hidden: true
+    limit: 10
+  - title: Review
+    filters: is:open review-requested:@me
+theme:
+  ui:
+    table:
+      compact: true
+`,
+		},
+		{
+			name: "move a section",
+			edit: func(f *ConfigFile) error {
+				return f.MoveSection(PRsView, 1, 0)
+			},
+			want: `# my sections
+prSections:
+  - title: Review
+    filters: is:open review-requested:@me
+  - title: Mine # the ones I opened
+    filters: is:open author:@me
+    layout:
+      repo:
+        hidden: true
+        width: 20
+theme:
+  ui:
+    table:
+      compact: true
+`,
+		},
+		{
+			name: "delete a section",
+			edit: func(f *ConfigFile) error {
+				return f.DeleteSection(PRsView, 0)
+			},
+			want: `# my sections
+prSections:
+  - title: Review
+    filters: is:open review-requested:@me
+theme:
+  ui:
+    table:
+      compact: true
+`,
+		},
+		{
+			name: "change the type of a section",
+			edit: func(f *ConfigFile) error {
+				_, err := f.SaveSection(PRsView, 1, SectionDraft{
+					View: IssuesView, Title: "Review", Filters: "is:open assignee:@me",
+				})
+				return err
+			},
+			want: `# my sections
+prSections:
+  - title: Mine # the ones I opened
+    filters: is:open author:@me
+    layout:
+      repo:
+        hidden: true
+        width: 20
+theme:
+  ui:
+    table:
+      compact: true
+issuesSections:
+  - title: Assigned
+    filters: is:open assignee:@me
+  - title: Review
+    filters: is:open assignee:@me
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yml")
+			require.NoError(t, os.WriteFile(path, []byte(editedConfig), 0o644))
+			cfg := Config{IssuesSections: []IssuesSectionConfig{
+				{Title: "Assigned", Filters: "is:open assignee:@me"},
+			}}
+
+			f, err := OpenConfigFile(path, cfg)
+			require.NoError(t, err)
+			require.NoError(t, tt.edit(f))
+			require.NoError(t, f.Write())
+
+			got, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestConfigFileNewSection(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte(editedConfig), 0o644))
+	f, err := OpenConfigFile(path, Config{})
+	require.NoError(t, err)
+
+	index, err := f.SaveSection(PRsView, -1, SectionDraft{View: PRsView, Title: "Bugs", Filters: "label:bug"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, index)
+
+	_, err = f.SaveSection(PRsView, -1, SectionDraft{View: PRsView, Title: "No filters"})
+	assert.Error(t, err)
+	assert.Error(t, f.MoveSection(PRsView, 2, 3))
+}
+
+func TestSectionDraftValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		draft   SectionDraft
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			draft: SectionDraft{View: IssuesView, Title: "Bugs", Filters: "label:bug", Hidden: []string{"creator"}},
+		},
+		{
+			name:    "unknown view",
+			draft:   SectionDraft{View: FeedsView, Title: "Bugs", Filters: "label:bug"},
+			wantErr: true,
+		},
+		{
+			name:    "no title",
+			draft:   SectionDraft{View: PRsView, Filters: "label:bug"},
+			wantErr: true,
+		},
+		{
+			name:    "zero limit",
+			draft:   SectionDraft{View: PRsView, Title: "Bugs", Filters: "label:bug", Limit: utils.IntPtr(0)},
+			wantErr: true,
+		},
+		{
+			name:    "column of the other view",
+			draft:   SectionDraft{View: PRsView, Title: "Bugs", Filters: "label:bug", Hidden: []string{"creator"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.draft.Validate()
+			assert.Equal(t, tt.wantErr, err != nil, "Validate() = %v", err)
+		})
+	}
+}
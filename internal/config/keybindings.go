@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+)
+
+// AppliesToSection reports whether the keybinding is active in the section
+// with the given id
+func (kb Keybinding) AppliesToSection(sectionId int) bool {
+	return len(kb.Sections) == 0 || slices.Contains(kb.Sections, sectionId)
+}
+
+// FindKeybinding returns the custom command bound to key in the section with
+// the given id, and the index of the list it was found in. Section scoped
+// commands take precedence over unscoped ones, after that earlier lists take
+// precedence over later ones.
+func FindKeybinding(key string, sectionId int, lists ...[]Keybinding) (Keybinding, int, bool) {
+	for _, scoped := range []bool{true, false} {
+		for i, list := range lists {
+			for _, kb := range list {
+				if kb.Builtin != "" || kb.Command == "" || kb.Key != key ||
+					(len(kb.Sections) > 0) != scoped {
+					continue
+				}
+				if kb.AppliesToSection(sectionId) {
+					return kb, i, true
+				}
+			}
+		}
+	}
+	return Keybinding{}, -1, false
+}
+
+// Conflicts returns a description of every pair of keybindings that are bound
+// to the same key in overlapping scopes, along with scopes that won't be used
+func (kbs Keybindings) Conflicts() []string {
+	var conflicts []string
+
+	views := []struct {
+		name string
+		list []Keybinding
+	}{
+		{"universal", kbs.Universal},
+		{"prs", kbs.Prs},
+		{"issues", kbs.Issues},
+		{"branches", kbs.Branches},
+		{"workflows", kbs.Workflows},
+	}
+
+	for _, view := range views {
+		for i, kb := range view.list {
+			if kb.Builtin != "" && len(kb.Sections) > 0 {
+				conflicts = append(conflicts, fmt.Sprintf(
+					"%s: sections are ignored for builtin %q, only custom commands can be scoped",
+					view.name, kb.Builtin))
+			}
+
+			for _, other := range view.list[i+1:] {
+				if kb.Key == other.Key && sectionsOverlap(kb, other) {
+					conflicts = append(conflicts, fmt.Sprintf(
+						"%s: key %q is bound to both %s and %s%s",
+						view.name, kb.Key, kb.describe(), other.describe(), scopeSuffix(kb, other)))
+				}
+			}
+
+			if view.name == "universal" || kb.Builtin != "" {
+				continue
+			}
+			for _, universal := range kbs.Universal {
+				if universal.Builtin == "" && universal.Key == kb.Key && sectionsOverlap(kb, universal) {
+					conflicts = append(conflicts, fmt.Sprintf(
+						"%s: key %q is bound to %s, which conflicts with universal %s%s",
+						view.name, kb.Key, kb.describe(), universal.describe(), scopeSuffix(kb, universal)))
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
+
+func (kb Keybinding) describe() string {
+	switch {
+	case kb.Builtin != "":
+		return fmt.Sprintf("builtin %q", kb.Builtin)
+	case kb.Name != "":
+		return fmt.Sprintf("%q", kb.Name)
+	default:
+		return fmt.Sprintf("command %q", TruncateCommand(kb.Command))
+	}
+}
+
+// scope returns the sections the keybinding is active in, builtins can't be
+// scoped so they're active everywhere
+func (kb Keybinding) scope() []int {
+	if kb.Builtin != "" {
+		return nil
+	}
+	return kb.Sections
+}
+
+func sectionsOverlap(a, b Keybinding) bool {
+	as, bs := a.scope(), b.scope()
+	if len(as) == 0 || len(bs) == 0 {
+		// an unscoped keybinding is shadowed by a scoped one rather than
+		// conflicting with it
+		return len(as) == len(bs)
+	}
+	for _, s := range as {
+		if slices.Contains(bs, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func scopeSuffix(a, b Keybinding) string {
+	for _, s := range a.scope() {
+		if slices.Contains(b.scope(), s) {
+			return fmt.Sprintf(" in section %d", s)
+		}
+	}
+	return ""
+}
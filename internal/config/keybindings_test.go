@@ -0,0 +1,155 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindKeybinding(t *testing.T) {
+	universal := []Keybinding{
+		{Key: "g", Command: "lazygit"},
+		{Key: "q", Builtin: "quit"},
+	}
+	prs := []Keybinding{
+		{Key: "g", Command: "gh pr view", Sections: []int{2}},
+		{Key: "d", Command: "gh pr diff"},
+		{Key: "d", Command: "gh pr diff --name-only", Sections: []int{1, 3}},
+		{Key: "e", Name: "empty"},
+	}
+
+	tests := []struct {
+		name      string
+		key       string
+		sectionId int
+		want      string
+		wantList  int
+		wantOk    bool
+	}{
+		{
+			name:      "universal command",
+			key:       "g",
+			sectionId: 1,
+			want:      "lazygit",
+			wantList:  0,
+			wantOk:    true,
+		},
+		{
+			name:      "section scoped command shadows universal",
+			key:       "g",
+			sectionId: 2,
+			want:      "gh pr view",
+			wantList:  1,
+			wantOk:    true,
+		},
+		{
+			name:      "unscoped view command",
+			key:       "d",
+			sectionId: 2,
+			want:      "gh pr diff",
+			wantList:  1,
+			wantOk:    true,
+		},
+		{
+			name:      "section scoped command shadows unscoped",
+			key:       "d",
+			sectionId: 3,
+			want:      "gh pr diff --name-only",
+			wantList:  1,
+			wantOk:    true,
+		},
+		{
+			name:      "builtins are not custom commands",
+			key:       "q",
+			sectionId: 1,
+			wantList:  -1,
+		},
+		{
+			name:      "keybinding without command",
+			key:       "e",
+			sectionId: 1,
+			wantList:  -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, list, ok := FindKeybinding(tt.key, tt.sectionId, universal, prs)
+			if ok != tt.wantOk || list != tt.wantList || got.Command != tt.want {
+				t.Errorf("FindKeybinding(%q, %d) = %q, %d, %v, want %q, %d, %v",
+					tt.key, tt.sectionId, got.Command, list, ok, tt.want, tt.wantList, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestKeybindingConflicts(t *testing.T) {
+	tests := []struct {
+		name        string
+		keybindings Keybindings
+		want        []string
+	}{
+		{
+			name: "no conflicts",
+			keybindings: Keybindings{
+				Universal: []Keybinding{{Key: "g", Command: "lazygit"}},
+				Prs: []Keybinding{
+					{Key: "d", Builtin: "diff"},
+					{Key: "d", Command: "gh pr diff --name-only", Sections: []int{2}},
+					{Key: "v", Command: "gh pr view", Sections: []int{1}},
+					{Key: "v", Command: "gh pr view --web", Sections: []int{2}},
+				},
+				Branches: []Keybinding{{Key: "d", Builtin: "delete"}},
+			},
+		},
+		{
+			name: "same key in the same view",
+			keybindings: Keybindings{
+				Prs: []Keybinding{
+					{Key: "d", Builtin: "diff"},
+					{Key: "d", Name: "diff names", Command: "gh pr diff --name-only"},
+				},
+			},
+			want: []string{`prs: key "d" is bound to both builtin "diff" and "diff names"`},
+		},
+		{
+			name: "overlapping sections",
+			keybindings: Keybindings{
+				Issues: []Keybinding{
+					{Key: "v", Command: "gh issue view", Sections: []int{1, 2}},
+					{Key: "v", Command: "gh issue view --web", Sections: []int{2, 3}},
+				},
+			},
+			want: []string{
+				`issues: key "v" is bound to both command "gh issue view" and command "gh issue view --web" in section 2`,
+			},
+		},
+		{
+			name: "view command conflicts with universal",
+			keybindings: Keybindings{
+				Universal: []Keybinding{{Key: "g", Command: "lazygit"}},
+				Branches:  []Keybinding{{Key: "g", Command: "git log"}},
+			},
+			want: []string{
+				`branches: key "g" is bound to command "git log", which conflicts with universal command "lazygit"`,
+			},
+		},
+		{
+			name: "scoped builtin",
+			keybindings: Keybindings{
+				Prs: []Keybinding{{Key: "m", Builtin: "merge", Sections: []int{1}}},
+			},
+			want: []string{
+				`prs: sections are ignored for builtin "merge", only custom commands can be scoped`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.keybindings.Conflicts()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Conflicts() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
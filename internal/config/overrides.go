@@ -0,0 +1,146 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ViewTypes are the views of the dashboard, in the order they're offered
+// when completing --view
+var ViewTypes = []ViewType{
+	PRsView,
+	IssuesView,
+	RepoView,
+	WorkflowsView,
+	FeedsView,
+	DiscussionsView,
+	ReleasesView,
+	DependenciesView,
+	ArchiveView,
+}
+
+// ParseViewType returns the view named s, ignoring case
+func ParseViewType(s string) (ViewType, error) {
+	for _, view := range ViewTypes {
+		if strings.EqualFold(s, string(view)) {
+			return view, nil
+		}
+	}
+	names := make([]string, 0, len(ViewTypes))
+	for _, view := range ViewTypes {
+		names = append(names, string(view))
+	}
+	return "", fmt.Errorf("unknown view %q, expected one of %s", s, strings.Join(names, ", "))
+}
+
+// SectionTitles returns the titles of the configured sections of view, in
+// order
+func (cfg Config) SectionTitles(view ViewType) []string {
+	var titles []string
+	switch view {
+	case PRsView:
+		for _, s := range cfg.PRSections {
+			titles = append(titles, s.Title)
+		}
+	case IssuesView:
+		for _, s := range cfg.IssuesSections {
+			titles = append(titles, s.Title)
+		}
+	case WorkflowsView:
+		for _, s := range cfg.WorkflowsSections {
+			titles = append(titles, s.Title)
+		}
+	case FeedsView:
+		for _, s := range cfg.FeedsSections {
+			titles = append(titles, s.Title)
+		}
+	case DiscussionsView:
+		for _, s := range cfg.DiscussionsSections {
+			titles = append(titles, s.Title)
+		}
+	case ReleasesView:
+		for _, s := range cfg.ReleasesSections {
+			titles = append(titles, s.Title)
+		}
+	case DependenciesView:
+		for _, s := range cfg.DependenciesSections {
+			titles = append(titles, s.Title)
+		}
+	case ArchiveView:
+		if cfg.Archive.Enabled() {
+			for _, s := range ArchiveSections {
+				titles = append(titles, s.Title)
+			}
+		}
+	}
+	return titles
+}
+
+// SectionIndex returns the position of the section of view titled title,
+// from 1 and ignoring case, or 0 when there's no such section
+func (cfg Config) SectionIndex(view ViewType, title string) int {
+	for i, t := range cfg.SectionTitles(view) {
+		if strings.EqualFold(t, title) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// CheckView returns why view can't be shown, nil when it can
+func (cfg Config) CheckView(view ViewType) error {
+	switch view {
+	case PRsView, IssuesView:
+	case RepoView:
+		if !IsFeatureEnabled(FF_REPO_VIEW) {
+			return fmt.Errorf("the %s view isn't enabled", view)
+		}
+	case ArchiveView:
+		if !cfg.Archive.Enabled() {
+			return fmt.Errorf("the %s view is off, set archive.days to turn it on", view)
+		}
+	default:
+		if len(cfg.SectionTitles(view)) == 0 {
+			return fmt.Errorf("the %s view has no sections configured", view)
+		}
+	}
+	return nil
+}
+
+// applyLocation overrides the default view and the filters of the section
+// shown first with the ones passed on the command line
+func (cfg *Config) applyLocation(location Location) error {
+	if location.View != "" {
+		if err := cfg.CheckView(location.View); err != nil {
+			return err
+		}
+		cfg.Defaults.View = location.View
+	}
+
+	if location.Filters == "" {
+		return nil
+	}
+	view := cfg.Defaults.View
+	count := len(cfg.SectionTitles(view))
+	if count == 0 {
+		return fmt.Errorf("the %s view has no sections to apply the filters to", view)
+	}
+	// like the dashboard, a section out of range shows the first one
+	i := location.Section - 1
+	if i < 0 || i >= count {
+		i = 0
+	}
+	switch view {
+	case PRsView:
+		cfg.PRSections[i].Filters = location.Filters
+	case IssuesView:
+		cfg.IssuesSections[i].Filters = location.Filters
+	case WorkflowsView:
+		cfg.WorkflowsSections[i].Filters = location.Filters
+	case DiscussionsView:
+		cfg.DiscussionsSections[i].Filters = location.Filters
+	default:
+		return fmt.Errorf("the sections of the %s view have no filters", view)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testOverridesConfig() Config {
+	return Config{
+		Defaults: Defaults{View: PRsView},
+		PRSections: []PrsSectionConfig{
+			{Title: "Mine", Filters: "author:@me"},
+			{Title: "Needs My Review", Filters: "review-requested:@me"},
+		},
+		IssuesSections: []IssuesSectionConfig{
+			{Title: "Assigned", Filters: "assignee:@me"},
+		},
+		FeedsSections: []FeedsSectionConfig{
+			{Title: "Releases", Url: "https://example.com/feed"},
+		},
+	}
+}
+
+func TestParseViewType(t *testing.T) {
+	view, err := ParseViewType("Issues")
+	assert.NoError(t, err)
+	assert.Equal(t, IssuesView, view)
+
+	_, err = ParseViewType("inbox")
+	assert.Error(t, err)
+}
+
+func TestSectionIndex(t *testing.T) {
+	cfg := testOverridesConfig()
+	assert.Equal(t, 2, cfg.SectionIndex(PRsView, "needs my review"))
+	assert.Equal(t, 1, cfg.SectionIndex(IssuesView, "Assigned"))
+	assert.Equal(t, 0, cfg.SectionIndex(IssuesView, "Mine"))
+}
+
+func TestApplyLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		location Location
+		wantErr  bool
+		check    func(t *testing.T, cfg Config)
+	}{
+		{
+			name:     "view",
+			location: Location{View: IssuesView},
+			check: func(t *testing.T, cfg Config) {
+				assert.Equal(t, IssuesView, cfg.Defaults.View)
+			},
+		},
+		{
+			name:     "view without sections",
+			location: Location{View: WorkflowsView},
+			wantErr:  true,
+		},
+		{
+			name:     "archive view that's off",
+			location: Location{View: ArchiveView},
+			wantErr:  true,
+		},
+		{
+			name:     "filters of the first section",
+			location: Location{Filters: "is:open"},
+			check: func(t *testing.T, cfg Config) {
+				assert.Equal(t, "is:open", cfg.PRSections[0].Filters)
+				assert.Equal(t, "review-requested:@me", cfg.PRSections[1].Filters)
+			},
+		},
+		{
+			name:     "filters of the picked section",
+			location: Location{Section: 2, Filters: "is:open"},
+			check: func(t *testing.T, cfg Config) {
+				assert.Equal(t, "author:@me", cfg.PRSections[0].Filters)
+				assert.Equal(t, "is:open", cfg.PRSections[1].Filters)
+			},
+		},
+		{
+			name:     "filters of a section out of range",
+			location: Location{View: IssuesView, Section: 5, Filters: "is:closed"},
+			check: func(t *testing.T, cfg Config) {
+				assert.Equal(t, "is:closed", cfg.IssuesSections[0].Filters)
+			},
+		},
+		{
+			name:     "filters of a view without them",
+			location: Location{View: FeedsView, Filters: "is:open"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testOverridesConfig()
+			err := cfg.applyLocation(tt.location)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			tt.check(t, cfg)
+		})
+	}
+}
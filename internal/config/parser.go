@@ -38,7 +38,10 @@ const ConfigYamlFileName = "config.yaml"
 
 const DEFAULT_XDG_CONFIG_DIRNAME = ".config"
 
-var validate *validator.Validate
+// validate checks configs against the validate tags of their fields. It's
+// created once since the dashboards served over SSH parse the config at the
+// same time.
+var validate = newValidator()
 
 /* Stringer implementation for ViewType */
 type ViewType string
@@ -63,42 +66,193 @@ func (a *ViewType) UnmarshalJSON(b []byte) error {
 		*a = IssuesView
 	case "repo":
 		*a = RepoView
+	case "workflows":
+		*a = WorkflowsView
+	case "feeds":
+		*a = FeedsView
+	case "discussions":
+		*a = DiscussionsView
+	case "releases":
+		*a = ReleasesView
+	case "dependencies":
+		*a = DependenciesView
+	case "archive":
+		*a = ArchiveView
 	}
 
 	return nil
 }
 
 const (
-	PRsView    ViewType = "prs"
-	IssuesView ViewType = "issues"
-	RepoView   ViewType = "repo"
+	PRsView          ViewType = "prs"
+	IssuesView       ViewType = "issues"
+	RepoView         ViewType = "repo"
+	WorkflowsView    ViewType = "workflows"
+	FeedsView        ViewType = "feeds"
+	DiscussionsView  ViewType = "discussions"
+	ReleasesView     ViewType = "releases"
+	DependenciesView ViewType = "dependencies"
+	ArchiveView      ViewType = "archive"
 )
 
 type SectionConfig struct {
-	Title   string
-	Filters string
-	Limit   *int      `yaml:"limit,omitempty"`
-	Type    *ViewType `yaml:"type,omitempty"`
+	Title                  string
+	Filters                string
+	Limit                  *int      `yaml:"limit,omitempty"`
+	Type                   *ViewType `yaml:"type,omitempty"`
+	RefetchIntervalMinutes *int      `yaml:"refetchIntervalMinutes,omitempty"`
+	// Inbox tracks which of the section's rows were read, rendering the
+	// unread ones in bold
+	Inbox bool `yaml:"inbox,omitempty"`
+	// ProjectFields are the names of the project (v2) fields shown as columns
+	ProjectFields []string `yaml:"projectFields,omitempty"`
+	// ComputedColumns are shown after the project fields, their values are
+	// produced by templates over the rows' fields
+	ComputedColumns []ComputedColumn `yaml:"computedColumns,omitempty"`
+	// AutoPrioritize orders the rows by their score, highest first
+	AutoPrioritize bool `yaml:"autoPrioritize,omitempty"`
+	// GroupBySprint orders the rows by the iteration of their sprint field,
+	// see SprintConfig
+	GroupBySprint bool `yaml:"groupBySprint,omitempty"`
+	// GroupBy shows the rows under a header per repo, author or label
+	GroupBy string `yaml:"groupBy,omitempty" validate:"omitempty,oneof=repo author label"`
+	// Sort orders the rows client-side, over GitHub's sort
+	Sort *SortConfig `yaml:"sort,omitempty"`
+	// Provider is the forge the section's rows are fetched from, GitHub when
+	// empty. GitLab and Gitea sections are read-only.
+	Provider string `yaml:"provider,omitempty"`
+	// Host is the forge's host, e.g. gitlab.example.com or a GitHub
+	// Enterprise host
+	Host string `yaml:"host,omitempty"`
+	// Notify sends a notification for the rows that show up in the section
+	// when it's refreshed in the background, see NotificationsConfig
+	Notify bool `yaml:"notify,omitempty"`
+}
+
+// SortConfig orders the rows of a section once they're fetched, for orders
+// GitHub's search can't sort by
+type SortConfig struct {
+	// By is updated, created, reactions, comments, ci, size or template
+	By string `yaml:"by" validate:"omitempty,oneof=updated created reactions comments ci size template"`
+	// Direction is asc or desc, desc when empty
+	Direction string `yaml:"direction,omitempty" validate:"omitempty,oneof=asc desc"`
+	// Template is executed over the fields of each row, like the ones of
+	// computed columns, to sort by its value when By is template
+	Template string `yaml:"template,omitempty"`
+}
+
+// ComputedColumn is a column whose values are produced by a Go template over
+// the fields of each row
+type ComputedColumn struct {
+	Title    string `yaml:"title"`
+	Template string `yaml:"template"`
+	Width    *int   `yaml:"width,omitempty"`
+	// Align is left, center or right, left when empty
+	Align string `yaml:"align,omitempty" validate:"omitempty,oneof=left center right"`
+	// Color is the color of the values, or a template executed like Template
+	// producing it, e.g. to color a value by a threshold. The default text
+	// color is used when it's empty.
+	Color string `yaml:"color,omitempty"`
 }
 
 type PrsSectionConfig struct {
-	Title   string
-	Filters string
-	Limit   *int            `yaml:"limit,omitempty"`
-	Layout  PrsLayoutConfig `yaml:"layout,omitempty"`
-	Type    *ViewType       `yaml:"type,omitempty"`
+	Title                  string
+	Filters                string
+	Limit                  *int             `yaml:"limit,omitempty"`
+	Layout                 PrsLayoutConfig  `yaml:"layout,omitempty"`
+	Type                   *ViewType        `yaml:"type,omitempty"`
+	RefetchIntervalMinutes *int             `yaml:"refetchIntervalMinutes,omitempty" validate:"omitempty,gte=0"`
+	Inbox                  bool             `yaml:"inbox,omitempty"`
+	ProjectFields          []string         `yaml:"projectFields,omitempty"`
+	ComputedColumns        []ComputedColumn `yaml:"computedColumns,omitempty"`
+	AutoPrioritize         bool             `yaml:"autoPrioritize,omitempty"`
+	GroupBySprint          bool             `yaml:"groupBySprint,omitempty"`
+	GroupBy                string           `yaml:"groupBy,omitempty"         validate:"omitempty,oneof=repo author label"`
+	Sort                   *SortConfig      `yaml:"sort,omitempty"`
+	Provider               string           `yaml:"provider,omitempty"        validate:"omitempty,oneof=github gitlab gitea"`
+	Host                   string           `yaml:"host,omitempty"`
+	Notify                 bool             `yaml:"notify,omitempty"`
 }
 
 type IssuesSectionConfig struct {
-	Title   string
-	Filters string
-	Limit   *int               `yaml:"limit,omitempty"`
-	Layout  IssuesLayoutConfig `yaml:"layout,omitempty"`
+	Title                  string
+	Filters                string
+	Limit                  *int               `yaml:"limit,omitempty"`
+	Layout                 IssuesLayoutConfig `yaml:"layout,omitempty"`
+	RefetchIntervalMinutes *int               `yaml:"refetchIntervalMinutes,omitempty" validate:"omitempty,gte=0"`
+	Inbox                  bool               `yaml:"inbox,omitempty"`
+	ProjectFields          []string           `yaml:"projectFields,omitempty"`
+	ComputedColumns        []ComputedColumn   `yaml:"computedColumns,omitempty"`
+	AutoPrioritize         bool               `yaml:"autoPrioritize,omitempty"`
+	GroupBySprint          bool               `yaml:"groupBySprint,omitempty"`
+	GroupBy                string             `yaml:"groupBy,omitempty"         validate:"omitempty,oneof=repo author label"`
+	Sort                   *SortConfig        `yaml:"sort,omitempty"`
+	Provider               string             `yaml:"provider,omitempty"        validate:"omitempty,oneof=github gitlab gitea"`
+	Host                   string             `yaml:"host,omitempty"`
+	Notify                 bool               `yaml:"notify,omitempty"`
+}
+
+type WorkflowsSectionConfig struct {
+	Title                  string
+	Filters                string
+	Limit                  *int                  `yaml:"limit,omitempty"`
+	Layout                 WorkflowsLayoutConfig `yaml:"layout,omitempty"`
+	RefetchIntervalMinutes *int                  `yaml:"refetchIntervalMinutes,omitempty" validate:"omitempty,gte=0"`
+}
+
+type DiscussionsSectionConfig struct {
+	Title                  string
+	Filters                string
+	Limit                  *int                    `yaml:"limit,omitempty"`
+	Layout                 DiscussionsLayoutConfig `yaml:"layout,omitempty"`
+	RefetchIntervalMinutes *int                    `yaml:"refetchIntervalMinutes,omitempty" validate:"omitempty,gte=0"`
+}
+
+type FeedsSectionConfig struct {
+	Title                  string
+	Url                    string `yaml:"url"`
+	Limit                  *int   `yaml:"limit,omitempty"`
+	RefetchIntervalMinutes *int   `yaml:"refetchIntervalMinutes,omitempty" validate:"omitempty,gte=0"`
+}
+
+// ReleasesSectionConfig is a release train, the repos released together
+type ReleasesSectionConfig struct {
+	Title string
+	// Repos are the repos of the train, as owner/name
+	Repos []string `yaml:"repos"`
+	// BlockerLabels are the labels of the open PRs and issues blocking a
+	// release, release-blocker when empty
+	BlockerLabels          []string `yaml:"blockerLabels,omitempty"`
+	RefetchIntervalMinutes *int     `yaml:"refetchIntervalMinutes,omitempty" validate:"omitempty,gte=0"`
+}
+
+// DependenciesSectionConfig is a dependency whose version is compared
+// across repos
+type DependenciesSectionConfig struct {
+	Title string
+	// Dependency is the name of the dependency in the manifests, like a Go
+	// module path or an npm package
+	Dependency string `yaml:"dependency"`
+	// Manifest is the path of the manifest in the repos, go.mod when empty
+	Manifest string `yaml:"manifest,omitempty"`
+	// Version is the version the repos should be on, the latest one of the
+	// repos when empty
+	Version string `yaml:"version,omitempty"`
+	// Repos are the repos to check, as owner/name
+	Repos                  []string `yaml:"repos"`
+	RefetchIntervalMinutes *int     `yaml:"refetchIntervalMinutes,omitempty" validate:"omitempty,gte=0"`
 }
 
 type PreviewConfig struct {
 	Open  bool
 	Width int
+	// ReadingWidth caps the width of the text of the preview, like the
+	// bodies and comments of PRs, to keep it readable in a wide pane. The
+	// whole pane is used when it's 0.
+	ReadingWidth int `yaml:"readingWidth,omitempty" validate:"gte=0"`
+	// Overflow is wrap or truncate, how the lines wider than the text, like
+	// the ones of code blocks, are shown. They're wrapped when it's empty.
+	Overflow string `yaml:"overflow,omitempty" validate:"omitempty,oneof=wrap truncate"`
 }
 
 type NullableBool struct {
@@ -147,8 +301,14 @@ type PrsLayoutConfig struct {
 	ReviewStatus ColumnConfig `yaml:"reviewStatus,omitempty"`
 	State        ColumnConfig `yaml:"state,omitempty"`
 	Ci           ColumnConfig `yaml:"ci,omitempty"`
+	MergeQueue   ColumnConfig `yaml:"mergeQueue,omitempty"`
+	Mergeable    ColumnConfig `yaml:"mergeable,omitempty"`
 	Lines        ColumnConfig `yaml:"lines,omitempty"`
+	Size         ColumnConfig `yaml:"size,omitempty"`
+	Files        ColumnConfig `yaml:"files,omitempty"`
 	NumComments  ColumnConfig `yaml:"numComments,omitempty"`
+	Score        ColumnConfig `yaml:"score,omitempty"`
+	Milestone    ColumnConfig `yaml:"milestone,omitempty"`
 }
 
 type IssuesLayoutConfig struct {
@@ -162,11 +322,39 @@ type IssuesLayoutConfig struct {
 	Assignees   ColumnConfig `yaml:"assignees,omitempty"`
 	Comments    ColumnConfig `yaml:"comments,omitempty"`
 	Reactions   ColumnConfig `yaml:"reactions,omitempty"`
+	Score       ColumnConfig `yaml:"score,omitempty"`
+	Estimate    ColumnConfig `yaml:"estimate,omitempty"`
+	Milestone   ColumnConfig `yaml:"milestone,omitempty"`
+}
+
+type WorkflowsLayoutConfig struct {
+	UpdatedAt  ColumnConfig `yaml:"updatedAt,omitempty"`
+	Status     ColumnConfig `yaml:"status,omitempty"`
+	Repo       ColumnConfig `yaml:"repo,omitempty"`
+	Workflow   ColumnConfig `yaml:"workflow,omitempty"`
+	Title      ColumnConfig `yaml:"title,omitempty"`
+	Branch     ColumnConfig `yaml:"branch,omitempty"`
+	Event      ColumnConfig `yaml:"event,omitempty"`
+	Conclusion ColumnConfig `yaml:"conclusion,omitempty"`
+	Duration   ColumnConfig `yaml:"duration,omitempty"`
+}
+
+type DiscussionsLayoutConfig struct {
+	UpdatedAt ColumnConfig `yaml:"updatedAt,omitempty"`
+	Answered  ColumnConfig `yaml:"answered,omitempty"`
+	Repo      ColumnConfig `yaml:"repo,omitempty"`
+	Title     ColumnConfig `yaml:"title,omitempty"`
+	Category  ColumnConfig `yaml:"category,omitempty"`
+	Author    ColumnConfig `yaml:"author,omitempty"`
+	Upvotes   ColumnConfig `yaml:"upvotes,omitempty"`
+	Comments  ColumnConfig `yaml:"comments,omitempty"`
 }
 
 type LayoutConfig struct {
-	Prs    PrsLayoutConfig    `yaml:"prs,omitempty"`
-	Issues IssuesLayoutConfig `yaml:"issues,omitempty"`
+	Prs         PrsLayoutConfig         `yaml:"prs,omitempty"`
+	Issues      IssuesLayoutConfig      `yaml:"issues,omitempty"`
+	Workflows   WorkflowsLayoutConfig   `yaml:"workflows,omitempty"`
+	Discussions DiscussionsLayoutConfig `yaml:"discussions,omitempty"`
 }
 
 type Defaults struct {
@@ -174,15 +362,268 @@ type Defaults struct {
 	PrsLimit               int           `yaml:"prsLimit"`
 	PrApproveComment       string        `yaml:"prApproveComment,omitempty"`
 	IssuesLimit            int           `yaml:"issuesLimit"`
+	WorkflowsLimit         int           `yaml:"workflowsLimit,omitempty"`
+	FeedsLimit             int           `yaml:"feedsLimit,omitempty"`
+	DiscussionsLimit       int           `yaml:"discussionsLimit,omitempty"`
+	CheckLogLines          int           `yaml:"checkLogLines,omitempty" validate:"gte=0"`
 	View                   ViewType      `yaml:"view"`
 	Layout                 LayoutConfig  `yaml:"layout,omitempty"`
 	RefetchIntervalMinutes int           `yaml:"refetchIntervalMinutes,omitempty"`
 	DateFormat             string        `yaml:"dateFormat,omitempty"`
+	// PrUpdateMethod is how a PR's branch is updated from its base branch
+	// by default, with a merge commit or by rebasing it
+	PrUpdateMethod string `yaml:"prUpdateMethod,omitempty" validate:"omitempty,oneof=merge rebase"`
+	// Host is the GitHub host of the PRs and issues sections that don't set
+	// one, e.g. a GitHub Enterprise host. The one gh uses when empty.
+	Host string `yaml:"host,omitempty"`
 }
 
 type RepoConfig struct {
-	BranchesRefetchIntervalSeconds int `yaml:"branchesRefetchIntervalSeconds,omitempty"`
-	PrsRefetchIntervalSeconds      int `yaml:"prsRefetchIntervalSeconds,omitempty"`
+	BranchesRefetchIntervalSeconds int             `yaml:"branchesRefetchIntervalSeconds,omitempty"`
+	PrsRefetchIntervalSeconds      int             `yaml:"prsRefetchIntervalSeconds,omitempty"`
+	BranchesPageSize               int             `yaml:"branchesPageSize,omitempty" validate:"gte=0"`
+	BranchesUpdatedWithinDays      int             `yaml:"branchesUpdatedWithinDays,omitempty" validate:"gte=0"`
+	Worktrees                      WorktreesConfig `yaml:"worktrees,omitempty"`
+}
+
+// RepoDefaults are the defaults of the sections searching a repo
+type RepoDefaults struct {
+	// Filters are qualifiers merged into the search of every section with a
+	// repo: qualifier of the repo, e.g. -author:app/renovate
+	Filters string `yaml:"filters,omitempty"`
+}
+
+// WorktreesConfig configures the worktrees created from the repo and PRs views
+type WorktreesConfig struct {
+	// Dir is the directory worktrees are created in, next to the repo when
+	// empty
+	Dir string `yaml:"dir,omitempty"`
+	// OpenCommand is the command opening a worktree, a template given its
+	// WorktreePath, BranchName and RepoPath
+	OpenCommand string `yaml:"openCommand,omitempty"`
+}
+
+type GitConfig struct {
+	Binary         string         `yaml:"binary,omitempty"`
+	TimeoutSeconds int            `yaml:"timeoutSeconds,omitempty" validate:"gte=0"`
+	Timeouts       map[string]int `yaml:"timeouts,omitempty"`
+	Env            []string       `yaml:"env,omitempty"`
+}
+
+// BotsConfig configures hiding the comments of bots, e.g. coverage reports, in
+// the activity of PRs and issues
+type BotsConfig struct {
+	// Logins are bot accounts to hide in addition to the well-known ones
+	Logins []string `yaml:"logins,omitempty"`
+	// Show shows the comments of bots unless toggled
+	Show bool `yaml:"show,omitempty"`
+}
+
+// ScoringConfig weighs the traits of PRs and issues into a score, to
+// prioritize them
+type ScoringConfig struct {
+	// Age is added for every day since the row was created
+	Age float64 `yaml:"age,omitempty"`
+	// ReviewRequired, ChangesRequested and Approved are added by the review
+	// decision of PRs
+	ReviewRequired   float64 `yaml:"reviewRequired,omitempty"`
+	ChangesRequested float64 `yaml:"changesRequested,omitempty"`
+	Approved         float64 `yaml:"approved,omitempty"`
+	// Size is added for every 100 lines a PR changes
+	Size float64 `yaml:"size,omitempty"`
+	// Labels are added by the names of the row's labels
+	Labels map[string]float64 `yaml:"labels,omitempty"`
+	// Teammate is added when the author is one of the Teammates
+	Teammate  float64  `yaml:"teammate,omitempty"`
+	Teammates []string `yaml:"teammates,omitempty"`
+}
+
+// PrSizeConfig are the most lines a PR of each size changes, its additions
+// and deletions. The PRs changing more than L are XL.
+type PrSizeConfig struct {
+	XS int `yaml:"xs" validate:"gte=0"`
+	S  int `yaml:"s" validate:"gtefield=XS"`
+	M  int `yaml:"m" validate:"gtefield=S"`
+	L  int `yaml:"l" validate:"gtefield=M"`
+}
+
+// EstimateField is the project (v2) number field holding the estimate of
+// issues, e.g. their story points
+type EstimateField struct {
+	// Field is the name of the field, e.g. Estimate
+	Field string `yaml:"field,omitempty"`
+	// Project is the project of the field, as owner/number like the project
+	// search qualifier. When it's empty, the first project of the issue
+	// with the field is used.
+	Project string `yaml:"project,omitempty"`
+}
+
+// EstimateConfig is the estimate field of issues, the field and the project
+// can be overridden per repo
+type EstimateConfig struct {
+	Field   string `yaml:"field,omitempty"`
+	Project string `yaml:"project,omitempty"`
+	// Repos are the fields of the issues of some repos, by owner/name
+	Repos map[string]EstimateField `yaml:"repos,omitempty"`
+}
+
+// SprintConfig is the project (v2) iteration field holding the sprint of PRs
+// and issues
+type SprintConfig struct {
+	// Field is the name of the field, e.g. Sprint
+	Field string `yaml:"field,omitempty"`
+	// Project is the project of the field, as owner/number like the project
+	// search qualifier, its iterations are read from it. When it's empty,
+	// the project: qualifier of the section's search is used.
+	Project string `yaml:"project,omitempty"`
+}
+
+// RateLimitConfig is when fetches are throttled to spare the API quota
+type RateLimitConfig struct {
+	// Threshold is the share of a quota left, in percent, under which
+	// auto-refresh pauses and fetches are sent one at a time until the quota
+	// resets. 0 never throttles.
+	Threshold *int `yaml:"threshold,omitempty" validate:"omitempty,gte=0,lte=100"`
+}
+
+// ListQueriesConfig is what the sections' list queries fetch of their rows
+type ListQueriesConfig struct {
+	// Trim are the heavy fields left out of the rows, the row shown in the
+	// sidebar fetches them instead
+	Trim []string `yaml:"trim,omitempty" validate:"dive,oneof=body labels assignees"`
+}
+
+// GraphQLConfig pins what is asked of the GraphQL API, for GitHub Enterprise
+// Server instances that only accept known query shapes
+type GraphQLConfig struct {
+	// Features are sent in the GraphQL-Features header to opt into the
+	// schema previews the instance has
+	Features []string `yaml:"features,omitempty"`
+	// ApiVersion is sent in the X-GitHub-Api-Version header to pin the
+	// version of the schema
+	ApiVersion string `yaml:"apiVersion,omitempty"`
+	// PersistedQueries sends the SHA-256 hash of queries instead of their
+	// text. "auto" sends the text when the server doesn't know the hash yet,
+	// "only" never does. Defaults to "off".
+	PersistedQueries string `yaml:"persistedQueries,omitempty" validate:"omitempty,oneof=off auto only"`
+	// Manifest is a JSON file every query sent is recorded in, by hash, so
+	// they can be registered with the server beforehand
+	Manifest string `yaml:"manifest,omitempty"`
+}
+
+// HostConfig is how the dashboard authenticates to a host
+type HostConfig struct {
+	// Host is the host's name, e.g. github.com, a GitHub Enterprise host or
+	// the host of a GitLab or Gitea section
+	Host string `yaml:"host" validate:"required"`
+	// Auth is gh to use the token gh is logged in with, the default, or app
+	// to authenticate as the installation of App
+	Auth string           `yaml:"auth,omitempty" validate:"omitempty,oneof=gh app"`
+	App  *GitHubAppConfig `yaml:"app,omitempty"  validate:"required_if=Auth app,omitempty"`
+	// TokenEnv is the environment variable holding the token of a GitLab or
+	// Gitea host, <HOST>_TOKEN by default
+	TokenEnv string `yaml:"tokenEnv,omitempty"`
+	// AllowHttp is whether the token of a GitLab or Gitea host is sent when
+	// its URL is http
+	AllowHttp bool `yaml:"allowHttp,omitempty"`
+}
+
+// GitHubAppConfig is a GitHub App installation, its higher rate limits suit
+// read-heavy dashboards of organizations
+type GitHubAppConfig struct {
+	AppId          int64 `yaml:"appId"          validate:"required"`
+	InstallationId int64 `yaml:"installationId" validate:"required"`
+	// PrivateKeyPath is the PEM file of a private key of the app, ~ is
+	// expanded
+	PrivateKeyPath string `yaml:"privateKeyPath" validate:"required"`
+}
+
+// UpdateCheckConfig is how the dashboard checks for new releases of gh-dash
+type UpdateCheckConfig struct {
+	// Enabled checks for a new release at most once a day, it's off unless
+	// set
+	Enabled bool `yaml:"enabled,omitempty"`
+}
+
+// NotificationsConfig is how the rows that show up in the sections with
+// notify are announced, when the sections are refreshed in the background
+type NotificationsConfig struct {
+	// Desktop sends a desktop notification per new row, it's on unless set to
+	// false
+	Desktop *bool `yaml:"desktop,omitempty"`
+	// Bell rings the terminal bell once per refresh bringing new rows
+	Bell bool `yaml:"bell,omitempty"`
+	// Hook is a shell command run per new row, with the row in the
+	// GH_DASH_SECTION, GH_DASH_TYPE, GH_DASH_REPO, GH_DASH_NUMBER,
+	// GH_DASH_TITLE, GH_DASH_AUTHOR and GH_DASH_URL environment variables
+	Hook string `yaml:"hook,omitempty"`
+}
+
+// ProfileConfig overrides the defaults and the theme when its conditions
+// match the terminal, or when it's picked with --profile
+type ProfileConfig struct {
+	Name string `yaml:"name" validate:"required"`
+	// When are the conditions the terminal has to meet, a profile without
+	// any is only applied when picked by name
+	When ProfileConditions `yaml:"when,omitempty"`
+	// Defaults and Theme are merged over the config's, e.g. to hide columns
+	// or use compact rows on small terminals
+	Defaults map[string]any `yaml:"defaults,omitempty"`
+	Theme    map[string]any `yaml:"theme,omitempty"`
+}
+
+// ProfileConditions are matched against the terminal, all the ones set have
+// to match
+type ProfileConditions struct {
+	MinWidth  int `yaml:"minWidth,omitempty"  validate:"gte=0"`
+	MaxWidth  int `yaml:"maxWidth,omitempty"  validate:"gte=0"`
+	MinHeight int `yaml:"minHeight,omitempty" validate:"gte=0"`
+	MaxHeight int `yaml:"maxHeight,omitempty" validate:"gte=0"`
+	// Term and TermProgram are glob patterns matched against $TERM and
+	// $TERM_PROGRAM, e.g. xterm-* or iTerm.app
+	Term        string `yaml:"term,omitempty"`
+	TermProgram string `yaml:"termProgram,omitempty"`
+}
+
+// ExportConfig is how the rows of a section are exported
+type ExportConfig struct {
+	// Format is json or csv, json when empty
+	Format string `yaml:"format,omitempty" validate:"omitempty,oneof=json csv"`
+	// Dir is where the exported files are written, the current directory
+	// when empty
+	Dir string `yaml:"dir,omitempty"`
+}
+
+// ArchiveConfig is how long the PRs and issues that leave the sections once
+// merged or closed are kept in the archive view
+type ArchiveConfig struct {
+	// Days is how many days items stay archived, the archive is off when
+	// it's 0
+	Days int `yaml:"days,omitempty" validate:"gte=0"`
+}
+
+// TriageConfig is how issues are triaged
+type TriageConfig struct {
+	// Team are the logins issues are balanced between when auto-assigning
+	// them to the least loaded one
+	Team []string `yaml:"team,omitempty"`
+}
+
+type CacheConfig struct {
+	Disabled    bool                `yaml:"disabled,omitempty"`
+	Dir         string              `yaml:"dir,omitempty"`
+	MaxAgeHours int                 `yaml:"maxAgeHours,omitempty" validate:"gte=0"`
+	Metadata    MetadataCacheConfig `yaml:"metadata,omitempty"`
+}
+
+// MetadataCacheConfig is how many minutes the labels, milestones, members
+// and teams listed by the pickers are reused before they're fetched again,
+// the defaults are used for the ones that aren't set
+type MetadataCacheConfig struct {
+	Labels     *int `yaml:"labels,omitempty" validate:"omitempty,gte=0"`
+	Milestones *int `yaml:"milestones,omitempty" validate:"omitempty,gte=0"`
+	Members    *int `yaml:"members,omitempty" validate:"omitempty,gte=0"`
+	Teams      *int `yaml:"teams,omitempty" validate:"omitempty,gte=0"`
 }
 
 type Keybinding struct {
@@ -190,6 +631,9 @@ type Keybinding struct {
 	Command string `yaml:"command,omitempty"`
 	Builtin string `yaml:"builtin,omitempty"`
 	Name    string `yaml:"name,omitempty"`
+	// Sections limits a custom command to the sections at these indexes of
+	// its view, starting from 1. Empty means every section.
+	Sections []int `yaml:"sections,omitempty" validate:"dive,gte=1"`
 }
 
 func (kb Keybinding) NewBinding(previous *key.Binding) key.Binding {
@@ -213,6 +657,7 @@ type Keybindings struct {
 	Issues    []Keybinding `yaml:"issues,omitempty"`
 	Prs       []Keybinding `yaml:"prs,omitempty"`
 	Branches  []Keybinding `yaml:"branches,omitempty"`
+	Workflows []Keybinding `yaml:"workflows,omitempty"`
 }
 
 type Pager struct {
@@ -258,11 +703,34 @@ type ColorThemeBackground struct {
 	Selected HexColor `yaml:"selected" validate:"omitempty,hexcolor"`
 }
 
+// ColorThemeState colors the icons of the states of PRs and issues
+type ColorThemeState struct {
+	OpenPR      HexColor `yaml:"openPr"      validate:"omitempty,hexcolor"`
+	DraftPR     HexColor `yaml:"draftPr"     validate:"omitempty,hexcolor"`
+	MergedPR    HexColor `yaml:"mergedPr"    validate:"omitempty,hexcolor"`
+	ClosedPR    HexColor `yaml:"closedPr"    validate:"omitempty,hexcolor"`
+	OpenIssue   HexColor `yaml:"openIssue"   validate:"omitempty,hexcolor"`
+	ClosedIssue HexColor `yaml:"closedIssue" validate:"omitempty,hexcolor"`
+}
+
+// ColorThemeRows colors the titles of the rows of PRs and issues by their
+// state, the rows of the states left unset keep the primary text color
+type ColorThemeRows struct {
+	Open          HexColor `yaml:"open"          validate:"omitempty,hexcolor"`
+	Draft         HexColor `yaml:"draft"         validate:"omitempty,hexcolor"`
+	Merged        HexColor `yaml:"merged"        validate:"omitempty,hexcolor"`
+	Closed        HexColor `yaml:"closed"        validate:"omitempty,hexcolor"`
+	FailingChecks HexColor `yaml:"failingChecks" validate:"omitempty,hexcolor"`
+	Stale         HexColor `yaml:"stale"         validate:"omitempty,hexcolor"`
+}
+
 type ColorTheme struct {
 	Icon       ColorThemeIcon       `yaml:"icon,omitempty"       validate:"required,omitempty"`
 	Text       ColorThemeText       `yaml:"text,omitempty"       validate:"required,omitempty"`
 	Background ColorThemeBackground `yaml:"background,omitempty" validate:"required,omitempty"`
 	Border     ColorThemeBorder     `yaml:"border,omitempty"     validate:"required,omitempty"`
+	State      ColorThemeState      `yaml:"state,omitempty"      validate:"required,omitempty"`
+	Rows       ColorThemeRows       `yaml:"rows,omitempty"       validate:"required,omitempty"`
 }
 
 type ColorThemeConfig struct {
@@ -279,12 +747,15 @@ type IconTheme struct {
 }
 
 type IconThemeConfig struct {
-	Inline IconTheme `yaml:",inline"`
+	Inline IconTheme `yaml:",inline,squash"`
 }
 
 type TableUIThemeConfig struct {
 	ShowSeparator bool `yaml:"showSeparator" default:"true"`
 	Compact       bool `yaml:"compact" default:"false"`
+	// StaleDays is how many days open PRs and issues go without updates
+	// before their rows are colored as stale
+	StaleDays int `yaml:"staleDays" default:"30" validate:"gte=0"`
 }
 
 type UIThemeConfig struct {
@@ -293,23 +764,51 @@ type UIThemeConfig struct {
 }
 
 type ThemeConfig struct {
+	// Name is the palette of the themes directory the colors and icons
+	// override
+	Name   string            `yaml:"name,omitempty"`
 	Ui     UIThemeConfig     `yaml:"ui,omitempty"     validate:"omitempty"`
 	Colors *ColorThemeConfig `yaml:"colors,omitempty" validate:"omitempty"`
 	Icons  *IconThemeConfig  `yaml:"icons,omitempty" validate:"omitempty"`
 }
 
 type Config struct {
-	PRSections             []PrsSectionConfig    `yaml:"prSections"`
-	IssuesSections         []IssuesSectionConfig `yaml:"issuesSections"`
-	Repo                   RepoConfig            `yaml:"repo,omitempty"`
-	Defaults               Defaults              `yaml:"defaults"`
-	Keybindings            Keybindings           `yaml:"keybindings"`
-	RepoPaths              map[string]string     `yaml:"repoPaths"`
-	Theme                  *ThemeConfig          `yaml:"theme,omitempty" validate:"omitempty"`
-	Pager                  Pager                 `yaml:"pager"`
-	ConfirmQuit            bool                  `yaml:"confirmQuit"`
-	ShowAuthorIcons        bool                  `yaml:"showAuthorIcons,omitempty"`
-	SmartFilteringAtLaunch bool                  `yaml:"smartFilteringAtLaunch" default:"true"`
+	PRSections             []PrsSectionConfig          `yaml:"prSections"`
+	IssuesSections         []IssuesSectionConfig       `yaml:"issuesSections"`
+	WorkflowsSections      []WorkflowsSectionConfig    `yaml:"workflowsSections,omitempty"`
+	FeedsSections          []FeedsSectionConfig        `yaml:"feedsSections,omitempty"`
+	DiscussionsSections    []DiscussionsSectionConfig  `yaml:"discussionsSections,omitempty"`
+	ReleasesSections       []ReleasesSectionConfig     `yaml:"releasesSections,omitempty"`
+	DependenciesSections   []DependenciesSectionConfig `yaml:"dependenciesSections,omitempty"`
+	Repo                   RepoConfig                  `yaml:"repo,omitempty"`
+	RepoDefaults           map[string]RepoDefaults     `yaml:"repos,omitempty"`
+	Git                    GitConfig                   `yaml:"git,omitempty"`
+	Cache                  CacheConfig                 `yaml:"cache,omitempty"`
+	RateLimit              RateLimitConfig             `yaml:"rateLimit,omitempty"`
+	GraphQL                GraphQLConfig               `yaml:"graphql,omitempty"`
+	ListQueries            ListQueriesConfig           `yaml:"listQueries,omitempty"`
+	Export                 ExportConfig                `yaml:"export,omitempty"`
+	Archive                ArchiveConfig               `yaml:"archive,omitempty"`
+	Triage                 TriageConfig                `yaml:"triage,omitempty"`
+	Bots                   BotsConfig                  `yaml:"bots,omitempty"`
+	Scoring                ScoringConfig               `yaml:"scoring,omitempty"`
+	PrSize                 PrSizeConfig                `yaml:"prSize,omitempty"`
+	Estimate               EstimateConfig              `yaml:"estimate,omitempty"`
+	Sprint                 SprintConfig                `yaml:"sprint,omitempty"`
+	SavedFilters           map[string]string           `yaml:"savedFilters,omitempty" validate:"dive,keys,required,endkeys,required"`
+	Profiles               []ProfileConfig             `yaml:"profiles,omitempty" validate:"dive"`
+	UpdateCheck            UpdateCheckConfig           `yaml:"updateCheck,omitempty"`
+	Notifications          NotificationsConfig         `yaml:"notifications,omitempty"`
+	Hosts                  []HostConfig                `yaml:"hosts,omitempty" validate:"dive"`
+	Defaults               Defaults                    `yaml:"defaults"`
+	Keybindings            Keybindings                 `yaml:"keybindings"`
+	RepoPaths              map[string]string           `yaml:"repoPaths"`
+	Theme                  *ThemeConfig                `yaml:"theme,omitempty" validate:"omitempty"`
+	Pager                  Pager                       `yaml:"pager"`
+	ConfirmQuit            bool                        `yaml:"confirmQuit"`
+	ShowAuthorIcons        bool                        `yaml:"showAuthorIcons,omitempty"`
+	SmartFilteringAtLaunch bool                        `yaml:"smartFilteringAtLaunch" default:"true"`
+	RestoreSession         bool                        `yaml:"restoreSession,omitempty"`
 }
 
 type configError struct {
@@ -332,6 +831,10 @@ func (parser ConfigParser) getDefaultConfig() Config {
 			PrsLimit:               20,
 			PrApproveComment:       "LGTM",
 			IssuesLimit:            20,
+			WorkflowsLimit:         20,
+			FeedsLimit:             50,
+			DiscussionsLimit:       20,
+			CheckLogLines:          20,
 			View:                   PRsView,
 			RefetchIntervalMinutes: 30,
 			Layout: LayoutConfig{
@@ -359,9 +862,32 @@ func (parser ConfigParser) getDefaultConfig() Config {
 						Width:  utils.IntPtr(15),
 						Hidden: utils.BoolPtr(true),
 					},
+					MergeQueue: ColumnConfig{
+						Width: utils.IntPtr(lipgloss.Width("Queue  ")),
+					},
+					Mergeable: ColumnConfig{
+						Width:  utils.IntPtr(lipgloss.Width("Merge  ")),
+						Hidden: utils.BoolPtr(true),
+					},
 					Lines: ColumnConfig{
 						Width: utils.IntPtr(lipgloss.Width(" +31.4k -31.6k ")),
 					},
+					Size: ColumnConfig{
+						Width:  utils.IntPtr(lipgloss.Width("Size ")),
+						Hidden: utils.BoolPtr(true),
+					},
+					Files: ColumnConfig{
+						Width:  utils.IntPtr(lipgloss.Width("Files ")),
+						Hidden: utils.BoolPtr(true),
+					},
+					Score: ColumnConfig{
+						Width:  utils.IntPtr(lipgloss.Width("Score  ")),
+						Hidden: utils.BoolPtr(true),
+					},
+					Milestone: ColumnConfig{
+						Width:  utils.IntPtr(15),
+						Hidden: utils.BoolPtr(true),
+					},
 				},
 				Issues: IssuesLayoutConfig{
 					UpdatedAt: ColumnConfig{
@@ -383,9 +909,65 @@ func (parser ConfigParser) getDefaultConfig() Config {
 						Width:  utils.IntPtr(20),
 						Hidden: utils.BoolPtr(true),
 					},
+					Score: ColumnConfig{
+						Width:  utils.IntPtr(lipgloss.Width("Score  ")),
+						Hidden: utils.BoolPtr(true),
+					},
+					// shown when an estimate field is configured
+					Estimate: ColumnConfig{
+						Width: utils.IntPtr(lipgloss.Width("Estimate  ")),
+					},
+					Milestone: ColumnConfig{
+						Width:  utils.IntPtr(15),
+						Hidden: utils.BoolPtr(true),
+					},
+				},
+				Workflows: WorkflowsLayoutConfig{
+					UpdatedAt: ColumnConfig{
+						Width: utils.IntPtr(lipgloss.Width("2mo  ")),
+					},
+					Repo: ColumnConfig{
+						Width: utils.IntPtr(15),
+					},
+					Workflow: ColumnConfig{
+						Width: utils.IntPtr(20),
+					},
+					Branch: ColumnConfig{
+						Width: utils.IntPtr(20),
+					},
+					Event: ColumnConfig{
+						Width:  utils.IntPtr(12),
+						Hidden: utils.BoolPtr(true),
+					},
+					Conclusion: ColumnConfig{
+						Width: utils.IntPtr(lipgloss.Width("cancelled ")),
+					},
+					Duration: ColumnConfig{
+						Width: utils.IntPtr(lipgloss.Width("1h23m ")),
+					},
+				},
+				Discussions: DiscussionsLayoutConfig{
+					UpdatedAt: ColumnConfig{
+						Width: utils.IntPtr(lipgloss.Width("2mo  ")),
+					},
+					Repo: ColumnConfig{
+						Width: utils.IntPtr(15),
+					},
+					Category: ColumnConfig{
+						Width: utils.IntPtr(15),
+					},
+					Author: ColumnConfig{
+						Width: utils.IntPtr(10),
+					},
 				},
 			},
 		},
+		PrSize: PrSizeConfig{
+			XS: 10,
+			S:  100,
+			M:  500,
+			L:  1000,
+		},
 		Repo: RepoConfig{
 			BranchesRefetchIntervalSeconds: 30,
 			PrsRefetchIntervalSeconds:      60,
@@ -430,6 +1012,7 @@ func (parser ConfigParser) getDefaultConfig() Config {
 				Table: TableUIThemeConfig{
 					ShowSeparator: true,
 					Compact:       false,
+					StaleDays:     30,
 				},
 			},
 		},
@@ -509,7 +1092,8 @@ func (parser ConfigParser) createConfigFileIfMissing(
 	return nil
 }
 
-func (parser ConfigParser) getGlobalConfigPathOrCreateIfMissing() (string, error) {
+// globalConfigDir returns the directory of the global config
+func globalConfigDir() (string, error) {
 	configDir := os.Getenv("XDG_CONFIG_HOME")
 	if configDir == "" {
 		homeDir, err := os.UserHomeDir()
@@ -518,12 +1102,19 @@ func (parser ConfigParser) getGlobalConfigPathOrCreateIfMissing() (string, error
 		}
 		configDir = filepath.Join(homeDir, DEFAULT_XDG_CONFIG_DIRNAME)
 	}
+	return filepath.Join(configDir, DashDir), nil
+}
+
+func (parser ConfigParser) getGlobalConfigPathOrCreateIfMissing() (string, error) {
+	configDir, err := globalConfigDir()
+	if err != nil {
+		return "", err
+	}
 
-	configFilePath := filepath.Join(configDir, DashDir, ConfigYmlFileName)
+	configFilePath := filepath.Join(configDir, ConfigYmlFileName)
 	log.Debug("using global config path", "path", configFilePath)
 
 	// Ensure directory exists before attempting to create file
-	configDir = filepath.Dir(configFilePath)
 	if _, err := os.Stat(configDir); os.IsNotExist(err) {
 		if err = os.MkdirAll(configDir, os.ModePerm); err != nil {
 			return "", configError{
@@ -666,25 +1257,38 @@ func (e parsingError) Error() string {
 	return fmt.Sprintf("failed parsing config at path %s with error %v", e.path, e.err)
 }
 
-func initParser() ConfigParser {
-	validate = validator.New()
-
-	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+// newValidator returns a validator naming the fields of its errors by their
+// yaml keys
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
 		name := strings.Split(fld.Tag.Get("yaml"), ",")[0]
 		if name == "-" {
 			return ""
 		}
 		return name
 	})
+	return v
+}
 
+func initParser() ConfigParser {
 	return ConfigParser{
 		k: koanf.NewWithConf(conf),
 	}
 }
 
 type Location struct {
-	RepoPath   string // path if inside a git repo
-	ConfigFlag string // Config passed with explicit --config flag
+	RepoPath   string   // path if inside a git repo
+	ConfigFlag string   // Config passed with explicit --config flag
+	OpenUrl    string   // PR or issue to show right away, e.g. when launched from a notification
+	ReadOnly   bool     // only allow browsing, e.g. when the dashboard is shared over SSH
+	Mini       bool     // compact layout for a narrow pane, e.g. a tmux split
+	Profile    string   // profile applied instead of the ones matching the terminal
+	Section    int      // section of the default view shown first, from 1, 0 for the first one
+	View       ViewType // view shown first instead of the default one
+	Filters    string   // filters replacing the ones of the section shown first
+	Version    string   // version of the running gh-dash, e.g. v4.12.0
+	Shared     bool     // the settings shared by the dashboards of the process were applied once for all of them
 }
 
 func ParseConfig(location Location) (Config, error) {
@@ -700,19 +1304,23 @@ func ParseConfig(location Location) (Config, error) {
 
 	userProvidedCfgPath := parser.getProvidedConfigPath(location)
 	if userProvidedCfgPath != "" {
-		mergedCfg, err := parser.mergeConfigs(globalCfgPath, userProvidedCfgPath)
+		config, err = parser.mergeConfigs(globalCfgPath, userProvidedCfgPath)
 		if err != nil {
 			return Config{}, err
 		}
-		return mergedCfg, nil
-	}
-
-	if err = parser.loadGlobalConfig(globalCfgPath); err != nil {
-		log.Error("failed loading global config", "err", err)
-		return Config{}, parsingError{path: globalCfgPath, err: err}
+	} else {
+		if err = parser.loadGlobalConfig(globalCfgPath); err != nil {
+			log.Error("failed loading global config", "err", err)
+			return Config{}, parsingError{path: globalCfgPath, err: err}
+		}
+		config, err = parser.unmarshalConfigWithDefaults()
+		if err != nil {
+			return config, err
+		}
 	}
 
-	return parser.unmarshalConfigWithDefaults()
+	err = config.applyLocation(location)
+	return config, err
 }
 
 func (parser ConfigParser) unmarshalConfigWithDefaults() (Config, error) {
@@ -726,7 +1334,32 @@ func (parser ConfigParser) unmarshalConfigWithDefaults() (Config, error) {
 	if cfg.Defaults.View == RepoView && !repoFF {
 		cfg.Defaults.View = PRsView
 	}
+	if cfg.Defaults.View == WorkflowsView && len(cfg.WorkflowsSections) == 0 {
+		cfg.Defaults.View = PRsView
+	}
+	if cfg.Defaults.View == FeedsView && len(cfg.FeedsSections) == 0 {
+		cfg.Defaults.View = PRsView
+	}
+	if cfg.Defaults.View == DiscussionsView && len(cfg.DiscussionsSections) == 0 {
+		cfg.Defaults.View = PRsView
+	}
+	if cfg.Defaults.View == ReleasesView && len(cfg.ReleasesSections) == 0 {
+		cfg.Defaults.View = PRsView
+	}
+	if cfg.Defaults.View == DependenciesView && len(cfg.DependenciesSections) == 0 {
+		cfg.Defaults.View = PRsView
+	}
+	if cfg.Defaults.View == ArchiveView && !cfg.Archive.Enabled() {
+		cfg.Defaults.View = PRsView
+	}
 
-	err = validate.Struct(cfg)
+	if err = validate.Struct(cfg); err != nil {
+		return cfg, err
+	}
+	if cfg.Theme != nil && cfg.Theme.Name != "" {
+		// the palette is read again whenever the theme is parsed, an
+		// unknown one is reported right away
+		_, err = LoadThemePalette(cfg.Theme.Name)
+	}
 	return cfg, err
 }
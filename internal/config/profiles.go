@@ -0,0 +1,166 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/knadh/koanf/maps"
+	"github.com/knadh/koanf/v2"
+)
+
+// Terminal is what the conditions of the profiles are matched against, a
+// size of 0 is unknown
+type Terminal struct {
+	Width   int
+	Height  int
+	Term    string
+	Program string
+}
+
+// CurrentTerminal returns the terminal of the given size, the dashboard
+// runs in
+func CurrentTerminal(width, height int) Terminal {
+	return Terminal{
+		Width:   width,
+		Height:  height,
+		Term:    os.Getenv("TERM"),
+		Program: os.Getenv("TERM_PROGRAM"),
+	}
+}
+
+func (c ProfileConditions) IsEmpty() bool {
+	return c == ProfileConditions{}
+}
+
+// Matches returns whether t meets all the conditions set, the size ones
+// never match a terminal of unknown size
+func (c ProfileConditions) Matches(t Terminal) bool {
+	if c.IsEmpty() {
+		return false
+	}
+	if (c.MinWidth > 0 || c.MaxWidth > 0) && t.Width == 0 {
+		return false
+	}
+	if (c.MinHeight > 0 || c.MaxHeight > 0) && t.Height == 0 {
+		return false
+	}
+	if c.MinWidth > 0 && t.Width < c.MinWidth {
+		return false
+	}
+	if c.MaxWidth > 0 && t.Width > c.MaxWidth {
+		return false
+	}
+	if c.MinHeight > 0 && t.Height < c.MinHeight {
+		return false
+	}
+	if c.MaxHeight > 0 && t.Height > c.MaxHeight {
+		return false
+	}
+	return globMatches(c.Term, t.Term) && globMatches(c.TermProgram, t.Program)
+}
+
+func globMatches(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+	matched, err := path.Match(pattern, value)
+	return err == nil && matched
+}
+
+// ActiveProfiles returns the profiles applied on t: the one named when
+// there's a name, or else every profile whose conditions match, in order
+func (cfg Config) ActiveProfiles(name string, t Terminal) ([]ProfileConfig, error) {
+	if name != "" {
+		for _, profile := range cfg.Profiles {
+			if profile.Name == name {
+				return []ProfileConfig{profile}, nil
+			}
+		}
+		return nil, fmt.Errorf("no profile named %q in the config", name)
+	}
+
+	var active []ProfileConfig
+	for _, profile := range cfg.Profiles {
+		if profile.When.Matches(t) {
+			active = append(active, profile)
+		}
+	}
+	return active, nil
+}
+
+// WithProfiles returns cfg with the overrides of the profiles merged over it,
+// the later profiles win
+func (cfg Config) WithProfiles(profiles []ProfileConfig) (Config, error) {
+	if len(profiles) == 0 {
+		return cfg, nil
+	}
+
+	var raw map[string]any
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{TagName: "yaml", Result: &raw})
+	if err != nil {
+		return Config{}, err
+	}
+	if err := decoder.Decode(cfg); err != nil {
+		return Config{}, err
+	}
+	for _, profile := range profiles {
+		overrides := map[string]any{}
+		if len(profile.Defaults) > 0 {
+			overrides["defaults"] = maps.Copy(profile.Defaults)
+		}
+		if len(profile.Theme) > 0 {
+			overrides["theme"] = maps.Copy(profile.Theme)
+		}
+		mergeOverrides(overrides, raw)
+	}
+
+	parser := initParser()
+	if err := parser.k.Load(mapProvider(raw), nil); err != nil {
+		return Config{}, err
+	}
+	// cfg already has its defaults, starting over from the zero config keeps
+	// the values they were omitted for
+	var resolved Config
+	if err := parser.k.UnmarshalWithConf("", &resolved, koanf.UnmarshalConf{Tag: "yaml"}); err != nil {
+		return Config{}, err
+	}
+	if err := validate.Struct(resolved); err != nil {
+		return Config{}, fmt.Errorf("failed applying profiles: %w", err)
+	}
+	return resolved, nil
+}
+
+// mergeOverrides merges src into dest like maps.Merge, matching the keys
+// regardless of their case like the config's fields are, e.g. preview.open
+// overrides Preview.Open
+func mergeOverrides(src, dest map[string]any) {
+	for key, val := range src {
+		for existing := range dest {
+			if strings.EqualFold(existing, key) {
+				key = existing
+				break
+			}
+		}
+		srcMap, ok := val.(map[string]any)
+		destMap, destOk := dest[key].(map[string]any)
+		if ok && destOk {
+			mergeOverrides(srcMap, destMap)
+			continue
+		}
+		dest[key] = val
+	}
+}
+
+// mapProvider loads an already parsed config into koanf
+type mapProvider map[string]any
+
+func (p mapProvider) ReadBytes() ([]byte, error) {
+	return nil, fmt.Errorf("mapProvider doesn't support ReadBytes")
+}
+
+func (p mapProvider) Read() (map[string]any, error) {
+	return maps.Copy(p), nil
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/testutils"
+)
+
+func TestProfileConditionsMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		when ProfileConditions
+		term Terminal
+		want bool
+	}{
+		{
+			name: "no conditions",
+			when: ProfileConditions{},
+			term: Terminal{Width: 80, Height: 24},
+			want: false,
+		},
+		{
+			name: "narrow enough",
+			when: ProfileConditions{MaxWidth: 120},
+			term: Terminal{Width: 120, Height: 40},
+			want: true,
+		},
+		{
+			name: "too wide",
+			when: ProfileConditions{MaxWidth: 120},
+			term: Terminal{Width: 121, Height: 40},
+			want: false,
+		},
+		{
+			name: "unknown size",
+			when: ProfileConditions{MaxWidth: 120},
+			term: Terminal{},
+			want: false,
+		},
+		{
+			name: "height range",
+			when: ProfileConditions{MinHeight: 20, MaxHeight: 30},
+			term: Terminal{Width: 200, Height: 25},
+			want: true,
+		},
+		{
+			name: "term glob",
+			when: ProfileConditions{Term: "xterm-*"},
+			term: Terminal{Term: "xterm-kitty"},
+			want: true,
+		},
+		{
+			name: "term program mismatch",
+			when: ProfileConditions{MaxWidth: 120, TermProgram: "iTerm.app"},
+			term: Terminal{Width: 100, Height: 30, Program: "vscode"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.when.Matches(tt.term); got != tt.want {
+				t.Errorf("Matches(%+v) = %v, want %v", tt.term, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActiveProfiles(t *testing.T) {
+	cfg := Config{Profiles: []ProfileConfig{
+		{Name: "small", When: ProfileConditions{MaxWidth: 120}},
+		{Name: "short", When: ProfileConditions{MaxHeight: 30}},
+		{Name: "demo"},
+	}}
+
+	tests := []struct {
+		name    string
+		profile string
+		term    Terminal
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "none match",
+			term: Terminal{Width: 200, Height: 50},
+		},
+		{
+			name: "every match in order",
+			term: Terminal{Width: 100, Height: 24},
+			want: []string{"small", "short"},
+		},
+		{
+			name:    "named profile wins",
+			profile: "demo",
+			term:    Terminal{Width: 100, Height: 24},
+			want:    []string{"demo"},
+		},
+		{
+			name:    "unknown name",
+			profile: "nope",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles, err := cfg.ActiveProfiles(tt.profile, tt.term)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ActiveProfiles() err = %v, want an error: %v", err, tt.wantErr)
+			}
+			var names []string
+			for _, profile := range profiles {
+				names = append(names, profile.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestWithProfiles(t *testing.T) {
+	cfg := loadExpected(t, "./testdata/global-config.golden.yml")
+
+	t.Run("keeps the config without overrides", func(t *testing.T) {
+		actual, err := cfg.WithProfiles([]ProfileConfig{{Name: "empty"}})
+		testutils.AssertNoError(t, err)
+		assert.Empty(t, cmp.Diff(cfg, actual, keybindSorter))
+	})
+
+	t.Run("merges the overrides in order", func(t *testing.T) {
+		actual, err := cfg.WithProfiles([]ProfileConfig{
+			{
+				Name: "small",
+				Defaults: map[string]any{
+					"preview": map[string]any{"open": false, "width": 40},
+					"layout": map[string]any{
+						"prs": map[string]any{"repo": map[string]any{"hidden": true}},
+					},
+				},
+				Theme: map[string]any{
+					"ui": map[string]any{"table": map[string]any{"compact": true}},
+				},
+			},
+			{
+				Name:     "wider preview",
+				Defaults: map[string]any{"preview": map[string]any{"width": 60}},
+			},
+		})
+		testutils.AssertNoError(t, err)
+
+		assert.False(t, actual.Defaults.Preview.Open)
+		assert.Equal(t, 60, actual.Defaults.Preview.Width)
+		if assert.NotNil(t, actual.Defaults.Layout.Prs.Repo.Hidden) {
+			assert.True(t, *actual.Defaults.Layout.Prs.Repo.Hidden)
+		}
+		assert.Equal(t, cfg.Defaults.Layout.Prs.Repo.Width, actual.Defaults.Layout.Prs.Repo.Width)
+		assert.True(t, actual.Theme.Ui.Table.Compact)
+		assert.Equal(t, cfg.PRSections, actual.PRSections)
+	})
+
+	t.Run("rejects invalid overrides", func(t *testing.T) {
+		_, err := cfg.WithProfiles([]ProfileConfig{{
+			Name:     "broken",
+			Defaults: map[string]any{"checkLogLines": -1},
+		}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/knadh/koanf/parsers/yaml"
+	"github.com/knadh/koanf/providers/file"
+	"github.com/knadh/koanf/v2"
+)
+
+// ThemesDirName is the directory of the global config the palettes are
+// read from, one YAML file per palette named after it
+const ThemesDirName = "themes"
+
+// ThemePalette is a theme defined in a file of the themes directory, it has
+// the colors and icons of the theme config
+type ThemePalette struct {
+	Colors *ColorThemeConfig `yaml:"colors,omitempty" validate:"omitempty"`
+	Icons  *IconThemeConfig  `yaml:"icons,omitempty"  validate:"omitempty"`
+}
+
+// ThemesDir returns the directory the palettes are read from
+func ThemesDir() (string, error) {
+	dir, err := globalConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, ThemesDirName), nil
+}
+
+// ThemeNames returns the names of the palettes of the themes directory,
+// sorted, none when it doesn't exist
+func ThemeNames() ([]string, error) {
+	dir, err := ThemesDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yml" && ext != ".yaml") {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ext)
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// LoadThemePalette reads the palette name of the themes directory
+func LoadThemePalette(name string) (ThemePalette, error) {
+	dir, err := ThemesDir()
+	if err != nil {
+		return ThemePalette{}, err
+	}
+	path := ""
+	for _, ext := range []string{".yml", ".yaml"} {
+		candidate := filepath.Join(dir, name+ext)
+		if _, err := os.Stat(candidate); err == nil {
+			path = candidate
+			break
+		}
+	}
+	if path == "" {
+		return ThemePalette{}, fmt.Errorf("no theme %q in %s", name, dir)
+	}
+
+	parser := initParser()
+	if err := parser.k.Load(file.Provider(path), yaml.Parser()); err != nil {
+		return ThemePalette{}, parsingError{path: path, err: err}
+	}
+	var palette ThemePalette
+	if err := parser.k.UnmarshalWithConf("", &palette, koanf.UnmarshalConf{Tag: "yaml"}); err != nil {
+		return ThemePalette{}, parsingError{path: path, err: err}
+	}
+	if err := validate.Struct(palette); err != nil {
+		return ThemePalette{}, parsingError{path: path, err: err}
+	}
+	return palette, nil
+}
+
+// WithPalette returns the theme with the colors and icons of palette, the
+// ones set in the theme override them
+func (t ThemeConfig) WithPalette(palette ThemePalette) ThemeConfig {
+	if palette.Colors != nil {
+		colors := *palette.Colors
+		if t.Colors != nil {
+			overlay(reflect.ValueOf(&colors).Elem(), reflect.ValueOf(*t.Colors))
+		}
+		t.Colors = &colors
+	}
+	if palette.Icons != nil {
+		icons := *palette.Icons
+		if t.Icons != nil {
+			overlay(reflect.ValueOf(&icons).Elem(), reflect.ValueOf(*t.Icons))
+		}
+		t.Icons = &icons
+	}
+	return t
+}
+
+// overlay sets the strings of dst to the ones of src that aren't empty,
+// walking the nested structs
+func overlay(dst, src reflect.Value) {
+	for i := range src.NumField() {
+		switch field := src.Field(i); field.Kind() {
+		case reflect.Struct:
+			overlay(dst.Field(i), field)
+		case reflect.String:
+			if field.String() != "" {
+				dst.Field(i).SetString(field.String())
+			}
+		}
+	}
+}
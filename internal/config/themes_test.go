@@ -0,0 +1,87 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const draculaTheme = `colors:
+  text:
+    primary: "#F8F8F2"
+    faint: "#6272A4"
+  state:
+    mergedPr: "#BD93F9"
+  rows:
+    failingChecks: "#FF5555"
+    stale: "#6272A4"
+icons:
+  owner: "O"
+`
+
+func writeTheme(t *testing.T, name, content string) {
+	t.Helper()
+	dir := filepath.Join(os.Getenv("XDG_CONFIG_HOME"), DashDir, ThemesDirName)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+}
+
+func TestThemeNames(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	names, err := ThemeNames()
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	writeTheme(t, "nord.yaml", draculaTheme)
+	writeTheme(t, "dracula.yml", draculaTheme)
+	writeTheme(t, "notes.txt", "")
+	names, err = ThemeNames()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dracula", "nord"}, names)
+}
+
+func TestLoadThemePalette(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	writeTheme(t, "dracula.yml", draculaTheme)
+	writeTheme(t, "invalid.yml", "colors:\n  text:\n    primary: red\n")
+
+	palette, err := LoadThemePalette("dracula")
+	require.NoError(t, err)
+	require.NotNil(t, palette.Colors)
+	assert.Equal(t, HexColor("#F8F8F2"), palette.Colors.Inline.Text.Primary)
+	assert.Equal(t, HexColor("#BD93F9"), palette.Colors.Inline.State.MergedPR)
+	assert.Equal(t, HexColor("#FF5555"), palette.Colors.Inline.Rows.FailingChecks)
+	require.NotNil(t, palette.Icons)
+	assert.Equal(t, "O", palette.Icons.Inline.Owner)
+
+	_, err = LoadThemePalette("invalid")
+	assert.Error(t, err)
+	_, err = LoadThemePalette("missing")
+	assert.Error(t, err)
+}
+
+func TestThemeConfigWithPalette(t *testing.T) {
+	palette := ThemePalette{
+		Colors: &ColorThemeConfig{Inline: ColorTheme{
+			Text: ColorThemeText{Primary: "#F8F8F2", Faint: "#6272A4"},
+			Rows: ColorThemeRows{Stale: "#6272A4"},
+		}},
+	}
+	theme := ThemeConfig{
+		Name: "dracula",
+		Colors: &ColorThemeConfig{Inline: ColorTheme{
+			Text: ColorThemeText{Primary: "#FFFFFF"},
+		}},
+	}
+
+	got := theme.WithPalette(palette)
+	assert.Equal(t, HexColor("#FFFFFF"), got.Colors.Inline.Text.Primary)
+	assert.Equal(t, HexColor("#6272A4"), got.Colors.Inline.Text.Faint)
+	assert.Equal(t, HexColor("#6272A4"), got.Colors.Inline.Rows.Stale)
+	assert.Nil(t, got.Icons)
+	// the palette is left as is
+	assert.Equal(t, HexColor("#F8F8F2"), palette.Colors.Inline.Text.Primary)
+}
@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 )
 
 func (cfg Config) GetFullScreenDiffPagerEnv() []string {
@@ -28,23 +30,182 @@ func (cfg Config) GetFullScreenDiffPagerEnv() []string {
 	return env
 }
 
+// MaxAge is how old cached rows can be to still be shown, 0 means any age
+func (cfg CacheConfig) MaxAge() time.Duration {
+	return time.Duration(cfg.MaxAgeHours) * time.Hour
+}
+
+// Enabled returns whether the items leaving the sections are archived
+func (cfg ArchiveConfig) Enabled() bool {
+	return cfg.Days > 0
+}
+
+// ArchiveSections are the sections of the archive view, the PRs and the
+// issues that left the sections of their views
+var ArchiveSections = []SectionConfig{
+	{Title: "PRs", Filters: "is:pr"},
+	{Title: "Issues", Filters: "is:issue"},
+}
+
+// defaultRateLimitThreshold is the share of a quota left, in percent, under
+// which fetches are throttled when it isn't configured
+const defaultRateLimitThreshold = 10
+
+// GetThreshold returns the share of a quota left, in percent, under which
+// fetches are throttled
+func (cfg RateLimitConfig) GetThreshold() int {
+	if cfg.Threshold == nil {
+		return defaultRateLimitThreshold
+	}
+	return *cfg.Threshold
+}
+
+// defaultBotLogins are bots that are hidden without being configured
+var defaultBotLogins = []string{
+	"codecov",
+	"coveralls",
+	"dependabot",
+	"github-actions",
+	"netlify",
+	"renovate",
+	"sonarcloud",
+	"vercel",
+}
+
+// IsBot returns whether the comments of login are hidden as bot comments
+func (cfg BotsConfig) IsBot(login string) bool {
+	login = strings.ToLower(login)
+	if strings.HasSuffix(login, "[bot]") {
+		return true
+	}
+	if login == "" {
+		return false
+	}
+	for _, bot := range slices.Concat(defaultBotLogins, cfg.Logins) {
+		if strings.TrimSuffix(strings.ToLower(bot), "[bot]") == login {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultScoring are the weights of the score when none are configured:
+// older PRs waiting for a review come first, large ones last
+var defaultScoring = ScoringConfig{
+	Age:              1,
+	ReviewRequired:   10,
+	ChangesRequested: -5,
+	Approved:         -10,
+	Size:             -2,
+	Teammate:         10,
+}
+
+// WithDefaults returns the scoring config with the default weights when no
+// weight is configured, the teammates are kept
+func (cfg ScoringConfig) WithDefaults() ScoringConfig {
+	if cfg.Age != 0 || cfg.ReviewRequired != 0 || cfg.ChangesRequested != 0 ||
+		cfg.Approved != 0 || cfg.Size != 0 || cfg.Teammate != 0 || len(cfg.Labels) > 0 {
+		return cfg
+	}
+	scoring := defaultScoring
+	scoring.Teammates = cfg.Teammates
+	return scoring
+}
+
 func (cfg PrsSectionConfig) ToSectionConfig() SectionConfig {
 	return SectionConfig{
-		Title:   cfg.Title,
-		Filters: cfg.Filters,
-		Limit:   cfg.Limit,
-		Type:    cfg.Type,
+		Title:                  cfg.Title,
+		Filters:                cfg.Filters,
+		Limit:                  cfg.Limit,
+		Type:                   cfg.Type,
+		RefetchIntervalMinutes: cfg.RefetchIntervalMinutes,
+		Inbox:                  cfg.Inbox,
+		ProjectFields:          cfg.ProjectFields,
+		ComputedColumns:        cfg.ComputedColumns,
+		AutoPrioritize:         cfg.AutoPrioritize,
+		GroupBySprint:          cfg.GroupBySprint,
+		GroupBy:                cfg.GroupBy,
+		Sort:                   cfg.Sort,
+		Provider:               cfg.Provider,
+		Host:                   cfg.Host,
+		Notify:                 cfg.Notify,
 	}
 }
 
 func (cfg IssuesSectionConfig) ToSectionConfig() SectionConfig {
 	return SectionConfig{
-		Title:   cfg.Title,
-		Filters: cfg.Filters,
-		Limit:   cfg.Limit,
+		Title:                  cfg.Title,
+		Filters:                cfg.Filters,
+		Limit:                  cfg.Limit,
+		RefetchIntervalMinutes: cfg.RefetchIntervalMinutes,
+		Inbox:                  cfg.Inbox,
+		ProjectFields:          cfg.ProjectFields,
+		ComputedColumns:        cfg.ComputedColumns,
+		AutoPrioritize:         cfg.AutoPrioritize,
+		GroupBySprint:          cfg.GroupBySprint,
+		GroupBy:                cfg.GroupBy,
+		Sort:                   cfg.Sort,
+		Provider:               cfg.Provider,
+		Host:                   cfg.Host,
+		Notify:                 cfg.Notify,
+	}
+}
+
+func (cfg WorkflowsSectionConfig) ToSectionConfig() SectionConfig {
+	return SectionConfig{
+		Title:                  cfg.Title,
+		Filters:                cfg.Filters,
+		Limit:                  cfg.Limit,
+		RefetchIntervalMinutes: cfg.RefetchIntervalMinutes,
+	}
+}
+
+func (cfg DiscussionsSectionConfig) ToSectionConfig() SectionConfig {
+	return SectionConfig{
+		Title:                  cfg.Title,
+		Filters:                cfg.Filters,
+		Limit:                  cfg.Limit,
+		RefetchIntervalMinutes: cfg.RefetchIntervalMinutes,
 	}
 }
 
+func (cfg FeedsSectionConfig) ToSectionConfig() SectionConfig {
+	return SectionConfig{
+		Title:                  cfg.Title,
+		Limit:                  cfg.Limit,
+		RefetchIntervalMinutes: cfg.RefetchIntervalMinutes,
+	}
+}
+
+func (cfg ReleasesSectionConfig) ToSectionConfig() SectionConfig {
+	return SectionConfig{
+		Title:                  cfg.Title,
+		RefetchIntervalMinutes: cfg.RefetchIntervalMinutes,
+	}
+}
+
+func (cfg DependenciesSectionConfig) ToSectionConfig() SectionConfig {
+	return SectionConfig{
+		Title:                  cfg.Title,
+		RefetchIntervalMinutes: cfg.RefetchIntervalMinutes,
+	}
+}
+
+// IsGitHub returns whether the section's rows are fetched from GitHub, as
+// opposed to a GitLab or Gitea forge
+func (cfg SectionConfig) IsGitHub() bool {
+	return cfg.Provider == "" || cfg.Provider == "github"
+}
+
+// SectionHost returns the host the rows of section are fetched from, the
+// default host of the config for GitHub sections that don't set one
+func (cfg Config) SectionHost(section SectionConfig) string {
+	if section.Host == "" && section.IsGitHub() {
+		return cfg.Defaults.Host
+	}
+	return section.Host
+}
+
 func MergeColumnConfigs(defaultCfg, sectionCfg ColumnConfig) ColumnConfig {
 	colCfg := defaultCfg
 	if sectionCfg.Width != nil {
@@ -63,3 +224,123 @@ func TruncateCommand(cmd string) string {
 	}
 	return cmd
 }
+
+// Repos returns the repos the config refers to, the ones in repoPaths and the
+// ones the PR and issues sections filter by, sorted and without duplicates.
+// Wildcards and templated names are skipped.
+func (cfg Config) Repos() []string {
+	var repos []string
+	add := func(repo string) {
+		if strings.Count(repo, "/") != 1 || strings.ContainsAny(repo, "*{}") {
+			return
+		}
+		repos = append(repos, repo)
+	}
+
+	for repo := range cfg.RepoPaths {
+		add(repo)
+	}
+	filters := make([]string, 0, len(cfg.PRSections)+len(cfg.IssuesSections))
+	for _, s := range cfg.PRSections {
+		if s.ToSectionConfig().IsGitHub() {
+			filters = append(filters, s.Filters)
+		}
+	}
+	for _, s := range cfg.IssuesSections {
+		if s.ToSectionConfig().IsGitHub() {
+			filters = append(filters, s.Filters)
+		}
+	}
+	for _, f := range filters {
+		for token := range strings.FieldsSeq(f) {
+			if repo, ok := strings.CutPrefix(token, "repo:"); ok {
+				add(repo)
+			}
+		}
+	}
+
+	slices.Sort(repos)
+	return slices.Compact(repos)
+}
+
+// ForRepo returns the estimate field of the issues of repo, as owner/name.
+// It's the zero value when no field is configured.
+func (cfg EstimateConfig) ForRepo(repo string) EstimateField {
+	field := EstimateField{Field: cfg.Field, Project: cfg.Project}
+	for name, override := range cfg.Repos {
+		if !strings.EqualFold(name, repo) {
+			continue
+		}
+		if override.Field != "" {
+			field.Field = override.Field
+		}
+		if override.Project != "" {
+			field.Project = override.Project
+		}
+	}
+	return field
+}
+
+// MergeRepoFilters returns searchValue with the filters of the repos block of
+// the repos it has a repo: qualifier of. The filters it already has aren't
+// added again, nor the ones it negates, e.g. -author:app/renovate isn't added
+// to a search with author:app/renovate.
+func (cfg Config) MergeRepoFilters(searchValue string) string {
+	if len(cfg.RepoDefaults) == 0 {
+		return searchValue
+	}
+
+	tokens := strings.Fields(searchValue)
+	var merged []string
+	for _, token := range tokens {
+		repo, ok := strings.CutPrefix(token, "repo:")
+		if !ok {
+			continue
+		}
+		for name, defaults := range cfg.RepoDefaults {
+			if !strings.EqualFold(name, repo) {
+				continue
+			}
+			for filter := range strings.FieldsSeq(defaults.Filters) {
+				negated := "-" + filter
+				if trimmed, ok := strings.CutPrefix(filter, "-"); ok {
+					negated = trimmed
+				}
+				if slices.Contains(tokens, filter) || slices.Contains(tokens, negated) ||
+					slices.Contains(merged, filter) {
+					continue
+				}
+				merged = append(merged, filter)
+			}
+		}
+	}
+	return strings.Join(append(tokens, merged...), " ")
+}
+
+// TextWidth returns the width of the text of a preview whose content is
+// width wide
+func (cfg PreviewConfig) TextWidth(width int) int {
+	if cfg.ReadingWidth > 0 && cfg.ReadingWidth < width {
+		return cfg.ReadingWidth
+	}
+	return width
+}
+
+// IsDesktop returns whether new rows are announced with desktop
+// notifications
+func (cfg NotificationsConfig) IsDesktop() bool {
+	return cfg.Desktop == nil || *cfg.Desktop
+}
+
+// IsSet returns whether an estimate field is configured for some issues
+func (cfg EstimateConfig) IsSet() bool {
+	if cfg.Field != "" {
+		return true
+	}
+	for _, override := range cfg.Repos {
+		if override.Field != "" {
+			return true
+		}
+	}
+	return false
+}
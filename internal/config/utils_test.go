@@ -0,0 +1,169 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBotsConfigIsBot(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   BotsConfig
+		login string
+		want  bool
+	}{
+		{
+			name:  "well-known bot",
+			cfg:   BotsConfig{},
+			login: "codecov",
+			want:  true,
+		},
+		{
+			name:  "app login suffix",
+			cfg:   BotsConfig{},
+			login: "my-ci[bot]",
+			want:  true,
+		},
+		{
+			name:  "configured bot ignores case",
+			cfg:   BotsConfig{Logins: []string{"Deploy-Preview"}},
+			login: "deploy-preview",
+			want:  true,
+		},
+		{
+			name:  "configured bot with suffix",
+			cfg:   BotsConfig{Logins: []string{"changeset-bot[bot]"}},
+			login: "changeset-bot",
+			want:  true,
+		},
+		{
+			name:  "user",
+			cfg:   BotsConfig{Logins: []string{"deploy-preview"}},
+			login: "dlvhdr",
+			want:  false,
+		},
+		{
+			name:  "deleted user",
+			cfg:   BotsConfig{},
+			login: "",
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.IsBot(tt.login); got != tt.want {
+				t.Errorf("IsBot(%q) = %v, want %v", tt.login, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigRepos(t *testing.T) {
+	cfg := Config{
+		RepoPaths: map[string]string{
+			"dlvhdr/gh-dash": "~/code/gh-dash",
+			"dlvhdr/*":       "~/code/*",
+		},
+		PRSections: []PrsSectionConfig{
+			{Filters: "is:open repo:dlvhdr/gh-dash author:@me"},
+			{Filters: "repo:charmbracelet/bubbletea -repo:charmbracelet/lipgloss"},
+		},
+		IssuesSections: []IssuesSectionConfig{
+			{Filters: "repo:cli/cli repo:{{ .Repo }}"},
+			{Filters: "repo:gitlab-org/cli", Provider: "gitlab"},
+		},
+	}
+
+	want := []string{"charmbracelet/bubbletea", "cli/cli", "dlvhdr/gh-dash"}
+	if got := cfg.Repos(); !slices.Equal(got, want) {
+		t.Errorf("Repos() = %v, want %v", got, want)
+	}
+}
+
+func TestEstimateConfigForRepo(t *testing.T) {
+	cfg := EstimateConfig{
+		Field:   "Estimate",
+		Project: "cli/1",
+		Repos: map[string]EstimateField{
+			"dlvhdr/gh-dash":     {Field: "Points"},
+			"charmbracelet/glow": {Project: "charmbracelet/4"},
+		},
+	}
+
+	tests := []struct {
+		repo string
+		want EstimateField
+	}{
+		{repo: "cli/cli", want: EstimateField{Field: "Estimate", Project: "cli/1"}},
+		{repo: "Dlvhdr/GH-Dash", want: EstimateField{Field: "Points", Project: "cli/1"}},
+		{repo: "charmbracelet/glow", want: EstimateField{Field: "Estimate", Project: "charmbracelet/4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repo, func(t *testing.T) {
+			if got := cfg.ForRepo(tt.repo); got != tt.want {
+				t.Errorf("ForRepo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if (EstimateConfig{}).IsSet() {
+		t.Error("IsSet() of an empty config = true, want false")
+	}
+	if !(EstimateConfig{Repos: map[string]EstimateField{"cli/cli": {Field: "Size"}}}).IsSet() {
+		t.Error("IsSet() with a repo field = false, want true")
+	}
+}
+
+func TestConfigMergeRepoFilters(t *testing.T) {
+	cfg := Config{
+		RepoDefaults: map[string]RepoDefaults{
+			"dlvhdr/gh-dash": {Filters: "-author:app/renovate label:team-tui"},
+			"cli/cli":        {Filters: "-label:blocked -author:app/renovate"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		searchValue string
+		want        string
+	}{
+		{
+			name:        "no repo",
+			searchValue: "is:open author:@me",
+			want:        "is:open author:@me",
+		},
+		{
+			name:        "repo",
+			searchValue: "is:open repo:Dlvhdr/GH-Dash",
+			want:        "is:open repo:Dlvhdr/GH-Dash -author:app/renovate label:team-tui",
+		},
+		{
+			name:        "already there",
+			searchValue: "repo:dlvhdr/gh-dash label:team-tui",
+			want:        "repo:dlvhdr/gh-dash label:team-tui -author:app/renovate",
+		},
+		{
+			name:        "negated",
+			searchValue: "repo:dlvhdr/gh-dash author:app/renovate",
+			want:        "repo:dlvhdr/gh-dash author:app/renovate label:team-tui",
+		},
+		{
+			name:        "several repos",
+			searchValue: "repo:dlvhdr/gh-dash repo:cli/cli",
+			want:        "repo:dlvhdr/gh-dash repo:cli/cli -author:app/renovate label:team-tui -label:blocked",
+		},
+		{
+			name:        "excluded repo",
+			searchValue: "is:open -repo:cli/cli",
+			want:        "is:open -repo:cli/cli",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.MergeRepoFilters(tt.searchValue); got != tt.want {
+				t.Errorf("MergeRepoFilters() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
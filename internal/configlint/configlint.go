@@ -0,0 +1,257 @@
+// Package configlint estimates what the PR and issue sections of a config
+// cost in API rate limit points, and points out the sections that search the
+// same rows or cost more than they need to.
+package configlint
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+const (
+	// GraphQLPointsPerHour is the GraphQL API quota of a user
+	GraphQLPointsPerHour = 5000
+	// budgetShare is the share of the quota, in percent, the refreshes of
+	// the sections can take before they're flagged, the rest is left to the
+	// sidebar, the actions and the other tools using the token
+	budgetShare = 5
+	// maxUsefulLimit is the most rows a section lists before its limit is
+	// flagged, the rows past a screen or two are rarely looked at
+	maxUsefulLimit = 50
+)
+
+// SectionCost is what refreshing a section costs
+type SectionCost struct {
+	View  config.ViewType
+	Title string
+	Limit int
+	// IntervalMinutes is how often the section is refetched, 0 when it
+	// isn't
+	IntervalMinutes int
+	// PointsPerRefresh are the points a fetch of the section costs
+	PointsPerRefresh int
+}
+
+// PointsPerHour are the points the refreshes of the section cost in an hour
+func (c SectionCost) PointsPerHour() int {
+	if c.IntervalMinutes <= 0 {
+		return 0
+	}
+	return c.PointsPerRefresh * 60 / c.IntervalMinutes
+}
+
+// Finding is a problem of the config and how to fix it
+type Finding struct {
+	Message    string
+	Suggestion string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s\n  → %s", f.Message, f.Suggestion)
+}
+
+// Report is what the sections of a config cost and what could be improved
+type Report struct {
+	Sections []SectionCost
+	Findings []Finding
+}
+
+// PointsPerHour are the points the refreshes of all the sections cost in an
+// hour
+func (r Report) PointsPerHour() int {
+	total := 0
+	for _, s := range r.Sections {
+		total += s.PointsPerHour()
+	}
+	return total
+}
+
+// section is a section of the config as the linter sees it
+type section struct {
+	cost SectionCost
+	// qualifiers are the lowercased qualifiers of its filters, sorted
+	qualifiers []string
+}
+
+// Lint estimates the cost of the PR and issue sections of cfg and returns
+// what could be improved, in the order of the sections
+func Lint(cfg config.Config) Report {
+	var sections []section
+	add := func(view config.ViewType, s config.SectionConfig, defaultLimit int) {
+		limit := defaultLimit
+		if s.Limit != nil {
+			limit = *s.Limit
+		}
+		interval := cfg.Defaults.RefetchIntervalMinutes
+		if s.RefetchIntervalMinutes != nil {
+			interval = *s.RefetchIntervalMinutes
+		}
+		points := data.IssuesSearchCost(limit, cfg.ListQueries.Trim)
+		if view == config.PRsView {
+			points = data.PullRequestsSearchCost(limit, cfg.ListQueries.Trim)
+		}
+		sections = append(sections, section{
+			cost: SectionCost{
+				View:             view,
+				Title:            s.Title,
+				Limit:            limit,
+				IntervalMinutes:  max(interval, 0),
+				PointsPerRefresh: points,
+			},
+			qualifiers: qualifiers(s.Filters),
+		})
+	}
+	for _, s := range cfg.PRSections {
+		add(config.PRsView, s.ToSectionConfig(), cfg.Defaults.PrsLimit)
+	}
+	for _, s := range cfg.IssuesSections {
+		add(config.IssuesView, s.ToSectionConfig(), cfg.Defaults.IssuesLimit)
+	}
+
+	var report Report
+	for _, s := range sections {
+		report.Sections = append(report.Sections, s.cost)
+	}
+	report.Findings = append(report.Findings, overlaps(sections)...)
+	report.Findings = append(report.Findings, limits(sections)...)
+	if finding, ok := budget(report); ok {
+		report.Findings = append(report.Findings, finding)
+	}
+	return report
+}
+
+// qualifiers splits filters into its qualifiers, lowercased and sorted so
+// that the same search written differently compares equal
+func qualifiers(filters string) []string {
+	fields := strings.Fields(strings.ToLower(filters))
+	slices.Sort(fields)
+	return slices.Compact(fields)
+}
+
+// overlaps flags the sections of a view searching the same rows, or whose
+// rows are all listed by another section as well
+func overlaps(sections []section) []Finding {
+	var findings []Finding
+	for i, a := range sections {
+		for _, b := range sections[i+1:] {
+			if a.cost.View != b.cost.View {
+				continue
+			}
+			switch {
+			case slices.Equal(a.qualifiers, b.qualifiers):
+				findings = append(findings, Finding{
+					Message: fmt.Sprintf("%s sections %s and %s search the same %s",
+						a.cost.View, quote(a.cost.Title), quote(b.cost.Title), rowsOf(a.cost.View)),
+					Suggestion: fmt.Sprintf("remove one of them to save %d points per refresh",
+						b.cost.PointsPerRefresh),
+				})
+			case isNarrower(a.qualifiers, b.qualifiers):
+				findings = append(findings, narrower(a, b))
+			case isNarrower(b.qualifiers, a.qualifiers):
+				findings = append(findings, narrower(b, a))
+			}
+		}
+	}
+	return findings
+}
+
+// isNarrower returns whether a search with the qualifiers of narrow only
+// finds rows a search with the ones of wide finds too, when narrow adds
+// qualifiers to all of wide's. Searches with OR can't be compared.
+func isNarrower(narrow []string, wide []string) bool {
+	if slices.Contains(narrow, "or") || slices.Contains(wide, "or") || len(narrow) <= len(wide) {
+		return false
+	}
+	for _, q := range wide {
+		if strings.HasPrefix(q, "sort:") {
+			continue
+		}
+		if _, found := slices.BinarySearch(narrow, q); !found {
+			return false
+		}
+	}
+	return true
+}
+
+func narrower(narrow section, wide section) Finding {
+	var extra []string
+	for _, q := range narrow.qualifiers {
+		if _, found := slices.BinarySearch(wide.qualifiers, q); !found {
+			extra = append(extra, q)
+		}
+	}
+	suggestion := fmt.Sprintf("merge them and search %s in %s when needed",
+		strings.Join(extra, " "), quote(wide.cost.Title))
+	if len(extra) == 1 {
+		// the rows missing one qualifier are the ones with its negation
+		suggestion += fmt.Sprintf(", or add %s to %s so they don't overlap",
+			negate(extra[0]), quote(wide.cost.Title))
+	}
+	return Finding{
+		Message: fmt.Sprintf("every %s of %s section %s is also listed by %s",
+			strings.TrimSuffix(rowsOf(narrow.cost.View), "s"), narrow.cost.View,
+			quote(narrow.cost.Title), quote(wide.cost.Title)),
+		Suggestion: suggestion,
+	}
+}
+
+// limits flags the sections listing more rows than are likely looked at
+func limits(sections []section) []Finding {
+	var findings []Finding
+	for _, s := range sections {
+		if s.cost.Limit <= maxUsefulLimit {
+			continue
+		}
+		findings = append(findings, Finding{
+			Message: fmt.Sprintf("%s section %s fetches %d %s, each refresh costs %d points",
+				s.cost.View, quote(s.cost.Title), s.cost.Limit, rowsOf(s.cost.View),
+				s.cost.PointsPerRefresh),
+			Suggestion: fmt.Sprintf("lower its limit to %d, the next pages are fetched as you scroll",
+				maxUsefulLimit),
+		})
+	}
+	return findings
+}
+
+// budget flags the sections when their refreshes take more than their share
+// of the hourly quota, suggesting to refresh the costliest one less often
+func budget(report Report) (Finding, bool) {
+	total := report.PointsPerHour()
+	if total*100 <= GraphQLPointsPerHour*budgetShare || len(report.Sections) == 0 {
+		return Finding{}, false
+	}
+
+	costliest := slices.MaxFunc(report.Sections, func(a, b SectionCost) int {
+		return a.PointsPerHour() - b.PointsPerHour()
+	})
+	return Finding{
+		Message: fmt.Sprintf("refreshing the sections costs %d points an hour, %d%% of the %d the API allows",
+			total, total*100/GraphQLPointsPerHour, GraphQLPointsPerHour),
+		Suggestion: fmt.Sprintf("%s section %s costs the most, %d points an hour: raise its refetchIntervalMinutes from %d or lower its limit",
+			costliest.View, quote(costliest.Title), costliest.PointsPerHour(), costliest.IntervalMinutes),
+	}, true
+}
+
+// negate returns the qualifier excluding the rows matching qualifier, e.g.
+// -label:bug for label:bug
+func negate(qualifier string) string {
+	if negated, ok := strings.CutPrefix(qualifier, "-"); ok {
+		return negated
+	}
+	return "-" + qualifier
+}
+
+func rowsOf(view config.ViewType) string {
+	if view == config.PRsView {
+		return "PRs"
+	}
+	return "issues"
+}
+
+func quote(title string) string {
+	return fmt.Sprintf("%q", title)
+}
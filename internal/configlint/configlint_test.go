@@ -0,0 +1,120 @@
+package configlint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+func TestLint(t *testing.T) {
+	defaults := config.Defaults{PrsLimit: 20, IssuesLimit: 20, RefetchIntervalMinutes: 30}
+
+	tests := []struct {
+		name     string
+		prs      []config.PrsSectionConfig
+		issues   []config.IssuesSectionConfig
+		defaults config.Defaults
+		want     []string
+	}{
+		{
+			name: "distinct sections",
+			prs: []config.PrsSectionConfig{
+				{Title: "Mine", Filters: "is:open author:@me"},
+				{Title: "Review", Filters: "is:open review-requested:@me"},
+			},
+			defaults: defaults,
+		},
+		{
+			name: "same search written differently",
+			prs: []config.PrsSectionConfig{
+				{Title: "Mine", Filters: "is:open author:@me"},
+				{Title: "Authored", Filters: "author:@me  IS:OPEN"},
+			},
+			defaults: defaults,
+			want:     []string{`prs sections "Mine" and "Authored" search the same PRs`},
+		},
+		{
+			name: "section within another",
+			issues: []config.IssuesSectionConfig{
+				{Title: "Bugs", Filters: "is:open assignee:@me label:bug"},
+				{Title: "Assigned", Filters: "is:open assignee:@me"},
+			},
+			defaults: defaults,
+			want: []string{
+				`every issue of issues section "Bugs" is also listed by "Assigned"`,
+				`add -label:bug to "Assigned"`,
+			},
+		},
+		{
+			name: "same qualifiers in different views",
+			prs: []config.PrsSectionConfig{
+				{Title: "Mine", Filters: "is:open author:@me"},
+			},
+			issues: []config.IssuesSectionConfig{
+				{Title: "Mine", Filters: "is:open author:@me"},
+			},
+			defaults: defaults,
+		},
+		{
+			name: "searches with OR aren't compared",
+			prs: []config.PrsSectionConfig{
+				{Title: "Mine", Filters: "is:open author:@me"},
+				{Title: "Mine or assigned", Filters: "is:open author:@me OR assignee:@me"},
+			},
+			defaults: defaults,
+		},
+		{
+			name: "limit past a screen",
+			prs: []config.PrsSectionConfig{
+				{Title: "All", Filters: "is:open", Limit: utils.IntPtr(100)},
+			},
+			defaults: defaults,
+			want:     []string{`prs section "All" fetches 100 PRs`, "lower its limit to 50"},
+		},
+		{
+			name: "refreshes over budget",
+			prs: []config.PrsSectionConfig{
+				{Title: "Mine", Filters: "is:open author:@me", Limit: utils.IntPtr(50), RefetchIntervalMinutes: utils.IntPtr(1)},
+				{Title: "Review", Filters: "is:open review-requested:@me", Limit: utils.IntPtr(50), RefetchIntervalMinutes: utils.IntPtr(3)},
+			},
+			defaults: defaults,
+			want: []string{
+				"refreshing the sections costs 400 points an hour",
+				`prs section "Mine" costs the most, 300 points an hour`,
+				"raise its refetchIntervalMinutes from 1",
+			},
+		},
+		{
+			name: "sections that aren't refetched cost nothing an hour",
+			prs: []config.PrsSectionConfig{
+				{Title: "Mine", Filters: "is:open author:@me"},
+			},
+			defaults: config.Defaults{PrsLimit: 50, RefetchIntervalMinutes: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := Lint(config.Config{
+				PRSections:     tt.prs,
+				IssuesSections: tt.issues,
+				Defaults:       tt.defaults,
+			})
+
+			var got strings.Builder
+			for _, f := range report.Findings {
+				got.WriteString(f.String() + "\n")
+			}
+			if len(tt.want) == 0 && len(report.Findings) > 0 {
+				t.Errorf("Lint() findings = %q, want none", got.String())
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got.String(), want) {
+					t.Errorf("Lint() findings = %q, want them to contain %q", got.String(), want)
+				}
+			}
+		})
+	}
+}
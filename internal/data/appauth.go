@@ -0,0 +1,264 @@
+package data
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/auth"
+)
+
+// AppAuth is a GitHub App installation the dashboard authenticates to a host
+// as, instead of the token gh is logged in with
+type AppAuth struct {
+	AppId          int64
+	InstallationId int64
+	// PrivateKeyPath is the PEM file of a private key of the app
+	PrivateKeyPath string
+}
+
+// installationToken is a token of an installation, valid for an hour
+type installationToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// installationTokenMargin is how long before its expiry a token is renewed,
+// so that it doesn't expire mid-request
+const installationTokenMargin = 5 * time.Minute
+
+type appAuthState struct {
+	mu sync.Mutex
+	// apps are the installations to authenticate as, by host
+	apps   map[string]AppAuth
+	tokens map[string]installationToken
+	// fetches are the tokens being created, by host, the requests needing one
+	// wait for them rather than creating their own
+	fetches map[string]*tokenFetch
+}
+
+// tokenFetch is an installation token being created, done is closed once it
+// is
+type tokenFetch struct {
+	done  chan struct{}
+	token installationToken
+	err   error
+}
+
+var appAuths = appAuthState{}
+
+// SetAppAuths sets the hosts whose requests authenticate as a GitHub App
+// installation from now on, the others use the token of gh
+func SetAppAuths(apps map[string]AppAuth) {
+	appAuths.mu.Lock()
+	defer appAuths.mu.Unlock()
+	appAuths.apps = map[string]AppAuth{}
+	for host, app := range apps {
+		appAuths.apps[auth.NormalizeHostname(host)] = app
+	}
+	appAuths.tokens = map[string]installationToken{}
+	appAuths.fetches = map[string]*tokenFetch{}
+}
+
+// usesAppAuth returns whether the requests to host authenticate as a GitHub
+// App installation
+func usesAppAuth(host string) bool {
+	appAuths.mu.Lock()
+	defer appAuths.mu.Unlock()
+	_, ok := appAuths.apps[auth.NormalizeHostname(host)]
+	return ok
+}
+
+// installationTokenFor returns a token of the installation host uses, it's
+// created again when it's about to expire. The requests to the other hosts
+// don't wait for it to be created, the ones to host wait for a single one.
+func installationTokenFor(host string, now time.Time) (string, error) {
+	host = auth.NormalizeHostname(host)
+	appAuths.mu.Lock()
+	app, ok := appAuths.apps[host]
+	if !ok {
+		appAuths.mu.Unlock()
+		return "", fmt.Errorf("no GitHub App configured for %s", host)
+	}
+	if token, ok := appAuths.tokens[host]; ok && now.Add(installationTokenMargin).Before(token.ExpiresAt) {
+		appAuths.mu.Unlock()
+		return token.Token, nil
+	}
+	fetch, ok := appAuths.fetches[host]
+	if ok {
+		appAuths.mu.Unlock()
+		<-fetch.done
+	} else {
+		fetch = &tokenFetch{done: make(chan struct{})}
+		appAuths.fetches[host] = fetch
+		appAuths.mu.Unlock()
+
+		log.Debug("Creating GitHub App installation token", "host", host, "installation", app.InstallationId)
+		fetch.token, fetch.err = createInstallationToken(host, app, now)
+
+		appAuths.mu.Lock()
+		if appAuths.fetches[host] == fetch {
+			delete(appAuths.fetches, host)
+		}
+		// the hosts may have been set again meanwhile
+		if fetch.err == nil && appAuths.apps[host] == app {
+			appAuths.tokens[host] = fetch.token
+		}
+		appAuths.mu.Unlock()
+		close(fetch.done)
+	}
+
+	if fetch.err != nil {
+		return "", fmt.Errorf("authenticating as GitHub App %d on %s: %w", app.AppId, host, fetch.err)
+	}
+	return fetch.token.Token, nil
+}
+
+// createInstallationToken creates a token of an installation, it's replaced
+// in tests
+var createInstallationToken = requestInstallationToken
+
+// requestInstallationToken exchanges a JWT signed with the app's private key
+// for a token of its installation
+func requestInstallationToken(host string, app AppAuth, now time.Time) (installationToken, error) {
+	pemBytes, err := os.ReadFile(app.PrivateKeyPath)
+	if err != nil {
+		return installationToken{}, err
+	}
+	key, err := parseAppPrivateKey(pemBytes)
+	if err != nil {
+		return installationToken{}, err
+	}
+	jwt, err := appJWT(app.AppId, key, now)
+	if err != nil {
+		return installationToken{}, err
+	}
+
+	// the JWT goes in a Bearer header, it isn't a token gh would send
+	client, err := gh.NewRESTClient(gh.ClientOptions{
+		Host:      host,
+		AuthToken: jwt,
+		Headers:   map[string]string{"Authorization": "Bearer " + jwt},
+	})
+	if err != nil {
+		return installationToken{}, err
+	}
+	var token installationToken
+	path := fmt.Sprintf("app/installations/%d/access_tokens", app.InstallationId)
+	if err := client.Post(path, nil, &token); err != nil {
+		return installationToken{}, err
+	}
+	return token, nil
+}
+
+// parseAppPrivateKey reads the RSA private key of a PEM file, GitHub
+// generates PKCS #1 ones
+func parseAppPrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("the private key isn't a PEM file")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("the private key isn't an RSA key")
+	}
+	return key, nil
+}
+
+// appJWT returns the JWT the app authenticates with, signed with its private
+// key. It's issued a minute in the past against clock drift and is valid for
+// ten minutes, the most GitHub accepts.
+func appJWT(appId int64, key *rsa.PrivateKey, now time.Time) (string, error) {
+	encode := func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return base64.RawURLEncoding.EncodeToString(b), nil
+	}
+
+	header, err := encode(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := encode(map[string]any{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": fmt.Sprint(appId),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signed := header + "." + claims
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// appAuthTransport authenticates the requests to the hosts that use a GitHub
+// App installation with a token of the installation
+type appAuthTransport struct {
+	base http.RoundTripper
+}
+
+func (t appAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Hostname()
+	if !usesAppAuth(host) {
+		return t.base.RoundTrip(req)
+	}
+	token, err := installationTokenFor(host, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "token "+token)
+	return t.base.RoundTrip(req)
+}
+
+// withAppAuth returns opts with a transport authenticating as the GitHub App
+// installation of the host, when it uses one
+func withAppAuth(opts gh.ClientOptions) gh.ClientOptions {
+	host := opts.Host
+	if host == "" {
+		host, _ = auth.DefaultHost()
+	}
+	if !usesAppAuth(host) {
+		return opts
+	}
+
+	base := opts.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	opts.Transport = appAuthTransport{base: base}
+	if token, _ := auth.TokenForHost(host); opts.AuthToken == "" && token == "" {
+		// the client needs a token to be created when gh isn't logged in to
+		// the host, the installation's replaces it
+		opts.AuthToken = "github-app"
+	}
+	return opts
+}
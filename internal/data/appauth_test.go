@@ -0,0 +1,176 @@
+package data
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAppJWT(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	jwt, err := appJWT(12345, key, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		t.Fatalf("appJWT() = %q, want 3 parts", jwt)
+	}
+
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var claims struct {
+		Iat int64  `json:"iat"`
+		Exp int64  `json:"exp"`
+		Iss string `json:"iss"`
+	}
+	if err := json.Unmarshal(claimsJSON, &claims); err != nil {
+		t.Fatal(err)
+	}
+	if claims.Iss != "12345" || claims.Iat != now.Add(-time.Minute).Unix() ||
+		claims.Exp-claims.Iat > int64((10*time.Minute).Seconds()) {
+		t.Errorf("claims = %+v, want the app issuing a JWT valid for at most 10 minutes", claims)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		t.Errorf("the JWT's signature doesn't verify: %v", err)
+	}
+}
+
+func TestParseAppPrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		pem     []byte
+		wantErr bool
+	}{
+		{
+			name: "PKCS #1",
+			pem:  pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		},
+		{
+			name: "PKCS #8",
+			pem:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+		},
+		{name: "not PEM", pem: []byte("not a key"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAppPrivateKey(tt.pem)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("parseAppPrivateKey() didn't fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(key) {
+				t.Error("parseAppPrivateKey() returned another key")
+			}
+		})
+	}
+}
+
+func TestUsesAppAuth(t *testing.T) {
+	t.Cleanup(func() { SetAppAuths(nil) })
+	SetAppAuths(map[string]AppAuth{
+		"github.com":       {AppId: 1},
+		"GHE.example.com":  {AppId: 2},
+		"octocorp.ghe.com": {AppId: 3},
+	})
+
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{host: "github.com", want: true},
+		{host: "api.github.com", want: true},
+		{host: "ghe.example.com", want: true},
+		{host: "api.octocorp.ghe.com", want: true},
+		{host: "other.example.com", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			if got := usesAppAuth(tt.host); got != tt.want {
+				t.Errorf("usesAppAuth(%q) = %v, want %v", tt.host, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstallationTokenForDoesNotBlock(t *testing.T) {
+	t.Cleanup(func() { SetAppAuths(nil) })
+	SetAppAuths(map[string]AppAuth{"github.com": {AppId: 1}})
+
+	release := make(chan struct{})
+	var created atomic.Int32
+	orig := createInstallationToken
+	t.Cleanup(func() { createInstallationToken = orig })
+	createInstallationToken = func(string, AppAuth, time.Time) (installationToken, error) {
+		created.Add(1)
+		<-release
+		return installationToken{Token: "ghs_1", ExpiresAt: time.Now().Add(time.Hour)}, nil
+	}
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 3)
+	for i := range tokens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tokens[i], _ = installationTokenFor("github.com", time.Now())
+		}()
+	}
+
+	// the token being created doesn't hold up the other requests
+	done := make(chan bool)
+	go func() { done <- usesAppAuth("other.example.com") }()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("usesAppAuth() waited for the installation token")
+	}
+
+	close(release)
+	wg.Wait()
+	if n := created.Load(); n != 1 {
+		t.Errorf("created %d installation tokens, want 1", n)
+	}
+	for _, token := range tokens {
+		if token != "ghs_1" {
+			t.Errorf("installationTokenFor() = %q, want %q", token, "ghs_1")
+		}
+	}
+}
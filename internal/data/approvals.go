@@ -0,0 +1,44 @@
+package data
+
+// BaseRef is the branch a PR merges into
+type BaseRef struct {
+	// BranchProtectionRule is the rule protecting the branch, nil when it
+	// isn't protected
+	BranchProtectionRule *struct {
+		RequiredApprovingReviewCount int
+	}
+}
+
+// LatestReviews are the latest review approving or requesting changes of
+// each reviewer with write access, the ones branch protection counts
+type LatestReviews struct {
+	Nodes []LatestReview
+}
+
+// LatestReview is the latest review of a reviewer
+type LatestReview struct {
+	Id     string
+	Author struct {
+		Login string
+	}
+	State string
+	// Commit is the commit the review was left on, nil when it's no longer
+	// in the PR
+	Commit *struct {
+		Oid string
+	}
+}
+
+// Approvals returns how many reviewers approve the PR, and how many approvals
+// the protection rule of its base branch requires, 0 when it requires none
+func (data PullRequestData) Approvals() (approved int, required int) {
+	for _, review := range data.LatestReviews.Nodes {
+		if review.State == "APPROVED" {
+			approved++
+		}
+	}
+	if data.BaseRef != nil && data.BaseRef.BranchProtectionRule != nil {
+		required = data.BaseRef.BranchProtectionRule.RequiredApprovingReviewCount
+	}
+	return approved, required
+}
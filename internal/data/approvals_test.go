@@ -0,0 +1,58 @@
+package data
+
+import "testing"
+
+func TestPullRequestApprovals(t *testing.T) {
+	pr := func(required int, states ...string) PullRequestData {
+		var pr PullRequestData
+		if required > 0 {
+			pr.BaseRef = &BaseRef{}
+			pr.BaseRef.BranchProtectionRule = &struct {
+				RequiredApprovingReviewCount int
+			}{RequiredApprovingReviewCount: required}
+		}
+		for _, state := range states {
+			pr.LatestReviews.Nodes = append(pr.LatestReviews.Nodes, LatestReview{State: state})
+		}
+		return pr
+	}
+
+	tests := []struct {
+		name         string
+		pr           PullRequestData
+		wantApproved int
+		wantRequired int
+	}{
+		{
+			name: "no reviews and no protection",
+			pr:   pr(0),
+		},
+		{
+			name:         "unprotected branch",
+			pr:           pr(0, "APPROVED"),
+			wantApproved: 1,
+		},
+		{
+			name:         "changes requested aren't approvals",
+			pr:           pr(3, "APPROVED", "CHANGES_REQUESTED", "APPROVED"),
+			wantApproved: 2,
+			wantRequired: 3,
+		},
+		{
+			name:         "deleted base branch",
+			pr:           PullRequestData{},
+			wantApproved: 0,
+			wantRequired: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approved, required := tt.pr.Approvals()
+			if approved != tt.wantApproved || required != tt.wantRequired {
+				t.Errorf("Approvals() = %d, %d, want %d, %d",
+					approved, required, tt.wantApproved, tt.wantRequired)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+const (
+	// batchWindow is how long a search waits for others to share its request,
+	// the sections' first pages are all requested at once on startup
+	batchWindow = 15 * time.Millisecond
+
+	// maxBatchSize caps the searches sent in one request, GitHub times out
+	// queries that are too expensive
+	maxBatchSize = 10
+)
+
+// searchConnection is the result of a search, T is the node type with the
+// fragment of the searched type, e.g. pullRequestNode
+type searchConnection[T any] struct {
+	Nodes      []T
+	IssueCount int
+	PageInfo   PageInfo
+}
+
+// searchRequest is a first page search waiting to be sent along with others
+type searchRequest struct {
+	query string
+	limit int
+	// conn points to the searchConnection the result is decoded into
+	conn any
+	// solo runs the search on its own, when there's nothing to batch it with
+	// or the batch failed
+	solo func() error
+	done chan error
+}
+
+// searchBatcher combines the searches made within batchWindow of each other
+// into a single GraphQL request, aliasing each search
+type searchBatcher struct {
+	mu      sync.Mutex
+	window  time.Duration
+	pending []*searchRequest
+	timer   *time.Timer
+}
+
+var batcher = &searchBatcher{window: batchWindow}
+
+// batchSearch runs the search for the first page of query, decoding it into
+// conn, along with the other searches made at the same time. solo runs the
+// same search on its own.
+func batchSearch(query string, limit int, conn any, solo func() error) error {
+	req := &searchRequest{
+		query: query,
+		limit: limit,
+		conn:  conn,
+		solo:  solo,
+		done:  make(chan error, 1),
+	}
+	batcher.add(req)
+	return <-req.done
+}
+
+func (b *searchBatcher) add(req *searchRequest) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(b.pending, req)
+	if len(b.pending) >= maxBatchSize {
+		b.timer.Stop()
+		go send(b.take())
+		return
+	}
+	if len(b.pending) == 1 {
+		b.timer = time.AfterFunc(b.window, func() {
+			b.mu.Lock()
+			reqs := b.take()
+			b.mu.Unlock()
+			send(reqs)
+		})
+	}
+}
+
+// take empties the pending searches, b.mu must be held
+func (b *searchBatcher) take() []*searchRequest {
+	reqs := b.pending
+	b.pending = nil
+	return reqs
+}
+
+func send(reqs []*searchRequest) {
+	switch len(reqs) {
+	case 0:
+		return
+	case 1:
+		reqs[0].done <- reqs[0].solo()
+		return
+	}
+
+	err := sendBatch(reqs)
+	if err == nil {
+		for _, req := range reqs {
+			req.done <- nil
+		}
+		return
+	}
+
+	// one bad search fails the whole request, so retry them one by one to
+	// only fail the sections it belongs to
+	log.Warn("Batched search failed, searching separately", "count", len(reqs), "err", err)
+	for _, req := range reqs {
+		go func() {
+			req.done <- req.solo()
+		}()
+	}
+}
+
+// batchQuery builds the query of reqs, a struct with a field aliasing a search
+// for each of them, and its variables
+func batchQuery(reqs []*searchRequest) (reflect.Value, map[string]any) {
+	fields := make([]reflect.StructField, 0, len(reqs))
+	variables := make(map[string]any, 2*len(reqs))
+	for i, req := range reqs {
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("S%d", i),
+			Type: reflect.TypeOf(req.conn).Elem(),
+			Tag: reflect.StructTag(fmt.Sprintf(
+				`graphql:"s%d: search(type: ISSUE, first: $limit%d, query: $query%d)"`, i, i, i)),
+		})
+		variables[fmt.Sprintf("query%d", i)] = graphql.String(req.query)
+		variables[fmt.Sprintf("limit%d", i)] = graphql.Int(req.limit)
+		// stats don't fetch the trimmable fields, GraphQL rejects variables
+		// that aren't used
+		if _, isStats := req.conn.(*searchConnection[statsNode]); !isStats {
+			withListFields(variables)
+		}
+	}
+	return reflect.New(reflect.StructOf(fields)), variables
+}
+
+func sendBatch(reqs []*searchRequest) error {
+	if err := initClient(); err != nil {
+		return err
+	}
+
+	queryResult, variables := batchQuery(reqs)
+	log.Debug("Fetching batched searches", "count", len(reqs))
+	err := client.Query("SearchSections", queryResult.Interface(), variables)
+	if err != nil {
+		return err
+	}
+	log.Info("Successfully fetched batched searches", "count", len(reqs))
+
+	for i, req := range reqs {
+		reflect.ValueOf(req.conn).Elem().Set(queryResult.Elem().Field(i))
+	}
+	return nil
+}
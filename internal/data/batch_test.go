@@ -0,0 +1,86 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+func TestBatchQuery(t *testing.T) {
+	var prs searchConnection[pullRequestNode]
+	var issues searchConnection[issueNode]
+	reqs := []*searchRequest{
+		{query: "is:pr author:@me", limit: 20, conn: &prs},
+		{query: "is:issue assignee:@me", limit: 5, conn: &issues},
+	}
+
+	queryResult, variables := batchQuery(reqs)
+
+	typ := queryResult.Elem().Type()
+	if typ.NumField() != len(reqs) {
+		t.Fatalf("got %d fields, want %d", typ.NumField(), len(reqs))
+	}
+	wantTags := []string{
+		"s0: search(type: ISSUE, first: $limit0, query: $query0)",
+		"s1: search(type: ISSUE, first: $limit1, query: $query1)",
+	}
+	for i, want := range wantTags {
+		if got := typ.Field(i).Tag.Get("graphql"); got != want {
+			t.Errorf("field %d tag = %q, want %q", i, got, want)
+		}
+	}
+	if typ.Field(0).Type != reflect.TypeOf(prs) || typ.Field(1).Type != reflect.TypeOf(issues) {
+		t.Errorf("fields should keep the connection type of their search")
+	}
+
+	wantVars := map[string]any{
+		"query0": graphql.String("is:pr author:@me"),
+		"limit0": graphql.Int(20),
+		"query1": graphql.String("is:issue assignee:@me"),
+		"limit1": graphql.Int(5),
+		// nothing is trimmed by default
+		"withBody":      graphql.Boolean(true),
+		"withLabels":    graphql.Boolean(true),
+		"withAssignees": graphql.Boolean(true),
+	}
+	if len(variables) != len(wantVars) {
+		t.Fatalf("got %d variables, want %d", len(variables), len(wantVars))
+	}
+	for k, want := range wantVars {
+		if got := variables[k]; got != want {
+			t.Errorf("variable %s = %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestBatchQueryStatsOnly(t *testing.T) {
+	var a, b searchConnection[statsNode]
+	reqs := []*searchRequest{
+		{query: "is:pr author:@me", limit: 1, conn: &a},
+		{query: "is:issue author:@me", limit: 1, conn: &b},
+	}
+
+	_, variables := batchQuery(reqs)
+	if _, ok := variables["withBody"]; ok {
+		t.Errorf("stats shouldn't declare the variables of the trimmable fields, got %v", variables)
+	}
+}
+
+func TestBatchQueryTrimmed(t *testing.T) {
+	SetTrimmedFields([]string{TrimBody, TrimAssignees})
+	t.Cleanup(func() { SetTrimmedFields(nil) })
+
+	var prs searchConnection[pullRequestNode]
+	_, variables := batchQuery([]*searchRequest{{query: "is:pr", limit: 100, conn: &prs}})
+	want := map[string]any{
+		"withBody":      graphql.Boolean(false),
+		"withLabels":    graphql.Boolean(true),
+		"withAssignees": graphql.Boolean(false),
+	}
+	for k, v := range want {
+		if got := variables[k]; got != v {
+			t.Errorf("variable %s = %v, want %v", k, got, v)
+		}
+	}
+}
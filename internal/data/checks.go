@@ -0,0 +1,90 @@
+package data
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// FetchPullRequestChecks fetches the status checks of the last commit of the
+// PR. Unlike FetchPullRequest it skips the cache, so that it can be polled
+// for the live statuses of the checks.
+func FetchPullRequestChecks(prUrl string) (CommitsWithStatusChecks, error) {
+	client, err := newGraphQLClient(forUrl(prUrl, gh.ClientOptions{}))
+	if err != nil {
+		return CommitsWithStatusChecks{}, err
+	}
+
+	var queryResult struct {
+		Resource struct {
+			PullRequest struct {
+				Commits CommitsWithStatusChecks `graphql:"commits(last: 1)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"resource(url: $url)"`
+	}
+	parsedUrl, err := url.Parse(prUrl)
+	if err != nil {
+		return CommitsWithStatusChecks{}, err
+	}
+	variables := map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	}
+	log.Debug("Fetching PR checks", "url", prUrl)
+	err = client.Query("FetchPullRequestChecks", &queryResult, variables)
+	if err != nil {
+		return CommitsWithStatusChecks{}, err
+	}
+	log.Info("Successfully fetched PR checks", "url", prUrl)
+
+	return queryResult.Resource.PullRequest.Commits, nil
+}
+
+// FetchJobLogTail fetches the log of a GitHub Actions job and returns its last
+// n lines
+func FetchJobLogTail(repoNameWithOwner string, jobId int, n int) ([]string, error) {
+	client, err := newRESTClient(gh.ClientOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("Fetching job log", "repo", repoNameWithOwner, "job", jobId)
+	res, err := client.Request(http.MethodGet,
+		fmt.Sprintf("repos/%s/actions/jobs/%d/logs", repoNameWithOwner, jobId), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Successfully fetched job log", "repo", repoNameWithOwner, "job", jobId, "bytes", len(body))
+
+	return TailLog(string(body), n), nil
+}
+
+// TailLog returns the last n non-empty lines of an Actions job log, without
+// the timestamps every line starts with
+func TailLog(jobLog string, n int) []string {
+	var lines []string
+	for line := range strings.SplitSeq(jobLog, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if timestamp, rest, ok := strings.Cut(line, " "); ok {
+			if _, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+				line = rest
+			}
+		}
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines[max(0, len(lines)-n):]
+}
@@ -0,0 +1,38 @@
+package data
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTailLog(t *testing.T) {
+	jobLog := "2024-03-10T12:00:00.1234567Z ##[group]Run go test ./...\r\n" +
+		"2024-03-10T12:00:01.1234567Z --- FAIL: TestFoo (0.00s)\r\n" +
+		"\r\n" +
+		"2024-03-10T12:00:02.1234567Z FAIL\r\n" +
+		"not a timestamp line\r\n"
+
+	tests := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{
+			name: "last lines without timestamps",
+			n:    2,
+			want: []string{"FAIL", "not a timestamp line"},
+		},
+		{
+			name: "fewer lines than asked",
+			n:    10,
+			want: []string{"##[group]Run go test ./...", "--- FAIL: TestFoo (0.00s)", "FAIL", "not a timestamp line"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TailLog(jobLog, tt.n); !slices.Equal(got, tt.want) {
+				t.Errorf("TailLog() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package data
+
+import "time"
+
+// PullRequestFieldNames are the names of the fields of a PR's ColumnFields,
+// in the order they're exported
+var PullRequestFieldNames = []string{
+	"Number", "Title", "Author", "RepoName", "Url", "State", "IsDraft", "ReviewDecision", "Ci",
+	"MergeStateStatus", "Additions", "Deletions", "ChangedFiles", "Comments", "HeadRefName",
+	"BaseRefName", "Labels", "Assignees", "CreatedAt", "UpdatedAt", "ReviewedAt",
+}
+
+// IssueFieldNames are the names of the fields of an issue's ColumnFields, in
+// the order they're exported
+var IssueFieldNames = []string{
+	"Number", "Title", "Author", "RepoName", "Url", "State", "Comments", "Reactions", "Labels",
+	"Assignees", "CreatedAt", "UpdatedAt",
+}
+
+// ColumnFields returns the fields of the PR the templates of computed columns
+// are executed with
+func (data PullRequestData) ColumnFields() map[string]any {
+	ci := ""
+	if len(data.Commits.Nodes) > 0 {
+		ci = string(data.Commits.Nodes[0].Commit.StatusCheckRollup.State)
+	}
+
+	return map[string]any{
+		"Number":           data.Number,
+		"Title":            data.Title,
+		"Author":           data.Author.Login,
+		"RepoName":         data.GetRepoNameWithOwner(),
+		"Url":              data.Url,
+		"State":            data.State,
+		"IsDraft":          data.IsDraft,
+		"ReviewDecision":   data.ReviewDecision,
+		"Ci":               ci,
+		"MergeStateStatus": string(data.MergeStateStatus),
+		"Additions":        data.Additions,
+		"Deletions":        data.Deletions,
+		"ChangedFiles":     data.ChangedFiles,
+		"Comments":         data.Comments.TotalCount + data.ReviewThreads.TotalCount,
+		"HeadRefName":      data.HeadRefName,
+		"BaseRefName":      data.BaseRefName,
+		"Labels":           labelNames(data.Labels.Nodes),
+		"Assignees":        assigneeLogins(data.Assignees),
+		"CreatedAt":        data.CreatedAt,
+		"UpdatedAt":        data.UpdatedAt,
+		"ReviewedAt":       data.reviewedAt(),
+	}
+}
+
+// reviewedAt returns when the PR was last reviewed, the zero time when it
+// wasn't
+func (data PullRequestData) reviewedAt() time.Time {
+	var reviewedAt time.Time
+	for _, review := range data.Reviews.Nodes {
+		if review.UpdatedAt.After(reviewedAt) {
+			reviewedAt = review.UpdatedAt
+		}
+	}
+	return reviewedAt
+}
+
+// ColumnFields returns the fields of the issue the templates of computed
+// columns are executed with
+func (data IssueData) ColumnFields() map[string]any {
+	return map[string]any{
+		"Number":    data.Number,
+		"Title":     data.Title,
+		"Author":    data.Author.Login,
+		"RepoName":  data.GetRepoNameWithOwner(),
+		"Url":       data.Url,
+		"State":     data.State,
+		"Comments":  data.Comments.TotalCount,
+		"Reactions": data.Reactions.TotalCount,
+		"Labels":    labelNames(data.Labels.Nodes),
+		"Assignees": assigneeLogins(data.Assignees),
+		"CreatedAt": data.CreatedAt,
+		"UpdatedAt": data.UpdatedAt,
+	}
+}
+
+func labelNames(labels []Label) []string {
+	names := make([]string, 0, len(labels))
+	for _, l := range labels {
+		names = append(names, l.Name)
+	}
+	return names
+}
+
+func assigneeLogins(assignees Assignees) []string {
+	logins := make([]string, 0, len(assignees.Nodes))
+	for _, a := range assignees.Nodes {
+		logins = append(logins, a.Login)
+	}
+	return logins
+}
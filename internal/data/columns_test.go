@@ -0,0 +1,36 @@
+package data
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestColumnFieldNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]any
+		names  []string
+	}{
+		{
+			name:   "pull request",
+			fields: PullRequestData{}.ColumnFields(),
+			names:  PullRequestFieldNames,
+		},
+		{
+			name:   "issue",
+			fields: IssueData{}.ColumnFields(),
+			names:  IssueFieldNames,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Sorted(maps.Keys(tt.fields))
+			want := slices.Sorted(slices.Values(tt.names))
+			if !slices.Equal(got, want) {
+				t.Errorf("ColumnFields() has the fields %v, the names list %v", got, want)
+			}
+		})
+	}
+}
@@ -18,7 +18,7 @@ func FetchLatestVersion() (VersionResponse, error) {
 	var queryResult VersionResponse
 	var err error
 	if client == nil {
-		client, err = gh.DefaultGraphQLClient()
+		client, err = newGraphQLClient(gh.ClientOptions{})
 	}
 	if err != nil {
 		return VersionResponse{}, err
@@ -62,7 +62,7 @@ func FetchSponsors() (SponsorsResponse, error) {
 	var queryResult SponsorsResponse
 	var err error
 	if client == nil {
-		client, err = gh.DefaultGraphQLClient()
+		client, err = newGraphQLClient(gh.ClientOptions{})
 	}
 	if err != nil {
 		return SponsorsResponse{}, err
@@ -0,0 +1,68 @@
+package data
+
+import (
+	"os"
+	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// Configure sets up the requests made from now on with cfg, e.g. their
+// GraphQL options, the hosts authenticating as a GitHub App and the tokens of
+// the GitLab and Gitea hosts. Every command
+// calls it once the config is loaded.
+func Configure(cfg config.Config) {
+	SetGraphQLOptions(graphQLOptionsFromConfig(cfg.GraphQL))
+	SetAppAuths(appAuthsFromConfig(cfg.Hosts))
+	SetForgeAuths(forgeAuthsFromConfig(cfg.Hosts))
+}
+
+// graphQLOptionsFromConfig returns the options GraphQL requests are made with
+// for cfg
+func graphQLOptionsFromConfig(cfg config.GraphQLConfig) GraphQLOptions {
+	return GraphQLOptions{
+		Features:         cfg.Features,
+		ApiVersion:       cfg.ApiVersion,
+		PersistedQueries: cfg.PersistedQueries,
+		Manifest:         expandHome(cfg.Manifest),
+	}
+}
+
+// appAuthsFromConfig returns the GitHub App installations of the hosts
+// configured to authenticate as one, by host
+func appAuthsFromConfig(hosts []config.HostConfig) map[string]AppAuth {
+	apps := map[string]AppAuth{}
+	for _, host := range hosts {
+		if host.Auth != "app" || host.App == nil {
+			continue
+		}
+		apps[host.Host] = AppAuth{
+			AppId:          host.App.AppId,
+			InstallationId: host.App.InstallationId,
+			PrivateKeyPath: expandHome(host.App.PrivateKeyPath),
+		}
+	}
+	return apps
+}
+
+// forgeAuthsFromConfig returns how the GitLab and Gitea hosts authenticate,
+// by host
+func forgeAuthsFromConfig(hosts []config.HostConfig) map[string]ForgeAuth {
+	auths := map[string]ForgeAuth{}
+	for _, host := range hosts {
+		if host.TokenEnv == "" && !host.AllowHttp {
+			continue
+		}
+		auths[host.Host] = ForgeAuth{TokenEnv: host.TokenEnv, AllowHttp: host.AllowHttp}
+	}
+	return auths
+}
+
+// expandHome replaces the ~ a path starts with by the home directory
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~") {
+		return path
+	}
+	home, _ := os.UserHomeDir()
+	return strings.Replace(path, "~", home, 1)
+}
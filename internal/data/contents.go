@@ -0,0 +1,111 @@
+package data
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+)
+
+// FileContents is a file of a repo read with the contents API, or the
+// entries of a directory
+type FileContents struct {
+	Repo    string
+	Path    string
+	Content string
+	// IsBinary is set for the files that aren't text, their Content is empty
+	IsBinary bool
+	// IsDir is set when Path is a directory, whose entries are in Entries
+	IsDir bool
+	// Entries are the paths of the files of the directory, the ones of
+	// directories end with a slash
+	Entries []string
+}
+
+// Url returns the file on the default branch of the repo
+func (c FileContents) Url() string {
+	kind := "blob"
+	if c.IsDir {
+		kind = "tree"
+	}
+	return fmt.Sprintf("https://github.com/%s/%s/HEAD/%s", c.Repo, kind, c.Path)
+}
+
+type contentsEntry struct {
+	Type     string `json:"type"`
+	Path     string `json:"path"`
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+}
+
+// FetchFileContents fetches the file, or the directory, at filePath on the
+// default branch of repo, as owner/name, without cloning it
+func FetchFileContents(repo string, filePath string) (FileContents, error) {
+	client, err := newRESTClient(gh.ClientOptions{})
+	if err != nil {
+		return FileContents{}, err
+	}
+	return fetchContents(client, repo, filePath)
+}
+
+func fetchContents(client *gh.RESTClient, repo string, filePath string) (FileContents, error) {
+	filePath = strings.Trim(path.Clean("/"+filePath), "/")
+	var body json.RawMessage
+	contentsPath := fmt.Sprintf("repos/%s/contents/%s", repo, (&url.URL{Path: filePath}).EscapedPath())
+	log.Debug("Fetching contents", "repo", repo, "path", filePath)
+	if err := client.Get(contentsPath, &body); err != nil {
+		return FileContents{}, err
+	}
+	return parseContents(repo, filePath, body)
+}
+
+// parseContents parses the response of the contents API for the file or the
+// directory at filePath
+func parseContents(repo string, filePath string, body []byte) (FileContents, error) {
+	contents := FileContents{Repo: repo, Path: filePath}
+
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		var entries []contentsEntry
+		if err := json.Unmarshal(trimmed, &entries); err != nil {
+			return FileContents{}, fmt.Errorf("parsing the entries of %s: %w", filePath, err)
+		}
+		contents.IsDir = true
+		for _, entry := range entries {
+			name := entry.Path
+			if entry.Type == "dir" {
+				name += "/"
+			}
+			contents.Entries = append(contents.Entries, name)
+		}
+		return contents, nil
+	}
+
+	var entry contentsEntry
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return FileContents{}, fmt.Errorf("parsing %s: %w", filePath, err)
+	}
+	switch {
+	case entry.Type != "file":
+		return FileContents{}, fmt.Errorf("%s is a %s, not a file", filePath, entry.Type)
+	case entry.Encoding == "none":
+		return FileContents{}, fmt.Errorf("%s is too large to view", filePath)
+	case entry.Encoding != "base64":
+		return FileContents{}, fmt.Errorf("unsupported encoding %q", entry.Encoding)
+	}
+	content, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(entry.Content, "\n", ""))
+	if err != nil {
+		return FileContents{}, err
+	}
+	if bytes.IndexByte(content, 0) != -1 {
+		contents.IsBinary = true
+		return contents, nil
+	}
+	contents.Content = string(content)
+	return contents, nil
+}
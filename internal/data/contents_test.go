@@ -0,0 +1,67 @@
+package data
+
+import (
+	"encoding/base64"
+	"slices"
+	"testing"
+)
+
+func TestParseContents(t *testing.T) {
+	encode := func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	}
+	tests := []struct {
+		name        string
+		body        string
+		wantContent string
+		wantEntries []string
+		wantBinary  bool
+		wantErr     bool
+	}{
+		{
+			name:        "file",
+			body:        `{"type": "file", "path": "CODEOWNERS", "encoding": "base64", "content": "` + encode("* @dlvhdr\n") + `"}`,
+			wantContent: "* @dlvhdr\n",
+		},
+		{
+			name: "directory",
+			body: `[{"type": "file", "path": ".github/CODEOWNERS"}, {"type": "dir", "path": ".github/workflows"}]`,
+			wantEntries: []string{
+				".github/CODEOWNERS",
+				".github/workflows/",
+			},
+		},
+		{
+			name:       "binary file",
+			body:       `{"type": "file", "path": "logo.png", "encoding": "base64", "content": "` + encode("\x89PNG\x00\x01") + `"}`,
+			wantBinary: true,
+		},
+		{
+			name:    "too large",
+			body:    `{"type": "file", "path": "big.json", "encoding": "none", "content": ""}`,
+			wantErr: true,
+		},
+		{
+			name:    "submodule",
+			body:    `{"type": "submodule", "path": "vendor/lib"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseContents("dlvhdr/gh-dash", "path", []byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseContents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Content != tt.wantContent {
+				t.Errorf("parseContents() content = %q, want %q", got.Content, tt.wantContent)
+			}
+			if !slices.Equal(got.Entries, tt.wantEntries) {
+				t.Errorf("parseContents() entries = %v, want %v", got.Entries, tt.wantEntries)
+			}
+			if got.IsBinary != tt.wantBinary {
+				t.Errorf("parseContents() binary = %v, want %v", got.IsBinary, tt.wantBinary)
+			}
+		})
+	}
+}
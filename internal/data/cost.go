@@ -0,0 +1,78 @@
+package data
+
+import (
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// connectionArgPattern matches the page size of a connection in a graphql
+	// struct tag, e.g. labels(first: 6)
+	connectionArgPattern = regexp.MustCompile(`\((?:.*[ ,])?(?:first|last): (\d+)`)
+	// includePattern matches the @include directive of a field that's left
+	// out of the list queries when trimmed, e.g. @include(if: $withLabels)
+	includePattern = regexp.MustCompile(`@include\(if: \$with(\w+)\)`)
+	// skipPattern matches the @skip directive of a field only fetched when
+	// it's trimmed from the list queries
+	skipPattern = regexp.MustCompile(`@skip\(if: \$with\w+\)`)
+)
+
+// PullRequestsSearchCost estimates the rate limit points a search for limit
+// PRs costs, with the trimmed fields left out
+func PullRequestsSearchCost(limit int, trimmed []string) int {
+	return searchCost(reflect.TypeFor[PullRequestData](), limit, trimmed)
+}
+
+// IssuesSearchCost estimates the rate limit points a search for limit issues
+// costs, with the trimmed fields left out
+func IssuesSearchCost(limit int, trimmed []string) int {
+	return searchCost(reflect.TypeFor[IssueData](), limit, trimmed)
+}
+
+// searchCost follows GitHub's formula for the cost of a search of rows of
+// type node: every connection takes one request per node it's nested in, the
+// search itself one, and the requests divided by 100 and rounded are the
+// points it costs, at least 1
+func searchCost(node reflect.Type, limit int, trimmed []string) int {
+	requests := 1 + connectionRequests(node, limit, trimmed)
+	return max(1, (requests+50)/100)
+}
+
+// connectionRequests counts the requests the connections of t take when t is
+// fetched for parents nodes
+func connectionRequests(t reflect.Type, parents int, trimmed []string) int {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return 0
+	}
+
+	requests := 0
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("graphql")
+		if skipPattern.MatchString(tag) {
+			continue
+		}
+		if m := includePattern.FindStringSubmatch(tag); m != nil &&
+			slices.ContainsFunc(trimmed, func(f string) bool { return strings.EqualFold(f, m[1]) }) {
+			continue
+		}
+
+		nodes := parents
+		if m := connectionArgPattern.FindStringSubmatch(tag); m != nil {
+			pageSize, _ := strconv.Atoi(m[1])
+			requests += parents
+			nodes = parents * pageSize
+		}
+		requests += connectionRequests(field.Type, nodes, trimmed)
+	}
+	return requests
+}
@@ -0,0 +1,64 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+)
+
+type costTestNode struct {
+	Title  string
+	Labels struct {
+		Nodes []struct{ Name string }
+	} `graphql:"labels(first: 5) @include(if: $withLabels)"`
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				Deployments struct {
+					TotalCount int
+				} `graphql:"deployments(last: 10)"`
+			}
+		}
+	} `graphql:"commits(last: 2)"`
+	Comments struct {
+		TotalCount int
+	} `graphql:"comments"`
+	Body string `graphql:"body @skip(if: $withBody)"`
+}
+
+func TestSearchCost(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   int
+		trimmed []string
+		want    int
+	}{
+		{
+			// 1 search + 10 labels + 10 commits + 20 deployments
+			name:  "small search costs the minimum",
+			limit: 10,
+			want:  1,
+		},
+		{
+			// 1 search + 100 labels + 100 commits + 200 deployments
+			name:  "connections nested in connections",
+			limit: 100,
+			want:  4,
+		},
+		{
+			// 1 search + 100 commits + 200 deployments
+			name:    "trimmed connections are left out",
+			limit:   100,
+			trimmed: []string{"labels"},
+			want:    3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := searchCost(reflect.TypeFor[costTestNode](), tt.limit, tt.trimmed)
+			if got != tt.want {
+				t.Errorf("searchCost() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+)
+
+// NewItem describes a PR or an issue to create
+type NewItem struct {
+	IsPR bool
+	// Repo is the owner/name of the repo to create the item in
+	Repo      string
+	Title     string
+	Body      string
+	Labels    []string
+	Assignees []string
+	// Base and Head are the branches of a PR, Head may be prefixed with the
+	// owner of a fork, e.g. octocat:fix-typo
+	Base  string
+	Head  string
+	Draft bool
+}
+
+// CreateItem creates the PR or issue and fetches it back, returning either a
+// *PullRequestData or an *IssueData
+func CreateItem(item NewItem) (RowData, error) {
+	client, err := newRESTClient(gh.ClientOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	assignees, err := resolveMe(item.Assignees)
+	if err != nil {
+		return nil, err
+	}
+
+	var created struct {
+		Number  int    `json:"number"`
+		HtmlUrl string `json:"html_url"`
+	}
+	if item.IsPR {
+		log.Debug("Creating PR", "repo", item.Repo, "head", item.Head, "base", item.Base)
+		err = restRequest(client, http.MethodPost, fmt.Sprintf("repos/%s/pulls", item.Repo), map[string]any{
+			"title": item.Title,
+			"body":  item.Body,
+			"head":  item.Head,
+			"base":  item.Base,
+			"draft": item.Draft,
+		}, &created)
+		if err != nil {
+			return nil, err
+		}
+
+		// PRs are issues too, their labels and assignees are set through the
+		// issues API
+		if len(item.Labels) > 0 || len(assignees) > 0 {
+			err = restRequest(client, http.MethodPatch, fmt.Sprintf("repos/%s/issues/%d", item.Repo, created.Number), map[string]any{
+				"labels":    item.Labels,
+				"assignees": assignees,
+			}, nil)
+			if err != nil {
+				return nil, fmt.Errorf("created %s but failed setting its labels and assignees: %w", created.HtmlUrl, err)
+			}
+		}
+	} else {
+		log.Debug("Creating issue", "repo", item.Repo)
+		err = restRequest(client, http.MethodPost, fmt.Sprintf("repos/%s/issues", item.Repo), map[string]any{
+			"title":     item.Title,
+			"body":      item.Body,
+			"labels":    item.Labels,
+			"assignees": assignees,
+		}, &created)
+		if err != nil {
+			return nil, err
+		}
+	}
+	log.Info("Successfully created item", "url", created.HtmlUrl)
+
+	link, err := ParseItemUrl(created.HtmlUrl)
+	if err != nil {
+		return nil, err
+	}
+	return FetchItem(link)
+}
+
+// resolveMe replaces @me with the login of the current user, the REST API
+// only takes logins
+func resolveMe(logins []string) ([]string, error) {
+	resolved := make([]string, 0, len(logins))
+	for _, login := range logins {
+		if login == "@me" {
+			me, err := CurrentLoginName()
+			if err != nil {
+				return nil, err
+			}
+			login = me
+		}
+		resolved = append(resolved, strings.TrimPrefix(login, "@"))
+	}
+	return resolved, nil
+}
+
+func restRequest(client *gh.RESTClient, method string, path string, body map[string]any, response any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return client.Do(method, path, bytes.NewReader(payload), response)
+}
@@ -0,0 +1,363 @@
+package data
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// DefaultManifest is the manifest read when a dependency check configures
+// none
+const DefaultManifest = "go.mod"
+
+// DependencyUpdatePR is an open PR updating a dependency
+type DependencyUpdatePR struct {
+	Number int
+	Title  string
+	Url    string
+}
+
+// DependencyStatus is the version of a dependency a repo is on
+type DependencyStatus struct {
+	Repo       string
+	Dependency string
+	Manifest   string
+	// Version is the version required by the manifest, empty if the
+	// manifest doesn't have the dependency
+	Version string
+	// Target is the version the repos should be on
+	Target    string
+	UpdatePRs []DependencyUpdatePR
+}
+
+// IsMissing returns whether the manifest of the repo doesn't have the
+// dependency
+func (data DependencyStatus) IsMissing() bool {
+	return data.Version == ""
+}
+
+// IsBehind returns whether the repo is on an older version than the target
+func (data DependencyStatus) IsBehind() bool {
+	return !data.IsMissing() && data.Target != "" && CompareVersions(data.Version, data.Target) < 0
+}
+
+// ManifestUrl returns the manifest of the repo on its default branch
+func (data DependencyStatus) ManifestUrl() string {
+	return fmt.Sprintf("https://github.com/%s/blob/HEAD/%s", data.Repo, data.Manifest)
+}
+
+// GetFilePath returns the manifest the dependency is read from
+func (data DependencyStatus) GetFilePath() string {
+	return data.Manifest
+}
+
+func (data DependencyStatus) GetTitle() string {
+	return data.Repo
+}
+
+func (data DependencyStatus) GetRepoNameWithOwner() string {
+	return data.Repo
+}
+
+func (data DependencyStatus) GetNumber() int {
+	if len(data.UpdatePRs) > 0 {
+		return data.UpdatePRs[0].Number
+	}
+	return 0
+}
+
+// GetUrl returns the first open update PR, the manifest when there's none
+func (data DependencyStatus) GetUrl() string {
+	if len(data.UpdatePRs) > 0 {
+		return data.UpdatePRs[0].Url
+	}
+	return data.ManifestUrl()
+}
+
+func (data DependencyStatus) GetUpdatedAt() time.Time {
+	return time.Time{}
+}
+
+// normalizeVersion strips the range operators and the v prefix of version
+func normalizeVersion(version string) string {
+	version = strings.TrimSpace(version)
+	version = strings.TrimLeft(version, "^~=<>! ")
+	return strings.TrimPrefix(version, "v")
+}
+
+// CompareVersions compares the dot separated versions a and b numerically,
+// a pre-release comes before its release. Range operators like ^ and ~ are
+// ignored.
+func CompareVersions(a, b string) int {
+	a, aPre, aHasPre := strings.Cut(normalizeVersion(a), "-")
+	b, bPre, bHasPre := strings.Cut(normalizeVersion(b), "-")
+	a, _, _ = strings.Cut(a, "+")
+	b, _, _ = strings.Cut(b, "+")
+
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range max(len(aParts), len(bParts)) {
+		var aPart, bPart string
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+		aNum, aErr := strconv.Atoi(cmp.Or(aPart, "0"))
+		bNum, bErr := strconv.Atoi(cmp.Or(bPart, "0"))
+		if aErr != nil || bErr != nil {
+			if c := strings.Compare(aPart, bPart); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := cmp.Compare(aNum, bNum); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case aHasPre && !bHasPre:
+		return -1
+	case !aHasPre && bHasPre:
+		return 1
+	}
+	return strings.Compare(aPre, bPre)
+}
+
+// LatestVersion returns the newest version the repos are on
+func LatestVersion(statuses []DependencyStatus) string {
+	latest := ""
+	for _, status := range statuses {
+		if status.IsMissing() {
+			continue
+		}
+		if latest == "" || CompareVersions(status.Version, latest) > 0 {
+			latest = status.Version
+		}
+	}
+	return latest
+}
+
+// ParseDependencyVersion returns the version of dependency required by the
+// manifest called manifest, whose content is content. The go.mod,
+// package.json and requirements.txt manifests are supported.
+func ParseDependencyVersion(manifest string, content string, dependency string) (string, error) {
+	name := path.Base(manifest)
+	switch {
+	case name == "go.mod":
+		return parseGoModVersion(content, dependency), nil
+	case name == "package.json":
+		return parsePackageJsonVersion(content, dependency)
+	case strings.HasPrefix(name, "requirements") && strings.HasSuffix(name, ".txt"):
+		return parseRequirementsVersion(content, dependency), nil
+	}
+	return "", fmt.Errorf("unsupported manifest %q", manifest)
+}
+
+func parseGoModVersion(content string, dependency string) string {
+	inRequire := false
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "//")
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case fields[0] == "require" && len(fields) > 1 && fields[1] == "(":
+			inRequire = true
+			continue
+		case inRequire && fields[0] == ")":
+			inRequire = false
+			continue
+		case fields[0] == "require":
+			fields = fields[1:]
+		case !inRequire:
+			continue
+		}
+		if len(fields) >= 2 && fields[0] == dependency {
+			return fields[1]
+		}
+	}
+	return ""
+}
+
+func parsePackageJsonVersion(content string, dependency string) (string, error) {
+	var pkg map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(content), &pkg); err != nil {
+		return "", fmt.Errorf("parsing package.json: %w", err)
+	}
+	for _, field := range []string{"dependencies", "devDependencies", "peerDependencies", "optionalDependencies"} {
+		raw, ok := pkg[field]
+		if !ok {
+			continue
+		}
+		var deps map[string]string
+		if err := json.Unmarshal(raw, &deps); err != nil {
+			return "", fmt.Errorf("parsing %s of package.json: %w", field, err)
+		}
+		if version, ok := deps[dependency]; ok {
+			return version, nil
+		}
+	}
+	return "", nil
+}
+
+func parseRequirementsVersion(content string, dependency string) string {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.TrimSpace(line)
+		i := strings.IndexAny(line, "=<>!~ ;[")
+		if i == -1 {
+			continue
+		}
+		if !strings.EqualFold(strings.TrimSpace(line[:i]), dependency) {
+			continue
+		}
+		spec, _, _ := strings.Cut(line[i:], ";")
+		spec, _, _ = strings.Cut(spec, ",")
+		if _, rest, ok := strings.Cut(spec, "]"); ok {
+			spec = rest
+		}
+		return strings.TrimSpace(spec)
+	}
+	return ""
+}
+
+// FetchDependencyMatrix fetches the version of dependency in the manifest
+// of each of repos, as owner/name, at once, along with the open PRs
+// updating it. The target of the statuses is version, the latest version of
+// the repos when empty. The repos whose manifest was read are returned along
+// with the errors of the others.
+func FetchDependencyMatrix(dependency, manifest, version string, repos []string) ([]DependencyStatus, error) {
+	if manifest == "" {
+		manifest = DefaultManifest
+	}
+	client, err := newRESTClient(gh.ClientOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := initClient(); err != nil {
+		return nil, err
+	}
+
+	fetched := make([]DependencyStatus, len(repos))
+	errs := make([]error, len(repos)+1)
+	var updatePRs map[string][]DependencyUpdatePR
+	var wg sync.WaitGroup
+	for i, repo := range repos {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fetched[i], errs[i] = fetchDependencyStatus(client, repo, dependency, manifest)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		updatePRs, errs[len(repos)] = fetchDependencyUpdatePRs(repos, dependency)
+	}()
+	wg.Wait()
+
+	statuses := make([]DependencyStatus, 0, len(repos))
+	for i, status := range fetched {
+		if errs[i] != nil {
+			errs[i] = fmt.Errorf("reading %s of %s: %w", manifest, repos[i], errs[i])
+			continue
+		}
+		status.UpdatePRs = updatePRs[strings.ToLower(status.Repo)]
+		statuses = append(statuses, status)
+	}
+
+	target := version
+	if target == "" {
+		target = LatestVersion(statuses)
+	}
+	for i := range statuses {
+		statuses[i].Target = target
+	}
+	return statuses, errors.Join(errs...)
+}
+
+func fetchDependencyStatus(client *gh.RESTClient, repo, dependency, manifest string) (DependencyStatus, error) {
+	contents, err := fetchContents(client, repo, manifest)
+	if err != nil {
+		return DependencyStatus{}, err
+	}
+
+	version, err := ParseDependencyVersion(manifest, contents.Content, dependency)
+	if err != nil {
+		return DependencyStatus{}, err
+	}
+	return DependencyStatus{
+		Repo:       repo,
+		Dependency: dependency,
+		Manifest:   manifest,
+		Version:    version,
+	}, nil
+}
+
+// updatePRSearch returns the search of the open PRs of repos with
+// dependency in their title, like the ones of Dependabot and Renovate
+func updatePRSearch(repos []string, dependency string) string {
+	terms := []string{"is:pr", "is:open", "archived:false", "in:title", strconv.Quote(dependency)}
+	for _, repo := range repos {
+		terms = append(terms, "repo:"+repo)
+	}
+	return strings.Join(terms, " ")
+}
+
+// fetchDependencyUpdatePRs fetches the PRs updating dependency in repos, by
+// their lowercased owner/name
+func fetchDependencyUpdatePRs(repos []string, dependency string) (map[string][]DependencyUpdatePR, error) {
+	if len(repos) == 0 {
+		return nil, nil
+	}
+
+	var queryResult struct {
+		Search struct {
+			Nodes []struct {
+				PullRequest struct {
+					Number     int
+					Title      string
+					Url        string
+					Repository struct {
+						NameWithOwner string
+					}
+				} `graphql:"... on PullRequest"`
+			}
+		} `graphql:"search(type: ISSUE, first: 100, query: $query)"`
+	}
+	query := updatePRSearch(repos, dependency)
+	variables := map[string]any{
+		"query": graphql.String(query),
+	}
+	log.Debug("Fetching dependency update PRs", "query", query)
+	if err := client.Query("FetchDependencyUpdatePRs", &queryResult, variables); err != nil {
+		return nil, fmt.Errorf("fetching update PRs of %s: %w", dependency, err)
+	}
+
+	prs := map[string][]DependencyUpdatePR{}
+	for _, node := range queryResult.Search.Nodes {
+		pr := node.PullRequest
+		repo := strings.ToLower(pr.Repository.NameWithOwner)
+		prs[repo] = append(prs[repo], DependencyUpdatePR{
+			Number: pr.Number,
+			Title:  pr.Title,
+			Url:    pr.Url,
+		})
+	}
+	return prs, nil
+}
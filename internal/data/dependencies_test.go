@@ -0,0 +1,150 @@
+package data
+
+import "testing"
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "v1.2.3", b: "v1.2.3", want: 0},
+		{a: "v1.2.3", b: "1.2.3", want: 0},
+		{a: "v1.2.3", b: "v1.10.0", want: -1},
+		{a: "v2.0.0", b: "v1.99.99", want: 1},
+		{a: "1.2", b: "1.2.0", want: 0},
+		{a: "^1.4.0", b: "~1.3.9", want: 1},
+		{a: ">=2.31", b: "==2.31.0", want: 0},
+		{a: "v1.0.0-rc.1", b: "v1.0.0", want: -1},
+		{a: "v1.0.0-beta", b: "v1.0.0-alpha", want: 1},
+		{a: "v0.0.0-20240101000000-abcdef", b: "v0.0.0-20250101000000-123456", want: -1},
+		{a: "v1.0.0+incompatible", b: "v1.0.0", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if got := CompareVersions(tt.a, tt.b); got != tt.want {
+				t.Errorf("CompareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDependencyVersion(t *testing.T) {
+	goMod := `module github.com/dlvhdr/gh-dash/v4
+
+go 1.23
+
+require github.com/charmbracelet/log v0.4.0
+
+require (
+	github.com/charmbracelet/bubbletea v1.3.4 // indirect
+	github.com/cli/go-gh/v2 v2.11.2
+)
+`
+	packageJson := `{
+  "dependencies": {"react": "^18.2.0"},
+  "devDependencies": {"typescript": "~5.4.0"}
+}`
+	requirements := `# tooling
+requests[security]==2.31.0 ; python_version > "3.8"
+Django>=4.2,<5
+`
+	tests := []struct {
+		name       string
+		manifest   string
+		content    string
+		dependency string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "go.mod single require",
+			manifest:   "go.mod",
+			content:    goMod,
+			dependency: "github.com/charmbracelet/log",
+			want:       "v0.4.0",
+		},
+		{
+			name:       "go.mod require block",
+			manifest:   "go.mod",
+			content:    goMod,
+			dependency: "github.com/charmbracelet/bubbletea",
+			want:       "v1.3.4",
+		},
+		{
+			name:       "go.mod in a subdirectory",
+			manifest:   "tools/go.mod",
+			content:    goMod,
+			dependency: "github.com/cli/go-gh/v2",
+			want:       "v2.11.2",
+		},
+		{
+			name:       "go.mod missing",
+			manifest:   "go.mod",
+			content:    goMod,
+			dependency: "github.com/charmbracelet/lipgloss",
+			want:       "",
+		},
+		{
+			name:       "package.json dev dependency",
+			manifest:   "package.json",
+			content:    packageJson,
+			dependency: "typescript",
+			want:       "~5.4.0",
+		},
+		{
+			name:       "requirements with extras and markers",
+			manifest:   "requirements.txt",
+			content:    requirements,
+			dependency: "requests",
+			want:       "==2.31.0",
+		},
+		{
+			name:       "requirements ignores case",
+			manifest:   "requirements-dev.txt",
+			content:    requirements,
+			dependency: "django",
+			want:       ">=4.2",
+		},
+		{
+			name:       "unsupported manifest",
+			manifest:   "Cargo.toml",
+			content:    "",
+			dependency: "serde",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDependencyVersion(tt.manifest, tt.content, tt.dependency)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDependencyVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDependencyVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDependencyStatusIsBehind(t *testing.T) {
+	statuses := []DependencyStatus{
+		{Repo: "a/a", Version: "v1.2.0"},
+		{Repo: "b/b", Version: "v1.10.0"},
+		{Repo: "c/c"},
+	}
+	if got := LatestVersion(statuses); got != "v1.10.0" {
+		t.Fatalf("LatestVersion() = %q, want %q", got, "v1.10.0")
+	}
+	for i := range statuses {
+		statuses[i].Target = "v1.10.0"
+	}
+	if !statuses[0].IsBehind() {
+		t.Errorf("%s IsBehind() = false, want true", statuses[0].Repo)
+	}
+	if statuses[1].IsBehind() {
+		t.Errorf("%s IsBehind() = true, want false", statuses[1].Repo)
+	}
+	if statuses[2].IsBehind() {
+		t.Errorf("%s IsBehind() = true, want false for a missing dependency", statuses[2].Repo)
+	}
+}
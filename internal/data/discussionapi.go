@@ -0,0 +1,174 @@
+package data
+
+import (
+	"time"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+)
+
+type DiscussionData struct {
+	Id          string
+	Number      int
+	Title       string
+	Body        string
+	Url         string
+	Closed      bool
+	IsAnswered  bool
+	UpvoteCount int
+	Author      struct {
+		Login string
+	}
+	Category struct {
+		Name string
+	}
+	Repository struct {
+		Name          string
+		NameWithOwner string
+		IsArchived    bool
+	}
+	Comments  DiscussionComments `graphql:"comments(last: 15)"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type DiscussionComments struct {
+	Nodes      []DiscussionComment
+	TotalCount int
+}
+
+type DiscussionComment struct {
+	Id     string
+	Author struct {
+		Login string
+	}
+	Body      string
+	IsAnswer  bool
+	UpdatedAt time.Time
+}
+
+func (data DiscussionData) GetTitle() string {
+	return data.Title
+}
+
+func (data DiscussionData) GetRepoNameWithOwner() string {
+	return data.Repository.NameWithOwner
+}
+
+func (data DiscussionData) GetNumber() int {
+	return data.Number
+}
+
+func (data DiscussionData) GetUrl() string {
+	return data.Url
+}
+
+func (data DiscussionData) GetUpdatedAt() time.Time {
+	return data.UpdatedAt
+}
+
+// SetAnswer marks the comment with commentId as the answer of the
+// discussion, unmarking the previous answer
+func (data *DiscussionData) SetAnswer(commentId string) {
+	for i := range data.Comments.Nodes {
+		data.Comments.Nodes[i].IsAnswer = data.Comments.Nodes[i].Id == commentId
+	}
+	data.IsAnswered = true
+}
+
+type discussionNode struct {
+	Discussion DiscussionData `graphql:"... on Discussion"`
+}
+
+type DiscussionsResponse struct {
+	Discussions []DiscussionData
+	TotalCount  int
+	PageInfo    PageInfo
+}
+
+func FetchDiscussions(query string, limit int, pageInfo *PageInfo) (DiscussionsResponse, error) {
+	if err := initClient(); err != nil {
+		return DiscussionsResponse{}, err
+	}
+
+	var queryResult struct {
+		Search struct {
+			Nodes           []discussionNode
+			DiscussionCount int
+			PageInfo        PageInfo
+		} `graphql:"search(type: DISCUSSION, first: $limit, after: $endCursor, query: $query)"`
+	}
+	var endCursor *string
+	if pageInfo != nil {
+		endCursor = &pageInfo.EndCursor
+	}
+	variables := map[string]any{
+		"query":     graphql.String(query),
+		"limit":     graphql.Int(limit),
+		"endCursor": (*graphql.String)(endCursor),
+	}
+	log.Debug("Fetching discussions", "query", query, "limit", limit, "endCursor", endCursor)
+	err := client.Query("SearchDiscussions", &queryResult, variables)
+	if err != nil {
+		return DiscussionsResponse{}, err
+	}
+	log.Info("Successfully fetched discussions", "query", query, "count", queryResult.Search.DiscussionCount)
+
+	discussions := make([]DiscussionData, 0, len(queryResult.Search.Nodes))
+	for _, node := range queryResult.Search.Nodes {
+		if node.Discussion.Repository.IsArchived {
+			continue
+		}
+		discussions = append(discussions, node.Discussion)
+	}
+
+	return DiscussionsResponse{
+		Discussions: discussions,
+		TotalCount:  queryResult.Search.DiscussionCount,
+		PageInfo:    queryResult.Search.PageInfo,
+	}, nil
+}
+
+// AddDiscussionComment comments body on the discussion with discussionId
+func AddDiscussionComment(discussionId string, body string) (DiscussionComment, error) {
+	client, err := newGraphQLClient(gh.ClientOptions{})
+	if err != nil {
+		return DiscussionComment{}, err
+	}
+
+	var mutation struct {
+		AddDiscussionComment struct {
+			Comment DiscussionComment
+		} `graphql:"addDiscussionComment(input: $input)"`
+	}
+	input := githubv4.AddDiscussionCommentInput{
+		DiscussionID: discussionId,
+		Body:         githubv4.String(body),
+	}
+	log.Debug("Commenting on discussion", "id", discussionId)
+	err = client.Mutate("AddDiscussionComment", &mutation, map[string]any{"input": input})
+	if err != nil {
+		return DiscussionComment{}, err
+	}
+	return mutation.AddDiscussionComment.Comment, nil
+}
+
+// MarkDiscussionAnswer marks the discussion comment with commentId as the
+// answer of its discussion, which needs a category that accepts answers
+func MarkDiscussionAnswer(commentId string) error {
+	client, err := newGraphQLClient(gh.ClientOptions{})
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		MarkDiscussionCommentAsAnswer struct {
+			ClientMutationId *string
+		} `graphql:"markDiscussionCommentAsAnswer(input: $input)"`
+	}
+	input := githubv4.MarkDiscussionCommentAsAnswerInput{ID: commentId}
+	log.Debug("Marking discussion answer", "id", commentId)
+	return client.Mutate("MarkDiscussionAnswer", &mutation, map[string]any{"input": input})
+}
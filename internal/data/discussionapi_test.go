@@ -0,0 +1,23 @@
+package data
+
+import "testing"
+
+func TestDiscussionSetAnswer(t *testing.T) {
+	d := DiscussionData{}
+	d.Comments.Nodes = []DiscussionComment{
+		{Id: "a", IsAnswer: true},
+		{Id: "b"},
+		{Id: "c"},
+	}
+
+	d.SetAnswer("c")
+
+	if !d.IsAnswered {
+		t.Error("IsAnswered = false after SetAnswer()")
+	}
+	for _, c := range d.Comments.Nodes {
+		if want := c.Id == "c"; c.IsAnswer != want {
+			t.Errorf("comment %s IsAnswer = %v, want %v", c.Id, c.IsAnswer, want)
+		}
+	}
+}
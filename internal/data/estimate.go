@@ -0,0 +1,208 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+type estimateProjectField struct {
+	Field struct {
+		Id       string
+		Name     string
+		DataType string
+	} `graphql:"... on ProjectV2Field"`
+}
+
+type estimateProject struct {
+	Id     string
+	Url    string
+	Fields struct {
+		Nodes []estimateProjectField
+	} `graphql:"fields(first: 50)"`
+}
+
+// numberField returns the id of the number field called name, ignoring case
+func (p estimateProject) numberField(name string) (string, bool) {
+	for _, f := range p.Fields.Nodes {
+		if f.Field.DataType == "NUMBER" && strings.EqualFold(f.Field.Name, name) {
+			return f.Field.Id, true
+		}
+	}
+	return "", false
+}
+
+type estimateItem struct {
+	Id      string
+	Project estimateProject
+}
+
+// estimateTarget is where the estimate of an issue is written, the item is
+// empty when the issue isn't in the project yet
+type estimateTarget struct {
+	projectId string
+	itemId    string
+	fieldId   string
+}
+
+// findEstimateTarget returns the item of the issue in the project of field
+// that has the field, see config.EstimateField
+func findEstimateTarget(items []estimateItem, field config.EstimateField) (estimateTarget, bool) {
+	for _, item := range items {
+		if field.Project != "" && !isProject(item.Project.Url, field.Project) {
+			continue
+		}
+		if fieldId, ok := item.Project.numberField(field.Field); ok {
+			return estimateTarget{projectId: item.Project.Id, itemId: item.Id, fieldId: fieldId}, true
+		}
+	}
+	return estimateTarget{}, false
+}
+
+// SetEstimate sets the estimate of the issue at issueUrl to value, or clears
+// it when value is nil. The issue is added to the project of the field if
+// it isn't in it. The token needs the project scope.
+func SetEstimate(issueUrl string, field config.EstimateField, value *float64) error {
+	if field.Field == "" {
+		return errors.New("no estimate field is configured for this repo")
+	}
+	client, err := newGraphQLClient(forUrl(issueUrl, gh.ClientOptions{}))
+	if err != nil {
+		return err
+	}
+
+	parsedUrl, err := url.Parse(issueUrl)
+	if err != nil {
+		return err
+	}
+	var queryResult struct {
+		Resource struct {
+			Issue struct {
+				Id           string
+				ProjectItems struct {
+					Nodes []estimateItem
+				} `graphql:"projectItems(first: 10)"`
+			} `graphql:"... on Issue"`
+		} `graphql:"resource(url: $url)"`
+	}
+	variables := map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	}
+	log.Debug("Fetching project items of issue", "url", issueUrl)
+	if err := client.Query("FetchIssueProjectItems", &queryResult, variables); err != nil {
+		return err
+	}
+
+	issue := queryResult.Resource.Issue
+	target, ok := findEstimateTarget(issue.ProjectItems.Nodes, field)
+	if !ok {
+		if field.Project == "" {
+			return fmt.Errorf("the issue isn't in a project with a number field called %q", field.Field)
+		}
+		target, err = addToEstimateProject(client, issue.Id, field)
+		if err != nil {
+			return err
+		}
+	}
+
+	if value == nil {
+		var mutation struct {
+			ClearProjectV2ItemFieldValue struct {
+				ClientMutationId *string
+			} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+		}
+		input := githubv4.ClearProjectV2ItemFieldValueInput{
+			ProjectID: target.projectId,
+			ItemID:    target.itemId,
+			FieldID:   target.fieldId,
+		}
+		log.Debug("Clearing estimate", "url", issueUrl, "field", field.Field)
+		return client.Mutate("ClearEstimate", &mutation, map[string]any{"input": input})
+	}
+
+	var mutation struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ClientMutationId *string
+		} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+	}
+	input := githubv4.UpdateProjectV2ItemFieldValueInput{
+		ProjectID: target.projectId,
+		ItemID:    target.itemId,
+		FieldID:   target.fieldId,
+		Value:     githubv4.ProjectV2FieldValue{Number: githubv4.NewFloat(githubv4.Float(*value))},
+	}
+	log.Debug("Setting estimate", "url", issueUrl, "field", field.Field, "value", *value)
+	return client.Mutate("SetEstimate", &mutation, map[string]any{"input": input})
+}
+
+// addToEstimateProject adds the issue with issueId to the project of field
+func addToEstimateProject(client *gh.GraphQLClient, issueId string, field config.EstimateField) (estimateTarget, error) {
+	owner, number, _ := strings.Cut(field.Project, "/")
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return estimateTarget{}, fmt.Errorf("invalid estimate project %q, want owner/number", field.Project)
+	}
+
+	var queryResult struct {
+		RepositoryOwner struct {
+			ProjectOwner struct {
+				ProjectV2 *estimateProject `graphql:"projectV2(number: $number)"`
+			} `graphql:"... on ProjectV2Owner"`
+		} `graphql:"repositoryOwner(login: $login)"`
+	}
+	variables := map[string]any{
+		"login":  graphql.String(owner),
+		"number": graphql.Int(n),
+	}
+	if err := client.Query("FetchEstimateProject", &queryResult, variables); err != nil {
+		return estimateTarget{}, err
+	}
+	project := queryResult.RepositoryOwner.ProjectOwner.ProjectV2
+	if project == nil {
+		return estimateTarget{}, fmt.Errorf("project %s not found", field.Project)
+	}
+	fieldId, ok := project.numberField(field.Field)
+	if !ok {
+		return estimateTarget{}, fmt.Errorf("project %s has no number field called %q", field.Project, field.Field)
+	}
+
+	var mutation struct {
+		AddProjectV2ItemById struct {
+			Item struct {
+				Id string
+			}
+		} `graphql:"addProjectV2ItemById(input: $input)"`
+	}
+	input := githubv4.AddProjectV2ItemByIdInput{ProjectID: project.Id, ContentID: issueId}
+	log.Debug("Adding issue to project", "project", field.Project)
+	if err := client.Mutate("AddProjectItem", &mutation, map[string]any{"input": input}); err != nil {
+		return estimateTarget{}, err
+	}
+	return estimateTarget{
+		projectId: project.Id,
+		itemId:    mutation.AddProjectV2ItemById.Item.Id,
+		fieldId:   fieldId,
+	}, nil
+}
+
+// ParseEstimate parses an estimate typed in, empty clears it
+func ParseEstimate(s string) (*float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid estimate %q, want a number", s)
+	}
+	return &value, nil
+}
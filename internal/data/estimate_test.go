@@ -0,0 +1,67 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+func estimateProjectWith(id, url string, fields ...estimateProjectField) estimateProject {
+	p := estimateProject{Id: id, Url: url}
+	p.Fields.Nodes = fields
+	return p
+}
+
+func projectField(id, name, dataType string) estimateProjectField {
+	var f estimateProjectField
+	f.Field.Id, f.Field.Name, f.Field.DataType = id, name, dataType
+	return f
+}
+
+func TestFindEstimateTarget(t *testing.T) {
+	roadmap := estimateItem{Id: "item1", Project: estimateProjectWith("p1", "https://github.com/orgs/cli/projects/1",
+		projectField("f1", "Status", "SINGLE_SELECT"),
+		projectField("f2", "Estimate", "NUMBER"),
+	)}
+	backlog := estimateItem{Id: "item2", Project: estimateProjectWith("p2", "https://github.com/users/dlvhdr/projects/3",
+		projectField("f3", "Points", "NUMBER"),
+		projectField("f4", "Estimate", "TEXT"),
+	)}
+	items := []estimateItem{backlog, roadmap}
+
+	tests := []struct {
+		name   string
+		field  config.EstimateField
+		want   estimateTarget
+		wantOk bool
+	}{
+		{
+			name:   "first project with the number field",
+			field:  config.EstimateField{Field: "estimate"},
+			want:   estimateTarget{projectId: "p1", itemId: "item1", fieldId: "f2"},
+			wantOk: true,
+		},
+		{
+			name:   "configured project",
+			field:  config.EstimateField{Field: "Points", Project: "dlvhdr/3"},
+			want:   estimateTarget{projectId: "p2", itemId: "item2", fieldId: "f3"},
+			wantOk: true,
+		},
+		{
+			name:  "not in the configured project",
+			field: config.EstimateField{Field: "Estimate", Project: "cli/2"},
+		},
+		{
+			name:  "no number field",
+			field: config.EstimateField{Field: "Status"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := findEstimateTarget(items, tt.field)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("findEstimateTarget() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
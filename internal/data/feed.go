@@ -0,0 +1,298 @@
+package data
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+// FeedEntry is an entry of an RSS or Atom feed
+type FeedEntry struct {
+	Id      string
+	Title   string
+	Url     string
+	Author  string
+	Summary string
+	// Feed is the title of the feed the entry is from
+	Feed        string
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+func (e FeedEntry) GetRepoNameWithOwner() string {
+	return ""
+}
+
+func (e FeedEntry) GetTitle() string {
+	return e.Title
+}
+
+func (e FeedEntry) GetNumber() int {
+	return 0
+}
+
+func (e FeedEntry) GetUrl() string {
+	return e.Url
+}
+
+func (e FeedEntry) GetUpdatedAt() time.Time {
+	return e.UpdatedAt
+}
+
+// Feed is a parsed RSS or Atom feed
+type Feed struct {
+	Title   string
+	Entries []FeedEntry
+}
+
+// feedTimeout is how long fetching a feed can take
+const feedTimeout = 30 * time.Second
+
+var feedClient = &http.Client{Timeout: feedTimeout}
+
+// FetchFeed fetches and parses the RSS or Atom feed at url
+func FetchFeed(url string) (Feed, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return Feed{}, err
+	}
+	req.Header.Set("Accept", "application/atom+xml, application/rss+xml, application/xml;q=0.9, */*;q=0.8")
+	req.Header.Set("User-Agent", "gh-dash")
+
+	log.Debug("Fetching feed", "url", url)
+	res, err := feedClient.Do(req)
+	if err != nil {
+		return Feed{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return Feed{}, fmt.Errorf("fetching feed %s: %s", url, res.Status)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return Feed{}, err
+	}
+	return ParseFeed(body)
+}
+
+// FetchFeeds fetches the feeds at urls at once and returns their entries,
+// newest first. The entries of the feeds that were fetched are returned along
+// with the errors of the others.
+func FetchFeeds(urls []string) ([]FeedEntry, error) {
+	feeds := make([]Feed, len(urls))
+	errs := make([]error, len(urls))
+	var wg sync.WaitGroup
+	for i, url := range urls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			feeds[i], errs[i] = FetchFeed(url)
+		}()
+	}
+	wg.Wait()
+
+	var entries []FeedEntry
+	for _, feed := range feeds {
+		entries = append(entries, feed.Entries...)
+	}
+	slices.SortStableFunc(entries, func(a, b FeedEntry) int {
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	})
+	return entries, errors.Join(errs...)
+}
+
+type rssDocument struct {
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	// RSS 1.0 puts the items next to the channel
+	Items []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Guid        string `xml:"guid"`
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	Description string `xml:"description"`
+	Author      string `xml:"author"`
+	Creator     string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	PubDate     string `xml:"pubDate"`
+	Date        string `xml:"http://purl.org/dc/elements/1.1/ date"`
+}
+
+type atomFeed struct {
+	Title   string      `xml:"title"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	Id    string `xml:"id"`
+	Title string `xml:"title"`
+	Links []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+	Summary   string `xml:"summary"`
+	Content   string `xml:"content"`
+	Author    string `xml:"author>name"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+}
+
+// ParseFeed parses an RSS 2.0, RSS 1.0 or Atom feed
+func ParseFeed(body []byte) (Feed, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	decoder.Strict = false
+	// feeds in the wild declare all kinds of charsets, they're close enough to
+	// UTF-8 for titles and summaries
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			return Feed{}, errors.New("not an RSS or Atom feed")
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch strings.ToLower(start.Name.Local) {
+		case "feed":
+			var doc atomFeed
+			if err := decoder.DecodeElement(&doc, &start); err != nil {
+				return Feed{}, err
+			}
+			return doc.toFeed(), nil
+		case "rss", "rdf":
+			var doc rssDocument
+			if err := decoder.DecodeElement(&doc, &start); err != nil {
+				return Feed{}, err
+			}
+			return doc.toFeed(), nil
+		default:
+			return Feed{}, fmt.Errorf("not an RSS or Atom feed, got a <%s> document", start.Name.Local)
+		}
+	}
+}
+
+func (doc atomFeed) toFeed() Feed {
+	feed := Feed{Title: strings.TrimSpace(doc.Title)}
+	for _, e := range doc.Entries {
+		url := ""
+		for _, link := range e.Links {
+			if link.Rel == "" || link.Rel == "alternate" {
+				url = link.Href
+				break
+			}
+		}
+		if url == "" && len(e.Links) > 0 {
+			url = e.Links[0].Href
+		}
+		summary := e.Summary
+		if summary == "" {
+			summary = e.Content
+		}
+
+		entry := FeedEntry{
+			Id:          firstNonEmpty(e.Id, url),
+			Title:       strings.TrimSpace(e.Title),
+			Url:         url,
+			Author:      strings.TrimSpace(e.Author),
+			Summary:     FeedText(summary),
+			Feed:        feed.Title,
+			PublishedAt: parseFeedTime(firstNonEmpty(e.Published, e.Updated)),
+			UpdatedAt:   parseFeedTime(firstNonEmpty(e.Updated, e.Published)),
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return feed
+}
+
+func (doc rssDocument) toFeed() Feed {
+	feed := Feed{Title: strings.TrimSpace(doc.Channel.Title)}
+	for _, item := range append(doc.Channel.Items, doc.Items...) {
+		url := strings.TrimSpace(item.Link)
+		date := parseFeedTime(firstNonEmpty(item.PubDate, item.Date))
+		entry := FeedEntry{
+			Id:          firstNonEmpty(strings.TrimSpace(item.Guid), url),
+			Title:       strings.TrimSpace(item.Title),
+			Url:         url,
+			Author:      strings.TrimSpace(firstNonEmpty(item.Author, item.Creator)),
+			Summary:     FeedText(item.Description),
+			Feed:        feed.Title,
+			PublishedAt: date,
+			UpdatedAt:   date,
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return feed
+}
+
+var feedTimeLayouts = []string{
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// parseFeedTime parses the dates of RSS and Atom feeds, which are RFC 822 and
+// RFC 3339 dates respectively, give or take. It's zero when it can't be
+// parsed.
+func parseFeedTime(value string) time.Time {
+	value = strings.TrimSpace(value)
+	for _, layout := range feedTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+var (
+	htmlBreaks    = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|h[1-6]|pre|blockquote|tr)>`)
+	htmlTags      = regexp.MustCompile(`<[^>]*>`)
+	extraNewlines = regexp.MustCompile(`\n{3,}`)
+)
+
+// FeedText converts the HTML of a feed's summary to plain text
+func FeedText(value string) string {
+	value = htmlBreaks.ReplaceAllString(value, "\n")
+	value = htmlTags.ReplaceAllString(value, "")
+	value = html.UnescapeString(value)
+
+	lines := strings.Split(value, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	value = extraNewlines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+	return strings.TrimSpace(value)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,146 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseFeed(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantTitle string
+		want      []FeedEntry
+		wantErr   bool
+	}{
+		{
+			name: "atom",
+			body: `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Release notes from gh-dash</title>
+  <entry>
+    <id>tag:github.com,2008:Repository/1/v4.0.0</id>
+    <updated>2024-05-02T10:00:00Z</updated>
+    <link rel="alternate" type="text/html" href="https://github.com/dlvhdr/gh-dash/releases/tag/v4.0.0"/>
+    <title>v4.0.0</title>
+    <content type="html">&lt;p&gt;Big &amp;amp; shiny&lt;/p&gt;</content>
+    <author><name>dlvhdr</name></author>
+  </entry>
+</feed>`,
+			wantTitle: "Release notes from gh-dash",
+			want: []FeedEntry{{
+				Id:          "tag:github.com,2008:Repository/1/v4.0.0",
+				Title:       "v4.0.0",
+				Url:         "https://github.com/dlvhdr/gh-dash/releases/tag/v4.0.0",
+				Author:      "dlvhdr",
+				Summary:     "Big & shiny",
+				Feed:        "Release notes from gh-dash",
+				PublishedAt: time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+			}},
+		},
+		{
+			name: "rss 2.0",
+			body: `<?xml version="1.0"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>Security advisories</title>
+    <item>
+      <title>CVE-2024-1234</title>
+      <link>https://example.com/advisories/1</link>
+      <description>Upgrade now.&lt;br/&gt;Really.</description>
+      <dc:creator>security-team</dc:creator>
+      <pubDate>Thu, 02 May 2024 10:00:00 +0000</pubDate>
+    </item>
+  </channel>
+</rss>`,
+			wantTitle: "Security advisories",
+			want: []FeedEntry{{
+				Id:          "https://example.com/advisories/1",
+				Title:       "CVE-2024-1234",
+				Url:         "https://example.com/advisories/1",
+				Author:      "security-team",
+				Summary:     "Upgrade now.\nReally.",
+				Feed:        "Security advisories",
+				PublishedAt: time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+			}},
+		},
+		{
+			name: "rss 1.0",
+			body: `<?xml version="1.0"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns="http://purl.org/rss/1.0/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel><title>Blog</title></channel>
+  <item>
+    <title>Hello</title>
+    <link>https://example.com/hello</link>
+    <dc:date>2024-05-02T10:00:00Z</dc:date>
+  </item>
+</rdf:RDF>`,
+			wantTitle: "Blog",
+			want: []FeedEntry{{
+				Id:          "https://example.com/hello",
+				Title:       "Hello",
+				Url:         "https://example.com/hello",
+				Feed:        "Blog",
+				PublishedAt: time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+			}},
+		},
+		{
+			name:    "not a feed",
+			body:    `<html><body>Hello</body></html>`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFeed([]byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFeed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Title != tt.wantTitle {
+				t.Errorf("ParseFeed() title = %q, want %q", got.Title, tt.wantTitle)
+			}
+			if len(got.Entries) != len(tt.want) {
+				t.Fatalf("ParseFeed() got %d entries, want %d", len(got.Entries), len(tt.want))
+			}
+			for i, entry := range got.Entries {
+				want := tt.want[i]
+				if !entry.PublishedAt.Equal(want.PublishedAt) || !entry.UpdatedAt.Equal(want.UpdatedAt) {
+					t.Errorf("entry %d dates = %v, %v, want %v, %v", i,
+						entry.PublishedAt, entry.UpdatedAt, want.PublishedAt, want.UpdatedAt)
+				}
+				entry.PublishedAt, entry.UpdatedAt = want.PublishedAt, want.UpdatedAt
+				if entry != want {
+					t.Errorf("entry %d = %+v, want %+v", i, entry, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFeedText(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "plain", want: "plain"},
+		{value: "<p>One</p><p>Two</p>", want: "One\nTwo"},
+		{value: "<ul><li>a</li><li>b</li></ul>", want: "a\nb"},
+		{value: "a<br><br><br><br>b", want: "a\n\nb"},
+		{value: "Fish &amp; chips &lt;3", want: "Fish & chips <3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := FeedText(tt.value); got != tt.want {
+				t.Errorf("FeedText(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type giteaUser struct {
+	Login string `json:"login"`
+}
+
+type giteaIssue struct {
+	Number    int         `json:"number"`
+	Title     string      `json:"title"`
+	Body      string      `json:"body"`
+	State     string      `json:"state"`
+	User      giteaUser   `json:"user"`
+	Assignees []giteaUser `json:"assignees"`
+	Labels    []struct {
+		Name  string `json:"name"`
+		Color string `json:"color"`
+	} `json:"labels"`
+	Milestone *struct {
+		Id    int        `json:"id"`
+		Title string     `json:"title"`
+		DueOn *time.Time `json:"due_on"`
+	} `json:"milestone"`
+	Comments   int    `json:"comments"`
+	HtmlUrl    string `json:"html_url"`
+	Repository struct {
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	// PullRequest is only set for pull requests
+	PullRequest *struct {
+		Merged bool `json:"merged"`
+		Draft  bool `json:"draft"`
+	} `json:"pull_request"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (gi giteaIssue) state() string {
+	switch {
+	case gi.PullRequest != nil && gi.PullRequest.Merged:
+		return "MERGED"
+	case gi.State == "open":
+		return "OPEN"
+	default:
+		return "CLOSED"
+	}
+}
+
+func (gi giteaIssue) repository() Repository {
+	return Repository{Name: gi.Repository.Name, NameWithOwner: gi.Repository.FullName}
+}
+
+func (gi giteaIssue) assignees() Assignees {
+	var assignees Assignees
+	for _, u := range gi.Assignees {
+		assignees.Nodes = append(assignees.Nodes, Assignee{Login: u.Login})
+	}
+	return assignees
+}
+
+func (gi giteaIssue) labels() []Label {
+	var labels []Label
+	for _, l := range gi.Labels {
+		labels = append(labels, Label{Name: l.Name, Color: strings.TrimPrefix(l.Color, "#")})
+	}
+	return labels
+}
+
+func (gi giteaIssue) milestone() *ItemMilestone {
+	if gi.Milestone == nil {
+		return nil
+	}
+	return &ItemMilestone{Number: gi.Milestone.Id, Title: gi.Milestone.Title, DueOn: gi.Milestone.DueOn}
+}
+
+func (gi giteaIssue) toPullRequest() PullRequestData {
+	pr := PullRequestData{
+		Number:     gi.Number,
+		Title:      gi.Title,
+		Body:       gi.Body,
+		State:      gi.state(),
+		UpdatedAt:  gi.UpdatedAt,
+		CreatedAt:  gi.CreatedAt,
+		Url:        gi.HtmlUrl,
+		IsDraft:    gi.PullRequest != nil && gi.PullRequest.Draft,
+		Repository: gi.repository(),
+		Assignees:  gi.assignees(),
+		Labels:     PRLabels{Nodes: gi.labels()},
+		Milestone:  gi.milestone(),
+	}
+	pr.Author.Login = gi.User.Login
+	pr.Comments.TotalCount = gi.Comments
+	return pr
+}
+
+func (gi giteaIssue) toIssue() IssueData {
+	issue := IssueData{
+		Number:     gi.Number,
+		Title:      gi.Title,
+		Body:       gi.Body,
+		State:      gi.state(),
+		UpdatedAt:  gi.UpdatedAt,
+		CreatedAt:  gi.CreatedAt,
+		Url:        gi.HtmlUrl,
+		Repository: gi.repository(),
+		Assignees:  gi.assignees(),
+		Labels:     IssueLabels{Nodes: gi.labels()},
+		Milestone:  gi.milestone(),
+	}
+	issue.Author.Login = gi.User.Login
+	issue.Comments.TotalCount = gi.Comments
+	return issue
+}
+
+// giteaPath returns the path listing the pulls or issues of q. Gitea can
+// only filter by other users within a repo, searching across repos only
+// knows about the token's user.
+func (f *forge) giteaPath(q forgeQuery, kind string, limit, page int) (string, error) {
+	params := url.Values{}
+	params.Set("type", kind)
+	params.Set("limit", strconv.Itoa(limit))
+	params.Set("page", strconv.Itoa(page))
+	switch q.State {
+	case "merged":
+		params.Set("state", "closed")
+	default:
+		params.Set("state", q.State)
+	}
+	if len(q.Labels) > 0 {
+		params.Set("labels", strings.Join(q.Labels, ","))
+	}
+	if q.Milestone != "" {
+		params.Set("milestones", q.Milestone)
+	}
+	if q.Search != "" {
+		params.Set("q", q.Search)
+	}
+
+	if q.Repo == "" {
+		for param, login := range map[string]string{
+			"created":          q.Author,
+			"assigned":         q.Assignee,
+			"review_requested": q.Reviewer,
+		} {
+			switch login {
+			case "":
+			case "@me":
+				params.Set(param, "true")
+			default:
+				return "", errors.New("filtering by users other than @me needs a repo: filter on Gitea")
+			}
+		}
+		return "/api/v1/repos/issues/search?" + params.Encode(), nil
+	}
+
+	if q.Reviewer != "" {
+		return "", errors.New("filtering by reviewer needs no repo: filter on Gitea")
+	}
+	for param, login := range map[string]string{
+		"created_by":  q.Author,
+		"assigned_by": q.Assignee,
+	} {
+		if login == "" {
+			continue
+		}
+		user, err := f.user(login)
+		if err != nil {
+			return "", err
+		}
+		params.Set(param, user)
+	}
+	owner, name, ok := strings.Cut(q.Repo, "/")
+	if !ok {
+		return "", fmt.Errorf("invalid repo %q, expected owner/name", q.Repo)
+	}
+	return fmt.Sprintf("/api/v1/repos/%s/%s/issues?%s",
+		url.PathEscape(owner), url.PathEscape(name), params.Encode()), nil
+}
+
+func (f *forge) fetchGiteaPullRequests(q forgeQuery, limit, page int) (PullRequestsResponse, error) {
+	path, err := f.giteaPath(q, "pulls", limit, page)
+	if err != nil {
+		return PullRequestsResponse{}, err
+	}
+
+	var giteaIssues []giteaIssue
+	header, err := f.get(path, &giteaIssues)
+	if err != nil {
+		return PullRequestsResponse{}, err
+	}
+
+	prs := make([]PullRequestData, 0, len(giteaIssues))
+	for _, gi := range giteaIssues {
+		pr := gi.toPullRequest()
+		// Gitea lists merged pulls as closed
+		if q.State == "merged" && pr.State != "MERGED" {
+			continue
+		}
+		prs = append(prs, pr)
+	}
+	return PullRequestsResponse{
+		Prs:        prs,
+		TotalCount: forgeTotalCount(header, "X-Total-Count", (page-1)*limit+len(prs)),
+		PageInfo:   forgePageInfo(header, page),
+	}, nil
+}
+
+func (f *forge) fetchGiteaIssues(q forgeQuery, limit, page int) (IssuesResponse, error) {
+	if q.State == "merged" {
+		return IssuesResponse{}, errors.New("issues can't be merged, use is:open or is:closed")
+	}
+	path, err := f.giteaPath(q, "issues", limit, page)
+	if err != nil {
+		return IssuesResponse{}, err
+	}
+
+	var giteaIssues []giteaIssue
+	header, err := f.get(path, &giteaIssues)
+	if err != nil {
+		return IssuesResponse{}, err
+	}
+
+	issues := make([]IssueData, 0, len(giteaIssues))
+	for _, gi := range giteaIssues {
+		issues = append(issues, gi.toIssue())
+	}
+	return IssuesResponse{
+		Issues:     issues,
+		TotalCount: forgeTotalCount(header, "X-Total-Count", (page-1)*limit+len(issues)),
+		PageInfo:   forgePageInfo(header, page),
+	}, nil
+}
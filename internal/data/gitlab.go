@@ -0,0 +1,253 @@
+package data
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type gitLabUser struct {
+	Username string `json:"username"`
+}
+
+type gitLabLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type gitLabMilestone struct {
+	Iid   int    `json:"iid"`
+	Title string `json:"title"`
+	// DueDate is like 2024-01-31
+	DueDate string `json:"due_date"`
+}
+
+type gitLabReferences struct {
+	// Full is like group/project!12 for merge requests or group/project#12
+	// for issues
+	Full string `json:"full"`
+}
+
+type gitLabMergeRequest struct {
+	Iid            int              `json:"iid"`
+	Title          string           `json:"title"`
+	Description    string           `json:"description"`
+	State          string           `json:"state"`
+	Draft          bool             `json:"draft"`
+	SourceBranch   string           `json:"source_branch"`
+	TargetBranch   string           `json:"target_branch"`
+	Author         gitLabUser       `json:"author"`
+	Assignees      []gitLabUser     `json:"assignees"`
+	Labels         []gitLabLabel    `json:"labels"`
+	Milestone      *gitLabMilestone `json:"milestone"`
+	UserNotesCount int              `json:"user_notes_count"`
+	WebUrl         string           `json:"web_url"`
+	References     gitLabReferences `json:"references"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+type gitLabIssue struct {
+	Iid            int              `json:"iid"`
+	Title          string           `json:"title"`
+	Description    string           `json:"description"`
+	State          string           `json:"state"`
+	Author         gitLabUser       `json:"author"`
+	Assignees      []gitLabUser     `json:"assignees"`
+	Labels         []gitLabLabel    `json:"labels"`
+	Milestone      *gitLabMilestone `json:"milestone"`
+	UserNotesCount int              `json:"user_notes_count"`
+	Upvotes        int              `json:"upvotes"`
+	Downvotes      int              `json:"downvotes"`
+	WebUrl         string           `json:"web_url"`
+	References     gitLabReferences `json:"references"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// gitLabRepository returns the repository of a reference like
+// group/subgroup/project!12
+func gitLabRepository(ref string) Repository {
+	nameWithOwner, _, _ := strings.Cut(ref, "!")
+	nameWithOwner, _, _ = strings.Cut(nameWithOwner, "#")
+	name := nameWithOwner[strings.LastIndex(nameWithOwner, "/")+1:]
+	return Repository{Name: name, NameWithOwner: nameWithOwner}
+}
+
+func gitLabAssignees(users []gitLabUser) Assignees {
+	var assignees Assignees
+	for _, u := range users {
+		assignees.Nodes = append(assignees.Nodes, Assignee{Login: u.Username})
+	}
+	return assignees
+}
+
+func gitLabLabels(labels []gitLabLabel) []Label {
+	var res []Label
+	for _, l := range labels {
+		res = append(res, Label{Name: l.Name, Color: strings.TrimPrefix(l.Color, "#")})
+	}
+	return res
+}
+
+func (m *gitLabMilestone) toMilestone() *ItemMilestone {
+	if m == nil {
+		return nil
+	}
+	milestone := &ItemMilestone{Number: m.Iid, Title: m.Title}
+	if dueOn, err := time.Parse(time.DateOnly, m.DueDate); err == nil {
+		milestone.DueOn = &dueOn
+	}
+	return milestone
+}
+
+func (mr gitLabMergeRequest) toPullRequest() PullRequestData {
+	pr := PullRequestData{
+		Number:      mr.Iid,
+		Title:       mr.Title,
+		Body:        mr.Description,
+		UpdatedAt:   mr.UpdatedAt,
+		CreatedAt:   mr.CreatedAt,
+		Url:         mr.WebUrl,
+		IsDraft:     mr.Draft,
+		HeadRefName: mr.SourceBranch,
+		BaseRefName: mr.TargetBranch,
+		Repository:  gitLabRepository(mr.References.Full),
+		Assignees:   gitLabAssignees(mr.Assignees),
+		Labels:      PRLabels{Nodes: gitLabLabels(mr.Labels)},
+		Milestone:   mr.Milestone.toMilestone(),
+	}
+	pr.Author.Login = mr.Author.Username
+	pr.Comments.TotalCount = mr.UserNotesCount
+	switch mr.State {
+	case "merged":
+		pr.State = "MERGED"
+	case "opened":
+		pr.State = "OPEN"
+	default:
+		pr.State = "CLOSED"
+	}
+	return pr
+}
+
+func (gi gitLabIssue) toIssue() IssueData {
+	issue := IssueData{
+		Number:     gi.Iid,
+		Title:      gi.Title,
+		Body:       gi.Description,
+		UpdatedAt:  gi.UpdatedAt,
+		CreatedAt:  gi.CreatedAt,
+		Url:        gi.WebUrl,
+		Repository: gitLabRepository(gi.References.Full),
+		Assignees:  gitLabAssignees(gi.Assignees),
+		Labels:     IssueLabels{Nodes: gitLabLabels(gi.Labels)},
+		Milestone:  gi.Milestone.toMilestone(),
+	}
+	issue.Author.Login = gi.Author.Username
+	issue.Comments.TotalCount = gi.UserNotesCount
+	issue.Reactions.TotalCount = gi.Upvotes + gi.Downvotes
+	issue.State = "CLOSED"
+	if gi.State == "opened" {
+		issue.State = "OPEN"
+	}
+	return issue
+}
+
+// gitLabPath returns the path of the merge requests or issues of q and their
+// parameters shared by both
+func (f *forge) gitLabPath(q forgeQuery, kind string, limit, page int) (string, url.Values, error) {
+	params := url.Values{}
+	params.Set("per_page", strconv.Itoa(limit))
+	params.Set("page", strconv.Itoa(page))
+	params.Set("order_by", "updated_at")
+	params.Set("with_labels_details", "true")
+	switch q.State {
+	case "open":
+		params.Set("state", "opened")
+	default:
+		params.Set("state", q.State)
+	}
+	if len(q.Labels) > 0 {
+		params.Set("labels", strings.Join(q.Labels, ","))
+	}
+	if q.Milestone != "" {
+		params.Set("milestone", q.Milestone)
+	}
+	if q.Search != "" {
+		params.Set("search", q.Search)
+	}
+	for param, login := range map[string]string{
+		"author_username":   q.Author,
+		"assignee_username": q.Assignee,
+		"reviewer_username": q.Reviewer,
+	} {
+		if login == "" {
+			continue
+		}
+		user, err := f.user(login)
+		if err != nil {
+			return "", nil, err
+		}
+		params.Set(param, user)
+	}
+
+	if q.Repo != "" {
+		return "/api/v4/projects/" + url.PathEscape(q.Repo) + "/" + kind, params, nil
+	}
+	params.Set("scope", "all")
+	return "/api/v4/" + kind, params, nil
+}
+
+func (f *forge) fetchGitLabMergeRequests(q forgeQuery, limit, page int) (PullRequestsResponse, error) {
+	path, params, err := f.gitLabPath(q, "merge_requests", limit, page)
+	if err != nil {
+		return PullRequestsResponse{}, err
+	}
+
+	var mrs []gitLabMergeRequest
+	header, err := f.get(path+"?"+params.Encode(), &mrs)
+	if err != nil {
+		return PullRequestsResponse{}, err
+	}
+
+	prs := make([]PullRequestData, 0, len(mrs))
+	for _, mr := range mrs {
+		prs = append(prs, mr.toPullRequest())
+	}
+	return PullRequestsResponse{
+		Prs:        prs,
+		TotalCount: forgeTotalCount(header, "X-Total", (page-1)*limit+len(prs)),
+		PageInfo:   forgePageInfo(header, page),
+	}, nil
+}
+
+func (f *forge) fetchGitLabIssues(q forgeQuery, limit, page int) (IssuesResponse, error) {
+	if q.State == "merged" {
+		return IssuesResponse{}, errors.New("issues can't be merged, use is:open or is:closed")
+	}
+	if q.Reviewer != "" {
+		return IssuesResponse{}, errors.New("issues have no reviewers")
+	}
+	path, params, err := f.gitLabPath(q, "issues", limit, page)
+	if err != nil {
+		return IssuesResponse{}, err
+	}
+
+	var gitLabIssues []gitLabIssue
+	header, err := f.get(path+"?"+params.Encode(), &gitLabIssues)
+	if err != nil {
+		return IssuesResponse{}, err
+	}
+
+	issues := make([]IssueData, 0, len(gitLabIssues))
+	for _, gi := range gitLabIssues {
+		issues = append(issues, gi.toIssue())
+	}
+	return IssuesResponse{
+		Issues:     issues,
+		TotalCount: forgeTotalCount(header, "X-Total", (page-1)*limit+len(issues)),
+		PageInfo:   forgePageInfo(header, page),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package data
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/auth"
+)
+
+// hostClients are the search clients of the GitHub hosts other than the
+// default one, by host
+var hostClients = struct {
+	mu      sync.Mutex
+	clients map[string]*gh.GraphQLClient
+}{clients: map[string]*gh.GraphQLClient{}}
+
+// IsDefaultHost returns whether host is the one gh talks to by default, the
+// one of $GH_HOST or github.com. An empty host is the default one.
+func IsDefaultHost(host string) bool {
+	if host == "" {
+		return true
+	}
+	defaultHost, _ := auth.DefaultHost()
+	return auth.NormalizeHostname(host) == auth.NormalizeHostname(defaultHost)
+}
+
+// SameHost returns whether a and b are the same host, an empty one being the
+// default host
+func SameHost(a, b string) bool {
+	if a == "" || b == "" {
+		return IsDefaultHost(a) && IsDefaultHost(b)
+	}
+	return auth.NormalizeHostname(a) == auth.NormalizeHostname(b)
+}
+
+// HostOf returns the host of a URL, empty when it can't be parsed
+func HostOf(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// forUrl returns opts talking to the host of rawUrl, e.g. to fetch a PR of a
+// GitHub Enterprise host from its URL
+func forUrl(rawUrl string, opts gh.ClientOptions) gh.ClientOptions {
+	if host := HostOf(rawUrl); opts.Host == "" && !IsDefaultHost(host) {
+		opts.Host = host
+	}
+	return opts
+}
+
+// searchClient returns the client the searches of host are made with, the
+// shared one for the default host
+func searchClient(host string) (*gh.GraphQLClient, error) {
+	if IsDefaultHost(host) {
+		if err := initClient(); err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+
+	hostClients.mu.Lock()
+	defer hostClients.mu.Unlock()
+	host = auth.NormalizeHostname(host)
+	if c, ok := hostClients.clients[host]; ok {
+		return c, nil
+	}
+	c, err := newGraphQLClient(gh.ClientOptions{Host: host})
+	if err != nil {
+		return nil, err
+	}
+	hostClients.clients[host] = c
+	return c, nil
+}
+
+// RepoArg returns the repo of row the way the -R flag of gh takes it,
+// prefixed by its host when it's not the default one
+func RepoArg(row RowData) string {
+	if host := HostOf(row.GetUrl()); !IsDefaultHost(host) {
+		return host + "/" + row.GetRepoNameWithOwner()
+	}
+	return row.GetRepoNameWithOwner()
+}
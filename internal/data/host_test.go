@@ -0,0 +1,57 @@
+package data
+
+import "testing"
+
+func TestRepoArg(t *testing.T) {
+	t.Setenv("GH_HOST", "github.com")
+	tests := []struct {
+		name string
+		row  RowData
+		want string
+	}{
+		{
+			name: "default host",
+			row:  issueOn("https://github.com/owner/repo/issues/1", "owner/repo"),
+			want: "owner/repo",
+		},
+		{
+			name: "enterprise host",
+			row:  issueOn("https://github.example.com/owner/repo/issues/1", "owner/repo"),
+			want: "github.example.com/owner/repo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RepoArg(tt.row); got != tt.want {
+				t.Errorf("RepoArg() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameHost(t *testing.T) {
+	t.Setenv("GH_HOST", "github.example.com")
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "", b: "", want: true},
+		{a: "GitHub.Example.com", b: "", want: true},
+		{a: "github.com", b: "", want: false},
+		{a: "github.com", b: "github.com", want: true},
+		{a: "github.com", b: "github.example.com", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := SameHost(tt.a, tt.b); got != tt.want {
+			t.Errorf("SameHost(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func issueOn(url, repo string) *IssueData {
+	issue := &IssueData{Url: url}
+	issue.Repository.NameWithOwner = repo
+	return issue
+}
@@ -5,16 +5,16 @@ import (
 	"time"
 
 	"github.com/charmbracelet/log"
-	gh "github.com/cli/go-gh/v2/pkg/api"
 	graphql "github.com/cli/shurcooL-graphql"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
 )
 
 type IssueData struct {
+	Id     string
 	Number int
 	Title  string
-	Body   string
+	Body   string `graphql:"body @include(if: $withBody)"`
 	State  string
 	Author struct {
 		Login string
@@ -24,15 +24,17 @@ type IssueData struct {
 	CreatedAt         time.Time
 	Url               string
 	Repository        Repository
-	Assignees         Assignees      `graphql:"assignees(first: 3)"`
-	Comments          IssueComments  `graphql:"comments(first: 15)"`
+	Assignees         Assignees      `graphql:"assignees(first: 3) @include(if: $withAssignees)"`
+	Comments          IssueComments  `graphql:"comments(last: 15)"`
 	Reactions         IssueReactions `graphql:"reactions(first: 1)"`
-	Labels            IssueLabels    `graphql:"labels(first: 3)"`
+	Labels            IssueLabels    `graphql:"labels(first: 3) @include(if: $withLabels)"`
+	Milestone         *ItemMilestone
 }
 
 type IssueComments struct {
 	Nodes      []IssueComment
 	TotalCount int
+	PageInfo   CommentsPageInfo
 }
 
 type IssueComment struct {
@@ -92,54 +94,72 @@ func makeIssuesQuery(query string) string {
 	return fmt.Sprintf("is:issue %s sort:updated", query)
 }
 
+type issueNode struct {
+	Issue IssueData `graphql:"... on Issue"`
+}
+
 func FetchIssues(query string, limit int, pageInfo *PageInfo) (IssuesResponse, error) {
+	return fetchIssues("", query, limit, pageInfo)
+}
+
+// fetchIssues searches the issues of host, the default one when it's
+// empty. Only the searches of the default host are batched.
+func fetchIssues(host, query string, limit int, pageInfo *PageInfo) (IssuesResponse, error) {
+	var search searchConnection[issueNode]
 	var err error
-	if client == nil {
-		client, err = gh.DefaultGraphQLClient()
+	if pageInfo == nil && IsDefaultHost(host) {
+		err = batchSearch(makeIssuesQuery(query), limit, &search, func() error {
+			return searchIssues("", query, limit, nil, &search)
+		})
+	} else {
+		err = searchIssues(host, query, limit, pageInfo, &search)
 	}
-
 	if err != nil {
 		return IssuesResponse{}, err
 	}
+	log.Info("Successfully fetched issues", "query", query, "count", search.IssueCount)
+
+	issues := make([]IssueData, 0, len(search.Nodes))
+	for _, node := range search.Nodes {
+		if node.Issue.Repository.IsArchived {
+			continue
+		}
+		issues = append(issues, node.Issue)
+	}
+
+	return IssuesResponse{
+		Issues:     issues,
+		TotalCount: search.IssueCount,
+		PageInfo:   search.PageInfo,
+	}, nil
+}
+
+// searchIssues fetches the page of issues after pageInfo on its own
+func searchIssues(host, query string, limit int, pageInfo *PageInfo, search *searchConnection[issueNode]) error {
+	client, err := searchClient(host)
+	if err != nil {
+		return err
+	}
 
 	var queryResult struct {
-		Search struct {
-			Nodes []struct {
-				Issue IssueData `graphql:"... on Issue"`
-			}
-			IssueCount int
-			PageInfo   PageInfo
-		} `graphql:"search(type: ISSUE, first: $limit, after: $endCursor, query: $query)"`
+		Search searchConnection[issueNode] `graphql:"search(type: ISSUE, first: $limit, after: $endCursor, query: $query)"`
 	}
 	var endCursor *string
 	if pageInfo != nil {
 		endCursor = &pageInfo.EndCursor
 	}
-	variables := map[string]any{
+	variables := withListFields(map[string]any{
 		"query":     graphql.String(makeIssuesQuery(query)),
 		"limit":     graphql.Int(limit),
 		"endCursor": (*graphql.String)(endCursor),
-	}
+	})
 	log.Debug("Fetching issues", "query", query, "limit", limit, "endCursor", endCursor)
-	err = client.Query("SearchIssues", &queryResult, variables)
-	if err != nil {
-		return IssuesResponse{}, err
+	if err := client.Query("SearchIssues", &queryResult, variables); err != nil {
+		return err
 	}
-	log.Info("Successfully fetched issues", "query", query, "count", queryResult.Search.IssueCount)
 
-	issues := make([]IssueData, 0, len(queryResult.Search.Nodes))
-	for _, node := range queryResult.Search.Nodes {
-		if node.Issue.Repository.IsArchived {
-			continue
-		}
-		issues = append(issues, node.Issue)
-	}
-
-	return IssuesResponse{
-		Issues:     issues,
-		TotalCount: queryResult.Search.IssueCount,
-		PageInfo:   queryResult.Search.PageInfo,
-	}, nil
+	*search = queryResult.Search
+	return nil
 }
 
 type IssuesResponse struct {
@@ -0,0 +1,112 @@
+package data
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// ItemUrl is a link to a PR or an issue
+type ItemUrl struct {
+	// Url is the canonical link, e.g. https://github.com/owner/repo/pull/123
+	Url    string
+	Owner  string
+	Repo   string
+	Number int
+	IsPR   bool
+}
+
+// ParseItemUrl parses a link to a PR or an issue on github.com or a GitHub
+// Enterprise host. Anything after the number, e.g. /files or a comment
+// anchor, is dropped.
+func ParseItemUrl(rawUrl string) (ItemUrl, error) {
+	u, err := url.Parse(strings.TrimSpace(rawUrl))
+	if err != nil {
+		return ItemUrl{}, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return ItemUrl{}, fmt.Errorf("%q isn't a link to a PR or issue", rawUrl)
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 4 || parts[0] == "" || parts[1] == "" {
+		return ItemUrl{}, fmt.Errorf("%q isn't a link to a PR or issue", rawUrl)
+	}
+
+	var isPR bool
+	switch parts[2] {
+	case "pull":
+		isPR = true
+	case "issues":
+		isPR = false
+	default:
+		return ItemUrl{}, fmt.Errorf("%q isn't a link to a PR or issue", rawUrl)
+	}
+
+	number, err := strconv.Atoi(parts[3])
+	if err != nil || number <= 0 {
+		return ItemUrl{}, fmt.Errorf("%q doesn't have a valid PR or issue number", rawUrl)
+	}
+
+	kind := parts[2]
+	return ItemUrl{
+		Url:    fmt.Sprintf("%s://%s/%s/%s/%s/%d", u.Scheme, u.Host, parts[0], parts[1], kind, number),
+		Owner:  parts[0],
+		Repo:   parts[1],
+		Number: number,
+		IsPR:   isPR,
+	}, nil
+}
+
+// FetchItem fetches the PR or issue link points to, returning either a
+// *PullRequestData or an *IssueData
+func FetchItem(link ItemUrl) (RowData, error) {
+	client, err := newGraphQLClient(forUrl(link.Url, gh.ClientOptions{}))
+	if err != nil {
+		return nil, err
+	}
+
+	parsedUrl, err := url.Parse(link.Url)
+	if err != nil {
+		return nil, err
+	}
+	variables := withListFields(map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	})
+	log.Debug("Fetching item", "url", link.Url)
+
+	if link.IsPR {
+		var queryResult struct {
+			Resource struct {
+				PullRequest PullRequestData `graphql:"... on PullRequest"`
+			} `graphql:"resource(url: $url)"`
+		}
+		if err := client.Query("FetchLinkedPullRequest", &queryResult, variables); err != nil {
+			return nil, err
+		}
+		if queryResult.Resource.PullRequest.Number == 0 {
+			return nil, fmt.Errorf("no PR found at %s", link.Url)
+		}
+		log.Info("Successfully fetched PR", "url", link.Url)
+		return &queryResult.Resource.PullRequest, nil
+	}
+
+	var queryResult struct {
+		Resource struct {
+			Issue IssueData `graphql:"... on Issue"`
+		} `graphql:"resource(url: $url)"`
+	}
+	if err := client.Query("FetchLinkedIssue", &queryResult, variables); err != nil {
+		return nil, err
+	}
+	if queryResult.Resource.Issue.Number == 0 {
+		return nil, fmt.Errorf("no issue found at %s", link.Url)
+	}
+	log.Info("Successfully fetched issue", "url", link.Url)
+	return &queryResult.Resource.Issue, nil
+}
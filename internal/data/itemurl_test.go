@@ -0,0 +1,65 @@
+package data
+
+import "testing"
+
+func TestParseItemUrl(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    ItemUrl
+		wantErr bool
+	}{
+		{
+			name: "PR",
+			url:  "https://github.com/owner/repo/pull/123",
+			want: ItemUrl{Url: "https://github.com/owner/repo/pull/123", Owner: "owner", Repo: "repo", Number: 123, IsPR: true},
+		},
+		{
+			name: "issue",
+			url:  "https://github.com/owner/repo/issues/7",
+			want: ItemUrl{Url: "https://github.com/owner/repo/issues/7", Owner: "owner", Repo: "repo", Number: 7},
+		},
+		{
+			name: "PR tab and anchor are dropped",
+			url:  "https://github.com/owner/repo/pull/123/files#diff-abc",
+			want: ItemUrl{Url: "https://github.com/owner/repo/pull/123", Owner: "owner", Repo: "repo", Number: 123, IsPR: true},
+		},
+		{
+			name: "GitHub Enterprise host",
+			url:  "https://github.example.com/org/project/issues/42?foo=bar",
+			want: ItemUrl{Url: "https://github.example.com/org/project/issues/42", Owner: "org", Repo: "project", Number: 42},
+		},
+		{
+			name:    "repo link",
+			url:     "https://github.com/owner/repo",
+			wantErr: true,
+		},
+		{
+			name:    "other kind of link",
+			url:     "https://github.com/owner/repo/discussions/1",
+			wantErr: true,
+		},
+		{
+			name:    "invalid number",
+			url:     "https://github.com/owner/repo/pull/abc",
+			wantErr: true,
+		},
+		{
+			name:    "not a url",
+			url:     "owner/repo#123",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseItemUrl(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseItemUrl() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseItemUrl() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package data
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// Iteration is an iteration of a project (v2) iteration field, e.g. a sprint
+type Iteration struct {
+	Id        string
+	Title     string
+	StartDate string
+	// Duration is the length of the iteration in days
+	Duration int
+}
+
+// Start returns the first day of the iteration, zero if it can't be parsed
+func (it Iteration) Start() time.Time {
+	start, err := time.ParseInLocation(time.DateOnly, it.StartDate, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return start
+}
+
+// End returns the day after the last day of the iteration
+func (it Iteration) End() time.Time {
+	return it.Start().AddDate(0, 0, it.Duration)
+}
+
+// Iterations are the iterations of a field, ordered by their start date
+type Iterations []Iteration
+
+func newIterations(iterations ...[]Iteration) Iterations {
+	its := Iterations(slices.Concat(iterations...))
+	slices.SortStableFunc(its, func(a, b Iteration) int {
+		return a.Start().Compare(b.Start())
+	})
+	return its
+}
+
+// Current returns the iteration running on the day of now
+func (its Iterations) Current(now time.Time) (Iteration, bool) {
+	for _, it := range its {
+		if !now.Before(it.Start()) && now.Before(it.End()) {
+			return it, true
+		}
+	}
+	return Iteration{}, false
+}
+
+// Next returns the first iteration starting after the day of now
+func (its Iterations) Next(now time.Time) (Iteration, bool) {
+	for _, it := range its {
+		if it.Start().After(now) {
+			return it, true
+		}
+	}
+	return Iteration{}, false
+}
+
+// Index returns the position of the iteration called title, -1 if there's
+// none
+func (its Iterations) Index(title string) int {
+	return slices.IndexFunc(its, func(it Iteration) bool {
+		return it.Title == title
+	})
+}
+
+// CompareTitles orders the iterations called a and b by their start date,
+// the unknown ones last
+func (its Iterations) CompareTitles(a, b string) int {
+	i, j := its.Index(a), its.Index(b)
+	if i == -1 || j == -1 {
+		return cmp.Compare(j, i)
+	}
+	return cmp.Compare(i, j)
+}
+
+// iterationsMaxAge is how long the iterations of a field are reused before
+// they're fetched again
+const iterationsMaxAge = time.Hour
+
+type cachedIterations struct {
+	iterations Iterations
+	fetchedAt  time.Time
+}
+
+var (
+	iterationsMu    sync.Mutex
+	iterationsCache = map[string]cachedIterations{}
+)
+
+// FetchIterations fetches the iterations of the iteration field called field
+// of project, as owner/number like the project search qualifier. The
+// completed iterations are included. The token needs the read:project scope.
+func FetchIterations(project, field string) (Iterations, error) {
+	owner, number, _ := strings.Cut(project, "/")
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sprint project %q, want owner/number", project)
+	}
+
+	key := strings.ToLower(project + "|" + field)
+	iterationsMu.Lock()
+	cached, ok := iterationsCache[key]
+	iterationsMu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < iterationsMaxAge {
+		return cached.iterations, nil
+	}
+
+	if err := initClient(); err != nil {
+		return nil, err
+	}
+	var queryResult struct {
+		RepositoryOwner struct {
+			ProjectOwner struct {
+				ProjectV2 *struct {
+					Field struct {
+						IterationField struct {
+							Configuration struct {
+								Iterations          []Iteration
+								CompletedIterations []Iteration
+							}
+						} `graphql:"... on ProjectV2IterationField"`
+					} `graphql:"field(name: $field)"`
+				} `graphql:"projectV2(number: $number)"`
+			} `graphql:"... on ProjectV2Owner"`
+		} `graphql:"repositoryOwner(login: $login)"`
+	}
+	variables := map[string]any{
+		"login":  graphql.String(owner),
+		"number": graphql.Int(n),
+		"field":  graphql.String(field),
+	}
+	log.Debug("Fetching iterations", "project", project, "field", field)
+	if err := client.Query("FetchIterations", &queryResult, variables); err != nil {
+		return nil, err
+	}
+	p := queryResult.RepositoryOwner.ProjectOwner.ProjectV2
+	if p == nil {
+		return nil, fmt.Errorf("project %s not found", project)
+	}
+	configuration := p.Field.IterationField.Configuration
+	iterations := newIterations(configuration.CompletedIterations, configuration.Iterations)
+	if len(iterations) == 0 {
+		return nil, fmt.Errorf("project %s has no iteration field called %q", project, field)
+	}
+
+	iterationsMu.Lock()
+	iterationsCache[key] = cachedIterations{iterations: iterations, fetchedAt: time.Now()}
+	iterationsMu.Unlock()
+	return iterations, nil
+}
@@ -0,0 +1,88 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIterations(t *testing.T) {
+	its := newIterations(
+		[]Iteration{
+			{Title: "Sprint 3", StartDate: "2024-05-13", Duration: 14},
+			{Title: "Sprint 4", StartDate: "2024-05-27", Duration: 14},
+		},
+		[]Iteration{
+			{Title: "Sprint 2", StartDate: "2024-04-29", Duration: 14},
+		},
+	)
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		wantCurrent string
+		wantNext    string
+	}{
+		{
+			name:        "first day",
+			now:         time.Date(2024, 5, 13, 9, 0, 0, 0, time.Local),
+			wantCurrent: "Sprint 3",
+			wantNext:    "Sprint 4",
+		},
+		{
+			name:        "last day",
+			now:         time.Date(2024, 5, 26, 18, 0, 0, 0, time.Local),
+			wantCurrent: "Sprint 3",
+			wantNext:    "Sprint 4",
+		},
+		{
+			name:        "last iteration",
+			now:         time.Date(2024, 6, 1, 9, 0, 0, 0, time.Local),
+			wantCurrent: "Sprint 4",
+		},
+		{
+			name:     "before the first iteration",
+			now:      time.Date(2024, 4, 1, 9, 0, 0, 0, time.Local),
+			wantNext: "Sprint 2",
+		},
+		{
+			name: "after the last iteration",
+			now:  time.Date(2024, 7, 1, 9, 0, 0, 0, time.Local),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, _ := its.Current(tt.now)
+			if current.Title != tt.wantCurrent {
+				t.Errorf("Current() = %q, want %q", current.Title, tt.wantCurrent)
+			}
+			next, _ := its.Next(tt.now)
+			if next.Title != tt.wantNext {
+				t.Errorf("Next() = %q, want %q", next.Title, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestIterationsCompareTitles(t *testing.T) {
+	its := newIterations([]Iteration{
+		{Title: "Sprint 10", StartDate: "2024-05-27", Duration: 14},
+		{Title: "Sprint 9", StartDate: "2024-05-13", Duration: 14},
+	})
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "Sprint 9", b: "Sprint 10", want: -1},
+		{a: "Sprint 10", b: "Sprint 9", want: 1},
+		{a: "Sprint 9", b: "Sprint 9", want: 0},
+		{a: "", b: "Sprint 9", want: 1},
+		{a: "Sprint 10", b: "Unknown", want: -1},
+		{a: "", b: "", want: 0},
+	}
+	for _, tt := range tests {
+		if got := its.CompareTitles(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareTitles(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
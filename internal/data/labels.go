@@ -0,0 +1,51 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// RepoLabel is a label that can be added to the PRs and issues of a repo
+type RepoLabel struct {
+	Name        string
+	Color       string
+	Description string
+}
+
+// FetchRepoLabels fetches the labels of repo, as owner/name, sorted by name.
+// They're reused from the metadata cache while they're fresh.
+func FetchRepoLabels(repo string) ([]RepoLabel, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repo name %q", repo)
+	}
+	return cachedMetadata(MetadataLabels, repo, func() ([]RepoLabel, error) {
+		return fetchRepoLabels(owner, name)
+	})
+}
+
+func fetchRepoLabels(owner, name string) ([]RepoLabel, error) {
+	if err := initClient(); err != nil {
+		return nil, err
+	}
+	var queryResult struct {
+		Repository struct {
+			Labels struct {
+				Nodes []RepoLabel
+			} `graphql:"labels(first: 100, orderBy: {field: NAME, direction: ASC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]any{
+		"owner": graphql.String(owner),
+		"name":  graphql.String(name),
+	}
+	log.Debug("Fetching labels", "owner", owner, "name", name)
+	if err := client.Query("FetchRepoLabels", &queryResult, variables); err != nil {
+		return nil, err
+	}
+
+	return queryResult.Repository.Labels.Nodes, nil
+}
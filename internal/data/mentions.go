@@ -0,0 +1,52 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// FetchMentionableUsers fetches the logins of the users that can be
+// mentioned in repo, as owner/name: its collaborators, the members of its
+// org and the participants of its PRs and issues. They're reused from the
+// metadata cache while they're fresh.
+func FetchMentionableUsers(repo string) ([]string, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repo name %q", repo)
+	}
+	return cachedMetadata(MetadataMembers, repo, func() ([]string, error) {
+		return fetchMentionableUsers(owner, name)
+	})
+}
+
+func fetchMentionableUsers(owner, name string) ([]string, error) {
+	if err := initClient(); err != nil {
+		return nil, err
+	}
+	var queryResult struct {
+		Repository struct {
+			MentionableUsers struct {
+				Nodes []struct {
+					Login string
+				}
+			} `graphql:"mentionableUsers(first: 100)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]any{
+		"owner": graphql.String(owner),
+		"name":  graphql.String(name),
+	}
+	log.Debug("Fetching mentionable users", "owner", owner, "name", name)
+	if err := client.Query("FetchMentionableUsers", &queryResult, variables); err != nil {
+		return nil, err
+	}
+
+	logins := make([]string, 0, len(queryResult.Repository.MentionableUsers.Nodes))
+	for _, user := range queryResult.Repository.MentionableUsers.Nodes {
+		logins = append(logins, user.Login)
+	}
+	return logins, nil
+}
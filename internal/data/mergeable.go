@@ -0,0 +1,115 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	MergeableStateMergeable   = "MERGEABLE"
+	MergeableStateConflicting = "CONFLICTING"
+	// MergeableStateUnknown is the state of PRs whose mergeability GitHub
+	// hasn't computed yet, it computes it in the background once asked for
+	MergeableStateUnknown = "UNKNOWN"
+)
+
+const (
+	BranchUpdateMerge  = "merge"
+	BranchUpdateRebase = "rebase"
+)
+
+// UpdatePullRequestBranch updates the head branch of the PR at prUrl with the
+// changes of its base branch, with a merge commit or by rebasing it on them
+// depending on method. It returns the mergeable state of the updated PR.
+func UpdatePullRequestBranch(prUrl string, method string) (string, error) {
+	client, err := newGraphQLClient(forUrl(prUrl, gh.ClientOptions{}))
+	if err != nil {
+		return "", err
+	}
+	prId, err := fetchPullRequestId(client, prUrl)
+	if err != nil {
+		return "", err
+	}
+
+	var mutation struct {
+		UpdatePullRequestBranch struct {
+			PullRequest struct {
+				Mergeable string
+			}
+		} `graphql:"updatePullRequestBranch(input: $input)"`
+	}
+	updateMethod := githubv4.PullRequestBranchUpdateMethodMerge
+	if method == BranchUpdateRebase {
+		updateMethod = githubv4.PullRequestBranchUpdateMethodRebase
+	}
+	input := githubv4.UpdatePullRequestBranchInput{
+		PullRequestID: prId,
+		UpdateMethod:  &updateMethod,
+	}
+	log.Debug("Updating PR branch", "url", prUrl, "method", method)
+	err = client.Mutate("UpdatePullRequestBranch", &mutation, map[string]any{"input": input})
+	if err != nil {
+		return "", err
+	}
+	return mutation.UpdatePullRequestBranch.PullRequest.Mergeable, nil
+}
+
+// FetchMergeableStates fetches the mergeable states of the PRs with ids on
+// host, by URL
+func FetchMergeableStates(host string, ids []string) (map[string]string, error) {
+	c, err := searchClient(host)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]string, len(ids))
+	for start := 0; start < len(ids); start += maxNodeIds {
+		chunk := ids[start:min(start+maxNodeIds, len(ids))]
+		nodeIds := make([]graphql.ID, 0, len(chunk))
+		for _, id := range chunk {
+			nodeIds = append(nodeIds, graphql.ID(id))
+		}
+
+		var queryResult struct {
+			Nodes []struct {
+				PullRequest struct {
+					Url       string
+					Mergeable string
+				} `graphql:"... on PullRequest"`
+			} `graphql:"nodes(ids: $ids)"`
+		}
+		log.Debug("Fetching mergeable states", "count", len(chunk))
+		err := c.Query("FetchMergeableStates", &queryResult, map[string]any{"ids": nodeIds})
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range queryResult.Nodes {
+			if node.PullRequest.Url != "" {
+				states[node.PullRequest.Url] = node.PullRequest.Mergeable
+			}
+		}
+	}
+	return states, nil
+}
+
+// ParseBranchUpdateAnswer reads the answer to the prompt confirming a branch
+// update: m or y updates with defaultMethod, r rebases. It's false for the
+// answers declining the update.
+func ParseBranchUpdateAnswer(answer, defaultMethod string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y":
+		if defaultMethod == "" {
+			return BranchUpdateMerge, true
+		}
+		return defaultMethod, true
+	case "m":
+		return BranchUpdateMerge, true
+	case "r":
+		return BranchUpdateRebase, true
+	}
+	return "", false
+}
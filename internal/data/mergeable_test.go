@@ -0,0 +1,28 @@
+package data
+
+import "testing"
+
+func TestParseBranchUpdateAnswer(t *testing.T) {
+	tests := []struct {
+		name          string
+		answer        string
+		defaultMethod string
+		want          string
+		wantOk        bool
+	}{
+		{name: "yes merges by default", answer: "Y", want: BranchUpdateMerge, wantOk: true},
+		{name: "yes with rebase as default", answer: "y", defaultMethod: BranchUpdateRebase, want: BranchUpdateRebase, wantOk: true},
+		{name: "merge", answer: "m", defaultMethod: BranchUpdateRebase, want: BranchUpdateMerge, wantOk: true},
+		{name: "rebase", answer: " R ", want: BranchUpdateRebase, wantOk: true},
+		{name: "no", answer: "n"},
+		{name: "empty", answer: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseBranchUpdateAnswer(tt.answer, tt.defaultMethod)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("ParseBranchUpdateAnswer() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+type MergeQueueEntryState string
+
+const (
+	MergeQueueEntryStateQueued         MergeQueueEntryState = "QUEUED"
+	MergeQueueEntryStateAwaitingChecks MergeQueueEntryState = "AWAITING_CHECKS"
+	MergeQueueEntryStateMergeable      MergeQueueEntryState = "MERGEABLE"
+	MergeQueueEntryStateUnmergeable    MergeQueueEntryState = "UNMERGEABLE"
+	MergeQueueEntryStateLocked         MergeQueueEntryState = "LOCKED"
+)
+
+// MergeQueueEntry is the place of a PR in the merge queue of its base branch
+type MergeQueueEntry struct {
+	// Position is 0 for the PR merged next
+	Position int
+	State    MergeQueueEntryState
+}
+
+// Description describes the state of the entry, e.g. "Waiting for checks to
+// pass"
+func (e MergeQueueEntry) Description() string {
+	switch e.State {
+	case MergeQueueEntryStateQueued:
+		return "Waiting for its turn"
+	case MergeQueueEntryStateAwaitingChecks:
+		return "Waiting for checks to pass"
+	case MergeQueueEntryStateMergeable:
+		return "Ready to merge"
+	case MergeQueueEntryStateUnmergeable:
+		return "Can't be merged and will be removed from the queue"
+	case MergeQueueEntryStateLocked:
+		return "Being merged"
+	default:
+		return ""
+	}
+}
+
+// EnqueuePullRequest adds the PR at prUrl to the merge queue of its base
+// branch and returns its place in the queue
+func EnqueuePullRequest(prUrl string) (*MergeQueueEntry, error) {
+	client, err := newGraphQLClient(forUrl(prUrl, gh.ClientOptions{}))
+	if err != nil {
+		return nil, err
+	}
+	prId, err := fetchPullRequestId(client, prUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	var mutation struct {
+		EnqueuePullRequest struct {
+			MergeQueueEntry *MergeQueueEntry
+		} `graphql:"enqueuePullRequest(input: $input)"`
+	}
+	input := githubv4.EnqueuePullRequestInput{PullRequestID: prId}
+	log.Debug("Adding PR to the merge queue", "url", prUrl)
+	err = client.Mutate("EnqueuePullRequest", &mutation, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := mutation.EnqueuePullRequest.MergeQueueEntry
+	if entry == nil {
+		return nil, fmt.Errorf("%s wasn't added to the merge queue", prUrl)
+	}
+	return entry, nil
+}
+
+// DequeuePullRequest removes the PR at prUrl from the merge queue of its base
+// branch
+func DequeuePullRequest(prUrl string) error {
+	client, err := newGraphQLClient(forUrl(prUrl, gh.ClientOptions{}))
+	if err != nil {
+		return err
+	}
+	prId, err := fetchPullRequestId(client, prUrl)
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		DequeuePullRequest struct {
+			ClientMutationId *string
+		} `graphql:"dequeuePullRequest(input: $input)"`
+	}
+	input := githubv4.DequeuePullRequestInput{ID: prId}
+	log.Debug("Removing PR from the merge queue", "url", prUrl)
+	return client.Mutate("DequeuePullRequest", &mutation, map[string]any{"input": input})
+}
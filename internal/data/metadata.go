@@ -0,0 +1,131 @@
+package data
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	diskcache "github.com/dlvhdr/gh-dash/v4/internal/cache"
+)
+
+// MetadataKind is a kind of metadata of repos and orgs the pickers and the
+// autocompletes list
+type MetadataKind string
+
+const (
+	MetadataLabels     MetadataKind = "labels"
+	MetadataMilestones MetadataKind = "milestones"
+	// MetadataMembers are the users that can be mentioned in a repo
+	MetadataMembers MetadataKind = "members"
+	// MetadataTeams are the teams of an org
+	MetadataTeams MetadataKind = "teams"
+)
+
+// DefaultMetadataMaxAges are how long each kind of metadata is reused before
+// it's fetched again, milestones change more often than the rest
+var DefaultMetadataMaxAges = map[MetadataKind]time.Duration{
+	MetadataLabels:     24 * time.Hour,
+	MetadataMilestones: time.Hour,
+	MetadataMembers:    24 * time.Hour,
+	MetadataTeams:      24 * time.Hour,
+}
+
+type metadataEntry struct {
+	value     any
+	fetchedAt time.Time
+}
+
+type metadataState struct {
+	mu sync.Mutex
+	// dir is where the metadata is persisted across runs, empty to only
+	// keep it in memory
+	dir     string
+	maxAges map[MetadataKind]time.Duration
+	entries map[string]metadataEntry
+}
+
+var metadata = metadataState{
+	maxAges: DefaultMetadataMaxAges,
+	entries: map[string]metadataEntry{},
+}
+
+// SetMetadataCache sets the directory the metadata is persisted in, empty to
+// keep it in memory only, and how long each kind is reused. The kinds
+// missing from maxAges keep their default, a max age of 0 fetches the kind
+// every time.
+func SetMetadataCache(dir string, maxAges map[MetadataKind]time.Duration) {
+	metadata.mu.Lock()
+	defer metadata.mu.Unlock()
+	metadata.dir = dir
+	metadata.maxAges = map[MetadataKind]time.Duration{}
+	for kind, maxAge := range DefaultMetadataMaxAges {
+		metadata.maxAges[kind] = maxAge
+	}
+	for kind, maxAge := range maxAges {
+		metadata.maxAges[kind] = maxAge
+	}
+}
+
+func metadataKey(kind MetadataKind, key string) string {
+	return diskcache.Key("metadata", string(kind), strings.ToLower(key))
+}
+
+// cachedMetadata returns the metadata of kind for key, e.g. the labels of a
+// repo, from memory or from disk while it's not older than the max age of
+// kind. It's fetched and cached otherwise.
+func cachedMetadata[T any](kind MetadataKind, key string, fetch func() (T, error)) (T, error) {
+	cacheKey := metadataKey(kind, key)
+	metadata.mu.Lock()
+	dir, maxAge := metadata.dir, metadata.maxAges[kind]
+	entry, ok := metadata.entries[cacheKey]
+	metadata.mu.Unlock()
+
+	if maxAge > 0 {
+		if value, isT := entry.value.(T); ok && isT && time.Since(entry.fetchedAt) < maxAge {
+			return value, nil
+		}
+		if dir != "" {
+			if value, savedAt, err := diskcache.Read[T](dir, cacheKey, maxAge); err == nil {
+				storeMetadata(cacheKey, value, savedAt)
+				return value, nil
+			}
+		}
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+	storeMetadata(cacheKey, value, time.Now())
+	if dir != "" {
+		if err := diskcache.Write(dir, cacheKey, value); err != nil {
+			log.Debug("Failed caching metadata", "kind", kind, "key", key, "err", err)
+		}
+	}
+	return value, nil
+}
+
+func storeMetadata(cacheKey string, value any, fetchedAt time.Time) {
+	metadata.mu.Lock()
+	defer metadata.mu.Unlock()
+	metadata.entries[cacheKey] = metadataEntry{value: value, fetchedAt: fetchedAt}
+}
+
+// RefreshMetadata drops the cached metadata of kind for key, it's fetched
+// again the next time it's asked for
+func RefreshMetadata(kind MetadataKind, key string) {
+	cacheKey := metadataKey(kind, key)
+	metadata.mu.Lock()
+	dir := metadata.dir
+	delete(metadata.entries, cacheKey)
+	metadata.mu.Unlock()
+
+	if dir == "" {
+		return
+	}
+	if err := diskcache.Remove(dir, cacheKey); err != nil {
+		log.Debug("Failed removing cached metadata", "kind", kind, "key", key, "err", err)
+	}
+}
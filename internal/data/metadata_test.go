@@ -0,0 +1,93 @@
+package data
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCachedMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+		// restart drops the metadata kept in memory between the two reads,
+		// as if the dashboard was relaunched
+		restart   bool
+		refresh   bool
+		persisted bool
+		maxAge    time.Duration
+		wantFetch int
+	}{
+		{
+			name:      "reused from memory",
+			maxAge:    time.Hour,
+			wantFetch: 1,
+		},
+		{
+			name:      "reused from disk after a restart",
+			restart:   true,
+			persisted: true,
+			maxAge:    time.Hour,
+			wantFetch: 1,
+		},
+		{
+			name:      "fetched again after a restart without a cache dir",
+			restart:   true,
+			maxAge:    time.Hour,
+			wantFetch: 2,
+		},
+		{
+			name:      "fetched again once refreshed",
+			refresh:   true,
+			persisted: true,
+			maxAge:    time.Hour,
+			wantFetch: 2,
+		},
+		{
+			name:      "fetched every time with a max age of 0",
+			persisted: true,
+			wantFetch: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := ""
+			if tt.persisted {
+				dir = t.TempDir()
+			}
+			SetMetadataCache(dir, map[MetadataKind]time.Duration{MetadataLabels: tt.maxAge})
+			metadata.entries = map[string]metadataEntry{}
+			t.Cleanup(func() {
+				SetMetadataCache("", nil)
+				metadata.entries = map[string]metadataEntry{}
+			})
+
+			fetches := 0
+			fetch := func() ([]string, error) {
+				fetches++
+				return []string{"bug", "enhancement"}, nil
+			}
+
+			if _, err := cachedMetadata(MetadataLabels, "dlvhdr/gh-dash", fetch); err != nil {
+				t.Fatalf("cachedMetadata() err = %v", err)
+			}
+			if tt.restart {
+				metadata.entries = map[string]metadataEntry{}
+			}
+			if tt.refresh {
+				RefreshMetadata(MetadataLabels, "dlvhdr/gh-dash")
+			}
+			got, err := cachedMetadata(MetadataLabels, "DLVHDR/gh-dash", fetch)
+			if err != nil {
+				t.Fatalf("cachedMetadata() err = %v", err)
+			}
+
+			if want := []string{"bug", "enhancement"}; !slices.Equal(got, want) {
+				t.Errorf("cachedMetadata() = %v, want %v", got, want)
+			}
+			if fetches != tt.wantFetch {
+				t.Errorf("fetched %d times, want %d", fetches, tt.wantFetch)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// ItemMilestone is the milestone of a PR or an issue
+type ItemMilestone struct {
+	Number int
+	Title  string
+	DueOn  *time.Time
+}
+
+// FetchRepoMilestones fetches the open milestones of repo, as owner/name,
+// the ones due first first. They're reused from the metadata cache while
+// they're fresh.
+func FetchRepoMilestones(repo string) ([]ItemMilestone, error) {
+	owner, name, ok := strings.Cut(repo, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid repo name %q", repo)
+	}
+	return cachedMetadata(MetadataMilestones, repo, func() ([]ItemMilestone, error) {
+		return fetchRepoMilestones(owner, name)
+	})
+}
+
+func fetchRepoMilestones(owner, name string) ([]ItemMilestone, error) {
+	if err := initClient(); err != nil {
+		return nil, err
+	}
+	var queryResult struct {
+		Repository struct {
+			Milestones struct {
+				Nodes []ItemMilestone
+			} `graphql:"milestones(first: 100, states: OPEN, orderBy: {field: DUE_DATE, direction: ASC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]any{
+		"owner": graphql.String(owner),
+		"name":  graphql.String(name),
+	}
+	log.Debug("Fetching milestones", "owner", owner, "name", name)
+	if err := client.Query("FetchRepoMilestones", &queryResult, variables); err != nil {
+		return nil, err
+	}
+
+	return queryResult.Repository.Milestones.Nodes, nil
+}
@@ -0,0 +1,75 @@
+package data
+
+import (
+	"errors"
+	"net"
+	"strings"
+)
+
+// offlineMessages are printed by gh, or wrapped in the errors of its API
+// clients, when GitHub can't be reached
+var offlineMessages = []string{
+	"error connecting to",
+	"no such host",
+	"connection refused",
+	"network is unreachable",
+	"i/o timeout",
+	"TLS handshake timeout",
+}
+
+// IsOffline returns whether err means that GitHub couldn't be reached, as
+// opposed to GitHub rejecting the request
+func IsOffline(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	msg := err.Error()
+	for _, m := range offlineMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// unsentMessages are printed by gh, or wrapped in the errors of its API
+// clients, when the connection to GitHub couldn't be opened
+var unsentMessages = []string{
+	"error connecting to",
+	"no such host",
+	"dial tcp",
+	"TLS handshake timeout",
+}
+
+// IsUnsent returns whether err means that the request never reached GitHub
+// because the connection couldn't be opened, e.g. a DNS or dial failure. Unlike
+// IsOffline it's false for timeouts once the request was sent, which GitHub
+// may have carried out, so only these requests are safe to send again.
+func IsUnsent(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	msg := err.Error()
+	for _, m := range unsentMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+)
+
+func TestIsOffline(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "no error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "dns error from an API client",
+			err: &url.Error{Op: "Post", URL: "https://api.github.com/graphql", Err: &net.DNSError{
+				Err: "no such host", Name: "api.github.com", IsNotFound: true,
+			}},
+			want: true,
+		},
+		{
+			name: "wrapped network error",
+			err:  fmt.Errorf("fetching PRs: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}),
+			want: true,
+		},
+		{
+			name: "gh output",
+			err:  errors.New("exit status 1: error connecting to api.github.com\ncheck your internet connection"),
+			want: true,
+		},
+		{
+			name: "rejected request",
+			err:  errors.New("GraphQL: Could not resolve to a PullRequest with the number of 1."),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsOffline(tt.err); got != tt.want {
+				t.Errorf("IsOffline() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsUnsent(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "no error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "dns error from an API client",
+			err: &url.Error{Op: "Post", URL: "https://api.github.com/graphql", Err: &net.DNSError{
+				Err: "no such host", Name: "api.github.com", IsNotFound: true,
+			}},
+			want: true,
+		},
+		{
+			name: "dial error",
+			err:  fmt.Errorf("posting comment: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}),
+			want: true,
+		},
+		{
+			name: "read timeout",
+			err:  &url.Error{Op: "Post", URL: "https://api.github.com/graphql", Err: &net.OpError{Op: "read", Err: errors.New("i/o timeout")}},
+			want: false,
+		},
+		{
+			name: "gh output of a dns error",
+			err:  errors.New("exit status 1: error connecting to api.github.com\ncheck your internet connection"),
+			want: true,
+		},
+		{
+			name: "gh output of a refused connection",
+			err:  errors.New("exit status 1: Post \"https://api.github.com/graphql\": dial tcp 140.82.112.6:443: connect: connection refused"),
+			want: true,
+		},
+		{
+			name: "gh output of a response timeout",
+			err:  errors.New("exit status 1: Post \"https://api.github.com/graphql\": read tcp 10.0.0.2:51234->140.82.112.6:443: i/o timeout"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsUnsent(tt.err); got != tt.want {
+				t.Errorf("IsUnsent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
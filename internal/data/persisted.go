@@ -0,0 +1,235 @@
+package data
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/log"
+)
+
+// GraphQLOptions pins what is asked of the GraphQL API, for GitHub
+// Enterprise Server instances that only accept known query shapes
+type GraphQLOptions struct {
+	// Features are sent in the GraphQL-Features header
+	Features []string
+	// ApiVersion is sent in the X-GitHub-Api-Version header
+	ApiVersion string
+	// PersistedQueries is "auto" to send the hash of queries and their text
+	// only when the server doesn't know it, "only" to never send the text
+	PersistedQueries string
+	// Manifest is the JSON file the queries sent are recorded in, by hash
+	Manifest string
+}
+
+const (
+	PersistedQueriesOff  = "off"
+	PersistedQueriesAuto = "auto"
+	PersistedQueriesOnly = "only"
+)
+
+type graphQLState struct {
+	mu   sync.Mutex
+	opts GraphQLOptions
+	// recorded are the queries of the manifest, by hash
+	recorded map[string]string
+}
+
+var graphQL = graphQLState{}
+
+// SetGraphQLOptions sets the options the GraphQL requests sent from now on
+// are made with
+func SetGraphQLOptions(opts GraphQLOptions) {
+	graphQL.mu.Lock()
+	defer graphQL.mu.Unlock()
+	graphQL.opts = opts
+	graphQL.recorded = nil
+}
+
+func currentGraphQLOptions() GraphQLOptions {
+	graphQL.mu.Lock()
+	defer graphQL.mu.Unlock()
+	return graphQL.opts
+}
+
+// graphQLRequest is the body of a GraphQL request, extensions carries the
+// hash of a persisted query
+type graphQLRequest struct {
+	Query         string          `json:"query,omitempty"`
+	OperationName string          `json:"operationName,omitempty"`
+	Variables     json.RawMessage `json:"variables,omitempty"`
+	Extensions    *struct {
+		PersistedQuery persistedQuery `json:"persistedQuery"`
+	} `json:"extensions,omitempty"`
+}
+
+type persistedQuery struct {
+	Version    int    `json:"version"`
+	Sha256Hash string `json:"sha256Hash"`
+}
+
+// queryHash is the hash a query is persisted under
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+// persistQuery rewrites the body of a GraphQL request to refer to its query
+// by hash, the query text is kept when withQuery is set. It returns the
+// query and its hash, e.g. to record them.
+func persistQuery(body []byte, withQuery bool) (persisted []byte, query string, hash string, err error) {
+	var req graphQLRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, "", "", err
+	}
+	if req.Query == "" {
+		return nil, "", "", errors.New("the request has no query to persist")
+	}
+
+	query = req.Query
+	hash = queryHash(query)
+	req.Extensions = &struct {
+		PersistedQuery persistedQuery `json:"persistedQuery"`
+	}{PersistedQuery: persistedQuery{Version: 1, Sha256Hash: hash}}
+	if !withQuery {
+		req.Query = ""
+	}
+	persisted, err = json.Marshal(req)
+	return persisted, query, hash, err
+}
+
+// isPersistedQueryNotFound returns whether the body of a GraphQL response
+// tells the hash of the query isn't known to the server
+func isPersistedQueryNotFound(body []byte) bool {
+	var res struct {
+		Errors []struct {
+			Message    string `json:"message"`
+			Extensions struct {
+				Code string `json:"code"`
+			} `json:"extensions"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return false
+	}
+	for _, e := range res.Errors {
+		if e.Message == "PersistedQueryNotFound" || e.Extensions.Code == "PERSISTED_QUERY_NOT_FOUND" {
+			return true
+		}
+	}
+	return false
+}
+
+// recordQuery adds query to the manifest of the options, the file is only
+// written when the query is new to it
+func recordQuery(manifest string, hash string, query string) {
+	graphQL.mu.Lock()
+	defer graphQL.mu.Unlock()
+
+	if graphQL.recorded == nil {
+		graphQL.recorded = map[string]string{}
+		if content, err := os.ReadFile(manifest); err == nil {
+			if err := json.Unmarshal(content, &graphQL.recorded); err != nil {
+				log.Warn("Failed reading the GraphQL manifest, it will be overwritten", "path", manifest, "err", err)
+				graphQL.recorded = map[string]string{}
+			}
+		}
+	}
+	if _, ok := graphQL.recorded[hash]; ok {
+		return
+	}
+
+	graphQL.recorded[hash] = query
+	content, err := json.MarshalIndent(graphQL.recorded, "", "  ")
+	if err == nil {
+		err = os.WriteFile(manifest, content, 0o644)
+	}
+	if err != nil {
+		log.Error("Failed writing the GraphQL manifest", "path", manifest, "err", err)
+	}
+}
+
+// graphQLTransport sends the pinned features and schema version along with
+// every GraphQL request, and persisted queries when they're enabled
+type graphQLTransport struct {
+	base http.RoundTripper
+}
+
+func (t graphQLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	opts := currentGraphQLOptions()
+	if len(opts.Features) == 0 && opts.ApiVersion == "" && opts.Manifest == "" &&
+		(opts.PersistedQueries == "" || opts.PersistedQueries == PersistedQueriesOff) {
+		return t.base.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	if len(opts.Features) > 0 {
+		req.Header.Set("GraphQL-Features", strings.Join(opts.Features, ","))
+	}
+	if opts.ApiVersion != "" {
+		req.Header.Set("X-GitHub-Api-Version", opts.ApiVersion)
+	}
+	if req.Body == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	persisted := opts.PersistedQueries == PersistedQueriesAuto || opts.PersistedQueries == PersistedQueriesOnly
+	hashOnly, query, hash, err := persistQuery(body, false)
+	if err != nil {
+		// not a query, e.g. a batch, it's sent as is
+		return t.send(req, body)
+	}
+	if opts.Manifest != "" {
+		recordQuery(opts.Manifest, hash, query)
+	}
+	if !persisted {
+		return t.send(req, body)
+	}
+
+	res, err := t.send(req, hashOnly)
+	if err != nil || opts.PersistedQueries == PersistedQueriesOnly {
+		return res, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if !isPersistedQueryNotFound(resBody) {
+		res.Body = io.NopCloser(bytes.NewReader(resBody))
+		return res, nil
+	}
+
+	// the server doesn't know the query yet, it's registered by sending it
+	// along with its hash
+	withQuery, _, _, err := persistQuery(body, true)
+	if err != nil {
+		return nil, err
+	}
+	return t.send(req, withQuery)
+}
+
+// send sends req with body
+func (t graphQLTransport) send(req *http.Request, body []byte) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,85 @@
+package data
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPersistQuery(t *testing.T) {
+	body := []byte(`{"query":"query Viewer{viewer{login}}","variables":{"limit":1000000}}`)
+	hash := queryHash("query Viewer{viewer{login}}")
+
+	tests := []struct {
+		name      string
+		withQuery bool
+		want      string
+	}{
+		{
+			name: "hash only",
+			want: `{"variables":{"limit":1000000},"extensions":{"persistedQuery":{"version":1,"sha256Hash":"` +
+				hash + `"}}}`,
+		},
+		{
+			name:      "with the query",
+			withQuery: true,
+			want: `{"query":"query Viewer{viewer{login}}","variables":{"limit":1000000},` +
+				`"extensions":{"persistedQuery":{"version":1,"sha256Hash":"` + hash + `"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, query, gotHash, err := persistQuery(body, tt.withQuery)
+			if err != nil {
+				t.Fatalf("persistQuery() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("persistQuery() = %s, want %s", got, tt.want)
+			}
+			if query != "query Viewer{viewer{login}}" || gotHash != hash {
+				t.Errorf("persistQuery() query, hash = %q, %q", query, gotHash)
+			}
+		})
+	}
+
+	if _, _, _, err := persistQuery([]byte(`[{"query":"a"}]`), false); err == nil {
+		t.Errorf("persistQuery() of a batch should fail")
+	}
+}
+
+func TestIsPersistedQueryNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		body any
+		want bool
+	}{
+		{name: "data", body: map[string]any{"data": map[string]any{}}, want: false},
+		{
+			name: "other error",
+			body: map[string]any{"errors": []any{map[string]any{"message": "Something went wrong"}}},
+			want: false,
+		},
+		{
+			name: "not found message",
+			body: map[string]any{"errors": []any{map[string]any{"message": "PersistedQueryNotFound"}}},
+			want: true,
+		},
+		{
+			name: "not found code",
+			body: map[string]any{"errors": []any{map[string]any{
+				"message":    "Unknown query",
+				"extensions": map[string]any{"code": "PERSISTED_QUERY_NOT_FOUND"},
+			}}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.body)
+			if got := isPersistedQueryNotFound(body); got != tt.want {
+				t.Errorf("isPersistedQueryNotFound(%s) = %v, want %v", body, got, tt.want)
+			}
+		})
+	}
+}
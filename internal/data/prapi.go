@@ -3,8 +3,10 @@ package data
 import (
 	"crypto/tls"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/log"
@@ -22,14 +24,21 @@ type EnrichedPullRequestData struct {
 	Number        int
 	Repository    Repository
 	Commits       CommitsWithStatusChecks   `graphql:"commits(last: 1)"`
-	Comments      CommentsWithBody          `graphql:"comments(last: 50, orderBy: { field: UPDATED_AT, direction: DESC })"`
+	Comments      CommentsWithBody          `graphql:"comments(last: 30)"`
 	ReviewThreads ReviewThreadsWithComments `graphql:"reviewThreads(last: 50)"`
+	TimelineItems TimelineItems             `graphql:"timelineItems(last: 100, itemTypes: [PULL_REQUEST_COMMIT, PULL_REQUEST_REVIEW, LABELED_EVENT, UNLABELED_EVENT, ASSIGNED_EVENT, UNASSIGNED_EVENT, CROSS_REFERENCED_EVENT, DEPLOYED_EVENT, HEAD_REF_FORCE_PUSHED_EVENT])"`
+	// Body, Labels and Assignees are only fetched when they're trimmed from
+	// the list queries
+	Body      string    `graphql:"body @skip(if: $withBody)"`
+	Labels    PRLabels  `graphql:"labels(first: 20) @skip(if: $withLabels)"`
+	Assignees Assignees `graphql:"assignees(first: 20) @skip(if: $withAssignees)"`
 }
 
 type PullRequestData struct {
+	Id     string
 	Number int
 	Title  string
-	Body   string
+	Body   string `graphql:"body @include(if: $withBody)"`
 	Author struct {
 		Login string
 	}
@@ -42,43 +51,82 @@ type PullRequestData struct {
 	ReviewDecision    string
 	Additions         int
 	Deletions         int
+	ChangedFiles      int
 	HeadRefName       string
+	HeadRefOid        string
 	BaseRefName       string
 	HeadRepository    struct {
 		Name string
+		Url  string
 	}
-	HeadRef struct {
+	// HeadRepositoryOwner is empty when the head repository was deleted
+	HeadRepositoryOwner struct {
+		Login string
+	}
+	IsCrossRepository bool
+	HeadRef           struct {
 		Name string
 	}
+	// BaseRef is nil when the base branch was deleted
+	BaseRef          *BaseRef
 	Repository       Repository
-	Assignees        Assignees      `graphql:"assignees(first: 3)"`
+	Assignees        Assignees      `graphql:"assignees(first: 3) @include(if: $withAssignees)"`
 	Comments         Comments       `graphql:"comments"`
 	ReviewThreads    ReviewThreads  `graphql:"reviewThreads"`
 	Reviews          Reviews        `graphql:"reviews(last: 3)"`
+	LatestReviews    LatestReviews  `graphql:"latestOpinionatedReviews(first: 20, writersOnly: true)"`
 	ReviewRequests   ReviewRequests `graphql:"reviewRequests(last: 5)"`
 	Files            ChangedFiles   `graphql:"files(first: 5)"`
 	IsDraft          bool
 	Commits          Commits          `graphql:"commits(last: 1)"`
-	Labels           PRLabels         `graphql:"labels(first: 6)"`
+	Labels           PRLabels         `graphql:"labels(first: 6) @include(if: $withLabels)"`
 	MergeStateStatus MergeStateStatus `graphql:"mergeStateStatus"`
+	// IsMergeQueueEnabled is whether the base branch merges PRs through a
+	// merge queue
+	IsMergeQueueEnabled bool
+	MergeQueueEntry     *MergeQueueEntry
+	Milestone           *ItemMilestone
 }
 
 type CheckRun struct {
-	Name       graphql.String
-	Status     graphql.String
-	Conclusion checks.CheckRunState
-	CheckSuite struct {
+	// DatabaseId is the id of the job for the check runs of GitHub Actions
+	DatabaseId  graphql.Int
+	Name        graphql.String
+	Status      graphql.String
+	Conclusion  checks.CheckRunState
+	StartedAt   time.Time
+	CompletedAt time.Time
+	CheckSuite  struct {
 		Creator struct {
 			Login graphql.String
 		}
 		WorkflowRun struct {
-			Workflow struct {
+			DatabaseId graphql.Int
+			Workflow   struct {
 				Name graphql.String
 			}
 		}
 	}
 }
 
+// IsActionsJob returns whether the check run is a job of a GitHub Actions
+// workflow run, which can be re-run and has logs
+func (checkRun CheckRun) IsActionsJob() bool {
+	return checkRun.DatabaseId != 0 && checkRun.CheckSuite.WorkflowRun.DatabaseId != 0
+}
+
+// Duration is how long the check run took, or has been running for if it
+// isn't completed yet
+func (checkRun CheckRun) Duration() time.Duration {
+	if checkRun.StartedAt.IsZero() {
+		return 0
+	}
+	if !checkRun.CompletedAt.IsZero() {
+		return checkRun.CompletedAt.Sub(checkRun.StartedAt)
+	}
+	return time.Since(checkRun.StartedAt)
+}
+
 type StatusContext struct {
 	Context graphql.String
 	State   graphql.String
@@ -120,6 +168,14 @@ type CommitsWithStatusChecks struct {
 type CommentsWithBody struct {
 	TotalCount graphql.Int
 	Nodes      []Comment
+	PageInfo   CommentsPageInfo
+}
+
+// CommentsPageInfo is the page info of comments fetched newest page first,
+// older pages come before StartCursor
+type CommentsPageInfo struct {
+	HasPreviousPage bool
+	StartCursor     string
 }
 
 type ContextCountByState = struct {
@@ -161,6 +217,7 @@ type ReviewComment struct {
 	UpdatedAt time.Time
 	StartLine int
 	Line      int
+	DiffHunk  string
 }
 
 type ReviewComments struct {
@@ -190,16 +247,19 @@ type Reviews struct {
 	Nodes      []Review
 }
 
+type ReviewThread struct {
+	Id           string
+	IsOutdated   bool
+	IsResolved   bool
+	OriginalLine int
+	StartLine    int
+	Line         int
+	Path         string
+	Comments     ReviewComments `graphql:"comments(first: 20)"`
+}
+
 type ReviewThreadsWithComments struct {
-	Nodes []struct {
-		Id           string
-		IsOutdated   bool
-		OriginalLine int
-		StartLine    int
-		Line         int
-		Path         string
-		Comments     ReviewComments `graphql:"comments(first: 20)"`
-	}
+	Nodes []ReviewThread
 }
 
 type ChangedFile struct {
@@ -216,8 +276,18 @@ type ChangedFiles struct {
 
 type ReviewRequests struct {
 	TotalCount int
-	Nodes      []struct {
-		AsCodeOwner bool `graphql:"asCodeOwner"`
+	Nodes      []ReviewRequest
+}
+
+type ReviewRequest struct {
+	AsCodeOwner       bool `graphql:"asCodeOwner"`
+	RequestedReviewer struct {
+		User struct {
+			Login string
+		} `graphql:"... on User"`
+		Team struct {
+			Slug string
+		} `graphql:"... on Team"`
 	}
 }
 
@@ -286,65 +356,94 @@ func SetClient(c *gh.GraphQLClient) {
 	client = c
 }
 
+// initClient creates the client used for searching, unless one was set
+func initClient() error {
+	if client != nil {
+		return nil
+	}
+
+	var err error
+	if config.IsFeatureEnabled(config.FF_MOCK_DATA) {
+		log.Info("using mock data", "server", "https://localhost:3000")
+		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		client, err = newGraphQLClient(gh.ClientOptions{Host: "localhost:3000", AuthToken: "fake-token"})
+	} else {
+		client, err = newGraphQLClient(gh.ClientOptions{})
+	}
+	return err
+}
+
+type pullRequestNode struct {
+	PullRequest PullRequestData `graphql:"... on PullRequest"`
+}
+
 func FetchPullRequests(query string, limit int, pageInfo *PageInfo) (PullRequestsResponse, error) {
+	return fetchPullRequests("", query, limit, pageInfo)
+}
+
+// fetchPullRequests searches the PRs of host, the default one when it's
+// empty. Only the searches of the default host are batched.
+func fetchPullRequests(host, query string, limit int, pageInfo *PageInfo) (PullRequestsResponse, error) {
+	var search searchConnection[pullRequestNode]
 	var err error
-	if client == nil {
-		if config.IsFeatureEnabled(config.FF_MOCK_DATA) {
-			log.Info("using mock data", "server", "https://localhost:3000")
-			http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-			client, err = gh.NewGraphQLClient(gh.ClientOptions{Host: "localhost:3000", AuthToken: "fake-token"})
-		} else {
-			client, err = gh.DefaultGraphQLClient()
+	if pageInfo == nil && IsDefaultHost(host) {
+		err = batchSearch(makePullRequestsQuery(query), limit, &search, func() error {
+			return searchPullRequests("", query, limit, nil, &search)
+		})
+	} else {
+		err = searchPullRequests(host, query, limit, pageInfo, &search)
+	}
+	if err != nil {
+		return PullRequestsResponse{}, err
+	}
+	log.Info("Successfully fetched PRs", "count", search.IssueCount)
+
+	prs := make([]PullRequestData, 0, len(search.Nodes))
+	for _, node := range search.Nodes {
+		if node.PullRequest.Repository.IsArchived {
+			continue
 		}
+		prs = append(prs, node.PullRequest)
 	}
 
+	return PullRequestsResponse{
+		Prs:        prs,
+		TotalCount: search.IssueCount,
+		PageInfo:   search.PageInfo,
+	}, nil
+}
+
+// searchPullRequests fetches the page of PRs after pageInfo on its own
+func searchPullRequests(host, query string, limit int, pageInfo *PageInfo, search *searchConnection[pullRequestNode]) error {
+	client, err := searchClient(host)
 	if err != nil {
-		return PullRequestsResponse{}, err
+		return err
 	}
 
 	var queryResult struct {
-		Search struct {
-			Nodes []struct {
-				PullRequest PullRequestData `graphql:"... on PullRequest"`
-			}
-			IssueCount int
-			PageInfo   PageInfo
-		} `graphql:"search(type: ISSUE, first: $limit, after: $endCursor, query: $query)"`
+		Search searchConnection[pullRequestNode] `graphql:"search(type: ISSUE, first: $limit, after: $endCursor, query: $query)"`
 	}
 	var endCursor *string
 	if pageInfo != nil {
 		endCursor = &pageInfo.EndCursor
 	}
-	variables := map[string]any{
+	variables := withListFields(map[string]any{
 		"query":     graphql.String(makePullRequestsQuery(query)),
 		"limit":     graphql.Int(limit),
 		"endCursor": (*graphql.String)(endCursor),
-	}
+	})
 	log.Debug("Fetching PRs", "query", query, "limit", limit, "endCursor", endCursor)
-	err = client.Query("SearchPullRequests", &queryResult, variables)
-	if err != nil {
-		return PullRequestsResponse{}, err
+	if err := client.Query("SearchPullRequests", &queryResult, variables); err != nil {
+		return err
 	}
-	log.Info("Successfully fetched PRs", "count", queryResult.Search.IssueCount)
 
-	prs := make([]PullRequestData, 0, len(queryResult.Search.Nodes))
-	for _, node := range queryResult.Search.Nodes {
-		if node.PullRequest.Repository.IsArchived {
-			continue
-		}
-		prs = append(prs, node.PullRequest)
-	}
-
-	return PullRequestsResponse{
-		Prs:        prs,
-		TotalCount: queryResult.Search.IssueCount,
-		PageInfo:   queryResult.Search.PageInfo,
-	}, nil
+	*search = queryResult.Search
+	return nil
 }
 
 func FetchPullRequest(prUrl string) (EnrichedPullRequestData, error) {
 	var err error
-	client, err := gh.NewGraphQLClient(gh.ClientOptions{EnableCache: true, CacheTTL: 5 * time.Minute})
+	client, err := newGraphQLClient(forUrl(prUrl, gh.ClientOptions{EnableCache: true, CacheTTL: 5 * time.Minute}))
 	if err != nil {
 		return EnrichedPullRequestData{}, err
 	}
@@ -358,9 +457,9 @@ func FetchPullRequest(prUrl string) (EnrichedPullRequestData, error) {
 	if err != nil {
 		return EnrichedPullRequestData{}, err
 	}
-	variables := map[string]any{
+	variables := withListFields(map[string]any{
 		"url": githubv4.URI{URL: parsedUrl},
-	}
+	})
 	log.Debug("Fetching PR", "url", prUrl)
 	err = client.Query("FetchPullRequest", &queryResult, variables)
 	if err != nil {
@@ -370,3 +469,75 @@ func FetchPullRequest(prUrl string) (EnrichedPullRequestData, error) {
 
 	return queryResult.Resource.PullRequest, nil
 }
+
+// commentsPageSize is how many older comments are fetched at a time
+const commentsPageSize = 30
+
+// FetchOlderComments fetches the page of comments of the PR or issue at itemUrl
+// that comes before the cursor
+func FetchOlderComments(itemUrl string, before string) (CommentsWithBody, error) {
+	client, err := newGraphQLClient(forUrl(itemUrl, gh.ClientOptions{EnableCache: true, CacheTTL: 5 * time.Minute}))
+	if err != nil {
+		return CommentsWithBody{}, err
+	}
+
+	var queryResult struct {
+		Resource struct {
+			PullRequest struct {
+				Comments CommentsWithBody `graphql:"comments(last: $pageSize, before: $before)"`
+			} `graphql:"... on PullRequest"`
+			Issue struct {
+				Comments CommentsWithBody `graphql:"comments(last: $pageSize, before: $before)"`
+			} `graphql:"... on Issue"`
+		} `graphql:"resource(url: $url)"`
+	}
+	parsedUrl, err := url.Parse(itemUrl)
+	if err != nil {
+		return CommentsWithBody{}, err
+	}
+	variables := map[string]any{
+		"url":      githubv4.URI{URL: parsedUrl},
+		"before":   graphql.String(before),
+		"pageSize": graphql.Int(commentsPageSize),
+	}
+	log.Debug("Fetching older comments", "url", itemUrl, "before", before)
+	err = client.Query("FetchOlderComments", &queryResult, variables)
+	if err != nil {
+		return CommentsWithBody{}, err
+	}
+
+	comments := queryResult.Resource.Issue.Comments
+	if strings.Contains(parsedUrl.Path, "/pull/") {
+		comments = queryResult.Resource.PullRequest.Comments
+	}
+	log.Info("Successfully fetched older comments", "url", itemUrl, "count", len(comments.Nodes))
+
+	return comments, nil
+}
+
+// FetchPullRequestDiff fetches the unified diff of a PR
+func FetchPullRequestDiff(repoNameWithOwner string, number int) (string, error) {
+	client, err := newRESTClient(gh.ClientOptions{
+		EnableCache: true,
+		CacheTTL:    5 * time.Minute,
+		Headers:     map[string]string{"Accept": "application/vnd.github.v3.diff"},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	log.Debug("Fetching PR diff", "repo", repoNameWithOwner, "number", number)
+	res, err := client.Request(http.MethodGet, fmt.Sprintf("repos/%s/pulls/%d", repoNameWithOwner, number), nil)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	diff, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	log.Info("Successfully fetched PR diff", "repo", repoNameWithOwner, "number", number, "bytes", len(diff))
+
+	return string(diff), nil
+}
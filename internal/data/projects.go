@@ -0,0 +1,172 @@
+package data
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// ProjectFields are the values of the fields of a PR or issue in a project
+// (v2), by field name, e.g. "Status": "In Progress"
+type ProjectFields map[string]string
+
+// maxNodeIds is how many nodes GitHub returns in one nodes query
+const maxNodeIds = 100
+
+type projectFieldName struct {
+	Common struct {
+		Name string
+	} `graphql:"... on ProjectV2FieldCommon"`
+}
+
+type projectFieldValue struct {
+	SingleSelect struct {
+		Name  string
+		Field projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	Iteration struct {
+		Title string
+		Field projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+	Text struct {
+		Text  string
+		Field projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldTextValue"`
+	Number struct {
+		Number *float64
+		Field  projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+	Date struct {
+		Date  string
+		Field projectFieldName
+	} `graphql:"... on ProjectV2ItemFieldDateValue"`
+}
+
+// field returns the name of the field and its value, the value is empty for
+// the kinds of fields that aren't shown, like labels or assignees
+func (v projectFieldValue) field() (name, value string) {
+	switch {
+	case v.SingleSelect.Name != "":
+		return v.SingleSelect.Field.Common.Name, v.SingleSelect.Name
+	case v.Iteration.Title != "":
+		return v.Iteration.Field.Common.Name, v.Iteration.Title
+	case v.Text.Text != "":
+		return v.Text.Field.Common.Name, v.Text.Text
+	case v.Number.Number != nil:
+		return v.Number.Field.Common.Name, strconv.FormatFloat(*v.Number.Number, 'f', -1, 64)
+	case v.Date.Date != "":
+		return v.Date.Field.Common.Name, v.Date.Date
+	}
+	return "", ""
+}
+
+type projectItem struct {
+	Project struct {
+		Url string
+	}
+	FieldValues struct {
+		Nodes []projectFieldValue
+	} `graphql:"fieldValues(first: 20)"`
+}
+
+type projectItems struct {
+	Nodes []projectItem
+}
+
+type projectItemsNode struct {
+	PullRequest struct {
+		Url          string
+		ProjectItems projectItems `graphql:"projectItems(first: 10)"`
+	} `graphql:"... on PullRequest"`
+	Issue struct {
+		Url          string
+		ProjectItems projectItems `graphql:"projectItems(first: 10)"`
+	} `graphql:"... on Issue"`
+}
+
+// FetchProjectFields fetches the project fields of the PRs and issues with
+// ids, by their URL. When project is set, as owner/number like the project
+// search qualifier, only the fields of that project are returned, otherwise
+// the first project of an item with a field wins. The token needs the
+// read:project scope.
+func FetchProjectFields(ids []string, project string) (map[string]ProjectFields, error) {
+	if err := initClient(); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]ProjectFields, len(ids))
+	for start := 0; start < len(ids); start += maxNodeIds {
+		chunk := ids[start:min(start+maxNodeIds, len(ids))]
+		nodeIds := make([]graphql.ID, 0, len(chunk))
+		for _, id := range chunk {
+			nodeIds = append(nodeIds, graphql.ID(id))
+		}
+
+		var queryResult struct {
+			Nodes []projectItemsNode `graphql:"nodes(ids: $ids)"`
+		}
+		variables := map[string]any{
+			"ids": nodeIds,
+		}
+		log.Debug("Fetching project fields", "count", len(chunk))
+		if err := client.Query("FetchProjectFields", &queryResult, variables); err != nil {
+			return nil, err
+		}
+
+		for _, node := range queryResult.Nodes {
+			url, items := node.PullRequest.Url, node.PullRequest.ProjectItems.Nodes
+			if url == "" {
+				url, items = node.Issue.Url, node.Issue.ProjectItems.Nodes
+			}
+			if url != "" {
+				fields[url] = projectItemFields(items, project)
+			}
+		}
+	}
+	return fields, nil
+}
+
+// projectItemFields merges the fields of an item's projects, see
+// FetchProjectFields
+func projectItemFields(items []projectItem, project string) ProjectFields {
+	fields := ProjectFields{}
+	for _, item := range items {
+		if project != "" && !isProject(item.Project.Url, project) {
+			continue
+		}
+		for _, v := range item.FieldValues.Nodes {
+			name, value := v.field()
+			if name == "" || value == "" {
+				continue
+			}
+			if _, ok := fields[name]; !ok {
+				fields[name] = value
+			}
+		}
+	}
+	return fields
+}
+
+// isProject returns whether url, like https://github.com/orgs/cli/projects/1,
+// is the url of project, like cli/1
+func isProject(url, project string) bool {
+	owner, number, ok := strings.Cut(project, "/")
+	if !ok {
+		return false
+	}
+	suffix := strings.ToLower("/" + owner + "/projects/" + number)
+	return strings.HasSuffix(strings.ToLower(url), suffix)
+}
+
+// ProjectFromSearch returns the project of the first project: qualifier of
+// query, e.g. cli/1 for project:cli/1
+func ProjectFromSearch(query string) string {
+	for token := range strings.FieldsSeq(query) {
+		if project, ok := strings.CutPrefix(token, "project:"); ok {
+			return project
+		}
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"maps"
+	"testing"
+)
+
+func singleSelect(field, value string) projectFieldValue {
+	var v projectFieldValue
+	v.SingleSelect.Name = value
+	v.SingleSelect.Field.Common.Name = field
+	return v
+}
+
+func iteration(field, value string) projectFieldValue {
+	var v projectFieldValue
+	v.Iteration.Title = value
+	v.Iteration.Field.Common.Name = field
+	return v
+}
+
+func number(field string, value float64) projectFieldValue {
+	var v projectFieldValue
+	v.Number.Number = &value
+	v.Number.Field.Common.Name = field
+	return v
+}
+
+func item(url string, values ...projectFieldValue) projectItem {
+	var i projectItem
+	i.Project.Url = url
+	i.FieldValues.Nodes = values
+	return i
+}
+
+func TestProjectItemFields(t *testing.T) {
+	roadmap := item("https://github.com/orgs/cli/projects/1",
+		singleSelect("Status", "In Progress"),
+		iteration("Iteration", "Sprint 4"),
+		number("Estimate", 2.5),
+		// fields like labels have no value
+		projectFieldValue{},
+	)
+	triage := item("https://github.com/users/mislav/projects/7",
+		singleSelect("Status", "Needs Triage"),
+		singleSelect("Priority", "P1"),
+	)
+
+	tests := []struct {
+		name    string
+		items   []projectItem
+		project string
+		want    ProjectFields
+	}{
+		{
+			name:  "no projects",
+			items: nil,
+			want:  ProjectFields{},
+		},
+		{
+			name:  "first project wins",
+			items: []projectItem{roadmap, triage},
+			want: ProjectFields{
+				"Status":    "In Progress",
+				"Iteration": "Sprint 4",
+				"Estimate":  "2.5",
+				"Priority":  "P1",
+			},
+		},
+		{
+			name:    "filtered by project",
+			items:   []projectItem{roadmap, triage},
+			project: "Mislav/7",
+			want: ProjectFields{
+				"Status":   "Needs Triage",
+				"Priority": "P1",
+			},
+		},
+		{
+			name:    "not in the project",
+			items:   []projectItem{roadmap},
+			project: "cli/10",
+			want:    ProjectFields{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := projectItemFields(tt.items, tt.project)
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("projectItemFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectFromSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "is:open author:@me", want: ""},
+		{query: "is:open project:cli/1", want: "cli/1"},
+		{query: "project:cli/1 project:cli/2", want: "cli/1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := ProjectFromSearch(tt.query); got != tt.want {
+				t.Errorf("ProjectFromSearch(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
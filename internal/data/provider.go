@@ -0,0 +1,358 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/charmbracelet/log"
+)
+
+// The forges sections can list PRs and issues from
+const (
+	ProviderGitHub = "github"
+	ProviderGitLab = "gitlab"
+	ProviderGitea  = "gitea"
+)
+
+// Provider fetches the PRs and issues of a section from a forge. Only GitHub
+// supports acting on them, the other forges are read-only.
+type Provider interface {
+	FetchPullRequests(query string, limit int, pageInfo *PageInfo) (PullRequestsResponse, error)
+	FetchIssues(query string, limit int, pageInfo *PageInfo) (IssuesResponse, error)
+}
+
+// gitHubProvider searches GitHub, or the GitHub Enterprise host when it's
+// set
+type gitHubProvider struct {
+	host string
+}
+
+func (p gitHubProvider) FetchPullRequests(query string, limit int, pageInfo *PageInfo) (PullRequestsResponse, error) {
+	return fetchPullRequests(p.host, query, limit, pageInfo)
+}
+
+func (p gitHubProvider) FetchIssues(query string, limit int, pageInfo *PageInfo) (IssuesResponse, error) {
+	return fetchIssues(p.host, query, limit, pageInfo)
+}
+
+// ForgeAuth is how the dashboard authenticates to a GitLab or Gitea host
+type ForgeAuth struct {
+	// TokenEnv is the environment variable holding the host's token
+	TokenEnv string
+	// AllowHttp is whether the token is sent to the host when its URL is http
+	AllowHttp bool
+}
+
+var (
+	providersMu sync.Mutex
+	providers   = map[string]Provider{}
+	// forgeAuths are the GitLab and Gitea hosts set up in the config, by host
+	forgeAuths = map[string]ForgeAuth{}
+)
+
+// defaultForgeTokenEnvs are the environment variables the tokens of the
+// default hosts of the forges are also read from
+var defaultForgeTokenEnvs = map[string]string{
+	"gitlab.com":   "GITLAB_TOKEN",
+	"codeberg.org": "GITEA_TOKEN",
+}
+
+// SetForgeAuths sets how the GitLab and Gitea hosts authenticate from now
+// on, by host
+func SetForgeAuths(auths map[string]ForgeAuth) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	forgeAuths = map[string]ForgeAuth{}
+	for host, auth := range auths {
+		forgeAuths[forgeHostName(host)] = auth
+	}
+	providers = map[string]Provider{}
+}
+
+// GetProvider returns the provider of the forge of kind at host, the default
+// host of the forge when it's empty. GitHub hosts use the token gh is logged
+// in to them with, GitLab and Gitea hosts the token of their own, see
+// forgeToken.
+func GetProvider(kind, host string) (Provider, error) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+
+	key := kind + "|" + host
+	if p, ok := providers[key]; ok {
+		return p, nil
+	}
+
+	var p Provider
+	switch kind {
+	case "", ProviderGitHub:
+		p = gitHubProvider{host: host}
+	case ProviderGitLab, ProviderGitea:
+		if host == "" {
+			host = "gitlab.com"
+			if kind == ProviderGitea {
+				host = "codeberg.org"
+			}
+		}
+		token, err := forgeToken(host)
+		if err != nil {
+			return nil, err
+		}
+		p = newForge(kind, host, token)
+	default:
+		return nil, fmt.Errorf("unknown provider %q, expected github, gitlab or gitea", kind)
+	}
+	providers[key] = p
+	return p, nil
+}
+
+// forgeToken returns the token of the GitLab or Gitea host, read from the
+// tokenEnv of the host in the config, <HOST>_TOKEN by default. The tokens of
+// gitlab.com and codeberg.org are also read from $GITLAB_TOKEN and
+// $GITEA_TOKEN. The token isn't sent over plain http unless the host allows
+// it, it's an error instead. providersMu must be held.
+func forgeToken(host string) (string, error) {
+	name := forgeHostName(host)
+	auth := forgeAuths[name]
+	token := os.Getenv(firstNonEmpty(auth.TokenEnv, forgeTokenEnv(name)))
+	if token == "" && auth.TokenEnv == "" {
+		if env, ok := defaultForgeTokenEnvs[name]; ok {
+			token = os.Getenv(env)
+		}
+	}
+	if token != "" && !strings.HasPrefix(forgeBaseUrl(host), "https://") && !auth.AllowHttp {
+		return "", fmt.Errorf("not sending the token of %s over plain http, set allowHttp on the host in the config to allow it", name)
+	}
+	return token, nil
+}
+
+// forgeHostName returns the host's name without the scheme of its URL, e.g.
+// gitlab.example.com:8080 for http://gitlab.example.com:8080/
+func forgeHostName(host string) string {
+	if _, rest, ok := strings.Cut(host, "://"); ok {
+		host = rest
+	}
+	return strings.ToLower(strings.TrimSuffix(host, "/"))
+}
+
+// forgeTokenEnv returns the environment variable holding the token of the
+// host by default, e.g. GITLAB_EXAMPLE_COM_TOKEN for gitlab.example.com
+func forgeTokenEnv(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, name) + "_TOKEN"
+}
+
+// forgeBaseUrl returns the URL of the host, https unless it has a scheme
+func forgeBaseUrl(host string) string {
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	return strings.TrimSuffix(host, "/")
+}
+
+// forgeQuery holds the filters of a GitLab or Gitea section, a subset of
+// GitHub's search qualifiers
+type forgeQuery struct {
+	Repo     string
+	State    string
+	Author   string
+	Assignee string
+	Reviewer string
+	Labels   []string
+	// Milestone is the title of the milestone, its quotes trimmed
+	Milestone string
+	Search    string
+}
+
+// parseForgeQuery parses filters like "repo:group/project is:open
+// author:@me fix" into the parameters of the GitLab and Gitea APIs
+func parseForgeQuery(filters string) (forgeQuery, error) {
+	q := forgeQuery{State: "open"}
+	var words []string
+	for token := range strings.FieldsSeq(filters) {
+		key, value, ok := strings.Cut(token, ":")
+		if !ok {
+			words = append(words, token)
+			continue
+		}
+		if value == "" {
+			return q, fmt.Errorf("invalid filter %q, expected key:value", token)
+		}
+		switch key {
+		case "repo":
+			if q.Repo != "" && q.Repo != value {
+				return q, errors.New("only one repo:owner/name filter is supported outside GitHub")
+			}
+			q.Repo = value
+		case "is", "state":
+			switch value {
+			case "open", "opened":
+				q.State = "open"
+			case "closed", "merged", "all":
+				q.State = value
+			case "pr", "issue":
+			default:
+				return q, fmt.Errorf("unsupported filter %q", token)
+			}
+		case "author":
+			q.Author = value
+		case "assignee":
+			q.Assignee = value
+		case "reviewer", "review-requested":
+			q.Reviewer = value
+		case "label":
+			q.Labels = append(q.Labels, value)
+		case "milestone":
+			q.Milestone = strings.Trim(value, `"`)
+		case "archived", "sort":
+			// GitHub's defaults, nothing to do
+		default:
+			return q, fmt.Errorf("unsupported filter %q", token)
+		}
+	}
+	q.Search = strings.Join(words, " ")
+	return q, nil
+}
+
+// forge is the read-only provider of GitLab and Gitea, it talks to their
+// REST APIs
+type forge struct {
+	kind    string
+	baseUrl string
+	token   string
+	client  *http.Client
+
+	meOnce sync.Once
+	me     string
+	meErr  error
+}
+
+func newForge(kind, host, token string) *forge {
+	return &forge{
+		kind:    kind,
+		baseUrl: forgeBaseUrl(host),
+		token:   token,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// get fetches the API path, decoding its JSON into out, and returns the
+// headers of the response for paging
+func (f *forge) get(path string, out any) (http.Header, error) {
+	req, err := http.NewRequest(http.MethodGet, f.baseUrl+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "gh-dash")
+	if f.token != "" {
+		switch f.kind {
+		case ProviderGitLab:
+			req.Header.Set("PRIVATE-TOKEN", f.token)
+		default:
+			req.Header.Set("Authorization", "token "+f.token)
+		}
+	}
+
+	log.Debug("Fetching from forge", "forge", f.kind, "path", path)
+	res, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return nil, fmt.Errorf("fetching %s%s: %s %s", f.baseUrl, path, res.Status,
+			strings.TrimSpace(string(body)))
+	}
+	return res.Header, json.NewDecoder(res.Body).Decode(out)
+}
+
+// user resolves @me to the login of the token's user
+func (f *forge) user(login string) (string, error) {
+	if login != "@me" {
+		return login, nil
+	}
+	f.meOnce.Do(func() {
+		var user struct {
+			Username string `json:"username"`
+			Login    string `json:"login"`
+		}
+		path := "/api/v4/user"
+		if f.kind == ProviderGitea {
+			path = "/api/v1/user"
+		}
+		_, f.meErr = f.get(path, &user)
+		f.me = firstNonEmpty(user.Username, user.Login)
+	})
+	return f.me, f.meErr
+}
+
+// forgePage returns the page to fetch after pageInfo
+func forgePage(pageInfo *PageInfo) int {
+	if pageInfo == nil {
+		return 1
+	}
+	page, err := strconv.Atoi(pageInfo.EndCursor)
+	if err != nil || page < 1 {
+		return 1
+	}
+	return page
+}
+
+// forgePageInfo returns the paging of a response from its Link header, the
+// cursors are page numbers
+func forgePageInfo(header http.Header, page int) PageInfo {
+	hasNext := slices.ContainsFunc(header.Values("Link"), func(link string) bool {
+		return strings.Contains(link, `rel="next"`)
+	})
+	return PageInfo{
+		HasNextPage: hasNext,
+		StartCursor: strconv.Itoa(page),
+		EndCursor:   strconv.Itoa(page + 1),
+	}
+}
+
+// forgeTotalCount returns the total count of a response from its header,
+// fetched when it's missing, e.g. GitLab leaves it out for large results
+func forgeTotalCount(header http.Header, name string, fetched int) int {
+	if total, err := strconv.Atoi(header.Get(name)); err == nil {
+		return total
+	}
+	return fetched
+}
+
+func (f *forge) FetchPullRequests(query string, limit int, pageInfo *PageInfo) (PullRequestsResponse, error) {
+	q, err := parseForgeQuery(query)
+	if err != nil {
+		return PullRequestsResponse{}, err
+	}
+	if f.kind == ProviderGitLab {
+		return f.fetchGitLabMergeRequests(q, limit, forgePage(pageInfo))
+	}
+	return f.fetchGiteaPullRequests(q, limit, forgePage(pageInfo))
+}
+
+func (f *forge) FetchIssues(query string, limit int, pageInfo *PageInfo) (IssuesResponse, error) {
+	q, err := parseForgeQuery(query)
+	if err != nil {
+		return IssuesResponse{}, err
+	}
+	if f.kind == ProviderGitLab {
+		return f.fetchGitLabIssues(q, limit, forgePage(pageInfo))
+	}
+	return f.fetchGiteaIssues(q, limit, forgePage(pageInfo))
+}
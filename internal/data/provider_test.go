@@ -0,0 +1,251 @@
+package data
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseForgeQuery(t *testing.T) {
+	tests := []struct {
+		filters string
+		want    forgeQuery
+		wantErr bool
+	}{
+		{
+			filters: "",
+			want:    forgeQuery{State: "open"},
+		},
+		{
+			filters: "repo:group/project is:pr is:merged author:@me label:bug label:ui fix crash",
+			want: forgeQuery{
+				Repo:   "group/project",
+				State:  "merged",
+				Author: "@me",
+				Labels: []string{"bug", "ui"},
+				Search: "fix crash",
+			},
+		},
+		{
+			filters: "state:opened assignee:alice review-requested:@me archived:false",
+			want:    forgeQuery{State: "open", Assignee: "alice", Reviewer: "@me"},
+		},
+		{filters: "repo:a/b repo:c/d", wantErr: true},
+		{
+			filters: `milestone:"v1" label:bug`,
+			want:    forgeQuery{State: "open", Labels: []string{"bug"}, Milestone: "v1"},
+		},
+		{filters: "is:locked", wantErr: true},
+		{filters: "author:", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filters, func(t *testing.T) {
+			got, err := parseForgeQuery(tt.filters)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseForgeQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseForgeQuery() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetProvider(t *testing.T) {
+	if _, ok := mustGetProvider(t, "", "").(gitHubProvider); !ok {
+		t.Error("GetProvider() of no kind isn't GitHub")
+	}
+	if _, ok := mustGetProvider(t, ProviderGitLab, "").(*forge); !ok {
+		t.Error("GetProvider(gitlab) isn't a forge")
+	}
+	if _, err := GetProvider("bitbucket", ""); err == nil {
+		t.Error("GetProvider(bitbucket) didn't fail")
+	}
+}
+
+func TestGetProviderToken(t *testing.T) {
+	t.Cleanup(func() { SetForgeAuths(nil) })
+	t.Setenv("GITLAB_TOKEN", "gitlab.com token")
+	t.Setenv("GITLAB_EXAMPLE_COM_TOKEN", "example token")
+	t.Setenv("WORK_GITLAB_TOKEN", "work token")
+	t.Setenv("GITLAB_HOME_LAN_80_TOKEN", "home token")
+	SetForgeAuths(map[string]ForgeAuth{
+		"gitlab.work.com":           {TokenEnv: "WORK_GITLAB_TOKEN"},
+		"http://gitlab.home.lan:80": {AllowHttp: true},
+	})
+
+	tests := []struct {
+		name    string
+		host    string
+		want    string
+		wantErr bool
+	}{
+		{name: "default host", host: "", want: "gitlab.com token"},
+		{name: "token of the host", host: "gitlab.example.com", want: "example token"},
+		{name: "tokenEnv of the host", host: "https://gitlab.work.com/", want: "work token"},
+		{name: "host without a token", host: "gitlab.other.com", want: ""},
+		{name: "http host", host: "http://gitlab.example.com", wantErr: true},
+		{name: "http host allowing it", host: "http://gitlab.home.lan:80", want: "home token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := GetProvider(ProviderGitLab, tt.host)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := p.(*forge).token; got != tt.want {
+				t.Errorf("token = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func mustGetProvider(t *testing.T, kind, host string) Provider {
+	t.Helper()
+	p, err := GetProvider(kind, host)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// forgeServer serves body for path, checking the request's query
+func forgeServer(t *testing.T, path, query, body string, header http.Header) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/user" || r.URL.Path == "/api/v1/user" {
+			_, _ = w.Write([]byte(`{"username": "me", "login": "me"}`))
+			return
+		}
+		if r.URL.EscapedPath() != path {
+			t.Errorf("path = %s, want %s", r.URL.EscapedPath(), path)
+		}
+		if r.URL.RawQuery != query {
+			t.Errorf("query = %s, want %s", r.URL.RawQuery, query)
+		}
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGitLabMergeRequests(t *testing.T) {
+	srv := forgeServer(t,
+		"/api/v4/projects/group%2Fsub%2Fproject/merge_requests",
+		"author_username=me&labels=bug&milestone=v2.0&order_by=updated_at&page=1&per_page=20&state=opened&with_labels_details=true",
+		`[{
+			"iid": 12,
+			"title": "Fix crash",
+			"description": "It crashed",
+			"state": "opened",
+			"draft": true,
+			"source_branch": "fix",
+			"target_branch": "main",
+			"author": {"username": "alice"},
+			"assignees": [{"username": "bob"}],
+			"labels": [{"name": "bug", "color": "#d73a4a"}],
+			"milestone": {"iid": 4, "title": "v2.0", "due_date": "2024-06-30"},
+			"user_notes_count": 3,
+			"web_url": "https://gitlab.com/group/sub/project/-/merge_requests/12",
+			"references": {"full": "group/sub/project!12"},
+			"created_at": "2024-05-01T10:00:00Z",
+			"updated_at": "2024-05-02T10:00:00Z"
+		}]`,
+		http.Header{"X-Total": {"31"}, "Link": {`<https://gitlab.com/next>; rel="next"`}},
+	)
+
+	p := newForge(ProviderGitLab, srv.URL, "token")
+	res, err := p.FetchPullRequests("repo:group/sub/project author:@me label:bug milestone:v2.0", 20, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := PullRequestData{
+		Number:      12,
+		Title:       "Fix crash",
+		Body:        "It crashed",
+		State:       "OPEN",
+		IsDraft:     true,
+		HeadRefName: "fix",
+		BaseRefName: "main",
+		Url:         "https://gitlab.com/group/sub/project/-/merge_requests/12",
+		Repository:  Repository{Name: "project", NameWithOwner: "group/sub/project"},
+		Assignees:   Assignees{Nodes: []Assignee{{Login: "bob"}}},
+		Labels:      PRLabels{Nodes: []Label{{Name: "bug", Color: "d73a4a"}}},
+		CreatedAt:   time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+	}
+	dueOn := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	want.Milestone = &ItemMilestone{Number: 4, Title: "v2.0", DueOn: &dueOn}
+	want.Author.Login = "alice"
+	want.Comments.TotalCount = 3
+
+	if len(res.Prs) != 1 || !reflect.DeepEqual(res.Prs[0], want) {
+		t.Errorf("Prs = %+v, want %+v", res.Prs, want)
+	}
+	if res.TotalCount != 31 {
+		t.Errorf("TotalCount = %d, want 31", res.TotalCount)
+	}
+	if !res.PageInfo.HasNextPage || res.PageInfo.EndCursor != "2" {
+		t.Errorf("PageInfo = %+v, want the next page 2", res.PageInfo)
+	}
+}
+
+func TestGiteaIssues(t *testing.T) {
+	srv := forgeServer(t,
+		"/api/v1/repos/issues/search",
+		"assigned=true&limit=10&page=2&state=closed&type=issues",
+		`[{
+			"number": 7,
+			"title": "Docs are wrong",
+			"body": "See the readme",
+			"state": "closed",
+			"user": {"login": "alice"},
+			"assignees": null,
+			"labels": [{"name": "docs", "color": "0075ca"}],
+			"comments": 1,
+			"html_url": "https://codeberg.org/owner/repo/issues/7",
+			"repository": {"name": "repo", "full_name": "owner/repo"},
+			"pull_request": null,
+			"created_at": "2024-05-01T10:00:00Z",
+			"updated_at": "2024-05-02T10:00:00Z"
+		}]`,
+		http.Header{"X-Total-Count": {"11"}},
+	)
+
+	p := newForge(ProviderGitea, srv.URL, "")
+	res, err := p.FetchIssues("is:closed assignee:@me", 10, &PageInfo{EndCursor: "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := IssueData{
+		Number:     7,
+		Title:      "Docs are wrong",
+		Body:       "See the readme",
+		State:      "CLOSED",
+		Url:        "https://codeberg.org/owner/repo/issues/7",
+		Repository: Repository{Name: "repo", NameWithOwner: "owner/repo"},
+		Labels:     IssueLabels{Nodes: []Label{{Name: "docs", Color: "0075ca"}}},
+		CreatedAt:  time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt:  time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+	}
+	want.Author.Login = "alice"
+	want.Comments.TotalCount = 1
+
+	if len(res.Issues) != 1 || !reflect.DeepEqual(res.Issues[0], want) {
+		t.Errorf("Issues = %+v, want %+v", res.Issues, want)
+	}
+	if res.TotalCount != 11 || res.PageInfo.HasNextPage {
+		t.Errorf("TotalCount = %d, PageInfo = %+v, want 11 and no next page", res.TotalCount, res.PageInfo)
+	}
+}
@@ -0,0 +1,147 @@
+package data
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+)
+
+type rateLimitState struct {
+	mu sync.Mutex
+	// quotas are the latest quotas responses told about, by their
+	// resource, e.g. graphql or core for the REST API
+	quotas map[string]RateLimit
+	// threshold is the share of a quota left, in percent, under which
+	// fetches are throttled
+	threshold int
+}
+
+var rateLimits = rateLimitState{quotas: map[string]RateLimit{}}
+
+// throttleMu sends the requests one at a time while throttled
+var throttleMu sync.Mutex
+
+// SetRateLimitThreshold sets the share of a quota left, in percent, under
+// which fetches are throttled until the quota resets. 0 never throttles.
+func SetRateLimitThreshold(threshold int) {
+	rateLimits.mu.Lock()
+	defer rateLimits.mu.Unlock()
+	rateLimits.threshold = threshold
+}
+
+// recordRateLimit keeps the quota of the X-RateLimit headers of a response,
+// responses without them, e.g. cached ones, are skipped
+func recordRateLimit(header http.Header) {
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil || limit <= 0 {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	resource := header.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = "core"
+	}
+
+	quota := RateLimit{Limit: limit, Remaining: remaining, ResetAt: time.Unix(reset, 0)}
+	rateLimits.mu.Lock()
+	defer rateLimits.mu.Unlock()
+	wasThrottled := rateLimits.isLow(rateLimits.quotas[resource], time.Now())
+	rateLimits.quotas[resource] = quota
+	if !wasThrottled && rateLimits.isLow(quota, time.Now()) {
+		log.Warn("Rate limit is running low, throttling fetches", "resource", resource,
+			"remaining", remaining, "limit", limit, "resetAt", quota.ResetAt)
+	}
+}
+
+// isLow returns whether the share left of quota is under the threshold, a
+// quota that was reset since isn't
+func (s *rateLimitState) isLow(quota RateLimit, now time.Time) bool {
+	if quota.Limit == 0 || !now.Before(quota.ResetAt) {
+		return false
+	}
+	return quota.Remaining*100 < s.threshold*quota.Limit
+}
+
+// LowestRateLimit returns the quota with the smallest share left among the
+// ones that haven't reset yet, ok is false when there's none
+func LowestRateLimit() (lowest RateLimit, ok bool) {
+	rateLimits.mu.Lock()
+	defer rateLimits.mu.Unlock()
+
+	now := time.Now()
+	for _, quota := range rateLimits.quotas {
+		if !now.Before(quota.ResetAt) {
+			continue
+		}
+		if !ok || quota.Remaining*lowest.Limit < lowest.Remaining*quota.Limit {
+			lowest, ok = quota, true
+		}
+	}
+	return lowest, ok
+}
+
+// ThrottledUntil returns when the quotas running low reset, ok is false when
+// none is
+func ThrottledUntil() (until time.Time, ok bool) {
+	rateLimits.mu.Lock()
+	defer rateLimits.mu.Unlock()
+
+	now := time.Now()
+	for _, quota := range rateLimits.quotas {
+		if rateLimits.isLow(quota, now) && quota.ResetAt.After(until) {
+			until, ok = quota.ResetAt, true
+		}
+	}
+	return until, ok
+}
+
+// rateLimitTransport records the quotas of GitHub's responses, and sends the
+// requests one at a time while a quota is running low
+type rateLimitTransport struct {
+	base http.RoundTripper
+}
+
+func (t rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if _, throttled := ThrottledUntil(); throttled {
+		throttleMu.Lock()
+		defer throttleMu.Unlock()
+	}
+	res, err := t.base.RoundTrip(req)
+	if err == nil {
+		recordRateLimit(res.Header)
+	}
+	return res, err
+}
+
+// withRateLimit returns opts with a transport tracking the rate limit, of
+// the GitHub App installation when the host uses one
+func withRateLimit(opts gh.ClientOptions) gh.ClientOptions {
+	opts = withAppAuth(opts)
+	base := opts.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	opts.Transport = rateLimitTransport{base: base}
+	return opts
+}
+
+func newGraphQLClient(opts gh.ClientOptions) (*gh.GraphQLClient, error) {
+	opts = withRateLimit(opts)
+	opts.Transport = graphQLTransport{base: opts.Transport}
+	return gh.NewGraphQLClient(opts)
+}
+
+func newRESTClient(opts gh.ClientOptions) (*gh.RESTClient, error) {
+	return gh.NewRESTClient(withRateLimit(opts))
+}
@@ -0,0 +1,134 @@
+package data
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func resetRateLimits(t *testing.T, threshold int) {
+	t.Helper()
+	rateLimits.mu.Lock()
+	rateLimits.quotas = map[string]RateLimit{}
+	rateLimits.mu.Unlock()
+	SetRateLimitThreshold(threshold)
+	t.Cleanup(func() {
+		rateLimits.mu.Lock()
+		rateLimits.quotas = map[string]RateLimit{}
+		rateLimits.mu.Unlock()
+		SetRateLimitThreshold(0)
+	})
+}
+
+func rateLimitHeader(resource string, limit, remaining int, resetAt time.Time) http.Header {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(resetAt.Unix(), 10))
+	header.Set("X-RateLimit-Resource", resource)
+	return header
+}
+
+func TestRecordRateLimit(t *testing.T) {
+	resetAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name          string
+		headers       []http.Header
+		wantLowest    *RateLimit
+		wantThrottled bool
+	}{
+		{
+			name:    "no headers",
+			headers: []http.Header{{}},
+		},
+		{
+			name:       "plenty left",
+			headers:    []http.Header{rateLimitHeader("graphql", 5000, 4000, resetAt)},
+			wantLowest: &RateLimit{Limit: 5000, Remaining: 4000, ResetAt: resetAt},
+		},
+		{
+			name: "lowest share of the resources",
+			headers: []http.Header{
+				rateLimitHeader("graphql", 5000, 4000, resetAt),
+				rateLimitHeader("core", 5000, 1000, resetAt),
+			},
+			wantLowest: &RateLimit{Limit: 5000, Remaining: 1000, ResetAt: resetAt},
+		},
+		{
+			name: "latest response wins",
+			headers: []http.Header{
+				rateLimitHeader("graphql", 5000, 100, resetAt),
+				rateLimitHeader("graphql", 5000, 4999, resetAt),
+			},
+			wantLowest: &RateLimit{Limit: 5000, Remaining: 4999, ResetAt: resetAt},
+		},
+		{
+			name:          "under the threshold",
+			headers:       []http.Header{rateLimitHeader("graphql", 5000, 499, resetAt)},
+			wantLowest:    &RateLimit{Limit: 5000, Remaining: 499, ResetAt: resetAt},
+			wantThrottled: true,
+		},
+		{
+			name:    "already reset",
+			headers: []http.Header{rateLimitHeader("graphql", 5000, 0, time.Now().Add(-time.Minute))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetRateLimits(t, 10)
+			for _, header := range tt.headers {
+				recordRateLimit(header)
+			}
+
+			lowest, ok := LowestRateLimit()
+			if ok != (tt.wantLowest != nil) {
+				t.Fatalf("LowestRateLimit() ok = %v, want %v", ok, tt.wantLowest != nil)
+			}
+			if ok && lowest != *tt.wantLowest {
+				t.Errorf("LowestRateLimit() = %+v, want %+v", lowest, *tt.wantLowest)
+			}
+			until, throttled := ThrottledUntil()
+			if throttled != tt.wantThrottled {
+				t.Errorf("ThrottledUntil() ok = %v, want %v", throttled, tt.wantThrottled)
+			}
+			if throttled && !until.Equal(resetAt) {
+				t.Errorf("ThrottledUntil() = %v, want %v", until, resetAt)
+			}
+		})
+	}
+}
+
+func TestRateLimitThresholdDisabled(t *testing.T) {
+	resetRateLimits(t, 0)
+	recordRateLimit(rateLimitHeader("graphql", 5000, 0, time.Now().Add(time.Hour)))
+	if _, throttled := ThrottledUntil(); throttled {
+		t.Error("ThrottledUntil() ok = true with a threshold of 0, want false")
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	resetRateLimits(t, 10)
+	resetAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		for k, v := range rateLimitHeader("graphql", 5000, 4321, resetAt) {
+			w.Header()[k] = v
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client := &http.Client{Transport: rateLimitTransport{base: http.DefaultTransport}}
+	res, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	want := RateLimit{Limit: 5000, Remaining: 4321, ResetAt: resetAt}
+	if got, ok := LowestRateLimit(); !ok || got != want {
+		t.Errorf("LowestRateLimit() = %+v, %v, want %+v", got, ok, want)
+	}
+}
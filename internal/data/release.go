@@ -0,0 +1,283 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// maxUnreleasedCommits is how many of the commits since the latest release
+// are fetched, they're all counted
+const maxUnreleasedCommits = 20
+
+// DefaultBlockerLabels are the labels of the PRs and issues blocking a
+// release when a release train configures none
+var DefaultBlockerLabels = []string{"release-blocker"}
+
+type ReleaseCommit struct {
+	AbbreviatedOid  string
+	MessageHeadline string
+	Url             string
+	CommittedDate   time.Time
+	Author          struct {
+		Name string
+		User *struct {
+			Login string
+		}
+	}
+}
+
+// AuthorName returns the login of the commit's author, or their git name if
+// they have no GitHub account
+func (c ReleaseCommit) AuthorName() string {
+	if c.Author.User != nil {
+		return c.Author.User.Login
+	}
+	return c.Author.Name
+}
+
+// ReleaseBlocker is an open PR or issue blocking the release of its repo
+type ReleaseBlocker struct {
+	Number int
+	Title  string
+	Url    string
+	IsPR   bool
+}
+
+// ReleaseTrainRepo is the release state of a repo of a release train
+type ReleaseTrainRepo struct {
+	NameWithOwner string
+	Url           string
+	DefaultBranch string
+	// Release is the latest release, nil if the repo has none
+	Release *Release
+	// UnreleasedCount is the number of commits on the default branch since
+	// the latest release
+	UnreleasedCount int
+	// Unreleased are the latest of these commits, newest first
+	Unreleased []ReleaseCommit
+	Blockers   []ReleaseBlocker
+	// LastCommitAt is when the last commit of the default branch was made
+	LastCommitAt time.Time
+}
+
+func (data ReleaseTrainRepo) GetTitle() string {
+	return data.NameWithOwner
+}
+
+func (data ReleaseTrainRepo) GetRepoNameWithOwner() string {
+	return data.NameWithOwner
+}
+
+func (data ReleaseTrainRepo) GetNumber() int {
+	return 0
+}
+
+// GetUrl returns the comparison of the latest release with the default
+// branch, the repo when it has no release
+func (data ReleaseTrainRepo) GetUrl() string {
+	if data.Release == nil || data.DefaultBranch == "" {
+		return data.Url
+	}
+	return fmt.Sprintf("%s/compare/%s...%s", data.Url, data.Release.TagName, data.DefaultBranch)
+}
+
+func (data ReleaseTrainRepo) GetUpdatedAt() time.Time {
+	return data.LastCommitAt
+}
+
+// FetchReleaseTrain fetches the release state of repos, as owner/name, at
+// once. The blockers are the open PRs and issues with one of blockerLabels.
+// The repos that were fetched are returned along with the errors of the
+// others.
+func FetchReleaseTrain(repos []string, blockerLabels []string) ([]ReleaseTrainRepo, error) {
+	if err := initClient(); err != nil {
+		return nil, err
+	}
+
+	fetched := make([]ReleaseTrainRepo, len(repos))
+	errs := make([]error, len(repos)+1)
+	var blockers map[string][]ReleaseBlocker
+	var wg sync.WaitGroup
+	for i, repo := range repos {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fetched[i], errs[i] = fetchReleaseTrainRepo(repo)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		blockers, errs[len(repos)] = fetchReleaseBlockers(repos, blockerLabels)
+	}()
+	wg.Wait()
+
+	train := make([]ReleaseTrainRepo, 0, len(repos))
+	for i, repo := range fetched {
+		if errs[i] != nil {
+			errs[i] = fmt.Errorf("fetching release of %s: %w", repos[i], errs[i])
+			continue
+		}
+		repo.Blockers = blockers[strings.ToLower(repo.NameWithOwner)]
+		train = append(train, repo)
+	}
+	return train, errors.Join(errs...)
+}
+
+func fetchReleaseTrainRepo(nameWithOwner string) (ReleaseTrainRepo, error) {
+	owner, name, ok := strings.Cut(nameWithOwner, "/")
+	if !ok {
+		return ReleaseTrainRepo{}, fmt.Errorf("invalid repo %q, want owner/name", nameWithOwner)
+	}
+
+	var repoQuery struct {
+		Repository struct {
+			NameWithOwner    string
+			Url              string
+			LatestRelease    *releaseNode
+			DefaultBranchRef *struct {
+				Name   string
+				Target struct {
+					Commit struct {
+						CommittedDate time.Time
+					} `graphql:"... on Commit"`
+				}
+			}
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]any{
+		"owner": graphql.String(owner),
+		"name":  graphql.String(name),
+	}
+	log.Debug("Fetching latest release", "repo", nameWithOwner)
+	if err := client.Query("FetchLatestRelease", &repoQuery, variables); err != nil {
+		return ReleaseTrainRepo{}, err
+	}
+
+	r := repoQuery.Repository
+	repo := ReleaseTrainRepo{
+		NameWithOwner: r.NameWithOwner,
+		Url:           r.Url,
+	}
+	if latest := r.LatestRelease; latest != nil && latest.PublishedAt != nil {
+		repo.Release = &Release{
+			Repo:         r.NameWithOwner,
+			Name:         latest.Name,
+			TagName:      latest.TagName,
+			Url:          latest.Url,
+			PublishedAt:  *latest.PublishedAt,
+			UpdatedAt:    latest.UpdatedAt,
+			IsPrerelease: latest.IsPrerelease,
+		}
+	}
+	if r.DefaultBranchRef == nil {
+		return repo, nil
+	}
+	repo.DefaultBranch = r.DefaultBranchRef.Name
+	repo.LastCommitAt = r.DefaultBranchRef.Target.Commit.CommittedDate
+	if repo.Release == nil {
+		return repo, nil
+	}
+
+	var compareQuery struct {
+		Repository struct {
+			Ref *struct {
+				Compare struct {
+					AheadBy int
+					Commits struct {
+						Nodes []ReleaseCommit
+					} `graphql:"commits(last: $limit)"`
+				} `graphql:"compare(headRef: $head)"`
+			} `graphql:"ref(qualifiedName: $tag)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables["tag"] = graphql.String("refs/tags/" + repo.Release.TagName)
+	variables["head"] = graphql.String(repo.DefaultBranch)
+	variables["limit"] = graphql.Int(maxUnreleasedCommits)
+	log.Debug("Fetching unreleased commits", "repo", nameWithOwner, "tag", repo.Release.TagName)
+	if err := client.Query("FetchUnreleasedCommits", &compareQuery, variables); err != nil {
+		return ReleaseTrainRepo{}, err
+	}
+	if ref := compareQuery.Repository.Ref; ref != nil {
+		repo.UnreleasedCount = ref.Compare.AheadBy
+		repo.Unreleased = ref.Compare.Commits.Nodes
+		slices.Reverse(repo.Unreleased)
+	}
+	return repo, nil
+}
+
+// blockerSearch returns the search of the open PRs and issues of repos with
+// one of labels
+func blockerSearch(repos []string, labels []string) string {
+	quoted := make([]string, 0, len(labels))
+	for _, label := range labels {
+		quoted = append(quoted, strconv.Quote(label))
+	}
+	terms := []string{"is:open", "archived:false", "label:" + strings.Join(quoted, ",")}
+	for _, repo := range repos {
+		terms = append(terms, "repo:"+repo)
+	}
+	return strings.Join(terms, " ")
+}
+
+type releaseBlockerFields struct {
+	Number     int
+	Title      string
+	Url        string
+	Repository struct {
+		NameWithOwner string
+	}
+}
+
+// fetchReleaseBlockers fetches the blockers of repos, by their lowercased
+// owner/name
+func fetchReleaseBlockers(repos []string, labels []string) (map[string][]ReleaseBlocker, error) {
+	if len(repos) == 0 {
+		return nil, nil
+	}
+	if len(labels) == 0 {
+		labels = DefaultBlockerLabels
+	}
+
+	var queryResult struct {
+		Search struct {
+			Nodes []struct {
+				Typename    string               `graphql:"__typename"`
+				Issue       releaseBlockerFields `graphql:"... on Issue"`
+				PullRequest releaseBlockerFields `graphql:"... on PullRequest"`
+			}
+		} `graphql:"search(type: ISSUE, first: 100, query: $query)"`
+	}
+	query := blockerSearch(repos, labels)
+	variables := map[string]any{
+		"query": graphql.String(query),
+	}
+	log.Debug("Fetching release blockers", "query", query)
+	if err := client.Query("FetchReleaseBlockers", &queryResult, variables); err != nil {
+		return nil, fmt.Errorf("fetching release blockers: %w", err)
+	}
+
+	blockers := map[string][]ReleaseBlocker{}
+	for _, node := range queryResult.Search.Nodes {
+		fields, isPR := node.Issue, node.Typename == "PullRequest"
+		if isPR {
+			fields = node.PullRequest
+		}
+		repo := strings.ToLower(fields.Repository.NameWithOwner)
+		blockers[repo] = append(blockers[repo], ReleaseBlocker{
+			Number: fields.Number,
+			Title:  fields.Title,
+			Url:    fields.Url,
+			IsPR:   isPR,
+		})
+	}
+	return blockers, nil
+}
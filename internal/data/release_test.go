@@ -0,0 +1,48 @@
+package data
+
+import "testing"
+
+func TestBlockerSearch(t *testing.T) {
+	tests := []struct {
+		name   string
+		repos  []string
+		labels []string
+		want   string
+	}{
+		{
+			name:   "one label",
+			repos:  []string{"dlvhdr/gh-dash"},
+			labels: []string{"release-blocker"},
+			want:   `is:open archived:false label:"release-blocker" repo:dlvhdr/gh-dash`,
+		},
+		{
+			name:   "several labels and repos",
+			repos:  []string{"dlvhdr/gh-dash", "dlvhdr/diffnav"},
+			labels: []string{"blocker", "release blocker"},
+			want:   `is:open archived:false label:"blocker","release blocker" repo:dlvhdr/gh-dash repo:dlvhdr/diffnav`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := blockerSearch(tt.repos, tt.labels); got != tt.want {
+				t.Errorf("blockerSearch() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReleaseTrainRepoGetUrl(t *testing.T) {
+	repo := ReleaseTrainRepo{
+		Url:           "https://github.com/dlvhdr/gh-dash",
+		DefaultBranch: "main",
+	}
+	if got := repo.GetUrl(); got != repo.Url {
+		t.Errorf("GetUrl() without release = %q, want %q", got, repo.Url)
+	}
+
+	repo.Release = &Release{TagName: "v4.1.0"}
+	want := "https://github.com/dlvhdr/gh-dash/compare/v4.1.0...main"
+	if got := repo.GetUrl(); got != want {
+		t.Errorf("GetUrl() = %q, want %q", got, want)
+	}
+}
@@ -1,6 +1,13 @@
 package data
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
 	graphql "github.com/cli/shurcooL-graphql"
 )
 
@@ -14,8 +21,173 @@ type BranchProtectionRules struct {
 }
 
 type Repository struct {
-	Name                  string
-	NameWithOwner         string
-	IsArchived            bool
+	Name          string
+	NameWithOwner string
+	IsArchived    bool
+	// ViewerPermission is the permission of the user on the repo, e.g. ADMIN,
+	// MAINTAIN, WRITE, TRIAGE or READ
+	ViewerPermission      string
 	BranchProtectionRules BranchProtectionRules `graphql:"branchProtectionRules(first: 1)"`
 }
+
+// BranchProtection holds a repo's default branch and the name patterns of its
+// branch protection rules
+type BranchProtection struct {
+	DefaultBranch string
+	Patterns      []string
+}
+
+// IsProtected reports whether branch matches one of the protection patterns
+func (p BranchProtection) IsProtected(branch string) bool {
+	for _, pattern := range p.Patterns {
+		if MatchBranchPattern(pattern, branch) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchBranchPattern matches branch against a protection rule pattern the way
+// GitHub does: "*" doesn't cross "/" while "**" does
+func MatchBranchPattern(pattern string, branch string) bool {
+	var re strings.Builder
+	re.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				re.WriteString(".*")
+				i++
+			} else {
+				re.WriteString("[^/]*")
+			}
+		case '?':
+			re.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				re.WriteString(regexp.QuoteMeta(pattern[i:]))
+				i = len(pattern)
+				break
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			re.WriteString("[" + class + "]")
+			i += end + 1
+		default:
+			re.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	re.WriteString("$")
+	matched, err := regexp.MatchString(re.String(), branch)
+	return err == nil && matched
+}
+
+func FetchBranchProtection(repoNameWithOwner string) (BranchProtection, error) {
+	owner, name, ok := strings.Cut(repoNameWithOwner, "/")
+	if !ok {
+		return BranchProtection{}, fmt.Errorf("invalid repo name %q", repoNameWithOwner)
+	}
+
+	client, err := newGraphQLClient(gh.ClientOptions{EnableCache: true, CacheTTL: 5 * time.Minute})
+	if err != nil {
+		return BranchProtection{}, err
+	}
+
+	var queryResult struct {
+		Repository struct {
+			DefaultBranchRef struct {
+				Name string
+			}
+			BranchProtectionRules struct {
+				Nodes []struct {
+					Pattern string
+				}
+			} `graphql:"branchProtectionRules(first: 100)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]any{
+		"owner": graphql.String(owner),
+		"name":  graphql.String(name),
+	}
+	log.Debug("Fetching branch protection", "repo", repoNameWithOwner)
+	err = client.Query("FetchBranchProtection", &queryResult, variables)
+	if err != nil {
+		return BranchProtection{}, err
+	}
+
+	res := BranchProtection{DefaultBranch: queryResult.Repository.DefaultBranchRef.Name}
+	for _, rule := range queryResult.Repository.BranchProtectionRules.Nodes {
+		res.Patterns = append(res.Patterns, rule.Pattern)
+	}
+	return res, nil
+}
+
+// AccessibleRepo is a repo the user can filter sections by, Source tells
+// why it's listed
+type AccessibleRepo struct {
+	NameWithOwner string
+	Description   string
+	PushedAt      time.Time
+	Source        string
+}
+
+type accessibleRepoNode struct {
+	NameWithOwner string
+	Description   string
+	PushedAt      time.Time
+}
+
+// FetchAccessibleRepos fetches the repos the user owns or is a member of, the
+// repos they recently contributed to and the repos they starred, without
+// duplicates, in that order
+func FetchAccessibleRepos() ([]AccessibleRepo, error) {
+	client, err := newGraphQLClient(gh.ClientOptions{EnableCache: true, CacheTTL: 5 * time.Minute})
+	if err != nil {
+		return nil, err
+	}
+
+	var queryResult struct {
+		Viewer struct {
+			Repositories struct {
+				Nodes []accessibleRepoNode
+			} `graphql:"repositories(first: 100, ownerAffiliations: [OWNER, ORGANIZATION_MEMBER, COLLABORATOR], orderBy: {field: PUSHED_AT, direction: DESC})"`
+			RepositoriesContributedTo struct {
+				Nodes []accessibleRepoNode
+			} `graphql:"repositoriesContributedTo(first: 50, includeUserRepositories: false, contributionTypes: [COMMIT, PULL_REQUEST], orderBy: {field: PUSHED_AT, direction: DESC})"`
+			StarredRepositories struct {
+				Nodes []accessibleRepoNode
+			} `graphql:"starredRepositories(first: 100, orderBy: {field: STARRED_AT, direction: DESC})"`
+		}
+	}
+	log.Debug("Fetching accessible repos")
+	err = client.Query("FetchAccessibleRepos", &queryResult, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var repos []AccessibleRepo
+	add := func(nodes []accessibleRepoNode, source string) {
+		for _, node := range nodes {
+			if seen[node.NameWithOwner] {
+				continue
+			}
+			seen[node.NameWithOwner] = true
+			repos = append(repos, AccessibleRepo{
+				NameWithOwner: node.NameWithOwner,
+				Description:   node.Description,
+				PushedAt:      node.PushedAt,
+				Source:        source,
+			})
+		}
+	}
+	add(queryResult.Viewer.Repositories.Nodes, "owned")
+	add(queryResult.Viewer.RepositoriesContributedTo.Nodes, "contributed")
+	add(queryResult.Viewer.StarredRepositories.Nodes, "starred")
+	log.Info("Successfully fetched accessible repos", "count", len(repos))
+
+	return repos, nil
+}
@@ -0,0 +1,76 @@
+package data
+
+import "testing"
+
+func TestMatchBranchPattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		branch  string
+		want    bool
+	}{
+		{
+			name:    "exact name",
+			pattern: "main",
+			branch:  "main",
+			want:    true,
+		},
+		{
+			name:    "exact name mismatch",
+			pattern: "main",
+			branch:  "main2",
+			want:    false,
+		},
+		{
+			name:    "single star within segment",
+			pattern: "release/*",
+			branch:  "release/v1",
+			want:    true,
+		},
+		{
+			name:    "single star doesn't cross slashes",
+			pattern: "release/*",
+			branch:  "release/v1/hotfix",
+			want:    false,
+		},
+		{
+			name:    "double star crosses slashes",
+			pattern: "release/**",
+			branch:  "release/v1/hotfix",
+			want:    true,
+		},
+		{
+			name:    "question mark",
+			pattern: "v?",
+			branch:  "v2",
+			want:    true,
+		},
+		{
+			name:    "character class",
+			pattern: "[mr]ain",
+			branch:  "rain",
+			want:    true,
+		},
+		{
+			name:    "negated character class",
+			pattern: "[!m]ain",
+			branch:  "main",
+			want:    false,
+		},
+		{
+			name:    "regexp characters are literal",
+			pattern: "v1.0",
+			branch:  "v1x0",
+			want:    false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := MatchBranchPattern(tc.pattern, tc.branch)
+			if got != tc.want {
+				t.Errorf("MatchBranchPattern(%q, %q) = %v, want %v", tc.pattern, tc.branch, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"net/url"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+type ReviewEvent = githubv4.PullRequestReviewEvent
+
+const (
+	ReviewEventApprove        ReviewEvent = githubv4.PullRequestReviewEventApprove
+	ReviewEventRequestChanges ReviewEvent = githubv4.PullRequestReviewEventRequestChanges
+	ReviewEventComment        ReviewEvent = githubv4.PullRequestReviewEventComment
+)
+
+// SubmitReview submits a review of the PR at prUrl. Requesting changes and
+// commenting require a body.
+func SubmitReview(prUrl string, event ReviewEvent, body string) error {
+	client, err := newGraphQLClient(forUrl(prUrl, gh.ClientOptions{}))
+	if err != nil {
+		return err
+	}
+
+	prId, err := fetchPullRequestId(client, prUrl)
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		AddPullRequestReview struct {
+			PullRequestReview struct {
+				Id string
+			}
+		} `graphql:"addPullRequestReview(input: $input)"`
+	}
+	input := githubv4.AddPullRequestReviewInput{
+		PullRequestID: prId,
+		Event:         &event,
+	}
+	if body != "" {
+		input.Body = githubv4.NewString(githubv4.String(body))
+	}
+	log.Debug("Submitting review", "url", prUrl, "event", event)
+	err = client.Mutate("SubmitReview", &mutation, map[string]any{"input": input})
+	if err != nil {
+		return err
+	}
+	log.Info("Successfully submitted review", "url", prUrl, "event", event)
+
+	return nil
+}
+
+// fetchPullRequestId fetches the node id of the PR at prUrl, which mutations
+// take
+func fetchPullRequestId(client *gh.GraphQLClient, prUrl string) (string, error) {
+	parsedUrl, err := url.Parse(prUrl)
+	if err != nil {
+		return "", err
+	}
+	var prQuery struct {
+		Resource struct {
+			PullRequest struct {
+				Id string
+			} `graphql:"... on PullRequest"`
+		} `graphql:"resource(url: $url)"`
+	}
+	err = client.Query("FetchPullRequestId", &prQuery, map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	})
+	if err != nil {
+		return "", err
+	}
+	return prQuery.Resource.PullRequest.Id, nil
+}
@@ -0,0 +1,89 @@
+package data
+
+import (
+	"slices"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// IsStale returns whether the review was left on an older commit than the
+// head of the PR
+func (data PullRequestData) IsStale(review LatestReview) bool {
+	return review.Commit == nil || review.Commit.Oid != data.HeadRefOid
+}
+
+// RequestedReviewers returns the logins of the users and the slugs of the
+// teams whose review is requested
+func (data PullRequestData) RequestedReviewers() []string {
+	var reviewers []string
+	for _, request := range data.ReviewRequests.Nodes {
+		switch {
+		case request.RequestedReviewer.User.Login != "":
+			reviewers = append(reviewers, request.RequestedReviewer.User.Login)
+		case request.RequestedReviewer.Team.Slug != "":
+			reviewers = append(reviewers, request.RequestedReviewer.Team.Slug)
+		}
+	}
+	return reviewers
+}
+
+// StaleReviewers returns the logins of the reviewers whose latest review is
+// stale and whose review isn't requested again yet
+func (data PullRequestData) StaleReviewers() []string {
+	requested := data.RequestedReviewers()
+	var reviewers []string
+	for _, review := range data.LatestReviews.Nodes {
+		login := review.Author.Login
+		if login == "" || !data.IsStale(review) || slices.Contains(requested, login) {
+			continue
+		}
+		reviewers = append(reviewers, login)
+	}
+	return reviewers
+}
+
+// BlockingReviews returns the latest reviews requesting changes
+func (data PullRequestData) BlockingReviews() []LatestReview {
+	var reviews []LatestReview
+	for _, review := range data.LatestReviews.Nodes {
+		if review.State == "CHANGES_REQUESTED" {
+			reviews = append(reviews, review)
+		}
+	}
+	return reviews
+}
+
+// ViewerCanDismissReviews returns whether the user has the write access to
+// the repo dismissing reviews requires
+func (r Repository) ViewerCanDismissReviews() bool {
+	switch r.ViewerPermission {
+	case "ADMIN", "MAINTAIN", "WRITE":
+		return true
+	}
+	return false
+}
+
+// DismissReview dismisses the review with the node id reviewId, leaving
+// message as the reason
+func DismissReview(reviewId string, message string) error {
+	client, err := newGraphQLClient(gh.ClientOptions{})
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		DismissPullRequestReview struct {
+			PullRequestReview struct {
+				State string
+			}
+		} `graphql:"dismissPullRequestReview(input: $input)"`
+	}
+	input := githubv4.DismissPullRequestReviewInput{
+		PullRequestReviewID: reviewId,
+		Message:             githubv4.String(message),
+	}
+	log.Debug("Dismissing review", "review", reviewId)
+	return client.Mutate("DismissPullRequestReview", &mutation, map[string]any{"input": input})
+}
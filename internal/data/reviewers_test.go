@@ -0,0 +1,65 @@
+package data
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPullRequestStaleReviewers(t *testing.T) {
+	review := func(login string, oid string) LatestReview {
+		r := LatestReview{State: "APPROVED"}
+		r.Author.Login = login
+		if oid != "" {
+			r.Commit = &struct{ Oid string }{Oid: oid}
+		}
+		return r
+	}
+	request := func(login string) ReviewRequest {
+		var r ReviewRequest
+		r.RequestedReviewer.User.Login = login
+		return r
+	}
+
+	tests := []struct {
+		name     string
+		reviews  []LatestReview
+		requests []ReviewRequest
+		want     []string
+	}{
+		{
+			name:    "reviews of the head",
+			reviews: []LatestReview{review("alice", "head"), review("bob", "head")},
+		},
+		{
+			name:    "reviews of older commits",
+			reviews: []LatestReview{review("alice", "old"), review("bob", "head")},
+			want:    []string{"alice"},
+		},
+		{
+			name:    "reviewed commit no longer in the PR",
+			reviews: []LatestReview{review("alice", "")},
+			want:    []string{"alice"},
+		},
+		{
+			name:     "review already requested again",
+			reviews:  []LatestReview{review("alice", "old"), review("bob", "old")},
+			requests: []ReviewRequest{request("bob")},
+			want:     []string{"alice"},
+		},
+		{
+			name:    "deleted reviewer",
+			reviews: []LatestReview{review("", "old")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := PullRequestData{HeadRefOid: "head"}
+			pr.LatestReviews.Nodes = tt.reviews
+			pr.ReviewRequests.Nodes = tt.requests
+			if got := pr.StaleReviewers(); !slices.Equal(got, tt.want) {
+				t.Errorf("StaleReviewers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
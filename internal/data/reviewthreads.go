@@ -0,0 +1,62 @@
+package data
+
+import (
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	"github.com/shurcooL/githubv4"
+)
+
+// ReplyToReviewThread adds a reply with body to the review thread of a PR
+// with the node id threadId
+func ReplyToReviewThread(threadId string, body string) error {
+	client, err := newGraphQLClient(gh.ClientOptions{})
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		AddPullRequestReviewThreadReply struct {
+			Comment struct {
+				Id string
+			}
+		} `graphql:"addPullRequestReviewThreadReply(input: $input)"`
+	}
+	input := githubv4.AddPullRequestReviewThreadReplyInput{
+		PullRequestReviewThreadID: threadId,
+		Body:                      githubv4.String(body),
+	}
+	log.Debug("Replying to review thread", "thread", threadId)
+	return client.Mutate("ReplyToReviewThread", &mutation, map[string]any{"input": input})
+}
+
+// ResolveReviewThread resolves the review thread with the node id threadId,
+// or unresolves it when resolve is false
+func ResolveReviewThread(threadId string, resolve bool) error {
+	client, err := newGraphQLClient(gh.ClientOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("Resolving review thread", "thread", threadId, "resolve", resolve)
+	if !resolve {
+		var mutation struct {
+			UnresolveReviewThread struct {
+				Thread struct {
+					IsResolved bool
+				}
+			} `graphql:"unresolveReviewThread(input: $input)"`
+		}
+		input := githubv4.UnresolveReviewThreadInput{ThreadID: threadId}
+		return client.Mutate("UnresolveReviewThread", &mutation, map[string]any{"input": input})
+	}
+
+	var mutation struct {
+		ResolveReviewThread struct {
+			Thread struct {
+				IsResolved bool
+			}
+		} `graphql:"resolveReviewThread(input: $input)"`
+	}
+	input := githubv4.ResolveReviewThreadInput{ThreadID: threadId}
+	return client.Mutate("ResolveReviewThread", &mutation, map[string]any{"input": input})
+}
@@ -0,0 +1,129 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// Milestone is an open milestone with a due date
+type Milestone struct {
+	Repo         string
+	Number       int
+	Title        string
+	Description  string
+	Url          string
+	DueOn        time.Time
+	UpdatedAt    time.Time
+	OpenIssues   int
+	ClosedIssues int
+}
+
+// Release is a published release
+type Release struct {
+	Repo         string
+	Name         string
+	TagName      string
+	Url          string
+	PublishedAt  time.Time
+	UpdatedAt    time.Time
+	IsPrerelease bool
+}
+
+// Schedule is what's due or was released in a repo
+type Schedule struct {
+	Milestones []Milestone
+	Releases   []Release
+}
+
+type milestoneNode struct {
+	Number      int
+	Title       string
+	Description string
+	Url         string
+	DueOn       *time.Time
+	UpdatedAt   time.Time
+	OpenIssues  struct {
+		TotalCount int
+	} `graphql:"openIssues: issues(states: OPEN)"`
+	ClosedIssues struct {
+		TotalCount int
+	} `graphql:"closedIssues: issues(states: CLOSED)"`
+}
+
+type releaseNode struct {
+	Name         string
+	TagName      string
+	Url          string
+	PublishedAt  *time.Time
+	UpdatedAt    time.Time
+	IsDraft      bool
+	IsPrerelease bool
+}
+
+// FetchSchedule fetches the open milestones of repo that are due, and its
+// latest releases
+func FetchSchedule(repoNameWithOwner string) (Schedule, error) {
+	owner, name, ok := strings.Cut(repoNameWithOwner, "/")
+	if !ok {
+		return Schedule{}, fmt.Errorf("invalid repo name %q", repoNameWithOwner)
+	}
+	if err := initClient(); err != nil {
+		return Schedule{}, err
+	}
+
+	var queryResult struct {
+		Repository struct {
+			Milestones struct {
+				Nodes []milestoneNode
+			} `graphql:"milestones(first: 50, states: OPEN, orderBy: {field: DUE_DATE, direction: ASC})"`
+			Releases struct {
+				Nodes []releaseNode
+			} `graphql:"releases(first: 20, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	variables := map[string]any{
+		"owner": graphql.String(owner),
+		"name":  graphql.String(name),
+	}
+	log.Debug("Fetching schedule", "repo", repoNameWithOwner)
+	if err := client.Query("FetchSchedule", &queryResult, variables); err != nil {
+		return Schedule{}, err
+	}
+
+	var schedule Schedule
+	for _, m := range queryResult.Repository.Milestones.Nodes {
+		if m.DueOn == nil {
+			continue
+		}
+		schedule.Milestones = append(schedule.Milestones, Milestone{
+			Repo:         repoNameWithOwner,
+			Number:       m.Number,
+			Title:        m.Title,
+			Description:  m.Description,
+			Url:          m.Url,
+			DueOn:        *m.DueOn,
+			UpdatedAt:    m.UpdatedAt,
+			OpenIssues:   m.OpenIssues.TotalCount,
+			ClosedIssues: m.ClosedIssues.TotalCount,
+		})
+	}
+	for _, r := range queryResult.Repository.Releases.Nodes {
+		if r.IsDraft || r.PublishedAt == nil {
+			continue
+		}
+		schedule.Releases = append(schedule.Releases, Release{
+			Repo:         repoNameWithOwner,
+			Name:         r.Name,
+			TagName:      r.TagName,
+			Url:          r.Url,
+			PublishedAt:  *r.PublishedAt,
+			UpdatedAt:    r.UpdatedAt,
+			IsPrerelease: r.IsPrerelease,
+		})
+	}
+	return schedule, nil
+}
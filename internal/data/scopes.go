@@ -0,0 +1,121 @@
+package data
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+)
+
+// impliedScopes are the scopes granted along with a scope, e.g. repo lets a
+// token do everything public_repo does
+var impliedScopes = map[string][]string{
+	"repo":             {"public_repo", "repo:status", "repo_deployment", "repo:invite", "security_events"},
+	"admin:org":        {"write:org", "read:org", "manage_runners:org"},
+	"write:org":        {"read:org"},
+	"project":          {"read:project"},
+	"write:discussion": {"read:discussion"},
+	"user":             {"read:user", "user:email", "user:follow"},
+	"write:packages":   {"read:packages"},
+}
+
+// forbiddenMessages are in the errors of GitHub, or printed by gh, when the
+// token isn't allowed to do what was requested
+var forbiddenMessages = []string{
+	"HTTP 403",
+	"Resource not accessible by",
+	"must have push access",
+	"must have admin rights",
+	"does not have the correct permissions",
+	"has not been granted the required scopes",
+}
+
+// TokenScopes are the OAuth scopes of the token the dashboard talks to GitHub
+// with. Only classic and OAuth app tokens tell theirs, Known is false for
+// fine-grained and GitHub App tokens whose permissions can't be told
+// beforehand.
+type TokenScopes struct {
+	Known  bool
+	Scopes []string
+}
+
+// ParseTokenScopes reads the scopes of the X-OAuth-Scopes header of a
+// response, they're unknown when it doesn't have the header
+func ParseTokenScopes(header http.Header) TokenScopes {
+	values, ok := header[http.CanonicalHeaderKey("X-OAuth-Scopes")]
+	if !ok {
+		return TokenScopes{}
+	}
+
+	scopes := TokenScopes{Known: true}
+	for _, scope := range strings.Split(strings.Join(values, ","), ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes.Scopes = append(scopes.Scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// HasAny returns whether the token has one of scopes, directly or through a
+// scope implying it. It's true when the scopes of the token are unknown or
+// when no scope is needed.
+func (s TokenScopes) HasAny(scopes ...string) bool {
+	if !s.Known || len(scopes) == 0 {
+		return true
+	}
+	for _, has := range s.Scopes {
+		if slices.Contains(scopes, has) {
+			return true
+		}
+		for _, implied := range impliedScopes[has] {
+			if slices.Contains(scopes, implied) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FetchTokenScopes fetches the scopes of the token the dashboard uses
+func FetchTokenScopes() (TokenScopes, error) {
+	client, err := newRESTClient(gh.ClientOptions{})
+	if err != nil {
+		return TokenScopes{}, err
+	}
+
+	log.Debug("Fetching token scopes")
+	res, err := client.Request(http.MethodGet, "user", nil)
+	if err != nil {
+		return TokenScopes{}, err
+	}
+	defer res.Body.Close()
+	return ParseTokenScopes(res.Header), nil
+}
+
+// IsForbidden returns whether err means that GitHub refused the request
+// because the token isn't allowed to make it, as opposed to it failing
+func IsForbidden(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var httpErr *gh.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusForbidden {
+		// GitHub also answers 403 when the rate limit is exceeded
+		return !strings.Contains(httpErr.Message, "rate limit")
+	}
+
+	msg := err.Error()
+	if strings.Contains(msg, "rate limit") {
+		return false
+	}
+	for _, m := range forbiddenMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	gh "github.com/cli/go-gh/v2/pkg/api"
+)
+
+func TestParseTokenScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		want   TokenScopes
+	}{
+		{
+			name:   "fine-grained token",
+			header: http.Header{},
+			want:   TokenScopes{},
+		},
+		{
+			name:   "classic token",
+			header: http.Header{"X-Oauth-Scopes": {"gist, read:org, repo, workflow"}},
+			want:   TokenScopes{Known: true, Scopes: []string{"gist", "read:org", "repo", "workflow"}},
+		},
+		{
+			name:   "token without scopes",
+			header: http.Header{"X-Oauth-Scopes": {""}},
+			want:   TokenScopes{Known: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseTokenScopes(tt.header)
+			if got.Known != tt.want.Known || !slices.Equal(got.Scopes, tt.want.Scopes) {
+				t.Errorf("ParseTokenScopes() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenScopesHasAny(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes TokenScopes
+		needed []string
+		want   bool
+	}{
+		{name: "unknown scopes", scopes: TokenScopes{}, needed: []string{"repo"}, want: true},
+		{name: "nothing needed", scopes: TokenScopes{Known: true}, needed: nil, want: true},
+		{
+			name:   "has the scope",
+			scopes: TokenScopes{Known: true, Scopes: []string{"read:org", "repo"}},
+			needed: []string{"repo", "public_repo"},
+			want:   true,
+		},
+		{
+			name:   "implied scope",
+			scopes: TokenScopes{Known: true, Scopes: []string{"project"}},
+			needed: []string{"read:project"},
+			want:   true,
+		},
+		{
+			name:   "missing the scope",
+			scopes: TokenScopes{Known: true, Scopes: []string{"read:org", "gist"}},
+			needed: []string{"repo", "public_repo"},
+			want:   false,
+		},
+		{
+			name:   "narrower scope",
+			scopes: TokenScopes{Known: true, Scopes: []string{"public_repo"}},
+			needed: []string{"repo"},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scopes.HasAny(tt.needed...); got != tt.want {
+				t.Errorf("HasAny(%v) = %v, want %v", tt.needed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsForbidden(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "no error", err: nil, want: false},
+		{
+			name: "REST error",
+			err:  fmt.Errorf("merging: %w", &gh.HTTPError{StatusCode: 403, Message: "Resource not accessible by integration"}),
+			want: true,
+		},
+		{
+			name: "rate limit",
+			err:  &gh.HTTPError{StatusCode: 403, Message: "API rate limit exceeded for user"},
+			want: false,
+		},
+		{
+			name: "not found",
+			err:  &gh.HTTPError{StatusCode: 404, Message: "Not Found"},
+			want: false,
+		},
+		{
+			name: "gh output",
+			err:  errors.New("exit status 1: GraphQL: dlvhdr does not have the correct permissions to execute `MergePullRequest` (mergePullRequest)"),
+			want: true,
+		},
+		{
+			name: "missing scopes",
+			err:  errors.New("Your token has not been granted the required scopes to execute this query"),
+			want: true,
+		},
+		{name: "other failure", err: errors.New("exit status 1: Pull request is not mergeable"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsForbidden(tt.err); got != tt.want {
+				t.Errorf("IsForbidden() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package data
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// Score weighs the traits of the PR with the weights of cfg, the PRs with the
+// highest scores come first in auto-prioritized sections
+func (data PullRequestData) Score(cfg config.ScoringConfig, now time.Time) float64 {
+	score := commonScore(cfg, data.CreatedAt, data.Author.Login, data.Labels.Nodes, now)
+	switch data.ReviewDecision {
+	case "REVIEW_REQUIRED":
+		score += cfg.ReviewRequired
+	case "CHANGES_REQUESTED":
+		score += cfg.ChangesRequested
+	case "APPROVED":
+		score += cfg.Approved
+	}
+	return score + cfg.Size*float64(data.Additions+data.Deletions)/100
+}
+
+// Score weighs the traits of the issue with the weights of cfg, the ones of
+// PRs don't apply
+func (data IssueData) Score(cfg config.ScoringConfig, now time.Time) float64 {
+	return commonScore(cfg, data.CreatedAt, data.Author.Login, data.Labels.Nodes, now)
+}
+
+func commonScore(
+	cfg config.ScoringConfig,
+	createdAt time.Time,
+	author string,
+	labels []Label,
+	now time.Time,
+) float64 {
+	score := 0.0
+	if !createdAt.IsZero() {
+		score += cfg.Age * max(0, now.Sub(createdAt).Hours()/24)
+	}
+	for _, l := range labels {
+		for name, weight := range cfg.Labels {
+			if strings.EqualFold(name, l.Name) {
+				score += weight
+			}
+		}
+	}
+	for _, teammate := range cfg.Teammates {
+		if strings.EqualFold(teammate, author) {
+			score += cfg.Teammate
+			break
+		}
+	}
+	return score
+}
@@ -0,0 +1,102 @@
+package data
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+func TestPullRequestScore(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	cfg := config.ScoringConfig{
+		Age:              1,
+		ReviewRequired:   10,
+		ChangesRequested: -5,
+		Approved:         -10,
+		Size:             -2,
+		Labels:           map[string]float64{"bug": 5, "P1": 20},
+		Teammate:         3,
+		Teammates:        []string{"Alice"},
+	}
+	pr := func(modify func(pr *PullRequestData)) PullRequestData {
+		pr := PullRequestData{CreatedAt: now}
+		modify(&pr)
+		return pr
+	}
+
+	tests := []struct {
+		name string
+		pr   PullRequestData
+		want float64
+	}{
+		{
+			name: "nothing to weigh",
+			pr:   pr(func(*PullRequestData) {}),
+			want: 0,
+		},
+		{
+			name: "age in days",
+			pr:   pr(func(pr *PullRequestData) { pr.CreatedAt = now.Add(-36 * time.Hour) }),
+			want: 1.5,
+		},
+		{
+			name: "review required",
+			pr:   pr(func(pr *PullRequestData) { pr.ReviewDecision = "REVIEW_REQUIRED" }),
+			want: 10,
+		},
+		{
+			name: "approved",
+			pr:   pr(func(pr *PullRequestData) { pr.ReviewDecision = "APPROVED" }),
+			want: -10,
+		},
+		{
+			name: "size per 100 lines",
+			pr: pr(func(pr *PullRequestData) {
+				pr.Additions = 200
+				pr.Deletions = 50
+			}),
+			want: -5,
+		},
+		{
+			name: "labels ignoring case",
+			pr: pr(func(pr *PullRequestData) {
+				pr.Labels.Nodes = []Label{{Name: "Bug"}, {Name: "p1"}, {Name: "docs"}}
+			}),
+			want: 25,
+		},
+		{
+			name: "teammate",
+			pr:   pr(func(pr *PullRequestData) { pr.Author.Login = "alice" }),
+			want: 3,
+		},
+		{
+			name: "not a teammate",
+			pr:   pr(func(pr *PullRequestData) { pr.Author.Login = "bob" }),
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pr.Score(cfg, now); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoringDefaults(t *testing.T) {
+	cfg := config.ScoringConfig{Teammates: []string{"alice"}}.WithDefaults()
+	if cfg.Age == 0 || cfg.ReviewRequired == 0 {
+		t.Errorf("WithDefaults() = %+v, want the default weights", cfg)
+	}
+	if len(cfg.Teammates) != 1 {
+		t.Errorf("WithDefaults() dropped the teammates: %+v", cfg)
+	}
+
+	cfg = config.ScoringConfig{Size: -1}.WithDefaults()
+	if cfg.Age != 0 || cfg.Size != -1 {
+		t.Errorf("WithDefaults() = %+v, want the configured weights only", cfg)
+	}
+}
@@ -0,0 +1,17 @@
+package data
+
+import "github.com/dlvhdr/gh-dash/v4/internal/config"
+
+// PrSizes are the sizes of PRs, from the smallest
+var PrSizes = []string{"XS", "S", "M", "L", "XL"}
+
+// Size returns the size of the PR, one of PrSizes, by the lines it changes
+func (data PullRequestData) Size(cfg config.PrSizeConfig) string {
+	lines := data.Additions + data.Deletions
+	for i, most := range []int{cfg.XS, cfg.S, cfg.M, cfg.L} {
+		if lines <= most {
+			return PrSizes[i]
+		}
+	}
+	return PrSizes[len(PrSizes)-1]
+}
@@ -0,0 +1,33 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+func TestPullRequestSize(t *testing.T) {
+	cfg := config.PrSizeConfig{XS: 10, S: 100, M: 500, L: 1000}
+
+	tests := []struct {
+		name      string
+		additions int
+		deletions int
+		want      string
+	}{
+		{name: "no changes", want: "XS"},
+		{name: "at the most of a size", additions: 6, deletions: 4, want: "XS"},
+		{name: "additions and deletions add up", additions: 60, deletions: 41, want: "M"},
+		{name: "small", additions: 80, want: "S"},
+		{name: "large", deletions: 1000, want: "L"},
+		{name: "more than large", additions: 900, deletions: 101, want: "XL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := PullRequestData{Additions: tt.additions, Deletions: tt.deletions}
+			if got := pr.Size(cfg); got != tt.want {
+				t.Errorf("Size() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
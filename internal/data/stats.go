@@ -0,0 +1,99 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// SearchStats summarizes the items matching a section's filters
+type SearchStats struct {
+	Count int
+	// OldestCreatedAt is when the oldest item was created, zero when nothing
+	// matches
+	OldestCreatedAt time.Time
+}
+
+type statsNode struct {
+	PullRequest struct {
+		CreatedAt time.Time
+	} `graphql:"... on PullRequest"`
+	Issue struct {
+		CreatedAt time.Time
+	} `graphql:"... on Issue"`
+}
+
+func makeStatsQuery(query string, isPR bool) string {
+	kind := "is:issue"
+	if isPR {
+		kind = "is:pr"
+	}
+	return fmt.Sprintf("%s %s sort:created-asc", kind, query)
+}
+
+// FetchSearchStats counts the PRs or issues matching query and finds the
+// oldest of them. Only the oldest item is fetched so it's cheap, and it's
+// batched with the stats of other sections fetched at the same time.
+func FetchSearchStats(query string, isPR bool) (SearchStats, error) {
+	var search searchConnection[statsNode]
+	statsQuery := makeStatsQuery(query, isPR)
+	err := batchSearch(statsQuery, 1, &search, func() error {
+		return searchStats(statsQuery, &search)
+	})
+	if err != nil {
+		return SearchStats{}, err
+	}
+
+	stats := SearchStats{Count: search.IssueCount}
+	if len(search.Nodes) > 0 {
+		stats.OldestCreatedAt = search.Nodes[0].Issue.CreatedAt
+		if isPR {
+			stats.OldestCreatedAt = search.Nodes[0].PullRequest.CreatedAt
+		}
+	}
+	return stats, nil
+}
+
+func searchStats(query string, search *searchConnection[statsNode]) error {
+	if err := initClient(); err != nil {
+		return err
+	}
+
+	var queryResult struct {
+		Search searchConnection[statsNode] `graphql:"search(type: ISSUE, first: 1, query: $query)"`
+	}
+	log.Debug("Fetching search stats", "query", query)
+	err := client.Query("SearchStats", &queryResult, map[string]any{
+		"query": graphql.String(query),
+	})
+	if err != nil {
+		return err
+	}
+
+	*search = queryResult.Search
+	return nil
+}
+
+// RateLimit is the GraphQL API quota of the current user
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+}
+
+func FetchRateLimit() (RateLimit, error) {
+	if err := initClient(); err != nil {
+		return RateLimit{}, err
+	}
+
+	var queryResult struct {
+		RateLimit RateLimit
+	}
+	log.Debug("Fetching rate limit")
+	if err := client.Query("RateLimit", &queryResult, nil); err != nil {
+		return RateLimit{}, err
+	}
+	return queryResult.RateLimit, nil
+}
@@ -0,0 +1,42 @@
+package data
+
+import (
+	"github.com/charmbracelet/log"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// FetchOrgTeams fetches the teams of org that can be mentioned, as org/slug.
+// They're reused from the metadata cache while they're fresh.
+func FetchOrgTeams(org string) ([]string, error) {
+	return cachedMetadata(MetadataTeams, org, func() ([]string, error) {
+		return fetchOrgTeams(org)
+	})
+}
+
+func fetchOrgTeams(org string) ([]string, error) {
+	if err := initClient(); err != nil {
+		return nil, err
+	}
+	var queryResult struct {
+		Organization struct {
+			Teams struct {
+				Nodes []struct {
+					Slug string
+				}
+			} `graphql:"teams(first: 100)"`
+		} `graphql:"organization(login: $login)"`
+	}
+	variables := map[string]any{
+		"login": graphql.String(org),
+	}
+	log.Debug("Fetching teams", "org", org)
+	if err := client.Query("FetchOrgTeams", &queryResult, variables); err != nil {
+		return nil, err
+	}
+
+	teams := make([]string, 0, len(queryResult.Organization.Teams.Nodes))
+	for _, team := range queryResult.Organization.Teams.Nodes {
+		teams = append(teams, org+"/"+team.Slug)
+	}
+	return teams, nil
+}
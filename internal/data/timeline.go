@@ -0,0 +1,179 @@
+package data
+
+import (
+	"time"
+)
+
+// TimelineItems are the events of a PR besides its comments and review
+// threads, e.g. commits, reviews, labels being added or the branch being
+// force pushed
+type TimelineItems struct {
+	Nodes []TimelineItem
+}
+
+type TimelineActor struct {
+	Login string
+}
+
+type TimelineLabel struct {
+	Name  string
+	Color string
+}
+
+// TimelineAssignee is the user or bot that was (un)assigned
+type TimelineAssignee struct {
+	User struct {
+		Login string
+	} `graphql:"... on User"`
+	Bot struct {
+		Login string
+	} `graphql:"... on Bot"`
+}
+
+func (a TimelineAssignee) Login() string {
+	if a.User.Login != "" {
+		return a.User.Login
+	}
+	return a.Bot.Login
+}
+
+// TimelineCommitAuthor is who authored a commit, User is nil when the email
+// of the commit isn't one of a GitHub user
+type TimelineCommitAuthor struct {
+	Name string
+	User *struct {
+		Login string
+	}
+}
+
+// TimelineReference is the PR or issue that referenced the PR
+type TimelineReference struct {
+	PullRequest struct {
+		Number     int
+		Title      string
+		Repository struct {
+			NameWithOwner string
+		}
+	} `graphql:"... on PullRequest"`
+	Issue struct {
+		Number     int
+		Title      string
+		Repository struct {
+			NameWithOwner string
+		}
+	} `graphql:"... on Issue"`
+}
+
+type TimelineItem struct {
+	Typename     string `graphql:"__typename"`
+	LabeledEvent struct {
+		Actor     TimelineActor
+		CreatedAt time.Time
+		Label     TimelineLabel
+	} `graphql:"... on LabeledEvent"`
+	UnlabeledEvent struct {
+		Actor     TimelineActor
+		CreatedAt time.Time
+		Label     TimelineLabel
+	} `graphql:"... on UnlabeledEvent"`
+	AssignedEvent struct {
+		Actor     TimelineActor
+		CreatedAt time.Time
+		Assignee  TimelineAssignee
+	} `graphql:"... on AssignedEvent"`
+	UnassignedEvent struct {
+		Actor     TimelineActor
+		CreatedAt time.Time
+		Assignee  TimelineAssignee
+	} `graphql:"... on UnassignedEvent"`
+	CrossReferencedEvent struct {
+		Actor     TimelineActor
+		CreatedAt time.Time
+		Source    TimelineReference
+	} `graphql:"... on CrossReferencedEvent"`
+	DeployedEvent struct {
+		Actor      TimelineActor
+		CreatedAt  time.Time
+		Deployment struct {
+			Environment string
+		}
+	} `graphql:"... on DeployedEvent"`
+	HeadRefForcePushedEvent struct {
+		Actor        TimelineActor
+		CreatedAt    time.Time
+		BeforeCommit struct {
+			AbbreviatedOid string
+		}
+		AfterCommit struct {
+			AbbreviatedOid string
+		}
+	} `graphql:"... on HeadRefForcePushedEvent"`
+	PullRequestCommit struct {
+		Commit struct {
+			AbbreviatedOid  string
+			MessageHeadline string
+			CommittedDate   time.Time
+			Author          TimelineCommitAuthor
+		}
+	} `graphql:"... on PullRequestCommit"`
+	// PullRequestReview has no SubmittedAt while it's pending
+	PullRequestReview struct {
+		Author      TimelineActor
+		Body        string
+		State       string
+		SubmittedAt time.Time
+	} `graphql:"... on PullRequestReview"`
+}
+
+// Actor returns the login of who caused the event
+func (item TimelineItem) Actor() string {
+	switch item.Typename {
+	case "LabeledEvent":
+		return item.LabeledEvent.Actor.Login
+	case "UnlabeledEvent":
+		return item.UnlabeledEvent.Actor.Login
+	case "AssignedEvent":
+		return item.AssignedEvent.Actor.Login
+	case "UnassignedEvent":
+		return item.UnassignedEvent.Actor.Login
+	case "CrossReferencedEvent":
+		return item.CrossReferencedEvent.Actor.Login
+	case "DeployedEvent":
+		return item.DeployedEvent.Actor.Login
+	case "HeadRefForcePushedEvent":
+		return item.HeadRefForcePushedEvent.Actor.Login
+	case "PullRequestCommit":
+		author := item.PullRequestCommit.Commit.Author
+		if author.User != nil {
+			return author.User.Login
+		}
+		return author.Name
+	case "PullRequestReview":
+		return item.PullRequestReview.Author.Login
+	}
+	return ""
+}
+
+func (item TimelineItem) CreatedAt() time.Time {
+	switch item.Typename {
+	case "LabeledEvent":
+		return item.LabeledEvent.CreatedAt
+	case "UnlabeledEvent":
+		return item.UnlabeledEvent.CreatedAt
+	case "AssignedEvent":
+		return item.AssignedEvent.CreatedAt
+	case "UnassignedEvent":
+		return item.UnassignedEvent.CreatedAt
+	case "CrossReferencedEvent":
+		return item.CrossReferencedEvent.CreatedAt
+	case "DeployedEvent":
+		return item.DeployedEvent.CreatedAt
+	case "HeadRefForcePushedEvent":
+		return item.HeadRefForcePushedEvent.CreatedAt
+	case "PullRequestCommit":
+		return item.PullRequestCommit.Commit.CommittedDate
+	case "PullRequestReview":
+		return item.PullRequestReview.SubmittedAt
+	}
+	return time.Time{}
+}
@@ -0,0 +1,118 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimelineItem(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	labeled := TimelineItem{Typename: "LabeledEvent"}
+	labeled.LabeledEvent.Actor.Login = "dlvhdr"
+	labeled.LabeledEvent.CreatedAt = createdAt
+
+	assigned := TimelineItem{Typename: "AssignedEvent"}
+	assigned.AssignedEvent.Actor.Login = "dlvhdr"
+	assigned.AssignedEvent.CreatedAt = createdAt
+
+	forcePushed := TimelineItem{Typename: "HeadRefForcePushedEvent"}
+	forcePushed.HeadRefForcePushedEvent.Actor.Login = "dlvhdr"
+	forcePushed.HeadRefForcePushedEvent.CreatedAt = createdAt
+
+	commit := TimelineItem{Typename: "PullRequestCommit"}
+	commit.PullRequestCommit.Commit.Author.Name = "Dolev Hadar"
+	commit.PullRequestCommit.Commit.CommittedDate = createdAt
+
+	userCommit := commit
+	userCommit.PullRequestCommit.Commit.Author.User = &struct{ Login string }{Login: "dlvhdr"}
+
+	review := TimelineItem{Typename: "PullRequestReview"}
+	review.PullRequestReview.Author.Login = "dlvhdr"
+	review.PullRequestReview.SubmittedAt = createdAt
+
+	tests := []struct {
+		name          string
+		item          TimelineItem
+		wantActor     string
+		wantCreatedAt time.Time
+	}{
+		{
+			name:          "labeled",
+			item:          labeled,
+			wantActor:     "dlvhdr",
+			wantCreatedAt: createdAt,
+		},
+		{
+			name:          "assigned",
+			item:          assigned,
+			wantActor:     "dlvhdr",
+			wantCreatedAt: createdAt,
+		},
+		{
+			name:          "force pushed",
+			item:          forcePushed,
+			wantActor:     "dlvhdr",
+			wantCreatedAt: createdAt,
+		},
+		{
+			name:          "commit of an unknown user",
+			item:          commit,
+			wantActor:     "Dolev Hadar",
+			wantCreatedAt: createdAt,
+		},
+		{
+			name:          "commit of a user",
+			item:          userCommit,
+			wantActor:     "dlvhdr",
+			wantCreatedAt: createdAt,
+		},
+		{
+			name:          "review",
+			item:          review,
+			wantActor:     "dlvhdr",
+			wantCreatedAt: createdAt,
+		},
+		{
+			name:          "unknown event",
+			item:          TimelineItem{Typename: "MilestonedEvent"},
+			wantActor:     "",
+			wantCreatedAt: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.item.Actor(); got != tt.wantActor {
+				t.Errorf("Actor() = %q, want %q", got, tt.wantActor)
+			}
+			if got := tt.item.CreatedAt(); !got.Equal(tt.wantCreatedAt) {
+				t.Errorf("CreatedAt() = %v, want %v", got, tt.wantCreatedAt)
+			}
+		})
+	}
+}
+
+func TestTimelineAssigneeLogin(t *testing.T) {
+	var user, bot TimelineAssignee
+	user.User.Login = "dlvhdr"
+	bot.Bot.Login = "dependabot"
+
+	tests := []struct {
+		name     string
+		assignee TimelineAssignee
+		want     string
+	}{
+		{name: "user", assignee: user, want: "dlvhdr"},
+		{name: "bot", assignee: bot, want: "dependabot"},
+		{name: "neither", assignee: TimelineAssignee{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.assignee.Login(); got != tt.want {
+				t.Errorf("Login() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package data
+
+import (
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+	"github.com/shurcooL/githubv4"
+)
+
+// The heavy fields that can be trimmed from the rows of the list queries,
+// they're fetched for the row shown in the sidebar instead
+const (
+	TrimBody      = "body"
+	TrimLabels    = "labels"
+	TrimAssignees = "assignees"
+)
+
+var (
+	trimmedFields   []string
+	trimmedFieldsMu sync.RWMutex
+)
+
+// SetTrimmedFields sets the fields left out of the rows of the list queries
+// sent from now on
+func SetTrimmedFields(fields []string) {
+	trimmedFieldsMu.Lock()
+	defer trimmedFieldsMu.Unlock()
+	trimmedFields = fields
+}
+
+// IsTrimmed returns whether field is left out of the rows of list queries
+func IsTrimmed(field string) bool {
+	trimmedFieldsMu.RLock()
+	defer trimmedFieldsMu.RUnlock()
+	return slices.Contains(trimmedFields, field)
+}
+
+// IsAnyTrimmed returns whether any field is left out of the rows of list
+// queries
+func IsAnyTrimmed() bool {
+	trimmedFieldsMu.RLock()
+	defer trimmedFieldsMu.RUnlock()
+	return len(trimmedFields) > 0
+}
+
+// withListFields adds the variables the @include directives of the trimmable
+// fields of PullRequestData and IssueData refer to, every query fetching
+// them needs these
+func withListFields(variables map[string]any) map[string]any {
+	variables["withBody"] = graphql.Boolean(!IsTrimmed(TrimBody))
+	variables["withLabels"] = graphql.Boolean(!IsTrimmed(TrimLabels))
+	variables["withAssignees"] = graphql.Boolean(!IsTrimmed(TrimAssignees))
+	return variables
+}
+
+// IssueDetails are the fields of an issue that can be trimmed from the list
+// queries
+type IssueDetails struct {
+	Url       string
+	Body      string
+	Labels    IssueLabels `graphql:"labels(first: 20)"`
+	Assignees Assignees   `graphql:"assignees(first: 20)"`
+}
+
+// FetchIssueDetails fetches the fields trimmed from the issue at issueUrl
+func FetchIssueDetails(issueUrl string) (IssueDetails, error) {
+	client, err := newGraphQLClient(forUrl(issueUrl, gh.ClientOptions{EnableCache: true, CacheTTL: 5 * time.Minute}))
+	if err != nil {
+		return IssueDetails{}, err
+	}
+
+	var queryResult struct {
+		Resource struct {
+			Issue IssueDetails `graphql:"... on Issue"`
+		} `graphql:"resource(url: $url)"`
+	}
+	parsedUrl, err := url.Parse(issueUrl)
+	if err != nil {
+		return IssueDetails{}, err
+	}
+	variables := map[string]any{
+		"url": githubv4.URI{URL: parsedUrl},
+	}
+	log.Debug("Fetching issue details", "url", issueUrl)
+	err = client.Query("FetchIssueDetails", &queryResult, variables)
+	if err != nil {
+		return IssueDetails{}, err
+	}
+	log.Info("Successfully fetched issue details", "url", issueUrl)
+
+	return queryResult.Resource.Issue, nil
+}
+
+// RestoreTrimmed sets the fields trimmed from the issue to details
+func (data *IssueData) RestoreTrimmed(details IssueDetails) {
+	if IsTrimmed(TrimBody) {
+		data.Body = details.Body
+	}
+	if IsTrimmed(TrimLabels) {
+		data.Labels = details.Labels
+	}
+	if IsTrimmed(TrimAssignees) {
+		data.Assignees = details.Assignees
+	}
+}
+
+// RestoreTrimmed sets the fields trimmed from the PR to the ones of its
+// enriched data
+func (data *PullRequestData) RestoreTrimmed(enriched EnrichedPullRequestData) {
+	if IsTrimmed(TrimBody) {
+		data.Body = enriched.Body
+	}
+	if IsTrimmed(TrimLabels) {
+		data.Labels = enriched.Labels
+	}
+	if IsTrimmed(TrimAssignees) {
+		data.Assignees = enriched.Assignees
+	}
+}
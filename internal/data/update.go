@@ -0,0 +1,81 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+)
+
+// DashRepo is the repo gh-dash is released from
+const DashRepo = "dlvhdr/gh-dash"
+
+// DashRelease is a release of gh-dash
+type DashRelease struct {
+	Version     string    `json:"tag_name"`
+	Name        string    `json:"name"`
+	Url         string    `json:"html_url"`
+	Notes       string    `json:"body"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// FetchLatestDashRelease fetches the latest release of gh-dash, from
+// github.com whatever the host the dashboard is used with
+func FetchLatestDashRelease() (DashRelease, error) {
+	client, err := newRESTClient(gh.ClientOptions{Host: "github.com"})
+	if err != nil {
+		return DashRelease{}, err
+	}
+
+	var release DashRelease
+	log.Debug("Fetching latest gh-dash release")
+	if err := client.Get(fmt.Sprintf("repos/%s/releases/latest", DashRepo), &release); err != nil {
+		return DashRelease{}, err
+	}
+	return release, nil
+}
+
+// IsNewerVersion returns whether latest is a newer version than current,
+// e.g. v4.12.0 than v4.11.2 or v4.12.0-rc1. It's false when either isn't a
+// version, like the dev builds.
+func IsNewerVersion(current string, latest string) bool {
+	currentCore, currentPre, ok := parseVersion(current)
+	if !ok {
+		return false
+	}
+	latestCore, latestPre, ok := parseVersion(latest)
+	if !ok {
+		return false
+	}
+
+	for i := range currentCore {
+		if latestCore[i] != currentCore[i] {
+			return latestCore[i] > currentCore[i]
+		}
+	}
+	// a release is newer than its pre-releases
+	return currentPre != "" && (latestPre == "" || latestPre > currentPre)
+}
+
+// parseVersion splits a version like v4.12.0-rc1 into its major, minor and
+// patch numbers and its pre-release
+func parseVersion(version string) ([3]int, string, bool) {
+	var core [3]int
+	version, _, _ = strings.Cut(strings.TrimPrefix(version, "v"), "+")
+	version, pre, _ := strings.Cut(version, "-")
+	parts := strings.Split(version, ".")
+	if len(parts) != len(core) {
+		return core, "", false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return core, "", false
+		}
+		core[i] = n
+	}
+	return core, pre, true
+}
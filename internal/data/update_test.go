@@ -0,0 +1,33 @@
+package data
+
+import "testing"
+
+func TestIsNewerVersion(t *testing.T) {
+	tests := []struct {
+		current string
+		latest  string
+		want    bool
+	}{
+		{current: "v4.11.2", latest: "v4.12.0", want: true},
+		{current: "v4.12.0", latest: "v4.12.0", want: false},
+		{current: "4.12.0", latest: "v4.12.1", want: true},
+		{current: "v4.12.0", latest: "v4.11.9", want: false},
+		{current: "v4.9.0", latest: "v4.10.0", want: true},
+		{current: "v4.12.0", latest: "v5.0.0", want: true},
+		{current: "v4.12.0-rc1", latest: "v4.12.0", want: true},
+		{current: "v4.12.0-rc1", latest: "v4.12.0-rc2", want: true},
+		{current: "v4.12.0", latest: "v4.12.1-rc1", want: true},
+		{current: "v4.12.0", latest: "v4.12.0-rc1", want: false},
+		{current: "v4.12.0+dirty", latest: "v4.12.0", want: false},
+		{current: "dev", latest: "v4.12.0", want: false},
+		{current: "v4.12.0", latest: "nightly", want: false},
+		{current: "(devel)", latest: "v4.12.0", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.current+" to "+tt.latest, func(t *testing.T) {
+			if got := IsNewerVersion(tt.current, tt.latest); got != tt.want {
+				t.Errorf("IsNewerVersion(%q, %q) = %v, want %v", tt.current, tt.latest, got, tt.want)
+			}
+		})
+	}
+}
@@ -5,7 +5,7 @@ import (
 )
 
 func CurrentLoginName() (string, error) {
-	client, err := gh.DefaultGraphQLClient()
+	client, err := newGraphQLClient(gh.ClientOptions{})
 	if err != nil {
 		return "", nil
 	}
@@ -16,6 +16,12 @@ type RowData interface {
 	GetUpdatedAt() time.Time
 }
 
+// FileReferrer is implemented by the rows that reference a file of their repo,
+// e.g. a manifest or a workflow
+type FileReferrer interface {
+	GetFilePath() string
+}
+
 func GetAuthorRoleIcon(role string, theme theme.Theme) string {
 	// https://docs.github.com/en/graphql/reference/enums#commentauthorassociation
 	switch role {
@@ -0,0 +1,230 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	gh "github.com/cli/go-gh/v2/pkg/api"
+)
+
+type WorkflowRunData struct {
+	Id           int64     `json:"id"`
+	Name         string    `json:"name"`
+	DisplayTitle string    `json:"display_title"`
+	RunNumber    int       `json:"run_number"`
+	RunAttempt   int       `json:"run_attempt"`
+	Event        string    `json:"event"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	HeadBranch   string    `json:"head_branch"`
+	HeadSha      string    `json:"head_sha"`
+	Path         string    `json:"path"`
+	Url          string    `json:"html_url"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	RunStartedAt time.Time `json:"run_started_at"`
+	Actor        struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+	Repository struct {
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// GetFilePath returns the path of the workflow file of the run
+func (data WorkflowRunData) GetFilePath() string {
+	path, _, _ := strings.Cut(data.Path, "@")
+	return path
+}
+
+func (data WorkflowRunData) GetRepoNameWithOwner() string {
+	return data.Repository.FullName
+}
+
+func (data WorkflowRunData) GetTitle() string {
+	return data.DisplayTitle
+}
+
+func (data WorkflowRunData) GetNumber() int {
+	return data.RunNumber
+}
+
+func (data WorkflowRunData) GetUrl() string {
+	return data.Url
+}
+
+func (data WorkflowRunData) GetUpdatedAt() time.Time {
+	return data.UpdatedAt
+}
+
+func (data WorkflowRunData) IsCompleted() bool {
+	return data.Status == "completed"
+}
+
+// Duration is how long the run took, or has been running for if it isn't
+// completed yet
+func (data WorkflowRunData) Duration() time.Duration {
+	if data.RunStartedAt.IsZero() {
+		return 0
+	}
+	if data.IsCompleted() {
+		return data.UpdatedAt.Sub(data.RunStartedAt)
+	}
+	return time.Since(data.RunStartedAt)
+}
+
+type WorkflowRunsResponse struct {
+	Runs       []WorkflowRunData
+	TotalCount int
+	PageInfo   PageInfo
+}
+
+// WorkflowRunsQuery holds the parsed filters of a workflows section
+type WorkflowRunsQuery struct {
+	Repos    []string
+	Workflow string
+	Branch   string
+	Status   string
+	Event    string
+	Actor    string
+	Created  string
+}
+
+// ParseWorkflowRunsQuery parses filters like "repo:dlvhdr/gh-dash branch:main
+// status:failure" into the parameters of the workflow runs REST API
+func ParseWorkflowRunsQuery(filters string) (WorkflowRunsQuery, error) {
+	var q WorkflowRunsQuery
+	for token := range strings.FieldsSeq(filters) {
+		key, value, ok := strings.Cut(token, ":")
+		if !ok || value == "" {
+			return q, fmt.Errorf("invalid workflow runs filter %q, expected key:value", token)
+		}
+		switch key {
+		case "repo":
+			if !slices.Contains(q.Repos, value) {
+				q.Repos = append(q.Repos, value)
+			}
+		case "workflow":
+			q.Workflow = value
+		case "branch":
+			q.Branch = value
+		case "status", "is":
+			q.Status = value
+		case "event":
+			q.Event = value
+		case "actor", "author":
+			q.Actor = value
+		case "created":
+			q.Created = value
+		default:
+			return q, fmt.Errorf("unsupported workflow runs filter %q", token)
+		}
+	}
+	if len(q.Repos) == 0 {
+		return q, errors.New("workflow runs need at least one repo:owner/name filter")
+	}
+	return q, nil
+}
+
+func (q WorkflowRunsQuery) path(repo string, limit int, page int) string {
+	params := url.Values{}
+	params.Set("per_page", strconv.Itoa(limit))
+	params.Set("page", strconv.Itoa(page))
+	if q.Branch != "" {
+		params.Set("branch", q.Branch)
+	}
+	if q.Status != "" {
+		params.Set("status", q.Status)
+	}
+	if q.Event != "" {
+		params.Set("event", q.Event)
+	}
+	if q.Actor != "" {
+		params.Set("actor", q.Actor)
+	}
+	if q.Created != "" {
+		params.Set("created", q.Created)
+	}
+
+	runsPath := fmt.Sprintf("repos/%s/actions/runs", repo)
+	if q.Workflow != "" {
+		runsPath = fmt.Sprintf("repos/%s/actions/workflows/%s/runs", repo, url.PathEscape(q.Workflow))
+	}
+	return fmt.Sprintf("%s?%s", runsPath, params.Encode())
+}
+
+// repoLimit splits limit across the repos of q, returning how many runs to
+// fetch from the repo at index i. Each repo gets at least one run.
+func (q WorkflowRunsQuery) repoLimit(i int, limit int) int {
+	perRepo := limit / len(q.Repos)
+	if i < limit%len(q.Repos) {
+		perRepo++
+	}
+	return max(1, perRepo)
+}
+
+// FetchWorkflowRuns fetches the most recent workflow runs of every repo in
+// filters, limit runs in all split across the repos. Pages are numbered, the
+// next page to fetch is kept in PageInfo.EndCursor.
+func FetchWorkflowRuns(filters string, limit int, pageInfo *PageInfo) (WorkflowRunsResponse, error) {
+	q, err := ParseWorkflowRunsQuery(filters)
+	if err != nil {
+		return WorkflowRunsResponse{}, err
+	}
+
+	client, err := newRESTClient(gh.ClientOptions{})
+	if err != nil {
+		return WorkflowRunsResponse{}, err
+	}
+
+	if q.Actor == "@me" {
+		login, err := CurrentLoginName()
+		if err != nil {
+			return WorkflowRunsResponse{}, err
+		}
+		q.Actor = login
+	}
+
+	page := 1
+	if pageInfo != nil && pageInfo.EndCursor != "" {
+		page, err = strconv.Atoi(pageInfo.EndCursor)
+		if err != nil {
+			return WorkflowRunsResponse{}, err
+		}
+	}
+
+	var res WorkflowRunsResponse
+	for i, repo := range q.Repos {
+		repoLimit := q.repoLimit(i, limit)
+		var queryResult struct {
+			TotalCount   int               `json:"total_count"`
+			WorkflowRuns []WorkflowRunData `json:"workflow_runs"`
+		}
+		log.Debug("Fetching workflow runs", "repo", repo, "page", page)
+		err = client.Get(q.path(repo, repoLimit, page), &queryResult)
+		if err != nil {
+			return WorkflowRunsResponse{}, err
+		}
+		res.Runs = append(res.Runs, queryResult.WorkflowRuns...)
+		res.TotalCount += queryResult.TotalCount
+		if queryResult.TotalCount > page*repoLimit {
+			res.PageInfo.HasNextPage = true
+		}
+	}
+	log.Info("Successfully fetched workflow runs", "count", len(res.Runs))
+
+	slices.SortStableFunc(res.Runs, func(a, b WorkflowRunData) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	res.PageInfo.StartCursor = strconv.Itoa(page)
+	res.PageInfo.EndCursor = strconv.Itoa(page + 1)
+
+	return res, nil
+}
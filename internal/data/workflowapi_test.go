@@ -0,0 +1,104 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseWorkflowRunsQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters string
+		want    WorkflowRunsQuery
+		wantErr bool
+	}{
+		{
+			name:    "single repo",
+			filters: "repo:dlvhdr/gh-dash",
+			want:    WorkflowRunsQuery{Repos: []string{"dlvhdr/gh-dash"}},
+		},
+		{
+			name:    "all filters",
+			filters: "repo:dlvhdr/gh-dash workflow:ci.yml branch:main status:failure event:push actor:@me created:>=2024-01-01",
+			want: WorkflowRunsQuery{
+				Repos:    []string{"dlvhdr/gh-dash"},
+				Workflow: "ci.yml",
+				Branch:   "main",
+				Status:   "failure",
+				Event:    "push",
+				Actor:    "@me",
+				Created:  ">=2024-01-01",
+			},
+		},
+		{
+			name:    "multiple repos without duplicates",
+			filters: "repo:dlvhdr/gh-dash repo:dlvhdr/diffnav repo:dlvhdr/gh-dash",
+			want:    WorkflowRunsQuery{Repos: []string{"dlvhdr/gh-dash", "dlvhdr/diffnav"}},
+		},
+		{
+			name:    "search aliases",
+			filters: "repo:dlvhdr/gh-dash is:in_progress author:dlvhdr",
+			want: WorkflowRunsQuery{
+				Repos:  []string{"dlvhdr/gh-dash"},
+				Status: "in_progress",
+				Actor:  "dlvhdr",
+			},
+		},
+		{
+			name:    "missing repo",
+			filters: "branch:main",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported filter",
+			filters: "repo:dlvhdr/gh-dash label:bug",
+			wantErr: true,
+		},
+		{
+			name:    "token without value",
+			filters: "repo:dlvhdr/gh-dash main",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWorkflowRunsQuery(tt.filters)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseWorkflowRunsQuery(%q) err = %v, wantErr %v", tt.filters, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseWorkflowRunsQuery(%q) = %+v, want %+v", tt.filters, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowRunsQueryRepoLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		repos int
+		limit int
+		want  []int
+	}{
+		{name: "single repo", repos: 1, limit: 20, want: []int{20}},
+		{name: "split evenly", repos: 2, limit: 20, want: []int{10, 10}},
+		{name: "remainder to the first repos", repos: 3, limit: 20, want: []int{7, 7, 6}},
+		{name: "more repos than the limit", repos: 3, limit: 2, want: []int{1, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := WorkflowRunsQuery{Repos: make([]string, tt.repos)}
+			got := make([]int, tt.repos)
+			for i := range got {
+				got[i] = q.repoLimit(i, tt.limit)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("repoLimit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package data
+
+import "sort"
+
+// AssignedIssueCounts returns how many of the open issues are assigned to
+// each user, by login. Issues listed more than once, e.g. by several
+// sections, are counted once.
+func AssignedIssueCounts(issues []IssueData) map[string]int {
+	counts := map[string]int{}
+	seen := map[string]bool{}
+	for _, issue := range issues {
+		if issue.State != "OPEN" || seen[issue.Url] {
+			continue
+		}
+		seen[issue.Url] = true
+		for _, assignee := range issue.Assignees.Nodes {
+			counts[assignee.Login]++
+		}
+	}
+	return counts
+}
+
+// ByLoad sorts logins by how many open issues are assigned to them, the least
+// loaded first. Logins with the same load keep their order.
+func ByLoad(logins []string, counts map[string]int) []string {
+	sorted := append([]string(nil), logins...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return counts[sorted[i]] < counts[sorted[j]]
+	})
+	return sorted
+}
@@ -0,0 +1,69 @@
+package data
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestAssignedIssueCounts(t *testing.T) {
+	issue := func(url string, state string, assignees ...string) IssueData {
+		i := IssueData{Url: url, State: state}
+		for _, login := range assignees {
+			i.Assignees.Nodes = append(i.Assignees.Nodes, Assignee{Login: login})
+		}
+		return i
+	}
+
+	tests := []struct {
+		name   string
+		issues []IssueData
+		want   map[string]int
+	}{
+		{
+			name: "no issues",
+			want: map[string]int{},
+		},
+		{
+			name: "open issues",
+			issues: []IssueData{
+				issue("1", "OPEN", "alice", "bob"),
+				issue("2", "OPEN", "alice"),
+			},
+			want: map[string]int{"alice": 2, "bob": 1},
+		},
+		{
+			name: "closed issues aren't counted",
+			issues: []IssueData{
+				issue("1", "OPEN", "alice"),
+				issue("2", "CLOSED", "alice", "bob"),
+			},
+			want: map[string]int{"alice": 1},
+		},
+		{
+			name: "issues of several sections are counted once",
+			issues: []IssueData{
+				issue("1", "OPEN", "alice"),
+				issue("1", "OPEN", "alice"),
+			},
+			want: map[string]int{"alice": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AssignedIssueCounts(tt.issues); !maps.Equal(got, tt.want) {
+				t.Errorf("AssignedIssueCounts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestByLoad(t *testing.T) {
+	counts := map[string]int{"alice": 3, "bob": 1}
+	got := ByLoad([]string{"alice", "bob", "carol", "dave"}, counts)
+	want := []string{"carol", "dave", "bob", "alice"}
+	if !slices.Equal(got, want) {
+		t.Errorf("ByLoad() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,180 @@
+// Package export writes the rows of a section as JSON, CSV or an aligned
+// text table, so dashboards can be piped into scripts.
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+type Format string
+
+const (
+	JSON Format = "json"
+	CSV  Format = "csv"
+	// Text is a table aligned with spaces, for reading in a terminal
+	Text Format = "table"
+)
+
+// ParseFormat returns the format named s, ignoring case
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case JSON, CSV, Text:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown export format %q, expected json, csv or table", s)
+	}
+}
+
+// Table holds the rows of a section, the values of each row are in the order
+// of the columns
+type Table struct {
+	Columns []string
+	Rows    [][]any
+}
+
+// Append adds a row with the values of fields for the table's columns, the
+// columns missing from fields are empty
+func (t *Table) Append(fields map[string]any) {
+	row := make([]any, 0, len(t.Columns))
+	for _, column := range t.Columns {
+		row = append(row, fields[column])
+	}
+	t.Rows = append(t.Rows, row)
+}
+
+// Select returns the table with only the given columns, in their order. The
+// columns t doesn't have are skipped.
+func (t Table) Select(columns ...string) Table {
+	var selected Table
+	var indexes []int
+	for _, column := range columns {
+		if i := slices.Index(t.Columns, column); i >= 0 {
+			selected.Columns = append(selected.Columns, column)
+			indexes = append(indexes, i)
+		}
+	}
+	for _, row := range t.Rows {
+		values := make([]any, 0, len(indexes))
+		for _, i := range indexes {
+			values = append(values, row[i])
+		}
+		selected.Rows = append(selected.Rows, values)
+	}
+	return selected
+}
+
+// Write writes the rows of t to w in format
+func Write(w io.Writer, t Table, format Format) error {
+	switch format {
+	case CSV:
+		return writeCSV(w, t)
+	case Text:
+		return writeText(w, t)
+	default:
+		return writeJSON(w, t)
+	}
+}
+
+// writeJSON writes the rows as an array of objects, their keys in the order
+// of the columns
+func writeJSON(w io.Writer, t Table) error {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, row := range t.Rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		for j, value := range row {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			key, err := json.Marshal(t.Columns[j])
+			if err != nil {
+				return err
+			}
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("encoding %s: %w", t.Columns[j], err)
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(encoded)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(']')
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, buf.Bytes(), "", "  "); err != nil {
+		return err
+	}
+	out.WriteByte('\n')
+	_, err := out.WriteTo(w)
+	return err
+}
+
+// writeCSV writes the columns as the header, followed by a record per row
+func writeCSV(w io.Writer, t Table) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(t.Columns); err != nil {
+		return err
+	}
+	for _, row := range t.Rows {
+		record := make([]string, 0, len(row))
+		for _, value := range row {
+			record = append(record, formatValue(value))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeText writes the columns as the header, followed by a line per row,
+// the values aligned in columns
+func writeText(w io.Writer, t Table) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.Columns, "\t"))
+	for _, row := range t.Rows {
+		values := make([]string, 0, len(row))
+		for _, value := range row {
+			// a tab or newline in a title would break the alignment
+			values = append(values, strings.Join(strings.Fields(formatValue(value)), " "))
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
+
+// formatValue returns value as text: lists are joined with commas and
+// times are in RFC 3339, empty when unset
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ", ")
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
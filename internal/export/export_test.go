@@ -0,0 +1,115 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testTable() Table {
+	t := Table{Columns: []string{"Number", "Title", "Labels", "UpdatedAt", "Status"}}
+	t.Append(map[string]any{
+		"Number":    42,
+		"Title":     `Fix "quotes", commas`,
+		"Labels":    []string{"bug", "ui"},
+		"UpdatedAt": time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC),
+		"Status":    "In Progress",
+	})
+	t.Append(map[string]any{
+		"Number": 7,
+		"Title":  "Second",
+		"Labels": []string{},
+	})
+	return t
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		want   string
+	}{
+		{
+			name:   "json",
+			format: JSON,
+			want: `[
+  {
+    "Number": 42,
+    "Title": "Fix \"quotes\", commas",
+    "Labels": [
+      "bug",
+      "ui"
+    ],
+    "UpdatedAt": "2024-05-01T12:30:00Z",
+    "Status": "In Progress"
+  },
+  {
+    "Number": 7,
+    "Title": "Second",
+    "Labels": [],
+    "UpdatedAt": null,
+    "Status": null
+  }
+]
+`,
+		},
+		{
+			name:   "csv",
+			format: CSV,
+			want: `Number,Title,Labels,UpdatedAt,Status
+42,"Fix ""quotes"", commas","bug, ui",2024-05-01T12:30:00Z,In Progress
+7,Second,,,
+`,
+		},
+		{
+			name:   "table",
+			format: Text,
+			want: `Number  Title                 Labels   UpdatedAt             Status
+42      Fix "quotes", commas  bug, ui  2024-05-01T12:30:00Z  In Progress
+7       Second                                               
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, testTable(), tt.format); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Write() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteEmptyJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, Table{Columns: []string{"Number"}}, JSON); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "[]\n" {
+		t.Errorf("Write() = %q, want %q", got, "[]\n")
+	}
+}
+
+func TestSelect(t *testing.T) {
+	got := testTable().Select("Title", "Missing", "Number")
+	want := Table{
+		Columns: []string{"Title", "Number"},
+		Rows:    [][]any{{`Fix "quotes", commas`, 42}, {"Second", 7}},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestParseFormat(t *testing.T) {
+	if f, err := ParseFormat("CSV"); err != nil || f != CSV {
+		t.Errorf("ParseFormat(CSV) = %q, %v, want %q", f, err, CSV)
+	}
+	if _, err := ParseFormat("xml"); err == nil {
+		t.Error("ParseFormat(xml) succeeded, want an error")
+	}
+}
@@ -0,0 +1,160 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout is used for git commands without a configured timeout
+const DefaultTimeout = time.Minute
+
+// Options controls how git is invoked for all operations in this package
+type Options struct {
+	// Binary is the git executable, looked up in $PATH when not absolute.
+	// Defaults to "git".
+	Binary string
+	// Timeout applies to every git command without an entry in Timeouts.
+	// Defaults to DefaultTimeout.
+	Timeout time.Duration
+	// Timeouts overrides Timeout per git subcommand, e.g. "fetch".
+	Timeouts map[string]time.Duration
+	// Env holds extra "KEY=VALUE" entries added to the environment of every
+	// git command, e.g. GIT_SSH_COMMAND.
+	Env []string
+}
+
+var (
+	options    Options
+	optionsMtx sync.RWMutex
+)
+
+// Configure sets the options used by all subsequent git commands
+func Configure(opts Options) {
+	optionsMtx.Lock()
+	defer optionsMtx.Unlock()
+	options = opts
+}
+
+func currentOptions() Options {
+	optionsMtx.RLock()
+	defer optionsMtx.RUnlock()
+	return options
+}
+
+func (o Options) binary() string {
+	if o.Binary == "" {
+		return "git"
+	}
+	return o.Binary
+}
+
+func (o Options) timeoutFor(subcommand string) time.Duration {
+	if t, ok := o.Timeouts[subcommand]; ok && t > 0 {
+		return t
+	}
+	if o.Timeout > 0 {
+		return o.Timeout
+	}
+	return DefaultTimeout
+}
+
+// TimeoutError is returned when a git command runs longer than its timeout
+type TimeoutError struct {
+	Subcommand string
+	Timeout    time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf(
+		"git %s timed out after %s, raise git.timeouts.%s in your config if this is expected",
+		e.Subcommand,
+		e.Timeout,
+		e.Subcommand,
+	)
+}
+
+// run executes the configured git binary with args inside dir and returns
+// its stdout. Failures include git's stderr so they can be shown as is.
+func run(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	return runWithProgress(ctx, dir, nil, args...)
+}
+
+// runWithProgress is run, calling onProgress with every line git writes to
+// stderr as it's written, e.g. the progress of a fetch
+func runWithProgress(ctx context.Context, dir string, onProgress func(line string), args ...string) ([]byte, error) {
+	opts := currentOptions()
+	subcommand := ""
+	if len(args) > 0 {
+		subcommand = args[0]
+	}
+	timeout := opts.timeoutFor(subcommand)
+	tctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(tctx, opts.binary(), args...)
+	cmd.Dir = dir
+	if len(opts.Env) > 0 {
+		cmd.Env = append(os.Environ(), opts.Env...)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if onProgress != nil {
+		cmd.Stderr = io.MultiWriter(&stderr, &lineWriter{onLine: onProgress})
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if errors.Is(tctx.Err(), context.DeadlineExceeded) {
+			return nil, &TimeoutError{Subcommand: subcommand, Timeout: timeout}
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("git %s: %s", subcommand, msg)
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+// lines splits command output into its non empty lines
+func lines(out []byte) []string {
+	var res []string
+	for _, l := range strings.Split(string(out), "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+// lineWriter calls onLine with every complete line written to it. Carriage
+// returns end a line too, git uses them to redraw its progress in place.
+type lineWriter struct {
+	onLine func(line string)
+	buf    []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexAny(w.buf, "\r\n")
+		if i < 0 {
+			break
+		}
+		if l := strings.TrimSpace(string(w.buf[:i])); l != "" {
+			w.onLine(l)
+		}
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
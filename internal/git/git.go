@@ -3,13 +3,17 @@ package git
 import (
 	"bufio"
 	"bytes"
+	"cmp"
+	"context"
 	"errors"
 	"fmt"
-	"sort"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	gitm "github.com/aymanbagabas/git-module"
+	"github.com/cli/go-gh/v2/pkg/auth"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
@@ -22,6 +26,11 @@ type Repo struct {
 	Branches       []Branch
 	HeadBranchName string
 	Status         gitm.NameStatus
+	// TotalBranchCount is the number of branches matching the RepoOptions
+	// used to read the repo, Branches may hold fewer when limited
+	TotalBranchCount int
+	// DefaultBranch is the branch origin/HEAD points to, empty if unknown
+	DefaultBranch string
 }
 
 type Branch struct {
@@ -33,125 +42,288 @@ type Branch struct {
 	CommitsBehind int
 	IsCheckedOut  bool
 	Remotes       []string
+	// Upstream is the short name of the tracked remote branch, e.g. "origin/main"
+	Upstream string
+	// Worktree is the path of the other worktree the branch is checked out
+	// in, empty when it isn't
+	Worktree string
 }
 
 func GetOriginUrl(dir string) (string, error) {
-	repo, err := gitm.Open(dir)
-	if err != nil {
-		return "", err
-	}
-	remotes, err := repo.Remotes()
-	if err != nil {
-		return "", err
-	}
-
-	for _, remote := range remotes {
-		if remote != "origin" {
-			continue
-		}
-
-		urls, err := gitm.RemoteGetURL(dir, remote)
-		if err != nil || len(urls) == 0 {
-			return "", err
-		}
-		return urls[0], nil
-	}
+	return getRemoteUrl(dir, "origin")
+}
 
-	return "", errors.New("no origin remote found")
+// RepoOptions limits the branches GetRepoWithContext reads metadata for, so
+// repos with thousands of branches can be loaded incrementally
+type RepoOptions struct {
+	// Limit caps the number of branches, most recently updated first.
+	// Zero means no limit.
+	Limit int
+	// UpdatedSince skips branches whose last commit is older.
+	// The zero value disables the cutoff.
+	UpdatedSince time.Time
+	// NameFilter only keeps branches whose name contains it, ignoring case
+	NameFilter string
 }
 
 func GetRepo(dir string) (*Repo, error) {
+	return GetRepoWithContext(context.Background(), dir, RepoOptions{})
+}
+
+// GetRepoWithContext reads the repository at dir, killing any git command
+// still running once ctx is done.
+func GetRepoWithContext(ctx context.Context, dir string, opts RepoOptions) (*Repo, error) {
 	repo, err := gitm.Open(dir)
 	if err != nil {
 		return nil, err
 	}
 
-	bNames, err := repo.Branches()
+	out, err := run(ctx, dir, "rev-parse", "--abbrev-ref", "HEAD")
 	if err != nil {
 		return nil, err
 	}
+	headRef := strings.TrimSpace(string(out))
 
-	headRef, err := repo.RevParse("HEAD", gitm.RevParseOptions{
-		CommandOptions: gitm.CommandOptions{Args: []string{"--abbrev-ref"}},
-	})
+	status, err := getUnstagedStatus(ctx, dir)
 	if err != nil {
 		return nil, err
 	}
-	status, err := getUnstagedStatus(repo)
+
+	out, err = run(
+		ctx,
+		dir,
+		"for-each-ref",
+		"--sort=-committerdate",
+		"--format="+refFormat,
+		gitm.RefsHeads,
+	)
 	if err != nil {
 		return nil, err
 	}
-
-	branches := make([]Branch, len(bNames))
-	for i, b := range bNames {
-		var updatedAt *time.Time
-		var lastCommitMsg *string
-		isHead := b == headRef
-		commits, err := gitm.Log(dir, b, gitm.LogOptions{MaxCount: 1})
-		if err == nil && len(commits) > 0 {
-			updatedAt = &commits[0].Committer.When
-			lastCommitMsg = utils.StringPtr(commits[0].Summary())
+	refs, total := filterRefs(parseRefs(out), headRef, opts)
+
+	// a failure to list the worktrees, e.g. with an old git, only loses the
+	// worktree markers
+	worktrees := map[string]string{}
+	if wts, err := getWorktrees(ctx, dir); err == nil {
+		for _, wt := range wts {
+			if wt.Branch != "" && wt.Branch != headRef {
+				worktrees[wt.Branch] = wt.Path
+			}
 		}
-		commitsAhead, err := repo.RevListCount([]string{fmt.Sprintf("origin/%s..%s", b, b)})
-		if err != nil {
-			commitsAhead = 0
+	}
+
+	branches := make([]Branch, len(refs))
+	for i, ref := range refs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
-		commitsBehind, err := repo.RevListCount([]string{fmt.Sprintf("%s..origin/%s", b, b)})
-		if err != nil {
-			commitsBehind = 0
+		b := ref.name
+		var remotes []string
+		if ref.remote != "" {
+			remotes = []string{ref.remote}
 		}
-		remotes, _ := repo.RemoteGetURL(b)
 		branches[i] = Branch{
 			Name:          b,
-			LastUpdatedAt: updatedAt,
-			CreatedAt:     updatedAt,
-			IsCheckedOut:  isHead,
+			LastUpdatedAt: ref.updatedAt,
+			CreatedAt:     ref.updatedAt,
+			IsCheckedOut:  b == headRef,
 			Remotes:       remotes,
-			LastCommitMsg: lastCommitMsg,
-			CommitsAhead:  int(commitsAhead),
-			CommitsBehind: int(commitsBehind),
+			LastCommitMsg: ref.subject,
+			CommitsAhead:  ref.ahead,
+			CommitsBehind: ref.behind,
+			Upstream:      ref.upstream,
+			Worktree:      worktrees[b],
 		}
 	}
-	sort.Slice(branches, func(i, j int) bool {
-		if branches[j].LastUpdatedAt == nil || branches[i].LastUpdatedAt == nil {
-			return false
-		}
-		return branches[i].LastUpdatedAt.After(*branches[j].LastUpdatedAt)
-	})
-
-	headBranch, err := repo.SymbolicRef()
+	out, err = run(ctx, dir, "symbolic-ref", "HEAD")
 	if err != nil {
 		return nil, err
 	}
-	headBranch, _ = strings.CutPrefix(headBranch, gitm.RefsHeads)
+	headBranch, _ := strings.CutPrefix(strings.TrimSpace(string(out)), gitm.RefsHeads)
 
-	remotes, err := repo.Remotes(gitm.RemotesOptions{CommandOptions: gitm.CommandOptions{Args: []string{"show"}}})
+	out, err = run(ctx, dir, "remote", "show")
 	if err != nil {
 		return nil, err
 	}
-	origin, err := gitm.RemoteGetURL(dir, "origin", gitm.RemoteGetURLOptions{All: true})
+	remotes := lines(out)
+
+	out, err = run(ctx, dir, "remote", "get-url", "--all", "origin")
 	if err != nil {
 		return nil, err
 	}
+	origin := lines(out)
+	if len(origin) == 0 {
+		return nil, errors.New("no origin remote found")
+	}
+
+	defaultBranch, _ := getDefaultBranch(ctx, dir)
 
 	return &Repo{
 		Repository: *repo, Origin: origin[0], Remotes: remotes,
 		HeadBranchName: headBranch, Branches: branches, Status: status,
+		TotalBranchCount: total, DefaultBranch: defaultBranch,
 	}, nil
 }
 
-func GetStatus(dir string) (gitm.NameStatus, error) {
-	repo, err := gitm.Open(dir)
+// getDefaultBranch returns the branch origin/HEAD points to, falling back to
+// main or master when origin/HEAD was never set, e.g. for repos created with
+// git init and pushed later
+func getDefaultBranch(ctx context.Context, dir string) (string, error) {
+	out, err := run(ctx, dir, "symbolic-ref", "--short", "refs/remotes/origin/HEAD")
+	if err == nil {
+		return strings.TrimPrefix(strings.TrimSpace(string(out)), "origin/"), nil
+	}
+	for _, candidate := range []string{"main", "master"} {
+		if _, err := run(ctx, dir, "rev-parse", "--verify", "--quiet", "refs/remotes/origin/"+candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", err
+}
+
+// CommitMessage is the subject and body of a single commit
+type CommitMessage struct {
+	Subject string
+	Body    string
+}
+
+// GetCommitMessages returns the messages of the commits reachable from head
+// but not from base, oldest first
+func GetCommitMessages(dir, base, head string) ([]CommitMessage, error) {
+	out, err := run(
+		context.Background(),
+		dir,
+		"log",
+		"--reverse",
+		"--format=%s%x00%b%x1e",
+		base+".."+head,
+		"--",
+	)
 	if err != nil {
-		return gitm.NameStatus{}, err
+		return nil, err
+	}
+
+	var messages []CommitMessage
+	for entry := range strings.SplitSeq(string(out), "\x1e") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		subject, body, _ := strings.Cut(entry, "\x00")
+		messages = append(messages, CommitMessage{
+			Subject: strings.TrimSpace(subject),
+			Body:    strings.TrimSpace(body),
+		})
+	}
+	return messages, nil
+}
+
+// refFormat is the for-each-ref format parsed by parseRefs, fields are NUL
+// separated and the subject comes last as it's free text
+const refFormat = "%(refname:short)%00%(committerdate:unix)%00%(upstream:remotename)" +
+	"%00%(upstream:track,nobracket)%00%(upstream:short)%00%(contents:subject)"
+
+type branchRef struct {
+	name      string
+	updatedAt *time.Time
+	subject   *string
+	remote    string
+	upstream  string
+	// ahead and behind are the commits only on the branch and only on its
+	// upstream, both 0 without an upstream
+	ahead  int
+	behind int
+}
+
+// parseRefs parses the output of for-each-ref with refFormat
+func parseRefs(out []byte) []branchRef {
+	var refs []branchRef
+	for _, line := range lines(out) {
+		fields := strings.SplitN(line, "\x00", 6)
+		ref := branchRef{name: fields[0]}
+		if len(fields) == 6 {
+			if secs, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+				t := time.Unix(secs, 0)
+				ref.updatedAt = &t
+			}
+			ref.remote = fields[2]
+			_, ref.ahead, ref.behind = parseTrack(fields[2], fields[3])
+			ref.upstream = fields[4]
+			ref.subject = utils.StringPtr(fields[5])
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// parseTrack parses %(upstream:track,nobracket), e.g. "ahead 1, behind 2".
+// A branch whose upstream is "gone" is treated as having no upstream.
+func parseTrack(remote string, track string) (hasUpstream bool, ahead int, behind int) {
+	if remote == "" || track == "gone" {
+		return false, 0, 0
+	}
+	for part := range strings.SplitSeq(track, ",") {
+		kind, count, ok := strings.Cut(strings.TrimSpace(part), " ")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			continue
+		}
+		switch kind {
+		case "ahead":
+			ahead = n
+		case "behind":
+			behind = n
+		}
+	}
+	return true, ahead, behind
+}
+
+// filterRefs applies opts to refs, which are expected to be sorted by most
+// recently updated first. The checked out branch is always kept. It returns
+// the kept refs and how many matched before Limit was applied.
+func filterRefs(refs []branchRef, headRef string, opts RepoOptions) ([]branchRef, int) {
+	filter := strings.ToLower(opts.NameFilter)
+	matching := make([]branchRef, 0, len(refs))
+	for _, ref := range refs {
+		if ref.name != headRef {
+			if filter != "" && !strings.Contains(strings.ToLower(ref.name), filter) {
+				continue
+			}
+			if !opts.UpdatedSince.IsZero() && ref.updatedAt != nil && ref.updatedAt.Before(opts.UpdatedSince) {
+				continue
+			}
+		}
+		matching = append(matching, ref)
+	}
+
+	if opts.Limit <= 0 || len(matching) <= opts.Limit {
+		return matching, len(matching)
 	}
-	return getUnstagedStatus(repo)
+	limited := slices.Clone(matching[:opts.Limit])
+	if idx := slices.IndexFunc(matching[opts.Limit:], func(ref branchRef) bool {
+		return ref.name == headRef
+	}); idx != -1 {
+		limited = append(limited, matching[opts.Limit+idx])
+	}
+	return limited, len(matching)
+}
+
+func GetStatus(dir string) (gitm.NameStatus, error) {
+	return GetStatusWithContext(context.Background(), dir)
+}
+
+// GetStatusWithContext returns the unstaged changes of the repository at dir
+func GetStatusWithContext(ctx context.Context, dir string) (gitm.NameStatus, error) {
+	return getUnstagedStatus(ctx, dir)
 }
 
 // test
-func getUnstagedStatus(repo *gitm.Repository) (gitm.NameStatus, error) {
-	cmd := gitm.NewCommand("diff", "HEAD", "--name-status")
-	stdout, err := cmd.RunInDir(repo.Path())
+func getUnstagedStatus(ctx context.Context, dir string) (gitm.NameStatus, error) {
+	stdout, err := run(ctx, dir, "diff", "HEAD", "--name-status")
 	if err != nil {
 		return gitm.NameStatus{}, err
 	}
@@ -176,52 +348,225 @@ func getUnstagedStatus(repo *gitm.Repository) (gitm.NameStatus, error) {
 }
 
 func FetchRepo(dir string) (*Repo, error) {
-	repo, err := gitm.Open(dir)
-	if err != nil {
+	return FetchRepoWithContext(context.Background(), dir, RepoOptions{})
+}
+
+// FetchRepoWithContext fetches all remotes and then reads the repository at
+// dir, killing any git command still running once ctx is done.
+func FetchRepoWithContext(ctx context.Context, dir string, opts RepoOptions) (*Repo, error) {
+	if _, err := run(ctx, dir, "fetch", "--all"); err != nil {
 		return nil, err
 	}
-	err = repo.Fetch(gitm.FetchOptions{CommandOptions: gitm.CommandOptions{Args: []string{"--all"}}})
+	return GetRepoWithContext(ctx, dir, opts)
+}
+
+// Fetch runs git fetch with args in the repository at dir
+func Fetch(dir string, args ...string) error {
+	_, err := run(context.Background(), dir, append([]string{"fetch"}, args...)...)
+	return err
+}
+
+// Pull pulls branch from remote into the checked out branch
+func Pull(dir, remote, branch string, args ...string) error {
+	args = append(append([]string{"pull"}, args...), remote, branch)
+	_, err := run(context.Background(), dir, args...)
+	return err
+}
+
+// Push pushes branch to remote
+func Push(dir, remote, branch string, args ...string) error {
+	args = append(append([]string{"push"}, args...), remote, branch)
+	_, err := run(context.Background(), dir, args...)
+	return err
+}
+
+// GetCurrentBranch returns the branch checked out in dir, it fails when the
+// HEAD is detached
+func GetCurrentBranch(dir string) (string, error) {
+	out, err := run(context.Background(), dir, "symbolic-ref", "--short", "-q", "HEAD")
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	return GetRepo(dir)
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Checkout checks out an existing branch
+func Checkout(dir, branch string) error {
+	_, err := run(context.Background(), dir, "checkout", branch)
+	return err
+}
+
+// CreateBranch creates a new branch from base and checks it out
+func CreateBranch(dir, name, base string) error {
+	_, err := run(context.Background(), dir, "checkout", "-b", name, base)
+	return err
+}
+
+// DeleteBranch force deletes a local branch
+func DeleteBranch(dir, branch string) error {
+	_, err := run(context.Background(), dir, "branch", "-D", branch)
+	return err
 }
 
 func GetRepoInPwd() (*gitm.Repository, error) {
 	return gitm.Open(".")
 }
 
+// GetRepoShortName returns the owner/name of the repo at url, when it's on
+// github.com or a GitHub Enterprise host gh is logged in to, and url as is
+// otherwise
 func GetRepoShortName(url string) string {
-	r, _ := strings.CutPrefix(url, "https://github.com/")
-	r, _ = strings.CutSuffix(r, ".git")
-	return r
+	if !isGitHubHost(ParseRemoteHost(url)) {
+		return url
+	}
+	owner, name, err := ParseGitHubRepoFromUrl(url)
+	if err != nil {
+		return url
+	}
+	return owner + "/" + name
 }
 
-// GetUpstreamUrl returns the URL of the "upstream" remote if it exists.
-// This is typically the parent repository when working in a fork.
-func GetUpstreamUrl(dir string) (string, error) {
-	repo, err := gitm.Open(dir)
-	if err != nil {
-		return "", err
+// isGitHubHost returns whether host is github.com or a host gh is logged in
+// to
+func isGitHubHost(host string) bool {
+	if host == "" {
+		return false
 	}
-	remotes, err := repo.Remotes()
+	host = auth.NormalizeHostname(host)
+	return host == "github.com" || slices.Contains(auth.KnownHosts(), host)
+}
+
+// ParseRemoteHost returns the lowercased host of a remote URL, like
+// git@host:owner/repo.git, ssh://git@host:22/owner/repo.git or
+// https://host/owner/repo, empty when it has none
+func ParseRemoteHost(remoteUrl string) string {
+	remoteUrl = strings.TrimSpace(remoteUrl)
+	if scheme, rest, ok := strings.Cut(remoteUrl, "://"); ok && scheme != "file" {
+		host, _, _ := strings.Cut(rest, "/")
+		if _, afterUser, ok := strings.Cut(host, "@"); ok {
+			host = afterUser
+		}
+		host, _, _ = strings.Cut(host, ":")
+		return strings.ToLower(host)
+	}
+	// scp-like syntax, user@host:path
+	if _, rest, ok := strings.Cut(remoteUrl, "@"); ok {
+		if host, _, ok := strings.Cut(rest, ":"); ok && !strings.Contains(host, "/") {
+			return strings.ToLower(host)
+		}
+	}
+	return ""
+}
+
+// GetRemoteUrls returns the first URL of every configured remote, keyed by
+// remote name.
+func GetRemoteUrls(dir string) (map[string]string, error) {
+	out, err := run(context.Background(), dir, "remote")
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
+	remotes := lines(out)
+	urls := make(map[string]string, len(remotes))
 	for _, remote := range remotes {
-		if remote != "upstream" {
+		url, err := firstRemoteUrl(dir, remote)
+		if err != nil {
+			continue
+		}
+		urls[remote] = url
+	}
+	return urls, nil
+}
+
+// Remote is a git remote whose URL points to a GitHub repository
+type Remote struct {
+	Name string
+	Url  string
+	// Host is the host of the repository, e.g. github.com or a GitHub
+	// Enterprise host
+	Host  string
+	Owner string
+	Repo  string
+}
+
+// NameWithOwner returns the repository of the remote as owner/name
+func (r Remote) NameWithOwner() string {
+	return r.Owner + "/" + r.Repo
+}
+
+// GetRemotes returns every configured remote whose URL is a GitHub
+// repository, origin first, upstream second and the others by name.
+func GetRemotes(dir string) ([]Remote, error) {
+	urls, err := GetRemoteUrls(dir)
+	if err != nil {
+		return nil, err
+	}
+	return parseRemotes(urls), nil
+}
+
+// parseRemotes parses the remote URLs, keyed by remote name, into their
+// repositories and orders them origin first, upstream second and the others
+// by name. The remotes that aren't a repository are skipped.
+func parseRemotes(urls map[string]string) []Remote {
+	remotes := make([]Remote, 0, len(urls))
+	for name, url := range urls {
+		owner, repo, err := ParseGitHubRepoFromUrl(url)
+		if err != nil {
 			continue
 		}
+		remotes = append(remotes, Remote{
+			Name:  name,
+			Url:   url,
+			Host:  ParseRemoteHost(url),
+			Owner: owner,
+			Repo:  repo,
+		})
+	}
 
-		urls, err := gitm.RemoteGetURL(dir, remote)
-		if err != nil || len(urls) == 0 {
-			return "", err
+	rank := func(name string) int {
+		switch name {
+		case "origin":
+			return 0
+		case "upstream":
+			return 1
+		}
+		return 2
+	}
+	slices.SortFunc(remotes, func(a, b Remote) int {
+		if c := cmp.Compare(rank(a.Name), rank(b.Name)); c != 0 {
+			return c
 		}
-		return urls[0], nil
+		return strings.Compare(a.Name, b.Name)
+	})
+	return remotes
+}
+
+// GetUpstreamUrl returns the URL of the "upstream" remote if it exists.
+func GetUpstreamUrl(dir string) (string, error) {
+	return getRemoteUrl(dir, "upstream")
+}
+
+func getRemoteUrl(dir string, remote string) (string, error) {
+	out, err := run(context.Background(), dir, "remote")
+	if err != nil {
+		return "", err
 	}
+	if !slices.Contains(lines(out), remote) {
+		return "", fmt.Errorf("no %s remote found", remote)
+	}
+	return firstRemoteUrl(dir, remote)
+}
 
-	return "", errors.New("no upstream remote found")
+func firstRemoteUrl(dir string, remote string) (string, error) {
+	out, err := run(context.Background(), dir, "remote", "get-url", remote)
+	if err != nil {
+		return "", err
+	}
+	urls := lines(out)
+	if len(urls) == 0 {
+		return "", fmt.Errorf("no url configured for remote %s", remote)
+	}
+	return urls[0], nil
 }
 
 // ParseGitHubRepoFromUrl extracts the owner and repo name from a GitHub URL
@@ -3,6 +3,7 @@ package git
 import (
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestParseGitHubRepoFromUrl(t *testing.T) {
@@ -206,8 +207,19 @@ func TestGetRepoShortName(t *testing.T) {
 			url:  "https://gitlab.com/owner/repo",
 			want: "https://gitlab.com/owner/repo",
 		},
+		{
+			name: "enterprise URL of the host gh uses",
+			url:  "https://github.example.com/owner/repo.git",
+			want: "owner/repo",
+		},
+		{
+			name: "SSH URL",
+			url:  "git@github.com:owner/repo.git",
+			want: "owner/repo",
+		},
 	}
 
+	t.Setenv("GH_HOST", "github.example.com")
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := GetRepoShortName(tt.url)
@@ -218,7 +230,168 @@ func TestGetRepoShortName(t *testing.T) {
 	}
 }
 
+func TestParseRemoteHost(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://github.com/owner/repo.git", want: "github.com"},
+		{url: "https://user@GitHub.Example.com/owner/repo", want: "github.example.com"},
+		{url: "git@github.example.com:owner/repo.git", want: "github.example.com"},
+		{url: "ssh://git@github.example.com:2222/owner/repo.git", want: "github.example.com"},
+		{url: "/srv/git/repo.git", want: ""},
+		{url: "file:///srv/git/repo.git", want: ""},
+		{url: "../repo", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			if got := ParseRemoteHost(tt.url); got != tt.want {
+				t.Errorf("ParseRemoteHost(%q) = %q, want %q", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterRefs(t *testing.T) {
+	now := time.Now()
+	daysAgo := func(days int) *time.Time {
+		t := now.AddDate(0, 0, -days)
+		return &t
+	}
+	refs := []branchRef{
+		{name: "feat/login", updatedAt: daysAgo(1)},
+		{name: "fix/Crash", updatedAt: daysAgo(3)},
+		{name: "main", updatedAt: daysAgo(10)},
+		{name: "feat/old", updatedAt: daysAgo(40)},
+	}
+
+	tests := []struct {
+		name      string
+		headRef   string
+		opts      RepoOptions
+		want      []string
+		wantTotal int
+	}{
+		{
+			name:      "no options keeps everything",
+			headRef:   "main",
+			want:      []string{"feat/login", "fix/Crash", "main", "feat/old"},
+			wantTotal: 4,
+		},
+		{
+			name:      "limit keeps the most recent branches",
+			headRef:   "feat/login",
+			opts:      RepoOptions{Limit: 2},
+			want:      []string{"feat/login", "fix/Crash"},
+			wantTotal: 4,
+		},
+		{
+			name:      "limit always keeps the checked out branch",
+			headRef:   "feat/old",
+			opts:      RepoOptions{Limit: 1},
+			want:      []string{"feat/login", "feat/old"},
+			wantTotal: 4,
+		},
+		{
+			name:      "updated since drops stale branches",
+			headRef:   "main",
+			opts:      RepoOptions{UpdatedSince: now.AddDate(0, 0, -5)},
+			want:      []string{"feat/login", "fix/Crash", "main"},
+			wantTotal: 3,
+		},
+		{
+			name:      "name filter ignores case",
+			headRef:   "main",
+			opts:      RepoOptions{NameFilter: "crash"},
+			want:      []string{"fix/Crash", "main"},
+			wantTotal: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, total := filterRefs(refs, tt.headRef, tt.opts)
+			names := make([]string, len(got))
+			for i, ref := range got {
+				names[i] = ref.name
+			}
+			if strings.Join(names, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("filterRefs() = %v, want %v", names, tt.want)
+			}
+			if total != tt.wantTotal {
+				t.Errorf("filterRefs() total = %d, want %d", total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestParseTrack(t *testing.T) {
+	tests := []struct {
+		name            string
+		remote          string
+		track           string
+		wantHasUpstream bool
+		wantAhead       int
+		wantBehind      int
+	}{
+		{name: "no upstream", remote: "", track: ""},
+		{name: "up to date", remote: "origin", track: "", wantHasUpstream: true},
+		{name: "ahead", remote: "origin", track: "ahead 3", wantHasUpstream: true, wantAhead: 3},
+		{name: "behind", remote: "origin", track: "behind 2", wantHasUpstream: true, wantBehind: 2},
+		{
+			name:            "diverged",
+			remote:          "origin",
+			track:           "ahead 1, behind 12",
+			wantHasUpstream: true,
+			wantAhead:       1,
+			wantBehind:      12,
+		},
+		{name: "upstream gone", remote: "origin", track: "gone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasUpstream, ahead, behind := parseTrack(tt.remote, tt.track)
+			if hasUpstream != tt.wantHasUpstream || ahead != tt.wantAhead || behind != tt.wantBehind {
+				t.Errorf(
+					"parseTrack(%q, %q) = (%v, %d, %d), want (%v, %d, %d)",
+					tt.remote, tt.track, hasUpstream, ahead, behind,
+					tt.wantHasUpstream, tt.wantAhead, tt.wantBehind,
+				)
+			}
+		})
+	}
+}
+
 // contains checks if s contains substr (case-sensitive)
 func contains(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
+
+func TestParseRemotes(t *testing.T) {
+	urls := map[string]string{
+		"teammate": "git@github.com:teammate/gh-dash.git",
+		"upstream": "https://github.com/dlvhdr/gh-dash.git",
+		"origin":   "git@github.com:me/gh-dash.git",
+		"alice":    "https://github.com/alice/gh-dash",
+		"local":    "/srv/git/gh-dash.git",
+	}
+	got := parseRemotes(urls)
+
+	want := []string{"origin", "upstream", "alice", "teammate"}
+	if len(got) != len(want) {
+		t.Fatalf("parseRemotes() returned %d remotes, want %d", len(got), len(want))
+	}
+	for i, remote := range got {
+		if remote.Name != want[i] {
+			t.Errorf("parseRemotes()[%d] = %q, want %q", i, remote.Name, want[i])
+		}
+	}
+	if repo := got[3].NameWithOwner(); repo != "teammate/gh-dash" {
+		t.Errorf("NameWithOwner() = %q, want %q", repo, "teammate/gh-dash")
+	}
+	if host := got[0].Host; host != "github.com" {
+		t.Errorf("Host = %q, want %q", host, "github.com")
+	}
+}
@@ -0,0 +1,147 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// PullRequestHead is the branch a PR was opened from
+type PullRequestHead struct {
+	Number int
+	Branch string
+	// IsFork is set when the branch lives in another repository than the
+	// one the PR was opened against
+	IsFork bool
+	// Owner is the owner of the repository of the branch
+	Owner string
+	// Url is the URL of the repository of the branch, empty when it was
+	// deleted
+	Url string
+}
+
+// CheckoutPullRequest checks out the head of a PR in the repository at dir,
+// like gh pr checkout does, calling onProgress with git's output. The fork of
+// a PR is added as a remote named after its owner unless a remote already
+// points to it. It returns the name of the local branch checked out.
+func CheckoutPullRequest(dir string, head PullRequestHead, onProgress func(line string)) (string, error) {
+	ctx := context.Background()
+	remotes, err := GetRemotes(dir)
+	if err != nil {
+		return "", err
+	}
+	origin := ""
+	for _, r := range remotes {
+		if r.Name == "origin" {
+			origin = r.Url
+		}
+	}
+
+	remote, target := "origin", ""
+	switch {
+	case head.IsFork && head.Url == "":
+		// the fork is gone, its commits are still on the PR's ref
+		if _, err := runWithProgress(ctx, dir, onProgress,
+			"fetch", "--progress", "origin", fmt.Sprintf("refs/pull/%d/head", head.Number)); err != nil {
+			return "", err
+		}
+		remote, target = "", "FETCH_HEAD"
+	case head.IsFork:
+		remote = findRemote(remotes, head.Url)
+		if remote == "" {
+			remote = head.Owner
+			if _, err := run(ctx, dir, "remote", "add", remote, forkRemoteUrl(origin, head.Url)); err != nil {
+				return "", err
+			}
+		}
+		fallthrough
+	default:
+		refspec := fmt.Sprintf("+refs/heads/%s:refs/remotes/%s/%s", head.Branch, remote, head.Branch)
+		if _, err := runWithProgress(ctx, dir, onProgress, "fetch", "--progress", remote, refspec); err != nil {
+			return "", err
+		}
+		target = remote + "/" + head.Branch
+	}
+
+	local := head.Branch
+	exists, upstream := branchUpstream(ctx, dir, local)
+	if head.IsFork && exists && (remote == "" || upstream != target) {
+		// a branch of the same name that isn't the fork's, e.g. main
+		local = localForkBranch(head)
+		exists, _ = branchUpstream(ctx, dir, local)
+	}
+
+	if !exists {
+		args := []string{"checkout", "-b", local, "--no-track", target}
+		if remote != "" {
+			args = []string{"checkout", "-b", local, "--track", target}
+		}
+		_, err = runWithProgress(ctx, dir, onProgress, args...)
+		return local, err
+	}
+
+	if _, err := runWithProgress(ctx, dir, onProgress, "checkout", local); err != nil {
+		return "", err
+	}
+	_, err = runWithProgress(ctx, dir, onProgress, "merge", "--ff-only", target)
+	if err != nil && isNotFastForward(err) {
+		return local, fmt.Errorf("can't fast-forward %s: %w", local, ErrNotFastForward)
+	}
+	return local, err
+}
+
+// branchUpstream returns whether the local branch exists and its upstream,
+// e.g. "origin/main"
+func branchUpstream(ctx context.Context, dir, branch string) (exists bool, upstream string) {
+	out, err := run(ctx, dir, "for-each-ref", "--format=%(refname) %(upstream:short)", "refs/heads/"+branch)
+	if err != nil {
+		return false, ""
+	}
+	for _, line := range lines(out) {
+		ref, upstream, _ := strings.Cut(line, " ")
+		if ref == "refs/heads/"+branch {
+			return true, upstream
+		}
+	}
+	return false, ""
+}
+
+// localForkBranch is the local branch of a fork's PR whose branch name is
+// taken, e.g. "octocat/main"
+func localForkBranch(head PullRequestHead) string {
+	if head.Owner == "" {
+		return fmt.Sprintf("pr-%d", head.Number)
+	}
+	return head.Owner + "/" + head.Branch
+}
+
+// findRemote returns the name of the remote pointing to the repository at
+// url, empty when there's none
+func findRemote(remotes []Remote, url string) string {
+	owner, repo, err := ParseGitHubRepoFromUrl(url)
+	if err != nil {
+		return ""
+	}
+	for _, r := range remotes {
+		if strings.EqualFold(r.Owner, owner) && strings.EqualFold(r.Repo, repo) {
+			return r.Name
+		}
+	}
+	return ""
+}
+
+// forkRemoteUrl is the URL a fork at forkUrl is added as a remote with, it
+// uses the protocol of the origin URL, e.g. SSH when origin is cloned with it
+func forkRemoteUrl(originUrl, forkUrl string) string {
+	forkUrl = strings.TrimSuffix(forkUrl, "/")
+	host, ok := strings.CutPrefix(originUrl, "git@")
+	if !ok {
+		return strings.TrimSuffix(forkUrl, ".git") + ".git"
+	}
+	host, _, _ = strings.Cut(host, ":")
+	owner, repo, err := ParseGitHubRepoFromUrl(forkUrl)
+	if err != nil {
+		return forkUrl
+	}
+	return fmt.Sprintf("git@%s:%s/%s.git", host, owner, repo)
+}
@@ -0,0 +1,77 @@
+package git
+
+import "testing"
+
+func TestForkRemoteUrl(t *testing.T) {
+	tests := []struct {
+		name      string
+		originUrl string
+		forkUrl   string
+		want      string
+	}{
+		{
+			name:      "https origin",
+			originUrl: "https://github.com/dlvhdr/gh-dash.git",
+			forkUrl:   "https://github.com/octocat/gh-dash",
+			want:      "https://github.com/octocat/gh-dash.git",
+		},
+		{
+			name:      "ssh origin",
+			originUrl: "git@github.com:dlvhdr/gh-dash.git",
+			forkUrl:   "https://github.com/octocat/gh-dash",
+			want:      "git@github.com:octocat/gh-dash.git",
+		},
+		{
+			name:      "ssh origin on an enterprise host",
+			originUrl: "git@github.example.com:team/tool.git",
+			forkUrl:   "https://github.example.com/octocat/tool/",
+			want:      "git@github.example.com:octocat/tool.git",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := forkRemoteUrl(tt.originUrl, tt.forkUrl); got != tt.want {
+				t.Errorf("forkRemoteUrl() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindRemote(t *testing.T) {
+	remotes := []Remote{
+		{Name: "origin", Url: "git@github.com:dlvhdr/gh-dash.git", Owner: "dlvhdr", Repo: "gh-dash"},
+		{Name: "fork", Url: "https://github.com/Octocat/gh-dash.git", Owner: "Octocat", Repo: "gh-dash"},
+	}
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://github.com/dlvhdr/gh-dash", want: "origin"},
+		{url: "https://github.com/octocat/gh-dash", want: "fork"},
+		{url: "https://github.com/someone/gh-dash", want: ""},
+		{url: "not a url", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := findRemote(remotes, tt.url); got != tt.want {
+			t.Errorf("findRemote(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestLocalForkBranch(t *testing.T) {
+	tests := []struct {
+		head PullRequestHead
+		want string
+	}{
+		{head: PullRequestHead{Number: 12, Branch: "main", IsFork: true, Owner: "octocat"}, want: "octocat/main"},
+		{head: PullRequestHead{Number: 12, Branch: "main", IsFork: true}, want: "pr-12"},
+	}
+
+	for _, tt := range tests {
+		if got := localForkBranch(tt.head); got != tt.want {
+			t.Errorf("localForkBranch(%+v) = %q, want %q", tt.head, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotFastForward is returned when a branch has commits the branch it
+// should be fast-forwarded to doesn't have
+var ErrNotFastForward = errors.New("the branch has diverged, rebase it instead")
+
+// ConflictError is returned when a rebase stops on conflicting changes. The
+// rebase is aborted, so the branch is left as it was.
+type ConflictError struct {
+	Branch string
+	Onto   string
+	Files  []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf(
+		"rebasing %s onto %s conflicts in %s, the rebase was aborted",
+		e.Branch,
+		e.Onto,
+		strings.Join(e.Files, ", "),
+	)
+}
+
+// Rebase rebases branch onto onto, e.g. origin/main, calling onProgress with
+// git's output. The branch is checked out for the rebase, whatever was
+// checked out before is restored afterwards.
+func Rebase(dir, branch, onto string, onProgress func(line string)) error {
+	ctx := context.Background()
+	head, err := currentHead(ctx, dir)
+	if err != nil {
+		return err
+	}
+
+	_, rebaseErr := runWithProgress(ctx, dir, onProgress, "rebase", onto, branch)
+	if rebaseErr != nil {
+		rebaseErr = abortRebase(ctx, dir, branch, onto, rebaseErr)
+	}
+
+	if head != branch {
+		if _, err := run(ctx, dir, "checkout", head); err != nil {
+			return errors.Join(rebaseErr, err)
+		}
+	}
+	return rebaseErr
+}
+
+// abortRebase aborts a rebase that stopped with err, returning a
+// ConflictError if it stopped on conflicts
+func abortRebase(ctx context.Context, dir, branch, onto string, err error) error {
+	if !isRebaseInProgress(ctx, dir) {
+		// it failed before starting, e.g. because of uncommitted changes
+		return err
+	}
+
+	out, _ := run(ctx, dir, "diff", "--name-only", "--diff-filter=U")
+	files := lines(out)
+
+	if _, abortErr := run(ctx, dir, "rebase", "--abort"); abortErr != nil {
+		return fmt.Errorf("rebasing %s onto %s stopped and couldn't be aborted, run git rebase --abort: %w", branch, onto, abortErr)
+	}
+
+	if len(files) == 0 {
+		return err
+	}
+	return &ConflictError{Branch: branch, Onto: onto, Files: files}
+}
+
+func isRebaseInProgress(ctx context.Context, dir string) bool {
+	for _, name := range []string{"rebase-merge", "rebase-apply"} {
+		out, err := run(ctx, dir, "rev-parse", "--git-path", name)
+		if err != nil {
+			continue
+		}
+		p := strings.TrimSpace(string(out))
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(dir, p)
+		}
+		if _, err := os.Stat(p); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// currentHead returns the checked out branch, or the checked out commit when
+// the HEAD is detached
+func currentHead(ctx context.Context, dir string) (string, error) {
+	if out, err := run(ctx, dir, "symbolic-ref", "--short", "-q", "HEAD"); err == nil {
+		return strings.TrimSpace(string(out)), nil
+	}
+	out, err := run(ctx, dir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// FastForward moves branch to the tip of the branch of the same name on
+// remote, calling onProgress with git's output. It fails with
+// ErrNotFastForward when branch has diverged from it.
+func FastForward(dir, remote, branch string, isCheckedOut bool, onProgress func(line string)) error {
+	ctx := context.Background()
+	var err error
+	if isCheckedOut {
+		_, err = runWithProgress(ctx, dir, onProgress, "pull", "--ff-only", "--no-edit", "--progress", remote, branch)
+	} else {
+		_, err = runWithProgress(ctx, dir, onProgress, "fetch", "--progress", "--no-write-fetch-head", remote, branch+":"+branch)
+	}
+	if err != nil && isNotFastForward(err) {
+		return fmt.Errorf("can't fast-forward %s: %w", branch, ErrNotFastForward)
+	}
+	return err
+}
+
+func isNotFastForward(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "non-fast-forward") ||
+		strings.Contains(msg, "Not possible to fast-forward") ||
+		strings.Contains(msg, "Diverging branches can't be fast-forwarded")
+}
+
+// ResetToUpstream fetches the remote of upstream, e.g. origin/main, and
+// points branch at it, calling onProgress with git's output. Commits only
+// branch has are dropped, as are uncommitted changes when it's checked out.
+func ResetToUpstream(dir, branch, upstream string, isCheckedOut bool, onProgress func(line string)) error {
+	remote, _, ok := strings.Cut(upstream, "/")
+	if !ok {
+		return fmt.Errorf("%s has no upstream to reset to", branch)
+	}
+
+	ctx := context.Background()
+	if _, err := runWithProgress(ctx, dir, onProgress, "fetch", "--progress", remote); err != nil {
+		return err
+	}
+
+	var err error
+	if isCheckedOut {
+		_, err = runWithProgress(ctx, dir, onProgress, "reset", "--hard", upstream)
+	} else {
+		_, err = runWithProgress(ctx, dir, onProgress, "branch", "--force", branch, upstream)
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package git
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestIsNotFastForward(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "fetch into a diverged branch",
+			err:  errors.New("git fetch: ! [rejected]  main -> main  (non-fast-forward)"),
+			want: true,
+		},
+		{
+			name: "pull with diverged branches",
+			err:  errors.New("git pull: fatal: Not possible to fast-forward, aborting."),
+			want: true,
+		},
+		{
+			name: "other failures",
+			err:  errors.New("git fetch: fatal: couldn't find remote ref main"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNotFastForward(tt.err); got != tt.want {
+				t.Errorf("isNotFastForward() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		writes []string
+		want   []string
+	}{
+		{
+			name:   "complete lines",
+			writes: []string{"one\ntwo\n"},
+			want:   []string{"one", "two"},
+		},
+		{
+			name:   "lines split across writes",
+			writes: []string{"on", "e\ntw", "o\n"},
+			want:   []string{"one", "two"},
+		},
+		{
+			name:   "progress redrawn with carriage returns",
+			writes: []string{"Receiving objects:  50% (1/2)\rReceiving objects: 100% (2/2)\n"},
+			want:   []string{"Receiving objects:  50% (1/2)", "Receiving objects: 100% (2/2)"},
+		},
+		{
+			name:   "incomplete line is held back",
+			writes: []string{"one\ntw"},
+			want:   []string{"one"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			w := &lineWriter{onLine: func(line string) { got = append(got, line) }}
+			for _, s := range tt.writes {
+				if _, err := w.Write([]byte(s)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("lines = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package git
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Stash struct {
+	// Ref is the stash's reflog selector, e.g. "stash@{0}"
+	Ref       string
+	Index     int
+	Branch    string
+	Message   string
+	CreatedAt time.Time
+}
+
+// stashFormat is the stash list format parsed by parseStashes, fields are NUL
+// separated and the subject comes last as it's free text
+const stashFormat = "--format=%gd%x00%ct%x00%gs"
+
+// GetStashes lists the stashes of the repository at dir, the most recent first
+func GetStashes(dir string) ([]Stash, error) {
+	out, err := run(context.Background(), dir, "stash", "list", stashFormat)
+	if err != nil {
+		return nil, err
+	}
+	return parseStashes(out), nil
+}
+
+// parseStashes parses the output of stash list with stashFormat
+func parseStashes(out []byte) []Stash {
+	var stashes []Stash
+	for _, line := range lines(out) {
+		fields := strings.SplitN(line, "\x00", 3)
+		if len(fields) < 3 {
+			continue
+		}
+		stash := Stash{Ref: fields[0]}
+		index := strings.TrimSuffix(strings.TrimPrefix(fields[0], "stash@{"), "}")
+		stash.Index, _ = strconv.Atoi(index)
+		if secs, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+			stash.CreatedAt = time.Unix(secs, 0)
+		}
+		stash.Branch, stash.Message = parseStashSubject(fields[2])
+		stashes = append(stashes, stash)
+	}
+	return stashes
+}
+
+// parseStashSubject splits a stash subject, e.g. "WIP on main: 1a2b3c4 msg"
+// or "On main: msg", into the branch it was made on and its message
+func parseStashSubject(subject string) (branch string, message string) {
+	rest, ok := strings.CutPrefix(subject, "WIP on ")
+	if !ok {
+		rest, ok = strings.CutPrefix(subject, "On ")
+	}
+	if !ok {
+		return "", subject
+	}
+	branch, message, ok = strings.Cut(rest, ": ")
+	if !ok {
+		return "", subject
+	}
+	return branch, message
+}
+
+// StashDiff returns the patch of the changes stashed in ref
+func StashDiff(dir, ref string) (string, error) {
+	out, err := run(context.Background(), dir, "stash", "show", "-p", "--include-untracked", ref)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// StashPush stashes the uncommitted changes, untracked files included
+func StashPush(dir, message string) error {
+	args := []string{"stash", "push", "--include-untracked"}
+	if message != "" {
+		args = append(args, "-m", message)
+	}
+	_, err := run(context.Background(), dir, args...)
+	return err
+}
+
+// StashPop applies the changes stashed in ref and drops it
+func StashPop(dir, ref string) error {
+	_, err := run(context.Background(), dir, "stash", "pop", ref)
+	return err
+}
+
+// StashApply applies the changes stashed in ref, keeping the stash
+func StashApply(dir, ref string) error {
+	_, err := run(context.Background(), dir, "stash", "apply", ref)
+	return err
+}
+
+// StashDrop deletes the stash ref
+func StashDrop(dir, ref string) error {
+	_, err := run(context.Background(), dir, "stash", "drop", ref)
+	return err
+}
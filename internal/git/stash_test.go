@@ -0,0 +1,65 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseStashes(t *testing.T) {
+	tests := []struct {
+		name string
+		out  string
+		want []Stash
+	}{
+		{name: "no stashes", out: ""},
+		{
+			name: "stash without message",
+			out:  "stash@{0}\x001700000000\x00WIP on main: 1a2b3c4 Fix the footer\n",
+			want: []Stash{{
+				Ref:       "stash@{0}",
+				Branch:    "main",
+				Message:   "1a2b3c4 Fix the footer",
+				CreatedAt: time.Unix(1700000000, 0),
+			}},
+		},
+		{
+			name: "stash with message",
+			out: "stash@{0}\x001700000100\x00On feat/x: try: another way\n" +
+				"stash@{1}\x001700000000\x00On main: wip\n",
+			want: []Stash{
+				{
+					Ref:       "stash@{0}",
+					Branch:    "feat/x",
+					Message:   "try: another way",
+					CreatedAt: time.Unix(1700000100, 0),
+				},
+				{
+					Ref:       "stash@{1}",
+					Index:     1,
+					Branch:    "main",
+					Message:   "wip",
+					CreatedAt: time.Unix(1700000000, 0),
+				},
+			},
+		},
+		{
+			name: "unknown subject",
+			out:  "stash@{2}\x001700000000\x00autostash\n",
+			want: []Stash{{
+				Ref:       "stash@{2}",
+				Index:     2,
+				Message:   "autostash",
+				CreatedAt: time.Unix(1700000000, 0),
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseStashes([]byte(tt.out)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseStashes() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
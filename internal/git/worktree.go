@@ -0,0 +1,104 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+type Worktree struct {
+	Path string
+	// Branch is the short name of the branch checked out, empty when the
+	// HEAD is detached
+	Branch string
+	Head   string
+	// IsMain is set for the main worktree, the one listed first
+	IsMain bool
+	IsBare bool
+}
+
+// GetWorktrees lists the worktrees of the repository at dir, the main one
+// first
+func GetWorktrees(dir string) ([]Worktree, error) {
+	return getWorktrees(context.Background(), dir)
+}
+
+func getWorktrees(ctx context.Context, dir string) ([]Worktree, error) {
+	out, err := run(ctx, dir, "worktree", "list", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+	return parseWorktrees(out), nil
+}
+
+// parseWorktrees parses the output of worktree list --porcelain, where each
+// worktree is a block of attribute lines separated by an empty line
+func parseWorktrees(out []byte) []Worktree {
+	var worktrees []Worktree
+	var curr *Worktree
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if line == "" {
+			curr = nil
+			continue
+		}
+		key, value, _ := strings.Cut(line, " ")
+		if key == "worktree" {
+			worktrees = append(worktrees, Worktree{Path: value, IsMain: len(worktrees) == 0})
+			curr = &worktrees[len(worktrees)-1]
+			continue
+		}
+		if curr == nil {
+			continue
+		}
+		switch key {
+		case "HEAD":
+			curr.Head = value
+		case "branch":
+			curr.Branch = strings.TrimPrefix(value, "refs/heads/")
+		case "bare":
+			curr.IsBare = true
+		}
+	}
+	return worktrees
+}
+
+// AddWorktree creates a worktree at path with branch checked out, creating
+// the branch from startPoint when it's set
+func AddWorktree(dir, path, branch, startPoint string) error {
+	args := []string{"worktree", "add"}
+	if startPoint != "" {
+		args = append(args, "-b", branch, path, startPoint)
+	} else {
+		args = append(args, path, branch)
+	}
+	_, err := run(context.Background(), dir, args...)
+	return err
+}
+
+// WorktreePath is the path of the worktree of branch, named after the
+// repository at dir and the branch, e.g. "gh-dash-fix-typo". It's put in
+// parent, or next to the repository when parent is empty.
+func WorktreePath(dir, parent, branch string) string {
+	dir = filepath.Clean(dir)
+	if parent == "" {
+		parent = filepath.Dir(dir)
+	}
+	name := filepath.Base(dir) + "-" + strings.NewReplacer("/", "-", "\\", "-").Replace(branch)
+	return filepath.Join(parent, name)
+}
+
+// AddPullRequestWorktree creates a worktree at path for the PR with number,
+// checking out branch. The branch is created from the PR's head, fetched from
+// origin, unless it already exists.
+func AddPullRequestWorktree(dir, path, branch string, number int) error {
+	ctx := context.Background()
+	if _, err := run(ctx, dir, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch); err == nil {
+		return AddWorktree(dir, path, branch, "")
+	}
+	if _, err := run(ctx, dir, "fetch", "origin", fmt.Sprintf("refs/pull/%d/head", number)); err != nil {
+		return err
+	}
+	return AddWorktree(dir, path, branch, "FETCH_HEAD")
+}
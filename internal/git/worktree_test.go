@@ -0,0 +1,65 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseWorktrees(t *testing.T) {
+	tests := []struct {
+		name string
+		out  string
+		want []Worktree
+	}{
+		{name: "no worktrees", out: ""},
+		{
+			name: "main worktree only",
+			out:  "worktree /src/gh-dash\nHEAD 1a2b3c4\nbranch refs/heads/main\n\n",
+			want: []Worktree{{Path: "/src/gh-dash", Branch: "main", Head: "1a2b3c4", IsMain: true}},
+		},
+		{
+			name: "linked and detached worktrees",
+			out: "worktree /src/gh-dash\nHEAD 1a2b3c4\nbranch refs/heads/main\n\n" +
+				"worktree /src/gh-dash-feat/x\nHEAD 5d6e7f8\nbranch refs/heads/feat/x\nlocked\n\n" +
+				"worktree /tmp/review\nHEAD 9a8b7c6\ndetached\n\n",
+			want: []Worktree{
+				{Path: "/src/gh-dash", Branch: "main", Head: "1a2b3c4", IsMain: true},
+				{Path: "/src/gh-dash-feat/x", Branch: "feat/x", Head: "5d6e7f8"},
+				{Path: "/tmp/review", Head: "9a8b7c6"},
+			},
+		},
+		{
+			name: "bare repository",
+			out:  "worktree /src/gh-dash.git\nbare\n\nworktree /src/main\nHEAD 1a2b3c4\nbranch refs/heads/main\n",
+			want: []Worktree{
+				{Path: "/src/gh-dash.git", IsMain: true, IsBare: true},
+				{Path: "/src/main", Branch: "main", Head: "1a2b3c4"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseWorktrees([]byte(tt.out))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseWorktrees() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorktreePath(t *testing.T) {
+	tests := []struct {
+		dir, parent, branch, want string
+	}{
+		{dir: "/src/gh-dash", branch: "main", want: "/src/gh-dash-main"},
+		{dir: "/src/gh-dash/", branch: "feat/x", want: "/src/gh-dash-feat-x"},
+		{dir: "/src/gh-dash", parent: "/wt", branch: "feat/x", want: "/wt/gh-dash-feat-x"},
+	}
+
+	for _, tt := range tests {
+		if got := WorktreePath(tt.dir, tt.parent, tt.branch); got != tt.want {
+			t.Errorf("WorktreePath(%q, %q, %q) = %q, want %q", tt.dir, tt.parent, tt.branch, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Package ical writes all-day events in the iCalendar format (RFC 5545), so
+// calendar apps can import or subscribe to them.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+	// maxLineLength is the length in bytes past which lines are folded
+	maxLineLength = 75
+)
+
+// Event is an all-day event
+type Event struct {
+	// UID identifies the event across exports, so calendars update it in
+	// place instead of adding a copy
+	UID         string
+	Summary     string
+	Description string
+	Url         string
+	// Day is the day of the event, in UTC
+	Day      time.Time
+	Modified time.Time
+}
+
+// Calendar is a named list of events
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Write writes cal to w, stamped with now
+func Write(w io.Writer, cal Calendar, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		bw.WriteString(fold(name + ":" + value))
+		bw.WriteString("\r\n")
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//dlvhdr//gh-dash//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		line("X-WR-CALNAME", escape(cal.Name))
+	}
+	for _, e := range cal.Events {
+		day := e.Day.UTC()
+		line("BEGIN", "VEVENT")
+		line("UID", e.UID)
+		line("DTSTAMP", now.UTC().Format(dateTimeFormat))
+		line("DTSTART;VALUE=DATE", day.Format(dateFormat))
+		line("DTEND;VALUE=DATE", day.AddDate(0, 0, 1).Format(dateFormat))
+		line("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION", escape(e.Description))
+		}
+		if e.Url != "" {
+			line("URL", e.Url)
+		}
+		if !e.Modified.IsZero() {
+			line("LAST-MODIFIED", e.Modified.UTC().Format(dateTimeFormat))
+		}
+		line("TRANSP", "TRANSPARENT")
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+
+	return bw.Flush()
+}
+
+// escape escapes a text value
+func escape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
+
+// fold splits a content line longer than maxLineLength bytes into lines
+// continued with a leading space, without splitting a UTF-8 sequence
+func fold(line string) string {
+	if len(line) <= maxLineLength {
+		return line
+	}
+
+	var b strings.Builder
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// the continuation lines start with a space
+		limit = maxLineLength - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
@@ -0,0 +1,92 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWrite(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	cal := Calendar{
+		Name: "gh-dash",
+		Events: []Event{
+			{
+				UID:         "milestone-1@github.com",
+				Summary:     "owner/repo: v1.0, the big one",
+				Description: "Ships the thing;\nfinally",
+				Url:         "https://github.com/owner/repo/milestone/1",
+				Day:         time.Date(2024, 5, 31, 7, 0, 0, 0, time.UTC),
+				Modified:    time.Date(2024, 4, 1, 10, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	var b strings.Builder
+	if err := Write(&b, cal, now); err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//dlvhdr//gh-dash//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:gh-dash",
+		"BEGIN:VEVENT",
+		"UID:milestone-1@github.com",
+		"DTSTAMP:20240501T123000Z",
+		"DTSTART;VALUE=DATE:20240531",
+		"DTEND;VALUE=DATE:20240601",
+		`SUMMARY:owner/repo: v1.0\, the big one`,
+		`DESCRIPTION:Ships the thing\;\nfinally`,
+		"URL:https://github.com/owner/repo/milestone/1",
+		"LAST-MODIFIED:20240401T100000Z",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	if got := b.String(); got != want {
+		t.Errorf("Write() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "short line",
+			line: "SUMMARY:v1.0",
+			want: "SUMMARY:v1.0",
+		},
+		{
+			name: "long line",
+			line: "DESCRIPTION:" + strings.Repeat("a", 70),
+			want: "DESCRIPTION:" + strings.Repeat("a", 63) + "\r\n " + strings.Repeat("a", 7),
+		},
+		{
+			name: "doesn't split a multi-byte character",
+			line: "SUMMARY:" + strings.Repeat("a", 66) + "é",
+			want: "SUMMARY:" + strings.Repeat("a", 66) + "\r\n é",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fold(tt.line)
+			if got != tt.want {
+				t.Errorf("fold() = %q, want %q", got, tt.want)
+			}
+			for _, l := range strings.Split(got, "\r\n") {
+				if len(l) > maxLineLength {
+					t.Errorf("folded line %q is %d bytes, want at most %d", l, len(l), maxLineLength)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Package metrics exposes the counts of the dashboard's sections in the
+// Prometheus text format, so review queues can be alerted on.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// Section is a PR or issues section to export the stats of
+type Section struct {
+	View    config.ViewType
+	Title   string
+	Filters string
+}
+
+// SectionsFromConfig returns the PR and issues sections of cfg
+func SectionsFromConfig(cfg config.Config) []Section {
+	sections := make([]Section, 0, len(cfg.PRSections)+len(cfg.IssuesSections))
+	for _, s := range cfg.PRSections {
+		sections = append(sections, Section{View: config.PRsView, Title: s.Title, Filters: s.Filters})
+	}
+	for _, s := range cfg.IssuesSections {
+		sections = append(sections, Section{View: config.IssuesView, Title: s.Title, Filters: s.Filters})
+	}
+	return sections
+}
+
+type sectionSample struct {
+	section Section
+	stats   data.SearchStats
+	err     error
+}
+
+type snapshot struct {
+	at        time.Time
+	sections  []sectionSample
+	rateLimit *data.RateLimit
+}
+
+// Exporter refreshes the stats of the sections at an interval and serves the
+// latest ones
+type Exporter struct {
+	sections []Section
+	interval time.Duration
+	// fetchStats and fetchRateLimit are swapped in tests
+	fetchStats     func(query string, isPR bool) (data.SearchStats, error)
+	fetchRateLimit func() (data.RateLimit, error)
+
+	mu     sync.RWMutex
+	latest snapshot
+}
+
+func NewExporter(sections []Section, interval time.Duration) *Exporter {
+	return &Exporter{
+		sections:       sections,
+		interval:       interval,
+		fetchStats:     data.FetchSearchStats,
+		fetchRateLimit: data.FetchRateLimit,
+	}
+}
+
+// Run refreshes the stats until done is closed
+func (e *Exporter) Run(done <-chan struct{}) {
+	e.Refresh()
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			e.Refresh()
+		}
+	}
+}
+
+// Refresh fetches the stats of every section at once, so they're batched
+// into a single request
+func (e *Exporter) Refresh() {
+	samples := make([]sectionSample, len(e.sections))
+	var wg sync.WaitGroup
+	for i, s := range e.sections {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			query := utils.ExpandFiltersTemplate(s.Filters, s.Filters, utils.SearchVars{})
+			stats, err := e.fetchStats(query, s.View == config.PRsView)
+			if err != nil {
+				log.Error("Failed fetching section stats", "section", s.Title, "err", err)
+			}
+			samples[i] = sectionSample{section: s, stats: stats, err: err}
+		}()
+	}
+
+	var rateLimit *data.RateLimit
+	if rl, err := e.fetchRateLimit(); err != nil {
+		log.Error("Failed fetching rate limit", "err", err)
+	} else {
+		rateLimit = &rl
+	}
+	wg.Wait()
+
+	e.mu.Lock()
+	e.latest = snapshot{at: time.Now(), sections: samples, rateLimit: rateLimit}
+	e.mu.Unlock()
+}
+
+// ServeHTTP writes the latest stats in the Prometheus text format
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	e.mu.RLock()
+	latest := e.latest
+	e.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := write(w, latest, time.Now()); err != nil {
+		log.Error("Failed writing metrics", "err", err)
+	}
+}
+
+func write(w io.Writer, s snapshot, now time.Time) error {
+	var b strings.Builder
+
+	gauge := func(name, help string) {
+		fmt.Fprintf(&b, "# HELP %[1]s %[2]s\n# TYPE %[1]s gauge\n", name, help)
+	}
+
+	gauge("gh_dash_section_items", "Number of PRs or issues matching the section's filters.")
+	for _, sample := range s.sections {
+		if sample.err != nil {
+			continue
+		}
+		fmt.Fprintf(&b, "gh_dash_section_items{%s} %d\n", labels(sample.section), sample.stats.Count)
+	}
+
+	gauge("gh_dash_section_oldest_item_age_seconds", "Age of the oldest PR or issue matching the section's filters.")
+	for _, sample := range s.sections {
+		if sample.err != nil || sample.stats.OldestCreatedAt.IsZero() {
+			continue
+		}
+		age := now.Sub(sample.stats.OldestCreatedAt).Seconds()
+		fmt.Fprintf(&b, "gh_dash_section_oldest_item_age_seconds{%s} %.0f\n", labels(sample.section), age)
+	}
+
+	gauge("gh_dash_section_up", "Whether the section's stats were fetched on the last refresh.")
+	for _, sample := range s.sections {
+		up := 1
+		if sample.err != nil {
+			up = 0
+		}
+		fmt.Fprintf(&b, "gh_dash_section_up{%s} %d\n", labels(sample.section), up)
+	}
+
+	if s.rateLimit != nil {
+		gauge("gh_dash_rate_limit_remaining", "GraphQL API points left until the rate limit resets.")
+		fmt.Fprintf(&b, "gh_dash_rate_limit_remaining %d\n", s.rateLimit.Remaining)
+		gauge("gh_dash_rate_limit_limit", "GraphQL API points allowed per hour.")
+		fmt.Fprintf(&b, "gh_dash_rate_limit_limit %d\n", s.rateLimit.Limit)
+		gauge("gh_dash_rate_limit_reset_timestamp_seconds", "When the rate limit resets.")
+		fmt.Fprintf(&b, "gh_dash_rate_limit_reset_timestamp_seconds %d\n", s.rateLimit.ResetAt.Unix())
+	}
+
+	if !s.at.IsZero() {
+		gauge("gh_dash_last_refresh_timestamp_seconds", "When the stats were last refreshed.")
+		fmt.Fprintf(&b, "gh_dash_last_refresh_timestamp_seconds %d\n", s.at.Unix())
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func labels(s Section) string {
+	return fmt.Sprintf(`view="%s",section="%s"`, escape(string(s.View)), escape(s.Title))
+}
+
+// escape escapes a label value as the text format expects
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
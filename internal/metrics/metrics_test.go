@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestWrite(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	s := snapshot{
+		at: now.Add(-time.Minute),
+		sections: []sectionSample{
+			{
+				section: Section{View: config.PRsView, Title: "Needs \"my\" review"},
+				stats:   data.SearchStats{Count: 3, OldestCreatedAt: now.Add(-2 * time.Hour)},
+			},
+			{
+				section: Section{View: config.IssuesView, Title: "Empty"},
+				stats:   data.SearchStats{},
+			},
+			{
+				section: Section{View: config.IssuesView, Title: "Broken"},
+				err:     errors.New("bad query"),
+			},
+		},
+		rateLimit: &data.RateLimit{Limit: 5000, Remaining: 4990, ResetAt: now.Add(time.Hour)},
+	}
+
+	var b strings.Builder
+	if err := write(&b, s, now); err != nil {
+		t.Fatal(err)
+	}
+	got := b.String()
+
+	want := []string{
+		`gh_dash_section_items{view="prs",section="Needs \"my\" review"} 3`,
+		`gh_dash_section_items{view="issues",section="Empty"} 0`,
+		`gh_dash_section_oldest_item_age_seconds{view="prs",section="Needs \"my\" review"} 7200`,
+		`gh_dash_section_up{view="prs",section="Needs \"my\" review"} 1`,
+		`gh_dash_section_up{view="issues",section="Broken"} 0`,
+		`gh_dash_rate_limit_remaining 4990`,
+		`gh_dash_rate_limit_limit 5000`,
+		`gh_dash_rate_limit_reset_timestamp_seconds 1714568400`,
+		`gh_dash_last_refresh_timestamp_seconds 1714564740`,
+	}
+	for _, line := range want {
+		if !strings.Contains(got, line+"\n") {
+			t.Errorf("missing %q in:\n%s", line, got)
+		}
+	}
+
+	unwanted := []string{
+		`gh_dash_section_items{view="issues",section="Broken"}`,
+		`gh_dash_section_oldest_item_age_seconds{view="issues",section="Empty"}`,
+	}
+	for _, line := range unwanted {
+		if strings.Contains(got, line) {
+			t.Errorf("unexpected %q in:\n%s", line, got)
+		}
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	e := NewExporter([]Section{
+		{View: config.PRsView, Title: "Mine", Filters: "author:@me"},
+		{View: config.IssuesView, Title: "Bugs", Filters: "label:bug"},
+	}, time.Minute)
+	e.fetchStats = func(query string, isPR bool) (data.SearchStats, error) {
+		if isPR {
+			return data.SearchStats{Count: 2}, nil
+		}
+		return data.SearchStats{}, errors.New("bad query")
+	}
+	e.fetchRateLimit = func() (data.RateLimit, error) {
+		return data.RateLimit{}, errors.New("offline")
+	}
+
+	e.Refresh()
+
+	if len(e.latest.sections) != 2 {
+		t.Fatalf("got %d sections, want 2", len(e.latest.sections))
+	}
+	if got := e.latest.sections[0]; got.err != nil || got.stats.Count != 2 {
+		t.Errorf("PR section = %+v, want 2 items", got)
+	}
+	if got := e.latest.sections[1]; got.err == nil {
+		t.Errorf("issues section = %+v, want an error", got)
+	}
+	if e.latest.rateLimit != nil {
+		t.Errorf("rateLimit = %+v, want nil", e.latest.rateLimit)
+	}
+}
@@ -0,0 +1,176 @@
+package state
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const archiveFile = "archive.json"
+
+// ArchivedItem is a PR or an issue that left a section once it was merged
+// or closed
+type ArchivedItem struct {
+	Url    string `json:"url"`
+	Repo   string `json:"repo"`
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	Author string `json:"author,omitempty"`
+	IsPR   bool   `json:"isPr"`
+	// State is the state the item was found in once it left, e.g. MERGED
+	State string `json:"state,omitempty"`
+	// Section is the title of the section the item left
+	Section    string    `json:"section"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	ArchivedAt time.Time `json:"archivedAt"`
+}
+
+func (item ArchivedItem) GetRepoNameWithOwner() string {
+	return item.Repo
+}
+
+func (item ArchivedItem) GetTitle() string {
+	return item.Title
+}
+
+func (item ArchivedItem) GetNumber() int {
+	return item.Number
+}
+
+func (item ArchivedItem) GetUrl() string {
+	return item.Url
+}
+
+func (item ArchivedItem) GetUpdatedAt() time.Time {
+	return item.UpdatedAt
+}
+
+// sectionRows are the items a section listed when it was last fetched with
+// filters
+type sectionRows struct {
+	Filters string         `json:"filters"`
+	Items   []ArchivedItem `json:"items"`
+}
+
+// Archive holds the PRs and issues that left the sections of the dashboard
+// once merged or closed, keyed by URL, and the items each section listed
+// last to find the ones that leave it. It's shared by all sections and saved
+// in the background, so access goes through its methods.
+type Archive struct {
+	mu       sync.Mutex
+	dir      string
+	items    map[string]ArchivedItem
+	sections map[string]sectionRows
+}
+
+type archiveFileData struct {
+	Items    map[string]ArchivedItem `json:"items"`
+	Sections map[string]sectionRows  `json:"sections"`
+}
+
+// NewArchive returns an empty archive saved to dir
+func NewArchive(dir string) *Archive {
+	return &Archive{dir: dir, items: map[string]ArchivedItem{}, sections: map[string]sectionRows{}}
+}
+
+// LoadArchive reads the archive saved in dir, starting with an empty one if
+// it can't be read
+func LoadArchive(dir string) *Archive {
+	a := NewArchive(dir)
+
+	var data archiveFileData
+	if err := Read(dir, archiveFile, &data); err != nil {
+		log.Error("Failed reading the archive", "err", err)
+		return a
+	}
+	if data.Items != nil {
+		a.items = data.Items
+	}
+	if data.Sections != nil {
+		a.sections = data.Sections
+	}
+
+	return a
+}
+
+// Track records the items the section keyed key lists when fetched with
+// filters, and returns the ones it listed before and no longer does. Nothing
+// left the section when its filters changed since. The listed items that
+// were archived are back in a section and leave the archive.
+func (a *Archive) Track(key string, filters string, items []ArchivedItem) []ArchivedItem {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	listed := make(map[string]bool, len(items))
+	for _, item := range items {
+		listed[item.Url] = true
+		delete(a.items, item.Url)
+	}
+
+	var left []ArchivedItem
+	if prev, ok := a.sections[key]; ok && prev.Filters == filters {
+		for _, item := range prev.Items {
+			if !listed[item.Url] {
+				left = append(left, item)
+			}
+		}
+	}
+	a.sections[key] = sectionRows{Filters: filters, Items: slices.Clone(items)}
+	return left
+}
+
+// Add archives items as of now
+func (a *Archive) Add(items []ArchivedItem, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, item := range items {
+		item.ArchivedAt = now
+		a.items[item.Url] = item
+	}
+}
+
+// Items returns the items archived in the last days days, the most recently
+// archived first
+func (a *Archive) Items(days int, now time.Time) []ArchivedItem {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	since := now.AddDate(0, 0, -days)
+	items := make([]ArchivedItem, 0, len(a.items))
+	for _, item := range a.items {
+		if item.ArchivedAt.After(since) {
+			items = append(items, item)
+		}
+	}
+	slices.SortFunc(items, func(x, y ArchivedItem) int {
+		if c := y.ArchivedAt.Compare(x.ArchivedAt); c != 0 {
+			return c
+		}
+		return y.UpdatedAt.Compare(x.UpdatedAt)
+	})
+	return items
+}
+
+// Prune forgets the items archived more than days days ago
+func (a *Archive) Prune(days int, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	since := now.AddDate(0, 0, -days)
+	maps.DeleteFunc(a.items, func(_ string, item ArchivedItem) bool {
+		return !item.ArchivedAt.After(since)
+	})
+}
+
+// Save writes the archive to its state file
+func (a *Archive) Save() error {
+	a.mu.Lock()
+	data := archiveFileData{Items: maps.Clone(a.items), Sections: maps.Clone(a.sections)}
+	a.mu.Unlock()
+
+	return Write(a.dir, archiveFile, data)
+}
@@ -0,0 +1,79 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func archivedItem(number int) ArchivedItem {
+	return ArchivedItem{
+		Url:    fmt.Sprintf("https://github.com/owner/repo/pull/%d", number),
+		Repo:   "owner/repo",
+		Number: number,
+		IsPR:   true,
+	}
+}
+
+func TestArchiveTrack(t *testing.T) {
+	a := NewArchive(t.TempDir())
+	first, second, third := archivedItem(1), archivedItem(2), archivedItem(3)
+
+	if left := a.Track("prs/Mine", "is:open", []ArchivedItem{first, second}); len(left) != 0 {
+		t.Errorf("Track() of a new section = %v, want nothing left", left)
+	}
+	left := a.Track("prs/Mine", "is:open", []ArchivedItem{second, third})
+	if len(left) != 1 || left[0].Url != first.Url {
+		t.Errorf("Track() = %v, want the first item left", left)
+	}
+	if left := a.Track("prs/Mine", "is:open draft:false", []ArchivedItem{third}); len(left) != 0 {
+		t.Errorf("Track() with other filters = %v, want nothing left", left)
+	}
+	if left := a.Track("prs/Review", "is:open", nil); len(left) != 0 {
+		t.Errorf("Track() of another section = %v, want nothing left", left)
+	}
+}
+
+func TestArchiveItems(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	a := NewArchive(t.TempDir())
+	a.Add([]ArchivedItem{archivedItem(1)}, now.AddDate(0, 0, -10))
+	a.Add([]ArchivedItem{archivedItem(2)}, now.AddDate(0, 0, -2))
+	a.Add([]ArchivedItem{archivedItem(3)}, now.AddDate(0, 0, -1))
+
+	items := a.Items(7, now)
+	if len(items) != 2 || items[0].Number != 3 || items[1].Number != 2 {
+		t.Fatalf("Items() = %v, want the items 3 and 2", items)
+	}
+
+	// an archived item listed by a section again leaves the archive
+	a.Track("issues/Bugs", "is:open", []ArchivedItem{archivedItem(3)})
+	if items := a.Items(7, now); len(items) != 1 || items[0].Number != 2 {
+		t.Errorf("Items() after the item is listed again = %v, want the item 2", items)
+	}
+
+	a.Prune(7, now)
+	if len(a.items) != 1 {
+		t.Errorf("len(items) after Prune() = %d, want 1", len(a.items))
+	}
+}
+
+func TestArchiveSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	a := NewArchive(dir)
+	a.Track("prs/Mine", "is:open", []ArchivedItem{archivedItem(1)})
+	a.Add([]ArchivedItem{archivedItem(2)}, now)
+	if err := a.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded := LoadArchive(dir)
+	if items := loaded.Items(7, now); len(items) != 1 || items[0].Number != 2 {
+		t.Errorf("loaded Items() = %v, want the item 2", items)
+	}
+	if left := loaded.Track("prs/Mine", "is:open", nil); len(left) != 1 {
+		t.Errorf("loaded Track() = %v, want the item 1 left", left)
+	}
+}
@@ -0,0 +1,99 @@
+package state
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/log"
+)
+
+const checkFiltersFile = "check-filters.json"
+
+// CheckFilters holds the sources of checks hidden from the checks of the PRs
+// of each repo. A source is the app that created a check run, e.g.
+// github-actions, or the context of a commit status, e.g. ci/circleci. It's
+// shared by all PRs and saved in the background, so access goes through its
+// methods.
+type CheckFilters struct {
+	mu  sync.Mutex
+	dir string
+	// hidden are the hidden sources by repo, the repos lowercased
+	hidden map[string][]string
+}
+
+type checkFiltersFileData struct {
+	Hidden map[string][]string `json:"hidden"`
+}
+
+// NewCheckFilters returns check filters hiding nothing saved to dir
+func NewCheckFilters(dir string) *CheckFilters {
+	return &CheckFilters{dir: dir, hidden: map[string][]string{}}
+}
+
+// LoadCheckFilters reads the check filters saved in dir, starting with none
+// if they can't be read
+func LoadCheckFilters(dir string) *CheckFilters {
+	f := NewCheckFilters(dir)
+
+	var data checkFiltersFileData
+	if err := Read(dir, checkFiltersFile, &data); err != nil {
+		log.Error("Failed reading check filters", "err", err)
+		return f
+	}
+	if data.Hidden != nil {
+		f.hidden = data.Hidden
+	}
+
+	return f
+}
+
+// IsHidden returns whether the checks of source are hidden in repo
+func (f *CheckFilters) IsHidden(repo string, source string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Contains(f.hidden[strings.ToLower(repo)], source)
+}
+
+// Hidden returns the sources of the checks hidden in repo
+func (f *CheckFilters) Hidden(repo string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.hidden[strings.ToLower(repo)])
+}
+
+// Toggle hides the checks of source in repo, or shows them again if they're
+// hidden, returning whether they're hidden now
+func (f *CheckFilters) Toggle(repo string, source string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	repo = strings.ToLower(repo)
+	sources := f.hidden[repo]
+	if i := slices.Index(sources, source); i >= 0 {
+		sources = slices.Delete(sources, i, i+1)
+		if len(sources) == 0 {
+			delete(f.hidden, repo)
+		} else {
+			f.hidden[repo] = sources
+		}
+		return false
+	}
+
+	sources = append(slices.Clone(sources), source)
+	slices.Sort(sources)
+	f.hidden[repo] = sources
+	return true
+}
+
+// Save writes the check filters to their state file
+func (f *CheckFilters) Save() error {
+	f.mu.Lock()
+	data := checkFiltersFileData{Hidden: maps.Clone(f.hidden)}
+	f.mu.Unlock()
+
+	return Write(f.dir, checkFiltersFile, data)
+}
@@ -0,0 +1,49 @@
+package state
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCheckFiltersToggle(t *testing.T) {
+	f := NewCheckFilters(t.TempDir())
+
+	if !f.Toggle("owner/repo", "codecov") {
+		t.Error("Toggle() of a shown source = false, want true")
+	}
+	if !f.Toggle("owner/repo", "ci/circleci") {
+		t.Error("Toggle() of a shown source = false, want true")
+	}
+	if !f.IsHidden("Owner/Repo", "codecov") {
+		t.Error("IsHidden() ignoring the case of the repo = false, want true")
+	}
+	if f.IsHidden("owner/other", "codecov") {
+		t.Error("IsHidden() in another repo = true, want false")
+	}
+	if got, want := f.Hidden("owner/repo"), []string{"ci/circleci", "codecov"}; !slices.Equal(got, want) {
+		t.Errorf("Hidden() = %v, want %v", got, want)
+	}
+
+	if f.Toggle("owner/repo", "codecov") {
+		t.Error("Toggle() of a hidden source = true, want false")
+	}
+	if f.IsHidden("owner/repo", "codecov") {
+		t.Error("IsHidden() after showing the source again = true, want false")
+	}
+}
+
+func TestCheckFiltersSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	f := NewCheckFilters(dir)
+	f.Toggle("owner/repo", "codecov")
+
+	if LoadCheckFilters(dir).IsHidden("owner/repo", "codecov") {
+		t.Error("IsHidden() before saving = true, want false")
+	}
+	if err := f.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if !LoadCheckFilters(dir).IsHidden("owner/repo", "codecov") {
+		t.Error("IsHidden() after loading = false, want true")
+	}
+}
@@ -0,0 +1,77 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const (
+	crashesDir = "crashes"
+	// maxCrashReports is the number of crash reports kept, the oldest ones
+	// are removed when a new one is saved
+	maxCrashReports = 10
+)
+
+// CrashReport is what's known of the dashboard when it panicked
+type CrashReport struct {
+	Time    time.Time `json:"time"`
+	Version string    `json:"version"`
+	Panic   string    `json:"panic"`
+	Stack   string    `json:"stack,omitempty"`
+	// RecentMsgs are the types of the last messages handled, oldest first
+	RecentMsgs []string `json:"recentMsgs"`
+	// ConfigDigest identifies the config the dashboard ran with without
+	// disclosing it
+	ConfigDigest string  `json:"configDigest,omitempty"`
+	Session      Session `json:"session"`
+}
+
+// Session is where the dashboard was at, for it to be restarted there
+type Session struct {
+	View string `json:"view,omitempty"`
+	// Section is the section shown, from 1, 0 for the first one
+	Section  int    `json:"section,omitempty"`
+	RepoPath string `json:"repoPath,omitempty"`
+	// Filters are the filters of the section shown when they were changed
+	// from the configured ones
+	Filters string `json:"filters,omitempty"`
+}
+
+// SaveCrashReport stores report in the crashes directory of dir and returns
+// its path, only the most recent reports are kept
+func SaveCrashReport(dir string, report CrashReport) (string, error) {
+	dir = filepath.Join(dir, crashesDir)
+	name := "crash-" + report.Time.Format("20060102-150405") + ".json"
+	if err := Write(dir, name, report); err != nil {
+		return "", err
+	}
+	pruneCrashReports(dir)
+	return filepath.Join(dir, name), nil
+}
+
+// pruneCrashReports removes the oldest reports in dir past maxCrashReports,
+// their names sort by time
+func pruneCrashReports(dir string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	var reports []string
+	for _, e := range entries {
+		if strings.HasPrefix(e.Name(), "crash-") && strings.HasSuffix(e.Name(), ".json") {
+			reports = append(reports, e.Name())
+		}
+	}
+	slices.Sort(reports)
+	for len(reports) > maxCrashReports {
+		if err := os.Remove(filepath.Join(dir, reports[0])); err != nil {
+			log.Warn("Failed removing an old crash report", "name", reports[0], "err", err)
+		}
+		reports = reports[1:]
+	}
+}
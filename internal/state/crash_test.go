@@ -0,0 +1,55 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSaveCrashReport(t *testing.T) {
+	dir := t.TempDir()
+	report := CrashReport{
+		Time:       time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC),
+		Panic:      "index out of range",
+		RecentMsgs: []string{"tea.KeyMsg"},
+		Session:    Session{View: "prs", Section: 2},
+	}
+
+	path, err := SaveCrashReport(dir, report)
+	if err != nil {
+		t.Fatalf("SaveCrashReport() error = %v", err)
+	}
+	if want := filepath.Join(dir, "crashes", "crash-20240501-103000.json"); path != want {
+		t.Errorf("SaveCrashReport() = %q, want %q", path, want)
+	}
+
+	var got CrashReport
+	if err := Read(filepath.Join(dir, "crashes"), filepath.Base(path), &got); err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if got.Panic != report.Panic || got.Session != report.Session {
+		t.Errorf("saved report = %+v, want %+v", got, report)
+	}
+}
+
+func TestSaveCrashReportPrunesOldReports(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	for i := range maxCrashReports + 2 {
+		if _, err := SaveCrashReport(dir, CrashReport{Time: start.Add(time.Duration(i) * time.Minute)}); err != nil {
+			t.Fatalf("SaveCrashReport() error = %v", err)
+		}
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, "crashes"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != maxCrashReports {
+		t.Errorf("kept %d reports, want %d", len(entries), maxCrashReports)
+	}
+	if entries[0].Name() != "crash-20240501-100200.json" {
+		t.Errorf("oldest kept report = %s, want crash-20240501-100200.json", entries[0].Name())
+	}
+}
@@ -0,0 +1,31 @@
+package state
+
+import (
+	"github.com/charmbracelet/log"
+)
+
+const layoutFile = "layout.json"
+
+// Layout is how the panes of the dashboard were resized, restored at the
+// next launch
+type Layout struct {
+	// PreviewWidth is the width of the preview pane, the one of the config
+	// when it's 0
+	PreviewWidth int `json:"previewWidth,omitempty"`
+}
+
+// LoadLayout reads the layout saved in dir, a zero layout when there's none
+// or it can't be read
+func LoadLayout(dir string) Layout {
+	var layout Layout
+	if err := Read(dir, layoutFile, &layout); err != nil {
+		log.Error("Failed reading the layout", "err", err)
+		return Layout{}
+	}
+	return layout
+}
+
+// SaveLayout writes layout to its state file in dir
+func SaveLayout(dir string, layout Layout) error {
+	return Write(dir, layoutFile, layout)
+}
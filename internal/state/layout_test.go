@@ -0,0 +1,19 @@
+package state
+
+import "testing"
+
+func TestLayout(t *testing.T) {
+	dir := t.TempDir()
+
+	if layout := LoadLayout(dir); layout != (Layout{}) {
+		t.Errorf("LoadLayout() without a saved layout = %+v, want a zero one", layout)
+	}
+
+	saved := Layout{PreviewWidth: 72}
+	if err := SaveLayout(dir, saved); err != nil {
+		t.Fatalf("SaveLayout() error = %v", err)
+	}
+	if layout := LoadLayout(dir); layout != saved {
+		t.Errorf("LoadLayout() = %+v, want %+v", layout, saved)
+	}
+}
@@ -0,0 +1,112 @@
+package state
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const readItemsFile = "read-items.json"
+
+// MaxReadItems is how many read PRs and issues are remembered, the ones
+// updated the longest ago are forgotten first
+const MaxReadItems = 2000
+
+// ReadItems holds the PRs and issues of inbox sections that were looked at,
+// keyed by URL, with the time they were last updated when they were. An item
+// updated since is unread again. It's shared by all sections and saved in the
+// background, so access goes through its methods.
+type ReadItems struct {
+	mu    sync.Mutex
+	dir   string
+	items map[string]time.Time
+}
+
+type readItemsFileData struct {
+	Items map[string]time.Time `json:"items"`
+}
+
+// NewReadItems returns an empty set of read items saved to dir
+func NewReadItems(dir string) *ReadItems {
+	return &ReadItems{dir: dir, items: map[string]time.Time{}}
+}
+
+// LoadReadItems reads the items saved in dir, starting with none if they
+// can't be read
+func LoadReadItems(dir string) *ReadItems {
+	r := NewReadItems(dir)
+
+	var data readItemsFileData
+	if err := Read(dir, readItemsFile, &data); err != nil {
+		log.Error("Failed reading read items", "err", err)
+		return r
+	}
+	if data.Items != nil {
+		r.items = data.Items
+	}
+
+	return r
+}
+
+// IsUnread returns whether the item at url wasn't read since it was last
+// updated at updatedAt
+func (r *ReadItems) IsUnread(url string, updatedAt time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	readAt, ok := r.items[url]
+	return !ok || updatedAt.After(readAt)
+}
+
+// MarkRead records the item at url as read as of updatedAt, returning whether
+// it was unread before
+func (r *ReadItems) MarkRead(url string, updatedAt time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if readAt, ok := r.items[url]; ok && !updatedAt.After(readAt) {
+		return false
+	}
+	r.items[url] = updatedAt
+	r.prune()
+	return true
+}
+
+// MarkUnread forgets the item at url was read, returning whether it was
+func (r *ReadItems) MarkUnread(url string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.items[url]; !ok {
+		return false
+	}
+	delete(r.items, url)
+	return true
+}
+
+// prune forgets the items updated the longest ago past MaxReadItems, they're
+// unlikely to show up in a section again
+func (r *ReadItems) prune() {
+	if len(r.items) <= MaxReadItems {
+		return
+	}
+
+	urls := slices.SortedFunc(maps.Keys(r.items), func(a, b string) int {
+		return r.items[b].Compare(r.items[a])
+	})
+	for _, url := range urls[MaxReadItems:] {
+		delete(r.items, url)
+	}
+}
+
+// Save writes the read items to their state file
+func (r *ReadItems) Save() error {
+	r.mu.Lock()
+	data := readItemsFileData{Items: maps.Clone(r.items)}
+	r.mu.Unlock()
+
+	return Write(r.dir, readItemsFile, data)
+}
@@ -0,0 +1,98 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestReadItemsIsUnread(t *testing.T) {
+	readAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	url := "https://github.com/owner/repo/pull/1"
+
+	tests := []struct {
+		name      string
+		url       string
+		updatedAt time.Time
+		want      bool
+	}{
+		{name: "never read", url: "https://github.com/owner/repo/pull/2", updatedAt: readAt, want: true},
+		{name: "read since the last update", url: url, updatedAt: readAt, want: false},
+		{name: "updated before it was read", url: url, updatedAt: readAt.Add(-time.Hour), want: false},
+		{name: "updated after it was read", url: url, updatedAt: readAt.Add(time.Minute), want: true},
+	}
+
+	r := NewReadItems(t.TempDir())
+	r.MarkRead(url, readAt)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.IsUnread(tt.url, tt.updatedAt); got != tt.want {
+				t.Errorf("IsUnread() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadItemsMark(t *testing.T) {
+	readAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	url := "https://github.com/owner/repo/issues/1"
+	r := NewReadItems(t.TempDir())
+
+	if !r.MarkRead(url, readAt) {
+		t.Error("MarkRead() of an unread item = false, want true")
+	}
+	if r.MarkRead(url, readAt) {
+		t.Error("MarkRead() of a read item = true, want false")
+	}
+	if !r.MarkRead(url, readAt.Add(time.Hour)) {
+		t.Error("MarkRead() of an item updated since = false, want true")
+	}
+	if !r.MarkUnread(url) {
+		t.Error("MarkUnread() of a read item = false, want true")
+	}
+	if r.MarkUnread(url) {
+		t.Error("MarkUnread() of an unread item = true, want false")
+	}
+	if !r.IsUnread(url, readAt) {
+		t.Error("IsUnread() after MarkUnread() = false, want true")
+	}
+}
+
+func TestReadItemsMaxSize(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	r := NewReadItems(t.TempDir())
+	for i := range MaxReadItems + 5 {
+		r.MarkRead(fmt.Sprintf("https://github.com/owner/repo/issues/%d", i), start.Add(time.Duration(i)*time.Minute))
+	}
+
+	if len(r.items) != MaxReadItems {
+		t.Fatalf("len(items) = %d, want %d", len(r.items), MaxReadItems)
+	}
+	if !r.IsUnread("https://github.com/owner/repo/issues/0", start) {
+		t.Error("the item updated the longest ago is still read, want it forgotten")
+	}
+	last := MaxReadItems + 4
+	if r.IsUnread(fmt.Sprintf("https://github.com/owner/repo/issues/%d", last), start.Add(time.Duration(last)*time.Minute)) {
+		t.Error("the item updated last is unread, want it kept")
+	}
+}
+
+func TestReadItemsSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	readAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	url := "https://github.com/owner/repo/pull/1"
+
+	if !LoadReadItems(dir).IsUnread(url, readAt) {
+		t.Fatal("item without a state file is read, want unread")
+	}
+
+	r := NewReadItems(dir)
+	r.MarkRead(url, readAt)
+	if err := r.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	if LoadReadItems(dir).IsUnread(url, readAt) {
+		t.Error("loaded item is unread, want read")
+	}
+}
@@ -0,0 +1,162 @@
+package state
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const reviewPlanFile = "review-plan.json"
+
+// PlanBucket is when a review is planned for
+type PlanBucket string
+
+const (
+	PlanToday    PlanBucket = "today"
+	PlanTomorrow PlanBucket = "tomorrow"
+	PlanLater    PlanBucket = "later"
+)
+
+// PlanBuckets are the buckets in the order they're shown
+var PlanBuckets = []PlanBucket{PlanToday, PlanTomorrow, PlanLater}
+
+// PlannedReview is a review request assigned to a bucket
+type PlannedReview struct {
+	Url    string `json:"url"`
+	Title  string `json:"title"`
+	Repo   string `json:"repo"`
+	Number int    `json:"number"`
+	// Day is the day the review is planned for, zero for later. A review
+	// planned for tomorrow is due today the next day, and stays due until
+	// it's moved.
+	Day time.Time `json:"day"`
+}
+
+// Bucket returns the bucket of the review as of now
+func (r PlannedReview) Bucket(now time.Time) PlanBucket {
+	if r.Day.IsZero() {
+		return PlanLater
+	}
+	today := startOfDay(now)
+	if !r.Day.After(today) {
+		return PlanToday
+	}
+	if r.Day.Equal(today.AddDate(0, 0, 1)) {
+		return PlanTomorrow
+	}
+	return PlanLater
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// ReviewPlan holds the review requests planned for today, tomorrow or later,
+// keyed by URL. It's saved in the background, so access goes through its
+// methods.
+type ReviewPlan struct {
+	mu      sync.Mutex
+	dir     string
+	reviews map[string]PlannedReview
+}
+
+type reviewPlanFileData struct {
+	Reviews []PlannedReview `json:"reviews"`
+}
+
+// NewReviewPlan returns an empty plan saved to dir
+func NewReviewPlan(dir string) *ReviewPlan {
+	return &ReviewPlan{dir: dir, reviews: map[string]PlannedReview{}}
+}
+
+// LoadReviewPlan reads the plan saved in dir, starting with an empty one if
+// it can't be read
+func LoadReviewPlan(dir string) *ReviewPlan {
+	p := NewReviewPlan(dir)
+
+	var data reviewPlanFileData
+	if err := Read(dir, reviewPlanFile, &data); err != nil {
+		log.Error("Failed reading review plan", "err", err)
+		return p
+	}
+	for _, review := range data.Reviews {
+		p.reviews[review.Url] = review
+	}
+
+	return p
+}
+
+// Plan assigns review to bucket as of now, replacing its previous bucket
+func (p *ReviewPlan) Plan(review PlannedReview, bucket PlanBucket, now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch bucket {
+	case PlanToday:
+		review.Day = startOfDay(now)
+	case PlanTomorrow:
+		review.Day = startOfDay(now).AddDate(0, 0, 1)
+	default:
+		review.Day = time.Time{}
+	}
+	p.reviews[review.Url] = review
+}
+
+// Unplan removes the review at url from the plan, returning whether it was
+// planned
+func (p *ReviewPlan) Unplan(url string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.reviews[url]; !ok {
+		return false
+	}
+	delete(p.reviews, url)
+	return true
+}
+
+// Bucket returns the bucket of the review at url as of now, and whether it's
+// planned at all
+func (p *ReviewPlan) Bucket(url string, now time.Time) (PlanBucket, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	review, ok := p.reviews[url]
+	if !ok {
+		return "", false
+	}
+	return review.Bucket(now), true
+}
+
+// Reviews returns the reviews in bucket as of now, by repo and number
+func (p *ReviewPlan) Reviews(bucket PlanBucket, now time.Time) []PlannedReview {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var reviews []PlannedReview
+	for _, review := range p.reviews {
+		if review.Bucket(now) == bucket {
+			reviews = append(reviews, review)
+		}
+	}
+	slices.SortFunc(reviews, func(a, b PlannedReview) int {
+		return cmp.Or(cmp.Compare(a.Repo, b.Repo), cmp.Compare(a.Number, b.Number))
+	})
+	return reviews
+}
+
+// Save writes the plan to its state file
+func (p *ReviewPlan) Save() error {
+	p.mu.Lock()
+	reviews := slices.SortedFunc(maps.Values(p.reviews), func(a, b PlannedReview) int {
+		return cmp.Compare(a.Url, b.Url)
+	})
+	p.mu.Unlock()
+
+	return Write(p.dir, reviewPlanFile, reviewPlanFileData{Reviews: reviews})
+}
@@ -0,0 +1,83 @@
+package state
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlannedReviewBucket(t *testing.T) {
+	plannedAt := time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		bucket PlanBucket
+		now    time.Time
+		want   PlanBucket
+	}{
+		{name: "today", bucket: PlanToday, now: plannedAt, want: PlanToday},
+		{name: "today is overdue the next day", bucket: PlanToday, now: plannedAt.AddDate(0, 0, 2), want: PlanToday},
+		{name: "tomorrow", bucket: PlanTomorrow, now: plannedAt, want: PlanTomorrow},
+		{name: "tomorrow is due the next morning", bucket: PlanTomorrow, now: plannedAt.Add(7 * time.Hour), want: PlanToday},
+		{name: "later", bucket: PlanLater, now: plannedAt.AddDate(0, 1, 0), want: PlanLater},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewReviewPlan(t.TempDir())
+			url := "https://github.com/owner/repo/pull/1"
+			p.Plan(PlannedReview{Url: url}, tt.bucket, plannedAt)
+
+			got, ok := p.Bucket(url, tt.now)
+			if !ok || got != tt.want {
+				t.Errorf("Bucket() = %q, %v, want %q", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviewPlanReviews(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	p := NewReviewPlan(t.TempDir())
+	p.Plan(PlannedReview{Url: "b2", Repo: "owner/b", Number: 2}, PlanToday, now)
+	p.Plan(PlannedReview{Url: "a9", Repo: "owner/a", Number: 9}, PlanToday, now)
+	p.Plan(PlannedReview{Url: "b1", Repo: "owner/b", Number: 1}, PlanToday, now)
+	p.Plan(PlannedReview{Url: "c1", Repo: "owner/c", Number: 1}, PlanLater, now)
+
+	var urls []string
+	for _, review := range p.Reviews(PlanToday, now) {
+		urls = append(urls, review.Url)
+	}
+	if len(urls) != 3 || urls[0] != "a9" || urls[1] != "b1" || urls[2] != "b2" {
+		t.Errorf("Reviews(PlanToday) = %v, want [a9 b1 b2]", urls)
+	}
+
+	if !p.Unplan("c1") {
+		t.Error("Unplan() of a planned review = false, want true")
+	}
+	if p.Unplan("c1") {
+		t.Error("Unplan() of an unplanned review = true, want false")
+	}
+	if len(p.Reviews(PlanLater, now)) != 0 {
+		t.Error("Reviews(PlanLater) isn't empty after Unplan()")
+	}
+}
+
+func TestReviewPlanSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	url := "https://github.com/owner/repo/pull/1"
+
+	if _, ok := LoadReviewPlan(dir).Bucket(url, now); ok {
+		t.Fatal("review without a state file is planned, want unplanned")
+	}
+
+	p := NewReviewPlan(dir)
+	p.Plan(PlannedReview{Url: url, Title: "Fix typo"}, PlanTomorrow, now)
+	if err := p.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	bucket, ok := LoadReviewPlan(dir).Bucket(url, now)
+	if !ok || bucket != PlanTomorrow {
+		t.Errorf("loaded review bucket = %q, %v, want %q", bucket, ok, PlanTomorrow)
+	}
+}
@@ -0,0 +1,91 @@
+package state
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/log"
+)
+
+const searchHistoryFile = "search-history.json"
+
+// MaxSearchHistory is how many queries are remembered per section type
+const MaxSearchHistory = 50
+
+// SearchHistory holds the submitted search queries per section type, newest
+// first. It's shared by all sections and saved in the background, so access
+// goes through its methods.
+type SearchHistory struct {
+	mu      sync.Mutex
+	dir     string
+	queries map[string][]string
+}
+
+type searchHistoryFileData struct {
+	Queries map[string][]string `json:"queries"`
+}
+
+// NewSearchHistory returns an empty history saved to dir
+func NewSearchHistory(dir string) *SearchHistory {
+	return &SearchHistory{dir: dir, queries: map[string][]string{}}
+}
+
+// LoadSearchHistory reads the history saved in dir, starting a new one if it
+// can't be read
+func LoadSearchHistory(dir string) *SearchHistory {
+	h := NewSearchHistory(dir)
+
+	var data searchHistoryFileData
+	if err := Read(dir, searchHistoryFile, &data); err != nil {
+		log.Error("Failed reading search history", "err", err)
+		return h
+	}
+	if data.Queries != nil {
+		h.queries = data.Queries
+	}
+
+	return h
+}
+
+// Add records query as the most recent search of sectionType, moving it to
+// the front if it was searched before
+func (h *SearchHistory) Add(sectionType string, query string) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	queries := []string{query}
+	for _, q := range h.queries[sectionType] {
+		if q != query {
+			queries = append(queries, q)
+		}
+	}
+	if len(queries) > MaxSearchHistory {
+		queries = queries[:MaxSearchHistory]
+	}
+	h.queries[sectionType] = queries
+}
+
+// Get returns the queries searched in sectionType, newest first
+func (h *SearchHistory) Get(sectionType string) []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string(nil), h.queries[sectionType]...)
+}
+
+// Save writes the history to its state file
+func (h *SearchHistory) Save() error {
+	h.mu.Lock()
+	data := searchHistoryFileData{Queries: make(map[string][]string, len(h.queries))}
+	for sectionType, queries := range h.queries {
+		data.Queries[sectionType] = append([]string(nil), queries...)
+	}
+	h.mu.Unlock()
+
+	return Write(h.dir, searchHistoryFile, data)
+}
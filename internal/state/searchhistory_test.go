@@ -0,0 +1,89 @@
+package state
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestSearchHistoryAdd(t *testing.T) {
+	tests := []struct {
+		name  string
+		added []string
+		want  []string
+	}{
+		{
+			name:  "newest first",
+			added: []string{"is:open", "author:@me"},
+			want:  []string{"author:@me", "is:open"},
+		},
+		{
+			name:  "searching again moves the query to the front",
+			added: []string{"is:open", "author:@me", "is:open"},
+			want:  []string{"is:open", "author:@me"},
+		},
+		{
+			name:  "blank queries are ignored",
+			added: []string{"is:open", "  ", ""},
+			want:  []string{"is:open"},
+		},
+		{
+			name:  "queries are trimmed",
+			added: []string{" is:open ", "is:open"},
+			want:  []string{"is:open"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewSearchHistory(t.TempDir())
+			for _, q := range tt.added {
+				h.Add("pr", q)
+			}
+			if got := h.Get("pr"); !slices.Equal(got, tt.want) {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+			if got := h.Get("issue"); len(got) != 0 {
+				t.Errorf("Get() of another section type = %v, want empty", got)
+			}
+		})
+	}
+}
+
+func TestSearchHistoryMaxSize(t *testing.T) {
+	h := NewSearchHistory(t.TempDir())
+	for i := range MaxSearchHistory + 5 {
+		h.Add("pr", fmt.Sprintf("query %d", i))
+	}
+
+	got := h.Get("pr")
+	if len(got) != MaxSearchHistory {
+		t.Fatalf("len(Get()) = %d, want %d", len(got), MaxSearchHistory)
+	}
+	if want := fmt.Sprintf("query %d", MaxSearchHistory+4); got[0] != want {
+		t.Errorf("Get()[0] = %q, want %q", got[0], want)
+	}
+}
+
+func TestSearchHistorySaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	if got := LoadSearchHistory(dir).Get("pr"); len(got) != 0 {
+		t.Fatalf("history without a state file = %v, want empty", got)
+	}
+
+	h := NewSearchHistory(dir)
+	h.Add("pr", "is:open")
+	h.Add("issue", "label:bug")
+	if err := h.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded := LoadSearchHistory(dir)
+	if got := loaded.Get("pr"); !slices.Equal(got, []string{"is:open"}) {
+		t.Errorf("loaded pr history = %v", got)
+	}
+	if got := loaded.Get("issue"); !slices.Equal(got, []string{"label:bug"}) {
+		t.Errorf("loaded issue history = %v", got)
+	}
+}
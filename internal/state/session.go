@@ -0,0 +1,103 @@
+package state
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const (
+	sessionsFile = "sessions.json"
+	// maxSessions is the number of repos whose last session is kept, the
+	// oldest ones are dropped when another repo's is saved
+	maxSessions = 20
+)
+
+// SavedSession is where the dashboard was at when it was quit, to restart
+// there at the next launch
+type SavedSession struct {
+	SavedAt time.Time `json:"savedAt"`
+	Session
+	// Sections are the searches, repo filters and cursors of the sections
+	// of the views that were shown
+	Sections []SectionSession `json:"sections,omitempty"`
+}
+
+// SectionSession is the state of a section the dashboard restores
+type SectionSession struct {
+	// Type and Id identify the section, it's only restored while its Title
+	// and the Filters it's configured with are the same
+	Type    string `json:"type"`
+	Id      int    `json:"id"`
+	Title   string `json:"title,omitempty"`
+	Filters string `json:"filters,omitempty"`
+	// Search is what was searched, with the repo filter applied
+	Search        string `json:"search"`
+	FilterTarget  int    `json:"filterTarget"`
+	FilterRemote  string `json:"filterRemote,omitempty"`
+	CustomRepo    string `json:"customRepo,omitempty"`
+	AuthorRemoved bool   `json:"authorRemoved,omitempty"`
+	// Cursor is the row the cursor was on
+	Cursor int `json:"cursor,omitempty"`
+}
+
+// TakeSection returns the state of the section of sectionType at id, if it's
+// still titled title and configured with filters, and forgets it so it's
+// only restored once
+func (s *SavedSession) TakeSection(sectionType string, id int, title string, filters string) (SectionSession, bool) {
+	i := slices.IndexFunc(s.Sections, func(section SectionSession) bool {
+		return section.Type == sectionType && section.Id == id
+	})
+	if i < 0 {
+		return SectionSession{}, false
+	}
+	section := s.Sections[i]
+	s.Sections = slices.Delete(s.Sections, i, i+1)
+	if section.Title != title || section.Filters != filters {
+		return SectionSession{}, false
+	}
+	return section, true
+}
+
+// sessionsFileData are the last sessions by the path of the repo the
+// dashboard ran in, empty outside a repo
+type sessionsFileData struct {
+	Sessions map[string]SavedSession `json:"sessions"`
+}
+
+// LoadSession reads the last session saved in dir for the repo at repoPath,
+// ok is false when there's none or it can't be read
+func LoadSession(dir string, repoPath string) (session SavedSession, ok bool) {
+	var data sessionsFileData
+	if err := Read(dir, sessionsFile, &data); err != nil {
+		log.Error("Failed reading the last session", "err", err)
+		return SavedSession{}, false
+	}
+	session, ok = data.Sessions[repoPath]
+	return session, ok
+}
+
+// SaveSession stores session as the last one of the repo at repoPath, only
+// the sessions of the most recently used repos are kept
+func SaveSession(dir string, repoPath string, session SavedSession) error {
+	var data sessionsFileData
+	if err := Read(dir, sessionsFile, &data); err != nil {
+		log.Warn("Failed reading the saved sessions, replacing them", "err", err)
+	}
+	if data.Sessions == nil {
+		data.Sessions = map[string]SavedSession{}
+	}
+	data.Sessions[repoPath] = session
+
+	if len(data.Sessions) > maxSessions {
+		paths := slices.SortedFunc(maps.Keys(data.Sessions), func(a, b string) int {
+			return data.Sessions[a].SavedAt.Compare(data.Sessions[b].SavedAt)
+		})
+		for _, path := range paths[:len(paths)-maxSessions] {
+			delete(data.Sessions, path)
+		}
+	}
+	return Write(dir, sessionsFile, data)
+}
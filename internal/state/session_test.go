@@ -0,0 +1,78 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestSession(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	if _, ok := LoadSession(dir, "/src/gh-dash"); ok {
+		t.Errorf("LoadSession() without a saved session ok = true, want false")
+	}
+
+	saved := SavedSession{
+		SavedAt: now,
+		Session: Session{View: "issues", Section: 2},
+		Sections: []SectionSession{
+			{Type: "issue", Id: 2, Title: "Assigned", Filters: "is:open assignee:@me", Search: "is:open assignee:@me label:bug", Cursor: 4},
+		},
+	}
+	if err := SaveSession(dir, "/src/gh-dash", saved); err != nil {
+		t.Fatalf("SaveSession() error = %v", err)
+	}
+	if _, ok := LoadSession(dir, "/src/other"); ok {
+		t.Errorf("LoadSession() of another repo ok = true, want false")
+	}
+	session, ok := LoadSession(dir, "/src/gh-dash")
+	if !ok || session.View != "issues" || session.Section != 2 || len(session.Sections) != 1 {
+		t.Fatalf("LoadSession() = %+v, %v, want %+v", session, ok, saved)
+	}
+
+	tests := []struct {
+		name    string
+		title   string
+		filters string
+		want    bool
+	}{
+		{name: "section renamed", title: "Mine", filters: "is:open assignee:@me"},
+		{name: "filters changed", title: "Assigned", filters: "is:open"},
+		{name: "same section", title: "Assigned", filters: "is:open assignee:@me", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := session
+			session.Sections = append([]SectionSession(nil), session.Sections...)
+			section, ok := session.TakeSection("issue", 2, tt.title, tt.filters)
+			if ok != tt.want {
+				t.Fatalf("TakeSection() ok = %v, want %v", ok, tt.want)
+			}
+			if ok && section.Cursor != 4 {
+				t.Errorf("TakeSection() = %+v, want %+v", section, saved.Sections[0])
+			}
+			if _, ok := session.TakeSection("issue", 2, tt.title, tt.filters); ok {
+				t.Errorf("TakeSection() twice ok = true, want false")
+			}
+		})
+	}
+}
+
+func TestSaveSessionPrunesOldest(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	for i := range maxSessions + 2 {
+		session := SavedSession{SavedAt: start.Add(time.Duration(i) * time.Hour)}
+		if err := SaveSession(dir, fmt.Sprintf("/src/repo-%d", i), session); err != nil {
+			t.Fatalf("SaveSession() error = %v", err)
+		}
+	}
+
+	for i, want := range map[int]bool{0: false, 1: false, 2: true, maxSessions + 1: true} {
+		if _, ok := LoadSession(dir, fmt.Sprintf("/src/repo-%d", i)); ok != want {
+			t.Errorf("LoadSession() of repo %d ok = %v, want %v", i, ok, want)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package state
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const snoozedFile = "snoozed.json"
+
+// SnoozeOption is how long an item is snoozed for
+type SnoozeOption string
+
+const (
+	SnoozeHour     SnoozeOption = "1 hour"
+	SnoozeTomorrow SnoozeOption = "tomorrow"
+	SnoozeNextWeek SnoozeOption = "next week"
+	SnoozeUpdated  SnoozeOption = "until updated"
+)
+
+// SnoozeOptions are the options in the order they're shown
+var SnoozeOptions = []SnoozeOption{SnoozeHour, SnoozeTomorrow, SnoozeNextWeek, SnoozeUpdated}
+
+// Until returns when an item snoozed now wakes up, zero when it only wakes up
+// once updated. Tomorrow starts at midnight and next week on Monday.
+func (o SnoozeOption) Until(now time.Time) time.Time {
+	switch o {
+	case SnoozeHour:
+		return now.Add(time.Hour)
+	case SnoozeTomorrow:
+		return startOfDay(now).AddDate(0, 0, 1)
+	case SnoozeNextWeek:
+		days := (7 - int(now.Weekday()) + int(time.Monday)) % 7
+		if days == 0 {
+			days = 7
+		}
+		return startOfDay(now).AddDate(0, 0, days)
+	}
+	return time.Time{}
+}
+
+// SnoozedItem is a PR or issue hidden from the sections until a time, or
+// until it's updated, whichever comes first
+type SnoozedItem struct {
+	Url string `json:"url"`
+	// UpdatedAt is when the item was last updated when it was snoozed, any
+	// later update wakes it up
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Until is when the item wakes up, zero to wait for an update
+	Until time.Time `json:"until,omitzero"`
+}
+
+// IsSnoozed returns whether the item, last updated at updatedAt, is still
+// snoozed as of now
+func (s SnoozedItem) IsSnoozed(updatedAt, now time.Time) bool {
+	if updatedAt.After(s.UpdatedAt) {
+		return false
+	}
+	return s.Until.IsZero() || now.Before(s.Until)
+}
+
+// Snoozed holds the snoozed PRs and issues keyed by URL. It's shared by all
+// sections and saved in the background, so access goes through its methods.
+type Snoozed struct {
+	mu    sync.Mutex
+	dir   string
+	items map[string]SnoozedItem
+}
+
+type snoozedFileData struct {
+	Items []SnoozedItem `json:"items"`
+}
+
+// NewSnoozed returns an empty set of snoozed items saved to dir
+func NewSnoozed(dir string) *Snoozed {
+	return &Snoozed{dir: dir, items: map[string]SnoozedItem{}}
+}
+
+// LoadSnoozed reads the items snoozed in dir, starting with none if they
+// can't be read
+func LoadSnoozed(dir string) *Snoozed {
+	s := NewSnoozed(dir)
+
+	var data snoozedFileData
+	if err := Read(dir, snoozedFile, &data); err != nil {
+		log.Error("Failed reading snoozed items", "err", err)
+		return s
+	}
+	for _, item := range data.Items {
+		s.items[item.Url] = item
+	}
+	s.prune(time.Now())
+
+	return s
+}
+
+// IsSnoozed returns whether the item at url, last updated at updatedAt, is
+// snoozed as of now
+func (s *Snoozed) IsSnoozed(url string, updatedAt, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.items[url]
+	return ok && item.IsSnoozed(updatedAt, now)
+}
+
+// Snooze hides the item at url, last updated at updatedAt, for option as of
+// now
+func (s *Snoozed) Snooze(url string, updatedAt time.Time, option SnoozeOption, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[url] = SnoozedItem{Url: url, UpdatedAt: updatedAt, Until: option.Until(now)}
+	s.prune(now)
+}
+
+// Wake unsnoozes the item at url, returning whether it was snoozed
+func (s *Snoozed) Wake(url string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.items[url]; !ok {
+		return false
+	}
+	delete(s.items, url)
+	return true
+}
+
+// prune forgets the items whose time has come, the ones waiting for an update
+// are kept until they're woken up
+func (s *Snoozed) prune(now time.Time) {
+	maps.DeleteFunc(s.items, func(_ string, item SnoozedItem) bool {
+		return !item.Until.IsZero() && !now.Before(item.Until)
+	})
+}
+
+// Save writes the snoozed items to their state file
+func (s *Snoozed) Save() error {
+	s.mu.Lock()
+	items := slices.SortedFunc(maps.Values(s.items), func(a, b SnoozedItem) int {
+		return cmp.Compare(a.Url, b.Url)
+	})
+	s.mu.Unlock()
+
+	return Write(s.dir, snoozedFile, snoozedFileData{Items: items})
+}
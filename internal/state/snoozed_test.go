@@ -0,0 +1,105 @@
+package state
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnoozeOptionUntil(t *testing.T) {
+	// a Wednesday
+	now := time.Date(2024, 5, 1, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		option SnoozeOption
+		now    time.Time
+		want   time.Time
+	}{
+		{name: "1 hour", option: SnoozeHour, now: now, want: now.Add(time.Hour)},
+		{name: "tomorrow", option: SnoozeTomorrow, now: now, want: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "next week", option: SnoozeNextWeek, now: now, want: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)},
+		{name: "next week on a Monday", option: SnoozeNextWeek, now: now.AddDate(0, 0, 5), want: time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC)},
+		{name: "next week on a Sunday", option: SnoozeNextWeek, now: now.AddDate(0, 0, 4), want: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)},
+		{name: "until updated", option: SnoozeUpdated, now: now, want: time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.option.Until(tt.now); !got.Equal(tt.want) {
+				t.Errorf("Until() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnoozedIsSnoozed(t *testing.T) {
+	snoozedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	updatedAt := snoozedAt.Add(-time.Hour)
+	url := "https://github.com/owner/repo/pull/1"
+
+	tests := []struct {
+		name      string
+		option    SnoozeOption
+		updatedAt time.Time
+		now       time.Time
+		want      bool
+	}{
+		{name: "within the hour", option: SnoozeHour, updatedAt: updatedAt, now: snoozedAt.Add(time.Minute), want: true},
+		{name: "after the hour", option: SnoozeHour, updatedAt: updatedAt, now: snoozedAt.Add(time.Hour), want: false},
+		{name: "updated within the hour", option: SnoozeHour, updatedAt: snoozedAt.Add(time.Minute), now: snoozedAt.Add(time.Minute), want: false},
+		{name: "waiting for an update", option: SnoozeUpdated, updatedAt: updatedAt, now: snoozedAt.AddDate(1, 0, 0), want: true},
+		{name: "updated", option: SnoozeUpdated, updatedAt: snoozedAt, now: snoozedAt, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSnoozed(t.TempDir())
+			s.Snooze(url, updatedAt, tt.option, snoozedAt)
+
+			if got := s.IsSnoozed(url, tt.updatedAt, tt.now); got != tt.want {
+				t.Errorf("IsSnoozed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnoozedWake(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	url := "https://github.com/owner/repo/issues/1"
+	s := NewSnoozed(t.TempDir())
+	s.Snooze(url, now, SnoozeTomorrow, now)
+
+	if !s.Wake(url) {
+		t.Error("Wake() of a snoozed item = false, want true")
+	}
+	if s.Wake(url) {
+		t.Error("Wake() of an item that isn't snoozed = true, want false")
+	}
+	if s.IsSnoozed(url, now, now) {
+		t.Error("IsSnoozed() after Wake() = true, want false")
+	}
+}
+
+func TestSnoozedSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	url := "https://github.com/owner/repo/pull/1"
+	expired := "https://github.com/owner/repo/pull/2"
+
+	if LoadSnoozed(dir).IsSnoozed(url, now, now) {
+		t.Fatal("item without a state file is snoozed, want not snoozed")
+	}
+
+	s := NewSnoozed(dir)
+	s.Snooze(url, now, SnoozeNextWeek, now)
+	s.Snooze(expired, now, SnoozeHour, now.Add(-2*time.Hour))
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded := LoadSnoozed(dir)
+	if !loaded.IsSnoozed(url, now, now) {
+		t.Error("loaded item isn't snoozed, want snoozed")
+	}
+	if loaded.Wake(expired) {
+		t.Error("loaded item whose time has come is kept, want it pruned")
+	}
+}
@@ -0,0 +1,82 @@
+// Package state persists data gh-dash remembers between runs, e.g. the
+// search history, as opposed to cached responses that can be refetched.
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/charmbracelet/log"
+)
+
+const (
+	dashDir                            = "gh-dash"
+	defaultXdgStateDirName             = ".local/state"
+	fileMode               os.FileMode = 0o600
+)
+
+// Dir returns the directory state is stored in, $XDG_STATE_HOME/gh-dash or
+// ~/.local/state/gh-dash
+func Dir() (string, error) {
+	stateDir := os.Getenv("XDG_STATE_HOME")
+	if stateDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		stateDir = filepath.Join(homeDir, defaultXdgStateDirName)
+	}
+
+	return filepath.Join(stateDir, dashDir), nil
+}
+
+// Read decodes the file called name in dir into v, leaving v untouched if
+// the file doesn't exist yet
+func Read(dir string, name string, v any) error {
+	b, err := os.ReadFile(filepath.Join(dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// Write stores v in the file called name in dir, replacing its contents
+func Write(dir string, name string, v any) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	// write to a temp file first so a crash never leaves a partial file behind
+	tmp, err := os.CreateTemp(dir, "*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(fileMode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	p := filepath.Join(dir, name)
+	log.Debug("Writing state", "path", p)
+	return os.Rename(tmp.Name(), p)
+}
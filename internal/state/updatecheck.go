@@ -0,0 +1,42 @@
+package state
+
+import (
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const updateCheckFile = "update-check.json"
+
+// UpdateCheck is the latest release of gh-dash found by the last check for
+// updates, so that it's fetched at most once per interval
+type UpdateCheck struct {
+	CheckedAt   time.Time `json:"checkedAt"`
+	Version     string    `json:"version"`
+	Name        string    `json:"name,omitempty"`
+	Url         string    `json:"url"`
+	Notes       string    `json:"notes,omitempty"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+// IsStale returns whether the check is older than interval and has to be
+// done again
+func (c UpdateCheck) IsStale(interval time.Duration, now time.Time) bool {
+	return c.Version == "" || now.Sub(c.CheckedAt) >= interval || now.Before(c.CheckedAt)
+}
+
+// LoadUpdateCheck reads the last check for updates saved in dir, a zero
+// check when there's none or it can't be read
+func LoadUpdateCheck(dir string) UpdateCheck {
+	var check UpdateCheck
+	if err := Read(dir, updateCheckFile, &check); err != nil {
+		log.Error("Failed reading the last update check", "err", err)
+		return UpdateCheck{}
+	}
+	return check
+}
+
+// SaveUpdateCheck writes check to its state file in dir
+func SaveUpdateCheck(dir string, check UpdateCheck) error {
+	return Write(dir, updateCheckFile, check)
+}
@@ -0,0 +1,41 @@
+package state
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUpdateCheck(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	if check := LoadUpdateCheck(dir); !check.IsStale(24*time.Hour, now) {
+		t.Errorf("LoadUpdateCheck() without a saved check = %+v, want a stale one", check)
+	}
+
+	saved := UpdateCheck{CheckedAt: now, Version: "v4.12.0", Url: "https://github.com/dlvhdr/gh-dash/releases/tag/v4.12.0"}
+	if err := SaveUpdateCheck(dir, saved); err != nil {
+		t.Fatalf("SaveUpdateCheck() error = %v", err)
+	}
+	check := LoadUpdateCheck(dir)
+	if check.Version != saved.Version || !check.CheckedAt.Equal(saved.CheckedAt) {
+		t.Errorf("LoadUpdateCheck() = %+v, want %+v", check, saved)
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{name: "within the interval", now: now.Add(23 * time.Hour), want: false},
+		{name: "past the interval", now: now.Add(24 * time.Hour), want: true},
+		{name: "clock moved back", now: now.Add(-time.Hour), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := check.IsStale(24*time.Hour, tt.now); got != tt.want {
+				t.Errorf("IsStale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+)
+
+// maxArchiveChecks caps the items that left a section that are looked up
+// after a fetch, the rest are left out of the archive
+const maxArchiveChecks = 10
+
+// archiveItemsMsg carries the items that left a section once merged or
+// closed
+type archiveItemsMsg struct {
+	Items []state.ArchivedItem
+}
+
+// pruneArchive forgets the items archived before the days the archive
+// keeps them
+func (m *Model) pruneArchive() {
+	if !m.ctx.ArchiveEnabled() {
+		return
+	}
+	m.ctx.Archive.Prune(m.ctx.Config.Archive.Days, time.Now())
+}
+
+// trackArchive records the rows a GitHub section listed once its fresh rows
+// were fetched, and looks up the ones that left it. The search section and
+// the rows read from the cache aren't tracked.
+func (m *Model) trackArchive(id int, msg tea.Msg) tea.Cmd {
+	if !m.ctx.ArchiveEnabled() || id == 0 {
+		return nil
+	}
+
+	var (
+		view                        config.ViewType
+		cfg                         config.SectionConfig
+		taskId, lastTaskId, filters string
+		items                       []state.ArchivedItem
+	)
+	switch msg := msg.(type) {
+	case prssection.SectionPullRequestsFetchedMsg:
+		s, ok := m.prs[id].(*prssection.Model)
+		if !ok || msg.IsCached() || msg.Offline {
+			return nil
+		}
+		view, cfg, taskId, lastTaskId, filters = config.PRsView, s.Config, msg.TaskId,
+			s.LastFetchTaskId, s.GetFilters()
+		for _, pr := range s.Prs {
+			if pr.Primary == nil {
+				continue
+			}
+			items = append(items, state.ArchivedItem{
+				Url:       pr.Primary.Url,
+				Repo:      pr.Primary.GetRepoNameWithOwner(),
+				Number:    pr.Primary.Number,
+				Title:     pr.Primary.Title,
+				Author:    pr.Primary.Author.Login,
+				IsPR:      true,
+				UpdatedAt: pr.Primary.UpdatedAt,
+			})
+		}
+	case issuessection.SectionIssuesFetchedMsg:
+		s, ok := m.issues[id].(*issuessection.Model)
+		if !ok || msg.IsCached() || msg.Offline {
+			return nil
+		}
+		view, cfg, taskId, lastTaskId, filters = config.IssuesView, s.Config, msg.TaskId,
+			s.LastFetchTaskId, s.GetFilters()
+		for _, issue := range s.Issues {
+			items = append(items, state.ArchivedItem{
+				Url:       issue.Url,
+				Repo:      issue.GetRepoNameWithOwner(),
+				Number:    issue.Number,
+				Title:     issue.Title,
+				Author:    issue.Author.Login,
+				UpdatedAt: issue.UpdatedAt,
+			})
+		}
+	default:
+		return nil
+	}
+	// rows of an earlier fetch, or of another forge
+	if taskId != lastTaskId || !cfg.IsGitHub() {
+		return nil
+	}
+
+	for i := range items {
+		items[i].Section = cfg.Title
+	}
+	left := m.ctx.Archive.Track(string(view)+"/"+cfg.Title, filters, items)
+	archive := m.ctx.Archive
+	return func() tea.Msg {
+		if len(left) > maxArchiveChecks {
+			log.Debug("Too many items left the section, checking the first ones",
+				"section", cfg.Title, "left", len(left))
+			left = left[:maxArchiveChecks]
+		}
+
+		// the rows that are gone may have been sorted or paged out, only the
+		// merged or closed ones are archived
+		var archived []state.ArchivedItem
+		for _, item := range left {
+			link, err := data.ParseItemUrl(item.Url)
+			if err != nil {
+				continue
+			}
+			row, err := data.FetchItem(link)
+			if err != nil {
+				log.Debug("Failed checking an item that left its section", "url", item.Url, "err", err)
+				continue
+			}
+			switch row := row.(type) {
+			case *data.PullRequestData:
+				item.State, item.Title, item.UpdatedAt = row.State, row.Title, row.UpdatedAt
+			case *data.IssueData:
+				item.State, item.Title, item.UpdatedAt = row.State, row.Title, row.UpdatedAt
+			}
+			if item.State == "MERGED" || item.State == "CLOSED" {
+				archived = append(archived, item)
+			}
+		}
+
+		if len(archived) == 0 {
+			if err := archive.Save(); err != nil {
+				log.Error("Failed saving the archive", "err", err)
+			}
+			return nil
+		}
+		return archiveItemsMsg{Items: archived}
+	}
+}
+
+// onArchiveItems archives the items that left a section, and loads the
+// archive sections again
+func (m *Model) onArchiveItems(msg archiveItemsMsg) tea.Cmd {
+	if m.ctx.Archive == nil {
+		return nil
+	}
+	m.ctx.Archive.Add(msg.Items, time.Now())
+	log.Info("Archived items that left their section", "count", len(msg.Items))
+
+	cmds := []tea.Cmd{m.saveArchive()}
+	for _, s := range m.archive {
+		if s == nil {
+			continue
+		}
+		s.ResetPageInfo()
+		cmds = append(cmds, s.FetchNextPageSectionRows()...)
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m *Model) saveArchive() tea.Cmd {
+	archive := m.ctx.Archive
+	return func() tea.Msg {
+		if err := archive.Save(); err != nil {
+			log.Error("Failed saving the archive", "err", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,200 @@
+package tui
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+	"github.com/cli/go-gh/v2/pkg/browser"
+	"gopkg.in/yaml.v3"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+const (
+	bugReportUrl = "https://github.com/dlvhdr/gh-dash/issues/new"
+	// maxBugReportField is the length each prefilled field is cut to, GitHub
+	// rejects links much longer than 8KB
+	maxBugReportField = 2500
+	redactedValue     = "<redacted>"
+)
+
+// safeConfigKeys are the config keys whose values are kept in the shape of
+// the config, they're names of settings rather than the user's data
+var safeConfigKeys = map[string]bool{
+	"view":      true,
+	"provider":  true,
+	"type":      true,
+	"by":        true,
+	"direction": true,
+	"builtin":   true,
+	"key":       true,
+}
+
+// keyedByUserData are the config keys whose maps are keyed by repos or labels,
+// only their number of entries is kept
+var keyedByUserData = map[string]bool{
+	"repoPaths": true,
+	"labels":    true,
+	"repos":     true,
+}
+
+// bugReport is what's prefilled in the fields of the bug report form
+type bugReport struct {
+	Environment string
+	Config      string
+	Errors      string
+}
+
+// url returns the link to the bug report form prefilled with r
+func (r bugReport) url() string {
+	q := url.Values{}
+	q.Set("template", "bug_report.yml")
+	q.Set("environment", truncateBugReportField(r.Environment))
+	q.Set("config", truncateBugReportField(r.Config))
+	q.Set("errors", truncateBugReportField(r.Errors))
+	return bugReportUrl + "?" + q.Encode()
+}
+
+func truncateBugReportField(s string) string {
+	if len(s) <= maxBugReportField {
+		return s
+	}
+	return s[:maxBugReportField] + "\n... (truncated)"
+}
+
+// bugReport collects the environment, the shape of the config and the
+// recent errors of the dashboard
+func (m *Model) bugReport() bugReport {
+	terminal := []string{}
+	for _, name := range []string{"TERM", "TERM_PROGRAM", "TERM_PROGRAM_VERSION", "COLORTERM"} {
+		if value := os.Getenv(name); value != "" {
+			terminal = append(terminal, fmt.Sprintf("%s=%s", name, value))
+		}
+	}
+	environment := []string{
+		"gh-dash: " + m.ctx.Version,
+		fmt.Sprintf("OS: %s/%s", runtime.GOOS, runtime.GOARCH),
+		"Go: " + runtime.Version(),
+		"Terminal: " + strings.Join(terminal, " "),
+		fmt.Sprintf("Size: %dx%d", m.ctx.ScreenWidth, m.ctx.ScreenHeight),
+		"View: " + string(m.ctx.View),
+	}
+
+	errs := m.crash.lastErrors()
+	if len(errs) == 0 {
+		errs = []string{"None"}
+	}
+	return bugReport{
+		Environment: strings.Join(environment, "\n"),
+		Config:      configShape(m.ctx.Config),
+		Errors:      strings.Join(errs, "\n"),
+	}
+}
+
+// configShape returns cfg as YAML with the values of its settings redacted
+// and the unset ones left out, it tells how the dashboard is configured
+// without disclosing repos, filters, commands or tokens
+func configShape(cfg *config.Config) string {
+	if cfg == nil {
+		return ""
+	}
+	b, err := yaml.Marshal(cfg)
+	if err != nil {
+		return ""
+	}
+	var v any
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return ""
+	}
+	shape, err := yaml.Marshal(redactConfig("", v))
+	if err != nil {
+		return ""
+	}
+	return string(shape)
+}
+
+// redactConfig returns the shape of the value v of the config key key, nil
+// when it's unset
+func redactConfig(key string, v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if keyedByUserData[key] {
+			if len(v) == 0 {
+				return nil
+			}
+			return fmt.Sprintf("<%d entries>", len(v))
+		}
+		shape := map[string]any{}
+		for k, value := range v {
+			if value := redactConfig(k, value); value != nil {
+				shape[k] = value
+			}
+		}
+		if len(shape) == 0 {
+			return nil
+		}
+		return shape
+	case []any:
+		var shape []any
+		for _, value := range v {
+			if value := redactConfig(key, value); value != nil {
+				shape = append(shape, value)
+			}
+		}
+		if len(shape) == 0 {
+			return nil
+		}
+		return shape
+	case string:
+		if v == "" {
+			return nil
+		}
+		if safeConfigKeys[key] {
+			return v
+		}
+		return redactedValue
+	case bool:
+		if !v {
+			return nil
+		}
+		return v
+	case int:
+		if v == 0 {
+			return nil
+		}
+		return v
+	case float64:
+		if v == 0 {
+			return nil
+		}
+		return v
+	case nil:
+		return nil
+	}
+	return redactedValue
+}
+
+// reportBug opens the bug report form of gh-dash prefilled with the
+// environment, the shape of the config and the recent errors. A shared
+// dashboard doesn't get to open a browser on the server or read its setup.
+func (m *Model) reportBug() tea.Cmd {
+	if m.ctx.ReadOnly {
+		return m.notifyErr("This dashboard is read-only")
+	}
+	link := m.bugReport().url()
+	log.Info("Opening a bug report", "url", link)
+	openCmd := func() tea.Msg {
+		b := browser.New("", os.Stdout, os.Stdin)
+		if err := b.Browse(link); err != nil {
+			return constants.ErrMsg{Err: err}
+		}
+		return nil
+	}
+	return tea.Batch(m.notify("Opening a bug report in the browser"), openCmd)
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+func TestConfigShape(t *testing.T) {
+	cfg := &config.Config{
+		PRSections: []config.PrsSectionConfig{{
+			Title:   "Mine",
+			Filters: "is:open author:@me repo:secret/repo",
+			Sort:    &config.SortConfig{By: "created"},
+		}},
+		RepoPaths:   map[string]string{"secret/repo": "~/code/secret"},
+		ConfirmQuit: true,
+	}
+	cfg.Defaults.View = config.PRsView
+	cfg.Defaults.PrsLimit = 20
+
+	shape := configShape(cfg)
+	for _, leak := range []string{"Mine", "author:@me", "secret"} {
+		if strings.Contains(shape, leak) {
+			t.Errorf("configShape() leaks %q:\n%s", leak, shape)
+		}
+	}
+	for _, want := range []string{
+		"title: <redacted>",
+		"by: created",
+		"view: prs",
+		"prsLimit: 20",
+		"confirmQuit: true",
+		"repoPaths: <1 entries>",
+	} {
+		if !strings.Contains(shape, want) {
+			t.Errorf("configShape() doesn't contain %q:\n%s", want, shape)
+		}
+	}
+	if strings.Contains(shape, "workflowsSections") {
+		t.Errorf("configShape() lists unset settings:\n%s", shape)
+	}
+}
+
+func TestBugReportUrl(t *testing.T) {
+	r := bugReport{
+		Environment: "gh-dash: dev",
+		Config:      strings.Repeat("x", maxBugReportField+10),
+		Errors:      "None",
+	}
+	u, err := url.Parse(r.url())
+	if err != nil {
+		t.Fatalf("url() = %q isn't a valid url: %v", r.url(), err)
+	}
+	q := u.Query()
+	if q.Get("template") != "bug_report.yml" || q.Get("environment") != "gh-dash: dev" {
+		t.Errorf("url() query = %v, want the bug report form prefilled", q)
+	}
+	if config := q.Get("config"); !strings.HasSuffix(config, "(truncated)") {
+		t.Errorf("config of %d characters wasn't truncated", len(config))
+	}
+}
+
+func TestCrashRecorderKeepsRecentErrors(t *testing.T) {
+	r := &crashRecorder{}
+	for range maxRecentErrors {
+		r.record(constants.ErrMsg{Err: errors.New("old")})
+	}
+	r.record(tea.KeyMsg{})
+	r.record(constants.TaskFinishedMsg{Err: errors.New("failed task")})
+
+	errs := r.lastErrors()
+	if len(errs) != maxRecentErrors {
+		t.Errorf("kept %d errors, want %d", len(errs), maxRecentErrors)
+	}
+	if last := errs[len(errs)-1]; !strings.HasSuffix(last, "failed task") {
+		t.Errorf("last error = %q, want the failed task", last)
+	}
+}
@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+// chordTimeout is how long we wait for the next key of a chord before
+// handling the keys pressed so far on their own
+const chordTimeout = time.Second
+
+type chordTimeoutMsg struct {
+	id int
+}
+
+func keyStrings(msgs []tea.KeyMsg) []string {
+	strs := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		strs = append(strs, msg.String())
+	}
+	return strs
+}
+
+// updateChord handles msg if it starts or continues a multi-key binding
+func (m Model) updateChord(msg tea.KeyMsg) (bool, tea.Model, tea.Cmd) {
+	if len(m.pendingChord) > 0 && msg.Type == tea.KeyEsc {
+		m.pendingChord = nil
+		m.footer.SetChordHints("")
+		return true, m, nil
+	}
+
+	pending := append(slices.Clone(m.pendingChord), msg)
+	hints, complete := keys.ChordHints(keyStrings(pending), keys.ViewBindings(m.ctx.View))
+	if len(hints) > 0 {
+		m.pendingChord = pending
+		m.footer.SetChordHints(m.renderChordHints(hints))
+		m.chordId++
+		id := m.chordId
+		return true, m, tea.Tick(chordTimeout, func(time.Time) tea.Msg {
+			return chordTimeoutMsg{id: id}
+		})
+	}
+
+	if len(m.pendingChord) == 0 {
+		return false, m, nil
+	}
+
+	if complete {
+		m.pendingChord = nil
+		m.footer.SetChordHints("")
+		model, cmd := m.replayKey(keys.ChordMsg(keyStrings(pending)))
+		return true, model, cmd
+	}
+
+	// msg doesn't continue the chord, so handle the keys before it on their
+	// own and then msg as usual, it may start a new chord
+	m, flushCmd := m.flushPendingChord()
+	model, cmd := m.Update(msg)
+	return true, model, tea.Batch(flushCmd, cmd)
+}
+
+// flushChord handles the pending keys of a chord that timed out
+func (m Model) flushChord() (tea.Model, tea.Cmd) {
+	return m.flushPendingChord()
+}
+
+func (m Model) flushPendingChord() (Model, tea.Cmd) {
+	pending := m.pendingChord
+	m.pendingChord = nil
+	m.footer.SetChordHints("")
+
+	_, complete := keys.ChordHints(keyStrings(pending), keys.ViewBindings(m.ctx.View))
+	if complete {
+		return m.replayKey(keys.ChordMsg(keyStrings(pending)))
+	}
+
+	var cmds []tea.Cmd
+	for _, msg := range pending {
+		var cmd tea.Cmd
+		m, cmd = m.replayKey(msg)
+		cmds = append(cmds, cmd)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// replayKey handles msg without treating it as the start of a chord
+func (m Model) replayKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	m.skipChord = true
+	model, cmd := m.Update(msg)
+	m = model.(Model)
+	m.skipChord = false
+	return m, cmd
+}
+
+func (m *Model) renderChordHints(hints []keys.ChordHint) string {
+	keyStyle := m.ctx.Styles.Help.KeyText.
+		Background(m.ctx.Styles.Common.FooterStyle.GetBackground()).
+		Bold(true)
+	descStyle := m.ctx.Styles.Help.BubbleStyles.ShortDesc.
+		Background(m.ctx.Styles.Common.FooterStyle.GetBackground())
+
+	parts := make([]string, 0, len(hints))
+	for _, hint := range hints {
+		parts = append(parts, fmt.Sprintf("%s %s", keyStyle.Render(hint.Key), descStyle.Render(hint.Desc)))
+	}
+
+	prefix := strings.Join(keyStrings(m.pendingChord), keys.ChordSeparator)
+	return fmt.Sprintf("%s %s %s",
+		keyStyle.Render(prefix),
+		descStyle.Render("…"),
+		strings.Join(parts, descStyle.Render(" • ")))
+}
@@ -71,10 +71,7 @@ func BuildStyles(theme theme.Theme) CommonStyles {
 		Foreground(theme.PrimaryText).
 		Render(constants.DraftIcon)
 	s.MergedGlyph = lipgloss.NewStyle().
-		Foreground(lipgloss.AdaptiveColor{
-			Light: "#A371F7",
-			Dark:  "#A371F7",
-		}).
+		Foreground(theme.MergedPRColor).
 		Render(constants.MergedIcon)
 	return s
 }
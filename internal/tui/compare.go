@@ -0,0 +1,78 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// comparedSection is the section shown in the second pane of compare mode,
+// the current section is shown in the other one
+type comparedSection struct {
+	view config.ViewType
+	id   int
+	// onLeft is whether the compared section is in the left pane
+	onLeft bool
+}
+
+// getComparedSection returns the section shown next to the current one, nil
+// when the current view isn't split in two panes
+func (m *Model) getComparedSection() section.Section {
+	if m.compared == nil || m.compared.view != m.ctx.View || m.compared.id == m.currSectionId {
+		return nil
+	}
+	return m.getSectionAt(m.compared.id)
+}
+
+// toggleCompare splits the view in two panes, showing the section after the
+// current one next to it, or goes back to a single pane
+func (m *Model) toggleCompare() tea.Cmd {
+	if m.getComparedSection() != nil {
+		m.compared = nil
+		m.syncMainContentWidth()
+		return nil
+	}
+
+	sections := m.getCurrentViewSections()
+	if len(sections) < 2 {
+		return m.notifyErr("There's no other section to compare with")
+	}
+	id := m.currSectionId + 1
+	if id >= len(sections) {
+		id = m.currSectionId - 1
+	}
+	m.compared = &comparedSection{view: m.ctx.View, id: id, onLeft: id < m.currSectionId}
+	m.syncMainContentWidth()
+	return nil
+}
+
+// switchPane moves the focus to the other pane of compare mode, its section
+// becomes the current one
+func (m *Model) switchPane() tea.Cmd {
+	if m.getComparedSection() == nil {
+		return nil
+	}
+	id := m.currSectionId
+	m.setCurrSectionId(m.compared.id)
+	m.compared.id = id
+	m.compared.onLeft = !m.compared.onLeft
+	return m.onViewedRowChanged()
+}
+
+// renderPanes renders the current section next to the compared one, split by
+// a separator
+func (m *Model) renderPanes(curr section.Section, compared section.Section) string {
+	separator := lipgloss.NewStyle().
+		Height(m.ctx.MainContentHeight).
+		Border(lipgloss.NormalBorder(), false, true, false, false).
+		BorderForeground(m.ctx.Theme.FaintBorder).
+		Render("")
+
+	left, right := curr.View(), compared.View()
+	if m.compared.onLeft {
+		left, right = right, left
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, separator, right)
+}
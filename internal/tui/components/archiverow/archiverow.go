@@ -0,0 +1,126 @@
+package archiverow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+type Item struct {
+	Ctx  *context.ProgramContext
+	Data state.ArchivedItem
+}
+
+func (item *Item) ToTableRow() table.Row {
+	return table.Row{
+		item.renderState(),
+		item.renderRepo(),
+		item.renderTitle(),
+		item.renderSection(),
+		item.renderArchivedAt(),
+	}
+}
+
+func (item *Item) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(item.Ctx)
+}
+
+func (item *Item) renderState() string {
+	style := lipgloss.NewStyle()
+	switch {
+	case item.Data.State == "MERGED":
+		return style.Foreground(item.Ctx.Styles.Colors.MergedPR).Render(constants.MergedIcon)
+	case item.Data.IsPR:
+		return style.Foreground(item.Ctx.Styles.Colors.ClosedPR).Render(constants.ClosedIcon)
+	default:
+		return style.Foreground(item.Ctx.Styles.Colors.ClosedIssue).Render(constants.ClosedIcon)
+	}
+}
+
+func (item *Item) renderRepo() string {
+	return lipgloss.NewStyle().Foreground(item.Ctx.Theme.FaintText).Render(item.Data.Repo)
+}
+
+func (item *Item) rowState() theme.RowState {
+	if item.Data.State == "MERGED" {
+		return theme.RowMerged
+	}
+	return theme.RowClosed
+}
+
+func (item *Item) renderTitle() string {
+	return components.RenderRowTitle(
+		item.Ctx,
+		item.rowState(),
+		item.Data.State,
+		item.Data.Title,
+		item.Data.Number,
+		false,
+	)
+}
+
+func (item *Item) renderSection() string {
+	return lipgloss.NewStyle().Foreground(item.Ctx.Theme.FaintText).Render(item.Data.Section)
+}
+
+func (item *Item) renderArchivedAt() string {
+	return item.getTextStyle().Render(item.formatTime(item.Data.ArchivedAt))
+}
+
+func (item *Item) formatTime(t time.Time) string {
+	timeFormat := item.Ctx.Config.Defaults.DateFormat
+	if timeFormat == "" || timeFormat == "relative" {
+		return utils.TimeElapsed(t)
+	}
+	return t.Format(timeFormat)
+}
+
+// RenderDetails renders where the item was archived from and when for the
+// sidebar
+func (item *Item) RenderDetails(width int) string {
+	labelStyle := lipgloss.NewStyle().Foreground(item.Ctx.Theme.FaintText).Width(12)
+	valueStyle := item.getTextStyle()
+	headingStyle := lipgloss.NewStyle().Bold(true).Foreground(item.Ctx.Theme.PrimaryText)
+
+	kind := "Issue"
+	if item.Data.IsPR {
+		kind = "PR"
+	}
+	state := "Closed"
+	if item.Data.State == "MERGED" {
+		state = "Merged"
+	}
+	lines := []string{
+		headingStyle.Width(width).Render(item.Data.Title),
+		lipgloss.NewStyle().Foreground(item.Ctx.Theme.SecondaryText).Render(
+			fmt.Sprintf("%s #%d", item.Data.Repo, item.Data.Number)),
+		"",
+	}
+
+	fields := [][2]string{
+		{"Type", kind},
+		{"State", state},
+		{"Author", item.Data.Author},
+		{"Section", item.Data.Section},
+		{"Updated", item.Data.UpdatedAt.Local().Format("2006-01-02 15:04")},
+		{"Archived", item.Data.ArchivedAt.Local().Format("2006-01-02 15:04")},
+	}
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render(f[0]), valueStyle.Render(f[1])))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -0,0 +1,337 @@
+package archivesection
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/archiverow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "archive"
+
+type Model struct {
+	section.BaseModel
+	// Items are the archived items of the section's kind, the most recently
+	// archived first
+	Items []state.ArchivedItem
+	// visible are the items matching the search
+	visible []state.ArchivedItem
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.SectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:          id,
+			Config:      cfg,
+			Type:        SectionType,
+			Columns:     GetSectionColumns(ctx),
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	// the archive is searched locally, the repo filters of smart filtering
+	// don't apply to it
+	m.SearchValue = ""
+	m.SearchBar.SetValue("")
+	m.IsFilteredByCurrentRemote = false
+	m.FilterTarget = section.FilterTargetNone
+
+	return m
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.IsSearchFocused() {
+			if m.SearchBar.IsOverlayOpen() {
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+
+			switch msg.Type {
+			case tea.KeyCtrlC, tea.KeyEsc:
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case tea.KeyEnter:
+				m.SearchValue = m.SearchBar.Value()
+				historyCmd := m.SearchBar.AddToHistory(m.SearchValue)
+				m.SetIsSearching(false)
+				m.Table.ResetCurrItem()
+				m.syncRows()
+				return m, historyCmd
+
+			default:
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+		}
+
+	case SectionArchiveLoadedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			m.Items = filterKind(msg.Items, m.Config.Filters)
+			m.SetIsLoading(false)
+			m.IsRefreshing = false
+			m.PageInfo = &data.PageInfo{HasNextPage: false}
+			m.syncRows()
+			m.UpdateLastUpdated(time.Now())
+		}
+	}
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(searchCmd, tableCmd)
+}
+
+func GetSectionColumns(ctx *context.ProgramContext) []table.Column {
+	return []table.Column{
+		{
+			Title: "",
+			Width: utils.IntPtr(3),
+		},
+		{
+			Title: "Repo",
+			Width: utils.IntPtr(20),
+		},
+		{
+			Title: "Title",
+			Grow:  utils.BoolPtr(true),
+		},
+		{
+			Title: "Section",
+			Width: utils.IntPtr(15),
+		},
+		{
+			Title: "󱦻",
+			Width: utils.IntPtr(lipgloss.Width("2mo  ")),
+		},
+	}
+}
+
+// filterKind returns the items of the kind filters is for, "is:pr" or
+// "is:issue", or all of them when it's neither
+func filterKind(items []state.ArchivedItem, filters string) []state.ArchivedItem {
+	var isPR bool
+	switch strings.TrimSpace(filters) {
+	case "is:pr":
+		isPR = true
+	case "is:issue":
+		isPR = false
+	default:
+		return items
+	}
+
+	var filtered []state.ArchivedItem
+	for _, item := range items {
+		if item.IsPR == isPR {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// filterItems returns the items whose title, repo or section contains every
+// word of query, ignoring case
+func filterItems(items []state.ArchivedItem, query string) []state.ArchivedItem {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return items
+	}
+
+	var filtered []state.ArchivedItem
+	for _, item := range items {
+		text := strings.ToLower(strings.Join([]string{item.Title, item.Repo, item.Section}, " "))
+		matches := true
+		for _, word := range words {
+			if !strings.Contains(text, word) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+func (m Model) BuildRows() []table.Row {
+	rows := []table.Row{}
+	for _, currItem := range m.visible {
+		itemModel := archiverow.Item{Ctx: m.Ctx, Data: currItem}
+		rows = append(rows, itemModel.ToTableRow())
+	}
+	return rows
+}
+
+func (m *Model) NumRows() int {
+	return len(m.visible)
+}
+
+// syncRows rebuilds the rows from the items matching the search
+func (m *Model) syncRows() {
+	m.visible = filterItems(m.Items, m.SearchValue)
+	m.TotalCount = len(m.visible)
+	m.Table.SetRows(m.BuildRows())
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+func (m *Model) GetCurrRow() data.RowData {
+	if len(m.visible) == 0 {
+		return nil
+	}
+	item := m.visible[m.Table.GetCurrItem()]
+	return &item
+}
+
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	taskId := fmt.Sprintf("loading_archive_%d_%s", m.Id, time.Now().String())
+	m.LastFetchTaskId = taskId
+	title := m.Config.Title
+	if title == "" {
+		title = "all archived items"
+	}
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf(`Loading the archive of "%s"`, title),
+		FinishedText: fmt.Sprintf(`The archive of "%s" has been loaded`, title),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	archive, days := m.Ctx.Archive, m.Ctx.Config.Archive.Days
+	loadCmd := func() tea.Msg {
+		var items []state.ArchivedItem
+		if archive != nil {
+			items = archive.Items(days, time.Now())
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionArchiveLoadedMsg{
+				Items:  items,
+				TaskId: taskId,
+			},
+		}
+	}
+	cmds = append(cmds, loadCmd)
+
+	return cmds
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+}
+
+func (m *Model) ResetRows() {
+	m.Items = nil
+	m.visible = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := config.ArchiveSections
+	fetchArchiveCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1, // 0 is the search section
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		)
+		sections = append(sections, &sectionModel)
+		fetchArchiveCmds = append(
+			fetchArchiveCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchArchiveCmds...)
+}
+
+type SectionArchiveLoadedMsg struct {
+	Items  []state.ArchivedItem
+	TaskId string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Item"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Items"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m Model) GetPagerContent() string {
+	pagerContent := ""
+	if m.TotalCount > 0 {
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v/%v",
+			constants.WaitingIcon,
+			m.LastUpdated().Format("01/02 15:04:05"),
+			m.SingularForm,
+			m.Table.GetCurrItem()+1,
+			m.TotalCount,
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	checks "github.com/dlvhdr/x/gh-checks"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/git"
@@ -16,10 +17,29 @@ import (
 )
 
 type Branch struct {
-	Ctx     *context.ProgramContext
-	PR      *data.PullRequestData
-	Data    git.Branch
-	Columns []table.Column
+	Ctx         *context.ProgramContext
+	PR          *data.PullRequestData
+	Data        git.Branch
+	Columns     []table.Column
+	IsDefault   bool
+	IsProtected bool
+}
+
+// IsGuarded reports whether destructive actions on the branch need a
+// stronger confirmation
+func (b *Branch) IsGuarded() bool {
+	return b.IsDefault || b.IsProtected
+}
+
+// GuardReason describes why the branch is guarded, for confirmation prompts
+func (b *Branch) GuardReason() string {
+	switch {
+	case b.IsDefault:
+		return "the default branch"
+	case b.IsProtected:
+		return "a protected branch"
+	}
+	return ""
 }
 
 func (b *Branch) getTextStyle() lipgloss.Style {
@@ -58,7 +78,7 @@ func (b *Branch) renderState() string {
 	switch b.PR.State {
 	case "OPEN":
 		if b.PR.IsDraft {
-			return mergeCellStyle.Foreground(b.Ctx.Theme.FaintText).Render(constants.DraftIcon)
+			return mergeCellStyle.Foreground(b.Ctx.Styles.Colors.DraftPR).Render(constants.DraftIcon)
 		} else {
 			return mergeCellStyle.Foreground(b.Ctx.Styles.Colors.OpenPR).Render(constants.OpenIcon)
 		}
@@ -73,33 +93,35 @@ func (b *Branch) renderState() string {
 	}
 }
 
-func (b *Branch) GetStatusChecksRollup() string {
+func (b *Branch) GetStatusChecksRollup() checks.CommitState {
 	commits := b.PR.Commits.Nodes
 	if len(commits) == 0 {
-		return "UNKNOWN"
+		return checks.CommitStateUnknown
 	}
 
-	return string(commits[0].Commit.StatusCheckRollup.State)
+	return checks.CommitState(commits[0].Commit.StatusCheckRollup.State)
 }
 
+// renderCiStatus renders the checks of the branch's PR, next to its number
 func (b *Branch) renderCiStatus() string {
 	if b.PR == nil {
-		return "-"
+		return b.getTextStyle().Foreground(b.Ctx.Theme.FaintText).Render("-")
 	}
 
-	accStatus := b.GetStatusChecksRollup()
 	ciCellStyle := b.getTextStyle()
-	if accStatus == "SUCCESS" {
+	switch b.GetStatusChecksRollup() {
+	case checks.CommitStateSuccess:
 		ciCellStyle = ciCellStyle.Foreground(b.Ctx.Theme.SuccessText)
 		return ciCellStyle.Render(constants.SuccessIcon)
-	}
-
-	if accStatus == "PENDING" {
+	case checks.CommitStateExpected, checks.CommitStatePending:
 		return ciCellStyle.Render(b.Ctx.Styles.Common.WaitingGlyph)
+	case checks.CommitStateError, checks.CommitStateFailure:
+		ciCellStyle = ciCellStyle.Foreground(b.Ctx.Theme.ErrorText)
+		return ciCellStyle.Render(constants.FailureIcon)
+	default:
+		ciCellStyle = ciCellStyle.Foreground(b.Ctx.Theme.FaintText)
+		return ciCellStyle.Render(constants.EmptyIcon)
 	}
-
-	ciCellStyle = ciCellStyle.Foreground(b.Ctx.Theme.ErrorText)
-	return ciCellStyle.Render(constants.FailureIcon)
 }
 
 func (b *Branch) renderLines(isSelected bool) string {
@@ -138,12 +160,24 @@ func (b *Branch) renderLines(isSelected bool) string {
 	)
 }
 
+func (b *Branch) renderPrNumber() string {
+	if b.PR == nil {
+		return b.getTextStyle().Foreground(b.Ctx.Theme.FaintText).Render("-")
+	}
+	return b.getTextStyle().Foreground(b.Ctx.Theme.SecondaryText).Render(
+		fmt.Sprintf("#%d", b.PR.Number))
+}
+
 func (b *Branch) renderTitle() string {
+	if b.PR == nil {
+		return b.renderBranch(false, b.getMaxWidth())
+	}
 	return components.RenderIssueTitle(
 		b.Ctx,
 		b.PR.State,
 		b.PR.Title,
 		b.PR.Number,
+		false,
 	)
 }
 
@@ -157,6 +191,9 @@ func (b *Branch) renderExtendedTitle(isSelected bool) string {
 }
 
 func (pr *Branch) renderAuthor() string {
+	if pr.PR == nil {
+		return ""
+	}
 	return pr.getTextStyle().Render(pr.PR.Author.Login)
 }
 
@@ -172,6 +209,9 @@ func (b *Branch) renderAssignees() string {
 }
 
 func (b *Branch) renderRepoName() string {
+	if b.PR == nil {
+		return ""
+	}
 	repoName := ""
 	if !b.Ctx.Config.Theme.Ui.Table.Compact {
 		repoName = b.PR.Repository.NameWithOwner
@@ -231,11 +271,12 @@ func (b *Branch) ToTableRow(isSelected bool) table.Row {
 	if !b.Ctx.Config.Theme.Ui.Table.Compact {
 		return table.Row{
 			b.renderState(),
+			b.renderPrNumber(),
+			b.renderCiStatus(),
 			b.renderExtendedTitle(isSelected),
 			b.renderBaseName(),
 			b.renderAssignees(),
 			b.renderReviewStatus(),
-			b.renderCiStatus(),
 			b.renderLines(isSelected),
 			b.renderUpdateAt(),
 		}
@@ -243,13 +284,14 @@ func (b *Branch) ToTableRow(isSelected bool) table.Row {
 
 	return table.Row{
 		b.renderState(),
+		b.renderPrNumber(),
+		b.renderCiStatus(),
 		b.renderRepoName(),
 		b.renderTitle(),
 		b.renderAuthor(),
 		b.renderBaseName(),
 		b.renderAssignees(),
 		b.renderReviewStatus(),
-		b.renderCiStatus(),
 		b.renderLines(isSelected),
 		b.renderUpdateAt(),
 	}
@@ -266,10 +308,35 @@ func (b *Branch) renderBranch(isSelected bool, width int) string {
 	return baseStyle.MaxHeight(1).Width(width).MaxWidth(width).Render(lipgloss.JoinHorizontal(
 		lipgloss.Top,
 		name,
+		b.renderGuardMarkers(isSelected),
+		b.renderWorktreeMarker(isSelected),
 		b.renderCommitsAheadBehind(isSelected),
 	))
 }
 
+func (b *Branch) renderGuardMarkers(isSelected bool) string {
+	baseStyle := b.getBaseStyle(isSelected).Foreground(b.Ctx.Theme.FaintText)
+
+	markers := ""
+	if b.IsDefault {
+		markers += baseStyle.Render(" " + constants.DefaultBranchIcon)
+	}
+	if b.IsProtected {
+		markers += baseStyle.Render(" " + constants.ProtectedBranchIcon)
+	}
+	return markers
+}
+
+// renderWorktreeMarker marks branches checked out in another worktree, they
+// can't be checked out here
+func (b *Branch) renderWorktreeMarker(isSelected bool) string {
+	if b.Data.Worktree == "" {
+		return ""
+	}
+	return b.getBaseStyle(isSelected).Foreground(b.Ctx.Theme.FaintText).
+		Render(" " + constants.WorktreeIcon)
+}
+
 func (b *Branch) getBaseStyle(isSelected bool) lipgloss.Style {
 	baseStyle := lipgloss.NewStyle()
 	if isSelected {
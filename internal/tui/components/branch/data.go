@@ -37,3 +37,28 @@ func (b BranchData) GetUrl() string {
 func (b BranchData) GetUpdatedAt() time.Time {
 	return *b.Data.LastUpdatedAt
 }
+
+// StashData is the row of a stash, its branch is the one it was made on
+type StashData struct {
+	Data git.Stash
+}
+
+func (s StashData) GetRepoNameWithOwner() string {
+	return ""
+}
+
+func (s StashData) GetTitle() string {
+	return s.Data.Message
+}
+
+func (s StashData) GetNumber() int {
+	return s.Data.Index
+}
+
+func (s StashData) GetUrl() string {
+	return ""
+}
+
+func (s StashData) GetUpdatedAt() time.Time {
+	return s.Data.CreatedAt
+}
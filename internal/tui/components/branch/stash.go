@@ -0,0 +1,125 @@
+package branch
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// Stash is a row of the stash list, shown in the columns of the branches
+type Stash struct {
+	Ctx     *context.ProgramContext
+	Data    git.Stash
+	Columns []table.Column
+}
+
+func (s *Stash) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(s.Ctx)
+}
+
+func (s *Stash) getBaseStyle(isSelected bool) lipgloss.Style {
+	baseStyle := lipgloss.NewStyle()
+	if isSelected {
+		baseStyle = baseStyle.Background(s.Ctx.Theme.SelectedBackground)
+	}
+	return baseStyle
+}
+
+func (s *Stash) getMaxWidth() int {
+	var titleColumn table.Column
+	for _, column := range s.Columns {
+		if column.Grow != nil && *column.Grow {
+			titleColumn = column
+		}
+	}
+	return titleColumn.ComputedWidth - 2
+}
+
+func (s *Stash) renderIcon() string {
+	return s.getTextStyle().Foreground(s.Ctx.Theme.FaintText).Render(constants.StashIcon)
+}
+
+func (s *Stash) renderIndex() string {
+	return s.getTextStyle().Foreground(s.Ctx.Theme.SecondaryText).Render(
+		fmt.Sprintf("{%d}", s.Data.Index))
+}
+
+func (s *Stash) renderBranch(isSelected bool, width int) string {
+	baseStyle := s.getBaseStyle(isSelected)
+	name := s.Data.Branch
+	if name == "" {
+		name = s.Data.Ref
+	}
+	return baseStyle.Foreground(s.Ctx.Theme.PrimaryText).MaxHeight(1).Width(width).MaxWidth(width).Render(name)
+}
+
+func (s *Stash) renderMessage(isSelected bool, width int) string {
+	return s.getBaseStyle(isSelected).Foreground(s.Ctx.Theme.SecondaryText).
+		Width(width).MaxWidth(width).Render(s.Data.Message)
+}
+
+func (s *Stash) renderExtendedTitle(isSelected bool) string {
+	width := s.getMaxWidth()
+	return s.getBaseStyle(isSelected).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		s.renderBranch(isSelected, width),
+		s.renderMessage(isSelected, width),
+	))
+}
+
+func (s *Stash) renderTitle() string {
+	return s.getTextStyle().Render(s.Data.Message)
+}
+
+func (s *Stash) renderCreatedAt() string {
+	if s.Data.CreatedAt.IsZero() {
+		return ""
+	}
+	timeFormat := s.Ctx.Config.Defaults.DateFormat
+	createdAt := ""
+	if timeFormat == "" || timeFormat == "relative" {
+		createdAt = utils.TimeElapsed(s.Data.CreatedAt)
+	} else {
+		createdAt = s.Data.CreatedAt.Format(timeFormat)
+	}
+	return s.getTextStyle().Foreground(s.Ctx.Theme.FaintText).Render(createdAt)
+}
+
+// ToTableRow fills the columns of the branches, leaving the ones about PRs
+// empty
+func (s *Stash) ToTableRow(isSelected bool) table.Row {
+	if !s.Ctx.Config.Theme.Ui.Table.Compact {
+		return table.Row{
+			s.renderIcon(),
+			s.renderIndex(),
+			s.renderExtendedTitle(isSelected),
+			"",
+			"",
+			"",
+			"",
+			"",
+			s.renderCreatedAt(),
+		}
+	}
+
+	return table.Row{
+		s.renderIcon(),
+		s.renderIndex(),
+		"",
+		s.renderTitle(),
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		s.renderCreatedAt(),
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/dlvhdr/gh-dash/v4/internal/git"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branch"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/diffview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 )
 
@@ -17,11 +18,15 @@ type Model struct {
 	ctx    *context.ProgramContext
 	branch *branch.BranchData
 	status *gitm.NameStatus
+	// stash is shown instead of the branch while listing the stashes
+	stash *branch.StashData
+	diff  diffview.Model
 }
 
 func NewModel(ctx *context.ProgramContext) Model {
 	return Model{
 		branch: nil,
+		diff:   diffview.NewModel(ctx),
 	}
 }
 
@@ -35,6 +40,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 }
 
 func (m Model) View() string {
+	if m.stash != nil {
+		return m.viewStash()
+	}
+
 	s := strings.Builder{}
 
 	s.WriteString(lipgloss.NewStyle().Bold(true).Render("STATUS\n"))
@@ -81,6 +90,7 @@ type updateBranchStatusMsg struct {
 
 func (m *Model) SetRow(b *branch.BranchData) tea.Cmd {
 	m.branch = b
+	m.stash = nil
 	return m.refreshBranchStatusCmd
 }
 
@@ -96,4 +106,5 @@ func (m *Model) refreshBranchStatusCmd() tea.Msg {
 
 func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 	m.ctx = ctx
+	m.diff.UpdateProgramContext(ctx)
 }
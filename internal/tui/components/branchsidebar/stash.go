@@ -0,0 +1,78 @@
+package branchsidebar
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branch"
+)
+
+// StashDiffFetchedMsg carries the changes of the stash identified by key
+type StashDiffFetchedMsg struct {
+	key  string
+	Diff string
+	Err  error
+}
+
+// stashKey identifies a stash across drops, which shift the index of the
+// stashes older than the dropped one
+func stashKey(s git.Stash) string {
+	return fmt.Sprintf("%s@%d", s.Ref, s.CreatedAt.Unix())
+}
+
+// SetStash shows the stash s, reading its changes if another stash was shown
+func (m *Model) SetStash(s *branch.StashData) tea.Cmd {
+	m.stash = s
+	m.diff.SetWidth(m.ctx.Config.Defaults.Preview.Width - 5)
+	key := stashKey(s.Data)
+	if key == m.diff.Url() {
+		return nil
+	}
+	m.diff.SetLoading(key)
+	dir, ref := m.ctx.RepoPath, s.Data.Ref
+	return func() tea.Msg {
+		diff, err := git.StashDiff(dir, ref)
+		return StashDiffFetchedMsg{key: key, Diff: diff, Err: err}
+	}
+}
+
+// SetStashDiff sets the changes read for a stash, they're ignored if another
+// stash was selected in the meantime
+func (m *Model) SetStashDiff(msg StashDiffFetchedMsg) {
+	m.diff.SetDiff(msg.key, msg.Diff, msg.Err)
+}
+
+func (m Model) viewStash() string {
+	s := strings.Builder{}
+
+	s.WriteString(lipgloss.NewStyle().Bold(true).Render(m.stash.Data.Ref))
+	if m.stash.Data.Branch != "" {
+		s.WriteString(lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Render(
+			" on " + m.stash.Data.Branch))
+	}
+	s.WriteString("\n")
+	s.WriteString(m.stash.Data.Message)
+
+	s.WriteString("\n\n")
+	s.WriteString(lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintBorder).Render(
+		strings.Repeat(lipgloss.NormalBorder().Bottom, m.ctx.Config.Defaults.Preview.Width-5)),
+	)
+	s.WriteString("\n\n")
+	s.WriteString(m.diff.View())
+
+	return s.String()
+}
+
+// NextFile shows the next file changed by the stash
+func (m *Model) NextFile() {
+	m.diff.NextFile()
+}
+
+// PrevFile shows the previous file changed by the stash
+func (m *Model) PrevFile() {
+	m.diff.PrevFile()
+}
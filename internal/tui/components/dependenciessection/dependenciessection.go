@@ -0,0 +1,346 @@
+package dependenciessection
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/dependencyrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "dependency"
+
+type Model struct {
+	section.BaseModel
+	// Checks are the dependencies checked by the section, the search section
+	// checks all of them
+	Checks []config.DependenciesSectionConfig
+	// Statuses are the versions the repos are on, in the order they're
+	// configured
+	Statuses []data.DependencyStatus
+	// visible are the statuses matching the search
+	visible []data.DependencyStatus
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.DependenciesSectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:          id,
+			Config:      cfg.ToSectionConfig(),
+			Type:        SectionType,
+			Columns:     GetSectionColumns(ctx),
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	// repos are searched locally, the repo filters of smart filtering don't
+	// apply to them
+	m.SearchValue = ""
+	m.SearchBar.SetValue("")
+	m.IsFilteredByCurrentRemote = false
+	m.FilterTarget = section.FilterTargetNone
+
+	if cfg.Dependency != "" {
+		m.Checks = []config.DependenciesSectionConfig{cfg}
+	} else {
+		m.Checks = ctx.Config.DependenciesSections
+	}
+
+	return m
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.IsSearchFocused() {
+			if m.SearchBar.IsOverlayOpen() {
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+
+			switch msg.Type {
+			case tea.KeyCtrlC, tea.KeyEsc:
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case tea.KeyEnter:
+				m.SearchValue = m.SearchBar.Value()
+				historyCmd := m.SearchBar.AddToHistory(m.SearchValue)
+				m.SetIsSearching(false)
+				m.Table.ResetCurrItem()
+				m.syncRows()
+				return m, historyCmd
+
+			default:
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+		}
+
+	case SectionDependenciesFetchedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			m.Statuses = msg.Statuses
+			m.SetIsLoading(false)
+			m.IsRefreshing = false
+			m.PageInfo = &data.PageInfo{HasNextPage: false}
+			m.syncRows()
+			m.UpdateLastUpdated(time.Now())
+		}
+	}
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(searchCmd, tableCmd)
+}
+
+func GetSectionColumns(ctx *context.ProgramContext) []table.Column {
+	return []table.Column{
+		{
+			Title: "",
+			Width: utils.IntPtr(3),
+		},
+		{
+			Title: "Repo",
+			Grow:  utils.BoolPtr(true),
+		},
+		{
+			Title: "Dependency",
+			Grow:  utils.BoolPtr(true),
+		},
+		{
+			Title: "Version",
+			Width: utils.IntPtr(14),
+		},
+		{
+			Title: "Target",
+			Width: utils.IntPtr(14),
+		},
+		{
+			Title: "Update PR",
+			Width: utils.IntPtr(lipgloss.Width("Update PR  ")),
+		},
+	}
+}
+
+// filterStatuses returns the statuses whose repo or dependency contains
+// every word of query, ignoring case
+func filterStatuses(statuses []data.DependencyStatus, query string) []data.DependencyStatus {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return statuses
+	}
+
+	var filtered []data.DependencyStatus
+	for _, status := range statuses {
+		name := strings.ToLower(status.Repo + " " + status.Dependency)
+		matches := true
+		for _, word := range words {
+			if !strings.Contains(name, word) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, status)
+		}
+	}
+	return filtered
+}
+
+func (m Model) BuildRows() []table.Row {
+	rows := []table.Row{}
+	for _, currStatus := range m.visible {
+		statusModel := dependencyrow.Status{Ctx: m.Ctx, Data: currStatus}
+		rows = append(rows, statusModel.ToTableRow())
+	}
+	return rows
+}
+
+func (m *Model) NumRows() int {
+	return len(m.visible)
+}
+
+// syncRows rebuilds the rows from the statuses matching the search
+func (m *Model) syncRows() {
+	m.visible = filterStatuses(m.Statuses, m.SearchValue)
+	m.TotalCount = len(m.visible)
+	m.Table.SetRows(m.BuildRows())
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+func (m *Model) GetCurrRow() data.RowData {
+	if len(m.visible) == 0 {
+		return nil
+	}
+	status := m.visible[m.Table.GetCurrItem()]
+	return &status
+}
+
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	taskId := fmt.Sprintf("fetching_dependencies_%d_%s", m.Id, time.Now().String())
+	m.LastFetchTaskId = taskId
+	title := m.Config.Title
+	if title == "" {
+		title = "all dependencies"
+	}
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf(`Checking "%s" across repos`, title),
+		FinishedText: fmt.Sprintf(`"%s" has been checked across repos`, title),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	checks := m.Checks
+	fetchCmd := func() tea.Msg {
+		var statuses []data.DependencyStatus
+		var errs []error
+		for _, check := range checks {
+			checked, err := data.FetchDependencyMatrix(check.Dependency, check.Manifest, check.Version, check.Repos)
+			statuses = append(statuses, checked...)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		err := errors.Join(errs...)
+		if err != nil && len(statuses) == 0 {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+		if err != nil {
+			log.Error("Failed checking some of the dependencies", "section", m.Id, "err", err)
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionDependenciesFetchedMsg{
+				Statuses: statuses,
+				TaskId:   taskId,
+			},
+		}
+	}
+	cmds = append(cmds, fetchCmd)
+
+	return cmds
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+}
+
+func (m *Model) ResetRows() {
+	m.Statuses = nil
+	m.visible = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := ctx.Config.DependenciesSections
+	fetchDependenciesCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1, // 0 is the search section
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		)
+		sections = append(sections, &sectionModel)
+		fetchDependenciesCmds = append(
+			fetchDependenciesCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchDependenciesCmds...)
+}
+
+type SectionDependenciesFetchedMsg struct {
+	Statuses []data.DependencyStatus
+	TaskId   string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Repo"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Repos"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m Model) GetPagerContent() string {
+	pagerContent := ""
+	if m.TotalCount > 0 {
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v/%v",
+			constants.WaitingIcon,
+			m.LastUpdated().Format("01/02 15:04:05"),
+			m.SingularForm,
+			m.Table.GetCurrItem()+1,
+			m.TotalCount,
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
@@ -0,0 +1,130 @@
+package dependencyrow
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+type Status struct {
+	Ctx  *context.ProgramContext
+	Data data.DependencyStatus
+}
+
+func (s *Status) ToTableRow() table.Row {
+	return table.Row{
+		s.renderStatus(),
+		s.renderRepo(),
+		s.renderDependency(),
+		s.renderVersion(),
+		s.renderTarget(),
+		s.renderUpdatePR(),
+	}
+}
+
+func (s *Status) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(s.Ctx)
+}
+
+func (s *Status) getFaintStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(s.Ctx.Theme.FaintText)
+}
+
+// renderStatus renders whether the repo is behind the target version
+func (s *Status) renderStatus() string {
+	switch {
+	case s.Data.IsMissing():
+		return s.getFaintStyle().Render(constants.EmptyIcon)
+	case s.Data.IsBehind():
+		return lipgloss.NewStyle().Foreground(s.Ctx.Theme.ErrorText).Render(constants.FailureIcon)
+	default:
+		return lipgloss.NewStyle().Foreground(s.Ctx.Theme.SuccessText).Render(constants.SuccessIcon)
+	}
+}
+
+func (s *Status) renderRepo() string {
+	return s.getTextStyle().Render(s.Data.Repo)
+}
+
+func (s *Status) renderDependency() string {
+	return s.getFaintStyle().Render(s.Data.Dependency)
+}
+
+func (s *Status) renderVersion() string {
+	switch {
+	case s.Data.IsMissing():
+		return s.getFaintStyle().Render("not used")
+	case s.Data.IsBehind():
+		return lipgloss.NewStyle().Foreground(s.Ctx.Theme.ErrorText).Render(s.Data.Version)
+	default:
+		return s.getTextStyle().Render(s.Data.Version)
+	}
+}
+
+func (s *Status) renderTarget() string {
+	if s.Data.Target == "" {
+		return s.getFaintStyle().Render("-")
+	}
+	return s.getFaintStyle().Render(s.Data.Target)
+}
+
+func (s *Status) renderUpdatePR() string {
+	if len(s.Data.UpdatePRs) == 0 {
+		return s.getFaintStyle().Render("-")
+	}
+	pr := fmt.Sprintf("#%d", s.Data.UpdatePRs[0].Number)
+	if more := len(s.Data.UpdatePRs) - 1; more > 0 {
+		pr += fmt.Sprintf(" +%d", more)
+	}
+	return s.getTextStyle().Render(pr)
+}
+
+// RenderDetails renders the version of the dependency the repo is on and
+// its open update PRs for the sidebar
+func (s *Status) RenderDetails(width int) string {
+	labelStyle := s.getFaintStyle().Width(12)
+	valueStyle := s.getTextStyle()
+	headingStyle := lipgloss.NewStyle().Bold(true).Foreground(s.Ctx.Theme.PrimaryText)
+
+	version := s.Data.Version
+	if s.Data.IsMissing() {
+		version = "not used"
+	} else if s.Data.IsBehind() {
+		version += " (behind)"
+	}
+
+	lines := []string{headingStyle.Width(width).Render(s.Data.Repo), ""}
+	for _, f := range [][2]string{
+		{"Dependency", s.Data.Dependency},
+		{"Manifest", s.Data.Manifest},
+		{"Version", version},
+		{"Target", s.Data.Target},
+	} {
+		if f[1] == "" {
+			continue
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render(f[0]), valueStyle.Render(f[1])))
+	}
+
+	lines = append(lines, "", headingStyle.Render(fmt.Sprintf("Update PRs (%d)", len(s.Data.UpdatePRs))))
+	if len(s.Data.UpdatePRs) == 0 {
+		lines = append(lines, s.getFaintStyle().Render("No open PR updates the dependency"))
+	}
+	for _, pr := range s.Data.UpdatePRs {
+		line := fmt.Sprintf("%s %s",
+			lipgloss.NewStyle().Foreground(s.Ctx.Theme.SuccessText).Render(fmt.Sprintf("#%d", pr.Number)),
+			pr.Title,
+		)
+		lines = append(lines, ansi.Truncate(line, width, constants.Ellipsis))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -0,0 +1,310 @@
+package diffview
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2/quick"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+)
+
+// Model renders the diff of a PR one file at a time, keeping track of the
+// file and hunk being viewed
+type Model struct {
+	ctx       *context.ProgramContext
+	url       string
+	files     []File
+	file      int
+	hunk      int
+	isLoading bool
+	err       error
+	width     int
+
+	// highlighted caches the highlighted lines of each file's hunks, keyed by
+	// file index
+	highlighted map[int][][]string
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:         ctx,
+		highlighted: map[int][][]string{},
+	}
+}
+
+// Url is the url of the PR the diff belongs to
+func (m Model) Url() string {
+	return m.url
+}
+
+func (m Model) IsLoading() bool {
+	return m.isLoading
+}
+
+// SetLoading clears the current diff while the diff of the PR at url is fetched
+func (m *Model) SetLoading(url string) {
+	m.url = url
+	m.files = nil
+	m.file = 0
+	m.hunk = 0
+	m.err = nil
+	m.isLoading = true
+	m.highlighted = map[int][][]string{}
+}
+
+// SetDiff sets the fetched diff of the PR at url. It's ignored if another PR
+// was selected in the meantime.
+func (m *Model) SetDiff(url string, diff string, err error) {
+	if url != m.url {
+		return
+	}
+	m.isLoading = false
+	m.err = err
+	if err == nil {
+		m.files = Parse(diff)
+	}
+}
+
+func (m *Model) SetWidth(width int) {
+	m.width = width
+}
+
+func (m *Model) NextFile() {
+	if m.file < len(m.files)-1 {
+		m.file++
+		m.hunk = 0
+	}
+}
+
+func (m *Model) PrevFile() {
+	if m.file > 0 {
+		m.file--
+		m.hunk = 0
+	}
+}
+
+// NextHunk moves to the next hunk, continuing to the next file after the last
+// one
+func (m *Model) NextHunk() {
+	if len(m.files) == 0 {
+		return
+	}
+	if m.hunk < len(m.files[m.file].Hunks)-1 {
+		m.hunk++
+		return
+	}
+	if m.file < len(m.files)-1 {
+		m.file++
+		m.hunk = 0
+	}
+}
+
+// PrevHunk moves to the previous hunk, continuing to the last hunk of the
+// previous file before the first one
+func (m *Model) PrevHunk() {
+	if len(m.files) == 0 {
+		return
+	}
+	if m.hunk > 0 {
+		m.hunk--
+		return
+	}
+	if m.file > 0 {
+		m.file--
+		m.hunk = max(0, len(m.files[m.file].Hunks)-1)
+	}
+}
+
+func (m Model) View() string {
+	view, _ := m.render()
+	return view
+}
+
+// HunkOffset is the line of View at which the current hunk starts
+func (m Model) HunkOffset() int {
+	_, offset := m.render()
+	return offset
+}
+
+func (m Model) render() (string, int) {
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+
+	if m.isLoading {
+		return faint.Render("Loading diff..."), 0
+	}
+	if m.err != nil {
+		return lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Width(m.width).
+			Render(fmt.Sprintf("Failed fetching diff: %v", m.err)), 0
+	}
+	if len(m.files) == 0 {
+		return faint.Render("No changes"), 0
+	}
+
+	lines := make([]string, 0)
+	lines = append(lines, m.renderFileList()...)
+	lines = append(lines, "")
+
+	file := m.files[m.file]
+	title := file.Path()
+	if file.IsRenamed() {
+		title = fmt.Sprintf("%s → %s", file.OldPath, file.NewPath)
+	}
+	lines = append(lines, m.truncate(lipgloss.NewStyle().Bold(true).Underline(true).
+		Foreground(m.ctx.Theme.PrimaryText).Render(title)))
+
+	if file.IsBinary {
+		lines = append(lines, "", faint.Render("Binary file not shown"))
+		return strings.Join(lines, "\n"), len(lines) - 1
+	}
+	if len(file.Hunks) == 0 {
+		lines = append(lines, "", faint.Render("No content changes"))
+		return strings.Join(lines, "\n"), len(lines) - 1
+	}
+
+	offset := 0
+	for i, hunk := range file.Hunks {
+		lines = append(lines, "")
+		if i == m.hunk {
+			offset = len(lines)
+		}
+		lines = append(lines, m.renderHunkHeader(hunk, i == m.hunk))
+		lines = append(lines, m.renderHunkLines(hunk, m.highlightedHunk(i))...)
+	}
+
+	return strings.Join(lines, "\n"), offset
+}
+
+func (m Model) renderFileList() []string {
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	additions := lipgloss.NewStyle().Foreground(m.ctx.Theme.SuccessText).Width(6)
+	deletions := lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Width(6)
+
+	lines := []string{faint.Render(fmt.Sprintf("File %d/%d", m.file+1, len(m.files)))}
+	for i, file := range m.files {
+		cursor := "  "
+		path := faint.Render(file.Path())
+		if i == m.file {
+			cursor = "> "
+			path = lipgloss.NewStyle().Bold(true).Foreground(m.ctx.Theme.PrimaryText).Render(file.Path())
+		}
+		lines = append(lines, m.truncate(lipgloss.JoinHorizontal(lipgloss.Top,
+			cursor,
+			additions.Render(fmt.Sprintf("+%d", file.Additions())),
+			deletions.Render(fmt.Sprintf("-%d", file.Deletions())),
+			path,
+		)))
+	}
+	return lines
+}
+
+func (m Model) renderHunkHeader(hunk Hunk, isCurrent bool) string {
+	style := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	marker := "  "
+	if isCurrent {
+		style = lipgloss.NewStyle().
+			Foreground(m.ctx.Theme.PrimaryText).
+			Background(m.ctx.Theme.SelectedBackground)
+		marker = "> "
+	}
+	return m.truncate(style.Render(marker + hunk.Header))
+}
+
+func (m Model) renderHunkLines(hunk Hunk, highlighted []string) []string {
+	gutter := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Width(5).Align(lipgloss.Right)
+	added := lipgloss.NewStyle().Foreground(m.ctx.Theme.SuccessText)
+	removed := lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText)
+	unchanged := lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText)
+
+	lines := make([]string, 0, len(hunk.Lines))
+	oldLine, newLine := hunk.OldStart, hunk.NewStart
+	for i, line := range hunk.Lines {
+		content := line.Content
+		if highlighted != nil {
+			content = highlighted[i]
+		}
+
+		var num int
+		var sign string
+		switch line.Kind {
+		case LineAdded:
+			num, sign = newLine, added.Render("+")
+			if highlighted == nil {
+				content = added.Render(content)
+			}
+			newLine++
+		case LineRemoved:
+			num, sign = oldLine, removed.Render("-")
+			if highlighted == nil {
+				content = removed.Render(content)
+			}
+			oldLine++
+		case LineMeta:
+			lines = append(lines, m.truncate(gutter.Render("")+"  "+
+				lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Italic(true).Render(line.Content)))
+			continue
+		default:
+			num, sign = newLine, " "
+			if highlighted == nil {
+				content = unchanged.Render(content)
+			}
+			oldLine++
+			newLine++
+		}
+
+		lines = append(lines, m.truncate(gutter.Render(fmt.Sprint(num))+" "+sign+content))
+	}
+	return lines
+}
+
+// highlightedHunk returns the syntax highlighted lines of a hunk of the
+// current file, or nil if the file couldn't be highlighted
+func (m Model) highlightedHunk(hunk int) []string {
+	hunks, ok := m.highlighted[m.file]
+	if !ok {
+		file := m.files[m.file]
+		hunks = make([][]string, len(file.Hunks))
+		for i, h := range file.Hunks {
+			hunks[i] = highlight(file.Path(), h.Lines)
+		}
+		m.highlighted[m.file] = hunks
+	}
+	return hunks[hunk]
+}
+
+func highlight(path string, lines []Line) []string {
+	source := make([]string, len(lines))
+	for i, l := range lines {
+		source[i] = strings.ReplaceAll(l.Content, "\t", "    ")
+	}
+
+	var b strings.Builder
+	err := quick.Highlight(&b, strings.Join(source, "\n"), path, "terminal256", markdown.SyntaxStyle())
+	if err != nil {
+		return nil
+	}
+
+	highlighted := strings.Split(b.String(), "\n")
+	// the formatter may end with a reset sequence on a line of its own
+	for len(highlighted) > len(lines) && ansi.Strip(highlighted[len(highlighted)-1]) == "" {
+		highlighted = highlighted[:len(highlighted)-1]
+	}
+	if len(highlighted) != len(lines) {
+		return nil
+	}
+	return highlighted
+}
+
+func (m Model) truncate(s string) string {
+	if m.width <= 0 {
+		return s
+	}
+	return ansi.Truncate(strings.ReplaceAll(s, "\t", "    "), m.width, "…")
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
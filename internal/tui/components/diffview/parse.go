@@ -0,0 +1,181 @@
+package diffview
+
+import (
+	"strconv"
+	"strings"
+)
+
+type LineKind int
+
+const (
+	LineContext LineKind = iota
+	LineAdded
+	LineRemoved
+	// LineMeta is a "\ No newline at end of file" marker
+	LineMeta
+)
+
+type Line struct {
+	Kind    LineKind
+	Content string // The line without its +/-/space prefix
+}
+
+type Hunk struct {
+	Header   string // e.g. "@@ -10,7 +10,8 @@ func main() {"
+	OldStart int
+	NewStart int
+	Lines    []Line
+}
+
+type File struct {
+	OldPath  string // Empty for added files
+	NewPath  string // Empty for deleted files
+	IsBinary bool
+	Hunks    []Hunk
+}
+
+// Path is the path of the file after the change, or before it if the file was
+// deleted
+func (f File) Path() string {
+	if f.NewPath == "" {
+		return f.OldPath
+	}
+	return f.NewPath
+}
+
+func (f File) IsRenamed() bool {
+	return f.OldPath != "" && f.NewPath != "" && f.OldPath != f.NewPath
+}
+
+func (f File) Additions() int {
+	return f.count(LineAdded)
+}
+
+func (f File) Deletions() int {
+	return f.count(LineRemoved)
+}
+
+func (f File) count(kind LineKind) int {
+	n := 0
+	for _, h := range f.Hunks {
+		for _, l := range h.Lines {
+			if l.Kind == kind {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Parse splits a unified diff, as produced by git, into its files and hunks
+func Parse(diff string) []File {
+	var files []File
+	var file *File
+	var hunk *Hunk
+
+	flushHunk := func() {
+		if file != nil && hunk != nil {
+			file.Hunks = append(file.Hunks, *hunk)
+		}
+		hunk = nil
+	}
+	flushFile := func() {
+		flushHunk()
+		if file != nil {
+			files = append(files, *file)
+		}
+		file = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(diff, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(line, "diff --git ") {
+			flushFile()
+			file = &File{}
+			file.OldPath, file.NewPath = parseGitPaths(strings.TrimPrefix(line, "diff --git "))
+			continue
+		}
+		if file == nil {
+			continue
+		}
+
+		if strings.HasPrefix(line, "@@ ") {
+			flushHunk()
+			oldStart, newStart := parseHunkHeader(line)
+			hunk = &Hunk{Header: line, OldStart: oldStart, NewStart: newStart}
+			continue
+		}
+
+		if hunk != nil {
+			switch {
+			case strings.HasPrefix(line, "+"):
+				hunk.Lines = append(hunk.Lines, Line{Kind: LineAdded, Content: line[1:]})
+			case strings.HasPrefix(line, "-"):
+				hunk.Lines = append(hunk.Lines, Line{Kind: LineRemoved, Content: line[1:]})
+			case strings.HasPrefix(line, " "):
+				hunk.Lines = append(hunk.Lines, Line{Kind: LineContext, Content: line[1:]})
+			case strings.HasPrefix(line, `\`):
+				hunk.Lines = append(hunk.Lines, Line{Kind: LineMeta, Content: line})
+			}
+			continue
+		}
+
+		// extended header lines, before the first hunk
+		switch {
+		case strings.HasPrefix(line, "--- "):
+			file.OldPath = parseHeaderPath(strings.TrimPrefix(line, "--- "), "a/")
+		case strings.HasPrefix(line, "+++ "):
+			file.NewPath = parseHeaderPath(strings.TrimPrefix(line, "+++ "), "b/")
+		case strings.HasPrefix(line, "new file mode"):
+			file.OldPath = ""
+		case strings.HasPrefix(line, "deleted file mode"):
+			file.NewPath = ""
+		case strings.HasPrefix(line, "rename from "):
+			file.OldPath = strings.TrimPrefix(line, "rename from ")
+		case strings.HasPrefix(line, "rename to "):
+			file.NewPath = strings.TrimPrefix(line, "rename to ")
+		case strings.HasPrefix(line, "Binary files "):
+			file.IsBinary = true
+		}
+	}
+	flushFile()
+
+	return files
+}
+
+// parseGitPaths parses the "a/old b/new" part of a "diff --git" line. It's
+// ambiguous when paths contain " b/", the ---/+++ lines that follow override it.
+func parseGitPaths(s string) (string, string) {
+	i := strings.LastIndex(s, " b/")
+	if i < 0 || !strings.HasPrefix(s, "a/") {
+		return "", ""
+	}
+	return s[len("a/"):i], s[i+len(" b/"):]
+}
+
+func parseHeaderPath(s string, prefix string) string {
+	s, _, _ = strings.Cut(s, "\t")
+	if s == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(s, prefix)
+}
+
+// parseHunkHeader returns the starting line numbers of a
+// "@@ -old,count +new,count @@" header
+func parseHunkHeader(header string) (int, int) {
+	fields := strings.Fields(header)
+	if len(fields) < 3 {
+		return 0, 0
+	}
+	return parseRangeStart(fields[1], "-"), parseRangeStart(fields[2], "+")
+}
+
+func parseRangeStart(r string, prefix string) int {
+	r = strings.TrimPrefix(r, prefix)
+	start, _, _ := strings.Cut(r, ",")
+	n, err := strconv.Atoi(start)
+	if err != nil {
+		return 0
+	}
+	return n
+}
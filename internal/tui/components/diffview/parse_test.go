@@ -0,0 +1,159 @@
+package diffview
+
+import (
+	"reflect"
+	"testing"
+)
+
+const sampleDiff = `diff --git a/main.go b/main.go
+index 83db48f..bf269f4 100644
+--- a/main.go
++++ b/main.go
+@@ -1,4 +1,5 @@ package main
+ import "fmt"
+-func main() {
++
++func main() {
+ 	fmt.Println("hi")
+@@ -10,2 +11,2 @@ func helper() {
+--- a comment that looks like a header
++// a comment
+ }
+\ No newline at end of file
+diff --git a/docs/new.md b/docs/new.md
+new file mode 100644
+index 0000000..e69de29
+--- /dev/null
++++ b/docs/new.md
+@@ -0,0 +1 @@
++# New
+diff --git a/old.txt b/old.txt
+deleted file mode 100644
+index e69de29..0000000
+--- a/old.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+diff --git a/a.go b/b.go
+similarity index 100%
+rename from a.go
+rename to b.go
+diff --git a/logo.png b/logo.png
+index 1111111..2222222 100644
+Binary files a/logo.png and b/logo.png differ
+`
+
+func TestParse(t *testing.T) {
+	files := Parse(sampleDiff)
+
+	type summary struct {
+		OldPath   string
+		NewPath   string
+		Path      string
+		IsBinary  bool
+		IsRenamed bool
+		Hunks     int
+		Additions int
+		Deletions int
+	}
+	got := make([]summary, 0, len(files))
+	for _, f := range files {
+		got = append(got, summary{
+			OldPath:   f.OldPath,
+			NewPath:   f.NewPath,
+			Path:      f.Path(),
+			IsBinary:  f.IsBinary,
+			IsRenamed: f.IsRenamed(),
+			Hunks:     len(f.Hunks),
+			Additions: f.Additions(),
+			Deletions: f.Deletions(),
+		})
+	}
+
+	want := []summary{
+		{OldPath: "main.go", NewPath: "main.go", Path: "main.go", Hunks: 2, Additions: 3, Deletions: 2},
+		{NewPath: "docs/new.md", Path: "docs/new.md", Hunks: 1, Additions: 1},
+		{OldPath: "old.txt", Path: "old.txt", Hunks: 1, Deletions: 1},
+		{OldPath: "a.go", NewPath: "b.go", Path: "b.go", IsRenamed: true},
+		{OldPath: "logo.png", NewPath: "logo.png", Path: "logo.png", IsBinary: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	hunk := files[0].Hunks[1]
+	if hunk.OldStart != 10 || hunk.NewStart != 11 {
+		t.Errorf("hunk starts = %d,%d, want 10,11", hunk.OldStart, hunk.NewStart)
+	}
+	wantLines := []Line{
+		{Kind: LineRemoved, Content: "-- a comment that looks like a header"},
+		{Kind: LineAdded, Content: "// a comment"},
+		{Kind: LineContext, Content: "}"},
+		{Kind: LineMeta, Content: `\ No newline at end of file`},
+	}
+	if !reflect.DeepEqual(hunk.Lines, wantLines) {
+		t.Errorf("hunk lines = %+v, want %+v", hunk.Lines, wantLines)
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	tests := []struct {
+		name     string
+		moves    func(m *Model)
+		wantFile int
+		wantHunk int
+	}{
+		{
+			name:     "next hunk within file",
+			moves:    func(m *Model) { m.NextHunk() },
+			wantFile: 0,
+			wantHunk: 1,
+		},
+		{
+			name:     "next hunk continues to next file",
+			moves:    func(m *Model) { m.NextHunk(); m.NextHunk() },
+			wantFile: 1,
+			wantHunk: 0,
+		},
+		{
+			name:     "prev hunk continues to last hunk of prev file",
+			moves:    func(m *Model) { m.NextFile(); m.PrevHunk() },
+			wantFile: 0,
+			wantHunk: 1,
+		},
+		{
+			name:     "prev file resets hunk",
+			moves:    func(m *Model) { m.NextHunk(); m.NextFile(); m.PrevFile() },
+			wantFile: 0,
+			wantHunk: 0,
+		},
+		{
+			name: "stops at the last file",
+			moves: func(m *Model) {
+				for range 10 {
+					m.NextFile()
+				}
+			},
+			wantFile: 4,
+			wantHunk: 0,
+		},
+		{
+			name:     "stops at the first hunk",
+			moves:    func(m *Model) { m.PrevHunk(); m.PrevFile() },
+			wantFile: 0,
+			wantHunk: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel(nil)
+			m.SetLoading("url")
+			m.SetDiff("url", sampleDiff, nil)
+			tt.moves(&m)
+			if m.file != tt.wantFile || m.hunk != tt.wantHunk {
+				t.Errorf("at file %d hunk %d, want file %d hunk %d", m.file, m.hunk, tt.wantFile, tt.wantHunk)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package discussionrow
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+type Discussion struct {
+	Ctx  *context.ProgramContext
+	Data data.DiscussionData
+}
+
+func (d *Discussion) ToTableRow() table.Row {
+	return table.Row{
+		d.renderAnswered(),
+		d.renderRepoName(),
+		d.renderTitle(),
+		d.renderCategory(),
+		d.renderAuthor(),
+		d.renderUpvotes(),
+		d.renderNumComments(),
+		d.renderUpdateAt(),
+	}
+}
+
+func (d *Discussion) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(d.Ctx)
+}
+
+func (d *Discussion) renderAnswered() string {
+	if d.Data.IsAnswered {
+		return lipgloss.NewStyle().Foreground(d.Ctx.Theme.SuccessText).Render(constants.SuccessIcon)
+	}
+	return lipgloss.NewStyle().Foreground(d.Ctx.Theme.FaintText).Render(constants.EmptyIcon)
+}
+
+func (d *Discussion) renderRepoName() string {
+	return d.getTextStyle().Render(d.Data.Repository.Name)
+}
+
+func (d *Discussion) renderTitle() string {
+	state := "OPEN"
+	if d.Data.Closed {
+		state = "CLOSED"
+	}
+	return components.RenderIssueTitle(d.Ctx, state, d.Data.Title, d.Data.Number, false)
+}
+
+func (d *Discussion) renderCategory() string {
+	return d.getTextStyle().Render(d.Data.Category.Name)
+}
+
+func (d *Discussion) renderAuthor() string {
+	return d.getTextStyle().Render(d.Data.Author.Login)
+}
+
+func (d *Discussion) renderUpvotes() string {
+	return d.getTextStyle().Render(fmt.Sprintf("%d", d.Data.UpvoteCount))
+}
+
+func (d *Discussion) renderNumComments() string {
+	return d.getTextStyle().Render(fmt.Sprintf("%d", d.Data.Comments.TotalCount))
+}
+
+func (d *Discussion) renderUpdateAt() string {
+	timeFormat := d.Ctx.Config.Defaults.DateFormat
+
+	updatedAtOutput := ""
+	if timeFormat == "" || timeFormat == "relative" {
+		updatedAtOutput = utils.TimeElapsed(d.Data.UpdatedAt)
+	} else {
+		updatedAtOutput = d.Data.UpdatedAt.Format(timeFormat)
+	}
+
+	return d.getTextStyle().Render(updatedAtOutput)
+}
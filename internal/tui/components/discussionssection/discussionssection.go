@@ -0,0 +1,361 @@
+package discussionssection
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "discussion"
+
+type Model struct {
+	section.BaseModel
+	Discussions []data.DiscussionData
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.DiscussionsSectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:          id,
+			Config:      cfg.ToSectionConfig(),
+			Type:        SectionType,
+			Columns:     GetSectionColumns(cfg, ctx),
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	m.Discussions = []data.DiscussionData{}
+	m.MergesRepoFilters = true
+
+	return m
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+
+		if m.IsSearchFocused() {
+			if m.SearchBar.IsOverlayOpen() {
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+
+			switch msg.Type {
+			case tea.KeyCtrlC, tea.KeyEsc:
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case tea.KeyEnter:
+				m.SearchValue = m.SearchBar.Value()
+				historyCmd := m.SearchBar.AddToHistory(m.SearchValue)
+				m.SetIsSearching(false)
+				m.ResetRows()
+				return m, tea.Batch(append(m.FetchNextPageSectionRows(), historyCmd)...)
+
+			default:
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+		}
+
+	case UpdateDiscussionMsg:
+		for i, currDiscussion := range m.Discussions {
+			if currDiscussion.Id == msg.DiscussionId {
+				if msg.NewComment != nil {
+					currDiscussion.Comments.Nodes = append(currDiscussion.Comments.Nodes, *msg.NewComment)
+					currDiscussion.Comments.TotalCount++
+				}
+				if msg.AnswerId != nil {
+					currDiscussion.SetAnswer(*msg.AnswerId)
+				}
+				m.Discussions[i] = currDiscussion
+				m.Table.SetRows(m.BuildRows())
+				break
+			}
+		}
+
+	case SectionDiscussionsFetchedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			if m.PageInfo != nil {
+				m.Discussions = append(m.Discussions, msg.Discussions...)
+			} else {
+				m.Discussions = msg.Discussions
+			}
+			m.TotalCount = msg.TotalCount
+			m.SetIsLoading(false)
+			m.IsRefreshing = false
+			m.PageInfo = &msg.PageInfo
+			m.Table.SetRows(m.BuildRows())
+			m.UpdateLastUpdated(time.Now())
+			m.UpdateTotalItemsCount(m.TotalCount)
+		}
+	}
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(cmd, searchCmd, tableCmd)
+}
+
+func GetSectionColumns(
+	cfg config.DiscussionsSectionConfig,
+	ctx *context.ProgramContext,
+) []table.Column {
+	dLayout := ctx.Config.Defaults.Layout.Discussions
+	sLayout := cfg.Layout
+
+	updatedAtLayout := config.MergeColumnConfigs(
+		dLayout.UpdatedAt,
+		sLayout.UpdatedAt,
+	)
+	answeredLayout := config.MergeColumnConfigs(dLayout.Answered, sLayout.Answered)
+	repoLayout := config.MergeColumnConfigs(dLayout.Repo, sLayout.Repo)
+	titleLayout := config.MergeColumnConfigs(dLayout.Title, sLayout.Title)
+	categoryLayout := config.MergeColumnConfigs(dLayout.Category, sLayout.Category)
+	authorLayout := config.MergeColumnConfigs(dLayout.Author, sLayout.Author)
+	upvotesLayout := config.MergeColumnConfigs(dLayout.Upvotes, sLayout.Upvotes)
+	commentsLayout := config.MergeColumnConfigs(dLayout.Comments, sLayout.Comments)
+
+	return []table.Column{
+		{
+			Title:  "",
+			Width:  answeredLayout.Width,
+			Hidden: answeredLayout.Hidden,
+		},
+		{
+			Title:  "",
+			Width:  repoLayout.Width,
+			Hidden: repoLayout.Hidden,
+		},
+		{
+			Title:  "Title",
+			Grow:   utils.BoolPtr(true),
+			Hidden: titleLayout.Hidden,
+		},
+		{
+			Title:  "Category",
+			Width:  categoryLayout.Width,
+			Hidden: categoryLayout.Hidden,
+		},
+		{
+			Title:  "Author",
+			Width:  authorLayout.Width,
+			Hidden: authorLayout.Hidden,
+		},
+		{
+			Title:  "",
+			Width:  upvotesLayout.Width,
+			Hidden: upvotesLayout.Hidden,
+		},
+		{
+			Title:  constants.CommentsIcon,
+			Width:  commentsLayout.Width,
+			Hidden: commentsLayout.Hidden,
+		},
+		{
+			Title:  "󱦻",
+			Width:  updatedAtLayout.Width,
+			Hidden: updatedAtLayout.Hidden,
+		},
+	}
+}
+
+func (m Model) BuildRows() []table.Row {
+	var rows []table.Row
+	for _, currDiscussion := range m.Discussions {
+		discussionModel := discussionrow.Discussion{Ctx: m.Ctx, Data: currDiscussion}
+		rows = append(rows, discussionModel.ToTableRow())
+	}
+
+	if rows == nil {
+		rows = []table.Row{}
+	}
+
+	return rows
+}
+
+func (m *Model) NumRows() int {
+	return len(m.Discussions)
+}
+
+func (m *Model) GetCurrRow() data.RowData {
+	if len(m.Discussions) == 0 {
+		return nil
+	}
+	discussion := m.Discussions[m.Table.GetCurrItem()]
+	return &discussion
+}
+
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	startCursor := time.Now().String()
+	if m.PageInfo != nil {
+		startCursor = m.PageInfo.StartCursor
+	}
+	taskId := fmt.Sprintf("fetching_discussions_%d_%s", m.Id, startCursor)
+	m.LastFetchTaskId = taskId
+	task := context.Task{
+		Id:        taskId,
+		StartText: fmt.Sprintf(`Fetching discussions for "%s"`, m.Config.Title),
+		FinishedText: fmt.Sprintf(
+			`Discussions for "%s" have been fetched`,
+			m.Config.Title,
+		),
+		State: context.TaskStart,
+		Error: nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	fetchCmd := func() tea.Msg {
+		limit := m.Config.Limit
+		if limit == nil {
+			limit = &m.Ctx.Config.Defaults.DiscussionsLimit
+		}
+		res, err := data.FetchDiscussions(m.GetFilters(), *limit, m.PageInfo)
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionDiscussionsFetchedMsg{
+				Discussions: res.Discussions,
+				TotalCount:  res.TotalCount,
+				PageInfo:    res.PageInfo,
+				TaskId:      taskId,
+			},
+		}
+	}
+	cmds = append(cmds, fetchCmd)
+
+	return cmds
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+}
+
+func (m *Model) ResetRows() {
+	m.Discussions = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := ctx.Config.DiscussionsSections
+	fetchDiscussionsCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1, // 0 is the search section
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		)
+		sections = append(sections, &sectionModel)
+		fetchDiscussionsCmds = append(
+			fetchDiscussionsCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchDiscussionsCmds...)
+}
+
+type SectionDiscussionsFetchedMsg struct {
+	Discussions []data.DiscussionData
+	TotalCount  int
+	PageInfo    data.PageInfo
+	TaskId      string
+}
+
+// UpdateDiscussionMsg is sent when the discussion with DiscussionId was
+// commented on or its answer was marked
+type UpdateDiscussionMsg struct {
+	DiscussionId string
+	NewComment   *data.DiscussionComment
+	AnswerId     *string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Discussion"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Discussions"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m Model) GetPagerContent() string {
+	pagerContent := ""
+	if m.TotalCount > 0 {
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v/%v • Fetched %v",
+			constants.WaitingIcon,
+			m.LastUpdated().Format("01/02 15:04:05"),
+			m.SingularForm,
+			m.Table.GetCurrItem()+1,
+			m.TotalCount,
+			len(m.Table.Rows),
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
@@ -0,0 +1,66 @@
+package discussionview
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func (m *Model) comment(body string) tea.Cmd {
+	discussion := *m.discussion
+	taskId := fmt.Sprintf("discussion_comment_%s", discussion.Id)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Commenting on discussion #%d", discussion.Number),
+		FinishedText: fmt.Sprintf("Commented on discussion #%d", discussion.Number),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		comment, err := data.AddDiscussionComment(discussion.Id, body)
+		msg := discussionssection.UpdateDiscussionMsg{DiscussionId: discussion.Id}
+		if err == nil {
+			msg.NewComment = &comment
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: discussionssection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         msg,
+		}
+	})
+}
+
+func (m *Model) markAnswer(comment data.DiscussionComment) tea.Cmd {
+	discussion := *m.discussion
+	taskId := fmt.Sprintf("discussion_answer_%s", discussion.Id)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Marking the answer of discussion #%d", discussion.Number),
+		FinishedText: fmt.Sprintf("The comment of %s answers discussion #%d", comment.Author.Login, discussion.Number),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.MarkDiscussionAnswer(comment.Id)
+		msg := discussionssection.UpdateDiscussionMsg{DiscussionId: discussion.Id}
+		if err == nil {
+			msg.AnswerId = &comment.Id
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: discussionssection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         msg,
+		}
+	})
+}
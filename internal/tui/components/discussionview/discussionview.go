@@ -0,0 +1,255 @@
+package discussionview
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// Model is the sidebar of the discussions view, it shows the selected
+// discussion with its comments numbered so one can be marked as the answer
+type Model struct {
+	ctx        *context.ProgramContext
+	discussion *data.DiscussionData
+	sectionId  int
+	width      int
+
+	isCommenting    bool
+	isMarkingAnswer bool
+
+	inputBox inputbox.Model
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	inputBox := inputbox.NewModel(ctx)
+	inputBox.SetHeight(common.InputBoxHeight)
+
+	return Model{
+		ctx:      ctx,
+		inputBox: inputBox,
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var (
+		cmd   tea.Cmd
+		taCmd tea.Cmd
+	)
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !m.IsTextInputBoxFocused() {
+		return m, nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyCtrlD:
+		if m.isCommenting {
+			if len(strings.TrimSpace(m.inputBox.Value())) != 0 {
+				cmd = m.comment(m.inputBox.Value())
+			}
+		} else {
+			comment, err := m.commentByNumber(m.inputBox.Value())
+			if err != nil {
+				m.inputBox.SetPrompt(lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(err.Error()))
+				return m, nil
+			}
+			cmd = m.markAnswer(comment)
+		}
+		m.inputBox.Blur()
+		m.isCommenting = false
+		m.isMarkingAnswer = false
+		return m, cmd
+
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.inputBox.Blur()
+		m.isCommenting = false
+		m.isMarkingAnswer = false
+		return m, nil
+	}
+
+	m.inputBox, taCmd = m.inputBox.Update(msg)
+	return m, taCmd
+}
+
+// commentByNumber returns the comment numbered s in the sidebar
+func (m *Model) commentByNumber(s string) (data.DiscussionComment, error) {
+	comments := m.discussion.Comments.Nodes
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || n < 1 || n > len(comments) {
+		return data.DiscussionComment{}, fmt.Errorf("enter a comment number from 1 to %d", len(comments))
+	}
+	return comments[n-1], nil
+}
+
+func (m Model) View() string {
+	if m.discussion == nil {
+		return ""
+	}
+
+	s := strings.Builder{}
+
+	s.WriteString(lipgloss.NewStyle().
+		Foreground(m.ctx.Theme.SecondaryText).
+		Render(fmt.Sprintf("#%d · %s", m.discussion.Number, m.discussion.GetRepoNameWithOwner())))
+	s.WriteString("\n")
+	s.WriteString(m.ctx.Styles.Common.MainTextStyle.Width(m.getIndentedContentWidth()).
+		Render(m.discussion.Title))
+	s.WriteString("\n\n")
+	s.WriteString(m.renderSummary())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderBody())
+	s.WriteString("\n\n")
+	s.WriteString(m.renderComments())
+
+	if m.IsTextInputBoxFocused() {
+		s.WriteString(m.inputBox.View())
+	}
+
+	return lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).Render(s.String())
+}
+
+func (m *Model) renderSummary() string {
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	answered := faint.Render("Unanswered")
+	if m.discussion.IsAnswered {
+		answered = lipgloss.NewStyle().Foreground(m.ctx.Theme.SuccessText).
+			Render(constants.SuccessIcon + " Answered")
+	}
+	return strings.Join([]string{
+		m.ctx.Styles.Common.MainTextStyle.Render(m.discussion.Category.Name),
+		answered,
+		faint.Render(fmt.Sprintf("%d upvotes", m.discussion.UpvoteCount)),
+		faint.Render("by " + m.discussion.Author.Login),
+	}, faint.Render(" · "))
+}
+
+func (m *Model) renderBody() string {
+	width := m.getIndentedContentWidth()
+	body := strings.TrimSpace(m.discussion.Body)
+	if body == "" {
+		return lipgloss.NewStyle().Italic(true).Foreground(m.ctx.Theme.FaintText).Render("No description provided.")
+	}
+
+	markdownRenderer := m.ctx.ReadingRenderer(width)
+	rendered, err := markdownRenderer.Render(body)
+	if err != nil {
+		return ""
+	}
+	return lipgloss.NewStyle().Width(width).MaxWidth(width).Render(rendered)
+}
+
+func (m *Model) renderComments() string {
+	title := m.ctx.Styles.Common.MainTextStyle.
+		MarginBottom(1).
+		Underline(true).
+		Render(" Comments")
+
+	comments := m.discussion.Comments
+	if len(comments.Nodes) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, title,
+			lipgloss.NewStyle().PaddingLeft(2).Italic(true).Render("No comments..."))
+	}
+
+	markdownRenderer := m.ctx.ReadingRenderer(m.getIndentedContentWidth() - 2)
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	var rendered []string
+	if hidden := comments.TotalCount - len(comments.Nodes); hidden > 0 {
+		rendered = append(rendered, faint.Italic(true).Render(
+			fmt.Sprintf("Showing the latest %d of %d comments", len(comments.Nodes), comments.TotalCount)), "")
+	}
+	for i, comment := range comments.Nodes {
+		header := []string{
+			faint.Render(fmt.Sprintf("%d.", i+1)),
+			m.ctx.Styles.Common.MainTextStyle.Render(comment.Author.Login),
+			faint.Render(utils.TimeElapsed(comment.UpdatedAt)),
+		}
+		if comment.IsAnswer {
+			header = append(header, lipgloss.NewStyle().Foreground(m.ctx.Theme.SuccessText).
+				Render(constants.SuccessIcon+" Answer"))
+		}
+		body, err := markdownRenderer.Render(comment.Body)
+		if err != nil {
+			continue
+		}
+		rendered = append(rendered, strings.Join(header, " "), body)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, title,
+		lipgloss.NewStyle().PaddingLeft(2).Render(lipgloss.JoinVertical(lipgloss.Left, rendered...)))
+}
+
+func (m *Model) getIndentedContentWidth() int {
+	return m.width - 6
+}
+
+func (m *Model) SetWidth(width int) {
+	m.width = width
+	m.inputBox.SetWidth(width)
+}
+
+func (m *Model) SetSectionId(id int) {
+	m.sectionId = id
+}
+
+func (m *Model) SetRow(d *data.DiscussionData) {
+	m.discussion = d
+}
+
+func (m *Model) IsTextInputBoxFocused() bool {
+	return m.isCommenting || m.isMarkingAnswer
+}
+
+// HasDraft returns whether a comment is being written, with some text
+func (m *Model) HasDraft() bool {
+	return m.isCommenting && strings.TrimSpace(m.inputBox.Value()) != ""
+}
+
+func (m *Model) SetIsCommenting(isCommenting bool) tea.Cmd {
+	if m.discussion == nil {
+		return nil
+	}
+
+	if !m.isCommenting && isCommenting {
+		m.inputBox.Reset()
+	}
+	m.isCommenting = isCommenting
+	m.inputBox.SetPrompt("Leave a comment...")
+
+	if isCommenting {
+		return tea.Sequence(textarea.Blink, m.inputBox.Focus())
+	}
+	return nil
+}
+
+func (m *Model) SetIsMarkingAnswer(isMarkingAnswer bool) tea.Cmd {
+	if m.discussion == nil || len(m.discussion.Comments.Nodes) == 0 {
+		return nil
+	}
+
+	if !m.isMarkingAnswer && isMarkingAnswer {
+		m.inputBox.Reset()
+	}
+	m.isMarkingAnswer = isMarkingAnswer
+	m.inputBox.SetPrompt("Number of the comment answering the discussion...")
+
+	if isMarkingAnswer {
+		return tea.Sequence(textarea.Blink, m.inputBox.Focus())
+	}
+	return nil
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+	m.inputBox.UpdateProgramContext(ctx)
+}
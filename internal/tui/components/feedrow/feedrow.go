@@ -0,0 +1,102 @@
+package feedrow
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+type Entry struct {
+	Ctx  *context.ProgramContext
+	Data data.FeedEntry
+	// Unread renders the title in bold, for the entries that weren't read
+	Unread bool
+}
+
+func (entry *Entry) ToTableRow() table.Row {
+	return table.Row{
+		entry.renderFeed(),
+		entry.renderTitle(),
+		entry.renderAuthor(),
+		entry.renderUpdatedAt(),
+	}
+}
+
+func (entry *Entry) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(entry.Ctx)
+}
+
+func (entry *Entry) renderFeed() string {
+	return lipgloss.NewStyle().Foreground(entry.Ctx.Theme.FaintText).Render(entry.Data.Feed)
+}
+
+func (entry *Entry) renderTitle() string {
+	return entry.getTextStyle().Bold(entry.Unread).Render(entry.Data.Title)
+}
+
+func (entry *Entry) renderAuthor() string {
+	return entry.getTextStyle().Render(entry.Data.Author)
+}
+
+func (entry *Entry) renderUpdatedAt() string {
+	if entry.Data.UpdatedAt.IsZero() {
+		return entry.getTextStyle().Render("-")
+	}
+
+	timeFormat := entry.Ctx.Config.Defaults.DateFormat
+	updatedAtOutput := ""
+	if timeFormat == "" || timeFormat == "relative" {
+		updatedAtOutput = utils.TimeElapsed(entry.Data.UpdatedAt)
+	} else {
+		updatedAtOutput = entry.Data.UpdatedAt.Format(timeFormat)
+	}
+
+	return entry.getTextStyle().Render(updatedAtOutput)
+}
+
+// RenderDetails renders the entry for the sidebar
+func (entry *Entry) RenderDetails(width int) string {
+	labelStyle := lipgloss.NewStyle().Foreground(entry.Ctx.Theme.FaintText).Width(12)
+	valueStyle := entry.getTextStyle()
+
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(entry.Ctx.Theme.PrimaryText).
+		Width(width).
+		Render(entry.Data.Title)
+
+	fields := [][2]string{
+		{"Feed", entry.Data.Feed},
+		{"Author", entry.Data.Author},
+	}
+	if !entry.Data.PublishedAt.IsZero() {
+		fields = append(fields, [2]string{"Published", entry.Data.PublishedAt.Local().Format("2006-01-02 15:04")})
+	}
+	if !entry.Data.UpdatedAt.Equal(entry.Data.PublishedAt) {
+		fields = append(fields, [2]string{"Updated", entry.Data.UpdatedAt.Local().Format("2006-01-02 15:04")})
+	}
+
+	lines := []string{title, ""}
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render(f[0]), valueStyle.Render(f[1])))
+	}
+	if entry.Data.Url != "" {
+		lines = append(lines, "", lipgloss.NewStyle().
+			Foreground(entry.Ctx.Theme.SecondaryText).
+			Width(width).
+			Render(entry.Data.Url))
+	}
+	if entry.Data.Summary != "" {
+		lines = append(lines, "", valueStyle.Width(width).Render(entry.Data.Summary))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
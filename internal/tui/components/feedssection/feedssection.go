@@ -0,0 +1,356 @@
+package feedssection
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/feedrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "feed"
+
+type Model struct {
+	section.BaseModel
+	// Urls are the feeds the section lists, the search section lists all of
+	// the configured ones
+	Urls []string
+	// Entries are the fetched entries, newest first
+	Entries []data.FeedEntry
+	// visible are the entries matching the search
+	visible []data.FeedEntry
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.FeedsSectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:          id,
+			Config:      cfg.ToSectionConfig(),
+			Type:        SectionType,
+			Columns:     GetSectionColumns(ctx),
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	// feeds are searched locally, the repo filters of smart filtering don't
+	// apply to them
+	m.SearchValue = ""
+	m.SearchBar.SetValue("")
+	m.IsFilteredByCurrentRemote = false
+	m.FilterTarget = section.FilterTargetNone
+
+	if cfg.Url != "" {
+		m.Urls = []string{cfg.Url}
+	} else {
+		for _, feed := range ctx.Config.FeedsSections {
+			m.Urls = append(m.Urls, feed.Url)
+		}
+	}
+
+	return m
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.IsSearchFocused() {
+			if m.SearchBar.IsOverlayOpen() {
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+
+			switch msg.Type {
+			case tea.KeyCtrlC, tea.KeyEsc:
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case tea.KeyEnter:
+				m.SearchValue = m.SearchBar.Value()
+				historyCmd := m.SearchBar.AddToHistory(m.SearchValue)
+				m.SetIsSearching(false)
+				m.Table.ResetCurrItem()
+				m.SyncReadRows()
+				return m, historyCmd
+
+			default:
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+		}
+
+	case SectionFeedFetchedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			m.Entries = msg.Entries
+			m.SetIsLoading(false)
+			m.IsRefreshing = false
+			m.PageInfo = &data.PageInfo{HasNextPage: false}
+			m.SyncReadRows()
+			m.UpdateLastUpdated(time.Now())
+		}
+	}
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(searchCmd, tableCmd)
+}
+
+func GetSectionColumns(ctx *context.ProgramContext) []table.Column {
+	return []table.Column{
+		{
+			Title: "Feed",
+			Width: utils.IntPtr(20),
+		},
+		{
+			Title: "Title",
+			Grow:  utils.BoolPtr(true),
+		},
+		{
+			Title: "Author",
+			Width: utils.IntPtr(15),
+		},
+		{
+			Title: "󱦻",
+			Width: utils.IntPtr(lipgloss.Width("2mo  ")),
+		},
+	}
+}
+
+// filterEntries returns the entries whose title, summary or author contain
+// every word of query, ignoring case
+func filterEntries(entries []data.FeedEntry, query string) []data.FeedEntry {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return entries
+	}
+
+	var filtered []data.FeedEntry
+	for _, entry := range entries {
+		text := strings.ToLower(strings.Join([]string{entry.Title, entry.Summary, entry.Author}, "\n"))
+		matches := true
+		for _, word := range words {
+			if !strings.Contains(text, word) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+func (m Model) BuildRows() []table.Row {
+	rows := []table.Row{}
+	for _, currEntry := range m.visible {
+		entryModel := feedrow.Entry{Ctx: m.Ctx, Data: currEntry, Unread: m.isUnread(currEntry)}
+		rows = append(rows, entryModel.ToTableRow())
+	}
+	return rows
+}
+
+func (m *Model) NumRows() int {
+	return len(m.visible)
+}
+
+// IsInbox returns whether the read entries are tracked, which they are unless
+// the dashboard is read-only
+func (m *Model) IsInbox() bool {
+	return m.Ctx.ReadItems != nil
+}
+
+func (m *Model) isUnread(entry data.FeedEntry) bool {
+	return m.IsInbox() && m.Ctx.ReadItems.IsUnread(entry.GetUrl(), entry.GetUpdatedAt())
+}
+
+func (m *Model) UnreadRows() []int {
+	var unread []int
+	for i, entry := range m.visible {
+		if m.isUnread(entry) {
+			unread = append(unread, i)
+		}
+	}
+	return unread
+}
+
+// SyncReadRows rebuilds the rows from the entries matching the search
+func (m *Model) SyncReadRows() {
+	m.visible = filterEntries(m.Entries, m.SearchValue)
+	m.TotalCount = len(m.visible)
+	m.Table.SetRows(m.BuildRows())
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+func (m *Model) GetCurrRow() data.RowData {
+	if len(m.visible) == 0 {
+		return nil
+	}
+	entry := m.visible[m.Table.GetCurrItem()]
+	return &entry
+}
+
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	taskId := fmt.Sprintf("fetching_feed_%d_%s", m.Id, time.Now().String())
+	m.LastFetchTaskId = taskId
+	title := m.Config.Title
+	if title == "" {
+		title = "all feeds"
+	}
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf(`Fetching "%s"`, title),
+		FinishedText: fmt.Sprintf(`"%s" has been fetched`, title),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	urls := m.Urls
+	fetchCmd := func() tea.Msg {
+		limit := m.Config.Limit
+		if limit == nil {
+			limit = &m.Ctx.Config.Defaults.FeedsLimit
+		}
+		entries, err := data.FetchFeeds(urls)
+		if err != nil && len(entries) == 0 {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+		if err != nil {
+			log.Error("Failed fetching some of the feeds", "section", m.Id, "err", err)
+		}
+		if len(entries) > *limit {
+			entries = entries[:*limit]
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionFeedFetchedMsg{
+				Entries: entries,
+				TaskId:  taskId,
+			},
+		}
+	}
+	cmds = append(cmds, fetchCmd)
+
+	return cmds
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+}
+
+func (m *Model) ResetRows() {
+	m.Entries = nil
+	m.visible = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := ctx.Config.FeedsSections
+	fetchFeedCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1, // 0 is the search section
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		)
+		sections = append(sections, &sectionModel)
+		fetchFeedCmds = append(
+			fetchFeedCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchFeedCmds...)
+}
+
+type SectionFeedFetchedMsg struct {
+	Entries []data.FeedEntry
+	TaskId  string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Entry"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Entries"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m Model) GetPagerContent() string {
+	pagerContent := ""
+	if m.TotalCount > 0 {
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v/%v",
+			constants.WaitingIcon,
+			m.LastUpdated().Format("01/02 15:04:05"),
+			m.SingularForm,
+			m.Table.GetCurrItem()+1,
+			m.TotalCount,
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
@@ -0,0 +1,332 @@
+package fileview
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2/quick"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+	"github.com/dlvhdr/gh-dash/v4/internal/workflowlint"
+)
+
+// commonFiles are suggested when picking the file to view, on top of the
+// entries of the directory viewed last
+var commonFiles = []string{
+	"README.md",
+	"CODEOWNERS",
+	".github/CODEOWNERS",
+	"docs/CODEOWNERS",
+	"CONTRIBUTING.md",
+	".github/workflows/",
+	".github/dependabot.yml",
+	".gitlab-ci.yml",
+	"Makefile",
+	"go.mod",
+	"package.json",
+}
+
+// FetchedMsg is sent once the file at Path of Repo was fetched
+type FetchedMsg struct {
+	Repo     string
+	Path     string
+	Contents data.FileContents
+	Err      error
+}
+
+// Model shows a file of a repo read-only and syntax highlighted, after its
+// path is picked in an input. The file is fetched with the contents API, the
+// repo doesn't have to be cloned. Workflow files are linted, so their
+// mistakes show before they're pushed.
+type Model struct {
+	ctx       *context.ProgramContext
+	input     textinput.Model
+	isOpen    bool
+	isLoading bool
+	repo      string
+	path      string
+	contents  *data.FileContents
+	err       error
+	width     int
+
+	// highlighted caches the highlighted lines of the file, nil if it couldn't
+	// be highlighted
+	highlighted []string
+	// problems are those of the workflow viewed, nil if it isn't a workflow
+	problems []workflowlint.Problem
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	input := textinput.New()
+	input.Prompt = "Path: "
+	input.Placeholder = "e.g. .github/CODEOWNERS"
+	input.ShowSuggestions = true
+	input.SetSuggestions(commonFiles)
+
+	return Model{
+		ctx:   ctx,
+		input: input,
+	}
+}
+
+// IsOpen returns whether the file view replaces the details of the row
+func (m Model) IsOpen() bool {
+	return m.isOpen
+}
+
+// Focused returns whether the path of the file to view is being picked
+func (m Model) Focused() bool {
+	return m.input.Focused()
+}
+
+// Repo is the repo whose files are viewed
+func (m Model) Repo() string {
+	return m.repo
+}
+
+// Path is the path of the file viewed
+func (m Model) Path() string {
+	return m.path
+}
+
+// Open opens the file view on repo to pick the file to view, the input
+// starts with path
+func (m *Model) Open(repo string, path string) tea.Cmd {
+	if repo != m.repo {
+		m.contents = nil
+		m.err = nil
+		m.path = ""
+		m.input.SetSuggestions(commonFiles)
+	}
+	m.isOpen = true
+	m.repo = repo
+	m.input.SetValue(path)
+	m.input.CursorEnd()
+	return m.input.Focus()
+}
+
+// Fetch opens the file view on the file at path of repo and fetches it
+func (m *Model) Fetch(repo string, path string) tea.Cmd {
+	m.isOpen = true
+	m.isLoading = true
+	m.repo = repo
+	m.path = path
+	m.contents = nil
+	m.err = nil
+	m.highlighted = nil
+	m.problems = nil
+	m.input.Blur()
+
+	return func() tea.Msg {
+		contents, err := data.FetchFileContents(repo, path)
+		return FetchedMsg{Repo: repo, Path: path, Contents: contents, Err: err}
+	}
+}
+
+// Close goes back to the details of the row
+func (m *Model) Close() {
+	m.isOpen = false
+	m.input.Blur()
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case FetchedMsg:
+		if msg.Repo != m.repo || msg.Path != m.path {
+			return m, nil
+		}
+		m.isLoading = false
+		m.err = msg.Err
+		if msg.Err != nil {
+			return m, nil
+		}
+		m.contents = &msg.Contents
+		m.highlighted = highlight(msg.Contents.Path, msg.Contents.Content)
+		if !msg.Contents.IsDir && !msg.Contents.IsBinary && workflowlint.IsWorkflow(msg.Contents.Path) {
+			m.problems = workflowlint.Lint([]byte(msg.Contents.Content))
+			if m.problems == nil {
+				m.problems = []workflowlint.Problem{}
+			}
+		}
+		if msg.Contents.IsDir {
+			m.input.SetSuggestions(append(slices.Clone(msg.Contents.Entries), commonFiles...))
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		if !m.input.Focused() {
+			return m, nil
+		}
+		switch msg.Type {
+		case tea.KeyEnter:
+			path := strings.TrimSpace(m.input.Value())
+			if path == "" {
+				return m, nil
+			}
+			return m, m.Fetch(m.repo, path)
+
+		case tea.KeyEsc, tea.KeyCtrlC:
+			m.input.Blur()
+			if m.contents == nil && !m.isLoading && m.err == nil {
+				m.isOpen = false
+			}
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) SetWidth(width int) {
+	m.width = width
+	m.input.Width = max(0, width-lipgloss.Width(m.input.Prompt)-1)
+}
+
+func (m Model) View() string {
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	lines := []string{
+		m.truncate(lipgloss.NewStyle().Bold(true).Foreground(m.ctx.Theme.PrimaryText).Render(m.repo)),
+	}
+	if m.input.Focused() {
+		lines = append(lines, m.input.View(),
+			faint.Render("tab to complete, enter to view, esc to cancel"))
+	} else if m.path != "" {
+		lines = append(lines, m.truncate(lipgloss.NewStyle().Bold(true).Underline(true).
+			Foreground(m.ctx.Theme.PrimaryText).Render(m.path)))
+	}
+	lines = append(lines, "")
+
+	switch {
+	case m.isLoading:
+		lines = append(lines, faint.Render(fmt.Sprintf("Loading %s...", m.path)))
+	case m.err != nil:
+		lines = append(lines, lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Width(m.width).
+			Render(fmt.Sprintf("Failed fetching %s: %v", m.path, m.err)))
+	case m.contents == nil:
+		lines = append(lines, faint.Render("Pick the file to view"))
+	case m.contents.IsDir:
+		lines = append(lines, faint.Render(fmt.Sprintf("%d entries, pick one to view it", len(m.contents.Entries))))
+		for _, entry := range m.contents.Entries {
+			lines = append(lines, m.truncate("  "+entry))
+		}
+	case m.contents.IsBinary:
+		lines = append(lines, faint.Render("Binary file not shown"))
+	default:
+		lines = append(lines, m.renderProblems()...)
+		lines = append(lines, m.renderLines()...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) renderLines() []string {
+	content := strings.TrimSuffix(m.contents.Content, "\n")
+	if content == "" {
+		return []string{lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Render("Empty file")}
+	}
+
+	source := strings.Split(content, "\n")
+	gutter := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).
+		Width(len(fmt.Sprint(len(source)))).Align(lipgloss.Right)
+	problemGutter := gutter.Foreground(m.ctx.Theme.ErrorText).Bold(true)
+	text := lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText)
+
+	problemLines := map[int]bool{}
+	for _, problem := range m.problems {
+		problemLines[problem.Line] = true
+	}
+
+	lines := make([]string, 0, len(source))
+	for i, line := range source {
+		if m.highlighted != nil {
+			line = m.highlighted[i]
+		} else {
+			line = text.Render(line)
+		}
+		number := gutter.Render(fmt.Sprint(i + 1))
+		if problemLines[i+1] {
+			number = problemGutter.Render(fmt.Sprint(i + 1))
+		}
+		lines = append(lines, m.truncate(number+" "+line))
+	}
+	return lines
+}
+
+// renderProblems lists the problems of the workflow viewed above its lines
+func (m Model) renderProblems() []string {
+	if m.problems == nil {
+		return nil
+	}
+	if len(m.problems) == 0 {
+		return []string{
+			lipgloss.NewStyle().Foreground(m.ctx.Theme.SuccessText).Render("✓ No problems found in the workflow"),
+			"",
+		}
+	}
+
+	problemStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText)
+	lines := []string{problemStyle.Bold(true).Render(
+		fmt.Sprintf("✗ %d problem%s found in the workflow", len(m.problems), plural(len(m.problems))))}
+	for _, problem := range m.problems {
+		lines = append(lines, problemStyle.Width(m.width).Render(
+			fmt.Sprintf("  line %d: %s", problem.Line, problem.Message)))
+	}
+	return append(lines, "")
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
+
+// highlight returns the syntax highlighted lines of content, the language is
+// guessed from path. It's nil if the file couldn't be highlighted.
+func highlight(path string, content string) []string {
+	content = strings.TrimSuffix(content, "\n")
+	if content == "" {
+		return nil
+	}
+	source := strings.Split(content, "\n")
+	for i, line := range source {
+		source[i] = strings.ReplaceAll(line, "\t", "    ")
+	}
+
+	var b strings.Builder
+	err := quick.Highlight(&b, strings.Join(source, "\n"), path, "terminal256", markdown.SyntaxStyle())
+	if err != nil {
+		return nil
+	}
+
+	highlighted := strings.Split(b.String(), "\n")
+	// the formatter may end with a reset sequence on a line of its own
+	for len(highlighted) > len(source) && ansi.Strip(highlighted[len(highlighted)-1]) == "" {
+		highlighted = highlighted[:len(highlighted)-1]
+	}
+	if len(highlighted) != len(source) {
+		return nil
+	}
+	return highlighted
+}
+
+func (m Model) truncate(s string) string {
+	if m.width <= 0 {
+		return s
+	}
+	return ansi.Truncate(strings.ReplaceAll(s, "\t", "    "), m.width, constants.Ellipsis)
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
@@ -0,0 +1,119 @@
+package filterdebug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+var closeKey = key.NewBinding(
+	key.WithKeys("esc", "enter", "q", "ctrl+c"),
+	key.WithHelp("esc", "close"),
+)
+
+// Model is an overlay showing the filter state of a section and the query it
+// derives, to tell why a section searches what it does
+type Model struct {
+	ctx     *context.ProgramContext
+	debug   section.FilterDebug
+	width   int
+	focused bool
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:   ctx,
+		width: 70,
+	}
+}
+
+// Open shows the overlay with the filter state of a section
+func (m *Model) Open(debug section.FilterDebug) {
+	m.debug = debug
+	m.focused = true
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.focused && key.Matches(keyMsg, closeKey) {
+		m.focused = false
+	}
+	return m, nil
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText)
+	labelStyle := lipgloss.NewStyle().
+		Foreground(m.ctx.Theme.FaintText).
+		Width(18)
+	valueStyle := lipgloss.NewStyle().
+		Foreground(m.ctx.Theme.PrimaryText).
+		Width(m.width - 6 - 18)
+
+	state, env := m.debug.State, m.debug.Env
+	remotes := make([]string, 0, len(env.Remotes))
+	for _, remote := range env.Remotes {
+		remotes = append(remotes, fmt.Sprintf("%s=%s", remote.Remote, remote.NameWithOwner()))
+	}
+	target := state.Target.String()
+	if state.Target == section.FilterTargetRemote {
+		target += " " + state.Remote
+	}
+
+	rows := [][2]string{
+		{"target", target},
+		{"custom repo", orNone(state.CustomRepo)},
+		{"filtered", fmt.Sprint(state.IsFiltered)},
+		{"author removed", fmt.Sprint(state.IsAuthorRemoved)},
+		{"filtering by", state.Label(env)},
+		{"remotes", orNone(strings.Join(remotes, " "))},
+		{"repo in config", fmt.Sprint(env.HasRepoInConfig)},
+		{"GitHub", fmt.Sprint(env.IsGitHub)},
+		{"search", orNone(m.debug.Search)},
+		{"query", orNone(m.debug.Query)},
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Section Filters"))
+	b.WriteString("\n\n")
+	for _, row := range rows {
+		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render(row[0]), valueStyle.Render(row[1])))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render("Esc: close"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width).
+		Render(b.String())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,190 @@
+package filterpicker
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// KeyMap defines keybindings for the picker
+type KeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Toggle key.Binding
+	Apply  key.Binding
+	Cancel key.Binding
+}
+
+var Keys = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Toggle: key.NewBinding(
+		key.WithKeys(" ", "tab"),
+		key.WithHelp("space", "toggle"),
+	),
+	Apply: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "apply"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// AppliedMsg is sent when the picked filters differ from the ones the
+// section had
+type AppliedMsg struct {
+	Names []string
+}
+
+// Model is an overlay listing the saved filters of the config, the checked
+// ones are stacked on the search of the section
+type Model struct {
+	ctx     *context.ProgramContext
+	names   []string
+	checked map[string]bool
+	applied []string
+	cursor  int
+	focused bool
+	width   int
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:   ctx,
+		width: 60,
+	}
+}
+
+// Open lists the saved filters of the config, the applied ones checked
+func (m *Model) Open(applied []string) {
+	m.names = slices.Sorted(maps.Keys(m.ctx.Config.SavedFilters))
+	m.applied = applied
+	m.checked = make(map[string]bool, len(applied))
+	for _, name := range applied {
+		m.checked[name] = true
+	}
+	m.cursor = 0
+	m.focused = true
+}
+
+func (m *Model) Close() {
+	m.focused = false
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+// picked returns the checked filters, sorted
+func (m Model) picked() []string {
+	var names []string
+	for _, name := range m.names {
+		if m.checked[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, Keys.Cancel):
+		m.Close()
+	case key.Matches(keyMsg, Keys.Up):
+		m.cursor = max(0, m.cursor-1)
+	case key.Matches(keyMsg, Keys.Down):
+		m.cursor = max(0, min(m.cursor+1, len(m.names)-1))
+	case key.Matches(keyMsg, Keys.Toggle):
+		if len(m.names) > 0 {
+			name := m.names[m.cursor]
+			m.checked[name] = !m.checked[name]
+		}
+	case key.Matches(keyMsg, Keys.Apply):
+		m.Close()
+		names := m.picked()
+		if slices.Equal(names, m.applied) {
+			return m, nil
+		}
+		return m, func() tea.Msg { return AppliedMsg{Names: names} }
+	}
+	return m, nil
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText).
+		MarginBottom(1)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Bold(true)
+
+	b.WriteString(titleStyle.Render("Saved Filters"))
+	b.WriteString("\n\n")
+	for i, name := range m.names {
+		cursor, style := "  ", faintStyle
+		if i == m.cursor {
+			cursor, style = "> ", selectedStyle
+		}
+		check := "[ ] "
+		if m.checked[name] {
+			check = "[x] "
+		}
+		line := cursor + check + name
+		query := ansi.Truncate(m.ctx.Config.SavedFilters[name],
+			max(0, m.width-6-lipgloss.Width(line)-2), "…")
+		b.WriteString(style.Render(line))
+		b.WriteString("  ")
+		b.WriteString(faintStyle.Render(query))
+		b.WriteString("\n")
+	}
+	if len(m.names) == 0 {
+		b.WriteString(faintStyle.Italic(true).Render("  Add filters to savedFilters in the config to pick them"))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render("space: toggle • enter: apply • esc: close"))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width)
+	return boxStyle.Render(b.String())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
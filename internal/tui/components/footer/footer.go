@@ -7,9 +7,12 @@ import (
 
 	bbHelp "github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
@@ -22,6 +25,7 @@ type Model struct {
 	help            bbHelp.Model
 	ShowAll         bool
 	ShowConfirmQuit bool
+	chordHints      string
 }
 
 func NewModel(ctx *context.ProgramContext) Model {
@@ -43,6 +47,12 @@ func (m Model) View() string {
 
 	if m.ShowConfirmQuit {
 		footer = lipgloss.NewStyle().Render("Really quit? (Press y/enter to confirm, any other key to cancel)")
+	} else if m.chordHints != "" {
+		footer = m.ctx.Styles.Common.FooterStyle.
+			Width(m.ctx.ScreenWidth).
+			Render(m.chordHints)
+	} else if m.ctx.Mini {
+		footer = m.renderMini()
 	} else {
 		helpIndicator := lipgloss.NewStyle().
 			Background(m.ctx.Theme.FaintText).
@@ -58,6 +68,8 @@ func (m Model) View() string {
 		if m.rightSection != nil {
 			rightSection = *m.rightSection
 		}
+		rateLimit := m.renderRateLimit()
+		update := m.renderUpdate()
 		spacing := lipgloss.NewStyle().
 			Background(m.ctx.Theme.SelectedBackground).
 			Render(
@@ -68,6 +80,8 @@ func (m Model) View() string {
 							viewSwitcher,
 						)-lipgloss.Width(leftSection)-
 							lipgloss.Width(rightSection)-
+							lipgloss.Width(rateLimit)-
+							lipgloss.Width(update)-
 							lipgloss.Width(
 								helpIndicator,
 							),
@@ -75,11 +89,11 @@ func (m Model) View() string {
 
 		footer = m.ctx.Styles.Common.FooterStyle.
 			Render(lipgloss.JoinHorizontal(lipgloss.Top, viewSwitcher, leftSection, spacing,
-				rightSection, helpIndicator))
+				rightSection, update, rateLimit, helpIndicator))
 	}
 
 	if m.ShowAll {
-		keymap := keys.CreateKeyMapForView(m.ctx.View)
+		keymap := keys.CreateKeyMapForView(m.ctx.Keys, m.ctx.View)
 		fullHelp := m.help.View(keymap)
 		return lipgloss.JoinVertical(lipgloss.Top, footer, fullHelp)
 	}
@@ -87,6 +101,62 @@ func (m Model) View() string {
 	return footer
 }
 
+// renderUpdate renders a hint that a newer release of gh-dash is out, with the
+// key showing its notes
+func (m Model) renderUpdate() string {
+	if m.ctx.NewVersion == "" {
+		return ""
+	}
+	return m.ctx.Styles.Common.FooterStyle.
+		Foreground(m.ctx.Theme.SuccessText).
+		Render(fmt.Sprintf(" %s available (%s) ", m.ctx.NewVersion,
+			keys.Keys.ReleaseNotes.Help().Key))
+}
+
+// renderRateLimit renders the API quota with the smallest share left and when
+// it resets, warning when fetches are throttled to spare it
+func (m Model) renderRateLimit() string {
+	quota, ok := data.LowestRateLimit()
+	if !ok {
+		return ""
+	}
+
+	style := m.ctx.Styles.Common.FooterStyle.Foreground(m.ctx.Theme.FaintText)
+	text := fmt.Sprintf(" API %d/%d, resets %s ", quota.Remaining, quota.Limit,
+		quota.ResetAt.Format("15:04"))
+	if until, throttled := data.ThrottledUntil(); throttled {
+		style = style.Foreground(m.ctx.Theme.WarningText)
+		text = fmt.Sprintf(" %s API %d/%d, throttled until %s ", constants.WaitingIcon,
+			quota.Remaining, quota.Limit, until.Format("15:04"))
+	}
+	return style.Render(text)
+}
+
+// renderMini renders the footer of mini mode, without the view switcher and
+// the help indicator. The running task or the last notification replaces the
+// status of the section when both don't fit.
+func (m Model) renderMini() string {
+	left, right := *m.leftSection, *m.rightSection
+	width := m.ctx.ScreenWidth
+	content := right
+	if free := width - lipgloss.Width(left) - lipgloss.Width(right); free >= 0 {
+		content = left + lipgloss.NewStyle().
+			Background(m.ctx.Theme.SelectedBackground).
+			Render(strings.Repeat(" ", free)) + right
+	} else if strings.TrimSpace(ansi.Strip(right)) == "" {
+		content = left
+	}
+	return m.ctx.Styles.Common.FooterStyle.
+		Width(width).
+		Render(ansi.Truncate(content, width, constants.Ellipsis))
+}
+
+// SetChordHints shows which keys can follow the pending keys of a chord
+// instead of the footer, an empty string hides them
+func (m *Model) SetChordHints(hints string) {
+	m.chordHints = hints
+}
+
 func (m *Model) SetShowConfirmQuit(val bool) {
 	m.ShowConfirmQuit = val
 }
@@ -102,8 +172,19 @@ func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 
 func (m *Model) renderViewButton(view config.ViewType) string {
 	v := " PRs"
-	if view == config.IssuesView {
+	switch view {
+	case config.IssuesView:
 		v = " Issues"
+	case config.WorkflowsView:
+		v = " Actions"
+	case config.FeedsView:
+		v = " Feeds"
+	case config.DiscussionsView:
+		v = " Discussions"
+	case config.ReleasesView:
+		v = " Releases"
+	case config.DependenciesView:
+		v = " Dependencies"
 	}
 
 	if m.ctx.View == view {
@@ -127,11 +208,51 @@ func (m *Model) renderViewSwitcher(ctx *context.ProgramContext) string {
 		user = ctx.Styles.Common.FooterStyle.Render("@" + ctx.User)
 	}
 
-	view := lipgloss.JoinHorizontal(
-		lipgloss.Top,
+	views := []string{
 		ctx.Styles.ViewSwitcher.ViewsSeparator.PaddingLeft(1).Render(m.renderViewButton(config.PRsView)),
 		ctx.Styles.ViewSwitcher.ViewsSeparator.Render(" │ "),
 		m.renderViewButton(config.IssuesView),
+	}
+	if len(ctx.Config.WorkflowsSections) > 0 {
+		views = append(views,
+			ctx.Styles.ViewSwitcher.ViewsSeparator.Render(" │ "),
+			m.renderViewButton(config.WorkflowsView),
+		)
+	}
+	if len(ctx.Config.FeedsSections) > 0 {
+		views = append(views,
+			ctx.Styles.ViewSwitcher.ViewsSeparator.Render(" │ "),
+			m.renderViewButton(config.FeedsView),
+		)
+	}
+	if len(ctx.Config.DiscussionsSections) > 0 {
+		views = append(views,
+			ctx.Styles.ViewSwitcher.ViewsSeparator.Render(" │ "),
+			m.renderViewButton(config.DiscussionsView),
+		)
+	}
+	if len(ctx.Config.ReleasesSections) > 0 {
+		views = append(views,
+			ctx.Styles.ViewSwitcher.ViewsSeparator.Render(" │ "),
+			m.renderViewButton(config.ReleasesView),
+		)
+	}
+	if len(ctx.Config.DependenciesSections) > 0 {
+		views = append(views,
+			ctx.Styles.ViewSwitcher.ViewsSeparator.Render(" │ "),
+			m.renderViewButton(config.DependenciesView),
+		)
+	}
+	if ctx.ArchiveEnabled() {
+		views = append(views,
+			ctx.Styles.ViewSwitcher.ViewsSeparator.Render(" │ "),
+			m.renderViewButton(config.ArchiveView),
+		)
+	}
+
+	view := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		lipgloss.JoinHorizontal(lipgloss.Top, views...),
 		lipgloss.NewStyle().Background(ctx.Styles.Common.FooterStyle.GetBackground()).Foreground(
 			ctx.Styles.ViewSwitcher.ViewsSeparator.GetBackground()).Render(" "),
 		repo,
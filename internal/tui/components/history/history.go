@@ -0,0 +1,221 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// MaxEntries is how many actions are remembered per session
+const MaxEntries = 20
+
+// Entry is an action performed on a row
+type Entry struct {
+	View   config.ViewType
+	Key    tea.KeyMsg
+	Desc   string // The help text of the binding, e.g. "approve"
+	Target string // The row the action was performed on, e.g. "#123 Fix typo"
+	At     time.Time
+}
+
+// History holds the most recent actions, newest first
+type History struct {
+	entries []Entry
+	size    int
+}
+
+func New(size int) History {
+	return History{size: size}
+}
+
+// Push records an entry, dropping the oldest one when the history is full
+func (h *History) Push(e Entry) {
+	h.entries = append([]Entry{e}, h.entries...)
+	if h.size > 0 && len(h.entries) > h.size {
+		h.entries = h.entries[:h.size]
+	}
+}
+
+// Last returns the most recent entry performed in view
+func (h History) Last(view config.ViewType) (Entry, bool) {
+	for _, e := range h.entries {
+		if e.View == view {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// ForView returns the entries performed in view, newest first
+func (h History) ForView(view config.ViewType) []Entry {
+	entries := make([]Entry, 0, len(h.entries))
+	for _, e := range h.entries {
+		if e.View == view {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// KeyMap defines keybindings for the overlay
+type KeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Cancel key.Binding
+}
+
+var Keys = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Select: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "run on selection"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c", "q"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// SelectedMsg is sent when an entry is picked to be run again
+type SelectedMsg struct {
+	Entry Entry
+}
+
+// Model is an overlay listing recent actions
+type Model struct {
+	ctx     *context.ProgramContext
+	entries []Entry
+	cursor  int
+	width   int
+	focused bool
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:   ctx,
+		width: 60,
+	}
+}
+
+// Open shows the overlay with entries
+func (m *Model) Open(entries []Entry) {
+	m.entries = entries
+	m.cursor = 0
+	m.focused = true
+}
+
+func (m *Model) Close() {
+	m.focused = false
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, Keys.Up):
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case key.Matches(keyMsg, Keys.Down):
+		if m.cursor < len(m.entries)-1 {
+			m.cursor++
+		}
+	case key.Matches(keyMsg, Keys.Select):
+		m.focused = false
+		if len(m.entries) == 0 {
+			return m, nil
+		}
+		selected := m.entries[m.cursor]
+		return m, func() tea.Msg {
+			return SelectedMsg{Entry: selected}
+		}
+	case key.Matches(keyMsg, Keys.Cancel):
+		m.focused = false
+	}
+
+	return m, nil
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+
+	b.WriteString(titleStyle.Render("Recent Actions"))
+	b.WriteString("\n\n")
+
+	if len(m.entries) == 0 {
+		b.WriteString(faintStyle.Render("No actions performed in this view yet"))
+		b.WriteString("\n")
+	}
+
+	for i, e := range m.entries {
+		cursor := "  "
+		style := faintStyle
+		if i == m.cursor {
+			cursor = "> "
+			style = lipgloss.NewStyle().
+				Foreground(m.ctx.Theme.PrimaryText).
+				Bold(true)
+		}
+
+		line := fmt.Sprintf("%s%-8s %s", cursor, e.Key.String(), e.Desc)
+		b.WriteString(style.Render(line))
+		b.WriteString(faintStyle.Italic(true).Render(
+			fmt.Sprintf(" - %s (%s)", e.Target, utils.TimeElapsed(e.At))))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render(
+		"↑/↓: navigate • Enter: run on selected row • Esc: cancel"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width).
+		Render(b.String())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
@@ -0,0 +1,91 @@
+package history
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+func entry(view config.ViewType, r rune) Entry {
+	return Entry{View: view, Key: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}}
+}
+
+func TestHistory(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		pushed   []Entry
+		view     config.ViewType
+		wantLast string
+		wantKeys []string
+	}{
+		{
+			name:     "empty",
+			size:     3,
+			view:     config.PRsView,
+			wantKeys: []string{},
+		},
+		{
+			name:     "newest first",
+			size:     3,
+			pushed:   []Entry{entry(config.PRsView, 'a'), entry(config.PRsView, 'm')},
+			view:     config.PRsView,
+			wantLast: "m",
+			wantKeys: []string{"m", "a"},
+		},
+		{
+			name: "scoped to view",
+			size: 4,
+			pushed: []Entry{
+				entry(config.PRsView, 'a'),
+				entry(config.IssuesView, 'L'),
+				entry(config.PRsView, 'x'),
+				entry(config.IssuesView, 'c'),
+			},
+			view:     config.PRsView,
+			wantLast: "x",
+			wantKeys: []string{"x", "a"},
+		},
+		{
+			name: "drops oldest when full",
+			size: 2,
+			pushed: []Entry{
+				entry(config.PRsView, 'a'),
+				entry(config.PRsView, 'm'),
+				entry(config.PRsView, 'x'),
+			},
+			view:     config.PRsView,
+			wantLast: "x",
+			wantKeys: []string{"x", "m"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(tt.size)
+			for _, e := range tt.pushed {
+				h.Push(e)
+			}
+
+			last, ok := h.Last(tt.view)
+			if ok != (tt.wantLast != "") {
+				t.Fatalf("Last() ok = %v, want %v", ok, tt.wantLast != "")
+			}
+			if ok && last.Key.String() != tt.wantLast {
+				t.Errorf("Last() = %q, want %q", last.Key.String(), tt.wantLast)
+			}
+
+			got := h.ForView(tt.view)
+			if len(got) != len(tt.wantKeys) {
+				t.Fatalf("ForView() returned %d entries, want %d", len(got), len(tt.wantKeys))
+			}
+			for i, e := range got {
+				if e.Key.String() != tt.wantKeys[i] {
+					t.Errorf("ForView()[%d] = %q, want %q", i, e.Key.String(), tt.wantKeys[i])
+				}
+			}
+		})
+	}
+}
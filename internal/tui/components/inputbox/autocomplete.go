@@ -0,0 +1,151 @@
+package inputbox
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// maxSuggestions is how many completions are listed at once
+const maxSuggestions = 5
+
+// suggestion is a completion of the @mention or :emoji: being typed
+type suggestion struct {
+	// text replaces the typed word
+	text string
+	// label is how the suggestion is listed
+	label string
+}
+
+type emoji struct {
+	name  string
+	glyph string
+}
+
+// emojis are the GitHub emoji shortcodes that are suggested after a colon
+var emojis = []emoji{
+	{"+1", "👍"},
+	{"-1", "👎"},
+	{"100", "💯"},
+	{"bug", "🐛"},
+	{"boom", "💥"},
+	{"bulb", "💡"},
+	{"clap", "👏"},
+	{"confused", "😕"},
+	{"construction", "🚧"},
+	{"cry", "😢"},
+	{"eyes", "👀"},
+	{"facepalm", "🤦"},
+	{"fire", "🔥"},
+	{"grimacing", "😬"},
+	{"grin", "😁"},
+	{"hammer", "🔨"},
+	{"heart", "❤️"},
+	{"heavy_check_mark", "✔️"},
+	{"hooray", "🎉"},
+	{"hourglass", "⌛"},
+	{"hugs", "🤗"},
+	{"joy", "😂"},
+	{"laughing", "😆"},
+	{"lock", "🔒"},
+	{"memo", "📝"},
+	{"muscle", "💪"},
+	{"ok_hand", "👌"},
+	{"package", "📦"},
+	{"pensive", "😔"},
+	{"pray", "🙏"},
+	{"question", "❓"},
+	{"raised_hands", "🙌"},
+	{"recycle", "♻️"},
+	{"rocket", "🚀"},
+	{"rotating_light", "🚨"},
+	{"see_no_evil", "🙈"},
+	{"shipit", "🐿️"},
+	{"slightly_smiling_face", "🙂"},
+	{"smile", "😄"},
+	{"smiley", "😃"},
+	{"sob", "😭"},
+	{"sparkles", "✨"},
+	{"sweat_smile", "😅"},
+	{"tada", "🎉"},
+	{"thinking", "🤔"},
+	{"thumbsdown", "👎"},
+	{"thumbsup", "👍"},
+	{"upside_down_face", "🙃"},
+	{"warning", "⚠️"},
+	{"wave", "👋"},
+	{"white_check_mark", "✅"},
+	{"wink", "😉"},
+	{"wrench", "🔧"},
+	{"x", "❌"},
+	{"zap", "⚡"},
+}
+
+// wordAtCursor returns the @mention or :emoji: being typed before col in
+// line: its trigger, what was typed after it and the column it starts at
+func wordAtCursor(line []rune, col int) (trigger rune, query string, start int, ok bool) {
+	col = min(col, len(line))
+	start = col
+	for start > 0 && !unicode.IsSpace(line[start-1]) {
+		start--
+	}
+	if start == col {
+		return 0, "", 0, false
+	}
+
+	trigger, query = line[start], string(line[start+1:col])
+	switch {
+	case trigger == '@' && !strings.ContainsAny(query, "@:"):
+		return trigger, query, start, true
+	case trigger == ':' && query != "" && !strings.Contains(query, ":"):
+		return trigger, query, start, true
+	}
+	return 0, "", 0, false
+}
+
+// suggest returns the completions of query after trigger, the ones starting
+// with it first
+func suggest(trigger rune, query string, mentions []string) []suggestion {
+	query = strings.ToLower(query)
+	var prefixed, contained []suggestion
+	add := func(name string, s suggestion) {
+		lower := strings.ToLower(name)
+		switch {
+		case strings.HasPrefix(lower, query):
+			prefixed = append(prefixed, s)
+		case strings.Contains(lower, query):
+			contained = append(contained, s)
+		}
+	}
+
+	switch trigger {
+	case '@':
+		for _, login := range mentions {
+			add(login, suggestion{text: "@" + login, label: "@" + login})
+		}
+	case ':':
+		for _, e := range emojis {
+			add(e.name, suggestion{text: ":" + e.name + ":", label: e.glyph + " :" + e.name + ":"})
+		}
+	}
+
+	suggestions := append(prefixed, contained...)
+	return suggestions[:min(len(suggestions), maxSuggestions)]
+}
+
+// addMentions returns mentions with the logins it doesn't have yet, ignoring
+// case
+func addMentions(mentions []string, logins []string) []string {
+	for _, login := range logins {
+		if login == "" {
+			continue
+		}
+		if slices.ContainsFunc(mentions, func(m string) bool {
+			return strings.EqualFold(m, login)
+		}) {
+			continue
+		}
+		mentions = append(mentions, login)
+	}
+	return mentions
+}
@@ -0,0 +1,148 @@
+package inputbox
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWordAtCursor(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		col         int
+		wantTrigger rune
+		wantQuery   string
+		wantStart   int
+		wantOk      bool
+	}{
+		{
+			name:        "mention",
+			line:        "thanks @dlv",
+			col:         11,
+			wantTrigger: '@',
+			wantQuery:   "dlv",
+			wantStart:   7,
+			wantOk:      true,
+		},
+		{
+			name:        "bare at",
+			line:        "@",
+			col:         1,
+			wantTrigger: '@',
+			wantQuery:   "",
+			wantStart:   0,
+			wantOk:      true,
+		},
+		{
+			name:        "emoji",
+			line:        "lgtm :roc",
+			col:         9,
+			wantTrigger: ':',
+			wantQuery:   "roc",
+			wantStart:   5,
+			wantOk:      true,
+		},
+		{
+			name:        "cursor inside the word",
+			line:        "@dlvhdr rocks",
+			col:         3,
+			wantTrigger: '@',
+			wantQuery:   "dl",
+			wantStart:   0,
+			wantOk:      true,
+		},
+		{
+			name:   "bare colon",
+			line:   "note:",
+			col:    5,
+			wantOk: false,
+		},
+		{
+			name:   "completed emoji",
+			line:   ":rocket:",
+			col:    8,
+			wantOk: false,
+		},
+		{
+			name:   "email",
+			line:   "me@example.com",
+			col:    14,
+			wantOk: false,
+		},
+		{
+			name:   "after a space",
+			line:   "@dlvhdr ",
+			col:    8,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trigger, query, start, ok := wordAtCursor([]rune(tt.line), tt.col)
+			if ok != tt.wantOk {
+				t.Fatalf("wordAtCursor() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			if trigger != tt.wantTrigger || query != tt.wantQuery || start != tt.wantStart {
+				t.Errorf("wordAtCursor() = %q, %q, %d, want %q, %q, %d",
+					trigger, query, start, tt.wantTrigger, tt.wantQuery, tt.wantStart)
+			}
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	mentions := []string{"octocat", "dlvhdr", "hubot", "docs-bot"}
+	tests := []struct {
+		name    string
+		trigger rune
+		query   string
+		want    []string
+	}{
+		{
+			name:    "prefix before contains",
+			trigger: '@',
+			query:   "o",
+			want:    []string{"@octocat", "@hubot", "@docs-bot"},
+		},
+		{
+			name:    "ignores case",
+			trigger: '@',
+			query:   "DLV",
+			want:    []string{"@dlvhdr"},
+		},
+		{
+			name:    "emoji",
+			trigger: ':',
+			query:   "rock",
+			want:    []string{":rocket:"},
+		},
+		{
+			name:    "no match",
+			trigger: '@',
+			query:   "nobody",
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, s := range suggest(tt.trigger, tt.query, mentions) {
+				got = append(got, s.text)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("suggest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddMentions(t *testing.T) {
+	got := addMentions([]string{"dlvhdr"}, []string{"DLVHDR", "", "octocat", "octocat"})
+	want := []string{"dlvhdr", "octocat"}
+	if !slices.Equal(got, want) {
+		t.Errorf("addMentions() = %v, want %v", got, want)
+	}
+}
@@ -2,6 +2,7 @@ package inputbox
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
@@ -17,6 +18,20 @@ type Model struct {
 	textArea  textarea.Model
 	inputHelp help.Model
 	prompt    string
+
+	// autocomplete is whether @mentions and :emoji: are completed
+	autocomplete bool
+	// mentions are the logins suggested after an @
+	mentions    []string
+	suggestions []suggestion
+	selected    int
+	// wordLen is how many runes of the word being completed were typed
+	wordLen int
+}
+
+// MentionsFetchedMsg carries more logins to suggest after an @
+type MentionsFetchedMsg struct {
+	Mentions []string
 }
 
 var inputKeys = []key.Binding{
@@ -24,6 +39,12 @@ var inputKeys = []key.Binding{
 	key.NewBinding(key.WithKeys(tea.KeyCtrlC.String(), tea.KeyEsc.String()), key.WithHelp("Ctrl+c/esc", "cancel")),
 }
 
+var (
+	acceptSuggestionKey = key.NewBinding(key.WithKeys(tea.KeyTab.String(), tea.KeyEnter.String()), key.WithHelp("tab", "complete"))
+	nextSuggestionKey   = key.NewBinding(key.WithKeys(tea.KeyDown.String(), tea.KeyCtrlN.String()), key.WithHelp("↓", "next"))
+	prevSuggestionKey   = key.NewBinding(key.WithKeys(tea.KeyUp.String(), tea.KeyCtrlP.String()), key.WithHelp("↑", "previous"))
+)
+
 func NewModel(ctx *context.ProgramContext) Model {
 	ta := textarea.New()
 	ta.ShowLineNumbers = true
@@ -51,12 +72,120 @@ func NewModel(ctx *context.ProgramContext) Model {
 }
 
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case MentionsFetchedMsg:
+		m.AddMentions(msg.Mentions)
+		return m, nil
+
+	case tea.KeyMsg:
+		if len(m.suggestions) > 0 {
+			switch {
+			case key.Matches(msg, acceptSuggestionKey):
+				m.complete()
+				return m, nil
+			case key.Matches(msg, nextSuggestionKey):
+				m.selected = (m.selected + 1) % len(m.suggestions)
+				return m, nil
+			case key.Matches(msg, prevSuggestionKey):
+				m.selected = (m.selected - 1 + len(m.suggestions)) % len(m.suggestions)
+				return m, nil
+			}
+		}
+
+		var cmd tea.Cmd
+		m.textArea, cmd = m.textArea.Update(msg)
+		m.syncSuggestions()
+		return m, cmd
+	}
+
 	var cmd tea.Cmd
 	m.textArea, cmd = m.textArea.Update(msg)
 	return m, cmd
 }
 
+// syncSuggestions lists the completions of the word before the cursor
+func (m *Model) syncSuggestions() {
+	m.suggestions, m.selected, m.wordLen = nil, 0, 0
+	if !m.autocomplete {
+		return
+	}
+
+	lines := strings.Split(m.textArea.Value(), "\n")
+	row := m.textArea.Line()
+	if row >= len(lines) {
+		return
+	}
+	info := m.textArea.LineInfo()
+	col := info.StartColumn + info.ColumnOffset
+	trigger, query, start, ok := wordAtCursor([]rune(lines[row]), col)
+	if !ok {
+		return
+	}
+	m.suggestions = suggest(trigger, query, m.mentions)
+	m.wordLen = col - start
+}
+
+// complete replaces the word before the cursor with the selected suggestion
+func (m *Model) complete() {
+	s := m.suggestions[m.selected]
+	for range m.wordLen {
+		m.textArea, _ = m.textArea.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	m.textArea.InsertString(s.text + " ")
+	m.suggestions, m.selected, m.wordLen = nil, 0, 0
+}
+
+// DismissSuggestions hides the listed completions, it returns whether there
+// were any
+func (m *Model) DismissSuggestions() bool {
+	if len(m.suggestions) == 0 {
+		return false
+	}
+	m.suggestions, m.selected, m.wordLen = nil, 0, 0
+	return true
+}
+
+// SetAutocomplete sets whether @mentions and :emoji: are completed, with
+// mentions as the logins to suggest
+func (m *Model) SetAutocomplete(enabled bool, mentions []string) {
+	m.autocomplete = enabled
+	m.mentions = addMentions(nil, mentions)
+	m.suggestions, m.selected, m.wordLen = nil, 0, 0
+}
+
+// AddMentions adds logins to the ones suggested after an @
+func (m *Model) AddMentions(logins []string) {
+	m.mentions = addMentions(m.mentions, logins)
+}
+
+func (m Model) viewSuggestions() string {
+	lines := make([]string, 0, len(m.suggestions))
+	for i, s := range m.suggestions {
+		style := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).PaddingRight(1)
+		if i == m.selected {
+			style = lipgloss.NewStyle().
+				Background(m.ctx.Theme.SelectedBackground).
+				Foreground(m.ctx.Theme.PrimaryText).
+				PaddingRight(1)
+		}
+		lines = append(lines, style.Render(" "+s.label))
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.FaintBorder).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
 func (m Model) View() string {
+	help := m.inputHelp.ShortHelpView(inputKeys)
+	textArea := m.textArea.View()
+	if len(m.suggestions) > 0 {
+		help = m.inputHelp.ShortHelpView([]key.Binding{
+			acceptSuggestionKey, nextSuggestionKey, prevSuggestionKey,
+		})
+		textArea = lipgloss.JoinVertical(lipgloss.Left, textArea, m.viewSuggestions())
+	}
+
 	return lipgloss.NewStyle().
 		BorderTop(true).
 		BorderStyle(lipgloss.NormalBorder()).
@@ -66,10 +195,10 @@ func (m Model) View() string {
 			lipgloss.JoinVertical(
 				lipgloss.Left,
 				fmt.Sprintf("%s\n", m.prompt),
-				m.textArea.View(),
+				textArea,
 				lipgloss.NewStyle().
 					MarginTop(1).
-					Render(m.inputHelp.ShortHelpView(inputKeys)),
+					Render(help),
 			),
 		)
 }
@@ -102,8 +231,10 @@ func (m *Model) SetPrompt(prompt string) {
 	m.prompt = prompt
 }
 
+// Reset clears the input and turns autocomplete off
 func (m *Model) Reset() {
 	m.textArea.Reset()
+	m.SetAutocomplete(false, nil)
 }
 
 func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
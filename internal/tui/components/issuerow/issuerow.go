@@ -2,6 +2,8 @@ package issuerow
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -17,10 +19,20 @@ type Issue struct {
 	Ctx            *context.ProgramContext
 	Data           data.IssueData
 	ShowAuthorIcon bool
+	// Unread renders the title in bold, for the unread rows of inboxes
+	Unread bool
+	// ProjectFields are the values of the section's project field columns
+	ProjectFields []string
+	// Computed are the cells of the section's computed columns
+	Computed []table.Cell
+	// Score is the row's score, shown in the score column
+	Score float64
+	// Estimate is the value of the issue's estimate field, if it has one
+	Estimate string
 }
 
 func (issue *Issue) ToTableRow() table.Row {
-	return table.Row{
+	return append(table.Row{
 		issue.renderStatus(),
 		issue.renderRepoName(),
 		issue.renderTitle(),
@@ -30,7 +42,36 @@ func (issue *Issue) ToTableRow() table.Row {
 		issue.renderNumReactions(),
 		issue.renderUpdateAt(),
 		issue.renderCreatedAt(),
+		issue.renderScore(),
+		issue.getTextStyle().Render(issue.Estimate),
+		issue.renderMilestone(),
+	}, issue.renderExtraColumns()...)
+}
+
+func (issue *Issue) renderScore() string {
+	return issue.Ctx.Styles.Common.FaintTextStyle.Render(strconv.Itoa(int(math.Round(issue.Score))))
+}
+
+func (issue *Issue) renderMilestone() string {
+	if issue.Data.Milestone == nil {
+		return ""
+	}
+	return issue.getTextStyle().Render(issue.Data.Milestone.Title)
+}
+
+func (issue *Issue) renderExtraColumns() []string {
+	fields := make([]string, 0, len(issue.ProjectFields)+len(issue.Computed))
+	for _, value := range issue.ProjectFields {
+		fields = append(fields, issue.getTextStyle().Render(value))
+	}
+	for _, cell := range issue.Computed {
+		style := issue.getTextStyle()
+		if cell.Color != "" {
+			style = style.Foreground(lipgloss.Color(cell.Color))
+		}
+		fields = append(fields, style.Render(cell.Value))
 	}
+	return fields
 }
 
 func (issue *Issue) getTextStyle() lipgloss.Style {
@@ -69,7 +110,15 @@ func (issue *Issue) renderRepoName() string {
 }
 
 func (issue *Issue) renderTitle() string {
-	return components.RenderIssueTitle(issue.Ctx, issue.Data.State, issue.Data.Title, issue.Data.Number)
+	rowState := components.GetRowState(issue.Ctx, issue.Data.State, false, false, issue.Data.UpdatedAt)
+	return components.RenderRowTitle(
+		issue.Ctx,
+		rowState,
+		issue.Data.State,
+		issue.Data.Title,
+		issue.Data.Number,
+		issue.Unread,
+	)
 }
 
 func (issue *Issue) renderOpenedBy() string {
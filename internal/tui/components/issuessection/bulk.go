@@ -0,0 +1,125 @@
+package issuessection
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// bulkActions are the actions that run on every selected issue when some are
+// selected, instead of on the current one
+var bulkActions = []string{"close", "label", "assign"}
+
+func (m *Model) selectedIssues() []data.IssueData {
+	var issues []data.IssueData
+	for _, i := range m.Table.SelectedItems() {
+		if i < len(m.Issues) {
+			issues = append(issues, m.Issues[i])
+		}
+	}
+	return issues
+}
+
+// bulk runs action on every selected issue as a single task, input is what
+// was answered to its prompt
+func (m *Model) bulk(action string, input string) tea.Cmd {
+	issues := m.selectedIssues()
+	if len(issues) == 0 {
+		return nil
+	}
+
+	var items []tasks.BulkItem
+	var startText, finishedText string
+	switch action {
+	case "close":
+		if input != "Y" && input != "y" {
+			return nil
+		}
+		for _, issue := range issues {
+			items = append(items, bulkItem(issue, []string{"close"}, UpdateIssueMsg{
+				IssueNumber: issue.Number,
+				IsClosed:    utils.BoolPtr(true),
+			}))
+		}
+		startText = fmt.Sprintf("Closing %d issues", len(issues))
+		finishedText = fmt.Sprintf("%d issues have been closed", len(issues))
+
+	case "label":
+		labels := strings.Fields(input)
+		if len(labels) == 0 {
+			return nil
+		}
+		args := []string{"edit"}
+		for _, label := range labels {
+			args = append(args, "--add-label", label)
+		}
+		for _, issue := range issues {
+			items = append(items, bulkItem(issue, args, UpdateIssueMsg{
+				IssueNumber: issue.Number,
+				Labels:      addLabels(issue.Labels, labels),
+			}))
+		}
+		startText = fmt.Sprintf("Labeling %d issues with %s", len(issues), labels)
+		finishedText = fmt.Sprintf("%d issues have been labeled with %s", len(issues), labels)
+
+	case "assign":
+		usernames := strings.Fields(input)
+		if len(usernames) == 0 {
+			return nil
+		}
+		args := []string{"edit"}
+		assignees := data.Assignees{Nodes: []data.Assignee{}}
+		for _, username := range usernames {
+			args = append(args, "--add-assignee", username)
+			assignees.Nodes = append(assignees.Nodes, data.Assignee{Login: username})
+		}
+		for _, issue := range issues {
+			items = append(items, bulkItem(issue, args, UpdateIssueMsg{
+				IssueNumber:    issue.Number,
+				AddedAssignees: &assignees,
+			}))
+		}
+		startText = fmt.Sprintf("Assigning %d issues to %s", len(issues), usernames)
+		finishedText = fmt.Sprintf("%d issues have been assigned to %s", len(issues), usernames)
+
+	default:
+		return nil
+	}
+
+	m.Table.ClearSelection()
+	return tasks.RunBulk(m.Ctx, tasks.BulkTask{
+		Id:           fmt.Sprintf("issue_bulk_%s_%d", action, issues[0].Number),
+		Section:      tasks.SectionIdentifier{Id: m.Id, Type: SectionType},
+		StartText:    startText,
+		FinishedText: finishedText,
+		Items:        items,
+	})
+}
+
+// bulkItem runs the gh issue subcommand and the args of cmd on issue
+func bulkItem(issue data.IssueData, cmd []string, msg tea.Msg) tasks.BulkItem {
+	args := []string{"issue", cmd[0], fmt.Sprint(issue.Number), "-R", data.RepoArg(issue)}
+	return tasks.BulkItem{
+		Name: fmt.Sprintf("%s#%d", issue.GetRepoNameWithOwner(), issue.Number),
+		Args: append(args, cmd[1:]...),
+		Msg:  msg,
+	}
+}
+
+// addLabels returns the labels of an issue once the names it doesn't have
+// are added
+func addLabels(labels data.IssueLabels, names []string) *data.IssueLabels {
+	added := data.IssueLabels{Nodes: slices.Clone(labels.Nodes)}
+	for _, name := range names {
+		if !slices.ContainsFunc(added.Nodes, func(l data.Label) bool { return l.Name == name }) {
+			added.Nodes = append(added.Nodes, data.Label{Name: name})
+		}
+	}
+	return &added
+}
@@ -5,6 +5,8 @@ import (
 	"os/exec"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
@@ -22,17 +24,14 @@ func (m *Model) close() tea.Cmd {
 		Error:        nil,
 	}
 	startCmd := m.Ctx.StartTask(task)
-	return tea.Batch(startCmd, func() tea.Msg {
-		c := exec.Command(
-			"gh",
-			"issue",
-			"close",
-			fmt.Sprint(m.GetCurrRow().GetNumber()),
-			"-R",
-			m.GetCurrRow().GetRepoNameWithOwner(),
-		)
-
-		err := c.Run()
+	args := []string{
+		"issue",
+		"close",
+		fmt.Sprint(issueNumber),
+		"-R",
+		data.RepoArg(issue),
+	}
+	return tasks.RunQueueable(startCmd, args, func(_ *exec.Cmd, err error) constants.TaskFinishedMsg {
 		return constants.TaskFinishedMsg{
 			SectionId:   m.Id,
 			SectionType: SectionType,
@@ -1,8 +1,10 @@
 package issuessection
 
 import (
+	"cmp"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -10,10 +12,12 @@ import (
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/export"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuerow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/repopicker"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
@@ -49,6 +53,8 @@ func NewModel(
 		},
 	)
 	m.Issues = []data.IssueData{}
+	m.FetchesEstimates = ctx.Config.Estimate.IsSet()
+	m.MergesRepoFilters = true
 
 	return m
 }
@@ -60,6 +66,12 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	case tea.KeyMsg:
 
 		if m.IsSearchFocused() {
+			if m.SearchBar.IsOverlayOpen() {
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+
 			switch msg.Type {
 			case tea.KeyCtrlC, tea.KeyEsc:
 				m.SearchBar.SetValue(m.SearchValue)
@@ -68,10 +80,12 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 
 			case tea.KeyEnter:
 				m.SearchValue = m.SearchBar.Value()
+				historyCmd := m.SearchBar.AddToHistory(m.SearchValue)
 				m.SyncRepoFilterStateFromSearchValue()
+				m.SyncTimeSliceFromSearchValue()
 				m.SetIsSearching(false)
 				m.ResetRows()
-				return m, tea.Batch(m.FetchNextPageSectionRows()...)
+				return m, tea.Batch(append(m.FetchNextPageSectionRows(), historyCmd)...)
 
 			default:
 				// Forward all other keys to the search bar for input
@@ -97,7 +111,9 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			case tea.KeyEnter:
 				input := m.PromptConfirmationBox.Value()
 				action := m.GetPromptConfirmationAction()
-				if input == "Y" || input == "y" {
+				if m.HasSelection() && slices.Contains(bulkActions, action) {
+					cmd = m.bulk(action, input)
+				} else if input == "Y" || input == "y" {
 					switch action {
 					case "close":
 						cmd = m.close()
@@ -176,9 +192,46 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				return m, tea.Batch(m.FetchNextPageSectionRows()...)
 			}
 
-		case key.Matches(msg, keys.IssueKeys.OpenRepoPicker):
-			m.ShowRepoPicker()
+		case key.Matches(msg, keys.IssueKeys.SliceToday):
+			return m, m.sliceTime(section.TimeSliceToday)
+
+		case key.Matches(msg, keys.IssueKeys.SliceWeek):
+			return m, m.sliceTime(section.TimeSliceWeek)
+
+		case key.Matches(msg, keys.IssueKeys.SliceMonth):
+			return m, m.sliceTime(section.TimeSliceMonth)
+
+		case key.Matches(msg, keys.IssueKeys.SliceAllTime):
+			return m, m.sliceTime(section.TimeSliceAll)
+
+		case key.Matches(msg, keys.IssueKeys.ToggleCurrentSprint):
+			return m, m.toggleCurrentSprint()
+
+		case key.Matches(msg, keys.IssueKeys.CycleSort):
+			return m, m.cycleSort()
+
+		case key.Matches(msg, keys.IssueKeys.ReverseSort):
+			if m.ReverseSort() {
+				m.syncRows()
+			}
+			return m, nil
+
+		case key.Matches(msg, keys.IssueKeys.ToggleGroup):
+			m.Table.ToggleGroup()
 			return m, nil
+
+		case key.Matches(msg, keys.IssueKeys.ToggleAllGroups):
+			m.Table.ToggleAllGroups()
+			return m, nil
+
+		case key.Matches(msg, keys.IssueKeys.OpenRepoPicker):
+			return m, m.ShowRepoPicker()
+
+		case key.Matches(msg, keys.IssueKeys.ToggleSelection):
+			m.Table.ToggleSelection()
+
+		case key.Matches(msg, keys.IssueKeys.SelectRange):
+			m.Table.SelectRange()
 		}
 
 	case repopicker.RepoSelectedMsg:
@@ -194,6 +247,10 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	case repopicker.RepoCancelledMsg:
 		m.HideRepoPicker()
 
+	case repopicker.ReposFetchedMsg:
+		m.RepoPicker, cmd = m.RepoPicker.Update(msg)
+		return m, cmd
+
 	case UpdateIssueMsg:
 		for i, currIssue := range m.Issues {
 			if currIssue.Number == msg.IssueNumber {
@@ -207,9 +264,20 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				if msg.Labels != nil {
 					currIssue.Labels.Nodes = msg.Labels.Nodes
 				}
+				if msg.HasMilestone != nil {
+					currIssue.Milestone = nil
+					if *msg.HasMilestone {
+						currIssue.Milestone = msg.Milestone
+					}
+				}
 				if msg.NewComment != nil {
 					currIssue.Comments.Nodes = append(currIssue.Comments.Nodes, *msg.NewComment)
 				}
+				if msg.OlderComments != nil {
+					currIssue.Comments.Nodes = append(msg.OlderComments.Nodes, currIssue.Comments.Nodes...)
+					currIssue.Comments.PageInfo = msg.OlderComments.PageInfo
+					currIssue.Comments.TotalCount = msg.OlderComments.TotalCount
+				}
 				if msg.AddedAssignees != nil {
 					currIssue.Assignees.Nodes = addAssignees(
 						currIssue.Assignees.Nodes, msg.AddedAssignees.Nodes)
@@ -220,22 +288,108 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				}
 				m.Issues[i] = currIssue
 				m.SetIsLoading(false)
-				m.Table.SetRows(m.BuildRows())
+				m.syncRows()
 				break
 			}
 		}
 
+	case UpdateEstimateMsg:
+		if m.ProjectFields == nil {
+			m.ProjectFields = make(map[string]data.ProjectFields)
+		}
+		fields := m.ProjectFields[msg.Url]
+		if fields == nil {
+			fields = make(data.ProjectFields)
+			m.ProjectFields[msg.Url] = fields
+		}
+		for name := range fields {
+			if strings.EqualFold(name, msg.Field) {
+				delete(fields, name)
+			}
+		}
+		if msg.Value != "" {
+			fields[msg.Field] = msg.Value
+		}
+		m.syncRows()
+
+	case tasks.BulkUpdateMsg:
+		cmds := make([]tea.Cmd, 0, len(msg.Msgs))
+		for _, updateMsg := range msg.Msgs {
+			_, updateCmd := m.Update(updateMsg)
+			cmds = append(cmds, updateCmd)
+		}
+		return m, tea.Batch(cmds...)
+
+	case tasks.ItemCreatedMsg:
+		if issue, ok := msg.Row.(*data.IssueData); ok {
+			m.Issues = append([]data.IssueData{*issue}, m.Issues...)
+			m.TotalCount++
+			m.syncRows()
+			m.Table.FirstItem()
+			m.UpdateTotalItemsCount(m.TotalCount)
+		}
+
+	case section.SectionMsg:
+		switch internalMsg := msg.InternalMsg.(type) {
+		case SectionIssuesFetchedMsg, repopicker.ReposFetchedMsg:
+			return m.Update(internalMsg)
+		}
+
 	case SectionIssuesFetchedMsg:
+		msg = m.skipSnoozed(msg)
+		if msg.Offline {
+			// GitHub can't be reached, the rows shown are kept and the cached
+			// ones are shown if there are none
+			if m.LastFetchTaskId == msg.TaskId {
+				m.IsOffline = true
+				if msg.IsCached() && len(m.Issues) == 0 {
+					m.Issues = msg.Issues
+					m.prioritize()
+					m.TotalCount = msg.TotalCount
+					m.syncRows()
+					m.UpdateLastUpdated(msg.CachedAt)
+					m.UpdateTotalItemsCount(m.TotalCount)
+				}
+				m.SetIsLoading(false)
+				m.IsRefreshing = false
+			}
+			break
+		}
+		if msg.IsCached() {
+			// fresh rows win over cached ones if they arrived first
+			if m.LastFetchTaskId == msg.TaskId && m.PageInfo == nil && len(m.Issues) == 0 {
+				m.Issues = msg.Issues
+				m.prioritize()
+				m.TotalCount = msg.TotalCount
+				// the fresh rows are still being fetched
+				m.SetIsLoading(false)
+				m.IsRefreshing = true
+				m.syncRows()
+				m.UpdateLastUpdated(msg.CachedAt)
+				m.UpdateTotalItemsCount(m.TotalCount)
+			}
+			break
+		}
 		if m.LastFetchTaskId == msg.TaskId {
+			m.IsOffline = false
 			if m.PageInfo != nil {
 				m.Issues = append(m.Issues, msg.Issues...)
 			} else {
 				m.Issues = msg.Issues
+				m.Table.ClearSelection()
+			}
+			m.prioritize()
+			m.SetProjectFields(msg.ProjectFields, m.PageInfo != nil)
+			if msg.Iterations != nil {
+				m.Iterations = msg.Iterations
 			}
+			section.GroupBySprint(&m.BaseModel, m.Issues)
+			m.ResetComputedValues()
 			m.TotalCount = msg.TotalCount
 			m.SetIsLoading(false)
+			m.IsRefreshing = false
 			m.PageInfo = &msg.PageInfo
-			m.Table.SetRows(m.BuildRows())
+			m.syncRows()
 			m.UpdateLastUpdated(time.Now())
 			m.UpdateTotalItemsCount(m.TotalCount)
 		}
@@ -284,8 +438,14 @@ func GetSectionColumns(
 		dLayout.Reactions,
 		sLayout.Reactions,
 	)
+	scoreLayout := config.MergeColumnConfigs(dLayout.Score, sLayout.Score)
+	estimateLayout := config.MergeColumnConfigs(dLayout.Estimate, sLayout.Estimate)
+	milestoneLayout := config.MergeColumnConfigs(dLayout.Milestone, sLayout.Milestone)
+	if estimateLayout.Hidden == nil {
+		estimateLayout.Hidden = utils.BoolPtr(!ctx.Config.Estimate.IsSet())
+	}
 
-	return []table.Column{
+	return append([]table.Column{
 		{
 			Title:  "",
 			Width:  stateLayout.Width,
@@ -331,13 +491,53 @@ func GetSectionColumns(
 			Width:  createdAtLayout.Width,
 			Hidden: createdAtLayout.Hidden,
 		},
-	}
+		{
+			Title:  "Score",
+			Width:  scoreLayout.Width,
+			Hidden: scoreLayout.Hidden,
+		},
+		{
+			Title:  "Estimate",
+			Width:  estimateLayout.Width,
+			Hidden: estimateLayout.Hidden,
+		},
+		{
+			Title:  "Milestone",
+			Width:  milestoneLayout.Width,
+			Hidden: milestoneLayout.Hidden,
+		},
+	}, slices.Concat(
+		section.ProjectColumns(cfg.ProjectFields),
+		section.ComputedColumns(cfg.ComputedColumns),
+	)...)
+}
+
+// syncRows sets the issues as the rows of the table, under the headers of
+// their groups in grouped sections
+func (m *Model) syncRows() {
+	rows := m.BuildRows()
+	m.Table.SetGroupedRows(rows, section.GroupTitles(&m.BaseModel, m.Issues,
+		data.IssueData.ColumnFields))
 }
 
 func (m Model) BuildRows() []table.Row {
+	// the issues share their array with the model's, they're sorted in place
+	section.SortRows(&m.BaseModel, m.Issues, data.IssueData.ColumnFields)
 	var rows []table.Row
+	scoring := m.Ctx.Config.Scoring.WithDefaults()
+	now := time.Now()
 	for _, currIssue := range m.Issues {
-		issueModel := issuerow.Issue{Ctx: m.Ctx, Data: currIssue, ShowAuthorIcon: m.ShowAuthorIcon}
+		issueModel := issuerow.Issue{
+			Ctx:            m.Ctx,
+			Data:           currIssue,
+			ShowAuthorIcon: m.ShowAuthorIcon,
+			Unread:         m.IsUnread(currIssue),
+			ProjectFields:  m.ProjectFieldValues(currIssue.Url),
+			Computed: m.ComputedCells(currIssue.Url, currIssue.UpdatedAt,
+				currIssue.ColumnFields),
+			Score:    currIssue.Score(scoring, now),
+			Estimate: m.EstimateValue(currIssue.Url, currIssue.GetRepoNameWithOwner()),
+		}
 		rows = append(rows, issueModel.ToTableRow())
 	}
 
@@ -348,10 +548,83 @@ func (m Model) BuildRows() []table.Row {
 	return rows
 }
 
+// prioritize orders the issues by their score, highest first, in
+// auto-prioritized sections
+func (m *Model) prioritize() {
+	if !m.Config.AutoPrioritize {
+		return
+	}
+	scoring := m.Ctx.Config.Scoring.WithDefaults()
+	now := time.Now()
+	slices.SortStableFunc(m.Issues, func(a, b data.IssueData) int {
+		return cmp.Compare(b.Score(scoring, now), a.Score(scoring, now))
+	})
+}
+
+// skipSnoozed drops the snoozed issues, they don't count toward the total
+// either
+func (m *Model) skipSnoozed(msg SectionIssuesFetchedMsg) SectionIssuesFetchedMsg {
+	n := len(msg.Issues)
+	msg.Issues = slices.DeleteFunc(slices.Clone(msg.Issues), func(issue data.IssueData) bool {
+		return m.IsSnoozed(issue)
+	})
+	msg.TotalCount -= n - len(msg.Issues)
+	return msg
+}
+
+// DropSnoozed removes the issues snoozed since they were fetched
+func (m *Model) DropSnoozed() {
+	n := len(m.Issues)
+	m.Issues = slices.DeleteFunc(m.Issues, func(issue data.IssueData) bool {
+		return m.IsSnoozed(issue)
+	})
+	if len(m.Issues) == n {
+		return
+	}
+	m.TotalCount -= n - len(m.Issues)
+	m.Table.ClearSelection()
+	m.syncRows()
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
 func (m *Model) NumRows() int {
 	return len(m.Issues)
 }
 
+func (m *Model) UnreadRows() []int {
+	var unread []int
+	for i, issue := range m.Issues {
+		if m.IsUnread(issue) {
+			unread = append(unread, i)
+		}
+	}
+	return unread
+}
+
+func (m *Model) SyncReadRows() {
+	m.syncRows()
+}
+
+// ExportRows returns the rows of the section as they're shown, for exporting
+func (m *Model) ExportRows() export.Table {
+	t := export.Table{Columns: m.ExportColumns(data.IssueFieldNames)}
+	for _, issue := range m.Issues {
+		t.Append(m.ExportRow(issue.Url, issue.UpdatedAt, issue.ColumnFields))
+	}
+	return t
+}
+
+// RestoreTrimmed sets the fields trimmed from the list query of the issue
+// details belong to
+func (m *Model) RestoreTrimmed(details data.IssueDetails) {
+	for i := range m.Issues {
+		if m.Issues[i].Url == details.Url {
+			m.Issues[i].RestoreTrimmed(details)
+		}
+	}
+	m.syncRows()
+}
+
 func (m *Model) GetCurrRow() data.RowData {
 	if len(m.Issues) == 0 {
 		return nil
@@ -360,6 +633,41 @@ func (m *Model) GetCurrRow() data.RowData {
 	return &issue
 }
 
+// sliceTime restricts the section to the items updated in slice and
+// refetches it
+func (m *Model) sliceTime(slice section.TimeSlice) tea.Cmd {
+	if !m.SetTimeSlice(slice) {
+		return nil
+	}
+	m.SearchBar.SetValue(m.SearchValue)
+	m.SetIsSearching(false)
+	m.ResetRows()
+	return tea.Batch(m.FetchNextPageSectionRows()...)
+}
+
+// toggleCurrentSprint restricts the section to the items of the current
+// sprint, or lifts the restriction, and refetches it
+func (m *Model) toggleCurrentSprint() tea.Cmd {
+	if !m.ToggleCurrentSprint() {
+		return nil
+	}
+	m.SearchBar.SetValue(m.SearchValue)
+	m.SetIsSearching(false)
+	m.ResetRows()
+	return tea.Batch(m.FetchNextPageSectionRows()...)
+}
+
+// cycleSort sorts the issues by the next sort key, they're refetched to get
+// GitHub's order back after the last one
+func (m *Model) cycleSort() tea.Cmd {
+	if !m.CycleSort() {
+		m.syncRows()
+		return nil
+	}
+	m.ResetRows()
+	return tea.Batch(m.FetchNextPageSectionRows()...)
+}
+
 func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	if m == nil {
 		return nil
@@ -390,12 +698,61 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	startCmd := m.Ctx.StartTask(task)
 	cmds = append(cmds, startCmd)
 
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.IssuesLimit
+	}
+
+	isFirstPage := m.PageInfo == nil
+	if isFirstPage && len(m.Issues) == 0 {
+		cmds = append(cmds, section.ReadCachedRows(&m.BaseModel, *limit,
+			func(res data.IssuesResponse, savedAt time.Time) tea.Msg {
+				return SectionIssuesFetchedMsg{
+					Issues:     res.Issues,
+					TotalCount: res.TotalCount,
+					TaskId:     taskId,
+					CachedAt:   savedAt,
+				}
+			}))
+	}
+
 	fetchCmd := func() tea.Msg {
-		limit := m.Config.Limit
-		if limit == nil {
-			limit = &m.Ctx.Config.Defaults.IssuesLimit
+		provider, err := m.Provider()
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+		res, err := provider.FetchIssues(m.GetFilters(), *limit, m.PageInfo)
+		if err != nil {
+			var msg tea.Msg
+			if data.IsOffline(err) {
+				offlineMsg := SectionIssuesFetchedMsg{TaskId: taskId, Offline: true}
+				if res, savedAt, ok := section.ReadOfflineRows[data.IssuesResponse](&m.BaseModel, *limit); ok && isFirstPage {
+					offlineMsg.Issues = res.Issues
+					offlineMsg.TotalCount = res.TotalCount
+					offlineMsg.CachedAt = savedAt
+				}
+				msg = offlineMsg
+			}
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+				Msg:         msg,
+			}
 		}
-		res, err := data.FetchIssues(m.GetFilters(), *limit, m.PageInfo)
+
+		ids := make([]string, 0, len(res.Issues))
+		for _, issue := range res.Issues {
+			ids = append(ids, issue.Id)
+		}
+		projectFields := m.FetchProjectFields(ids)
+		iterations, err := m.FetchIterations()
 		if err != nil {
 			return constants.TaskFinishedMsg{
 				SectionId:   m.Id,
@@ -404,16 +761,25 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 				Err:         err,
 			}
 		}
+		fetched := len(res.Issues)
+		res.Issues = section.FilterBySprint(&m.BaseModel, res.Issues, projectFields, iterations, time.Now())
+		res.TotalCount -= fetched - len(res.Issues)
+
+		if isFirstPage {
+			section.WriteCachedRows(&m.BaseModel, *limit, res)
+		}
 
 		return constants.TaskFinishedMsg{
 			SectionId:   m.Id,
 			SectionType: m.Type,
 			TaskId:      taskId,
 			Msg: SectionIssuesFetchedMsg{
-				Issues:     res.Issues,
-				TotalCount: res.TotalCount,
-				PageInfo:   res.PageInfo,
-				TaskId:     taskId,
+				Issues:        res.Issues,
+				TotalCount:    res.TotalCount,
+				PageInfo:      res.PageInfo,
+				TaskId:        taskId,
+				ProjectFields: projectFields,
+				Iterations:    iterations,
 			},
 		}
 	}
@@ -461,15 +827,42 @@ type SectionIssuesFetchedMsg struct {
 	TotalCount int
 	PageInfo   data.PageInfo
 	TaskId     string
+	// CachedAt is set when the issues were read from the cache while the
+	// fresh ones are being fetched
+	CachedAt time.Time
+	// Offline is set when GitHub couldn't be reached, the rows are the cached
+	// ones if there are any
+	Offline bool
+	// ProjectFields are the values of the section's project fields of the
+	// issues, by their URL
+	ProjectFields map[string]data.ProjectFields
+	// Iterations are the iterations of the sprint field, set when the issues
+	// are grouped or filtered by sprint
+	Iterations data.Iterations
+}
+
+func (msg SectionIssuesFetchedMsg) IsCached() bool {
+	return !msg.CachedAt.IsZero()
 }
 
 type UpdateIssueMsg struct {
 	IssueNumber      int
 	Labels           *data.IssueLabels
 	NewComment       *data.IssueComment
+	OlderComments    *data.IssueComments
 	IsClosed         *bool
 	AddedAssignees   *data.Assignees
 	RemovedAssignees *data.Assignees
+	HasMilestone     *bool
+	Milestone        *data.ItemMilestone
+}
+
+// UpdateEstimateMsg is sent when the estimate of the issue with Url is set,
+// Value is empty when it's cleared
+type UpdateEstimateMsg struct {
+	Url   string
+	Field string
+	Value string
 }
 
 func addAssignees(assignees, addedAssignees []data.Assignee) []data.Assignee {
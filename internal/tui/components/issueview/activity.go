@@ -1,13 +1,14 @@
 package issueview
 
 import (
+	"fmt"
 	"sort"
 	"time"
 
-	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
@@ -19,10 +20,15 @@ type RenderedActivity struct {
 
 func (m *Model) renderActivity() string {
 	width := m.getIndentedContentWidth() - 2
-	markdownRenderer := markdown.GetMarkdownRenderer(width)
+	markdownRenderer := m.ctx.ReadingRenderer(width)
 
 	var activity []RenderedActivity
+	numHiddenBots := 0
 	for _, comment := range m.issue.Data.Comments.Nodes {
+		if !m.showBotComments() && m.ctx.Config.Bots.IsBot(comment.Author.Login) {
+			numHiddenBots++
+			continue
+		}
 		renderedComment, err := m.renderComment(comment, markdownRenderer)
 		if err != nil {
 			continue
@@ -39,7 +45,10 @@ func (m *Model) renderActivity() string {
 
 	body := ""
 	bodyStyle := lipgloss.NewStyle().PaddingLeft(2)
-	if len(activity) == 0 {
+	hiddenBots := m.renderHiddenBotComments(numHiddenBots)
+	if len(activity) == 0 && hiddenBots != "" {
+		body = hiddenBots
+	} else if len(activity) == 0 {
 		body = renderEmptyState()
 	} else {
 		var renderedActivities []string
@@ -47,11 +56,51 @@ func (m *Model) renderActivity() string {
 			renderedActivities = append(renderedActivities, activity.RenderedString)
 		}
 		body = lipgloss.JoinVertical(lipgloss.Left, renderedActivities...)
+		if hiddenBots != "" {
+			body = lipgloss.JoinVertical(lipgloss.Left, hiddenBots, "", body)
+		}
+		if loadOlder := m.renderLoadOlderComments(); loadOlder != "" {
+			body = lipgloss.JoinVertical(lipgloss.Left, loadOlder, "", body)
+		}
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, m.renderActivitiesTitle(), bodyStyle.Render(body))
 }
 
+// renderLoadOlderComments tells how many of the comments were fetched when
+// there are older ones to load
+func (m *Model) renderLoadOlderComments() string {
+	comments := m.issue.Data.Comments
+	if !comments.PageInfo.HasPreviousPage {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Italic(true).
+		Width(m.getIndentedContentWidth() - 2).
+		Render(fmt.Sprintf("Showing the latest %d of %d comments, press %s to load older ones",
+			len(comments.Nodes), comments.TotalCount, keys.IssueKeys.LoadOlderComments.Help().Key))
+}
+
+// renderHiddenBotComments tells how many bot comments were hidden and how to
+// show them
+func (m *Model) renderHiddenBotComments(numHidden int) string {
+	if numHidden == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Italic(true).
+		Width(m.getIndentedContentWidth() - 2).
+		Render(fmt.Sprintf("󰚩 %d bot comments hidden, press %s to show them",
+			numHidden, keys.IssueKeys.ToggleBotComments.Help().Key))
+}
+
+func (m *Model) showBotComments() bool {
+	return m.ctx.Config.Bots.Show != m.botCommentsToggled
+}
+
+// ToggleBotComments shows or hides the comments of bots
+func (m *Model) ToggleBotComments() {
+	m.botCommentsToggled = !m.botCommentsToggled
+}
+
 func (m Model) renderActivitiesTitle() string {
 	return m.ctx.Styles.Common.MainTextStyle.
 		MarginBottom(1).
@@ -63,7 +112,7 @@ func renderEmptyState() string {
 	return lipgloss.NewStyle().Italic(true).Render("No comments...")
 }
 
-func (m *Model) renderComment(comment data.IssueComment, markdownRenderer glamour.TermRenderer) (string, error) {
+func (m *Model) renderComment(comment data.IssueComment, markdownRenderer markdown.Renderer) (string, error) {
 	header := lipgloss.JoinHorizontal(lipgloss.Top,
 		m.ctx.Styles.Common.MainTextStyle.Render(comment.Author.Login),
 		" ",
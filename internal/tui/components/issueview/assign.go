@@ -29,7 +29,7 @@ func (m *Model) assign(usernames []string) tea.Cmd {
 		"edit",
 		fmt.Sprint(issueNumber),
 		"-R",
-		issue.GetRepoNameWithOwner(),
+		data.RepoArg(issue),
 	}
 	for _, assignee := range usernames {
 		commandArgs = append(commandArgs, "--add-assignee")
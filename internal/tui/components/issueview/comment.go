@@ -9,6 +9,7 @@ import (
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 )
@@ -25,19 +26,16 @@ func (m *Model) comment(body string) tea.Cmd {
 		Error:        nil,
 	}
 	startCmd := m.ctx.StartTask(task)
-	return tea.Batch(startCmd, func() tea.Msg {
-		c := exec.Command(
-			"gh",
-			"issue",
-			"comment",
-			fmt.Sprint(issueNumber),
-			"-R",
-			issue.GetRepoNameWithOwner(),
-			"-b",
-			body,
-		)
-
-		err := c.Run()
+	args := []string{
+		"issue",
+		"comment",
+		fmt.Sprint(issueNumber),
+		"-R",
+		data.RepoArg(issue),
+		"-b",
+		body,
+	}
+	return tasks.RunQueueable(startCmd, args, func(_ *exec.Cmd, err error) constants.TaskFinishedMsg {
 		return constants.TaskFinishedMsg{
 			SectionId:   m.sectionId,
 			SectionType: issuessection.SectionType,
@@ -54,3 +52,44 @@ func (m *Model) comment(body string) tea.Cmd {
 		}
 	})
 }
+
+// LoadOlderComments fetches the page of comments before the ones shown
+func (m *Model) LoadOlderComments() tea.Cmd {
+	if m.issue == nil || !m.issue.Data.Comments.PageInfo.HasPreviousPage {
+		return nil
+	}
+
+	issue := m.issue.Data
+	issueNumber := issue.GetNumber()
+	cursor := issue.Comments.PageInfo.StartCursor
+	taskId := fmt.Sprintf("issue_older_comments_%d", issueNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Loading older comments of issue #%d", issueNumber),
+		FinishedText: fmt.Sprintf("Loaded older comments of issue #%d", issueNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		comments, err := data.FetchOlderComments(issue.Url, cursor)
+		msg := issuessection.UpdateIssueMsg{IssueNumber: issueNumber}
+		if err == nil {
+			older := data.IssueComments{
+				TotalCount: int(comments.TotalCount),
+				PageInfo:   comments.PageInfo,
+			}
+			for _, c := range comments.Nodes {
+				older.Nodes = append(older.Nodes, data.IssueComment(c))
+			}
+			msg.OlderComments = &older
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: issuessection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         msg,
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package issueview
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func (m *Model) setEstimate(value *float64) tea.Cmd {
+	issue := m.issue.Data
+	issueNumber := issue.GetNumber()
+	field := m.ctx.Config.Estimate.ForRepo(issue.GetRepoNameWithOwner())
+
+	formatted := ""
+	finishedText := fmt.Sprintf("The %s of issue #%d has been cleared", field.Field, issueNumber)
+	if value != nil {
+		formatted = strconv.FormatFloat(*value, 'f', -1, 64)
+		finishedText = fmt.Sprintf("The %s of issue #%d has been set to %s", field.Field, issueNumber, formatted)
+	}
+
+	taskId := fmt.Sprintf("issue_estimate_%d", issueNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Setting the %s of issue #%d", field.Field, issueNumber),
+		FinishedText: finishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.SetEstimate(issue.Url, field, value)
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: issuessection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg: issuessection.UpdateEstimateMsg{
+				Url:   issue.Url,
+				Field: field.Field,
+				Value: formatted,
+			},
+		}
+	})
+}
@@ -3,18 +3,19 @@ package issueview
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuerow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 )
 
 var (
@@ -31,9 +32,20 @@ type Model struct {
 
 	ShowConfirmCancel bool
 	isCommenting      bool
-	isLabeling        bool
 	isAssigning       bool
 	isUnassigning     bool
+	isEstimating      bool
+	// estimate is the value of the issue's estimate field, empty if it has
+	// none or no field is configured for its repo
+	estimate string
+	// botCommentsToggled flips whether bot comments are shown from the config
+	botCommentsToggled bool
+	// assignedIssueCounts are how many open issues are assigned to each user
+	// in the issue sections, by login
+	assignedIssueCounts map[string]int
+	// detailsUrl is the issue the fields trimmed from the list queries were
+	// last fetched for
+	detailsUrl string
 
 	inputBox inputbox.Model
 }
@@ -46,7 +58,6 @@ func NewModel(ctx *context.ProgramContext) Model {
 		issue: nil,
 
 		isCommenting:  false,
-		isLabeling:    false,
 		isAssigning:   false,
 		isUnassigning: false,
 
@@ -62,6 +73,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	)
 
 	switch msg := msg.(type) {
+	case inputbox.MentionsFetchedMsg:
+		m.inputBox, cmd = m.inputBox.Update(msg)
+		return m, cmd
+
 	case tea.KeyMsg:
 		if m.isCommenting {
 			switch msg.Type {
@@ -74,6 +89,9 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				return m, cmd
 
 			case tea.KeyEsc, tea.KeyCtrlC:
+				if m.inputBox.DismissSuggestions() {
+					return m, nil
+				}
 				if !m.ShowConfirmCancel {
 					m.shouldCancelComment()
 				}
@@ -94,20 +112,22 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 			m.inputBox, taCmd = m.inputBox.Update(msg)
 			cmds = append(cmds, cmd, taCmd)
-		} else if m.isLabeling {
+		} else if m.isEstimating {
 			switch msg.Type {
 			case tea.KeyCtrlD:
-				labels := strings.Fields(m.inputBox.Value())
-				if len(labels) > 0 {
-					cmd = m.label(labels)
+				value, err := data.ParseEstimate(m.inputBox.Value())
+				if err != nil {
+					m.inputBox.SetPrompt(lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(err.Error()))
+					return m, nil
 				}
+				cmd = m.setEstimate(value)
 				m.inputBox.Blur()
-				m.isLabeling = false
+				m.isEstimating = false
 				return m, cmd
 
 			case tea.KeyEsc, tea.KeyCtrlC:
 				m.inputBox.Blur()
-				m.isLabeling = false
+				m.isEstimating = false
 				return m, nil
 			}
 
@@ -170,6 +190,11 @@ func (m Model) View() string {
 	s.WriteString(m.renderStatusPill())
 	s.WriteString("\n\n")
 
+	if estimate := m.renderEstimate(); estimate != "" {
+		s.WriteString(estimate)
+		s.WriteString("\n\n")
+	}
+
 	labels := m.renderLabels()
 	if labels != "" {
 		s.WriteString(labels)
@@ -180,9 +205,13 @@ func (m Model) View() string {
 	s.WriteString("\n\n")
 	s.WriteString(m.renderActivity())
 
-	if m.isCommenting || m.isAssigning || m.isUnassigning || m.isLabeling {
+	if m.isCommenting || m.isAssigning || m.isUnassigning || m.isEstimating {
 		s.WriteString(m.inputBox.View())
 	}
+	if m.isAssigning {
+		s.WriteString("\n")
+		s.WriteString(m.renderCandidates())
+	}
 
 	return lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).Render(s.String())
 }
@@ -216,6 +245,21 @@ func (m *Model) renderStatusPill() string {
 		Render(content)
 }
 
+// renderEstimate renders the estimate field of the issue, empty when no field
+// is configured for its repo
+func (m *Model) renderEstimate() string {
+	field := m.ctx.Config.Estimate.ForRepo(m.issue.Data.GetRepoNameWithOwner())
+	if field.Field == "" {
+		return ""
+	}
+
+	value := lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Render(m.estimate)
+	if m.estimate == "" {
+		value = lipgloss.NewStyle().Italic(true).Foreground(m.ctx.Theme.FaintText).Render("none")
+	}
+	return lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText).Render(field.Field+": ") + value
+}
+
 func (m *Model) renderBody() string {
 	width := m.getIndentedContentWidth()
 	// Strip HTML comments from body and cleanup body.
@@ -227,7 +271,7 @@ func (m *Model) renderBody() string {
 		return lipgloss.NewStyle().Italic(true).Foreground(m.ctx.Theme.FaintText).Render("No description provided.")
 	}
 
-	markdownRenderer := markdown.GetMarkdownRenderer(width)
+	markdownRenderer := m.ctx.ReadingRenderer(width)
 	rendered, err := markdownRenderer.Render(body)
 	if err != nil {
 		return ""
@@ -269,14 +313,24 @@ func (m *Model) SetRow(data *data.IssueData) {
 	}
 }
 
+// SetEstimate sets the value of the issue's estimate field shown
+func (m *Model) SetEstimate(value string) {
+	m.estimate = value
+}
+
 func (m *Model) IsTextInputBoxFocused() bool {
-	return m.isCommenting || m.isAssigning || m.isUnassigning || m.isLabeling
+	return m.isCommenting || m.isAssigning || m.isUnassigning || m.isEstimating
 }
 
 func (m *Model) GetIsCommenting() bool {
 	return m.isCommenting
 }
 
+// HasDraft returns whether a comment is being written, with some text
+func (m *Model) HasDraft() bool {
+	return m.isCommenting && strings.TrimSpace(m.inputBox.Value()) != ""
+}
+
 func (m *Model) shouldCancelComment() bool {
 	if !m.ShowConfirmCancel {
 		m.inputBox.SetPrompt(lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render("Discard comment? (y/N)"))
@@ -294,18 +348,54 @@ func (m *Model) SetIsCommenting(isCommenting bool) tea.Cmd {
 		return nil
 	}
 
+	var mentionsCmd tea.Cmd
 	if !m.isCommenting && isCommenting {
 		m.inputBox.Reset()
+		m.inputBox.SetAutocomplete(true, m.issueParticipants())
+		mentionsCmd = fetchMentions(m.issue.Data.Repository.NameWithOwner)
 	}
 	m.isCommenting = isCommenting
 	m.inputBox.SetPrompt("Leave a comment...")
 
 	if isCommenting {
-		return tea.Sequence(textarea.Blink, m.inputBox.Focus())
+		return tea.Batch(tea.Sequence(textarea.Blink, m.inputBox.Focus()), mentionsCmd)
 	}
 	return nil
 }
 
+// issueParticipants returns the logins of the author, assignees and
+// commenters of the issue
+func (m *Model) issueParticipants() []string {
+	issue := m.issue.Data
+	logins := []string{issue.Author.Login}
+	for _, assignee := range issue.Assignees.Nodes {
+		logins = append(logins, assignee.Login)
+	}
+	for _, comment := range issue.Comments.Nodes {
+		logins = append(logins, comment.Author.Login)
+	}
+	return logins
+}
+
+// fetchMentions fetches the users and the teams of its org that can be
+// mentioned in repo for the comment's autocomplete
+func fetchMentions(repo string) tea.Cmd {
+	return func() tea.Msg {
+		logins, err := data.FetchMentionableUsers(repo)
+		if err != nil {
+			log.Error("Failed fetching mentionable users", "repo", repo, "err", err)
+			return nil
+		}
+		owner, _, _ := strings.Cut(repo, "/")
+		teams, err := data.FetchOrgTeams(owner)
+		if err != nil {
+			// repos owned by users have no teams
+			log.Debug("Failed fetching teams", "org", owner, "err", err)
+		}
+		return inputbox.MentionsFetchedMsg{Mentions: slices.Concat(logins, teams)}
+	}
+}
+
 func (m *Model) GetIsAssigning() bool {
 	return m.isAssigning
 }
@@ -330,24 +420,23 @@ func (m *Model) SetIsAssigning(isAssigning bool) tea.Cmd {
 	return nil
 }
 
-func (m *Model) SetIsLabeling(isLabeling bool) tea.Cmd {
+func (m *Model) GetIsEstimating() bool {
+	return m.isEstimating
+}
+
+func (m *Model) SetIsEstimating(isEstimating bool) tea.Cmd {
 	if m.issue == nil {
 		return nil
 	}
 
-	if !m.isLabeling && isLabeling {
+	if !m.isEstimating && isEstimating {
 		m.inputBox.Reset()
 	}
-	m.isLabeling = isLabeling
-	m.inputBox.SetPrompt("Add/remove labels (whitespace-separated)...")
-
-	labels := make([]string, 0)
-	for _, label := range m.issue.Data.Labels.Nodes {
-		labels = append(labels, label.Name)
-	}
-	m.inputBox.SetValue(strings.Join(labels, " "))
+	m.isEstimating = isEstimating
+	m.inputBox.SetPrompt("Estimate (a number, empty to clear)...")
+	m.inputBox.SetValue(m.estimate)
 
-	if isLabeling {
+	if isEstimating {
 		return tea.Sequence(textarea.Blink, m.inputBox.Focus())
 	}
 	return nil
@@ -0,0 +1,29 @@
+package issueview
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// IssueDetailsMsg carries the fields trimmed from the list queries of the
+// issue shown
+type IssueDetailsMsg struct {
+	SectionId int
+	Details   data.IssueDetails
+	Err       error
+}
+
+// FetchTrimmedFields fetches the fields trimmed from the list queries for
+// the issue shown, once per issue
+func (m *Model) FetchTrimmedFields() tea.Cmd {
+	if m.issue == nil || !data.IsAnyTrimmed() || m.detailsUrl == m.issue.Data.Url {
+		return nil
+	}
+	url, sectionId := m.issue.Data.Url, m.sectionId
+	m.detailsUrl = url
+	return func() tea.Msg {
+		details, err := data.FetchIssueDetails(url)
+		return IssueDetailsMsg{SectionId: sectionId, Details: details, Err: err}
+	}
+}
@@ -28,7 +28,7 @@ func (m *Model) unassign(usernames []string) tea.Cmd {
 		"edit",
 		fmt.Sprint(issueNumber),
 		"-R",
-		issue.GetRepoNameWithOwner(),
+		data.RepoArg(issue),
 	}
 	for _, assignee := range usernames {
 		commandArgs = append(commandArgs, "--remove-assignee")
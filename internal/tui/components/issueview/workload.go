@@ -0,0 +1,71 @@
+package issueview
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// SetAssignedIssueCounts sets how many open issues are assigned to each
+// user, shown next to the candidates while assigning
+func (m *Model) SetAssignedIssueCounts(counts map[string]int) {
+	m.assignedIssueCounts = counts
+}
+
+// assignCandidates returns the team, the participants of the issue and the
+// user, the least loaded first, leaving out the ones already assigned
+func (m *Model) assignCandidates() []string {
+	logins := append([]string{}, m.ctx.Config.Triage.Team...)
+	logins = append(logins, m.ctx.User)
+	logins = append(logins, m.issueParticipants()...)
+
+	candidates := make([]string, 0, len(logins))
+	for _, login := range logins {
+		if login == "" || slices.Contains(candidates, login) || m.userAssignedToIssue(login) {
+			continue
+		}
+		candidates = append(candidates, login)
+	}
+	return data.ByLoad(candidates, m.assignedIssueCounts)
+}
+
+// AutoAssign assigns the issue to the member of the team with the fewest
+// open issues assigned, it returns false if no member can be assigned
+func (m *Model) AutoAssign() (tea.Cmd, bool) {
+	if m.issue == nil {
+		return nil, false
+	}
+	team := make([]string, 0, len(m.ctx.Config.Triage.Team))
+	for _, login := range m.ctx.Config.Triage.Team {
+		if !m.userAssignedToIssue(login) {
+			team = append(team, login)
+		}
+	}
+	if len(team) == 0 {
+		return nil, false
+	}
+	return m.assign(data.ByLoad(team, m.assignedIssueCounts)[:1]), true
+}
+
+// renderCandidates renders the candidates to assign the issue to with how
+// many open issues they're assigned
+func (m *Model) renderCandidates() string {
+	candidates := m.assignCandidates()
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	loads := make([]string, 0, len(candidates))
+	for _, login := range candidates {
+		loads = append(loads, fmt.Sprintf("%s %d", login, m.assignedIssueCounts[login]))
+	}
+	return lipgloss.NewStyle().
+		Foreground(m.ctx.Theme.FaintText).
+		Width(m.getIndentedContentWidth()).
+		Render("Open issues assigned: " + strings.Join(loads, " · "))
+}
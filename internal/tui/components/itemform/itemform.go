@@ -0,0 +1,490 @@
+package itemform
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// step is a page of the form
+type step int
+
+const (
+	stepRepo step = iota
+	stepTitle
+	stepBody
+	stepLabels
+	stepAssignees
+	stepBase
+	stepHead
+)
+
+func (s step) label() string {
+	switch s {
+	case stepRepo:
+		return "Repository"
+	case stepTitle:
+		return "Title"
+	case stepBody:
+		return "Body"
+	case stepLabels:
+		return "Labels"
+	case stepAssignees:
+		return "Assignees"
+	case stepBase:
+		return "Base branch"
+	default:
+		return "Head branch"
+	}
+}
+
+func (s step) placeholder() string {
+	switch s {
+	case stepRepo:
+		return "owner/repo"
+	case stepLabels:
+		return "bug, help wanted"
+	case stepAssignees:
+		return "@me, octocat"
+	case stepBase:
+		return "main"
+	case stepHead:
+		return "my-branch or owner:my-branch"
+	default:
+		return ""
+	}
+}
+
+// Model is the modal for creating a PR or an issue, one step at a time
+type Model struct {
+	ctx     *context.ProgramContext
+	isPR    bool
+	steps   []step
+	curr    int
+	inputs  map[step]textinput.Model
+	body    string
+	width   int
+	focused bool
+	err     string
+}
+
+// KeyMap defines keybindings for the form
+type KeyMap struct {
+	Next     key.Binding
+	Prev     key.Binding
+	EditBody key.Binding
+	Submit   key.Binding
+	Cancel   key.Binding
+}
+
+// DefaultKeyMap returns the default keybindings
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Next: key.NewBinding(
+			key.WithKeys("enter", "tab"),
+			key.WithHelp("enter", "next"),
+		),
+		Prev: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "back"),
+		),
+		EditBody: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit in $EDITOR"),
+		),
+		Submit: key.NewBinding(
+			key.WithKeys("ctrl+d"),
+			key.WithHelp("ctrl+d", "create"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc", "ctrl+c"),
+			key.WithHelp("esc", "cancel"),
+		),
+	}
+}
+
+var Keys = DefaultKeyMap()
+
+// SubmittedMsg is sent when the form is filled in
+type SubmittedMsg struct {
+	Item data.NewItem
+}
+
+// CancelledMsg is sent when the form is cancelled
+type CancelledMsg struct{}
+
+// BodyEditedMsg is sent when the editor writing the body exits
+type BodyEditedMsg struct {
+	Body string
+	Err  error
+}
+
+// NewModel creates a new form model
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:   ctx,
+		width: 70,
+	}
+}
+
+// Open focuses an empty form for a new PR or issue in repo
+func (m *Model) Open(isPR bool, repo string) tea.Cmd {
+	m.focused = true
+	m.isPR = isPR
+	m.curr = 0
+	m.body = ""
+	m.err = ""
+	m.steps = []step{stepRepo, stepTitle, stepBody, stepLabels, stepAssignees}
+	if isPR {
+		m.steps = append(m.steps, stepBase, stepHead)
+	}
+
+	m.inputs = make(map[step]textinput.Model, len(m.steps))
+	for _, s := range m.steps {
+		if s == stepBody {
+			continue
+		}
+		ti := textinput.New()
+		ti.Prompt = "> "
+		ti.Placeholder = s.placeholder()
+		ti.Width = m.width - 10
+		ti.Blur()
+		m.inputs[s] = ti
+	}
+	m.setValue(stepRepo, repo)
+	if repo != "" {
+		// most of the time the repo is the one we're in
+		m.curr = 1
+	}
+	return m.focusStep()
+}
+
+// Blur blurs the form
+func (m *Model) Blur() {
+	m.focused = false
+	for s, ti := range m.inputs {
+		ti.Blur()
+		m.inputs[s] = ti
+	}
+}
+
+// Focused returns whether the form is focused
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+// IsPR returns whether the form creates a PR rather than an issue
+func (m Model) IsPR() bool {
+	return m.isPR
+}
+
+// SetWidth sets the form width
+func (m *Model) SetWidth(w int) {
+	m.width = w
+	for s, ti := range m.inputs {
+		ti.Width = w - 10
+		m.inputs[s] = ti
+	}
+}
+
+func (m Model) step() step {
+	return m.steps[m.curr]
+}
+
+func (m Model) value(s step) string {
+	return strings.TrimSpace(m.inputs[s].Value())
+}
+
+func (m *Model) setValue(s step, value string) {
+	ti := m.inputs[s]
+	ti.SetValue(value)
+	m.inputs[s] = ti
+}
+
+func (m *Model) focusStep() tea.Cmd {
+	var cmd tea.Cmd
+	for s, ti := range m.inputs {
+		if s == m.step() {
+			cmd = ti.Focus()
+		} else {
+			ti.Blur()
+		}
+		m.inputs[s] = ti
+	}
+	return cmd
+}
+
+// Item returns the PR or issue described by the form
+func (m Model) Item() data.NewItem {
+	return data.NewItem{
+		IsPR:      m.isPR,
+		Repo:      m.value(stepRepo),
+		Title:     m.value(stepTitle),
+		Body:      strings.TrimSpace(m.body),
+		Labels:    SplitList(m.value(stepLabels)),
+		Assignees: SplitList(m.value(stepAssignees)),
+		Base:      m.value(stepBase),
+		Head:      m.value(stepHead),
+	}
+}
+
+// SplitList splits a comma separated list, dropping empty entries. Labels can
+// have spaces so they don't separate entries.
+func SplitList(value string) []string {
+	list := []string{}
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
+
+// Validate returns why the item can't be created
+func Validate(item data.NewItem) string {
+	switch {
+	case !isRepoName(item.Repo):
+		return "The repository should be owner/repo"
+	case item.Title == "":
+		return "A title is required"
+	case item.IsPR && item.Base == "":
+		return "A PR needs a base branch"
+	case item.IsPR && item.Head == "":
+		return "A PR needs a head branch"
+	}
+	return ""
+}
+
+func isRepoName(repo string) bool {
+	owner, name, ok := strings.Cut(repo, "/")
+	return ok && owner != "" && name != "" && !strings.Contains(name, "/")
+}
+
+// validateStep returns why the current step can't be left yet
+func (m Model) validateStep() string {
+	switch m.step() {
+	case stepRepo:
+		if !isRepoName(m.value(stepRepo)) {
+			return "The repository should be owner/repo"
+		}
+	case stepTitle, stepBase, stepHead:
+		if m.value(m.step()) == "" {
+			return fmt.Sprintf("%s is required", m.step().label())
+		}
+	}
+	return ""
+}
+
+// EditBody opens the user's editor with the body written so far
+func (m Model) EditBody() tea.Cmd {
+	f, err := os.CreateTemp("", "gh-dash-body-*.md")
+	if err != nil {
+		return func() tea.Msg { return BodyEditedMsg{Err: err} }
+	}
+	path := f.Name()
+	_, err = f.WriteString(m.body)
+	f.Close()
+	if err != nil {
+		os.Remove(path)
+		return func() tea.Msg { return BodyEditedMsg{Err: err} }
+	}
+
+	editor := strings.Fields(Editor())
+	c := exec.Command(editor[0], append(editor[1:], path)...)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		defer os.Remove(path)
+		if err != nil {
+			return BodyEditedMsg{Err: err}
+		}
+		body, err := os.ReadFile(path)
+		if err != nil {
+			return BodyEditedMsg{Err: err}
+		}
+		return BodyEditedMsg{Body: string(body)}
+	})
+}
+
+// Editor returns the user's editor, vi if none is set
+func Editor() string {
+	for _, env := range []string{"GH_EDITOR", "VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	return "vi"
+}
+
+// Update handles messages
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+
+	switch msg := msg.(type) {
+	case BodyEditedMsg:
+		if msg.Err != nil {
+			m.err = fmt.Sprintf("Failed editing the body: %v", msg.Err)
+			return m, nil
+		}
+		m.body = msg.Body
+		m.err = ""
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, Keys.Cancel):
+			m.Blur()
+			return m, func() tea.Msg {
+				return CancelledMsg{}
+			}
+
+		case key.Matches(msg, Keys.Submit):
+			return m.submit()
+
+		case key.Matches(msg, Keys.Prev):
+			if m.curr > 0 {
+				m.curr--
+			}
+			m.err = ""
+			return m, m.focusStep()
+
+		case key.Matches(msg, Keys.Next):
+			if m.err = m.validateStep(); m.err != "" {
+				return m, nil
+			}
+			if m.curr == len(m.steps)-1 {
+				return m.submit()
+			}
+			m.curr++
+			return m, m.focusStep()
+
+		case m.step() == stepBody && key.Matches(msg, Keys.EditBody):
+			return m, m.EditBody()
+		}
+	}
+
+	ti, ok := m.inputs[m.step()]
+	if !ok {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	ti, cmd = ti.Update(msg)
+	m.inputs[m.step()] = ti
+	return m, cmd
+}
+
+func (m Model) submit() (Model, tea.Cmd) {
+	item := m.Item()
+	if m.err = Validate(item); m.err != "" {
+		return m, nil
+	}
+	m.Blur()
+	return m, func() tea.Msg {
+		return SubmittedMsg{Item: item}
+	}
+}
+
+// View renders the form
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	labelStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText)
+
+	kind := "issue"
+	if m.isPR {
+		kind = "PR"
+	}
+	b.WriteString(titleStyle.Render(fmt.Sprintf("New %s", kind)))
+	b.WriteString(faintStyle.Render(fmt.Sprintf("  step %d of %d", m.curr+1, len(m.steps))))
+	b.WriteString("\n\n")
+
+	for i, s := range m.steps {
+		switch {
+		case i == m.curr:
+			b.WriteString(titleStyle.Render(s.label()))
+			b.WriteString("\n")
+			if s == stepBody {
+				b.WriteString(m.viewBody())
+			} else {
+				b.WriteString(m.inputs[s].View())
+			}
+		case s == stepBody:
+			b.WriteString(labelStyle.Render(s.label() + ": "))
+			b.WriteString(faintStyle.Render(summarizeBody(m.body)))
+		default:
+			b.WriteString(labelStyle.Render(s.label() + ": "))
+			b.WriteString(faintStyle.Render(m.value(s)))
+		}
+		b.WriteString("\n")
+	}
+
+	if m.err != "" {
+		b.WriteString("\n")
+		b.WriteString(lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(m.err))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := "enter: next • shift+tab: back • ctrl+d: create • esc: cancel"
+	if m.step() == stepBody {
+		help = fmt.Sprintf("e: edit in %s • %s", Editor(), help)
+	}
+	b.WriteString(helpStyle.Render(help))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width)
+
+	return boxStyle.Render(b.String())
+}
+
+func (m Model) viewBody() string {
+	if strings.TrimSpace(m.body) == "" {
+		return lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Render("No body yet")
+	}
+	lines := strings.Split(strings.TrimSpace(m.body), "\n")
+	if len(lines) > 8 {
+		lines = append(lines[:8], "…")
+	}
+	return lipgloss.NewStyle().
+		Foreground(m.ctx.Theme.PrimaryText).
+		Width(m.width - 6).
+		Render(strings.Join(lines, "\n"))
+}
+
+func summarizeBody(body string) string {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return ""
+	}
+	lines := strings.Count(body, "\n") + 1
+	if lines == 1 {
+		return "1 line"
+	}
+	return fmt.Sprintf("%d lines", lines)
+}
+
+// UpdateProgramContext updates the context
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
@@ -0,0 +1,81 @@
+package itemform
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		item    data.NewItem
+		wantErr bool
+	}{
+		{
+			name:    "issue with a title",
+			item:    data.NewItem{Repo: "owner/repo", Title: "Crash on startup"},
+			wantErr: false,
+		},
+		{
+			name:    "issue needs a title",
+			item:    data.NewItem{Repo: "owner/repo"},
+			wantErr: true,
+		},
+		{
+			name:    "repo needs an owner",
+			item:    data.NewItem{Repo: "repo", Title: "Crash on startup"},
+			wantErr: true,
+		},
+		{
+			name:    "repo can't be a path",
+			item:    data.NewItem{Repo: "owner/repo/issues", Title: "Crash on startup"},
+			wantErr: true,
+		},
+		{
+			name:    "PR needs a base branch",
+			item:    data.NewItem{IsPR: true, Repo: "owner/repo", Title: "Fix crash", Head: "fix-crash"},
+			wantErr: true,
+		},
+		{
+			name:    "PR needs a head branch",
+			item:    data.NewItem{IsPR: true, Repo: "owner/repo", Title: "Fix crash", Base: "main"},
+			wantErr: true,
+		},
+		{
+			name:    "PR with branches",
+			item:    data.NewItem{IsPR: true, Repo: "owner/repo", Title: "Fix crash", Base: "main", Head: "fix-crash"},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.item)
+			if (err != "") != tt.wantErr {
+				t.Errorf("Validate() = %q, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "", want: []string{}},
+		{value: "bug", want: []string{"bug"}},
+		{value: "bug, help wanted", want: []string{"bug", "help wanted"}},
+		{value: " @me , octocat,,", want: []string{"@me", "octocat"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := SplitList(tt.value); !slices.Equal(got, tt.want) {
+				t.Errorf("SplitList(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+package labelpicker
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/repopicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// maxVisibleLabels is how many labels are listed at once, the list scrolls
+// to keep the cursor in view
+const maxVisibleLabels = 12
+
+// KeyMap defines keybindings for the picker
+type KeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Toggle  key.Binding
+	Apply   key.Binding
+	Refresh key.Binding
+	Cancel  key.Binding
+}
+
+var Keys = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "ctrl+p", "ctrl+k"),
+		key.WithHelp("↑/ctrl+p", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "ctrl+n", "ctrl+j"),
+		key.WithHelp("↓/ctrl+n", "down"),
+	),
+	Toggle: key.NewBinding(
+		key.WithKeys("tab", "ctrl+space"),
+		key.WithHelp("tab", "toggle"),
+	),
+	Apply: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "apply"),
+	),
+	Refresh: key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "refresh"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// Target is the PR or issue whose labels are picked
+type Target struct {
+	Repo   string
+	Number int
+	IsPR   bool
+	// Labels are the labels the PR or issue has
+	Labels []data.Label
+}
+
+// FetchedMsg is sent once the labels of Repo were fetched
+type FetchedMsg struct {
+	Repo   string
+	Labels []data.RepoLabel
+	Err    error
+}
+
+// AppliedMsg is sent when the picked labels differ from the ones Target has
+type AppliedMsg struct {
+	Target  Target
+	Added   []string
+	Removed []string
+	// Labels are all the labels the PR or issue has once the changes are
+	// applied
+	Labels []data.Label
+}
+
+// Model is an overlay picking the labels of a PR or an issue among the labels
+// of its repo. Labels are filtered by fuzzy matching their names, and several
+// can be added or removed at once.
+type Model struct {
+	ctx         *context.ProgramContext
+	target      Target
+	labels      []data.RepoLabel
+	visible     []data.RepoLabel
+	picked      map[string]bool
+	cursor      int
+	filterInput textinput.Model
+	width       int
+	focused     bool
+	isFetching  bool
+	fetchErr    error
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	ti := textinput.New()
+	ti.Placeholder = "type to filter labels"
+	ti.Prompt = "> "
+	ti.CharLimit = 100
+	ti.Width = 50
+
+	return Model{
+		ctx:         ctx,
+		filterInput: ti,
+		width:       70,
+	}
+}
+
+// Open shows the picker with the labels of target checked, and fetches the
+// labels of its repo
+func (m *Model) Open(target Target) tea.Cmd {
+	m.target = target
+	m.picked = map[string]bool{}
+	for _, label := range target.Labels {
+		m.picked[label.Name] = true
+	}
+	m.labels = nil
+	m.fetchErr = nil
+	m.isFetching = true
+	m.cursor = 0
+	m.focused = true
+	m.filterInput.SetValue("")
+	m.applyFilter()
+
+	return tea.Batch(m.filterInput.Focus(), m.fetch())
+}
+
+// fetch fetches the labels of the repo of the target, they're reused from
+// the metadata cache while they're fresh
+func (m Model) fetch() tea.Cmd {
+	repo := m.target.Repo
+	return func() tea.Msg {
+		labels, err := data.FetchRepoLabels(repo)
+		return FetchedMsg{Repo: repo, Labels: labels, Err: err}
+	}
+}
+
+func (m *Model) Close() {
+	m.focused = false
+	m.filterInput.Blur()
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(FetchedMsg); ok {
+		if msg.Repo != m.target.Repo {
+			return m, nil
+		}
+		m.isFetching = false
+		m.fetchErr = msg.Err
+		m.labels = msg.Labels
+		m.applyFilter()
+		return m, nil
+	}
+
+	if !m.focused {
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, Keys.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+			return m, nil
+		case key.Matches(msg, Keys.Down):
+			if m.cursor < len(m.visible)-1 {
+				m.cursor++
+			}
+			return m, nil
+		case key.Matches(msg, Keys.Toggle):
+			if len(m.visible) > 0 {
+				name := m.visible[m.cursor].Name
+				m.picked[name] = !m.picked[name]
+				if m.cursor < len(m.visible)-1 {
+					m.cursor++
+				}
+			}
+			return m, nil
+		case key.Matches(msg, Keys.Apply):
+			m.Close()
+			applied, ok := m.applied()
+			if !ok {
+				return m, nil
+			}
+			return m, func() tea.Msg { return applied }
+		case key.Matches(msg, Keys.Refresh):
+			data.RefreshMetadata(data.MetadataLabels, m.target.Repo)
+			m.fetchErr = nil
+			m.isFetching = true
+			return m, m.fetch()
+		case key.Matches(msg, Keys.Cancel):
+			m.Close()
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	before := m.filterInput.Value()
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	if m.filterInput.Value() != before {
+		m.cursor = 0
+		m.applyFilter()
+	}
+	return m, cmd
+}
+
+// applied returns the changes to the labels of the target, ok is false when
+// there's none
+func (m Model) applied() (msg AppliedMsg, ok bool) {
+	msg.Target = m.target
+	had := map[string]bool{}
+	for _, label := range m.target.Labels {
+		had[label.Name] = true
+		if m.picked[label.Name] {
+			msg.Labels = append(msg.Labels, label)
+		} else {
+			msg.Removed = append(msg.Removed, label.Name)
+		}
+	}
+	for _, label := range m.all() {
+		if m.picked[label.Name] && !had[label.Name] {
+			msg.Added = append(msg.Added, label.Name)
+			msg.Labels = append(msg.Labels, data.Label{Name: label.Name, Color: label.Color})
+		}
+	}
+	return msg, len(msg.Added) > 0 || len(msg.Removed) > 0
+}
+
+// all returns the labels of the repo, along with the ones the target has
+// that weren't fetched
+func (m Model) all() []data.RepoLabel {
+	all := slices.Clone(m.labels)
+	for _, label := range m.target.Labels {
+		if !slices.ContainsFunc(all, func(l data.RepoLabel) bool { return l.Name == label.Name }) {
+			all = append(all, data.RepoLabel{Name: label.Name, Color: label.Color})
+		}
+	}
+	return all
+}
+
+// applyFilter lists the labels whose names fuzzy match the filter, best
+// matches first
+func (m *Model) applyFilter() {
+	m.visible = Filter(m.all(), m.filterInput.Value())
+	m.cursor = min(m.cursor, max(0, len(m.visible)-1))
+}
+
+// Filter returns the labels whose names fuzzy match query, best matches
+// first. All the labels are returned in order when query is empty.
+func Filter(labels []data.RepoLabel, query string) []data.RepoLabel {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return labels
+	}
+
+	type scored struct {
+		label data.RepoLabel
+		score int
+	}
+	var matches []scored
+	for _, label := range labels {
+		if score, ok := repopicker.FuzzyScore(query, label.Name); ok {
+			matches = append(matches, scored{label: label, score: score})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b scored) int {
+		return b.score - a.score
+	})
+
+	filtered := make([]data.RepoLabel, 0, len(matches))
+	for _, match := range matches {
+		filtered = append(filtered, match.label)
+	}
+	return filtered
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText).
+		MarginBottom(1)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+
+	kind := "issue"
+	if m.target.IsPR {
+		kind = "PR"
+	}
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Labels of %s %s#%d", kind, m.target.Repo, m.target.Number)))
+	b.WriteString("\n\n")
+	b.WriteString(m.filterInput.View())
+	b.WriteString("\n\n")
+
+	start := 0
+	if m.cursor >= maxVisibleLabels {
+		start = m.cursor - maxVisibleLabels + 1
+	}
+	end := min(len(m.visible), start+maxVisibleLabels)
+
+	// the box has a padding of 2 on both sides
+	lineWidth := m.width - 4
+	for i := start; i < end; i++ {
+		label := m.visible[i]
+		cursor := "  "
+		style := faintStyle
+		if i == m.cursor {
+			cursor = "> "
+			style = lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Bold(true)
+		}
+
+		check := "[ ] "
+		if m.picked[label.Name] {
+			check = "[x] "
+			if i != m.cursor {
+				style = lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText)
+			}
+		}
+
+		swatch := faintStyle.Render("● ")
+		if label.Color != "" {
+			swatch = lipgloss.NewStyle().Foreground(lipgloss.Color("#" + label.Color)).Render("● ")
+		}
+
+		line := style.Render(cursor+check) + swatch + style.Render(label.Name)
+		if label.Description != "" {
+			line += faintStyle.Italic(true).Render(" - " + label.Description)
+		}
+		b.WriteString(ansi.Truncate(line, lineWidth, constants.Ellipsis))
+		b.WriteString("\n")
+	}
+
+	switch {
+	case m.isFetching && len(m.visible) == 0:
+		b.WriteString(faintStyle.Render("  Fetching labels..."))
+		b.WriteString("\n")
+	case len(m.visible) == 0:
+		b.WriteString(faintStyle.Render("  No matching labels"))
+		b.WriteString("\n")
+	case len(m.visible) > end:
+		b.WriteString(faintStyle.Render(fmt.Sprintf("  … %d more", len(m.visible)-end)))
+		b.WriteString("\n")
+	}
+
+	if m.fetchErr != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(
+			ansi.Truncate(fmt.Sprintf("  Failed fetching labels: %v", m.fetchErr), lineWidth, constants.Ellipsis)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	b.WriteString(helpStyle.Render("type to filter • ↑/↓: navigate • Tab: toggle • Enter: apply • Ctrl+R: refresh • Esc: cancel"))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width)
+
+	return boxStyle.Render(b.String())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
@@ -0,0 +1,111 @@
+package labelpicker
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestFilter(t *testing.T) {
+	labels := []data.RepoLabel{
+		{Name: "bug"}, {Name: "documentation"}, {Name: "good first issue"}, {Name: "kind/bug"},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "no query lists everything",
+			query: "",
+			want:  []string{"bug", "documentation", "good first issue", "kind/bug"},
+		},
+		{
+			name:  "best matches first",
+			query: "bug",
+			want:  []string{"bug", "kind/bug"},
+		},
+		{
+			name:  "fuzzy match",
+			query: "gfi",
+			want:  []string{"good first issue"},
+		},
+		{
+			name:  "no match",
+			query: "wontfix",
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, label := range Filter(labels, tt.query) {
+				got = append(got, label.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	m := NewModel(nil)
+	m.Open(Target{
+		Repo:   "me/gh-dash",
+		Number: 1,
+		Labels: []data.Label{{Name: "bug", Color: "d73a4a"}, {Name: "stale", Color: "ededed"}},
+	})
+	m, _ = m.Update(FetchedMsg{Repo: "me/gh-dash", Labels: []data.RepoLabel{
+		{Name: "bug", Color: "d73a4a"}, {Name: "enhancement", Color: "a2eeef"},
+	}})
+
+	// the label that wasn't fetched is listed last
+	for _, k := range []tea.KeyMsg{
+		{Type: tea.KeyDown},
+		{Type: tea.KeyTab},
+		{Type: tea.KeyTab},
+	} {
+		m, _ = m.Update(k)
+	}
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("applying changed labels didn't send a msg")
+	}
+
+	got, ok := cmd().(AppliedMsg)
+	if !ok {
+		t.Fatalf("applying sent %T, want AppliedMsg", cmd())
+	}
+	if !reflect.DeepEqual(got.Added, []string{"enhancement"}) {
+		t.Errorf("Added = %v, want [enhancement]", got.Added)
+	}
+	if !reflect.DeepEqual(got.Removed, []string{"stale"}) {
+		t.Errorf("Removed = %v, want [stale]", got.Removed)
+	}
+	want := []data.Label{{Name: "bug", Color: "d73a4a"}, {Name: "enhancement", Color: "a2eeef"}}
+	if !reflect.DeepEqual(got.Labels, want) {
+		t.Errorf("Labels = %v, want %v", got.Labels, want)
+	}
+}
+
+func TestApplyUnchanged(t *testing.T) {
+	m := NewModel(nil)
+	m.Open(Target{Repo: "me/gh-dash", Number: 1, Labels: []data.Label{{Name: "bug"}}})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		t.Errorf("applying unchanged labels sent %v", cmd())
+	}
+	if m.Focused() {
+		t.Error("the picker is still open after applying")
+	}
+}
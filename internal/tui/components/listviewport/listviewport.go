@@ -56,6 +56,10 @@ func NewModel(
 
 func (m *Model) SetNumItems(numItems int) {
 	m.NumCurrentItems = numItems
+	// refetched rows can be fewer than the ones they replaced
+	if m.currId >= numItems {
+		m.currId = max(0, numItems-1)
+	}
 	m.bottomBoundId = utils.Min(m.NumCurrentItems-1, m.getNumPrsPerPage()-1)
 }
 
@@ -121,6 +125,38 @@ func (m *Model) LastItem() int {
 	return m.currId
 }
 
+// SelectItem moves the selection to the item at index i, scrolling it into
+// view when it's out of it
+func (m *Model) SelectItem(i int) int {
+	m.currId = utils.Max(utils.Min(i, m.NumCurrentItems-1), 0)
+	perPage := m.getNumPrsPerPage()
+	switch {
+	case m.currId < m.topBoundId:
+		m.topBoundId = m.currId
+		m.bottomBoundId = m.currId + perPage - 1
+	case m.currId > m.bottomBoundId:
+		m.bottomBoundId = m.currId
+		m.topBoundId = utils.Max(m.currId-perPage+1, 0)
+	default:
+		return m.currId
+	}
+	m.viewport.SetYOffset(m.topBoundId * m.ListItemHeight)
+	return m.currId
+}
+
+// ItemAt returns the index of the item shown at line y of the viewport, and
+// false when there's none there
+func (m *Model) ItemAt(y int) (int, bool) {
+	if m.ListItemHeight == 0 || y < 0 || y >= m.viewport.Height {
+		return 0, false
+	}
+	i := (m.viewport.YOffset + y) / m.ListItemHeight
+	if i >= m.NumCurrentItems {
+		return 0, false
+	}
+	return i, true
+}
+
 func (m *Model) SetDimensions(dimensions constants.Dimensions) {
 	m.viewport.Height = max(0, dimensions.Height)
 	m.viewport.Width = max(0, dimensions.Width)
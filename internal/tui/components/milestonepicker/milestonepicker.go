@@ -0,0 +1,368 @@
+package milestonepicker
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/repopicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// maxVisibleMilestones is how many milestones are listed at once, the list
+// scrolls to keep the cursor in view
+const maxVisibleMilestones = 10
+
+// KeyMap defines keybindings for the picker
+type KeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Apply   key.Binding
+	Refresh key.Binding
+	Cancel  key.Binding
+}
+
+var Keys = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "ctrl+p", "ctrl+k"),
+		key.WithHelp("↑/ctrl+p", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "ctrl+n", "ctrl+j"),
+		key.WithHelp("↓/ctrl+n", "down"),
+	),
+	Apply: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "apply"),
+	),
+	Refresh: key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "refresh"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// Target is the PR or issue whose milestone is picked
+type Target struct {
+	Repo   string
+	Number int
+	IsPR   bool
+	// Milestone is the milestone the PR or issue is in, nil when it's in
+	// none
+	Milestone *data.ItemMilestone
+}
+
+// FetchedMsg is sent once the milestones of Repo were fetched
+type FetchedMsg struct {
+	Repo       string
+	Milestones []data.ItemMilestone
+	Err        error
+}
+
+// AppliedMsg is sent when the picked milestone differs from the one Target
+// is in, Milestone is nil when it's cleared
+type AppliedMsg struct {
+	Target    Target
+	Milestone *data.ItemMilestone
+}
+
+// Model is an overlay picking the milestone of a PR or an issue among the
+// open milestones of its repo, filtered by fuzzy matching their titles. The
+// first entry clears the milestone.
+type Model struct {
+	ctx         *context.ProgramContext
+	target      Target
+	milestones  []data.ItemMilestone
+	visible     []data.ItemMilestone
+	cursor      int
+	filterInput textinput.Model
+	width       int
+	focused     bool
+	isFetching  bool
+	fetchErr    error
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	ti := textinput.New()
+	ti.Placeholder = "type to filter milestones"
+	ti.Prompt = "> "
+	ti.CharLimit = 100
+	ti.Width = 50
+
+	return Model{
+		ctx:         ctx,
+		filterInput: ti,
+		width:       70,
+	}
+}
+
+// Open shows the picker, and fetches the milestones of the repo of target
+func (m *Model) Open(target Target) tea.Cmd {
+	m.target = target
+	m.milestones = nil
+	m.fetchErr = nil
+	m.isFetching = true
+	m.cursor = 0
+	m.focused = true
+	m.filterInput.SetValue("")
+	m.applyFilter()
+
+	return tea.Batch(m.filterInput.Focus(), m.fetch())
+}
+
+// fetch fetches the milestones of the repo of the target, they're reused from
+// the metadata cache while they're fresh
+func (m Model) fetch() tea.Cmd {
+	repo := m.target.Repo
+	return func() tea.Msg {
+		milestones, err := data.FetchRepoMilestones(repo)
+		return FetchedMsg{Repo: repo, Milestones: milestones, Err: err}
+	}
+}
+
+func (m *Model) Close() {
+	m.focused = false
+	m.filterInput.Blur()
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(FetchedMsg); ok {
+		if msg.Repo != m.target.Repo {
+			return m, nil
+		}
+		m.isFetching = false
+		m.fetchErr = msg.Err
+		m.milestones = msg.Milestones
+		m.applyFilter()
+		return m, nil
+	}
+
+	if !m.focused {
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, Keys.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+			return m, nil
+		case key.Matches(msg, Keys.Down):
+			if m.cursor < len(m.visible) {
+				m.cursor++
+			}
+			return m, nil
+		case key.Matches(msg, Keys.Apply):
+			m.Close()
+			applied, ok := m.applied()
+			if !ok {
+				return m, nil
+			}
+			return m, func() tea.Msg { return applied }
+		case key.Matches(msg, Keys.Refresh):
+			data.RefreshMetadata(data.MetadataMilestones, m.target.Repo)
+			m.fetchErr = nil
+			m.isFetching = true
+			return m, m.fetch()
+		case key.Matches(msg, Keys.Cancel):
+			m.Close()
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	before := m.filterInput.Value()
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	if m.filterInput.Value() != before {
+		m.cursor = 0
+		m.applyFilter()
+	}
+	return m, cmd
+}
+
+// picked returns the milestone under the cursor, nil for the entry clearing
+// the milestone
+func (m Model) picked() *data.ItemMilestone {
+	if m.cursor == 0 || m.cursor > len(m.visible) {
+		return nil
+	}
+	return &m.visible[m.cursor-1]
+}
+
+// applied returns the milestone picked for the target, ok is false when it's
+// the one the target is in
+func (m Model) applied() (msg AppliedMsg, ok bool) {
+	msg = AppliedMsg{Target: m.target, Milestone: m.picked()}
+	switch {
+	case msg.Milestone == nil:
+		return msg, m.target.Milestone != nil
+	case m.target.Milestone == nil:
+		return msg, true
+	default:
+		return msg, msg.Milestone.Title != m.target.Milestone.Title
+	}
+}
+
+// applyFilter lists the milestones whose titles fuzzy match the filter, best
+// matches first
+func (m *Model) applyFilter() {
+	m.visible = Filter(m.milestones, m.filterInput.Value())
+	// the cursor starts on the first milestone, the one clearing it is above
+	if m.cursor == 0 && len(m.visible) > 0 {
+		m.cursor = 1
+	}
+	m.cursor = min(m.cursor, len(m.visible))
+}
+
+// Filter returns the milestones whose titles fuzzy match query, best matches
+// first. All the milestones are returned in order when query is empty.
+func Filter(milestones []data.ItemMilestone, query string) []data.ItemMilestone {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return milestones
+	}
+
+	type scored struct {
+		milestone data.ItemMilestone
+		score     int
+	}
+	var matches []scored
+	for _, milestone := range milestones {
+		if score, ok := repopicker.FuzzyScore(query, milestone.Title); ok {
+			matches = append(matches, scored{milestone: milestone, score: score})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b scored) int {
+		return b.score - a.score
+	})
+
+	filtered := make([]data.ItemMilestone, 0, len(matches))
+	for _, match := range matches {
+		filtered = append(filtered, match.milestone)
+	}
+	return filtered
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText).
+		MarginBottom(1)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Bold(true)
+
+	kind := "issue"
+	if m.target.IsPR {
+		kind = "PR"
+	}
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Milestone of %s %s#%d", kind, m.target.Repo, m.target.Number)))
+	b.WriteString("\n\n")
+	b.WriteString(m.filterInput.View())
+	b.WriteString("\n\n")
+
+	// the box has a padding of 2 on both sides
+	lineWidth := m.width - 4
+	current := ""
+	if m.target.Milestone != nil {
+		current = m.target.Milestone.Title
+	}
+	renderLine := func(i int, title, details string, isCurrent bool) {
+		cursor, style := "  ", faintStyle
+		if i == m.cursor {
+			cursor, style = "> ", selectedStyle
+		}
+		marker := "  "
+		if isCurrent {
+			marker = "● "
+		}
+		line := style.Render(cursor+marker+title) + faintStyle.Italic(true).Render(details)
+		b.WriteString(ansi.Truncate(line, lineWidth, constants.Ellipsis))
+		b.WriteString("\n")
+	}
+
+	start := 0
+	if m.cursor >= maxVisibleMilestones {
+		start = m.cursor - maxVisibleMilestones + 1
+	}
+	end := min(len(m.visible)+1, start+maxVisibleMilestones)
+	for i := start; i < end; i++ {
+		if i == 0 {
+			renderLine(i, "No milestone", "", current == "")
+			continue
+		}
+		milestone := m.visible[i-1]
+		renderLine(i, milestone.Title, m.renderDueOn(milestone), milestone.Title == current)
+	}
+
+	switch {
+	case m.isFetching:
+		b.WriteString(faintStyle.Render("  Fetching milestones..."))
+		b.WriteString("\n")
+	case len(m.visible) == 0 && m.filterInput.Value() != "":
+		b.WriteString(faintStyle.Render("  No matching milestones"))
+		b.WriteString("\n")
+	case len(m.visible)+1 > end:
+		b.WriteString(faintStyle.Render(fmt.Sprintf("  … %d more", len(m.visible)+1-end)))
+		b.WriteString("\n")
+	}
+
+	if m.fetchErr != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(
+			ansi.Truncate(fmt.Sprintf("  Failed fetching milestones: %v", m.fetchErr), lineWidth, constants.Ellipsis)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	b.WriteString(helpStyle.Render("type to filter • ↑/↓: navigate • Enter: apply • Ctrl+R: refresh • Esc: cancel"))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width)
+
+	return boxStyle.Render(b.String())
+}
+
+func (m Model) renderDueOn(milestone data.ItemMilestone) string {
+	if milestone.DueOn == nil {
+		return ""
+	}
+	if milestone.DueOn.Before(time.Now()) {
+		return fmt.Sprintf(" - overdue by %s", utils.TimeElapsed(*milestone.DueOn))
+	}
+	return fmt.Sprintf(" - due %s", milestone.DueOn.Format("Jan 2, 2006"))
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
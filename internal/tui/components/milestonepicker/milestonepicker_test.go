@@ -0,0 +1,114 @@
+package milestonepicker
+
+import (
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestFilter(t *testing.T) {
+	milestones := []data.ItemMilestone{
+		{Title: "v4.1"}, {Title: "v5.0"}, {Title: "Backlog"}, {Title: "Q3 planning"},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "no query lists everything",
+			query: "",
+			want:  []string{"v4.1", "v5.0", "Backlog", "Q3 planning"},
+		},
+		{
+			name:  "fuzzy match",
+			query: "v5",
+			want:  []string{"v5.0"},
+		},
+		{
+			name:  "no match",
+			query: "someday",
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, milestone := range Filter(milestones, tt.query) {
+				got = append(got, milestone.Title)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	fetched := FetchedMsg{Repo: "me/gh-dash", Milestones: []data.ItemMilestone{
+		{Number: 1, Title: "v4.1"}, {Number: 2, Title: "v5.0"},
+	}}
+
+	tests := []struct {
+		name    string
+		current *data.ItemMilestone
+		keys    []tea.KeyMsg
+		want    *data.ItemMilestone
+		wantMsg bool
+	}{
+		{
+			name:    "set",
+			keys:    []tea.KeyMsg{{Type: tea.KeyDown}},
+			want:    &data.ItemMilestone{Number: 2, Title: "v5.0"},
+			wantMsg: true,
+		},
+		{
+			name:    "clear",
+			current: &data.ItemMilestone{Number: 1, Title: "v4.1"},
+			keys:    []tea.KeyMsg{{Type: tea.KeyUp}},
+			wantMsg: true,
+		},
+		{
+			name:    "unchanged",
+			current: &data.ItemMilestone{Number: 1, Title: "v4.1"},
+		},
+		{
+			name: "still none",
+			keys: []tea.KeyMsg{{Type: tea.KeyUp}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel(nil)
+			m.Open(Target{Repo: "me/gh-dash", Number: 1, Milestone: tt.current})
+			m, _ = m.Update(fetched)
+			for _, k := range tt.keys {
+				m, _ = m.Update(k)
+			}
+
+			m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			if m.Focused() {
+				t.Error("the picker is still open after applying")
+			}
+			if (cmd != nil) != tt.wantMsg {
+				t.Fatalf("applying sent a msg = %v, want %v", cmd != nil, tt.wantMsg)
+			}
+			if cmd == nil {
+				return
+			}
+			got, ok := cmd().(AppliedMsg)
+			if !ok {
+				t.Fatalf("applying sent %T, want AppliedMsg", cmd())
+			}
+			if !reflect.DeepEqual(got.Milestone, tt.want) {
+				t.Errorf("Milestone = %v, want %v", got.Milestone, tt.want)
+			}
+		})
+	}
+}
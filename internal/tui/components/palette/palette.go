@@ -0,0 +1,286 @@
+package palette
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/repopicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// maxVisibleCommands is how many commands are listed at once, the list
+// scrolls to keep the cursor in view
+const maxVisibleCommands = 12
+
+// Kind is what running a command does
+type Kind int
+
+const (
+	// Recent is an action performed recently, run again on the selected row
+	Recent Kind = iota
+	// Section jumps to a section of the current view
+	Section
+	// Action is a builtin or user-defined keybinding
+	Action
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Recent:
+		return "recent"
+	case Section:
+		return "section"
+	default:
+		return "action"
+	}
+}
+
+// Command is an entry of the palette
+type Command struct {
+	Kind  Kind
+	Title string
+	// Key is the key pressed to run the command, empty for sections
+	Key tea.KeyMsg
+	// SectionId is the section jumped to by Section commands
+	SectionId int
+	// Desc is shown after the title, e.g. the row a recent action was
+	// performed on
+	Desc string
+}
+
+// KeyMap defines keybindings for the palette
+type KeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Cancel key.Binding
+}
+
+var Keys = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "ctrl+k"),
+		key.WithHelp("↑/ctrl+k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "ctrl+n", "ctrl+j"),
+		key.WithHelp("↓/ctrl+n", "down"),
+	),
+	Select: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "run"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c", "ctrl+p"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// SelectedMsg is sent when a command is picked to be run
+type SelectedMsg struct {
+	Command Command
+}
+
+// Model is an overlay listing the commands of the current view, filtered by
+// fuzzy matching their titles
+type Model struct {
+	ctx         *context.ProgramContext
+	commands    []Command
+	visible     []Command
+	cursor      int
+	filterInput textinput.Model
+	width       int
+	focused     bool
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	ti := textinput.New()
+	ti.Placeholder = "type a command or section"
+	ti.Prompt = "> "
+	ti.CharLimit = 100
+	ti.Width = 50
+
+	return Model{
+		ctx:         ctx,
+		filterInput: ti,
+		width:       70,
+	}
+}
+
+// Open shows the palette with commands, in the order they're listed when
+// nothing is typed
+func (m *Model) Open(commands []Command) tea.Cmd {
+	m.commands = commands
+	m.cursor = 0
+	m.focused = true
+	m.filterInput.SetValue("")
+	m.visible = Filter(m.commands, "")
+	return m.filterInput.Focus()
+}
+
+func (m *Model) Close() {
+	m.focused = false
+	m.filterInput.Blur()
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		m.filterInput, cmd = m.filterInput.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(keyMsg, Keys.Up):
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+	case key.Matches(keyMsg, Keys.Down):
+		if m.cursor < len(m.visible)-1 {
+			m.cursor++
+		}
+		return m, nil
+	case key.Matches(keyMsg, Keys.Select):
+		m.Close()
+		if len(m.visible) == 0 {
+			return m, nil
+		}
+		selected := m.visible[m.cursor]
+		return m, func() tea.Msg {
+			return SelectedMsg{Command: selected}
+		}
+	case key.Matches(keyMsg, Keys.Cancel):
+		m.Close()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	before := m.filterInput.Value()
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	if m.filterInput.Value() != before {
+		m.cursor = 0
+		m.visible = Filter(m.commands, m.filterInput.Value())
+	}
+	return m, cmd
+}
+
+// Filter returns the commands whose title fuzzy matches query, best matches
+// first. Recent commands are only listed when nothing is typed, the actions
+// they ran are listed anyway.
+func Filter(commands []Command, query string) []Command {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return commands
+	}
+
+	type scored struct {
+		command Command
+		score   int
+	}
+	var matches []scored
+	for _, c := range commands {
+		if c.Kind == Recent {
+			continue
+		}
+		if score, ok := repopicker.FuzzyScore(query, c.Title); ok {
+			matches = append(matches, scored{command: c, score: score})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b scored) int {
+		return b.score - a.score
+	})
+
+	visible := make([]Command, 0, len(matches))
+	for _, match := range matches {
+		visible = append(visible, match.command)
+	}
+	return visible
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+
+	b.WriteString(titleStyle.Render("Command Palette"))
+	b.WriteString("\n\n")
+	b.WriteString(m.filterInput.View())
+	b.WriteString("\n\n")
+
+	start := 0
+	if m.cursor >= maxVisibleCommands {
+		start = m.cursor - maxVisibleCommands + 1
+	}
+	end := min(len(m.visible), start+maxVisibleCommands)
+
+	for i := start; i < end; i++ {
+		c := m.visible[i]
+		cursor := "  "
+		style := faintStyle
+		if i == m.cursor {
+			cursor = "> "
+			style = lipgloss.NewStyle().
+				Foreground(m.ctx.Theme.PrimaryText).
+				Bold(true)
+		}
+
+		keyStr := ""
+		if c.Kind != Section {
+			keyStr = c.Key.String()
+		}
+		line := fmt.Sprintf("%s%-8s %-9s %s", cursor, keyStr, c.Kind, c.Title)
+		b.WriteString(style.Render(line))
+		if c.Desc != "" {
+			b.WriteString(faintStyle.Italic(true).Render(fmt.Sprintf(" - %s", c.Desc)))
+		}
+		b.WriteString("\n")
+	}
+
+	switch {
+	case len(m.visible) == 0:
+		b.WriteString(faintStyle.Render("  No matching commands"))
+		b.WriteString("\n")
+	case len(m.visible) > end:
+		b.WriteString(faintStyle.Render(fmt.Sprintf("  … %d more", len(m.visible)-end)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render(
+		"type to filter • ↑/↓: navigate • Enter: run • Esc: cancel"))
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width).
+		Render(b.String())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
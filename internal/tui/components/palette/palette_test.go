@@ -0,0 +1,61 @@
+package palette
+
+import (
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	commands := []Command{
+		{Kind: Recent, Title: "approve"},
+		{Kind: Section, Title: "My Pull Requests"},
+		{Kind: Section, Title: "Needs My Review"},
+		{Kind: Action, Title: "approve"},
+		{Kind: Action, Title: "merge"},
+		{Kind: Action, Title: "go to PRs"},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "nothing typed",
+			query: " ",
+			want:  []string{"approve", "My Pull Requests", "Needs My Review", "approve", "merge", "go to PRs"},
+		},
+		{
+			name:  "recent commands are skipped",
+			query: "appr",
+			want:  []string{"approve"},
+		},
+		{
+			name:  "fuzzy match",
+			query: "mpr",
+			want:  []string{"My Pull Requests"},
+		},
+		{
+			name:  "best matches first",
+			query: "re",
+			want:  []string{"Needs My Review", "My Pull Requests", "merge", "approve"},
+		},
+		{
+			name:  "no match",
+			query: "xyz",
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Filter(commands, tt.query)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Filter() returned %d commands, want %d: %v", len(got), len(tt.want), got)
+			}
+			for i, c := range got {
+				if c.Title != tt.want[i] {
+					t.Errorf("Filter()[%d] = %q, want %q", i, c.Title, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package planner
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// KeyMap defines keybindings for the overlay
+type KeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	Today    key.Binding
+	Tomorrow key.Binding
+	Later    key.Binding
+	Unplan   key.Binding
+	Close    key.Binding
+}
+
+var Keys = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Today: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "today"),
+	),
+	Tomorrow: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "tomorrow"),
+	),
+	Later: key.NewBinding(
+		key.WithKeys("l"),
+		key.WithHelp("l", "later"),
+	),
+	Unplan: key.NewBinding(
+		key.WithKeys("x", "backspace"),
+		key.WithHelp("x", "unplan"),
+	),
+	Close: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c", "q", "enter"),
+		key.WithHelp("esc", "close"),
+	),
+}
+
+// ClosedMsg is sent when the overlay is closed
+type ClosedMsg struct {
+	// Changed is whether a review was planned or unplanned
+	Changed bool
+}
+
+// Model is an overlay assigning review requests to the buckets of the review
+// plan, the reviews are listed by bucket with the unplanned ones last
+type Model struct {
+	ctx     *context.ProgramContext
+	reviews []state.PlannedReview
+	cursor  int
+	width   int
+	focused bool
+	changed bool
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:   ctx,
+		width: 80,
+	}
+}
+
+// Open shows the overlay with reviews, the planned ones that aren't among
+// them are listed too
+func (m *Model) Open(reviews []state.PlannedReview) {
+	listed := map[string]bool{}
+	for _, review := range reviews {
+		listed[review.Url] = true
+	}
+	for _, bucket := range state.PlanBuckets {
+		for _, review := range m.ctx.ReviewPlan.Reviews(bucket, time.Now()) {
+			if !listed[review.Url] {
+				reviews = append(reviews, review)
+			}
+		}
+	}
+
+	m.reviews = reviews
+	m.cursor = 0
+	m.focused = true
+	m.changed = false
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+// group is the reviews of a bucket, or the unplanned ones when bucket is
+// empty
+type group struct {
+	bucket  state.PlanBucket
+	reviews []state.PlannedReview
+}
+
+func (m Model) groups() []group {
+	now := time.Now()
+	groups := make([]group, 0, len(state.PlanBuckets)+1)
+	for _, bucket := range state.PlanBuckets {
+		groups = append(groups, group{bucket: bucket})
+	}
+	unplanned := group{}
+	for _, review := range m.reviews {
+		bucket, ok := m.ctx.ReviewPlan.Bucket(review.Url, now)
+		if !ok {
+			unplanned.reviews = append(unplanned.reviews, review)
+			continue
+		}
+		for i := range groups {
+			if groups[i].bucket == bucket {
+				groups[i].reviews = append(groups[i].reviews, review)
+			}
+		}
+	}
+	return append(groups, unplanned)
+}
+
+// ordered returns the reviews in the order they're listed
+func (m Model) ordered() []state.PlannedReview {
+	var reviews []state.PlannedReview
+	for _, g := range m.groups() {
+		reviews = append(reviews, g.reviews...)
+	}
+	return reviews
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	reviews := m.ordered()
+	switch {
+	case key.Matches(keyMsg, Keys.Up):
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case key.Matches(keyMsg, Keys.Down):
+		if m.cursor < len(reviews)-1 {
+			m.cursor++
+		}
+	case key.Matches(keyMsg, Keys.Today):
+		m.plan(reviews, state.PlanToday)
+	case key.Matches(keyMsg, Keys.Tomorrow):
+		m.plan(reviews, state.PlanTomorrow)
+	case key.Matches(keyMsg, Keys.Later):
+		m.plan(reviews, state.PlanLater)
+	case key.Matches(keyMsg, Keys.Unplan):
+		m.plan(reviews, "")
+	case key.Matches(keyMsg, Keys.Close):
+		m.focused = false
+		changed := m.changed
+		return m, func() tea.Msg {
+			return ClosedMsg{Changed: changed}
+		}
+	}
+
+	return m, nil
+}
+
+// plan moves the selected review to bucket, or out of the plan when it's
+// empty, keeping it selected
+func (m *Model) plan(reviews []state.PlannedReview, bucket state.PlanBucket) {
+	if len(reviews) == 0 {
+		return
+	}
+
+	selected := reviews[m.cursor]
+	if bucket == "" {
+		if !m.ctx.ReviewPlan.Unplan(selected.Url) {
+			return
+		}
+	} else {
+		m.ctx.ReviewPlan.Plan(selected, bucket, time.Now())
+	}
+	m.changed = true
+
+	for i, review := range m.ordered() {
+		if review.Url == selected.Url {
+			m.cursor = i
+		}
+	}
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+
+	b.WriteString(titleStyle.Render("Review Plan"))
+	b.WriteString("\n")
+
+	i := 0
+	for _, g := range m.groups() {
+		title := "Unplanned"
+		if g.bucket != "" {
+			title = strings.ToUpper(string(g.bucket[:1])) + string(g.bucket[1:])
+		}
+		b.WriteString("\n")
+		b.WriteString(titleStyle.Render(fmt.Sprintf("%s (%d)", title, len(g.reviews))))
+		b.WriteString("\n")
+
+		for _, review := range g.reviews {
+			cursor := "  "
+			style := faintStyle
+			if i == m.cursor {
+				cursor = "> "
+				style = lipgloss.NewStyle().
+					Foreground(m.ctx.Theme.PrimaryText).
+					Bold(true)
+			}
+			line := fmt.Sprintf("%s%s#%d %s", cursor, review.Repo, review.Number, review.Title)
+			b.WriteString(style.Render(ansi.Truncate(line, m.width-6, constants.Ellipsis)))
+			b.WriteString("\n")
+			i++
+		}
+	}
+
+	if len(m.reviews) == 0 {
+		b.WriteString("\n")
+		b.WriteString(faintStyle.Render("No review requests fetched yet"))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render(
+		"↑/↓: navigate • t: today • m: tomorrow • l: later • x: unplan • Esc: close"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width).
+		Render(b.String())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
@@ -10,6 +10,9 @@ type Data struct {
 	Primary    *data.PullRequestData
 	Enriched   data.EnrichedPullRequestData
 	IsEnriched bool
+	// Forge is the GitLab or Gitea forge the PR is from, empty for GitHub PRs
+	// which are the only ones that can be enriched
+	Forge string
 }
 
 func (data Data) GetTitle() string {
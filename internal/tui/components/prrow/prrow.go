@@ -2,16 +2,20 @@ package prrow
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	checks "github.com/dlvhdr/x/gh-checks"
 
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/git"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
 
@@ -21,6 +25,14 @@ type PullRequest struct {
 	Branch         git.Branch
 	Columns        []table.Column
 	ShowAuthorIcon bool
+	// Unread renders the title in bold, for the unread rows of inboxes
+	Unread bool
+	// ProjectFields are the values of the section's project field columns
+	ProjectFields []string
+	// Computed are the cells of the section's computed columns
+	Computed []table.Cell
+	// Score is the row's score, shown in the score column
+	Score float64
 }
 
 func (pr *PullRequest) getTextStyle() lipgloss.Style {
@@ -45,18 +57,30 @@ func (pr *PullRequest) renderReviewStatus() string {
 		return "-"
 	}
 	reviewCellStyle := pr.getTextStyle()
-	if pr.Data.Primary.ReviewDecision == "APPROVED" {
+	if pr.Data.Primary.ReviewDecision == "CHANGES_REQUESTED" {
 		reviewCellStyle = reviewCellStyle.Foreground(
-			pr.Ctx.Theme.SuccessText,
+			pr.Ctx.Theme.ErrorText,
 		)
-		return reviewCellStyle.Render("󰄬")
+		return reviewCellStyle.Render("")
 	}
 
-	if pr.Data.Primary.ReviewDecision == "CHANGES_REQUESTED" {
+	// the approvals of an open PR count towards the ones its base branch
+	// requires
+	if approved, required := pr.Data.Primary.Approvals(); required > 0 &&
+		pr.Data.Primary.State == "OPEN" {
+		if approved >= required {
+			reviewCellStyle = reviewCellStyle.Foreground(pr.Ctx.Theme.SuccessText)
+		} else if approved > 0 {
+			reviewCellStyle = reviewCellStyle.Foreground(pr.Ctx.Theme.WarningText)
+		}
+		return reviewCellStyle.Render(fmt.Sprintf("%d/%d", approved, required))
+	}
+
+	if pr.Data.Primary.ReviewDecision == "APPROVED" {
 		reviewCellStyle = reviewCellStyle.Foreground(
-			pr.Ctx.Theme.ErrorText,
+			pr.Ctx.Theme.SuccessText,
 		)
-		return reviewCellStyle.Render("")
+		return reviewCellStyle.Render("󰄬")
 	}
 
 	if pr.Data.Primary.Reviews.TotalCount > 0 {
@@ -76,7 +100,7 @@ func (pr *PullRequest) renderState() string {
 	switch pr.Data.Primary.State {
 	case "OPEN":
 		if pr.Data.Primary.IsDraft {
-			return mergeCellStyle.Foreground(pr.Ctx.Theme.FaintText).Render(constants.DraftIcon)
+			return mergeCellStyle.Foreground(pr.Ctx.Styles.Colors.DraftPR).Render(constants.DraftIcon)
 		} else {
 			return mergeCellStyle.Foreground(pr.Ctx.Styles.Colors.OpenPR).Render(constants.OpenIcon)
 		}
@@ -123,6 +147,43 @@ func (pr *PullRequest) renderCiStatus() string {
 	}
 }
 
+// renderMergeQueue renders the position of the PR in the merge queue, colored
+// by whether it can be merged
+func (pr *PullRequest) renderMergeQueue() string {
+	if pr.Data.Primary == nil || pr.Data.Primary.MergeQueueEntry == nil {
+		return ""
+	}
+
+	entry := pr.Data.Primary.MergeQueueEntry
+	style := pr.getTextStyle()
+	switch entry.State {
+	case data.MergeQueueEntryStateMergeable:
+		style = style.Foreground(pr.Ctx.Theme.SuccessText)
+	case data.MergeQueueEntryStateUnmergeable:
+		style = style.Foreground(pr.Ctx.Theme.ErrorText)
+	case data.MergeQueueEntryStateLocked:
+		style = style.Foreground(pr.Ctx.Theme.FaintText)
+	}
+	return style.Render(fmt.Sprintf("#%d", entry.Position+1))
+}
+
+// renderMergeable renders whether the PR conflicts with its base branch,
+// while GitHub is still computing it the PR is polled until it knows
+func (pr *PullRequest) renderMergeable() string {
+	if pr.Data.Primary == nil || pr.Data.Primary.State != "OPEN" {
+		return ""
+	}
+	switch pr.Data.Primary.Mergeable {
+	case data.MergeableStateMergeable:
+		return pr.getTextStyle().Foreground(pr.Ctx.Theme.SuccessText).Render(constants.SuccessIcon)
+	case data.MergeableStateConflicting:
+		return pr.getTextStyle().Foreground(pr.Ctx.Theme.ErrorText).Render(constants.ConflictIcon)
+	case data.MergeableStateUnknown:
+		return pr.Ctx.Styles.Common.FaintTextStyle.Render(constants.WaitingIcon)
+	}
+	return ""
+}
+
 func (pr *PullRequest) RenderLines(isSelected bool) string {
 	if pr.Data.Primary == nil {
 		return "-"
@@ -156,6 +217,33 @@ func (pr *PullRequest) RenderLines(isSelected bool) string {
 	)
 }
 
+// renderSize renders the size badge of the PR, colored from the smaller PRs
+// to review to the larger ones
+func (pr *PullRequest) renderSize() string {
+	if pr.Data.Primary == nil {
+		return "-"
+	}
+	size := pr.Data.Primary.Size(pr.Ctx.Config.PrSize)
+	style := pr.getTextStyle()
+	switch size {
+	case "XS", "S":
+		style = style.Foreground(pr.Ctx.Theme.SuccessText)
+	case "M":
+		style = style.Foreground(pr.Ctx.Theme.WarningText)
+	default:
+		style = style.Foreground(pr.Ctx.Theme.ErrorText)
+	}
+	return style.Render(size)
+}
+
+func (pr *PullRequest) renderChangedFiles() string {
+	if pr.Data.Primary == nil {
+		return "-"
+	}
+	return pr.Ctx.Styles.Common.FaintTextStyle.Render(
+		components.FormatNumber(pr.Data.Primary.ChangedFiles))
+}
+
 func keepSameSpacesOnAddDeletions(str string) string {
 	strAsList := strings.Split(str, " ")
 	return fmt.Sprintf(
@@ -167,12 +255,22 @@ func keepSameSpacesOnAddDeletions(str string) string {
 	)
 }
 
+// rowState returns the state the title of the PR is colored by
+func (pr *PullRequest) rowState() theme.RowState {
+	checksState := pr.GetStatusChecksRollup()
+	failing := checksState == checks.CommitStateError || checksState == checks.CommitStateFailure
+	return components.GetRowState(pr.Ctx, pr.Data.Primary.State, pr.Data.Primary.IsDraft,
+		failing, pr.Data.Primary.UpdatedAt)
+}
+
 func (pr *PullRequest) renderTitle() string {
-	return components.RenderIssueTitle(
+	return components.RenderRowTitle(
 		pr.Ctx,
+		pr.rowState(),
 		pr.Data.Primary.State,
 		pr.Data.Primary.Title,
 		pr.Data.Primary.Number,
+		pr.Unread,
 	)
 }
 
@@ -200,7 +298,7 @@ func (pr *PullRequest) renderExtendedTitle(isSelected bool) string {
 	}
 	width := titleColumn.ComputedWidth - 2
 	top = baseStyle.Foreground(pr.Ctx.Theme.SecondaryText).Width(width).MaxWidth(width).Height(1).MaxHeight(1).Render(top)
-	title = baseStyle.Foreground(pr.Ctx.Theme.PrimaryText).Width(width).MaxWidth(width).Height(1).MaxHeight(1).Render(title)
+	title = baseStyle.Foreground(pr.Ctx.Theme.RowText(pr.rowState())).Bold(pr.Unread).Width(width).MaxWidth(width).Height(1).MaxHeight(1).Render(title)
 
 	return baseStyle.Render(lipgloss.JoinVertical(lipgloss.Left, top, title))
 }
@@ -313,7 +411,7 @@ func (pr *PullRequest) RenderMergeStateStatus() string {
 
 func (pr *PullRequest) ToTableRow(isSelected bool) table.Row {
 	if !pr.Ctx.Config.Theme.Ui.Table.Compact {
-		return table.Row{
+		return append(table.Row{
 			pr.renderState(),
 			pr.renderExtendedTitle(isSelected),
 			pr.renderAssignees(),
@@ -321,13 +419,19 @@ func (pr *PullRequest) ToTableRow(isSelected bool) table.Row {
 			pr.renderNumComments(),
 			pr.renderReviewStatus(),
 			pr.renderCiStatus(),
+			pr.renderMergeQueue(),
+			pr.renderMergeable(),
 			pr.RenderLines(isSelected),
+			pr.renderSize(),
+			pr.renderChangedFiles(),
 			pr.renderUpdateAt(),
 			pr.renderCreatedAt(),
-		}
+			pr.renderScore(),
+			pr.renderMilestone(),
+		}, pr.renderExtraColumns()...)
 	}
 
-	return table.Row{
+	return append(table.Row{
 		pr.renderState(),
 		pr.renderRepoName(),
 		pr.renderTitle(),
@@ -337,8 +441,40 @@ func (pr *PullRequest) ToTableRow(isSelected bool) table.Row {
 		pr.renderNumComments(),
 		pr.renderReviewStatus(),
 		pr.renderCiStatus(),
+		pr.renderMergeQueue(),
+		pr.renderMergeable(),
 		pr.RenderLines(isSelected),
+		pr.renderSize(),
+		pr.renderChangedFiles(),
 		pr.renderUpdateAt(),
 		pr.renderCreatedAt(),
+		pr.renderScore(),
+		pr.renderMilestone(),
+	}, pr.renderExtraColumns()...)
+}
+
+func (pr *PullRequest) renderScore() string {
+	return pr.Ctx.Styles.Common.FaintTextStyle.Render(strconv.Itoa(int(math.Round(pr.Score))))
+}
+
+func (pr *PullRequest) renderMilestone() string {
+	if pr.Data.Primary == nil || pr.Data.Primary.Milestone == nil {
+		return ""
+	}
+	return pr.getTextStyle().Render(pr.Data.Primary.Milestone.Title)
+}
+
+func (pr *PullRequest) renderExtraColumns() []string {
+	fields := make([]string, 0, len(pr.ProjectFields)+len(pr.Computed))
+	for _, value := range pr.ProjectFields {
+		fields = append(fields, pr.getTextStyle().Render(value))
+	}
+	for _, cell := range pr.Computed {
+		style := pr.getTextStyle()
+		if cell.Color != "" {
+			style = style.Foreground(lipgloss.Color(cell.Color))
+		}
+		fields = append(fields, style.Render(cell.Value))
 	}
+	return fields
 }
@@ -0,0 +1,154 @@
+package prssection
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// bulkActions are the actions that run on every selected PR when some are
+// selected, instead of on the current one
+var bulkActions = []string{"close", "merge", "label", "assign"}
+
+// mergeMethodFlag returns the flag of gh pr merge for the merge method
+// answered to the bulk merge prompt, empty when it's declined
+func mergeMethodFlag(answer string) string {
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "m", "merge":
+		return "--merge"
+	case "s", "squash":
+		return "--squash"
+	case "r", "rebase":
+		return "--rebase"
+	}
+	return ""
+}
+
+func (m *Model) selectedPrs() []prrow.Data {
+	var prs []prrow.Data
+	for _, i := range m.Table.SelectedItems() {
+		if i < len(m.Prs) {
+			prs = append(prs, m.Prs[i])
+		}
+	}
+	return prs
+}
+
+// bulk runs action on every selected PR as a single task, input is what was
+// answered to its prompt
+func (m *Model) bulk(action string, input string) tea.Cmd {
+	prs := m.selectedPrs()
+	if len(prs) == 0 {
+		return nil
+	}
+
+	var items []tasks.BulkItem
+	var startText, finishedText string
+	switch action {
+	case "close":
+		if input != "Y" && input != "y" {
+			return nil
+		}
+		for _, pr := range prs {
+			items = append(items, bulkItem(pr, []string{"close"}, tasks.UpdatePRMsg{
+				PrNumber: pr.GetNumber(),
+				IsClosed: utils.BoolPtr(true),
+			}))
+		}
+		startText = fmt.Sprintf("Closing %d PRs", len(prs))
+		finishedText = fmt.Sprintf("%d PRs have been closed", len(prs))
+
+	case "merge":
+		flag := mergeMethodFlag(input)
+		if flag == "" {
+			return nil
+		}
+		for _, pr := range prs {
+			items = append(items, bulkItem(pr, []string{"merge", flag}, tasks.UpdatePRMsg{
+				PrNumber: pr.GetNumber(),
+				IsMerged: utils.BoolPtr(true),
+			}))
+		}
+		startText = fmt.Sprintf("Merging %d PRs", len(prs))
+		finishedText = fmt.Sprintf("%d PRs have been merged", len(prs))
+
+	case "label":
+		labels := strings.Fields(input)
+		if len(labels) == 0 {
+			return nil
+		}
+		args := []string{"edit"}
+		for _, label := range labels {
+			args = append(args, "--add-label", label)
+		}
+		for _, pr := range prs {
+			items = append(items, bulkItem(pr, args, tasks.UpdatePRMsg{
+				PrNumber: pr.GetNumber(),
+				Labels:   addLabels(pr.Primary.Labels, labels),
+			}))
+		}
+		startText = fmt.Sprintf("Labeling %d PRs with %s", len(prs), labels)
+		finishedText = fmt.Sprintf("%d PRs have been labeled with %s", len(prs), labels)
+
+	case "assign":
+		usernames := strings.Fields(input)
+		if len(usernames) == 0 {
+			return nil
+		}
+		args := []string{"edit"}
+		assignees := data.Assignees{Nodes: []data.Assignee{}}
+		for _, username := range usernames {
+			args = append(args, "--add-assignee", username)
+			assignees.Nodes = append(assignees.Nodes, data.Assignee{Login: username})
+		}
+		for _, pr := range prs {
+			items = append(items, bulkItem(pr, args, tasks.UpdatePRMsg{
+				PrNumber:       pr.GetNumber(),
+				AddedAssignees: &assignees,
+			}))
+		}
+		startText = fmt.Sprintf("Assigning %d PRs to %s", len(prs), usernames)
+		finishedText = fmt.Sprintf("%d PRs have been assigned to %s", len(prs), usernames)
+
+	default:
+		return nil
+	}
+
+	m.Table.ClearSelection()
+	return tasks.RunBulk(m.Ctx, tasks.BulkTask{
+		Id:           fmt.Sprintf("pr_bulk_%s_%d", action, prs[0].GetNumber()),
+		Section:      tasks.SectionIdentifier{Id: m.Id, Type: SectionType},
+		StartText:    startText,
+		FinishedText: finishedText,
+		Items:        items,
+	})
+}
+
+// bulkItem runs the gh pr subcommand and the args of cmd on pr
+func bulkItem(pr prrow.Data, cmd []string, msg tea.Msg) tasks.BulkItem {
+	args := []string{"pr", cmd[0], fmt.Sprint(pr.GetNumber()), "-R", data.RepoArg(pr)}
+	return tasks.BulkItem{
+		Name: fmt.Sprintf("%s#%d", pr.GetRepoNameWithOwner(), pr.GetNumber()),
+		Args: append(args, cmd[1:]...),
+		Msg:  msg,
+	}
+}
+
+// addLabels returns the labels of a PR once the names it doesn't have are
+// added
+func addLabels(labels data.PRLabels, names []string) *data.PRLabels {
+	added := data.PRLabels{Nodes: slices.Clone(labels.Nodes)}
+	for _, name := range names {
+		if !slices.ContainsFunc(added.Nodes, func(l data.Label) bool { return l.Name == name }) {
+			added.Nodes = append(added.Nodes, data.Label{Name: name})
+		}
+	}
+	return &added
+}
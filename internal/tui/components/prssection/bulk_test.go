@@ -0,0 +1,25 @@
+package prssection
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestAddLabels(t *testing.T) {
+	labels := data.PRLabels{Nodes: []data.Label{{Name: "bug"}}}
+	got := addLabels(labels, []string{"bug", "dependencies"})
+
+	var names []string
+	for _, label := range got.Nodes {
+		names = append(names, label.Name)
+	}
+	want := []string{"bug", "dependencies"}
+	if !slices.Equal(names, want) {
+		t.Errorf("addLabels() = %v, want %v", names, want)
+	}
+	if len(labels.Nodes) != 1 {
+		t.Errorf("addLabels() changed the labels it was given: %v", labels.Nodes)
+	}
+}
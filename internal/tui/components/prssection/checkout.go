@@ -4,21 +4,24 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 )
 
+// checkout checks out the PR in its local repo, adding the fork it was
+// opened from as a remote when needed, like gh pr checkout does
 func (m *Model) checkout() (tea.Cmd, error) {
-	pr := m.GetCurrRow()
-	if pr == nil {
+	if len(m.Prs) == 0 {
 		return nil, errors.New("no pr selected")
 	}
+	pr := m.Prs[m.Table.GetCurrItem()]
 
 	repoName := pr.GetRepoNameWithOwner()
 	repoPath, ok := common.GetRepoLocalPath(repoName, m.Ctx.Config.RepoPaths)
@@ -26,8 +29,19 @@ func (m *Model) checkout() (tea.Cmd, error) {
 	if !ok {
 		return nil, errors.New("local path to repo not specified, set one in your config.yml under repoPaths")
 	}
+	userHomeDir, _ := os.UserHomeDir()
+	if strings.HasPrefix(repoPath, "~") {
+		repoPath = strings.Replace(repoPath, "~", userHomeDir, 1)
+	}
 
 	prNumber := pr.GetNumber()
+	head := git.PullRequestHead{
+		Number: prNumber,
+		Branch: pr.Primary.HeadRefName,
+		IsFork: pr.Primary.IsCrossRepository,
+		Owner:  pr.Primary.HeadRepositoryOwner.Login,
+		Url:    pr.Primary.HeadRepository.Url,
+	}
 	taskId := fmt.Sprintf("checkout_%d", prNumber)
 	task := context.Task{
 		Id:           taskId,
@@ -37,20 +51,80 @@ func (m *Model) checkout() (tea.Cmd, error) {
 		Error:        nil,
 	}
 	startCmd := m.Ctx.StartTask(task)
+	// progress is best effort, lines are dropped rather than blocking git
+	lines := make(chan string, 16)
+	return tea.Batch(startCmd, tasks.ListenForProgress(taskId, lines), func() tea.Msg {
+		_, err := git.CheckoutPullRequest(repoPath, head, func(line string) {
+			select {
+			case lines <- line:
+			default:
+			}
+		})
+		close(lines)
+		return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
+	}), nil
+}
+
+// worktreeReadyMsg is sent once the worktree of a PR exists, for the
+// section to open it
+type worktreeReadyMsg struct {
+	repoPath string
+	path     string
+	branch   string
+}
+
+// checkoutWorktree checks out the PR in a worktree of its local repo, reusing
+// the one its head branch is already checked out in, and opens it
+func (m *Model) checkoutWorktree() (tea.Cmd, error) {
+	if len(m.Prs) == 0 {
+		return nil, errors.New("no pr selected")
+	}
+	pr := m.Prs[m.Table.GetCurrItem()]
+
+	repoPath, ok := common.GetRepoLocalPath(pr.GetRepoNameWithOwner(), m.Ctx.Config.RepoPaths)
+	if !ok {
+		return nil, errors.New("local path to repo not specified, set one in your config.yml under repoPaths")
+	}
+	userHomeDir, _ := os.UserHomeDir()
+	if strings.HasPrefix(repoPath, "~") {
+		repoPath = strings.Replace(repoPath, "~", userHomeDir, 1)
+	}
+
+	prNumber := pr.GetNumber()
+	branch := pr.Primary.HeadRefName
+	taskId := fmt.Sprintf("checkout_worktree_%d", prNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Checking out PR #%d in a worktree", prNumber),
+		FinishedText: fmt.Sprintf("PR #%d has been checked out in a worktree", prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
 	return tea.Batch(startCmd, func() tea.Msg {
-		c := exec.Command(
-			"gh",
-			"pr",
-			"checkout",
-			fmt.Sprint(m.GetCurrRow().GetNumber()),
-		)
-		userHomeDir, _ := os.UserHomeDir()
-		if strings.HasPrefix(repoPath, "~") {
-			repoPath = strings.Replace(repoPath, "~", userHomeDir, 1)
+		worktrees, err := git.GetWorktrees(repoPath)
+		if err != nil {
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
+		}
+		path := ""
+		for _, wt := range worktrees {
+			if wt.Branch == branch {
+				path = wt.Path
+				break
+			}
+		}
+		if path == "" {
+			path = tasks.WorktreePath(m.Ctx, repoPath, branch)
+			if err := git.AddPullRequestWorktree(repoPath, path, branch, prNumber); err != nil {
+				return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
+			}
 		}
 
-		c.Dir = repoPath
-		err := c.Run()
-		return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: SectionType,
+			TaskId:      taskId,
+			Msg:         worktreeReadyMsg{repoPath: repoPath, path: path, branch: branch},
+		}
 	}), nil
 }
@@ -5,6 +5,7 @@ import (
 	"os/exec"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 )
 
@@ -20,7 +21,7 @@ func (m Model) diff() tea.Cmd {
 		"diff",
 		fmt.Sprint(currRowData.GetNumber()),
 		"-R",
-		m.GetCurrRow().GetRepoNameWithOwner(),
+		data.RepoArg(m.GetCurrRow()),
 	)
 	c.Env = m.Ctx.Config.GetFullScreenDiffPagerEnv()
 
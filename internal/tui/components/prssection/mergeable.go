@@ -0,0 +1,109 @@
+package prssection
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+const (
+	// mergeablePollInterval is how long GitHub is given to compute whether
+	// PRs conflict with their base branch before they're refetched
+	mergeablePollInterval = 5 * time.Second
+	// maxMergeablePolls is how many times the PRs are refetched before
+	// their state is left unknown, until the section is refreshed
+	maxMergeablePolls = 6
+)
+
+// mergeablePollMsg refetches the mergeable states still unknown, unless a
+// later poll was started
+type mergeablePollMsg struct {
+	pollId  int
+	attempt int
+}
+
+// mergeableFetchedMsg carries the mergeable states of the PRs, by URL
+type mergeableFetchedMsg struct {
+	pollId  int
+	attempt int
+	states  map[string]string
+	err     error
+}
+
+// unknownMergeableIds returns the ids of the open PRs GitHub didn't tell yet
+// whether they conflict with their base branch
+func (m *Model) unknownMergeableIds() []string {
+	var ids []string
+	for _, pr := range m.Prs {
+		if pr.Primary != nil && pr.Primary.State == "OPEN" &&
+			pr.Primary.Mergeable == data.MergeableStateUnknown && pr.Primary.Id != "" {
+			ids = append(ids, pr.Primary.Id)
+		}
+	}
+	return ids
+}
+
+// startMergeablePoll polls the PRs whose mergeable state is unknown, the
+// polls started before stop
+func (m *Model) startMergeablePoll() tea.Cmd {
+	if !m.Config.IsGitHub() || len(m.unknownMergeableIds()) == 0 {
+		return nil
+	}
+	m.mergeablePollId++
+	return m.tickMergeablePoll(1)
+}
+
+func (m *Model) tickMergeablePoll(attempt int) tea.Cmd {
+	msg := mergeablePollMsg{pollId: m.mergeablePollId, attempt: attempt}
+	return m.MakeSectionCmd(tea.Tick(mergeablePollInterval, func(time.Time) tea.Msg {
+		return msg
+	}))
+}
+
+// pollMergeable refetches the mergeable states still unknown
+func (m *Model) pollMergeable(msg mergeablePollMsg) tea.Cmd {
+	ids := m.unknownMergeableIds()
+	if msg.pollId != m.mergeablePollId || len(ids) == 0 {
+		return nil
+	}
+	host := m.Ctx.Config.SectionHost(m.Config)
+	return m.MakeSectionCmd(func() tea.Msg {
+		states, err := data.FetchMergeableStates(host, ids)
+		return mergeableFetchedMsg{
+			pollId:  msg.pollId,
+			attempt: msg.attempt,
+			states:  states,
+			err:     err,
+		}
+	})
+}
+
+// setMergeableStates sets the fetched mergeable states of the PRs, and polls
+// again while some are still unknown
+func (m *Model) setMergeableStates(msg mergeableFetchedMsg) tea.Cmd {
+	if msg.pollId != m.mergeablePollId {
+		return nil
+	}
+	if msg.err != nil {
+		log.Error("Failed fetching mergeable states", "section", m.Id, "err", msg.err)
+		return nil
+	}
+
+	for i, pr := range m.Prs {
+		if pr.Primary == nil {
+			continue
+		}
+		if state, ok := msg.states[pr.Primary.Url]; ok && state != "" {
+			m.Prs[i].Primary.Mergeable = state
+		}
+	}
+	m.syncRows()
+
+	if msg.attempt >= maxMergeablePolls || len(m.unknownMergeableIds()) == 0 {
+		return nil
+	}
+	return m.tickMergeablePoll(msg.attempt + 1)
+}
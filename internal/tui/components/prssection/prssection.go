@@ -1,6 +1,7 @@
 package prssection
 
 import (
+	"cmp"
 	"fmt"
 	"slices"
 	"time"
@@ -10,8 +11,11 @@ import (
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/export"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/repopicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/reviewprompt"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
@@ -25,7 +29,14 @@ const SectionType = "pr"
 
 type Model struct {
 	section.BaseModel
-	Prs []prrow.Data
+	Prs          []prrow.Data
+	reviewPrompt reviewprompt.Model
+	// PlanBucket is set for the sections listing the review requests planned
+	// in a bucket of the review plan, only those are kept
+	PlanBucket state.PlanBucket
+	// mergeablePollId tells the polls of the unknown mergeable states apart,
+	// only the last one goes on
+	mergeablePollId int
 }
 
 func NewModel(
@@ -50,6 +61,8 @@ func NewModel(
 		},
 	)
 	m.Prs = []prrow.Data{}
+	m.reviewPrompt = reviewprompt.NewModel(ctx)
+	m.MergesRepoFilters = true
 
 	return m
 }
@@ -62,6 +75,12 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	case tea.KeyMsg:
 
 		if m.IsSearchFocused() {
+			if m.SearchBar.IsOverlayOpen() {
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+
 			switch msg.Type {
 			case tea.KeyCtrlC, tea.KeyEsc:
 				m.SearchBar.SetValue(m.SearchValue)
@@ -70,10 +89,12 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 
 			case tea.KeyEnter:
 				m.SearchValue = m.SearchBar.Value()
+				historyCmd := m.SearchBar.AddToHistory(m.SearchValue)
 				m.SyncRepoFilterStateFromSearchValue()
+				m.SyncTimeSliceFromSearchValue()
 				m.SetIsSearching(false)
 				m.ResetRows()
-				return m, tea.Batch(m.FetchNextPageSectionRows()...)
+				return m, tea.Batch(append(m.FetchNextPageSectionRows(), historyCmd)...)
 
 			default:
 				// Forward all other keys to the search bar for input
@@ -89,6 +110,11 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			return m, pickerCmd
 		}
 
+		if m.IsPromptConfirmationFocused() && m.GetPromptConfirmationAction() == reviewAction {
+			m.reviewPrompt, cmd = m.reviewPrompt.Update(msg)
+			return m, cmd
+		}
+
 		if m.IsPromptConfirmationFocused() {
 			switch msg.Type {
 			case tea.KeyCtrlC, tea.KeyEsc:
@@ -101,7 +127,12 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				action := m.GetPromptConfirmationAction()
 				pr := m.GetCurrRow()
 				sid := tasks.SectionIdentifier{Id: m.Id, Type: SectionType}
-				if input == "Y" || input == "y" {
+				method, update := data.ParseBranchUpdateAnswer(input, m.Ctx.Config.Defaults.PrUpdateMethod)
+				if m.HasSelection() && slices.Contains(bulkActions, action) {
+					cmd = m.bulk(action, input)
+				} else if action == "update" && update {
+					cmd = tasks.UpdatePR(m.Ctx, sid, pr, method)
+				} else if input == "Y" || input == "y" {
 					switch action {
 					case "close":
 						cmd = tasks.ClosePR(m.Ctx, sid, pr)
@@ -111,8 +142,10 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 						cmd = tasks.PRReady(m.Ctx, sid, pr)
 					case "merge":
 						cmd = tasks.MergePR(m.Ctx, sid, pr)
-					case "update":
-						cmd = tasks.UpdatePR(m.Ctx, sid, pr)
+					case "enqueue":
+						cmd = tasks.EnqueuePR(m.Ctx, sid, pr)
+					case "dequeue":
+						cmd = tasks.DequeuePR(m.Ctx, sid, pr)
 					}
 				}
 
@@ -190,18 +223,61 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				return m, tea.Batch(m.FetchNextPageSectionRows()...)
 			}
 
-		case key.Matches(msg, keys.PRKeys.OpenRepoPicker):
-			m.ShowRepoPicker()
+		case key.Matches(msg, keys.PRKeys.SliceToday):
+			return m, m.sliceTime(section.TimeSliceToday)
+
+		case key.Matches(msg, keys.PRKeys.SliceWeek):
+			return m, m.sliceTime(section.TimeSliceWeek)
+
+		case key.Matches(msg, keys.PRKeys.SliceMonth):
+			return m, m.sliceTime(section.TimeSliceMonth)
+
+		case key.Matches(msg, keys.PRKeys.SliceAllTime):
+			return m, m.sliceTime(section.TimeSliceAll)
+
+		case key.Matches(msg, keys.PRKeys.ToggleCurrentSprint):
+			return m, m.toggleCurrentSprint()
+
+		case key.Matches(msg, keys.PRKeys.CycleSort):
+			return m, m.cycleSort()
+
+		case key.Matches(msg, keys.PRKeys.ReverseSort):
+			if m.ReverseSort() {
+				m.syncRows()
+			}
 			return m, nil
 
+		case key.Matches(msg, keys.PRKeys.ToggleGroup):
+			m.Table.ToggleGroup()
+			return m, nil
+
+		case key.Matches(msg, keys.PRKeys.ToggleAllGroups):
+			m.Table.ToggleAllGroups()
+			return m, nil
+
+		case key.Matches(msg, keys.PRKeys.OpenRepoPicker):
+			return m, m.ShowRepoPicker()
+
 		case key.Matches(msg, keys.PRKeys.Checkout):
 			cmd, err = m.checkout()
 			if err != nil {
 				m.Ctx.Error = err
 			}
 
+		case key.Matches(msg, keys.PRKeys.CheckoutWorktree):
+			cmd, err = m.checkoutWorktree()
+			if err != nil {
+				m.Ctx.Error = err
+			}
+
 		case key.Matches(msg, keys.PRKeys.WatchChecks):
 			cmd = m.watchChecks()
+
+		case key.Matches(msg, keys.PRKeys.ToggleSelection):
+			m.Table.ToggleSelection()
+
+		case key.Matches(msg, keys.PRKeys.SelectRange):
+			m.Table.SelectRange()
 		}
 
 	case repopicker.RepoSelectedMsg:
@@ -217,6 +293,22 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	case repopicker.RepoCancelledMsg:
 		m.HideRepoPicker()
 
+	case repopicker.ReposFetchedMsg:
+		m.RepoPicker, cmd = m.RepoPicker.Update(msg)
+		return m, cmd
+
+	case reviewprompt.SubmittedMsg:
+		cmd = m.submitReview(msg.Event, msg.Body)
+		m.SetIsPromptConfirmationShown(false)
+		return m, cmd
+
+	case reviewprompt.CancelledMsg:
+		m.SetIsPromptConfirmationShown(false)
+		return m, nil
+
+	case worktreeReadyMsg:
+		cmd = tasks.OpenWorktree(m.Ctx, msg.repoPath, msg.path, msg.branch)
+
 	case tasks.UpdatePRMsg:
 		for i, currPr := range m.Prs {
 			if currPr.Primary.Number != msg.PrNumber {
@@ -234,6 +326,12 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				currPr.Enriched.Comments.Nodes = append(
 					currPr.Enriched.Comments.Nodes, *msg.NewComment)
 			}
+			if msg.OlderComments != nil {
+				currPr.Enriched.Comments.Nodes = append(
+					msg.OlderComments.Nodes, currPr.Enriched.Comments.Nodes...)
+				currPr.Enriched.Comments.PageInfo = msg.OlderComments.PageInfo
+				currPr.Enriched.Comments.TotalCount = msg.OlderComments.TotalCount
+			}
 			if msg.AddedAssignees != nil {
 				currPr.Primary.Assignees.Nodes = addAssignees(
 					currPr.Primary.Assignees.Nodes, msg.AddedAssignees.Nodes)
@@ -242,37 +340,164 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				currPr.Primary.Assignees.Nodes = removeAssignees(
 					currPr.Primary.Assignees.Nodes, msg.RemovedAssignees.Nodes)
 			}
+			if msg.Labels != nil {
+				currPr.Primary.Labels.Nodes = msg.Labels.Nodes
+			}
+			if msg.HasMilestone != nil {
+				currPr.Primary.Milestone = nil
+				if *msg.HasMilestone {
+					currPr.Primary.Milestone = msg.Milestone
+				}
+			}
 			if msg.ReadyForReview != nil && *msg.ReadyForReview {
 				currPr.Primary.IsDraft = false
 			}
+			if msg.ReviewDecision != nil {
+				currPr.Primary.ReviewDecision = *msg.ReviewDecision
+			}
 			if msg.IsMerged != nil && *msg.IsMerged {
 				currPr.Primary.State = "MERGED"
 				currPr.Primary.Mergeable = ""
+				currPr.Primary.MergeQueueEntry = nil
+			}
+			if msg.Mergeable != nil {
+				currPr.Primary.Mergeable = *msg.Mergeable
+			}
+			if msg.IsQueued != nil {
+				currPr.Primary.MergeQueueEntry = nil
+				if *msg.IsQueued {
+					currPr.Primary.MergeQueueEntry = msg.MergeQueueEntry
+				}
+			}
+			for j, thread := range currPr.Enriched.ReviewThreads.Nodes {
+				if thread.Id != msg.ReviewThreadId || msg.ReviewThreadId == "" {
+					continue
+				}
+				if msg.NewThreadReply != nil {
+					thread.Comments.Nodes = append(thread.Comments.Nodes, *msg.NewThreadReply)
+					thread.Comments.TotalCount++
+				}
+				if msg.IsThreadResolved != nil {
+					thread.IsResolved = *msg.IsThreadResolved
+				}
+				currPr.Enriched.ReviewThreads.Nodes[j] = thread
+			}
+			for _, reviewer := range msg.RequestedReviewers {
+				if slices.Contains(currPr.Primary.RequestedReviewers(), reviewer) {
+					continue
+				}
+				var request data.ReviewRequest
+				request.RequestedReviewer.User.Login = reviewer
+				currPr.Primary.ReviewRequests.Nodes = append(currPr.Primary.ReviewRequests.Nodes, request)
+				currPr.Primary.ReviewRequests.TotalCount++
+			}
+			if len(msg.DismissedReviewIds) > 0 {
+				currPr.Primary.LatestReviews.Nodes = slices.DeleteFunc(
+					slices.Clone(currPr.Primary.LatestReviews.Nodes),
+					func(review data.LatestReview) bool {
+						return slices.Contains(msg.DismissedReviewIds, review.Id)
+					})
 			}
 			m.Prs[i] = currPr
 			m.SetIsLoading(false)
-			m.Table.SetRows(m.BuildRows())
+			m.syncRows()
+			if msg.Mergeable != nil {
+				cmd = m.startMergeablePoll()
+			}
 			break
 		}
 
+	case tasks.BulkUpdateMsg:
+		cmds := make([]tea.Cmd, 0, len(msg.Msgs))
+		for _, updateMsg := range msg.Msgs {
+			_, updateCmd := m.Update(updateMsg)
+			cmds = append(cmds, updateCmd)
+		}
+		return m, tea.Batch(cmds...)
+
+	case tasks.ItemCreatedMsg:
+		if pr, ok := msg.Row.(*data.PullRequestData); ok {
+			m.Prs = append([]prrow.Data{{Primary: pr}}, m.Prs...)
+			m.TotalCount++
+			m.syncRows()
+			m.Table.FirstItem()
+			m.UpdateTotalItemsCount(m.TotalCount)
+		}
+
+	case section.SectionMsg:
+		switch internalMsg := msg.InternalMsg.(type) {
+		case SectionPullRequestsFetchedMsg, repopicker.ReposFetchedMsg:
+			return m.Update(internalMsg)
+		case mergeablePollMsg:
+			return m, m.pollMergeable(internalMsg)
+		case mergeableFetchedMsg:
+			return m, m.setMergeableStates(internalMsg)
+		}
+
 	case SectionPullRequestsFetchedMsg:
+		msg = m.keepPlanned(msg)
+		msg = m.skipSnoozed(msg)
+		if msg.Offline {
+			// GitHub can't be reached, the rows shown are kept and the cached
+			// ones are shown if there are none
+			if m.LastFetchTaskId == msg.TaskId {
+				m.IsOffline = true
+				if msg.IsCached() && len(m.Prs) == 0 {
+					m.Prs = msg.Prs
+					m.prioritize()
+					m.TotalCount = msg.TotalCount
+					m.syncRows()
+					m.Table.UpdateLastUpdated(msg.CachedAt)
+					m.UpdateTotalItemsCount(m.TotalCount)
+				}
+				m.SetIsLoading(false)
+				m.IsRefreshing = false
+			}
+			break
+		}
+		if msg.IsCached() {
+			// fresh rows win over cached ones if they arrived first
+			if m.LastFetchTaskId == msg.TaskId && m.PageInfo == nil && len(m.Prs) == 0 {
+				m.Prs = msg.Prs
+				m.prioritize()
+				m.TotalCount = msg.TotalCount
+				// the fresh rows are still being fetched
+				m.SetIsLoading(false)
+				m.IsRefreshing = true
+				m.syncRows()
+				m.Table.UpdateLastUpdated(msg.CachedAt)
+				m.UpdateTotalItemsCount(m.TotalCount)
+			}
+			break
+		}
 		if m.LastFetchTaskId == msg.TaskId {
+			m.IsOffline = false
 			if m.PageInfo != nil {
 				m.Prs = append(m.Prs, msg.Prs...)
 			} else {
 				m.Prs = msg.Prs
+				m.Table.ClearSelection()
+			}
+			m.prioritize()
+			m.SetProjectFields(msg.ProjectFields, m.PageInfo != nil)
+			if msg.Iterations != nil {
+				m.Iterations = msg.Iterations
 			}
+			section.GroupBySprint(&m.BaseModel, m.Prs)
+			m.ResetComputedValues()
 			m.TotalCount = msg.TotalCount
 			m.PageInfo = &msg.PageInfo
 			m.SetIsLoading(false)
-			m.Table.SetRows(m.BuildRows())
+			m.IsRefreshing = false
+			m.syncRows()
 			m.Table.UpdateLastUpdated(time.Now())
 			m.UpdateTotalItemsCount(m.TotalCount)
+			cmd = m.startMergeablePoll()
 		}
 	}
 
 	search, searchCmd := m.SearchBar.Update(msg)
-	m.Table.SetRows(m.BuildRows())
+	m.syncRows()
 	m.SearchBar = search
 
 	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
@@ -292,6 +517,7 @@ func (m *Model) EnrichPR(data data.EnrichedPullRequestData) {
 
 		m.Prs[i].IsEnriched = true
 		m.Prs[i].Enriched = data
+		m.Prs[i].Primary.RestoreTrimmed(data)
 	}
 }
 
@@ -328,10 +554,19 @@ func GetSectionColumns(
 	)
 	stateLayout := config.MergeColumnConfigs(dLayout.State, sLayout.State)
 	ciLayout := config.MergeColumnConfigs(dLayout.Ci, sLayout.Ci)
+	mergeQueueLayout := config.MergeColumnConfigs(dLayout.MergeQueue, sLayout.MergeQueue)
+	mergeableLayout := config.MergeColumnConfigs(dLayout.Mergeable, sLayout.Mergeable)
 	linesLayout := config.MergeColumnConfigs(dLayout.Lines, sLayout.Lines)
+	sizeLayout := config.MergeColumnConfigs(dLayout.Size, sLayout.Size)
+	filesLayout := config.MergeColumnConfigs(dLayout.Files, sLayout.Files)
+	scoreLayout := config.MergeColumnConfigs(dLayout.Score, sLayout.Score)
+	milestoneLayout := config.MergeColumnConfigs(dLayout.Milestone, sLayout.Milestone)
+
+	projectColumns := append(section.ProjectColumns(cfg.ProjectFields),
+		section.ComputedColumns(cfg.ComputedColumns)...)
 
 	if !ctx.Config.Theme.Ui.Table.Compact {
-		return []table.Column{
+		return append([]table.Column{
 			{
 				Title:  "",
 				Width:  utils.IntPtr(3),
@@ -359,7 +594,7 @@ func GetSectionColumns(
 			},
 			{
 				Title:  "󰯢",
-				Width:  utils.IntPtr(4),
+				Width:  utils.IntPtr(5),
 				Hidden: reviewStatusLayout.Hidden,
 			},
 			{
@@ -368,11 +603,31 @@ func GetSectionColumns(
 				Grow:   new(bool),
 				Hidden: ciLayout.Hidden,
 			},
+			{
+				Title:  "Queue",
+				Width:  mergeQueueLayout.Width,
+				Hidden: mergeQueueLayout.Hidden,
+			},
+			{
+				Title:  "Merge",
+				Width:  mergeableLayout.Width,
+				Hidden: mergeableLayout.Hidden,
+			},
 			{
 				Title:  "",
 				Width:  linesLayout.Width,
 				Hidden: linesLayout.Hidden,
 			},
+			{
+				Title:  "Size",
+				Width:  sizeLayout.Width,
+				Hidden: sizeLayout.Hidden,
+			},
+			{
+				Title:  "Files",
+				Width:  filesLayout.Width,
+				Hidden: filesLayout.Hidden,
+			},
 			{
 				Title:  "󱦻",
 				Width:  updatedAtLayout.Width,
@@ -383,10 +638,20 @@ func GetSectionColumns(
 				Width:  createdAtLayout.Width,
 				Hidden: createdAtLayout.Hidden,
 			},
-		}
+			{
+				Title:  "Score",
+				Width:  scoreLayout.Width,
+				Hidden: scoreLayout.Hidden,
+			},
+			{
+				Title:  "Milestone",
+				Width:  milestoneLayout.Width,
+				Hidden: milestoneLayout.Hidden,
+			},
+		}, projectColumns...)
 	}
 
-	return []table.Column{
+	return append([]table.Column{
 		{
 			Title:  "",
 			Width:  utils.IntPtr(3),
@@ -424,7 +689,7 @@ func GetSectionColumns(
 		},
 		{
 			Title:  "󰯢",
-			Width:  utils.IntPtr(4),
+			Width:  utils.IntPtr(5),
 			Hidden: reviewStatusLayout.Hidden,
 		},
 		{
@@ -433,11 +698,31 @@ func GetSectionColumns(
 			Grow:   new(bool),
 			Hidden: ciLayout.Hidden,
 		},
+		{
+			Title:  "Queue",
+			Width:  mergeQueueLayout.Width,
+			Hidden: mergeQueueLayout.Hidden,
+		},
+		{
+			Title:  "Merge",
+			Width:  mergeableLayout.Width,
+			Hidden: mergeableLayout.Hidden,
+		},
 		{
 			Title:  "",
 			Width:  linesLayout.Width,
 			Hidden: linesLayout.Hidden,
 		},
+		{
+			Title:  "Size",
+			Width:  sizeLayout.Width,
+			Hidden: sizeLayout.Hidden,
+		},
+		{
+			Title:  "Files",
+			Width:  filesLayout.Width,
+			Hidden: filesLayout.Hidden,
+		},
 		{
 			Title:  "󱦻",
 			Width:  updatedAtLayout.Width,
@@ -448,17 +733,46 @@ func GetSectionColumns(
 			Width:  createdAtLayout.Width,
 			Hidden: createdAtLayout.Hidden,
 		},
-	}
+		{
+			Title:  "Score",
+			Width:  scoreLayout.Width,
+			Hidden: scoreLayout.Hidden,
+		},
+		{
+			Title:  "Milestone",
+			Width:  milestoneLayout.Width,
+			Hidden: milestoneLayout.Hidden,
+		},
+	}, projectColumns...)
+}
+
+// syncRows sets the PRs as the rows of the table, under the headers of their
+// groups in grouped sections
+func (m *Model) syncRows() {
+	rows := m.BuildRows()
+	m.Table.SetGroupedRows(rows, section.GroupTitles(&m.BaseModel, m.Prs,
+		func(pr prrow.Data) map[string]any { return pr.Primary.ColumnFields() }))
 }
 
 func (m Model) BuildRows() []table.Row {
+	// the PRs share their array with the model's, they're sorted in place
+	section.SortRows(&m.BaseModel, m.Prs, func(pr prrow.Data) map[string]any {
+		return pr.Primary.ColumnFields()
+	})
 	var rows []table.Row
 	currItem := m.Table.GetCurrItem()
+	scoring := m.Ctx.Config.Scoring.WithDefaults()
+	now := time.Now()
 	for i, currPr := range m.Prs {
 		prModel := prrow.PullRequest{
 			Ctx:     m.Ctx,
 			Data:    &currPr,
 			Columns: m.Table.Columns, ShowAuthorIcon: m.ShowAuthorIcon,
+			Unread:        m.IsUnread(currPr),
+			ProjectFields: m.ProjectFieldValues(currPr.Primary.Url),
+			Computed: m.ComputedCells(currPr.Primary.Url, currPr.Primary.UpdatedAt,
+				currPr.Primary.ColumnFields),
+			Score: currPr.Primary.Score(scoring, now),
 		}
 		rows = append(
 			rows,
@@ -473,15 +787,120 @@ func (m Model) BuildRows() []table.Row {
 	return rows
 }
 
+// prioritize orders the PRs by their score, highest first, in auto-prioritized
+// sections
+func (m *Model) prioritize() {
+	if !m.Config.AutoPrioritize {
+		return
+	}
+	scoring := m.Ctx.Config.Scoring.WithDefaults()
+	now := time.Now()
+	slices.SortStableFunc(m.Prs, func(a, b prrow.Data) int {
+		return cmp.Compare(b.Primary.Score(scoring, now), a.Primary.Score(scoring, now))
+	})
+}
+
+// keepPlanned drops the PRs that aren't planned in the bucket of a plan
+// section, they're all fetched at once
+func (m *Model) keepPlanned(msg SectionPullRequestsFetchedMsg) SectionPullRequestsFetchedMsg {
+	if m.PlanBucket == "" || m.Ctx.ReviewPlan == nil {
+		return msg
+	}
+	now := time.Now()
+	msg.Prs = slices.DeleteFunc(slices.Clone(msg.Prs), func(pr prrow.Data) bool {
+		bucket, ok := m.Ctx.ReviewPlan.Bucket(pr.Primary.Url, now)
+		return !ok || bucket != m.PlanBucket
+	})
+	msg.TotalCount = len(msg.Prs)
+	msg.PageInfo.HasNextPage = false
+	return msg
+}
+
+// skipSnoozed drops the snoozed PRs, they don't count toward the total either
+func (m *Model) skipSnoozed(msg SectionPullRequestsFetchedMsg) SectionPullRequestsFetchedMsg {
+	n := len(msg.Prs)
+	msg.Prs = slices.DeleteFunc(slices.Clone(msg.Prs), func(pr prrow.Data) bool {
+		return m.IsSnoozed(pr.Primary)
+	})
+	msg.TotalCount -= n - len(msg.Prs)
+	return msg
+}
+
+// DropSnoozed removes the PRs snoozed since they were fetched
+func (m *Model) DropSnoozed() {
+	n := len(m.Prs)
+	m.Prs = slices.DeleteFunc(m.Prs, func(pr prrow.Data) bool {
+		return m.IsSnoozed(pr.Primary)
+	})
+	if len(m.Prs) == n {
+		return
+	}
+	m.TotalCount -= n - len(m.Prs)
+	m.Table.ClearSelection()
+	m.syncRows()
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
 func (m *Model) NumRows() int {
 	return len(m.Prs)
 }
 
+func (m *Model) UnreadRows() []int {
+	var unread []int
+	for i, pr := range m.Prs {
+		if m.IsUnread(pr) {
+			unread = append(unread, i)
+		}
+	}
+	return unread
+}
+
+func (m *Model) SyncReadRows() {
+	m.syncRows()
+}
+
 type SectionPullRequestsFetchedMsg struct {
 	Prs        []prrow.Data
 	TotalCount int
 	PageInfo   data.PageInfo
 	TaskId     string
+	// CachedAt is set when the PRs were read from the cache while the fresh
+	// ones are being fetched
+	CachedAt time.Time
+	// Offline is set when GitHub couldn't be reached, the rows are the cached
+	// ones if there are any
+	Offline bool
+	// ProjectFields are the values of the section's project fields of the
+	// PRs, by their URL
+	ProjectFields map[string]data.ProjectFields
+	// Iterations are the iterations of the sprint field, set when the PRs
+	// are grouped or filtered by sprint
+	Iterations data.Iterations
+}
+
+func (msg SectionPullRequestsFetchedMsg) IsCached() bool {
+	return !msg.CachedAt.IsZero()
+}
+
+func (m *Model) toPrRows(prs []data.PullRequestData) []prrow.Data {
+	forge := ""
+	if !m.Config.IsGitHub() {
+		forge = m.Config.Provider
+	}
+	rows := make([]prrow.Data, 0, len(prs))
+	for _, pr := range prs {
+		rows = append(rows, prrow.Data{Primary: &pr, Forge: forge})
+	}
+	return rows
+}
+
+// ExportRows returns the rows of the section as they're shown, for exporting
+func (m *Model) ExportRows() export.Table {
+	t := export.Table{Columns: m.ExportColumns(data.PullRequestFieldNames)}
+	for _, pr := range m.Prs {
+		t.Append(m.ExportRow(pr.Primary.Url, pr.Primary.UpdatedAt, pr.Primary.ColumnFields))
+	}
+	return t
 }
 
 func (m *Model) GetCurrRow() data.RowData {
@@ -492,6 +911,41 @@ func (m *Model) GetCurrRow() data.RowData {
 	return &pr
 }
 
+// sliceTime restricts the section to the items updated in slice and
+// refetches it
+func (m *Model) sliceTime(slice section.TimeSlice) tea.Cmd {
+	if !m.SetTimeSlice(slice) {
+		return nil
+	}
+	m.SearchBar.SetValue(m.SearchValue)
+	m.SetIsSearching(false)
+	m.ResetRows()
+	return tea.Batch(m.FetchNextPageSectionRows()...)
+}
+
+// toggleCurrentSprint restricts the section to the items of the current
+// sprint, or lifts the restriction, and refetches it
+func (m *Model) toggleCurrentSprint() tea.Cmd {
+	if !m.ToggleCurrentSprint() {
+		return nil
+	}
+	m.SearchBar.SetValue(m.SearchValue)
+	m.SetIsSearching(false)
+	m.ResetRows()
+	return tea.Batch(m.FetchNextPageSectionRows()...)
+}
+
+// cycleSort sorts the PRs by the next sort key, they're refetched to get
+// GitHub's order back after the last one
+func (m *Model) cycleSort() tea.Cmd {
+	if !m.CycleSort() {
+		m.syncRows()
+		return nil
+	}
+	m.ResetRows()
+	return tea.Batch(m.FetchNextPageSectionRows()...)
+}
+
 func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	if m == nil {
 		return nil
@@ -523,35 +977,89 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 	startCmd := m.Ctx.StartTask(task)
 	cmds = append(cmds, startCmd)
 
+	limit := m.Config.Limit
+	if limit == nil {
+		limit = &m.Ctx.Config.Defaults.PrsLimit
+	}
+
+	isFirstPage := m.PageInfo == nil
+	if isFirstPage && len(m.Prs) == 0 {
+		cmds = append(cmds, section.ReadCachedRows(&m.BaseModel, *limit,
+			func(res data.PullRequestsResponse, savedAt time.Time) tea.Msg {
+				return SectionPullRequestsFetchedMsg{
+					Prs:        m.toPrRows(res.Prs),
+					TotalCount: res.TotalCount,
+					TaskId:     taskId,
+					CachedAt:   savedAt,
+				}
+			}))
+	}
+
 	fetchCmd := func() tea.Msg {
-		limit := m.Config.Limit
-		if limit == nil {
-			limit = &m.Ctx.Config.Defaults.PrsLimit
+		provider, err := m.Provider()
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
 		}
-
-		res, err := data.FetchPullRequests(m.GetFilters(), *limit, m.PageInfo)
+		res, err := provider.FetchPullRequests(m.GetFilters(), *limit, m.PageInfo)
 		if err != nil {
+			var msg tea.Msg
+			if data.IsOffline(err) {
+				offlineMsg := SectionPullRequestsFetchedMsg{TaskId: taskId, Offline: true}
+				if res, savedAt, ok := section.ReadOfflineRows[data.PullRequestsResponse](&m.BaseModel, *limit); ok && isFirstPage {
+					offlineMsg.Prs = m.toPrRows(res.Prs)
+					offlineMsg.TotalCount = res.TotalCount
+					offlineMsg.CachedAt = savedAt
+				}
+				msg = offlineMsg
+			}
 			return constants.TaskFinishedMsg{
 				SectionId:   m.Id,
 				SectionType: m.Type,
 				TaskId:      taskId,
 				Err:         err,
+				Msg:         msg,
 			}
 		}
 
-		prs := make([]prrow.Data, 0)
+		ids := make([]string, 0, len(res.Prs))
 		for _, pr := range res.Prs {
-			prs = append(prs, prrow.Data{Primary: &pr})
+			ids = append(ids, pr.Id)
 		}
+		projectFields := m.FetchProjectFields(ids)
+		iterations, err := m.FetchIterations()
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+		fetched := len(res.Prs)
+		res.Prs = section.FilterBySprint(&m.BaseModel, res.Prs, projectFields, iterations, time.Now())
+		res.Prs = m.SizeFilter().Filter(res.Prs, m.Ctx.Config.PrSize)
+		res.TotalCount -= fetched - len(res.Prs)
+
+		if isFirstPage {
+			section.WriteCachedRows(&m.BaseModel, *limit, res)
+		}
+
 		return constants.TaskFinishedMsg{
 			SectionId:   m.Id,
 			SectionType: m.Type,
 			TaskId:      taskId,
 			Msg: SectionPullRequestsFetchedMsg{
-				Prs:        prs,
-				TotalCount: res.TotalCount,
-				PageInfo:   res.PageInfo,
-				TaskId:     taskId,
+				Prs:           m.toPrRows(res.Prs),
+				TotalCount:    res.TotalCount,
+				PageInfo:      res.PageInfo,
+				TaskId:        taskId,
+				ProjectFields: projectFields,
+				Iterations:    iterations,
 			},
 		}
 	}
@@ -598,6 +1106,20 @@ func FetchAllSections(
 			fetchPRsCmds,
 			sectionModel.FetchNextPageSectionRows()...)
 	}
+	for _, planSection := range ctx.PlanSections() {
+		sectionModel := NewModel(
+			len(sections)+1,
+			ctx,
+			planSection.Config,
+			time.Now(),
+			time.Now(),
+		)
+		sectionModel.PlanBucket = planSection.Bucket
+		sections = append(sections, &sectionModel)
+		fetchPRsCmds = append(
+			fetchPRsCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
 	return sections, tea.Batch(fetchPRsCmds...)
 }
 
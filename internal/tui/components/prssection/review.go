@@ -0,0 +1,112 @@
+package prssection
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const reviewAction = "review"
+
+// SetIsPromptConfirmationShown opens the review prompt instead of the footer
+// prompt when reviewing
+func (m *Model) SetIsPromptConfirmationShown(val bool) tea.Cmd {
+	if m.GetPromptConfirmationAction() != reviewAction {
+		return m.BaseModel.SetIsPromptConfirmationShown(val)
+	}
+
+	pr := m.GetCurrRow()
+	if !val || pr == nil {
+		m.reviewPrompt.Blur()
+		return m.BaseModel.SetIsPromptConfirmationShown(false)
+	}
+
+	m.BaseModel.SetIsPromptConfirmationShown(true)
+	m.PromptConfirmationBox.Blur()
+	m.reviewPrompt.SetWidth(min(80, m.GetDimensions().Width))
+	return m.reviewPrompt.Open(fmt.Sprintf("#%d %s", pr.GetNumber(), pr.GetTitle()), data.ReviewEventComment)
+}
+
+// HasReviewDraft returns whether a review of a PR of the section is being
+// written
+func (m *Model) HasReviewDraft() bool {
+	return m.reviewPrompt.HasDraft()
+}
+
+func (m *Model) GetPromptConfirmation() string {
+	if m.GetPromptConfirmationAction() == reviewAction {
+		// the review prompt is drawn over the table
+		return m.GetPagerContent()
+	}
+	return m.BaseModel.GetPromptConfirmation()
+}
+
+func (m *Model) View() string {
+	if !m.reviewPrompt.Focused() {
+		return m.BaseModel.View()
+	}
+
+	d := m.GetDimensions()
+	return m.Ctx.Styles.Section.ContainerStyle.Render(
+		lipgloss.JoinVertical(
+			lipgloss.Left,
+			m.SearchBar.View(m.Ctx),
+			lipgloss.Place(
+				d.Width,
+				d.Height,
+				lipgloss.Center,
+				lipgloss.Center,
+				m.reviewPrompt.View(),
+			),
+		),
+	)
+}
+
+func (m *Model) submitReview(event data.ReviewEvent, body string) tea.Cmd {
+	pr := m.GetCurrRow()
+	if pr == nil {
+		return nil
+	}
+
+	prNumber := pr.GetNumber()
+	url := pr.GetUrl()
+	taskId := fmt.Sprintf("pr_review_%d", prNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Submitting review of PR #%d", prNumber),
+		FinishedText: fmt.Sprintf("Submitted review of PR #%d", prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.SubmitReview(url, event, body)
+		msg := tasks.UpdatePRMsg{PrNumber: prNumber}
+		switch {
+		case err != nil:
+		case event == data.ReviewEventApprove:
+			msg.ReviewDecision = utils.StringPtr("APPROVED")
+		case event == data.ReviewEventRequestChanges:
+			msg.ReviewDecision = utils.StringPtr("CHANGES_REQUESTED")
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         msg,
+		}
+	})
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.BaseModel.UpdateProgramContext(ctx)
+	m.reviewPrompt.UpdateProgramContext(ctx)
+}
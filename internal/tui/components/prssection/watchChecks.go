@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/log"
 	"github.com/gen2brain/beeep"
 
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
@@ -43,7 +44,7 @@ func (m *Model) watchChecks() tea.Cmd {
 			"--fail-fast",
 			fmt.Sprint(m.GetCurrRow().GetNumber()),
 			"-R",
-			m.GetCurrRow().GetRepoNameWithOwner(),
+			data.RepoArg(m.GetCurrRow()),
 		)
 
 		var outb, errb bytes.Buffer
@@ -3,13 +3,15 @@ package prview
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
-	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
@@ -20,51 +22,109 @@ type RenderedActivity struct {
 }
 
 func (m *Model) renderActivity() string {
-	width := m.getIndentedContentWidth() - 2
-	markdownRenderer := markdown.GetMarkdownRenderer(width)
 	bodyStyle := lipgloss.NewStyle().PaddingLeft(2)
-
-	var activities []RenderedActivity
-	var comments []comment
-
 	if !m.pr.Data.IsEnriched {
 		return bodyStyle.Render("Loading...")
 	}
 
-	for _, review := range m.pr.Data.Enriched.ReviewThreads.Nodes {
-		path := review.Path
-		line := review.Line
-		for _, c := range review.Comments.Nodes {
+	activities, numComments, numHiddenBots := m.renderActivities()
+
+	body := ""
+	hiddenBots := m.renderHiddenBotComments(numHiddenBots)
+	if len(activities) == 0 && hiddenBots != "" {
+		body = hiddenBots
+	} else if len(activities) == 0 {
+		body = renderEmptyState()
+	} else {
+		var renderedActivities []string
+		for _, activity := range activities {
+			renderedActivities = append(renderedActivities, activity.RenderedString)
+		}
+		title := m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(
+			fmt.Sprintf("%s  %d comments", constants.CommentsIcon, numComments))
+		body = lipgloss.JoinVertical(lipgloss.Left, renderedActivities...)
+		if hiddenBots != "" {
+			body = lipgloss.JoinVertical(lipgloss.Left, hiddenBots, "", body)
+		}
+		if loadOlder := m.renderLoadOlderComments(); loadOlder != "" {
+			body = lipgloss.JoinVertical(lipgloss.Left, loadOlder, "", body)
+		}
+		body = lipgloss.JoinVertical(lipgloss.Left, title, body)
+	}
+
+	return bodyStyle.Render(body)
+}
+
+// renderActivities renders the comments, review threads, reviews and timeline
+// events of the PR, the oldest first. numComments are the ones that aren't
+// events.
+func (m *Model) renderActivities() (activities []RenderedActivity, numComments int, numHiddenBots int) {
+	width := m.getIndentedContentWidth() - 2
+	markdownRenderer := m.ctx.ReadingRenderer(width)
+	showBots := m.showBotComments()
+
+	for _, thread := range m.pr.Data.Enriched.ReviewThreads.Nodes {
+		path := thread.Path
+		line := thread.Line
+		var comments []comment
+		for _, c := range thread.Comments.Nodes {
+			if !showBots && m.ctx.Config.Bots.IsBot(c.Author.Login) {
+				numHiddenBots++
+				continue
+			}
 			comments = append(comments, comment{
 				Author:    c.Author.Login,
 				Body:      c.Body,
 				UpdatedAt: c.UpdatedAt,
-				Path:      &path,
-				Line:      &line,
 			})
 		}
+		if len(comments) == 0 {
+			continue
+		}
+		comments[0].Path, comments[0].Line = &path, &line
+		renderedThread, err := m.renderReviewThread(comments, markdownRenderer)
+		if err != nil {
+			continue
+		}
+		// the thread is shown once, where its latest reply was left
+		activities = append(activities, RenderedActivity{
+			UpdatedAt:      comments[len(comments)-1].UpdatedAt,
+			RenderedString: renderedThread,
+		})
 	}
 
 	for _, c := range m.pr.Data.Enriched.Comments.Nodes {
-		comments = append(comments, comment{
+		if !showBots && m.ctx.Config.Bots.IsBot(c.Author.Login) {
+			numHiddenBots++
+			continue
+		}
+		renderedComment, err := m.renderComment(comment{
 			Author:    c.Author.Login,
 			Body:      c.Body,
 			UpdatedAt: c.UpdatedAt,
-		})
-	}
-
-	for _, comment := range comments {
-		renderedComment, err := m.renderComment(comment, markdownRenderer)
+		}, markdownRenderer)
 		if err != nil {
 			continue
 		}
 		activities = append(activities, RenderedActivity{
-			UpdatedAt:      comment.UpdatedAt,
+			UpdatedAt:      c.UpdatedAt,
 			RenderedString: renderedComment,
 		})
 	}
 
-	for _, review := range m.pr.Data.Primary.Reviews.Nodes {
+	for _, item := range m.pr.Data.Enriched.TimelineItems.Nodes {
+		if item.Typename != "PullRequestReview" {
+			continue
+		}
+		review := timelineReview(item)
+		// the comments of a review without a body are shown in their threads
+		if review.UpdatedAt.IsZero() || (review.State == "COMMENTED" && review.Body == "") {
+			continue
+		}
+		if !showBots && m.ctx.Config.Bots.IsBot(review.Author.Login) {
+			numHiddenBots++
+			continue
+		}
 		renderedReview, err := m.renderReview(review, markdownRenderer)
 		if err != nil {
 			continue
@@ -75,25 +135,85 @@ func (m *Model) renderActivity() string {
 		})
 	}
 
-	sort.Slice(activities, func(i, j int) bool {
+	numComments = len(activities)
+	if !m.hideTimelineEvents {
+		for _, item := range m.pr.Data.Enriched.TimelineItems.Nodes {
+			renderedEvent := m.renderTimelineEvent(item)
+			if renderedEvent == "" {
+				continue
+			}
+			activities = append(activities, RenderedActivity{
+				UpdatedAt:      item.CreatedAt(),
+				RenderedString: renderedEvent,
+			})
+		}
+	}
+
+	sort.SliceStable(activities, func(i, j int) bool {
 		return activities[i].UpdatedAt.Before(activities[j].UpdatedAt)
 	})
 
-	body := ""
+	return activities, numComments, numHiddenBots
+}
+
+// LatestActivityOffset is the line of View at which the latest entry of the
+// activity tab starts
+func (m Model) LatestActivityOffset() int {
+	if m.pr == nil || !m.pr.Data.IsEnriched {
+		return 0
+	}
+	activities, _, _ := m.renderActivities()
 	if len(activities) == 0 {
-		body = renderEmptyState()
-	} else {
-		var renderedActivities []string
-		for _, activity := range activities {
-			renderedActivities = append(renderedActivities, activity.RenderedString)
-		}
-		title := m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(
-			fmt.Sprintf("%s  %d comments", constants.CommentsIcon, len(activities)))
-		body = lipgloss.JoinVertical(lipgloss.Left, renderedActivities...)
-		body = lipgloss.JoinVertical(lipgloss.Left, title, body)
+		return 0
 	}
+	latest := activities[len(activities)-1].RenderedString
+	return lipgloss.Height(m.renderHeader()) + lipgloss.Height(m.renderActivity()) -
+		lipgloss.Height(latest)
+}
 
-	return bodyStyle.Render(body)
+// GoToActivityTab moves to the activity tab
+func (m *Model) GoToActivityTab() {
+	m.carousel.SetCursor(2)
+}
+
+// ToggleCollapsedActivity shows only the first line of the comments and
+// reviews of the activity tab, or all of them again
+func (m *Model) ToggleCollapsedActivity() {
+	m.collapsedActivity = !m.collapsedActivity
+}
+
+// renderLoadOlderComments tells how many of the comments were fetched when
+// there are older ones to load
+func (m *Model) renderLoadOlderComments() string {
+	comments := m.pr.Data.Enriched.Comments
+	if !comments.PageInfo.HasPreviousPage {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Italic(true).
+		Width(m.getIndentedContentWidth() - 2).
+		Render(fmt.Sprintf("Showing the latest %d of %d comments, press %s to load older ones",
+			len(comments.Nodes), comments.TotalCount, keys.PRKeys.LoadOlderComments.Help().Key))
+}
+
+// renderHiddenBotComments tells how many bot comments were hidden and how to
+// show them
+func (m *Model) renderHiddenBotComments(numHidden int) string {
+	if numHidden == 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Italic(true).
+		Width(m.getIndentedContentWidth() - 2).
+		Render(fmt.Sprintf("󰚩 %d bot comments hidden, press %s to show them",
+			numHidden, keys.PRKeys.ToggleBotComments.Help().Key))
+}
+
+func (m *Model) showBotComments() bool {
+	return m.ctx.Config.Bots.Show != m.botCommentsToggled
+}
+
+// ToggleBotComments shows or hides the comments and reviews of bots
+func (m *Model) ToggleBotComments() {
+	m.botCommentsToggled = !m.botCommentsToggled
 }
 
 func renderEmptyState() string {
@@ -108,7 +228,7 @@ type comment struct {
 	Line      *int
 }
 
-func (m *Model) renderComment(comment comment, markdownRenderer glamour.TermRenderer) (string, error) {
+func (m *Model) renderComment(comment comment, markdownRenderer markdown.Renderer) (string, error) {
 	width := m.getIndentedContentWidth()
 	authorAndTime := lipgloss.NewStyle().
 		Width(width).
@@ -134,6 +254,10 @@ func (m *Model) renderComment(comment comment, markdownRenderer glamour.TermRend
 		header = authorAndTime
 	}
 
+	if m.collapsedActivity {
+		return lipgloss.JoinVertical(lipgloss.Left, header, m.renderCollapsedBody(comment.Body)), nil
+	}
+
 	body := lineCleanupRegex.ReplaceAllString(comment.Body, "")
 	body, err := markdownRenderer.Render(body)
 
@@ -144,8 +268,14 @@ func (m *Model) renderComment(comment comment, markdownRenderer glamour.TermRend
 	), err
 }
 
-func (m *Model) renderReview(review data.Review, markdownRenderer glamour.TermRenderer) (string, error) {
+func (m *Model) renderReview(review data.Review, markdownRenderer markdown.Renderer) (string, error) {
 	header := m.renderReviewHeader(review)
+	if m.collapsedActivity {
+		if review.Body == "" {
+			return header, nil
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, header, m.renderCollapsedBody(review.Body)), nil
+	}
 	body, err := markdownRenderer.Render(review.Body)
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -154,6 +284,52 @@ func (m *Model) renderReview(review data.Review, markdownRenderer glamour.TermRe
 	), err
 }
 
+// renderReviewThread renders the comments of a review thread as one entry,
+// the replies indented under the first comment
+func (m *Model) renderReviewThread(comments []comment, markdownRenderer markdown.Renderer) (string, error) {
+	first, err := m.renderComment(comments[0], markdownRenderer)
+	if err != nil {
+		return "", err
+	}
+	rendered := []string{first}
+	for _, reply := range comments[1:] {
+		renderedReply, err := m.renderComment(reply, markdownRenderer)
+		if err != nil {
+			continue
+		}
+		rendered = append(rendered, lipgloss.NewStyle().PaddingLeft(2).Render(renderedReply))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rendered...), nil
+}
+
+// renderCollapsedBody renders the first line of the body of a collapsed
+// comment or review
+func (m *Model) renderCollapsedBody(body string) string {
+	firstLine := ""
+	for _, line := range strings.Split(htmlCommentRegex.ReplaceAllString(body, ""), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			firstLine = line
+			break
+		}
+	}
+	if firstLine == "" {
+		return ""
+	}
+	width := m.getIndentedContentWidth() - 2
+	return lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText).Italic(true).PaddingLeft(1).
+		Render(ansi.Truncate(firstLine, width-1, constants.Ellipsis))
+}
+
+// timelineReview returns the review of a timeline item of a review
+func timelineReview(item data.TimelineItem) data.Review {
+	var review data.Review
+	review.Author.Login = item.PullRequestReview.Author.Login
+	review.Body = item.PullRequestReview.Body
+	review.State = item.PullRequestReview.State
+	review.UpdatedAt = item.PullRequestReview.SubmittedAt
+	return review
+}
+
 func (m *Model) renderReviewHeader(review data.Review) string {
 	return lipgloss.JoinHorizontal(lipgloss.Top,
 		m.renderReviewDecision(review.State),
@@ -6,6 +6,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
@@ -28,7 +29,7 @@ func (m *Model) approve(comment string) tea.Cmd {
 		"pr",
 		"review",
 		"-R",
-		pr.GetRepoNameWithOwner(),
+		data.RepoArg(pr),
 		fmt.Sprint(prNumber),
 		"--approve",
 	}
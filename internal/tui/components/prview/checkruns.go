@@ -0,0 +1,435 @@
+package prview
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	ghchecks "github.com/dlvhdr/x/gh-checks"
+)
+
+// checksPollInterval is how often the checks are refetched while the checks
+// tab is shown and some of them haven't completed
+const checksPollInterval = 10 * time.Second
+
+// checkItem is a check run or a status context listed in the checks tab
+type checkItem struct {
+	category CheckCategory
+	// checkRun is nil for status contexts, it's the first failing job, or
+	// the first one, of collapsed matrix jobs
+	checkRun *data.CheckRun
+	// statusContext is nil for check runs
+	statusContext *data.StatusContext
+	// group is the matrix the check run is a job of, empty when it's the
+	// only job of its name in its workflow
+	group string
+	// jobs is how many matrix jobs are collapsed under the item
+	jobs int
+}
+
+// checkLog is the tail of the log of the job of a check run
+type checkLog struct {
+	jobId   int
+	lines   []string
+	loading bool
+	err     error
+}
+
+type ChecksPollMsg struct {
+	Url string
+	Id  int
+}
+
+type ChecksFetchedMsg struct {
+	Url     string
+	Id      int
+	Commits data.CommitsWithStatusChecks
+	Err     error
+}
+
+type CheckLogFetchedMsg struct {
+	JobId int
+	Lines []string
+	Err   error
+}
+
+// checkItems returns the checks of the last commit of the PR, failures
+// first, then the ones that haven't completed. The checks of hidden sources
+// are left out unless they're shown, and the jobs of a matrix are collapsed
+// into one item unless it's expanded.
+func (m *Model) checkItems() []checkItem {
+	items, _ := m.listChecks()
+	return items
+}
+
+// listChecks returns the check items and how many checks are hidden
+func (m *Model) listChecks() ([]checkItem, int) {
+	commits := m.pr.Data.Enriched.Commits.Nodes
+	if len(commits) == 0 {
+		return nil, 0
+	}
+
+	var items []checkItem
+	numHidden := 0
+	nodes := commits[0].Commit.StatusCheckRollup.Contexts.Nodes
+	for i := range nodes {
+		var item checkItem
+		switch nodes[i].Typename {
+		case "CheckRun":
+			item.checkRun = &nodes[i].CheckRun
+			item.category, _ = m.renderCheckRunConclusion(*item.checkRun)
+		case "StatusContext":
+			item.statusContext = &nodes[i].StatusContext
+			item.category, _ = m.renderStatusContextConclusion(*item.statusContext)
+		default:
+			continue
+		}
+		if m.isCheckSourceHidden(checkSource(item)) {
+			numHidden++
+			if !m.showHiddenChecks {
+				continue
+			}
+		}
+		items = append(items, item)
+	}
+	items = groupMatrixJobs(items, m.expandedChecks)
+
+	var failures, waiting, rest []checkItem
+	for _, item := range items {
+		switch item.category {
+		case CheckWaiting:
+			waiting = append(waiting, item)
+		case CheckFailure:
+			failures = append(failures, item)
+		default:
+			rest = append(rest, item)
+		}
+	}
+
+	items = append(failures, waiting...)
+	return append(items, rest...), numHidden
+}
+
+// checkSource returns the app that created the check run of item, or the
+// context of its commit status
+func checkSource(item checkItem) string {
+	if item.statusContext != nil {
+		return strings.TrimSpace(string(item.statusContext.Context))
+	}
+	return strings.TrimSpace(string(item.checkRun.CheckSuite.Creator.Login))
+}
+
+func (m *Model) isCheckSourceHidden(source string) bool {
+	return m.ctx.CheckFilters != nil && source != "" &&
+		m.ctx.CheckFilters.IsHidden(m.pr.Data.Primary.GetRepoNameWithOwner(), source)
+}
+
+// matrixJobName returns the name of the job of a matrix a check run is named
+// after, e.g. test for "test (ubuntu-latest, 1.22)", or name when it isn't
+// named after one
+func matrixJobName(name string) string {
+	name = strings.TrimSpace(name)
+	i := strings.LastIndex(name, " (")
+	if i <= 0 || !strings.HasSuffix(name, ")") {
+		return name
+	}
+	return name[:i]
+}
+
+// matrixGroup returns the matrix a check run is a job of, by its workflow and
+// job name
+func matrixGroup(checkRun data.CheckRun) string {
+	return strings.Join([]string{
+		string(checkRun.CheckSuite.Creator.Login),
+		string(checkRun.CheckSuite.WorkflowRun.Workflow.Name),
+		matrixJobName(string(checkRun.Name)),
+	}, "/")
+}
+
+// groupMatrixJobs collapses the check runs that are jobs of the same matrix
+// into the first one, unless the matrix is expanded. A collapsed matrix is
+// failing if one of its jobs is, waiting if one of them hasn't completed.
+func groupMatrixJobs(items []checkItem, expanded map[string]bool) []checkItem {
+	jobs := map[string][]int{}
+	for i, item := range items {
+		if item.checkRun != nil {
+			group := matrixGroup(*item.checkRun)
+			jobs[group] = append(jobs[group], i)
+		}
+	}
+
+	grouped := make([]checkItem, 0, len(items))
+	for i, item := range items {
+		if item.checkRun == nil {
+			grouped = append(grouped, item)
+			continue
+		}
+		group := matrixGroup(*item.checkRun)
+		members := jobs[group]
+		if len(members) < 2 {
+			grouped = append(grouped, item)
+			continue
+		}
+		item.group = group
+		if expanded[group] {
+			grouped = append(grouped, item)
+			continue
+		}
+		if members[0] != i {
+			continue
+		}
+
+		item.jobs = len(members)
+		for _, j := range members {
+			switch {
+			case items[j].category == CheckFailure && item.category != CheckFailure:
+				item.category, item.checkRun = CheckFailure, items[j].checkRun
+			case items[j].category == CheckWaiting && item.category == CheckSuccess:
+				item.category = CheckWaiting
+			}
+		}
+		grouped = append(grouped, item)
+	}
+	return grouped
+}
+
+// toggleCheckJobs expands the matrix of the selected check, or collapses it
+// if it's expanded
+func (m *Model) toggleCheckJobs() tea.Cmd {
+	item := m.selectedCheck()
+	if item == nil || item.group == "" {
+		return notifyErr(errors.New("the check isn't a job of a matrix"))
+	}
+
+	if m.expandedChecks[item.group] {
+		delete(m.expandedChecks, item.group)
+	} else {
+		if m.expandedChecks == nil {
+			m.expandedChecks = map[string]bool{}
+		}
+		m.expandedChecks[item.group] = true
+	}
+	m.checkLog = checkLog{}
+	// keep the matrix selected
+	for i, other := range m.checkItems() {
+		if other.group == item.group {
+			m.checkCursor = i
+			break
+		}
+	}
+	return nil
+}
+
+// toggleCheckSource hides the checks of the source of the selected check in
+// the PR's repo, or shows them again if they're hidden, and saves the choice
+func (m *Model) toggleCheckSource() tea.Cmd {
+	item := m.selectedCheck()
+	if item == nil {
+		return nil
+	}
+	source := checkSource(*item)
+	filters := m.ctx.CheckFilters
+	if filters == nil || source == "" {
+		return notifyErr(errors.New("the checks of this source can't be hidden"))
+	}
+
+	repo := m.pr.Data.Primary.GetRepoNameWithOwner()
+	hidden := filters.Toggle(repo, source)
+	m.checkCursor = min(m.checkCursor, max(0, len(m.checkItems())-1))
+	m.checkLog = checkLog{}
+
+	text := fmt.Sprintf("The checks of %s are shown in %s", source, repo)
+	if hidden {
+		text = fmt.Sprintf("The checks of %s are hidden in %s", source, repo)
+	}
+	taskId := fmt.Sprintf("check_filters_%s", source)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    "Saving check filters",
+		FinishedText: text,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: prssection.SectionType,
+			TaskId:      taskId,
+			Err:         filters.Save(),
+		}
+	})
+}
+
+// IsViewingChecks returns whether the checks tab is shown
+func (m *Model) IsViewingChecks() bool {
+	return m.carousel.SelectedItem() == tabs[1]
+}
+
+func (m *Model) selectedCheck() *checkItem {
+	if m.pr == nil {
+		return nil
+	}
+	items := m.checkItems()
+	if len(items) == 0 {
+		return nil
+	}
+	m.checkCursor = min(m.checkCursor, len(items)-1)
+	return &items[m.checkCursor]
+}
+
+func (m *Model) nextCheck() {
+	if m.pr == nil {
+		return
+	}
+	m.checkCursor = min(m.checkCursor+1, max(0, len(m.checkItems())-1))
+}
+
+func (m *Model) prevCheck() {
+	m.checkCursor = max(m.checkCursor-1, 0)
+}
+
+// hasPendingChecks returns whether some checks of the PR haven't completed
+func (m *Model) hasPendingChecks() bool {
+	for _, item := range m.checkItems() {
+		if item.category == CheckWaiting {
+			return true
+		}
+	}
+	return false
+}
+
+// startChecksPoll polls the checks of the PR while the checks tab is shown,
+// the polls started before stop
+func (m *Model) startChecksPoll() tea.Cmd {
+	if m.pr == nil || m.pr.Data.Forge != "" || !m.IsViewingChecks() {
+		return nil
+	}
+	m.checksPollId++
+	return m.tickChecksPoll()
+}
+
+func (m *Model) tickChecksPoll() tea.Cmd {
+	url := m.pr.Data.Primary.Url
+	id := m.checksPollId
+	return tea.Tick(checksPollInterval, func(time.Time) tea.Msg {
+		return ChecksPollMsg{Url: url, Id: id}
+	})
+}
+
+func (m *Model) isCurrentChecksPoll(url string, id int) bool {
+	return m.pr != nil && m.pr.Data.Primary.Url == url && id == m.checksPollId &&
+		m.IsViewingChecks()
+}
+
+// PollChecks refetches the checks of the PR, unless the poll was stopped
+func (m *Model) PollChecks(msg ChecksPollMsg) tea.Cmd {
+	if !m.isCurrentChecksPoll(msg.Url, msg.Id) {
+		return nil
+	}
+	return func() tea.Msg {
+		commits, err := data.FetchPullRequestChecks(msg.Url)
+		return ChecksFetchedMsg{Url: msg.Url, Id: msg.Id, Commits: commits, Err: err}
+	}
+}
+
+// SetChecks updates the checks of the PR and keeps polling them while some
+// haven't completed
+func (m *Model) SetChecks(msg ChecksFetchedMsg) tea.Cmd {
+	if !m.isCurrentChecksPoll(msg.Url, msg.Id) {
+		return nil
+	}
+	if msg.Err != nil {
+		log.Error("failed fetching pr checks", "err", msg.Err)
+	} else {
+		m.pr.Data.Enriched.Commits = msg.Commits
+	}
+
+	if !m.hasPendingChecks() {
+		return nil
+	}
+	return m.tickChecksPoll()
+}
+
+// tailCheckLog fetches the end of the log of the job of the selected check,
+// or hides it if it's shown
+func (m *Model) tailCheckLog() tea.Cmd {
+	item := m.selectedCheck()
+	if item == nil || item.checkRun == nil || !item.checkRun.IsActionsJob() {
+		return notifyErr(errors.New("only the logs of GitHub Actions jobs can be shown"))
+	}
+
+	jobId := int(item.checkRun.DatabaseId)
+	if m.checkLog.jobId == jobId {
+		m.checkLog = checkLog{}
+		return nil
+	}
+
+	m.checkLog = checkLog{jobId: jobId, loading: true}
+	repo := m.pr.Data.Primary.GetRepoNameWithOwner()
+	n := m.ctx.Config.Defaults.CheckLogLines
+	return func() tea.Msg {
+		lines, err := data.FetchJobLogTail(repo, jobId, n)
+		return CheckLogFetchedMsg{JobId: jobId, Lines: lines, Err: err}
+	}
+}
+
+func (m *Model) SetCheckLog(msg CheckLogFetchedMsg) {
+	if m.checkLog.jobId != msg.JobId {
+		return
+	}
+	m.checkLog = checkLog{jobId: msg.JobId, lines: msg.Lines, err: msg.Err}
+}
+
+// rerunFailedChecks re-runs the failed jobs of the workflow run of the
+// selected check
+func (m *Model) rerunFailedChecks() tea.Cmd {
+	item := m.selectedCheck()
+	if item == nil || item.checkRun == nil || !item.checkRun.IsActionsJob() {
+		return notifyErr(errors.New("only GitHub Actions jobs can be re-run"))
+	}
+	if !ghchecks.IsConclusionAFailure(string(item.checkRun.Conclusion)) {
+		return notifyErr(errors.New("the check didn't fail"))
+	}
+
+	pr := m.pr.Data.Primary
+	runId := int(item.checkRun.CheckSuite.WorkflowRun.DatabaseId)
+	workflow := string(item.checkRun.CheckSuite.WorkflowRun.Workflow.Name)
+	taskId := fmt.Sprintf("pr_rerun_%d", runId)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Re-running failed jobs of %s", workflow),
+		FinishedText: fmt.Sprintf("Re-run of the failed jobs of %s has been requested", workflow),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, m.startChecksPoll(), func() tea.Msg {
+		out, err := exec.Command("gh", "run", "rerun", fmt.Sprint(runId), "--failed",
+			"-R", data.RepoArg(pr)).CombinedOutput()
+		if err != nil {
+			err = fmt.Errorf("%w: %s", err, out)
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: prssection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+		}
+	})
+}
+
+func notifyErr(err error) tea.Cmd {
+	return func() tea.Msg {
+		return constants.ErrMsg{Err: err}
+	}
+}
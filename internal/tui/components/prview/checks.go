@@ -8,6 +8,8 @@ import (
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/workflowrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	ghchecks "github.com/dlvhdr/x/gh-checks"
 )
 
@@ -112,7 +114,16 @@ func (m *Model) viewMergeStatus() (string, checkSectionStatus) {
 	var icon, title, subtitle string
 	var status checkSectionStatus
 	numReviewOwners := m.numRequestedReviewOwners()
-	if m.pr.Data.Primary.MergeStateStatus == "CLEAN" ||
+	if entry := m.pr.Data.Primary.MergeQueueEntry; entry != nil {
+		icon = m.ctx.Styles.Common.WaitingGlyph
+		title = fmt.Sprintf("Queued to merge, #%d in the merge queue", entry.Position+1)
+		subtitle = entry.Description()
+		status = statusWaiting
+		if entry.State == data.MergeQueueEntryStateUnmergeable {
+			icon = m.ctx.Styles.Common.FailureGlyph
+			status = statusFailure
+		}
+	} else if m.pr.Data.Primary.MergeStateStatus == "CLEAN" ||
 		m.pr.Data.Primary.MergeStateStatus == "UNSTABLE" {
 		icon = m.ctx.Styles.Common.SuccessGlyph
 		title = "No conflicts with base branch"
@@ -204,12 +215,16 @@ func (m *Model) viewReviewStatus() (string, checkSectionStatus) {
 		title = "Review Required"
 
 		branchRules := m.pr.Data.Primary.Repository.BranchProtectionRules.Nodes
+		approved, required := m.pr.Data.Primary.Approvals()
 		if len(branchRules) > 0 && branchRules[0].RequiresCodeOwnerReviews && numApproving < 1 {
 			subtitle = "Code owner review required"
 			status = statusFailure
 		} else if numApproving < numReviewOwners {
 			subtitle = "Code owner review required"
 			status = statusFailure
+		} else if approved < required {
+			subtitle = fmt.Sprintf("%d of %d required approvals", approved, required)
+			status = statusWaiting
 		} else if len(branchRules) > 0 && numApproving <
 			branchRules[0].RequiredApprovingReviewCount {
 			subtitle = fmt.Sprintf("Need %d more approval",
@@ -226,6 +241,10 @@ func (m *Model) viewReviewStatus() (string, checkSectionStatus) {
 		status = statusNonRequested
 	}
 
+	if reviewers := m.renderReviewers(); reviewers != "" {
+		subtitle = strings.TrimPrefix(subtitle+"\n"+reviewers, "\n")
+	}
+
 	return m.viewCheckCategory(icon, title, subtitle, false), status
 }
 
@@ -352,6 +371,18 @@ func (m *Model) renderStatusContextConclusion(statusContext data.StatusContext)
 	return CheckSuccess, m.ctx.Styles.Common.SuccessGlyph
 }
 
+// renderCheckJobsName renders the name of the matrix the jobs collapsed
+// under checkRun are part of
+func renderCheckJobsName(checkRun data.CheckRun, jobs int) string {
+	var parts []string
+	for _, part := range strings.Split(matrixGroup(checkRun), "/") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return fmt.Sprintf("▸ %s (%d jobs)", strings.Join(parts, "/"), jobs)
+}
+
 func renderStatusContextName(statusContext data.StatusContext) string {
 	var parts []string
 	creator := strings.TrimSpace(string(statusContext.Creator.Login))
@@ -370,10 +401,9 @@ func renderStatusContextName(statusContext data.StatusContext) string {
 }
 
 func (sidebar *Model) renderChecks() string {
-	title := sidebar.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(" All Checks")
+	title := sidebar.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render(" All Checks")
 
-	commits := sidebar.pr.Data.Enriched.Commits.Nodes
-	if len(commits) == 0 {
+	if len(sidebar.pr.Data.Enriched.Commits.Nodes) == 0 {
 		return lipgloss.JoinVertical(
 			lipgloss.Left,
 			title,
@@ -381,39 +411,9 @@ func (sidebar *Model) renderChecks() string {
 		)
 	}
 
-	failures := make([]string, 0)
-	waiting := make([]string, 0)
-	rest := make([]string, 0)
-
-	lastCommit := commits[0]
-	for _, node := range lastCommit.Commit.StatusCheckRollup.Contexts.Nodes {
-		var category CheckCategory
-		var check string
-		switch node.Typename {
-		case "CheckRun":
-			checkRun := node.CheckRun
-			var renderedStatus string
-			category, renderedStatus = sidebar.renderCheckRunConclusion(checkRun)
-			name := renderCheckRunName(checkRun)
-			check = lipgloss.JoinHorizontal(lipgloss.Top, renderedStatus, " ", name)
-		case "StatusContext":
-			statusContext := node.StatusContext
-			var status string
-			category, status = sidebar.renderStatusContextConclusion(statusContext)
-			check = lipgloss.JoinHorizontal(lipgloss.Top, status, " ", renderStatusContextName(statusContext))
-		}
-
-		switch category {
-		case CheckWaiting:
-			waiting = append(waiting, check)
-		case CheckFailure:
-			failures = append(failures, check)
-		default:
-			rest = append(rest, check)
-		}
-	}
-
-	if len(waiting)+len(failures)+len(rest) == 0 {
+	items, numHidden := sidebar.listChecks()
+	legend := sidebar.renderChecksLegend(numHidden)
+	if len(items) == 0 {
 		return lipgloss.JoinVertical(
 			lipgloss.Left,
 			title,
@@ -422,20 +422,117 @@ func (sidebar *Model) renderChecks() string {
 				PaddingLeft(2).
 				Width(sidebar.getIndentedContentWidth()).
 				Render("No checks to display..."),
+			"",
+			legend,
 		)
 	}
 
-	parts := make([]string, 0)
-	parts = append(parts, failures...)
-	parts = append(parts, waiting...)
-	parts = append(parts, rest...)
+	cursor := min(sidebar.checkCursor, len(items)-1)
+	parts := make([]string, 0, len(items))
+	for i, item := range items {
+		check := sidebar.renderCheckItem(item)
+		if i == cursor {
+			check = lipgloss.NewStyle().
+				Background(sidebar.ctx.Theme.SelectedBackground).
+				Width(sidebar.getIndentedContentWidth() - 2).
+				Render(check)
+			if item.checkRun != nil && int(item.checkRun.DatabaseId) == sidebar.checkLog.jobId {
+				check = lipgloss.JoinVertical(lipgloss.Left, check, sidebar.renderCheckLog())
+			}
+		}
+		parts = append(parts, check)
+	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		title,
 		lipgloss.NewStyle().PaddingLeft(2).Width(sidebar.getIndentedContentWidth()).Render(
 			lipgloss.JoinVertical(lipgloss.Left, parts...)),
+		"",
+		legend,
+		lipgloss.NewStyle().Foreground(sidebar.ctx.Theme.FaintText).Width(
+			sidebar.getIndentedContentWidth()).Render(fmt.Sprintf(
+			"%s/%s select • %s re-run failed jobs • %s tail log • %s expand jobs • %s hide source • %s show hidden",
+			keys.PRKeys.PrevCheck.Help().Key,
+			keys.PRKeys.NextCheck.Help().Key,
+			keys.PRKeys.RerunFailedChecks.Help().Key,
+			keys.PRKeys.TailCheckLog.Help().Key,
+			keys.PRKeys.ToggleCheckJobs.Help().Key,
+			keys.PRKeys.ToggleCheckSource.Help().Key,
+			keys.PRKeys.ShowHiddenChecks.Help().Key,
+		)),
+	)
+}
+
+// renderChecksLegend renders what the glyphs of the checks stand for, and how
+// many checks are hidden
+func (sidebar *Model) renderChecksLegend(numHidden int) string {
+	faint := lipgloss.NewStyle().Foreground(sidebar.ctx.Theme.FaintText)
+	legend := lipgloss.JoinHorizontal(lipgloss.Top,
+		sidebar.ctx.Styles.Common.SuccessGlyph, faint.Render(" passed  "),
+		sidebar.ctx.Styles.Common.FailureGlyph, faint.Render(" failed  "),
+		sidebar.ctx.Styles.Common.WaitingGlyph, faint.Render(" running"),
 	)
+	if numHidden == 0 {
+		return legend
+	}
+
+	hidden := fmt.Sprintf(" • %d hidden", numHidden)
+	if sidebar.showHiddenChecks {
+		hidden = fmt.Sprintf(" • %d from hidden sources shown", numHidden)
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, legend, faint.Render(hidden))
+}
+
+func (sidebar *Model) renderCheckItem(item checkItem) string {
+	if item.statusContext != nil {
+		_, status := sidebar.renderStatusContextConclusion(*item.statusContext)
+		return lipgloss.JoinHorizontal(lipgloss.Top, status, " ", renderStatusContextName(*item.statusContext))
+	}
+
+	status := sidebar.ctx.Styles.Common.SuccessGlyph
+	switch item.category {
+	case CheckFailure:
+		status = sidebar.ctx.Styles.Common.FailureGlyph
+	case CheckWaiting:
+		status = sidebar.ctx.Styles.Common.WaitingGlyph
+	}
+	if item.jobs > 0 {
+		return lipgloss.JoinHorizontal(lipgloss.Top, status, " ", renderCheckJobsName(*item.checkRun, item.jobs))
+	}
+
+	check := lipgloss.JoinHorizontal(lipgloss.Top, status, " ", renderCheckRunName(*item.checkRun))
+	if d := item.checkRun.Duration(); d > 0 {
+		check = lipgloss.JoinHorizontal(lipgloss.Top, check,
+			lipgloss.NewStyle().Foreground(sidebar.ctx.Theme.FaintText).Render(
+				" · "+workflowrow.FormatDuration(d)))
+	}
+	return check
+}
+
+func (sidebar *Model) renderCheckLog() string {
+	var content string
+	switch {
+	case sidebar.checkLog.loading:
+		content = "Loading log..."
+	case sidebar.checkLog.err != nil:
+		content = lipgloss.NewStyle().Foreground(sidebar.ctx.Theme.ErrorText).Render(
+			fmt.Sprintf("Failed fetching log: %v", sidebar.checkLog.err))
+	case len(sidebar.checkLog.lines) == 0:
+		content = "The log is empty"
+	default:
+		content = strings.Join(sidebar.checkLog.lines, "\n")
+	}
+
+	w := sidebar.getIndentedContentWidth() - 2
+	return lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder(), false, false, false, true).
+		BorderForeground(sidebar.ctx.Theme.FaintBorder).
+		Foreground(sidebar.ctx.Theme.FaintText).
+		PaddingLeft(1).
+		Width(w).
+		MaxWidth(w).
+		Render(content)
 }
 
 type checksStats struct {
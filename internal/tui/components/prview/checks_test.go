@@ -0,0 +1,65 @@
+package prview
+
+import (
+	"testing"
+
+	graphql "github.com/cli/shurcooL-graphql"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestMatrixJobName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "test (ubuntu-latest, 1.22)", want: "test"},
+		{name: "build", want: "build"},
+		{name: "(windows)", want: "(windows)"},
+		{name: "lint (go) extra", want: "lint (go) extra"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matrixJobName(tt.name); got != tt.want {
+				t.Errorf("matrixJobName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func checkRunItem(workflow, name string, category CheckCategory) checkItem {
+	var run data.CheckRun
+	run.Name = graphql.String(name)
+	run.CheckSuite.Creator.Login = "github-actions"
+	run.CheckSuite.WorkflowRun.Workflow.Name = graphql.String(workflow)
+	return checkItem{category: category, checkRun: &run}
+}
+
+func TestGroupMatrixJobs(t *testing.T) {
+	items := []checkItem{
+		checkRunItem("ci", "test (ubuntu)", CheckSuccess),
+		checkRunItem("ci", "lint", CheckSuccess),
+		checkRunItem("ci", "test (macos)", CheckFailure),
+		checkRunItem("ci", "test (windows)", CheckWaiting),
+		checkRunItem("release", "test (ubuntu)", CheckSuccess),
+	}
+
+	grouped := groupMatrixJobs(items, nil)
+	if len(grouped) != 3 {
+		t.Fatalf("groupMatrixJobs() returned %d items, want 3", len(grouped))
+	}
+	matrix := grouped[0]
+	if matrix.jobs != 3 || matrix.category != CheckFailure || matrix.checkRun != items[2].checkRun {
+		t.Errorf("collapsed matrix = %d jobs, category %v, want 3 jobs failing on the macos job",
+			matrix.jobs, matrix.category)
+	}
+	if grouped[2].jobs != 0 || grouped[2].group != "" {
+		t.Errorf("the only job of a workflow was grouped as %q", grouped[2].group)
+	}
+
+	expanded := groupMatrixJobs(items, map[string]bool{matrix.group: true})
+	if len(expanded) != len(items) {
+		t.Errorf("groupMatrixJobs() of an expanded matrix returned %d items, want %d",
+			len(expanded), len(items))
+	}
+}
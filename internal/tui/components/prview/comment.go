@@ -26,19 +26,16 @@ func (m *Model) comment(body string) tea.Cmd {
 		Error:        nil,
 	}
 	startCmd := m.ctx.StartTask(task)
-	return tea.Batch(startCmd, func() tea.Msg {
-		c := exec.Command(
-			"gh",
-			"pr",
-			"comment",
-			fmt.Sprint(prNumber),
-			"-R",
-			pr.GetRepoNameWithOwner(),
-			"-b",
-			body,
-		)
-
-		err := c.Run()
+	args := []string{
+		"pr",
+		"comment",
+		fmt.Sprint(prNumber),
+		"-R",
+		data.RepoArg(pr),
+		"-b",
+		body,
+	}
+	return tasks.RunQueueable(startCmd, args, func(_ *exec.Cmd, err error) constants.TaskFinishedMsg {
 		return constants.TaskFinishedMsg{
 			SectionId:   m.sectionId,
 			SectionType: prssection.SectionType,
@@ -55,3 +52,37 @@ func (m *Model) comment(body string) tea.Cmd {
 		}
 	})
 }
+
+// LoadOlderComments fetches the page of comments before the ones shown
+func (m *Model) LoadOlderComments() tea.Cmd {
+	if m.pr == nil || !m.pr.Data.IsEnriched || !m.pr.Data.Enriched.Comments.PageInfo.HasPreviousPage {
+		return nil
+	}
+
+	pr := m.pr.Data.Primary
+	prNumber := pr.GetNumber()
+	cursor := m.pr.Data.Enriched.Comments.PageInfo.StartCursor
+	taskId := fmt.Sprintf("pr_older_comments_%d", prNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Loading older comments of PR #%d", prNumber),
+		FinishedText: fmt.Sprintf("Loaded older comments of PR #%d", prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		comments, err := data.FetchOlderComments(pr.Url, cursor)
+		msg := tasks.UpdatePRMsg{PrNumber: prNumber}
+		if err == nil {
+			msg.OlderComments = &comments
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: prssection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         msg,
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package prview
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+type DiffFetchedMsg struct {
+	Url  string
+	Diff string
+	Err  error
+}
+
+// FetchDiff fetches the diff of the PR when the files changed tab is shown,
+// unless it was already fetched
+func (m *Model) FetchDiff() tea.Cmd {
+	if m.pr == nil || m.pr.Data.Forge != "" || !m.IsViewingDiff() || m.diff.Url() == m.pr.Data.Primary.Url {
+		return nil
+	}
+
+	url := m.pr.Data.Primary.Url
+	repo := m.pr.Data.Primary.GetRepoNameWithOwner()
+	number := m.pr.Data.Primary.GetNumber()
+	m.diff.SetLoading(url)
+	return func() tea.Msg {
+		diff, err := data.FetchPullRequestDiff(repo, number)
+		return DiffFetchedMsg{Url: url, Diff: diff, Err: err}
+	}
+}
+
+func (m *Model) SetDiff(msg DiffFetchedMsg) {
+	m.diff.SetDiff(msg.Url, msg.Diff, msg.Err)
+}
+
+// IsViewingDiff returns whether the files changed tab is shown
+func (m *Model) IsViewingDiff() bool {
+	return m.carousel.SelectedItem() == tabs[3]
+}
+
+// DiffOffset is the line of View at which the current hunk of the diff starts
+func (m Model) DiffOffset() int {
+	if m.pr == nil || m.diff.Url() != m.pr.Data.Primary.Url {
+		return 0
+	}
+	return lipgloss.Height(m.renderHeader()) + m.diff.HunkOffset()
+}
@@ -1,24 +1,27 @@
 package prview
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textarea"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/carousel"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/diffview"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
 
@@ -36,6 +39,7 @@ type Model struct {
 	pr        *prrow.PullRequest
 	width     int
 	carousel  carousel.Model
+	diff      diffview.Model
 
 	ShowConfirmCancel bool
 	isCommenting      bool
@@ -43,11 +47,39 @@ type Model struct {
 	isAssigning       bool
 	isUnassigning     bool
 	summaryViewMore   bool
+	// isRequestingReview is whether the input box re-requests reviews
+	isRequestingReview bool
+	// isDismissing is whether the input box takes the reason to dismiss the
+	// reviews requesting changes
+	isDismissing bool
+	// hideTimelineEvents shows only comments and reviews in the activity tab
+	hideTimelineEvents bool
+	// collapsedActivity shows only the first line of the comments and
+	// reviews in the activity tab
+	collapsedActivity bool
+	// botCommentsToggled flips whether bot comments are shown from the config
+	botCommentsToggled bool
+	// checkCursor is the check selected in the checks tab
+	checkCursor int
+	// threadCursor is the review thread selected in the threads tab
+	threadCursor int
+	// replyThreadId is the review thread replied to while commenting, empty
+	// for a comment on the PR
+	replyThreadId string
+	// checkLog is the tail of the log of the selected check, when shown
+	checkLog checkLog
+	// showHiddenChecks lists the checks of the sources hidden in the repo
+	showHiddenChecks bool
+	// expandedChecks are the matrices whose jobs are listed one by one
+	expandedChecks map[string]bool
+	// checksPollId tells the polls of the checks apart, only the last one
+	// started keeps polling
+	checksPollId int
 
 	inputBox inputbox.Model
 }
 
-var tabs = []string{" Overview", " Checks", " Activity", " Files Changed"}
+var tabs = []string{" Overview", " Checks", " Activity", " Files Changed", "󰅺 Threads"}
 
 func NewModel(ctx *context.ProgramContext) Model {
 	inputBox := inputbox.NewModel(ctx)
@@ -66,6 +98,7 @@ func NewModel(ctx *context.ProgramContext) Model {
 		isAssigning:   false,
 		isUnassigning: false,
 		carousel:      c,
+		diff:          diffview.NewModel(ctx),
 
 		inputBox: inputBox,
 	}
@@ -79,18 +112,30 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	)
 
 	switch msg := msg.(type) {
+	case inputbox.MentionsFetchedMsg:
+		m.inputBox, cmd = m.inputBox.Update(msg)
+		return m, cmd
+
 	case tea.KeyMsg:
 		if m.isCommenting {
 			switch msg.Type {
 			case tea.KeyCtrlD:
 				if len(strings.Trim(m.inputBox.Value(), " ")) != 0 {
-					cmd = m.comment(m.inputBox.Value())
+					if m.replyThreadId != "" {
+						cmd = m.replyToThread(m.replyThreadId, m.inputBox.Value())
+					} else {
+						cmd = m.comment(m.inputBox.Value())
+					}
 				}
 				m.inputBox.Blur()
 				m.isCommenting = false
+				m.replyThreadId = ""
 				return m, cmd
 
 			case tea.KeyEsc, tea.KeyCtrlC:
+				if m.inputBox.DismissSuggestions() {
+					return m, nil
+				}
 				if !m.ShowConfirmCancel {
 					m.shouldCancelComment()
 				}
@@ -102,11 +147,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 					}
 				}
 				if m.ShowConfirmCancel && (msg.String() == "N" || msg.String() == "n") {
-					m.inputBox.SetPrompt(commentPrompt)
+					m.inputBox.SetPrompt(m.commentPrompt())
 					m.ShowConfirmCancel = false
 					return m, nil
 				}
-				m.inputBox.SetPrompt(commentPrompt)
+				m.inputBox.SetPrompt(m.commentPrompt())
 				m.ShowConfirmCancel = false
 			}
 
@@ -171,16 +216,89 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				return m, nil
 			}
 
+			m.inputBox, taCmd = m.inputBox.Update(msg)
+			cmds = append(cmds, cmd, taCmd)
+		} else if m.isRequestingReview {
+			switch msg.Type {
+			case tea.KeyCtrlD:
+				usernames := strings.Fields(m.inputBox.Value())
+				if len(usernames) > 0 {
+					cmd = m.requestReview(usernames)
+				}
+				m.inputBox.Blur()
+				m.isRequestingReview = false
+				return m, cmd
+
+			case tea.KeyEsc, tea.KeyCtrlC:
+				m.inputBox.Blur()
+				m.isRequestingReview = false
+				return m, nil
+			}
+
+			m.inputBox, taCmd = m.inputBox.Update(msg)
+			cmds = append(cmds, cmd, taCmd)
+		} else if m.isDismissing {
+			switch msg.Type {
+			case tea.KeyCtrlD:
+				reason := strings.TrimSpace(m.inputBox.Value())
+				if reason == "" {
+					return m, notifyErr(errors.New("dismissing a review requires a reason"))
+				}
+				cmd = m.dismissBlockingReviews(reason)
+				m.inputBox.Blur()
+				m.isDismissing = false
+				return m, cmd
+
+			case tea.KeyEsc, tea.KeyCtrlC:
+				m.inputBox.Blur()
+				m.isDismissing = false
+				return m, nil
+			}
+
 			m.inputBox, taCmd = m.inputBox.Update(msg)
 			cmds = append(cmds, cmd, taCmd)
 		} else {
 			switch {
 			case key.Matches(msg, keys.PRKeys.PrevSidebarTab):
 				m.carousel.MoveLeft()
-				return m, nil
+				return m, tea.Batch(m.FetchDiff(), m.startChecksPoll())
 			case key.Matches(msg, keys.PRKeys.NextSidebarTab):
 				m.carousel.MoveRight()
-				return m, nil
+				return m, tea.Batch(m.FetchDiff(), m.startChecksPoll())
+			case key.Matches(msg, keys.PRKeys.NextCheck):
+				if m.IsViewingThreads() {
+					m.nextThread()
+				} else {
+					m.nextCheck()
+				}
+			case key.Matches(msg, keys.PRKeys.PrevCheck):
+				if m.IsViewingThreads() {
+					m.prevThread()
+				} else {
+					m.prevCheck()
+				}
+			case m.IsViewingThreads() && key.Matches(msg, keys.PRKeys.ResolveThread):
+				return m, m.toggleThreadResolved()
+			case key.Matches(msg, keys.PRKeys.TailCheckLog):
+				return m, m.tailCheckLog()
+			case key.Matches(msg, keys.PRKeys.RerunFailedChecks):
+				return m, m.rerunFailedChecks()
+			case key.Matches(msg, keys.PRKeys.ToggleCheckJobs):
+				return m, m.toggleCheckJobs()
+			case key.Matches(msg, keys.PRKeys.ToggleCheckSource):
+				return m, m.toggleCheckSource()
+			case key.Matches(msg, keys.PRKeys.ShowHiddenChecks):
+				m.showHiddenChecks = !m.showHiddenChecks
+				m.checkCursor = 0
+				m.checkLog = checkLog{}
+			case key.Matches(msg, keys.PRKeys.NextDiffFile):
+				m.diff.NextFile()
+			case key.Matches(msg, keys.PRKeys.PrevDiffFile):
+				m.diff.PrevFile()
+			case key.Matches(msg, keys.PRKeys.NextDiffHunk):
+				m.diff.NextHunk()
+			case key.Matches(msg, keys.PRKeys.PrevDiffHunk):
+				m.diff.PrevHunk()
 			}
 			return m, nil
 		}
@@ -190,6 +308,13 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 }
 
 func (m Model) View() string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.renderHeader(),
+		lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).Render(m.renderBody()),
+	)
+}
+
+func (m Model) renderHeader() string {
 	header := strings.Builder{}
 
 	header.WriteString(m.renderFullNameAndNumber())
@@ -209,6 +334,10 @@ func (m Model) View() string {
 
 	header.WriteString("\n")
 
+	return header.String()
+}
+
+func (m Model) renderBody() string {
 	body := strings.Builder{}
 
 	switch m.carousel.SelectedItem() {
@@ -229,7 +358,8 @@ func (m Model) View() string {
 		body.WriteString("\n")
 		body.WriteString(m.renderChecksOverview())
 
-		if m.isCommenting || m.isApproving || m.isAssigning || m.isUnassigning {
+		if (m.isCommenting && m.replyThreadId == "") || m.isApproving || m.isAssigning || m.isUnassigning ||
+			m.isRequestingReview || m.isDismissing {
 			body.WriteString(m.inputBox.View())
 		}
 
@@ -241,13 +371,16 @@ func (m Model) View() string {
 	case tabs[2]:
 		body.WriteString(m.renderActivity())
 	case tabs[3]:
-		body.WriteString(m.renderChangedFiles())
+		if m.diff.Url() == m.pr.Data.Primary.Url {
+			body.WriteString(m.diff.View())
+		} else {
+			body.WriteString(m.renderChangedFiles())
+		}
+	case tabs[4]:
+		body.WriteString(m.renderThreads())
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left,
-		header.String(),
-		lipgloss.NewStyle().Padding(0, m.ctx.Styles.Sidebar.ContentPadding).Render(body.String()),
-	)
+	return body.String()
 }
 
 func (m *Model) renderFullNameAndNumber() string {
@@ -357,7 +490,7 @@ func (m *Model) renderSummary() string {
 		)
 	}
 
-	markdownRenderer := markdown.GetMarkdownRenderer(width)
+	markdownRenderer := m.ctx.ReadingRenderer(width)
 	rendered, err := markdownRenderer.Render(body)
 	if err != nil {
 		return ""
@@ -392,6 +525,12 @@ func (m *Model) SetSectionId(id int) {
 }
 
 func (m *Model) SetRow(d *prrow.Data) {
+	if d == nil || m.pr == nil || m.pr.Data.Primary.Url != d.Primary.Url {
+		m.checkCursor = 0
+		m.threadCursor = 0
+		m.checkLog = checkLog{}
+		m.expandedChecks = nil
+	}
 	if d == nil {
 		m.pr = nil
 	} else {
@@ -407,7 +546,7 @@ type EnrichedPrMsg struct {
 }
 
 func (m *Model) EnrichCurrRow() tea.Cmd {
-	if m == nil || m.pr == nil || m.pr.Data.IsEnriched {
+	if m == nil || m.pr == nil || m.pr.Data.IsEnriched || m.pr.Data.Forge != "" {
 		return nil
 	}
 	url := m.pr.Data.Primary.Url
@@ -426,10 +565,18 @@ func (m *Model) SetWidth(width int) {
 	m.width = width
 	m.carousel.SetWidth(width)
 	m.inputBox.SetWidth(width)
+	m.diff.SetWidth(m.getIndentedContentWidth())
 }
 
 func (m *Model) IsTextInputBoxFocused() bool {
-	return m.isCommenting || m.isAssigning || m.isApproving || m.isUnassigning
+	return m.isCommenting || m.isAssigning || m.isApproving || m.isUnassigning ||
+		m.isRequestingReview || m.isDismissing
+}
+
+// HasDraft returns whether a comment or an approval is being written, with
+// some text
+func (m *Model) HasDraft() bool {
+	return (m.isCommenting || m.isApproving) && strings.TrimSpace(m.inputBox.Value()) != ""
 }
 
 func (m *Model) GetIsCommenting() bool {
@@ -439,6 +586,7 @@ func (m *Model) GetIsCommenting() bool {
 func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 	m.ctx = ctx
 	m.inputBox.UpdateProgramContext(ctx)
+	m.diff.UpdateProgramContext(ctx)
 	m.carousel.SetStyles(
 		carousel.Styles{
 			Item:     lipgloss.NewStyle().Padding(0, 1).Foreground(m.ctx.Theme.FaintText),
@@ -456,6 +604,7 @@ func (m *Model) shouldCancelComment() bool {
 	m.inputBox.Blur()
 	m.isCommenting = false
 	m.isApproving = false
+	m.replyThreadId = ""
 	m.ShowConfirmCancel = false
 	return true
 }
@@ -465,18 +614,64 @@ func (m *Model) SetIsCommenting(isCommenting bool) tea.Cmd {
 		return nil
 	}
 
+	var mentionsCmd tea.Cmd
 	if !m.isCommenting && isCommenting {
 		m.inputBox.Reset()
+		m.inputBox.SetAutocomplete(true, m.prParticipants())
+		if m.pr.Data.Forge == "" {
+			mentionsCmd = fetchMentions(m.pr.Data.Primary.Repository.NameWithOwner)
+		}
 	}
 	m.isCommenting = isCommenting
 	m.inputBox.SetPrompt(commentPrompt)
 
 	if isCommenting {
-		return tea.Sequence(textarea.Blink, m.inputBox.Focus())
+		return tea.Batch(tea.Sequence(textarea.Blink, m.inputBox.Focus()), mentionsCmd)
 	}
 	return nil
 }
 
+// prParticipants returns the logins of the author, assignees, reviewers and
+// commenters of the PR
+func (m *Model) prParticipants() []string {
+	pr := m.pr.Data
+	logins := []string{pr.Primary.Author.Login}
+	for _, assignee := range pr.Primary.Assignees.Nodes {
+		logins = append(logins, assignee.Login)
+	}
+	for _, review := range pr.Primary.Reviews.Nodes {
+		logins = append(logins, review.Author.Login)
+	}
+	for _, comment := range pr.Enriched.Comments.Nodes {
+		logins = append(logins, comment.Author.Login)
+	}
+	for _, thread := range pr.Enriched.ReviewThreads.Nodes {
+		for _, comment := range thread.Comments.Nodes {
+			logins = append(logins, comment.Author.Login)
+		}
+	}
+	return logins
+}
+
+// fetchMentions fetches the users and the teams of its org that can be
+// mentioned in repo for the comment's autocomplete
+func fetchMentions(repo string) tea.Cmd {
+	return func() tea.Msg {
+		logins, err := data.FetchMentionableUsers(repo)
+		if err != nil {
+			log.Error("Failed fetching mentionable users", "repo", repo, "err", err)
+			return nil
+		}
+		owner, _, _ := strings.Cut(repo, "/")
+		teams, err := data.FetchOrgTeams(owner)
+		if err != nil {
+			// repos owned by users have no teams
+			log.Debug("Failed fetching teams", "org", owner, "err", err)
+		}
+		return inputbox.MentionsFetchedMsg{Mentions: slices.Concat(logins, teams)}
+	}
+}
+
 func (m *Model) getIndentedContentWidth() int {
 	return m.width - 3*m.ctx.Styles.Sidebar.ContentPadding
 }
@@ -582,5 +777,6 @@ func (m *Model) SetEnrichedPR(data data.EnrichedPullRequestData) {
 	if m.pr.Data.Primary.Url == data.Url {
 		m.pr.Data.Enriched = data
 		m.pr.Data.IsEnriched = true
+		m.pr.Data.Primary.RestoreTrimmed(data)
 	}
 }
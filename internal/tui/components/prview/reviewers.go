@@ -0,0 +1,191 @@
+package prview
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func (m *Model) GetIsRequestingReview() bool {
+	return m.isRequestingReview
+}
+
+// SetIsRequestingReview opens the input box to re-request the review of the
+// reviewers, filled with the ones whose review is stale
+func (m *Model) SetIsRequestingReview(isRequestingReview bool) tea.Cmd {
+	if m.pr == nil {
+		return nil
+	}
+
+	if !m.isRequestingReview && isRequestingReview {
+		m.inputBox.Reset()
+	}
+	m.isRequestingReview = isRequestingReview
+	m.inputBox.SetPrompt("Re-request review from users (whitespace-separated)...")
+	m.inputBox.SetValue(strings.Join(m.pr.Data.Primary.StaleReviewers(), "\n"))
+
+	if isRequestingReview {
+		return tea.Sequence(textarea.Blink, m.inputBox.Focus())
+	}
+	return nil
+}
+
+func (m *Model) GetIsDismissing() bool {
+	return m.isDismissing
+}
+
+// SetIsDismissing opens the input box for the reason to dismiss the reviews
+// requesting changes
+func (m *Model) SetIsDismissing(isDismissing bool) tea.Cmd {
+	if m.pr == nil {
+		return nil
+	}
+
+	if isDismissing {
+		if !m.pr.Data.Primary.Repository.ViewerCanDismissReviews() {
+			return notifyErr(errors.New("dismissing reviews requires write access to the repo"))
+		}
+		if len(m.pr.Data.Primary.BlockingReviews()) == 0 {
+			return notifyErr(errors.New("no review requests changes"))
+		}
+	}
+
+	if !m.isDismissing && isDismissing {
+		m.inputBox.Reset()
+	}
+	m.isDismissing = isDismissing
+	var reviewers []string
+	for _, review := range m.pr.Data.Primary.BlockingReviews() {
+		reviewers = append(reviewers, review.Author.Login)
+	}
+	m.inputBox.SetPrompt(fmt.Sprintf("Reason to dismiss the changes requested by %s...",
+		strings.Join(reviewers, ", ")))
+
+	if isDismissing {
+		return tea.Sequence(textarea.Blink, m.inputBox.Focus())
+	}
+	return nil
+}
+
+func (m *Model) requestReview(usernames []string) tea.Cmd {
+	pr := m.pr.Data.Primary
+	prNumber := pr.GetNumber()
+	taskId := fmt.Sprintf("pr_request_review_%d", prNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Requesting review of pr #%d from %s", prNumber, usernames),
+		FinishedText: fmt.Sprintf("Review of pr #%d has been requested from %s", prNumber, usernames),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+
+	commandArgs := []string{
+		"pr",
+		"edit",
+		fmt.Sprint(prNumber),
+		"-R",
+		data.RepoArg(pr),
+	}
+	for _, reviewer := range usernames {
+		commandArgs = append(commandArgs, "--add-reviewer")
+		commandArgs = append(commandArgs, reviewer)
+	}
+
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		c := exec.Command("gh", commandArgs...)
+
+		err := c.Run()
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: prssection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg: tasks.UpdatePRMsg{
+				PrNumber:           prNumber,
+				RequestedReviewers: usernames,
+			},
+		}
+	})
+}
+
+// dismissBlockingReviews dismisses the reviews requesting changes with reason
+func (m *Model) dismissBlockingReviews(reason string) tea.Cmd {
+	pr := m.pr.Data.Primary
+	prNumber := pr.GetNumber()
+	reviews := pr.BlockingReviews()
+	taskId := fmt.Sprintf("pr_dismiss_reviews_%d", prNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Dismissing the reviews requesting changes of pr #%d", prNumber),
+		FinishedText: fmt.Sprintf("Reviews requesting changes of pr #%d have been dismissed", prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		var dismissed []string
+		var err error
+		for _, review := range reviews {
+			if err = data.DismissReview(review.Id, reason); err != nil {
+				break
+			}
+			dismissed = append(dismissed, review.Id)
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: prssection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg: tasks.UpdatePRMsg{
+				PrNumber:           prNumber,
+				DismissedReviewIds: dismissed,
+			},
+		}
+	})
+}
+
+// renderReviewers renders the latest review of each reviewer, and the
+// reviewers whose review is requested
+func (m *Model) renderReviewers() string {
+	pr := m.pr.Data.Primary
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	requested := pr.RequestedReviewers()
+
+	lines := make([]string, 0)
+	for _, review := range pr.LatestReviews.Nodes {
+		glyph := m.ctx.Styles.Common.SuccessGlyph
+		if review.State == "CHANGES_REQUESTED" {
+			glyph = m.ctx.Styles.Common.FailureGlyph
+		}
+		line := glyph + " " + review.Author.Login
+		if slices.Contains(requested, review.Author.Login) {
+			line += faint.Render(" · re-requested")
+		} else if pr.IsStale(review) {
+			line += faint.Render(" · stale")
+		}
+		lines = append(lines, line)
+	}
+	for _, reviewer := range requested {
+		if slices.ContainsFunc(pr.LatestReviews.Nodes, func(review data.LatestReview) bool {
+			return review.Author.Login == reviewer
+		}) {
+			continue
+		}
+		lines = append(lines, m.ctx.Styles.Common.WaitingGlyph+" "+reviewer+faint.Render(" · requested"))
+	}
+	return strings.Join(lines, "\n")
+}
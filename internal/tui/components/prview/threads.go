@@ -0,0 +1,321 @@
+package prview
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const (
+	replyPrompt = "Reply to the thread..."
+	// diffHunkLines are the lines of the diff shown over a thread, the ones
+	// its first comment was left on
+	diffHunkLines = 4
+)
+
+// IsViewingThreads returns whether the review threads tab is shown
+func (m *Model) IsViewingThreads() bool {
+	return m.carousel.SelectedItem() == tabs[4]
+}
+
+// reviewThreads returns the review threads of the PR, the unresolved ones
+// first
+func (m *Model) reviewThreads() []data.ReviewThread {
+	var unresolved, resolved []data.ReviewThread
+	for _, thread := range m.pr.Data.Enriched.ReviewThreads.Nodes {
+		if len(thread.Comments.Nodes) == 0 {
+			continue
+		}
+		if thread.IsResolved {
+			resolved = append(resolved, thread)
+		} else {
+			unresolved = append(unresolved, thread)
+		}
+	}
+	return append(unresolved, resolved...)
+}
+
+func (m *Model) selectedThread() *data.ReviewThread {
+	if m.pr == nil {
+		return nil
+	}
+	threads := m.reviewThreads()
+	if len(threads) == 0 {
+		return nil
+	}
+	m.threadCursor = min(m.threadCursor, len(threads)-1)
+	return &threads[m.threadCursor]
+}
+
+func (m *Model) nextThread() {
+	if m.pr == nil {
+		return
+	}
+	m.threadCursor = min(m.threadCursor+1, max(0, len(m.reviewThreads())-1))
+}
+
+func (m *Model) prevThread() {
+	m.threadCursor = max(m.threadCursor-1, 0)
+}
+
+// SetIsReplying opens the input box to reply to the selected thread
+func (m *Model) SetIsReplying(isReplying bool) tea.Cmd {
+	if !isReplying {
+		m.replyThreadId = ""
+		return m.SetIsCommenting(false)
+	}
+	thread := m.selectedThread()
+	if thread == nil {
+		return nil
+	}
+	m.replyThreadId = thread.Id
+	cmd := m.SetIsCommenting(true)
+	m.inputBox.SetPrompt(replyPrompt)
+	return cmd
+}
+
+// commentPrompt is the prompt of the input box while commenting, or
+// replying to a thread
+func (m *Model) commentPrompt() string {
+	if m.replyThreadId != "" {
+		return replyPrompt
+	}
+	return commentPrompt
+}
+
+func (m *Model) replyToThread(threadId string, body string) tea.Cmd {
+	prNumber := m.pr.Data.Primary.GetNumber()
+	taskId := fmt.Sprintf("pr_thread_reply_%s", threadId)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Replying to a review thread of PR #%d", prNumber),
+		FinishedText: fmt.Sprintf("Replied to a review thread of PR #%d", prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.ReplyToReviewThread(threadId, body)
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: prssection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg: tasks.UpdatePRMsg{
+				PrNumber:       prNumber,
+				ReviewThreadId: threadId,
+				NewThreadReply: &data.ReviewComment{
+					Author:    struct{ Login string }{Login: m.ctx.User},
+					Body:      body,
+					UpdatedAt: time.Now(),
+				},
+			},
+		}
+	})
+}
+
+// toggleThreadResolved resolves the selected thread, or unresolves it if
+// it's resolved
+func (m *Model) toggleThreadResolved() tea.Cmd {
+	thread := m.selectedThread()
+	if thread == nil {
+		return nil
+	}
+
+	prNumber := m.pr.Data.Primary.GetNumber()
+	threadId, resolve := thread.Id, !thread.IsResolved
+	action, done := "Resolving", "Resolved"
+	if !resolve {
+		action, done = "Unresolving", "Unresolved"
+	}
+	taskId := fmt.Sprintf("pr_thread_resolve_%s", threadId)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("%s a review thread of PR #%d", action, prNumber),
+		FinishedText: fmt.Sprintf("%s a review thread of PR #%d", done, prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.ResolveReviewThread(threadId, resolve)
+		return constants.TaskFinishedMsg{
+			SectionId:   m.sectionId,
+			SectionType: prssection.SectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg: tasks.UpdatePRMsg{
+				PrNumber:         prNumber,
+				ReviewThreadId:   threadId,
+				IsThreadResolved: &resolve,
+			},
+		}
+	})
+}
+
+func (m *Model) renderThreadsTitle() string {
+	return m.ctx.Styles.Common.MainTextStyle.MarginBottom(1).Underline(true).Render("󰅺 Review Threads")
+}
+
+// renderThreadItems renders each review thread, the selected one expanded
+// with the input box under it while replying to it
+func (m *Model) renderThreadItems() []string {
+	threads := m.reviewThreads()
+	if len(threads) == 0 {
+		return nil
+	}
+
+	markdownRenderer := m.ctx.ReadingRenderer(m.getIndentedContentWidth() - 4)
+	cursor := min(m.threadCursor, len(threads)-1)
+	items := make([]string, 0, len(threads))
+	for i, thread := range threads {
+		items = append(items, m.renderThread(thread, i == cursor, markdownRenderer))
+	}
+	return items
+}
+
+func (m *Model) renderThreads() string {
+	title := m.renderThreadsTitle()
+	if !m.pr.Data.IsEnriched {
+		return lipgloss.JoinVertical(lipgloss.Left, title, "Loading...")
+	}
+
+	items := m.renderThreadItems()
+	if len(items) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, title,
+			lipgloss.NewStyle().Italic(true).PaddingLeft(2).Render("No review threads..."))
+	}
+
+	numUnresolved := 0
+	for _, thread := range m.reviewThreads() {
+		if !thread.IsResolved {
+			numUnresolved++
+		}
+	}
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		lipgloss.NewStyle().PaddingLeft(2).Render(lipgloss.JoinVertical(lipgloss.Left, items...)),
+		"",
+		faint.Render(fmt.Sprintf("%d of %d threads unresolved", numUnresolved, len(items))),
+		faint.Width(m.getIndentedContentWidth()).Render(fmt.Sprintf(
+			"%s/%s select • %s reply • %s resolve/unresolve",
+			keys.PRKeys.PrevCheck.Help().Key,
+			keys.PRKeys.NextCheck.Help().Key,
+			keys.PRKeys.Comment.Help().Key,
+			keys.PRKeys.ResolveThread.Help().Key,
+		)),
+	)
+}
+
+// renderThread renders the file and line a thread was left on and its
+// comments. Resolved threads are collapsed to their header unless selected.
+func (m *Model) renderThread(thread data.ReviewThread, isSelected bool, markdownRenderer markdown.Renderer) string {
+	width := m.getIndentedContentWidth() - 2
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+
+	status := m.ctx.Styles.Common.WaitingGlyph
+	if thread.IsResolved {
+		status = m.ctx.Styles.Common.SuccessGlyph
+	}
+	location := thread.Path
+	if thread.Line > 0 {
+		location = fmt.Sprintf("%s#L%d", thread.Path, thread.Line)
+	}
+	header := status + " " + location
+	if thread.IsOutdated {
+		header += faint.Render(" · outdated")
+	}
+	if thread.IsResolved {
+		header += faint.Render(" · resolved")
+	}
+	numComments := len(thread.Comments.Nodes)
+	header += faint.Render(fmt.Sprintf(" · %d comments", numComments))
+	headerStyle := lipgloss.NewStyle().Width(width)
+	if isSelected {
+		headerStyle = headerStyle.Background(m.ctx.Theme.SelectedBackground)
+	}
+	header = headerStyle.Render(ansi.Truncate(header, width, constants.Ellipsis))
+
+	if thread.IsResolved && !isSelected {
+		return header
+	}
+
+	parts := []string{header}
+	if hunk := m.renderDiffHunk(thread.Comments.Nodes[0].DiffHunk); hunk != "" {
+		parts = append(parts, hunk)
+	}
+	for _, c := range thread.Comments.Nodes {
+		body, err := markdownRenderer.Render(lineCleanupRegex.ReplaceAllString(c.Body, ""))
+		if err != nil {
+			continue
+		}
+		parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top,
+			m.ctx.Styles.Common.MainTextStyle.Render(c.Author.Login),
+			" ",
+			faint.Render(utils.TimeElapsed(c.UpdatedAt)),
+		), body)
+	}
+	if isSelected && m.isCommenting && m.replyThreadId == thread.Id {
+		parts = append(parts, m.inputBox.View())
+	}
+	parts = append(parts, "")
+
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
+
+// renderDiffHunk renders the last lines of the diff hunk a thread was left
+// on
+func (m *Model) renderDiffHunk(hunk string) string {
+	if hunk == "" {
+		return ""
+	}
+	lines := strings.Split(strings.TrimRight(hunk, "\n"), "\n")
+	lines = lines[max(0, len(lines)-diffHunkLines):]
+
+	width := m.getIndentedContentWidth() - 4
+	rendered := make([]string, 0, len(lines))
+	for _, line := range lines {
+		style := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+		switch {
+		case strings.HasPrefix(line, "+"):
+			style = style.Foreground(m.ctx.Theme.SuccessText)
+		case strings.HasPrefix(line, "-"):
+			style = style.Foreground(m.ctx.Theme.ErrorText)
+		}
+		rendered = append(rendered, style.Render(ansi.Truncate(line, width, constants.Ellipsis)))
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder(), false, false, false, true).
+		BorderForeground(m.ctx.Theme.FaintBorder).
+		PaddingLeft(1).
+		Render(strings.Join(rendered, "\n"))
+}
+
+// SelectedThreadOffset is the line of View at which the selected review
+// thread starts
+func (m Model) SelectedThreadOffset() int {
+	if m.pr == nil || !m.pr.Data.IsEnriched {
+		return 0
+	}
+	items := m.renderThreadItems()
+	offset := lipgloss.Height(m.renderHeader()) + lipgloss.Height(m.renderThreadsTitle())
+	for _, item := range items[:min(m.threadCursor, len(items))] {
+		offset += lipgloss.Height(item)
+	}
+	return offset
+}
@@ -0,0 +1,81 @@
+package prview
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// renderTimelineEvent renders an event as a single faint line, so it doesn't
+// stand out between the comments. Reviews aren't events, they're rendered
+// like comments.
+func (m *Model) renderTimelineEvent(item data.TimelineItem) string {
+	faint := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	strong := lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText)
+
+	var icon, desc string
+	switch item.Typename {
+	case "LabeledEvent":
+		icon = "󰌕"
+		desc = "added the " + m.renderTimelineLabel(item.LabeledEvent.Label) + faint.Render(" label")
+	case "UnlabeledEvent":
+		icon = "󰌕"
+		desc = "removed the " + m.renderTimelineLabel(item.UnlabeledEvent.Label) + faint.Render(" label")
+	case "AssignedEvent":
+		icon = ""
+		desc = "assigned " + strong.Render(item.AssignedEvent.Assignee.Login())
+	case "UnassignedEvent":
+		icon = ""
+		desc = "unassigned " + strong.Render(item.UnassignedEvent.Assignee.Login())
+	case "CrossReferencedEvent":
+		icon = ""
+		desc = "referenced this in " + strong.Render(renderTimelineReference(item.CrossReferencedEvent.Source))
+	case "DeployedEvent":
+		icon = ""
+		desc = "deployed to " + strong.Render(item.DeployedEvent.Deployment.Environment)
+	case "HeadRefForcePushedEvent":
+		icon = ""
+		desc = fmt.Sprintf("force-pushed %s → %s",
+			strong.Render(item.HeadRefForcePushedEvent.BeforeCommit.AbbreviatedOid),
+			strong.Render(item.HeadRefForcePushedEvent.AfterCommit.AbbreviatedOid))
+	case "PullRequestCommit":
+		icon = ""
+		commit := item.PullRequestCommit.Commit
+		desc = "committed " + strong.Render(commit.AbbreviatedOid) + " " +
+			ansi.Truncate(commit.MessageHeadline, m.getIndentedContentWidth()/2, constants.Ellipsis)
+	default:
+		return ""
+	}
+
+	actor := item.Actor()
+	if actor == "" {
+		actor = "ghost"
+	}
+	return lipgloss.NewStyle().Width(m.getIndentedContentWidth()).PaddingLeft(1).Render(
+		faint.Render(icon+" ") + strong.Render(actor) + " " + faint.Render(desc) + " " +
+			faint.Render(utils.TimeElapsed(item.CreatedAt())))
+}
+
+func (m *Model) renderTimelineLabel(label data.TimelineLabel) string {
+	if label.Color == "" {
+		return label.Name
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("#" + label.Color)).Render(label.Name)
+}
+
+func renderTimelineReference(ref data.TimelineReference) string {
+	if ref.PullRequest.Number != 0 {
+		return fmt.Sprintf("%s#%d", ref.PullRequest.Repository.NameWithOwner, ref.PullRequest.Number)
+	}
+	return fmt.Sprintf("%s#%d", ref.Issue.Repository.NameWithOwner, ref.Issue.Number)
+}
+
+// ToggleTimelineEvents shows or hides the events between the comments
+func (m *Model) ToggleTimelineEvents() {
+	m.hideTimelineEvents = !m.hideTimelineEvents
+}
@@ -30,7 +30,7 @@ func (m *Model) unassign(usernames []string) tea.Cmd {
 		"edit",
 		fmt.Sprint(prNumber),
 		"-R",
-		pr.GetRepoNameWithOwner(),
+		data.RepoArg(pr),
 	}
 	for _, assignee := range usernames {
 		commandArgs = append(commandArgs, "--remove-assignee")
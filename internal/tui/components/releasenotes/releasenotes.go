@@ -0,0 +1,159 @@
+package releasenotes
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/cli/go-gh/v2/pkg/browser"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
+)
+
+const (
+	maxWidth  = 90
+	maxHeight = 30
+)
+
+var (
+	closeKey = key.NewBinding(
+		key.WithKeys("esc", "q", "ctrl+c"),
+		key.WithHelp("esc", "close"),
+	)
+	openKey = key.NewBinding(
+		key.WithKeys("o"),
+		key.WithHelp("o", "open in browser"),
+	)
+)
+
+// Model is an overlay showing the notes of a release of gh-dash, rendered as
+// markdown and scrolled with the arrows
+type Model struct {
+	ctx      *context.ProgramContext
+	release  data.DashRelease
+	viewport viewport.Model
+	focused  bool
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:      ctx,
+		viewport: viewport.New(0, 0),
+	}
+}
+
+// Open shows the notes of release
+func (m *Model) Open(release data.DashRelease) {
+	m.release = release
+	m.focused = true
+	m.syncViewport()
+	m.viewport.GotoTop()
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case key.Matches(keyMsg, closeKey):
+		m.focused = false
+		return m, nil
+	case key.Matches(keyMsg, openKey) && !m.ctx.ReadOnly:
+		url := m.release.Url
+		return m, func() tea.Msg {
+			b := browser.New("", os.Stdout, os.Stdin)
+			if err := b.Browse(url); err != nil {
+				return constants.ErrMsg{Err: err}
+			}
+			return nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText)
+	faintStyle := lipgloss.NewStyle().
+		Foreground(m.ctx.Theme.FaintText)
+
+	title := "gh-dash " + m.release.Version
+	if m.release.Name != "" && m.release.Name != m.release.Version {
+		title += " - " + m.release.Name
+	}
+	var status string
+	if data.IsNewerVersion(m.ctx.Version, m.release.Version) {
+		status = fmt.Sprintf("A new release is out, you're on %s. Run `gh dash upgrade` to upgrade.",
+			m.ctx.Version)
+	} else {
+		status = fmt.Sprintf("Released %s, you're on %s.",
+			m.release.PublishedAt.Format("2006-01-02"), m.ctx.Version)
+	}
+
+	help := []string{"↑/↓ scroll", "esc close"}
+	if !m.ctx.ReadOnly {
+		help = []string{"↑/↓ scroll", "o open in browser", "esc close"}
+	}
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(0, 1).
+		Render(lipgloss.JoinVertical(lipgloss.Left,
+			titleStyle.Render(title),
+			faintStyle.Width(m.viewport.Width).Render(status),
+			"",
+			m.viewport.View(),
+			"",
+			faintStyle.Render(strings.Join(help, " · ")),
+		))
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+	if m.focused {
+		m.syncViewport()
+	}
+}
+
+// syncViewport sizes the notes to the screen and renders them
+func (m *Model) syncViewport() {
+	m.viewport.Width = max(20, min(maxWidth, m.ctx.ScreenWidth-4))
+	m.viewport.Height = max(5, min(maxHeight, m.ctx.MainContentHeight-8))
+
+	notes := m.release.Notes
+	if strings.TrimSpace(notes) == "" {
+		notes = "This release has no notes."
+	}
+	rendered, err := markdown.GetMarkdownRenderer(m.viewport.Width).Render(notes)
+	if err != nil {
+		log.Error("Failed rendering release notes", "err", err)
+		rendered = notes
+	}
+	m.viewport.SetContent(rendered)
+}
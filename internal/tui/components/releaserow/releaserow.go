@@ -0,0 +1,166 @@
+package releaserow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+type Repo struct {
+	Ctx  *context.ProgramContext
+	Data data.ReleaseTrainRepo
+}
+
+func (r *Repo) ToTableRow() table.Row {
+	return table.Row{
+		r.renderStatus(),
+		r.renderRepo(),
+		r.renderRelease(),
+		r.renderReleasedAt(),
+		r.renderUnreleased(),
+		r.renderBlockers(),
+		r.renderLastCommitAt(),
+	}
+}
+
+func (r *Repo) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(r.Ctx)
+}
+
+// renderStatus renders whether the repo can be released: it has unreleased
+// commits and no blockers
+func (r *Repo) renderStatus() string {
+	switch {
+	case len(r.Data.Blockers) > 0:
+		return lipgloss.NewStyle().Foreground(r.Ctx.Theme.ErrorText).Render(constants.FailureIcon)
+	case r.Data.UnreleasedCount > 0 || r.Data.Release == nil:
+		return lipgloss.NewStyle().Foreground(r.Ctx.Theme.SuccessText).Render(constants.SuccessIcon)
+	default:
+		return lipgloss.NewStyle().Foreground(r.Ctx.Theme.FaintText).Render(constants.EmptyIcon)
+	}
+}
+
+func (r *Repo) renderRepo() string {
+	return r.getTextStyle().Render(r.Data.NameWithOwner)
+}
+
+func (r *Repo) renderRelease() string {
+	if r.Data.Release == nil {
+		return lipgloss.NewStyle().Foreground(r.Ctx.Theme.FaintText).Render("no release")
+	}
+	return r.getTextStyle().Render(r.Data.Release.TagName)
+}
+
+func (r *Repo) renderReleasedAt() string {
+	if r.Data.Release == nil {
+		return r.getTextStyle().Render("-")
+	}
+	return r.getTextStyle().Render(r.formatTime(r.Data.Release.PublishedAt))
+}
+
+func (r *Repo) renderUnreleased() string {
+	if r.Data.Release == nil {
+		return r.getTextStyle().Render("-")
+	}
+	return r.getTextStyle().Render(fmt.Sprintf("%d", r.Data.UnreleasedCount))
+}
+
+func (r *Repo) renderBlockers() string {
+	count := len(r.Data.Blockers)
+	if count == 0 {
+		return lipgloss.NewStyle().Foreground(r.Ctx.Theme.FaintText).Render("0")
+	}
+	return lipgloss.NewStyle().Foreground(r.Ctx.Theme.ErrorText).Render(fmt.Sprintf("%d", count))
+}
+
+func (r *Repo) renderLastCommitAt() string {
+	if r.Data.LastCommitAt.IsZero() {
+		return r.getTextStyle().Render("-")
+	}
+	return r.getTextStyle().Render(r.formatTime(r.Data.LastCommitAt))
+}
+
+func (r *Repo) formatTime(t time.Time) string {
+	timeFormat := r.Ctx.Config.Defaults.DateFormat
+	if timeFormat == "" || timeFormat == "relative" {
+		return utils.TimeElapsed(t)
+	}
+	return t.Format(timeFormat)
+}
+
+// RenderDetails renders the latest release, the commits since it and the
+// blockers of the repo for the sidebar
+func (r *Repo) RenderDetails(width int) string {
+	labelStyle := lipgloss.NewStyle().Foreground(r.Ctx.Theme.FaintText).Width(12)
+	valueStyle := r.getTextStyle()
+	faintStyle := lipgloss.NewStyle().Foreground(r.Ctx.Theme.FaintText)
+	headingStyle := lipgloss.NewStyle().Bold(true).Foreground(r.Ctx.Theme.PrimaryText)
+
+	lines := []string{headingStyle.Width(width).Render(r.Data.NameWithOwner), ""}
+
+	fields := [][2]string{{"Branch", r.Data.DefaultBranch}}
+	if release := r.Data.Release; release != nil {
+		tag := release.TagName
+		if release.IsPrerelease {
+			tag += " (pre-release)"
+		}
+		fields = append(fields,
+			[2]string{"Release", tag},
+			[2]string{"Name", release.Name},
+			[2]string{"Published", release.PublishedAt.Local().Format("2006-01-02 15:04")},
+		)
+	} else {
+		fields = append(fields, [2]string{"Release", "none yet"})
+	}
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render(f[0]), valueStyle.Render(f[1])))
+	}
+
+	if r.Data.Release != nil {
+		lines = append(lines, "", headingStyle.Render(
+			fmt.Sprintf("Unreleased commits (%d)", r.Data.UnreleasedCount)))
+		for _, commit := range r.Data.Unreleased {
+			line := fmt.Sprintf("%s %s %s",
+				faintStyle.Render(commit.AbbreviatedOid),
+				commit.MessageHeadline,
+				faintStyle.Render("· "+commit.AuthorName()),
+			)
+			lines = append(lines, ansi.Truncate(line, width, constants.Ellipsis))
+		}
+		if hidden := r.Data.UnreleasedCount - len(r.Data.Unreleased); hidden > 0 {
+			lines = append(lines, faintStyle.Render(fmt.Sprintf("and %d older", hidden)))
+		}
+	}
+
+	lines = append(lines, "", headingStyle.Render(fmt.Sprintf("Blockers (%d)", len(r.Data.Blockers))))
+	if len(r.Data.Blockers) == 0 {
+		lines = append(lines, faintStyle.Render("Nothing blocks the release"))
+	}
+	for _, blocker := range r.Data.Blockers {
+		kind := "issue"
+		if blocker.IsPR {
+			kind = "PR"
+		}
+		line := fmt.Sprintf("%s %s %s",
+			lipgloss.NewStyle().Foreground(r.Ctx.Theme.ErrorText).Render(fmt.Sprintf("#%d", blocker.Number)),
+			blocker.Title,
+			faintStyle.Render("· "+kind),
+		)
+		lines = append(lines, ansi.Truncate(line, width, constants.Ellipsis))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -0,0 +1,364 @@
+package releasessection
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releaserow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "release"
+
+type Model struct {
+	section.BaseModel
+	// Repos are the repos of the release train, the search section lists the
+	// ones of every train
+	Repos []string
+	// BlockerLabels are the labels of the PRs and issues blocking a release
+	BlockerLabels []string
+	// Train is the release state of the fetched repos, in the order they're
+	// configured
+	Train []data.ReleaseTrainRepo
+	// visible are the repos matching the search
+	visible []data.ReleaseTrainRepo
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.ReleasesSectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:          id,
+			Config:      cfg.ToSectionConfig(),
+			Type:        SectionType,
+			Columns:     GetSectionColumns(ctx),
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	// repos are searched locally, the repo filters of smart filtering don't
+	// apply to them
+	m.SearchValue = ""
+	m.SearchBar.SetValue("")
+	m.IsFilteredByCurrentRemote = false
+	m.FilterTarget = section.FilterTargetNone
+
+	if len(cfg.Repos) > 0 {
+		m.Repos = cfg.Repos
+		m.BlockerLabels = cfg.BlockerLabels
+	} else {
+		for _, train := range ctx.Config.ReleasesSections {
+			m.Repos = append(m.Repos, train.Repos...)
+			m.BlockerLabels = append(m.BlockerLabels, train.BlockerLabels...)
+		}
+		slices.Sort(m.BlockerLabels)
+		m.BlockerLabels = slices.Compact(m.BlockerLabels)
+	}
+	m.Repos = uniqueRepos(m.Repos)
+
+	return m
+}
+
+// uniqueRepos returns repos without the repeated ones, ignoring case
+func uniqueRepos(repos []string) []string {
+	seen := map[string]bool{}
+	unique := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		if seen[strings.ToLower(repo)] {
+			continue
+		}
+		seen[strings.ToLower(repo)] = true
+		unique = append(unique, repo)
+	}
+	return unique
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.IsSearchFocused() {
+			if m.SearchBar.IsOverlayOpen() {
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+
+			switch msg.Type {
+			case tea.KeyCtrlC, tea.KeyEsc:
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case tea.KeyEnter:
+				m.SearchValue = m.SearchBar.Value()
+				historyCmd := m.SearchBar.AddToHistory(m.SearchValue)
+				m.SetIsSearching(false)
+				m.Table.ResetCurrItem()
+				m.syncRows()
+				return m, historyCmd
+
+			default:
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+		}
+
+	case SectionReleasesFetchedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			m.Train = msg.Train
+			m.SetIsLoading(false)
+			m.IsRefreshing = false
+			m.PageInfo = &data.PageInfo{HasNextPage: false}
+			m.syncRows()
+			m.UpdateLastUpdated(time.Now())
+		}
+	}
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(searchCmd, tableCmd)
+}
+
+func GetSectionColumns(ctx *context.ProgramContext) []table.Column {
+	return []table.Column{
+		{
+			Title: "",
+			Width: utils.IntPtr(3),
+		},
+		{
+			Title: "Repo",
+			Grow:  utils.BoolPtr(true),
+		},
+		{
+			Title: "Release",
+			Width: utils.IntPtr(15),
+		},
+		{
+			Title: "Released",
+			Width: utils.IntPtr(lipgloss.Width("Released  ")),
+		},
+		{
+			Title: "Unreleased",
+			Width: utils.IntPtr(lipgloss.Width("Unreleased  ")),
+		},
+		{
+			Title: "Blockers",
+			Width: utils.IntPtr(lipgloss.Width("Blockers  ")),
+		},
+		{
+			Title: "󱦻",
+			Width: utils.IntPtr(lipgloss.Width("2mo  ")),
+		},
+	}
+}
+
+// filterRepos returns the repos whose name contains every word of query,
+// ignoring case
+func filterRepos(train []data.ReleaseTrainRepo, query string) []data.ReleaseTrainRepo {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return train
+	}
+
+	var filtered []data.ReleaseTrainRepo
+	for _, repo := range train {
+		name := strings.ToLower(repo.NameWithOwner)
+		matches := true
+		for _, word := range words {
+			if !strings.Contains(name, word) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, repo)
+		}
+	}
+	return filtered
+}
+
+func (m Model) BuildRows() []table.Row {
+	rows := []table.Row{}
+	for _, currRepo := range m.visible {
+		repoModel := releaserow.Repo{Ctx: m.Ctx, Data: currRepo}
+		rows = append(rows, repoModel.ToTableRow())
+	}
+	return rows
+}
+
+func (m *Model) NumRows() int {
+	return len(m.visible)
+}
+
+// syncRows rebuilds the rows from the repos matching the search
+func (m *Model) syncRows() {
+	m.visible = filterRepos(m.Train, m.SearchValue)
+	m.TotalCount = len(m.visible)
+	m.Table.SetRows(m.BuildRows())
+	m.UpdateTotalItemsCount(m.TotalCount)
+}
+
+func (m *Model) GetCurrRow() data.RowData {
+	if len(m.visible) == 0 {
+		return nil
+	}
+	repo := m.visible[m.Table.GetCurrItem()]
+	return &repo
+}
+
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	taskId := fmt.Sprintf("fetching_releases_%d_%s", m.Id, time.Now().String())
+	m.LastFetchTaskId = taskId
+	title := m.Config.Title
+	if title == "" {
+		title = "all release trains"
+	}
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf(`Fetching releases of "%s"`, title),
+		FinishedText: fmt.Sprintf(`Releases of "%s" have been fetched`, title),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	repos, labels := m.Repos, m.BlockerLabels
+	fetchCmd := func() tea.Msg {
+		train, err := data.FetchReleaseTrain(repos, labels)
+		if err != nil && len(train) == 0 {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+		if err != nil {
+			log.Error("Failed fetching some of the releases", "section", m.Id, "err", err)
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionReleasesFetchedMsg{
+				Train:  train,
+				TaskId: taskId,
+			},
+		}
+	}
+	cmds = append(cmds, fetchCmd)
+
+	return cmds
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+}
+
+func (m *Model) ResetRows() {
+	m.Train = nil
+	m.visible = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := ctx.Config.ReleasesSections
+	fetchReleasesCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1, // 0 is the search section
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		)
+		sections = append(sections, &sectionModel)
+		fetchReleasesCmds = append(
+			fetchReleasesCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchReleasesCmds...)
+}
+
+type SectionReleasesFetchedMsg struct {
+	Train  []data.ReleaseTrainRepo
+	TaskId string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Repo"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Repos"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m Model) GetPagerContent() string {
+	pagerContent := ""
+	if m.TotalCount > 0 {
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v/%v",
+			constants.WaitingIcon,
+			m.LastUpdated().Format("01/02 15:04:05"),
+			m.SingularForm,
+			m.Table.GetCurrItem()+1,
+			m.TotalCount,
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
@@ -2,54 +2,66 @@ package repopicker
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 )
 
+// maxVisibleOptions is how many options are listed at once, the list scrolls
+// to keep the cursor in view
+const maxVisibleOptions = 10
+
 // RepoOption represents a selectable repository option
 type RepoOption struct {
 	Label string // Display label (e.g., "My Fork", "Upstream", "All Repos")
 	Value string // The repo value (e.g., "owner/repo" or empty for no filter)
 	Desc  string // Optional description
+
+	isCustom bool
 }
 
 // Model is the repo picker component
 type Model struct {
 	ctx           *context.ProgramContext
 	options       []RepoOption
+	repos         []RepoOption
+	visible       []RepoOption
 	cursor        int
-	customInput   textinput.Model
-	isCustomMode  bool
+	filterInput   textinput.Model
 	width         int
 	focused       bool
 	selectedValue string
+	isFetching    bool
+	hasFetched    bool
+	fetchErr      error
 }
 
 // KeyMap defines keybindings for the picker
 type KeyMap struct {
-	Up       key.Binding
-	Down     key.Binding
-	Select   key.Binding
-	Cancel   key.Binding
-	Custom   key.Binding
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Cancel key.Binding
 }
 
 // DefaultKeyMap returns the default keybindings
 func DefaultKeyMap() KeyMap {
 	return KeyMap{
 		Up: key.NewBinding(
-			key.WithKeys("up", "k"),
-			key.WithHelp("↑/k", "up"),
+			key.WithKeys("up", "ctrl+p", "ctrl+k"),
+			key.WithHelp("↑/ctrl+p", "up"),
 		),
 		Down: key.NewBinding(
-			key.WithKeys("down", "j"),
-			key.WithHelp("↓/j", "down"),
+			key.WithKeys("down", "ctrl+n", "ctrl+j"),
+			key.WithHelp("↓/ctrl+n", "down"),
 		),
 		Select: key.NewBinding(
 			key.WithKeys("enter"),
@@ -59,10 +71,6 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("esc", "ctrl+c"),
 			key.WithHelp("esc", "cancel"),
 		),
-		Custom: key.NewBinding(
-			key.WithKeys("c"),
-			key.WithHelp("c", "custom repo"),
-		),
 	}
 }
 
@@ -77,21 +85,27 @@ type RepoSelectedMsg struct {
 // RepoCancelledMsg is sent when the picker is cancelled
 type RepoCancelledMsg struct{}
 
+// ReposFetchedMsg is sent when the repos the user has access to are fetched
+type ReposFetchedMsg struct {
+	Repos []data.AccessibleRepo
+	Err   error
+}
+
 // NewModel creates a new repo picker model
 func NewModel(ctx *context.ProgramContext) Model {
 	ti := textinput.New()
-	ti.Placeholder = "owner/repo"
+	ti.Placeholder = "type to filter, or enter owner/repo"
+	ti.Prompt = "> "
 	ti.CharLimit = 100
 	ti.Width = 40
 
 	return Model{
-		ctx:          ctx,
-		options:      []RepoOption{},
-		cursor:       0,
-		customInput:  ti,
-		isCustomMode: false,
-		width:        50,
-		focused:      false,
+		ctx:         ctx,
+		options:     []RepoOption{},
+		cursor:      0,
+		filterInput: ti,
+		width:       50,
+		focused:     false,
 	}
 }
 
@@ -99,26 +113,28 @@ func NewModel(ctx *context.ProgramContext) Model {
 func (m *Model) SetOptions(options []RepoOption) {
 	m.options = options
 	m.cursor = 0
+	m.applyFilter()
 }
 
 // SetWidth sets the picker width
 func (m *Model) SetWidth(w int) {
 	m.width = w
-	m.customInput.Width = w - 10
+	m.filterInput.Width = w - 10
 }
 
 // Focus focuses the picker
-func (m *Model) Focus() {
+func (m *Model) Focus() tea.Cmd {
 	m.focused = true
-	m.isCustomMode = false
 	m.cursor = 0
+	m.filterInput.SetValue("")
+	m.applyFilter()
+	return m.filterInput.Focus()
 }
 
 // Blur blurs the picker
 func (m *Model) Blur() {
 	m.focused = false
-	m.isCustomMode = false
-	m.customInput.Blur()
+	m.filterInput.Blur()
 }
 
 // Focused returns whether the picker is focused
@@ -130,7 +146,7 @@ func (m Model) Focused() bool {
 func (m *Model) SetSelectedValue(value string) {
 	m.selectedValue = value
 	// Try to find and select the matching option
-	for i, opt := range m.options {
+	for i, opt := range m.visible {
 		if opt.Value == value {
 			m.cursor = i
 			break
@@ -138,68 +154,183 @@ func (m *Model) SetSelectedValue(value string) {
 	}
 }
 
+// FetchRepos returns a cmd fetching the repos the user owns, contributed to
+// or starred. They're only fetched once.
+func (m *Model) FetchRepos() tea.Cmd {
+	if m.hasFetched || m.isFetching {
+		return nil
+	}
+	m.isFetching = true
+	return func() tea.Msg {
+		repos, err := data.FetchAccessibleRepos()
+		return ReposFetchedMsg{Repos: repos, Err: err}
+	}
+}
+
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(ReposFetchedMsg); ok {
+		m.isFetching = false
+		m.fetchErr = msg.Err
+		if msg.Err == nil {
+			m.hasFetched = true
+			m.repos = make([]RepoOption, 0, len(msg.Repos))
+			for _, repo := range msg.Repos {
+				m.repos = append(m.repos, RepoOption{
+					Label: repo.NameWithOwner,
+					Value: repo.NameWithOwner,
+					Desc:  repo.Source,
+				})
+			}
+			m.applyFilter()
+		}
+		return m, nil
+	}
+
 	if !m.focused {
 		return m, nil
 	}
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		if m.isCustomMode {
-			switch {
-			case key.Matches(msg, Keys.Cancel):
-				m.isCustomMode = false
-				m.customInput.Blur()
-				m.customInput.SetValue("")
-				return m, nil
-			case key.Matches(msg, Keys.Select):
-				value := strings.TrimSpace(m.customInput.Value())
-				if value != "" {
-					m.focused = false
-					m.isCustomMode = false
-					return m, func() tea.Msg {
-						return RepoSelectedMsg{Value: value, IsCustom: true}
-					}
-				}
-				return m, nil
-			default:
-				var cmd tea.Cmd
-				m.customInput, cmd = m.customInput.Update(msg)
-				return m, cmd
-			}
-		}
-
 		switch {
 		case key.Matches(msg, Keys.Up):
 			if m.cursor > 0 {
 				m.cursor--
 			}
+			return m, nil
 		case key.Matches(msg, Keys.Down):
-			if m.cursor < len(m.options)-1 {
+			if m.cursor < len(m.visible)-1 {
 				m.cursor++
 			}
+			return m, nil
 		case key.Matches(msg, Keys.Select):
-			if len(m.options) > 0 {
-				selected := m.options[m.cursor]
-				m.focused = false
+			if len(m.visible) > 0 {
+				selected := m.visible[m.cursor]
+				m.Blur()
 				return m, func() tea.Msg {
-					return RepoSelectedMsg{Value: selected.Value, IsCustom: false}
+					return RepoSelectedMsg{Value: selected.Value, IsCustom: selected.isCustom}
 				}
 			}
+			return m, nil
 		case key.Matches(msg, Keys.Cancel):
-			m.focused = false
+			m.Blur()
 			return m, func() tea.Msg {
 				return RepoCancelledMsg{}
 			}
-		case key.Matches(msg, Keys.Custom):
-			m.isCustomMode = true
-			m.customInput.Focus()
-			return m, textinput.Blink
 		}
 	}
 
-	return m, nil
+	var cmd tea.Cmd
+	before := m.filterInput.Value()
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	if m.filterInput.Value() != before {
+		m.cursor = 0
+		m.applyFilter()
+	}
+	return m, cmd
+}
+
+// applyFilter lists the options and fetched repos that fuzzy match the
+// filter, best matches first
+func (m *Model) applyFilter() {
+	query := strings.TrimSpace(m.filterInput.Value())
+	all := slices.Clone(m.options)
+	for _, repo := range m.repos {
+		if !slices.ContainsFunc(m.options, func(opt RepoOption) bool { return opt.Value == repo.Value }) {
+			all = append(all, repo)
+		}
+	}
+
+	if query == "" {
+		m.visible = all
+		m.cursor = min(m.cursor, max(0, len(m.visible)-1))
+		return
+	}
+
+	type scored struct {
+		option RepoOption
+		score  int
+	}
+	var matches []scored
+	hasExact := false
+	for _, opt := range all {
+		if opt.Value == "" {
+			// "All repos" can't be filtered by name
+			continue
+		}
+		if strings.EqualFold(opt.Value, query) {
+			hasExact = true
+		}
+		if score, ok := FuzzyScore(query, opt.Value); ok {
+			matches = append(matches, scored{option: opt, score: score})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b scored) int {
+		return b.score - a.score
+	})
+
+	m.visible = make([]RepoOption, 0, len(matches)+1)
+	for _, match := range matches {
+		m.visible = append(m.visible, match.option)
+	}
+
+	// let the user filter by a repo that isn't listed
+	if !hasExact && isRepoName(query) {
+		m.visible = append(m.visible, RepoOption{
+			Label:    fmt.Sprintf("Use %s", query),
+			Value:    query,
+			Desc:     "Custom repo",
+			isCustom: true,
+		})
+	}
+	m.cursor = min(m.cursor, max(0, len(m.visible)-1))
+}
+
+func isRepoName(s string) bool {
+	owner, name, ok := strings.Cut(s, "/")
+	return ok && owner != "" && name != "" && !strings.ContainsAny(s, " \t") &&
+		!strings.Contains(name, "/")
+}
+
+// FuzzyScore reports whether every character of pattern appears in text in
+// order, ignoring case. Matches of consecutive characters and at the start of
+// words score higher.
+func FuzzyScore(pattern, text string) (int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(strings.ToLower(text))
+	if len(p) == 0 {
+		return 0, true
+	}
+
+	score := 0
+	pi := 0
+	prevMatch := -2
+	for ti, r := range t {
+		if pi == len(p) {
+			break
+		}
+		if r != p[pi] {
+			continue
+		}
+
+		score++
+		if ti == prevMatch+1 {
+			score += 5
+		}
+		if ti == 0 || !unicode.IsLetter(t[ti-1]) && !unicode.IsDigit(t[ti-1]) {
+			score += 3
+		}
+		prevMatch = ti
+		pi++
+	}
+
+	if pi < len(p) {
+		return 0, false
+	}
+
+	// prefer shorter names when the matches are as good
+	return score*100 - len(t), true
 }
 
 // View renders the picker
@@ -214,50 +345,69 @@ func (m Model) View() string {
 		Bold(true).
 		Foreground(m.ctx.Theme.PrimaryText).
 		MarginBottom(1)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
 
 	b.WriteString(titleStyle.Render("Select Repository Filter"))
 	b.WriteString("\n\n")
+	b.WriteString(m.filterInput.View())
+	b.WriteString("\n\n")
 
-	if m.isCustomMode {
-		b.WriteString("Enter custom repo (owner/repo):\n")
-		b.WriteString(m.customInput.View())
-		b.WriteString("\n\n")
-		b.WriteString(lipgloss.NewStyle().Faint(true).Render("Press Enter to confirm, Esc to cancel"))
-	} else {
-		for i, opt := range m.options {
-			cursor := "  "
-			style := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
-
-			if i == m.cursor {
-				cursor = "> "
-				style = lipgloss.NewStyle().
-					Foreground(m.ctx.Theme.PrimaryText).
-					Bold(true)
-			}
+	start := 0
+	if m.cursor >= maxVisibleOptions {
+		start = m.cursor - maxVisibleOptions + 1
+	}
+	end := min(len(m.visible), start+maxVisibleOptions)
+
+	for i := start; i < end; i++ {
+		opt := m.visible[i]
+		cursor := "  "
+		style := faintStyle
+
+		if i == m.cursor {
+			cursor = "> "
+			style = lipgloss.NewStyle().
+				Foreground(m.ctx.Theme.PrimaryText).
+				Bold(true)
+		}
 
-			// Mark the currently active option
-			marker := ""
-			if opt.Value == m.selectedValue {
-				marker = " (current)"
-			}
+		// Mark the currently active option
+		marker := ""
+		if opt.Value == m.selectedValue {
+			marker = " (current)"
+		}
 
-			line := fmt.Sprintf("%s%s%s", cursor, opt.Label, marker)
-			b.WriteString(style.Render(line))
+		line := fmt.Sprintf("%s%s%s", cursor, opt.Label, marker)
+		b.WriteString(style.Render(line))
 
-			if opt.Desc != "" {
-				descStyle := lipgloss.NewStyle().
-					Foreground(m.ctx.Theme.FaintText).
-					Italic(true)
-				b.WriteString(descStyle.Render(fmt.Sprintf(" - %s", opt.Desc)))
-			}
-			b.WriteString("\n")
+		if opt.Desc != "" {
+			descStyle := faintStyle.Italic(true)
+			b.WriteString(descStyle.Render(fmt.Sprintf(" - %s", opt.Desc)))
 		}
+		b.WriteString("\n")
+	}
+
+	switch {
+	case len(m.visible) == 0:
+		b.WriteString(faintStyle.Render("  No matching repos"))
+		b.WriteString("\n")
+	case len(m.visible) > end:
+		b.WriteString(faintStyle.Render(fmt.Sprintf("  … %d more", len(m.visible)-end)))
+		b.WriteString("\n")
+	}
 
+	if m.isFetching {
+		b.WriteString(faintStyle.Render("  Fetching your repos..."))
+		b.WriteString("\n")
+	} else if m.fetchErr != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(
+			fmt.Sprintf("  Failed fetching your repos: %v", m.fetchErr)))
 		b.WriteString("\n")
-		helpStyle := lipgloss.NewStyle().Faint(true)
-		b.WriteString(helpStyle.Render("↑/↓: navigate • Enter: select • c: custom • Esc: cancel"))
 	}
 
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	b.WriteString(helpStyle.Render("type to filter • ↑/↓: navigate • Enter: select • Esc: cancel"))
+
 	boxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(m.ctx.Theme.PrimaryBorder).
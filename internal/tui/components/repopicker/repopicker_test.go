@@ -0,0 +1,87 @@
+package repopicker
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestFilter(t *testing.T) {
+	m := NewModel(nil)
+	m.SetOptions([]RepoOption{
+		{Label: "Origin: me/gh-dash", Value: "me/gh-dash"},
+		{Label: "All Repositories", Value: ""},
+	})
+	m, _ = m.Update(ReposFetchedMsg{Repos: []data.AccessibleRepo{
+		{NameWithOwner: "me/gh-dash", Source: "owned"},
+		{NameWithOwner: "me/dotfiles", Source: "owned"},
+		{NameWithOwner: "dlvhdr/diffnav", Source: "starred"},
+		{NameWithOwner: "charmbracelet/bubbletea", Source: "starred"},
+	}})
+
+	tests := []struct {
+		name       string
+		query      string
+		want       []string
+		wantCustom bool
+	}{
+		{
+			name:  "no query lists everything",
+			query: "",
+			want: []string{
+				"me/gh-dash", "", "me/dotfiles", "dlvhdr/diffnav", "charmbracelet/bubbletea",
+			},
+		},
+		{
+			name:  "fuzzy match",
+			query: "dif",
+			want:  []string{"dlvhdr/diffnav"},
+		},
+		{
+			name:  "consecutive matches first",
+			query: "ha",
+			want:  []string{"charmbracelet/bubbletea", "me/gh-dash", "dlvhdr/diffnav"},
+		},
+		{
+			name:  "shorter names win ties",
+			query: "df",
+			want:  []string{"me/dotfiles", "dlvhdr/diffnav"},
+		},
+		{
+			name:  "case insensitive",
+			query: "BUBBLE",
+			want:  []string{"charmbracelet/bubbletea"},
+		},
+		{
+			name:       "custom repo",
+			query:      "cli/cli",
+			want:       []string{"cli/cli"},
+			wantCustom: true,
+		},
+		{
+			name:  "no custom repo when it's listed",
+			query: "me/dotfiles",
+			want:  []string{"me/dotfiles"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m.filterInput.SetValue(tt.query)
+			m.applyFilter()
+
+			got := make([]string, 0, len(m.visible))
+			for _, opt := range m.visible {
+				got = append(got, opt.Value)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filter %q = %q, want %q", tt.query, got, tt.want)
+			}
+			last := m.visible[len(m.visible)-1]
+			if last.isCustom != tt.wantCustom {
+				t.Errorf("filter %q last option isCustom = %v, want %v", tt.query, last.isCustom, tt.wantCustom)
+			}
+		})
+	}
+}
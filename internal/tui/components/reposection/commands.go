@@ -1,11 +1,13 @@
 package reposection
 
 import (
+	gocontext "context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
-	gitm "github.com/aymanbagabas/git-module"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/log"
 
@@ -37,44 +39,95 @@ func (m *Model) fastForward() (tea.Cmd, error) {
 		State:        context.TaskStart,
 		Error:        nil,
 	}
-	startCmd := m.Ctx.StartTask(task)
-	return tea.Batch(startCmd, func() tea.Msg {
-		var err error
-		repo, err := git.GetRepo(m.Ctx.RepoPath)
-		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
-		}
+	return m.runGitTask(task, func(onProgress func(string)) error {
+		return git.FastForward(m.Ctx.RepoPath, "origin", b.Data.Name, b.Data.IsCheckedOut, onProgress)
+	}), nil
+}
 
-		if b.Data.IsCheckedOut {
-			err = repo.Pull(gitm.PullOptions{
-				All:            false,
-				Remote:         "origin",
-				Branch:         b.Data.Name,
-				CommandOptions: gitm.CommandOptions{Args: []string{"--ff-only", "--no-edit"}},
-			})
-		} else {
-			err = repo.Fetch(gitm.FetchOptions{CommandOptions: gitm.CommandOptions{Args: []string{
-				"--no-write-fetch-head",
-				"origin",
-				b.Data.Name + ":" + b.Data.Name,
-			}}})
+// rebaseOnto is what branches are rebased onto, the default branch of origin
+func (m *Model) rebaseOnto() string {
+	if b := m.defaultBranch(); b != "" {
+		return "origin/" + b
+	}
+	return "origin/HEAD"
+}
+
+func (m *Model) rebase() tea.Cmd {
+	b := m.getCurrBranch()
+	if b == nil {
+		return nil
+	}
+	branch := b.Data.Name
+	onto := m.rebaseOnto()
+
+	taskId := fmt.Sprintf("rebase_%s_%d", branch, time.Now().Unix())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Rebasing branch %s onto %s", branch, onto),
+		FinishedText: fmt.Sprintf("Branch %s has been rebased onto %s", branch, onto),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	return m.runGitTask(task, func(onProgress func(string)) error {
+		remote, _, _ := strings.Cut(onto, "/")
+		if err := git.Fetch(m.Ctx.RepoPath, remote); err != nil {
+			return err
 		}
+		return git.Rebase(m.Ctx.RepoPath, branch, onto, onProgress)
+	})
+}
+
+func (m *Model) resetToUpstream() tea.Cmd {
+	b := m.getCurrBranch()
+	if b == nil {
+		return nil
+	}
+	branch := b.Data
+
+	taskId := fmt.Sprintf("reset_%s_%d", branch.Name, time.Now().Unix())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Resetting branch %s to %s", branch.Name, branch.Upstream),
+		FinishedText: fmt.Sprintf("Branch %s has been reset to %s", branch.Name, branch.Upstream),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	return m.runGitTask(task, func(onProgress func(string)) error {
+		return git.ResetToUpstream(m.Ctx.RepoPath, branch.Name, branch.Upstream, branch.IsCheckedOut, onProgress)
+	})
+}
+
+// runGitTask runs a git operation as a task, streaming its output into the
+// task's status line and reloading the repo once it's done
+func (m *Model) runGitTask(task context.Task, run func(onProgress func(line string)) error) tea.Cmd {
+	startCmd := m.Ctx.StartTask(task)
+	opts := m.repoOptions()
+	// progress is best effort, lines are dropped rather than blocking git
+	lines := make(chan string, 16)
+	return tea.Batch(startCmd, tasks.ListenForProgress(task.Id, lines), func() tea.Msg {
+		err := run(func(line string) {
+			select {
+			case lines <- line:
+			default:
+			}
+		})
+		close(lines)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: task.Id, Err: err}
 		}
-		repo, err = git.GetRepo(m.Ctx.RepoPath)
+		repo, err := git.GetRepoWithContext(gocontext.Background(), m.Ctx.RepoPath, opts)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: task.Id, Err: err}
 		}
 
 		return constants.TaskFinishedMsg{
 			SectionId:   0,
 			SectionType: SectionType,
-			TaskId:      taskId,
+			TaskId:      task.Id,
 			Msg:         repoMsg{repo: repo},
 			Err:         err,
 		}
-	}), nil
+	})
 }
 
 type pushOptions struct {
@@ -99,6 +152,7 @@ func (m *Model) push(opts pushOptions) (tea.Cmd, error) {
 		Error:        nil,
 	}
 	startCmd := m.Ctx.StartTask(task)
+	repoOpts := m.repoOptions()
 	return tea.Batch(startCmd, func() tea.Msg {
 		var err error
 		args := []string{}
@@ -107,26 +161,16 @@ func (m *Model) push(opts pushOptions) (tea.Cmd, error) {
 		}
 		if len(b.Data.Remotes) == 0 {
 			args = append(args, "--set-upstream")
-			err = gitm.Push(
-				m.Ctx.RepoPath,
-				"origin",
-				b.Data.Name,
-				gitm.PushOptions{CommandOptions: gitm.CommandOptions{Args: args}},
-			)
+			err = git.Push(m.Ctx.RepoPath, "origin", b.Data.Name, args...)
 		} else {
-			err = gitm.Push(
-				m.Ctx.RepoPath,
-				b.Data.Remotes[0],
-				b.Data.Name,
-				gitm.PushOptions{CommandOptions: gitm.CommandOptions{Args: args}},
-			)
+			err = git.Push(m.Ctx.RepoPath, b.Data.Remotes[0], b.Data.Name, args...)
 		}
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
 		}
-		repo, err := git.GetRepo(m.Ctx.RepoPath)
+		repo, err := git.GetRepoWithContext(gocontext.Background(), m.Ctx.RepoPath, repoOpts)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
 		}
 
 		return constants.TaskFinishedMsg{
@@ -151,14 +195,15 @@ func (m *Model) checkout() (tea.Cmd, error) {
 		Error:        nil,
 	}
 	startCmd := m.Ctx.StartTask(task)
+	opts := m.repoOptions()
 	return tea.Batch(startCmd, func() tea.Msg {
-		err := gitm.Checkout(m.Ctx.RepoPath, b.Data.Name)
+		err := git.Checkout(m.Ctx.RepoPath, b.Data.Name)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
 		}
-		repo, err := git.GetRepo(m.Ctx.RepoPath)
+		repo, err := git.GetRepoWithContext(gocontext.Background(), m.Ctx.RepoPath, opts)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
 		}
 
 		return constants.TaskFinishedMsg{
@@ -171,11 +216,173 @@ func (m *Model) checkout() (tea.Cmd, error) {
 	}), nil
 }
 
+type createPRMsg struct {
+	opts tasks.CreatePROptions
+}
+
+// prBase returns the branch a PR for b should target: the tracked upstream
+// when b was branched off another remote branch, otherwise the repo's
+// default branch
+func (m *Model) prBase(b git.Branch) string {
+	if remote, upstream, ok := strings.Cut(b.Upstream, "/"); ok && remote == "origin" && upstream != b.Name {
+		return upstream
+	}
+	return m.repo.DefaultBranch
+}
+
+// prBodyFromCommits uses the message of a single commit as the PR body, or
+// lists the subjects when the branch has several commits
+func prBodyFromCommits(commits []git.CommitMessage) string {
+	if len(commits) == 1 {
+		return commits[0].Body
+	}
+	var body strings.Builder
+	for _, c := range commits {
+		fmt.Fprintf(&body, "- %s\n", c.Subject)
+	}
+	return strings.TrimSuffix(body.String(), "\n")
+}
+
+// prepareCreatePR pushes the current branch if it has no upstream yet and
+// collects its commits since the base branch to prefill the PR body
+func (m *Model) prepareCreatePR(title string, draft bool) tea.Cmd {
+	b := m.getCurrBranch()
+	if b == nil {
+		return nil
+	}
+	branch := b.Data
+	base := m.prBase(branch)
+
+	taskId := fmt.Sprintf("prepare_pr_%s_%d", branch.Name, time.Now().Unix())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Preparing PR for branch %s", branch.Name),
+		FinishedText: fmt.Sprintf("PR for branch %s is ready to be created", branch.Name),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		if branch.Upstream == "" {
+			err := git.Push(m.Ctx.RepoPath, "origin", branch.Name, "--set-upstream")
+			if err != nil {
+				return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
+			}
+		}
+
+		body := ""
+		if base != "" {
+			commits, err := git.GetCommitMessages(m.Ctx.RepoPath, "origin/"+base, branch.Name)
+			if err != nil {
+				log.Warn("failed reading commits for PR body", "branch", branch.Name, "err", err)
+			}
+			body = prBodyFromCommits(commits)
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: SectionType,
+			TaskId:      taskId,
+			Msg: createPRMsg{opts: tasks.CreatePROptions{
+				Branch: branch.Name,
+				Title:  title,
+				Body:   body,
+				Base:   base,
+				Draft:  draft,
+			}},
+		}
+	})
+}
+
 type repoMsg struct {
 	repo           *git.Repo
 	resetSelection bool
 }
 
+// gitFailedMsg is sent when reading or fetching the repo fails so the
+// section can leave its loading state
+type gitFailedMsg struct{}
+
+var errGitCancelled = errors.New("git operation cancelled")
+
+// gitContext returns the context background git reads of this section run
+// under, it is cancelled by CancelGitOps.
+func (m *Model) gitContext() gocontext.Context {
+	if m.gitCtx == nil {
+		m.gitCtx, m.cancelGit = gocontext.WithCancel(gocontext.Background())
+	}
+	return m.gitCtx
+}
+
+// CancelGitOps kills any background git reads or fetches of this section
+// that are still running, e.g. when the user switches away from the view.
+// User initiated operations like push or checkout are left to complete.
+func (m *Model) CancelGitOps() {
+	if m.cancelGit != nil {
+		m.cancelGit()
+	}
+	m.gitCtx = nil
+	m.cancelGit = nil
+}
+
+// ReloadRepo re-reads the local branches, e.g. after CancelGitOps
+// interrupted a previous read.
+func (m *Model) ReloadRepo() []tea.Cmd {
+	if m.Ctx.RepoPath == "" {
+		return nil
+	}
+	return m.readRepoCmd()
+}
+
+// defaultBranchesPageSize is used when repo.branchesPageSize isn't configured
+const defaultBranchesPageSize = 50
+
+func (m *Model) branchesPageSize() int {
+	if m.Ctx.Config != nil && m.Ctx.Config.Repo.BranchesPageSize > 0 {
+		return m.Ctx.Config.Repo.BranchesPageSize
+	}
+	return defaultBranchesPageSize
+}
+
+// repoOptions limits reading the repo to the branches currently shown
+func (m *Model) repoOptions() git.RepoOptions {
+	if m.branchesLimit == 0 {
+		m.branchesLimit = m.branchesPageSize()
+	}
+	opts := git.RepoOptions{Limit: m.branchesLimit, NameFilter: m.SearchValue}
+	if m.Ctx.Config != nil && m.Ctx.Config.Repo.BranchesUpdatedWithinDays > 0 {
+		opts.UpdatedSince = time.Now().AddDate(0, 0, -m.Ctx.Config.Repo.BranchesUpdatedWithinDays)
+	}
+	return opts
+}
+
+// HasMoreBranches returns whether there are branches that weren't loaded yet
+func (m *Model) HasMoreBranches() bool {
+	return m.repo.TotalBranchCount > len(m.repo.Branches)
+}
+
+// LoadMoreBranches reads the next page of branches
+func (m *Model) LoadMoreBranches() []tea.Cmd {
+	if m.Ctx.RepoPath == "" || !m.HasMoreBranches() {
+		return nil
+	}
+	m.branchesLimit = len(m.repo.Branches) + m.branchesPageSize()
+	return m.readRepoCmd()
+}
+
+func (m *Model) gitTaskFailed(ctx gocontext.Context, taskId string, err error) constants.TaskFinishedMsg {
+	if ctx.Err() != nil {
+		err = errGitCancelled
+	}
+	return constants.TaskFinishedMsg{
+		SectionId:   0,
+		SectionType: SectionType,
+		TaskId:      taskId,
+		Msg:         gitFailedMsg{},
+		Err:         err,
+	}
+}
+
 func (m *Model) readRepoCmd() []tea.Cmd {
 	cmds := make([]tea.Cmd, 0)
 	branchesTaskId := fmt.Sprintf("fetching_branches_%d", time.Now().Unix())
@@ -190,10 +397,15 @@ func (m *Model) readRepoCmd() []tea.Cmd {
 		bCmd := m.Ctx.StartTask(branchesTask)
 		cmds = append(cmds, bCmd)
 	}
+	if len(m.repo.Branches) == 0 {
+		m.SetIsLoading(true)
+	}
+	ctx := m.gitContext()
+	opts := m.repoOptions()
 	cmds = append(cmds, func() tea.Msg {
-		repo, err := git.GetRepo(m.Ctx.RepoPath)
+		repo, err := git.GetRepoWithContext(ctx, m.Ctx.RepoPath, opts)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: branchesTaskId, Err: err}
+			return m.gitTaskFailed(ctx, branchesTaskId, err)
 		}
 		return constants.TaskFinishedMsg{
 			SectionId:   0,
@@ -220,10 +432,12 @@ func (m *Model) fetchRepoCmd() []tea.Cmd {
 		Error:        nil,
 	}
 	cmds = append(cmds, m.Ctx.StartTask(fetchTask))
+	ctx := m.gitContext()
+	opts := m.repoOptions()
 	cmds = append(cmds, func() tea.Msg {
-		repo, err := git.FetchRepo(m.Ctx.RepoPath)
+		repo, err := git.FetchRepoWithContext(ctx, m.Ctx.RepoPath, opts)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: fetchTaskId, Err: err}
+			return m.gitTaskFailed(ctx, fetchTaskId, err)
 		}
 		return constants.TaskFinishedMsg{
 			SectionId:   0,
@@ -251,7 +465,7 @@ func (m *Model) fetchPRsCmd() tea.Cmd {
 		if limit == nil {
 			limit = &m.Ctx.Config.Defaults.PrsLimit
 		}
-		res, err := data.FetchPullRequests(fmt.Sprintf("author:@me repo:%s", git.GetRepoShortName(m.Ctx.RepoUrl)), *limit, nil)
+		res, err := m.searchPullRequests(fmt.Sprintf("author:@me repo:%s", git.GetRepoShortName(m.Ctx.RepoUrl)), *limit)
 		if err != nil {
 			return constants.TaskFinishedMsg{
 				SectionId:   0,
@@ -274,6 +488,34 @@ func (m *Model) fetchPRsCmd() tea.Cmd {
 	})
 }
 
+// searchPullRequests searches the PRs of the host of the repo, e.g. a GitHub
+// Enterprise host
+func (m *Model) searchPullRequests(query string, limit int) (data.PullRequestsResponse, error) {
+	provider, err := data.GetProvider(data.ProviderGitHub, git.ParseRemoteHost(m.Ctx.RepoUrl))
+	if err != nil {
+		return data.PullRequestsResponse{}, err
+	}
+	return provider.FetchPullRequests(query, limit, nil)
+}
+
+type branchProtectionFetchedMsg struct {
+	protection data.BranchProtection
+}
+
+func (m *Model) fetchBranchProtectionCmd() tea.Cmd {
+	repoName := git.GetRepoShortName(m.Ctx.RepoUrl)
+	return func() tea.Msg {
+		protection, err := data.FetchBranchProtection(repoName)
+		if err != nil {
+			// reading protection rules needs admin access to the repo, so
+			// this is expected to fail for most users
+			log.Debug("failed fetching branch protection", "repo", repoName, "err", err)
+			return nil
+		}
+		return branchProtectionFetchedMsg{protection: protection}
+	}
+}
+
 func (m *Model) fetchPRCmd(branch string) []tea.Cmd {
 	prsTaskId := fmt.Sprintf("fetching_pr_for_branch_%s_%d", branch, time.Now().Unix())
 	task := context.Task{
@@ -285,7 +527,7 @@ func (m *Model) fetchPRCmd(branch string) []tea.Cmd {
 	}
 	startCmd := m.Ctx.StartTask(task)
 	return []tea.Cmd{startCmd, func() tea.Msg {
-		res, err := data.FetchPullRequests(fmt.Sprintf("author:@me repo:%s head:%s", git.GetRepoShortName(m.Ctx.RepoUrl), branch), 1, nil)
+		res, err := m.searchPullRequests(fmt.Sprintf("author:@me repo:%s head:%s", git.GetRepoShortName(m.Ctx.RepoUrl), branch), 1)
 		log.Debug("Fetching PRs", "res", res)
 		if err != nil {
 			return constants.TaskFinishedMsg{
@@ -367,8 +609,10 @@ func (m *Model) onRefreshPrsMsg() []tea.Cmd {
 }
 
 func (m *Model) OpenGithub() tea.Cmd {
-	row := m.CurrRow()
-	b := m.getFilteredBranches()[row]
+	b := m.getCurrBranch()
+	if b == nil {
+		return nil
+	}
 	return tasks.OpenBranchPR(m.Ctx, tasks.SectionIdentifier{Id: 0, Type: SectionType}, b.Data.Name)
 }
 
@@ -384,14 +628,15 @@ func (m *Model) deleteBranch() tea.Cmd {
 		Error:        nil,
 	}
 	startCmd := m.Ctx.StartTask(task)
+	opts := m.repoOptions()
 	return tea.Batch(startCmd, func() tea.Msg {
-		err := gitm.DeleteBranch(m.Ctx.RepoPath, b.Data.Name, gitm.DeleteBranchOptions{Force: true})
+		err := git.DeleteBranch(m.Ctx.RepoPath, b.Data.Name)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
 		}
-		repo, err := git.GetRepo(m.Ctx.RepoPath)
+		repo, err := git.GetRepoWithContext(gocontext.Background(), m.Ctx.RepoPath, opts)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
 		}
 
 		return constants.TaskFinishedMsg{
@@ -414,14 +659,15 @@ func (m *Model) newBranch(name string) tea.Cmd {
 		Error:        nil,
 	}
 	startCmd := m.Ctx.StartTask(task)
+	opts := m.repoOptions()
 	return tea.Batch(startCmd, func() tea.Msg {
-		err := gitm.Checkout(m.Ctx.RepoPath, name, gitm.CheckoutOptions{BaseBranch: m.repo.HeadBranchName})
+		err := git.CreateBranch(m.Ctx.RepoPath, name, m.repo.HeadBranchName)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
 		}
-		repo, err := git.GetRepo(m.Ctx.RepoPath)
+		repo, err := git.GetRepoWithContext(gocontext.Background(), m.Ctx.RepoPath, opts)
 		if err != nil {
-			return constants.TaskFinishedMsg{TaskId: taskId, Err: err}
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
 		}
 
 		return constants.TaskFinishedMsg{
@@ -1,6 +1,7 @@
 package reposection
 
 import (
+	gocontext "context"
 	"fmt"
 	"slices"
 	"strings"
@@ -34,6 +35,13 @@ type Model struct {
 	Prs            []data.PullRequestData
 	isRefreshSetUp bool
 	refreshId      int
+	gitCtx         gocontext.Context
+	cancelGit      gocontext.CancelFunc
+	branchesLimit  int
+	protection     data.BranchProtection
+	// isViewingStashes lists the stashes of the repo instead of its branches
+	isViewingStashes bool
+	stashes          []git.Stash
 }
 
 func NewModel(
@@ -74,6 +82,12 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	case tea.KeyMsg:
 
 		if m.IsSearchFocused() {
+			if m.SearchBar.IsOverlayOpen() {
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+
 			switch msg.Type {
 			case tea.KeyCtrlC, tea.KeyEsc:
 				m.SearchBar.SetValue(m.SearchValue)
@@ -84,8 +98,12 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				m.Table.ResetCurrItem()
 				m.SetIsSearching(false)
 				m.SearchValue = m.SearchBar.Value()
-				m.BuildRows()
-				return m, nil
+				historyCmd := m.SearchBar.AddToHistory(m.SearchValue)
+				m.Table.SetRows(m.BuildRows())
+				// the filter is also applied when reading the repo so
+				// branches that weren't loaded yet can be found
+				m.branchesLimit = 0
+				return m, tea.Batch(append(m.ReloadRepo(), historyCmd)...)
 			}
 
 			break
@@ -101,19 +119,50 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			case tea.KeyEnter:
 				input := m.PromptConfirmationBox.Value()
 				action := m.GetPromptConfirmationAction()
-				branch := m.getCurrBranch().Data.Name
+				branch := ""
+				if b := m.getCurrBranch(); b != nil {
+					branch = b.Data.Name
+				}
 				sid := tasks.SectionIdentifier{Id: m.Id, Type: SectionType}
 				switch action {
+				case "stash":
+					cmd = m.stashChanges(input)
+				case "drop_stash":
+					if input == "Y" || input == "y" {
+						cmd = m.dropStash()
+					}
 				case "new":
 					cmd = m.newBranch(input)
+				case "delete":
+					if m.isDestructiveActionConfirmed(input) {
+						cmd = m.deleteBranch()
+					}
+				case "force_push":
+					if m.isDestructiveActionConfirmed(input) {
+						cmd, err = m.push(pushOptions{force: true})
+						if err != nil {
+							m.Ctx.Error = err
+						}
+					}
+				case "rebase":
+					if input == "Y" || input == "y" {
+						cmd = m.rebase()
+					}
+				case "reset_upstream":
+					if m.isDestructiveActionConfirmed(input) {
+						cmd = m.resetToUpstream()
+					}
 				case "create_pr":
-					cmd = tasks.CreatePR(m.Ctx, sid, branch, input)
+					cmd = m.prepareCreatePR(input, false)
+				case "create_draft_pr":
+					cmd = m.prepareCreatePR(input, true)
 				default:
 					pr := findPRForRef(m.Prs, branch)
-					if input == "Y" || input == "y" {
+					method, update := data.ParseBranchUpdateAnswer(input, m.Ctx.Config.Defaults.PrUpdateMethod)
+					if action == "update" && update {
+						cmd = tasks.UpdatePR(m.Ctx, sid, pr, method)
+					} else if input == "Y" || input == "y" {
 						switch action {
-						case "delete":
-							cmd = m.deleteBranch()
 						case "close":
 							cmd = tasks.ClosePR(m.Ctx, sid, pr)
 						case "reopen":
@@ -122,8 +171,6 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 							cmd = tasks.PRReady(m.Ctx, sid, pr)
 						case "merge":
 							cmd = tasks.MergePR(m.Ctx, sid, pr)
-						case "update":
-							cmd = tasks.UpdatePR(m.Ctx, sid, pr)
 						}
 					}
 				}
@@ -137,6 +184,27 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			break
 		}
 
+		switch {
+		case key.Matches(msg, keys.BranchKeys.ToggleStashes):
+			cmd = m.ToggleStashes()
+			return m, cmd
+
+		case key.Matches(msg, keys.BranchKeys.Stash):
+			m.SetPromptConfirmationAction("stash")
+			cmd = m.SetIsPromptConfirmationShown(true)
+			return m, cmd
+		}
+
+		if m.isViewingStashes {
+			switch {
+			case key.Matches(msg, keys.BranchKeys.ApplyStash):
+				cmd = m.applyStash(false)
+			case key.Matches(msg, keys.BranchKeys.PopStash):
+				cmd = m.applyStash(true)
+			}
+			break
+		}
+
 		switch {
 		case key.Matches(msg, keys.BranchKeys.Checkout):
 			cmd, err = m.checkout()
@@ -150,6 +218,11 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 				m.Ctx.Error = err
 			}
 		case key.Matches(msg, keys.BranchKeys.ForcePush):
+			if b := m.getCurrBranch(); b != nil && b.IsGuarded() {
+				m.SetPromptConfirmationAction("force_push")
+				cmd = m.SetIsPromptConfirmationShown(true)
+				break
+			}
 			cmd, err = m.push(pushOptions{force: true})
 			if err != nil {
 				m.Ctx.Error = err
@@ -159,6 +232,34 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			if err != nil {
 				m.Ctx.Error = err
 			}
+		case key.Matches(msg, keys.BranchKeys.Rebase):
+			if m.getCurrBranch() != nil {
+				m.SetPromptConfirmationAction("rebase")
+				cmd = m.SetIsPromptConfirmationShown(true)
+			}
+		case key.Matches(msg, keys.BranchKeys.ResetUpstream):
+			b := m.getCurrBranch()
+			if b == nil {
+				break
+			}
+			if b.Data.Upstream == "" {
+				m.Ctx.Error = fmt.Errorf("%s has no upstream to reset to", b.Data.Name)
+				break
+			}
+			m.SetPromptConfirmationAction("reset_upstream")
+			cmd = m.SetIsPromptConfirmationShown(true)
+
+		case key.Matches(msg, keys.BranchKeys.AddWorktree):
+			cmd, err = m.addWorktree()
+			if err != nil {
+				m.Ctx.Error = err
+			}
+
+		case key.Matches(msg, keys.BranchKeys.OpenWorktree):
+			cmd, err = m.openWorktree()
+			if err != nil {
+				m.Ctx.Error = err
+			}
 		}
 
 	case tasks.UpdateBranchMsg:
@@ -177,9 +278,27 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 			m.Table.ResetCurrItem()
 		}
 
+	case stashesMsg:
+		m.stashes = msg.stashes
+		if msg.repo != nil {
+			m.repo = msg.repo
+		}
+		m.Table.SetRows(m.BuildRows())
+
+	case gitFailedMsg:
+		m.SetIsLoading(false)
+
+	case createPRMsg:
+		cmd = tasks.CreatePR(m.Ctx, tasks.SectionIdentifier{Id: m.Id, Type: SectionType}, msg.opts)
+
 	case SectionPullRequestsFetchedMsg:
 		m.Prs = msg.Prs
 
+	case branchProtectionFetchedMsg:
+		m.protection = msg.protection
+		m.updateBranchesWithPrs()
+		m.Table.SetRows(m.BuildRows())
+
 	case RefreshBranchesMsg:
 		if msg.id == m.refreshId {
 			cmds = append(cmds, m.onRefreshBranchesMsg()...)
@@ -203,8 +322,6 @@ func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
 	cmds = append(cmds, promptCmd)
 	m.PromptConfirmationBox = prompt
 
-	m.Table.SetRows(m.BuildRows())
-
 	m.Table.SetRows(m.BuildRows())
 	table, tableCmd := m.Table.Update(msg)
 	m.Table = table
@@ -217,12 +334,16 @@ func (m *Model) View() string {
 	view := ""
 	if m.Table.Rows == nil {
 		d := m.GetDimensions()
+		empty := "No local branches"
+		if m.isViewingStashes {
+			empty = "No stashes"
+		}
 		view = lipgloss.Place(
 			d.Width,
 			d.Height,
 			lipgloss.Center,
 			lipgloss.Center,
-			"No local branches",
+			empty,
 		)
 	} else {
 		view = m.Table.View()
@@ -233,6 +354,9 @@ func (m *Model) View() string {
 	)
 }
 
+// prNumberCellWidth fits the "#" prefix of a five digit PR number plus padding
+const prNumberCellWidth = 8
+
 func GetSectionColumns(
 	ctx *context.ProgramContext,
 	cfg config.PrsSectionConfig,
@@ -267,6 +391,17 @@ func GetSectionColumns(
 				Width:  utils.IntPtr(3),
 				Hidden: stateLayout.Hidden,
 			},
+			{
+				Title:  "PR",
+				Width:  utils.IntPtr(prNumberCellWidth),
+				Hidden: stateLayout.Hidden,
+			},
+			{
+				Title:  "",
+				Width:  &ctx.Styles.PrSection.CiCellWidth,
+				Grow:   new(bool),
+				Hidden: ciLayout.Hidden,
+			},
 			{
 				Title:  "Title",
 				Grow:   utils.BoolPtr(true),
@@ -287,12 +422,6 @@ func GetSectionColumns(
 				Width:  utils.IntPtr(4),
 				Hidden: reviewStatusLayout.Hidden,
 			},
-			{
-				Title:  "",
-				Width:  &ctx.Styles.PrSection.CiCellWidth,
-				Grow:   new(bool),
-				Hidden: ciLayout.Hidden,
-			},
 			{
 				Title:  "",
 				Width:  linesLayout.Width,
@@ -312,6 +441,17 @@ func GetSectionColumns(
 			Width:  utils.IntPtr(3),
 			Hidden: stateLayout.Hidden,
 		},
+		{
+			Title:  "PR",
+			Width:  utils.IntPtr(prNumberCellWidth),
+			Hidden: stateLayout.Hidden,
+		},
+		{
+			Title:  "",
+			Width:  &ctx.Styles.PrSection.CiCellWidth,
+			Grow:   new(bool),
+			Hidden: ciLayout.Hidden,
+		},
 		{
 			Title:  "",
 			Width:  repoLayout.Width,
@@ -342,12 +482,6 @@ func GetSectionColumns(
 			Width:  utils.IntPtr(4),
 			Hidden: reviewStatusLayout.Hidden,
 		},
-		{
-			Title:  "",
-			Width:  &ctx.Styles.PrSection.CiCellWidth,
-			Grow:   new(bool),
-			Hidden: ciLayout.Hidden,
-		},
 		{
 			Title:  "",
 			Width:  linesLayout.Width,
@@ -366,6 +500,8 @@ func (m *Model) updateBranchesWithPrs() {
 	for _, ref := range m.repo.Branches {
 		b := branch.Branch{Ctx: m.Ctx, Data: ref, Columns: m.Table.Columns}
 		b.PR = findPRForRef(m.Prs, ref.Name)
+		b.IsDefault = ref.Name == m.defaultBranch()
+		b.IsProtected = m.protection.IsProtected(ref.Name)
 
 		branches = append(branches, b)
 	}
@@ -389,18 +525,20 @@ func (m *Model) updateBranchesWithPrs() {
 }
 
 func (m Model) BuildRows() []table.Row {
+	if m.isViewingStashes {
+		return m.buildStashRows()
+	}
+
 	var rows []table.Row
 	currItem := m.Table.GetCurrItem()
 
 	filtered := m.getFilteredBranches()
 
 	for i, b := range filtered {
-		if strings.Contains(b.Data.Name, m.SearchValue) {
-			rows = append(
-				rows,
-				b.ToTableRow(currItem == i),
-			)
-		}
+		rows = append(
+			rows,
+			b.ToTableRow(currItem == i),
+		)
 	}
 
 	if rows == nil {
@@ -413,25 +551,102 @@ func (m Model) BuildRows() []table.Row {
 func (m *Model) getFilteredBranches() []branch.Branch {
 	sorted := m.Branches
 	filtered := make([]branch.Branch, 0)
+	search := strings.ToLower(m.SearchValue)
 	for _, b := range sorted {
-		if strings.Contains(b.Data.Name, m.SearchValue) {
+		if strings.Contains(strings.ToLower(b.Data.Name), search) {
 			filtered = append(filtered, b)
 		}
 	}
 	return filtered
 }
 
+// findPRForRef returns the PR whose head is branch, preferring open PRs
+// over closed or merged ones that reused the same branch name
 func findPRForRef(prs []data.PullRequestData, branch string) *data.PullRequestData {
-	for _, pr := range prs {
-		if pr.HeadRefName == branch {
-			return &pr
+	var found *data.PullRequestData
+	for i := range prs {
+		if prs[i].HeadRefName != branch {
+			continue
+		}
+		if prs[i].State == "OPEN" {
+			return &prs[i]
+		}
+		if found == nil {
+			found = &prs[i]
+		}
+	}
+	return found
+}
+
+// defaultBranch prefers the default branch reported by GitHub over the one
+// origin/HEAD points to, which may be stale
+func (m *Model) defaultBranch() string {
+	if m.protection.DefaultBranch != "" {
+		return m.protection.DefaultBranch
+	}
+	return m.repo.DefaultBranch
+}
+
+// isDestructiveActionConfirmed checks the prompt input of a delete, force
+// push or reset: guarded branches need their name typed out, others a plain "y"
+func (m *Model) isDestructiveActionConfirmed(input string) bool {
+	b := m.getCurrBranch()
+	if b == nil {
+		return false
+	}
+	if b.IsGuarded() {
+		return input == b.Data.Name
+	}
+	return input == "Y" || input == "y"
+}
+
+func (m *Model) GetPromptConfirmation() string {
+	if m.IsPromptConfirmationFocused() {
+		prompt := ""
+		switch m.PromptConfirmationAction {
+		case "stash":
+			prompt = "Enter stash message (optional): "
+		case "drop_stash":
+			if s := m.getCurrStash(); s != nil {
+				prompt = fmt.Sprintf("Drop %s, losing its changes? (Y/n) ", s.Ref)
+			}
+		}
+		if prompt != "" {
+			m.PromptConfirmationBox.SetPrompt(prompt)
+			return m.Ctx.Styles.ListViewPort.PagerStyle.Render(m.PromptConfirmationBox.View())
 		}
 	}
-	return nil
+
+	b := m.getCurrBranch()
+	if !m.IsPromptConfirmationFocused() || b == nil {
+		return m.BaseModel.GetPromptConfirmation()
+	}
+
+	var prompt string
+	switch {
+	case m.PromptConfirmationAction == "delete" && b.IsGuarded():
+		prompt = fmt.Sprintf("%s is %s, type its name to delete it: ", b.Data.Name, b.GuardReason())
+	case m.PromptConfirmationAction == "force_push":
+		prompt = fmt.Sprintf("%s is %s, type its name to force-push it: ", b.Data.Name, b.GuardReason())
+	case m.PromptConfirmationAction == "rebase":
+		prompt = fmt.Sprintf("Rebase %s onto %s? (Y/n) ", b.Data.Name, m.rebaseOnto())
+	case m.PromptConfirmationAction == "reset_upstream" && b.IsGuarded():
+		prompt = fmt.Sprintf("%s is %s, type its name to reset it to %s: ", b.Data.Name, b.GuardReason(), b.Data.Upstream)
+	case m.PromptConfirmationAction == "reset_upstream":
+		prompt = fmt.Sprintf("Reset %s to %s, dropping its local commits? (Y/n) ", b.Data.Name, b.Data.Upstream)
+	default:
+		return m.BaseModel.GetPromptConfirmation()
+	}
+
+	m.PromptConfirmationBox.SetPrompt(prompt)
+	return m.Ctx.Styles.ListViewPort.PagerStyle.Render(m.PromptConfirmationBox.View())
 }
 
 func (m *Model) NumRows() int {
-	return len(m.repo.Branches)
+	if m.isViewingStashes {
+		return len(m.getFilteredStashes())
+	}
+	return len(m.getFilteredBranches())
 }
 
 type SectionPullRequestsFetchedMsg struct {
@@ -442,21 +657,33 @@ type SectionPullRequestsFetchedMsg struct {
 }
 
 func (m *Model) getCurrBranch() *branch.Branch {
-	if len(m.repo.Branches) == 0 {
+	if m.isViewingStashes {
 		return nil
 	}
-	return &m.Branches[m.Table.GetCurrItem()]
+	filtered := m.getFilteredBranches()
+	curr := m.Table.GetCurrItem()
+	if curr < 0 || curr >= len(filtered) {
+		return nil
+	}
+	return &filtered[curr]
 }
 
 func (m *Model) GetCurrRow() data.RowData {
-	if len(m.repo.Branches) == 0 {
+	if m.isViewingStashes {
+		s := m.getCurrStash()
+		if s == nil {
+			return nil
+		}
+		return branch.StashData{Data: *s}
+	}
+
+	b := m.getCurrBranch()
+	if b == nil {
 		return nil
 	}
-	b := m.repo.Branches[m.Table.GetCurrItem()]
-	pr := findPRForRef(m.Prs, b.Name)
 	return branch.BranchData{
-		Data: b,
-		PR:   pr,
+		Data: b.Data,
+		PR:   b.PR,
 	}
 }
 
@@ -470,6 +697,7 @@ func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
 		cmds = append(cmds, m.readRepoCmd()...)
 		cmds = append(cmds, m.fetchRepoCmd()...)
 		cmds = append(cmds, m.fetchPRsCmd())
+		cmds = append(cmds, m.fetchBranchProtectionCmd())
 	}
 
 	return cmds
@@ -495,6 +723,7 @@ func FetchAllBranches(ctx *context.ProgramContext) (Model, tea.Cmd) {
 		cmds = append(cmds, m.readRepoCmd()...)
 		cmds = append(cmds, m.fetchRepoCmd()...)
 		cmds = append(cmds, m.fetchPRsCmd())
+		cmds = append(cmds, m.fetchBranchProtectionCmd())
 	}
 
 	if !m.isRefreshSetUp {
@@ -523,9 +752,11 @@ func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 		Height: newDimensions.Height,
 		Width:  newDimensions.Width,
 	}
-	m.Table.SetDimensions(tableDimensions)
 	m.Table.UpdateProgramContext(ctx)
-	m.Table.SyncViewPortContent()
+	if m.Table.GetDimensions() != tableDimensions {
+		m.Table.SetDimensions(tableDimensions)
+		m.Table.SyncViewPortContent()
+	}
 	m.SearchBar.UpdateProgramContext(ctx)
 }
 
@@ -534,15 +765,24 @@ func (m *Model) ResetRows() {
 }
 
 func (m *Model) GetItemSingularForm() string {
+	if m.isViewingStashes {
+		return "Stash"
+	}
 	return "Branch"
 }
 
 func (m *Model) GetItemPluralForm() string {
+	if m.isViewingStashes {
+		return "Stashes"
+	}
 	return "Branches"
 }
 
 func (m *Model) GetTotalCount() int {
-	return len(m.Branches)
+	if m.isViewingStashes {
+		return len(m.stashes)
+	}
+	return max(m.repo.TotalBranchCount, len(m.Branches))
 }
 
 func (m *Model) SetIsLoading(val bool) {
@@ -0,0 +1,189 @@
+package reposection
+
+import (
+	gocontext "context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branch"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// stashesMsg carries the stashes read after a stash operation, along with
+// the repo when the operation changed the working tree
+type stashesMsg struct {
+	stashes []git.Stash
+	repo    *git.Repo
+}
+
+// IsViewingStashes returns whether the section lists the stashes instead of
+// the branches
+func (m *Model) IsViewingStashes() bool {
+	return m.isViewingStashes
+}
+
+// ToggleStashes switches between listing the branches and the stashes
+func (m *Model) ToggleStashes() tea.Cmd {
+	m.isViewingStashes = !m.isViewingStashes
+	m.Table.ResetCurrItem()
+	m.Table.SetRows(m.BuildRows())
+	if m.Ctx.RepoPath == "" {
+		return nil
+	}
+	if !m.isViewingStashes {
+		return tea.Batch(m.ReloadRepo()...)
+	}
+	return m.readStashesCmd()
+}
+
+func (m *Model) readStashesCmd() tea.Cmd {
+	taskId := fmt.Sprintf("reading_stashes_%d", time.Now().Unix())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    "Reading stashes",
+		FinishedText: "Stashes read",
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	return tea.Batch(startCmd, func() tea.Msg {
+		stashes, err := git.GetStashes(m.Ctx.RepoPath)
+		if err != nil {
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   0,
+			SectionType: SectionType,
+			TaskId:      taskId,
+			Msg:         stashesMsg{stashes: stashes},
+		}
+	})
+}
+
+func (m *Model) getFilteredStashes() []git.Stash {
+	filtered := make([]git.Stash, 0)
+	search := strings.ToLower(m.SearchValue)
+	for _, s := range m.stashes {
+		if strings.Contains(strings.ToLower(s.Branch), search) ||
+			strings.Contains(strings.ToLower(s.Message), search) {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+func (m *Model) getCurrStash() *git.Stash {
+	if !m.isViewingStashes {
+		return nil
+	}
+	filtered := m.getFilteredStashes()
+	curr := m.Table.GetCurrItem()
+	if curr < 0 || curr >= len(filtered) {
+		return nil
+	}
+	return &filtered[curr]
+}
+
+// stashChanges stashes the uncommitted changes, untracked files included
+func (m *Model) stashChanges(message string) tea.Cmd {
+	task := context.Task{
+		Id:           fmt.Sprintf("stash_push_%d", time.Now().Unix()),
+		StartText:    "Stashing changes",
+		FinishedText: "Changes have been stashed",
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	return m.runStashTask(task, func() error {
+		return git.StashPush(m.Ctx.RepoPath, message)
+	})
+}
+
+// applyStash applies the selected stash to the working tree, dropping it
+// when pop is set
+func (m *Model) applyStash(pop bool) tea.Cmd {
+	s := m.getCurrStash()
+	if s == nil {
+		return nil
+	}
+	ref := s.Ref
+
+	verb, past := "Applying", "applied"
+	if pop {
+		verb, past = "Popping", "popped"
+	}
+	task := context.Task{
+		Id:           fmt.Sprintf("stash_apply_%s_%d", ref, time.Now().Unix()),
+		StartText:    fmt.Sprintf("%s %s", verb, ref),
+		FinishedText: fmt.Sprintf("%s has been %s", ref, past),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	return m.runStashTask(task, func() error {
+		if pop {
+			return git.StashPop(m.Ctx.RepoPath, ref)
+		}
+		return git.StashApply(m.Ctx.RepoPath, ref)
+	})
+}
+
+func (m *Model) dropStash() tea.Cmd {
+	s := m.getCurrStash()
+	if s == nil {
+		return nil
+	}
+	ref := s.Ref
+
+	task := context.Task{
+		Id:           fmt.Sprintf("stash_drop_%s_%d", ref, time.Now().Unix()),
+		StartText:    fmt.Sprintf("Dropping %s", ref),
+		FinishedText: fmt.Sprintf("%s has been dropped", ref),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	return m.runStashTask(task, func() error {
+		return git.StashDrop(m.Ctx.RepoPath, ref)
+	})
+}
+
+// runStashTask runs a stash operation as a task, reading the stashes and
+// the repo again once it's done
+func (m *Model) runStashTask(task context.Task, run func() error) tea.Cmd {
+	startCmd := m.Ctx.StartTask(task)
+	opts := m.repoOptions()
+	return tea.Batch(startCmd, func() tea.Msg {
+		if err := run(); err != nil {
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: task.Id, Err: err}
+		}
+		stashes, err := git.GetStashes(m.Ctx.RepoPath)
+		if err != nil {
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: task.Id, Err: err}
+		}
+		repo, err := git.GetRepoWithContext(gocontext.Background(), m.Ctx.RepoPath, opts)
+		if err != nil {
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: task.Id, Err: err}
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   0,
+			SectionType: SectionType,
+			TaskId:      task.Id,
+			Msg:         stashesMsg{stashes: stashes, repo: repo},
+		}
+	})
+}
+
+func (m Model) buildStashRows() []table.Row {
+	currItem := m.Table.GetCurrItem()
+	rows := []table.Row{}
+	for i, s := range m.getFilteredStashes() {
+		stash := branch.Stash{Ctx: m.Ctx, Data: s, Columns: m.Table.Columns}
+		rows = append(rows, stash.ToTableRow(currItem == i))
+	}
+	return rows
+}
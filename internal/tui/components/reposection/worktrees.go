@@ -0,0 +1,77 @@
+package reposection
+
+import (
+	gocontext "context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+// addWorktree creates a worktree with the selected branch checked out, at
+// the path set by the repo.worktrees config
+func (m *Model) addWorktree() (tea.Cmd, error) {
+	b := m.getCurrBranch()
+	if b == nil {
+		return nil, nil
+	}
+	name := b.Data.Name
+	if b.Data.IsCheckedOut {
+		return nil, fmt.Errorf("%s is checked out in this worktree", name)
+	}
+	if b.Data.Worktree != "" {
+		return nil, fmt.Errorf("%s is already checked out at %s", name, b.Data.Worktree)
+	}
+
+	path := tasks.WorktreePath(m.Ctx, m.Ctx.RepoPath, name)
+	taskId := fmt.Sprintf("worktree_add_%s_%d", name, time.Now().Unix())
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Creating a worktree for %s", name),
+		FinishedText: fmt.Sprintf("%s has been checked out at %s", name, path),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	opts := m.repoOptions()
+	return tea.Batch(startCmd, func() tea.Msg {
+		if err := git.AddWorktree(m.Ctx.RepoPath, path, name, ""); err != nil {
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
+		}
+		repo, err := git.GetRepoWithContext(gocontext.Background(), m.Ctx.RepoPath, opts)
+		if err != nil {
+			return constants.TaskFinishedMsg{SectionId: m.Id, SectionType: SectionType, TaskId: taskId, Err: err}
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   0,
+			SectionType: SectionType,
+			TaskId:      taskId,
+			Msg:         repoMsg{repo: repo},
+		}
+	}), nil
+}
+
+// openWorktree opens the worktree the selected branch is checked out in
+// with the repo.worktrees.openCommand
+func (m *Model) openWorktree() (tea.Cmd, error) {
+	b := m.getCurrBranch()
+	if b == nil {
+		return nil, nil
+	}
+	path := b.Data.Worktree
+	if b.Data.IsCheckedOut {
+		path = m.Ctx.RepoPath
+	}
+	if path == "" {
+		return nil, fmt.Errorf("%s isn't checked out in a worktree, create one with %s",
+			b.Data.Name, keys.BranchKeys.AddWorktree.Help().Key)
+	}
+	return tasks.OpenWorktree(m.Ctx, m.Ctx.RepoPath, path, b.Data.Name), nil
+}
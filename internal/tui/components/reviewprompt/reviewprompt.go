@@ -0,0 +1,243 @@
+package reviewprompt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/inputbox"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// events are the review types the prompt cycles through
+var events = []data.ReviewEvent{
+	data.ReviewEventComment,
+	data.ReviewEventApprove,
+	data.ReviewEventRequestChanges,
+}
+
+func eventLabel(event data.ReviewEvent) string {
+	switch event {
+	case data.ReviewEventApprove:
+		return "Approve"
+	case data.ReviewEventRequestChanges:
+		return "Request changes"
+	default:
+		return "Comment"
+	}
+}
+
+// Model is the modal for writing and submitting a PR review
+type Model struct {
+	ctx     *context.ProgramContext
+	input   inputbox.Model
+	event   int
+	title   string
+	width   int
+	focused bool
+	err     string
+}
+
+// KeyMap defines keybindings for the prompt
+type KeyMap struct {
+	NextEvent key.Binding
+	PrevEvent key.Binding
+	Submit    key.Binding
+	Cancel    key.Binding
+}
+
+// DefaultKeyMap returns the default keybindings
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		NextEvent: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "next review type"),
+		),
+		PrevEvent: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "previous review type"),
+		),
+		Submit: key.NewBinding(
+			key.WithKeys("ctrl+d"),
+			key.WithHelp("ctrl+d", "submit"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc", "ctrl+c"),
+			key.WithHelp("esc", "cancel"),
+		),
+	}
+}
+
+var Keys = DefaultKeyMap()
+
+// SubmittedMsg is sent when the review is submitted
+type SubmittedMsg struct {
+	Event data.ReviewEvent
+	Body  string
+}
+
+// CancelledMsg is sent when the prompt is cancelled
+type CancelledMsg struct{}
+
+// NewModel creates a new review prompt model
+func NewModel(ctx *context.ProgramContext) Model {
+	input := inputbox.NewModel(ctx)
+	input.SetHeight(8)
+	input.Blur()
+
+	return Model{
+		ctx:   ctx,
+		input: input,
+		width: 70,
+	}
+}
+
+// Open focuses the prompt with an empty body, reviewing the PR called title
+func (m *Model) Open(title string, event data.ReviewEvent) tea.Cmd {
+	m.focused = true
+	m.title = title
+	m.err = ""
+	m.event = 0
+	for i, e := range events {
+		if e == event {
+			m.event = i
+		}
+	}
+	m.input.Reset()
+	return m.input.Focus()
+}
+
+// Blur blurs the prompt
+func (m *Model) Blur() {
+	m.focused = false
+	m.input.Blur()
+}
+
+// Focused returns whether the prompt is focused
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+// HasDraft returns whether the prompt is open with a review body written
+func (m Model) HasDraft() bool {
+	return m.focused && strings.TrimSpace(m.input.Value()) != ""
+}
+
+// Event returns the selected review type
+func (m Model) Event() data.ReviewEvent {
+	return events[m.event]
+}
+
+// SetWidth sets the prompt width
+func (m *Model) SetWidth(w int) {
+	m.width = w
+	m.input.SetWidth(w - 6)
+}
+
+// Validate returns why the review can't be submitted, requesting changes and
+// commenting need a body
+func Validate(event data.ReviewEvent, body string) string {
+	if event != data.ReviewEventApprove && strings.TrimSpace(body) == "" {
+		return fmt.Sprintf("%s needs a review body", eventLabel(event))
+	}
+	return ""
+}
+
+// Update handles messages
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, Keys.NextEvent):
+			m.event = (m.event + 1) % len(events)
+			m.err = ""
+			return m, nil
+		case key.Matches(msg, Keys.PrevEvent):
+			m.event = (m.event + len(events) - 1) % len(events)
+			m.err = ""
+			return m, nil
+		case key.Matches(msg, Keys.Submit):
+			event := m.Event()
+			body := strings.TrimSpace(m.input.Value())
+			if m.err = Validate(event, body); m.err != "" {
+				return m, nil
+			}
+			m.Blur()
+			return m, func() tea.Msg {
+				return SubmittedMsg{Event: event, Body: body}
+			}
+		case key.Matches(msg, Keys.Cancel):
+			m.Blur()
+			return m, func() tea.Msg {
+				return CancelledMsg{}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// View renders the prompt
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Review %s", m.title)))
+	b.WriteString("\n\n")
+
+	tabs := make([]string, 0, len(events))
+	for i, event := range events {
+		if i == m.event {
+			tabs = append(tabs, lipgloss.NewStyle().
+				Foreground(m.ctx.Theme.PrimaryText).
+				Bold(true).
+				Underline(true).
+				Render(eventLabel(event)))
+		} else {
+			tabs = append(tabs, faintStyle.Render(eventLabel(event)))
+		}
+	}
+	b.WriteString(strings.Join(tabs, faintStyle.Render(" │ ")))
+	b.WriteString("\n")
+	b.WriteString(m.input.View())
+
+	if m.err != "" {
+		b.WriteString("\n")
+		b.WriteString(lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(m.err))
+	}
+
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	b.WriteString(helpStyle.Render("tab/shift+tab: change review type"))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width)
+
+	return boxStyle.Render(b.String())
+}
+
+// UpdateProgramContext updates the context
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+	m.input.UpdateProgramContext(ctx)
+}
@@ -0,0 +1,56 @@
+package reviewprompt
+
+import (
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   data.ReviewEvent
+		body    string
+		wantErr bool
+	}{
+		{
+			name:    "approve without a body",
+			event:   data.ReviewEventApprove,
+			body:    "",
+			wantErr: false,
+		},
+		{
+			name:    "approve with a body",
+			event:   data.ReviewEventApprove,
+			body:    "LGTM",
+			wantErr: false,
+		},
+		{
+			name:    "comment needs a body",
+			event:   data.ReviewEventComment,
+			body:    "  \n",
+			wantErr: true,
+		},
+		{
+			name:    "request changes needs a body",
+			event:   data.ReviewEventRequestChanges,
+			body:    "",
+			wantErr: true,
+		},
+		{
+			name:    "request changes with a body",
+			event:   data.ReviewEventRequestChanges,
+			body:    "Please add tests",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.event, tt.body)
+			if (err != "") != tt.wantErr {
+				t.Errorf("Validate() = %q, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package search
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+const (
+	// maxEditorWidth is the widest the editor gets on wide terminals
+	maxEditorWidth = 100
+	// minEditorLines and maxEditorLines bound the height of the editor, it
+	// scrolls past the max
+	minEditorLines = 3
+	maxEditorLines = 12
+)
+
+// EditorKeyMap defines keybindings for the query editor
+type EditorKeyMap struct {
+	Apply   key.Binding
+	Newline key.Binding
+	Cancel  key.Binding
+}
+
+var EditorKeys = EditorKeyMap{
+	Apply: key.NewBinding(
+		key.WithKeys("ctrl+d"),
+		key.WithHelp("ctrl+d", "apply"),
+	),
+	Newline: key.NewBinding(
+		key.WithKeys("enter", "ctrl+m"),
+		key.WithHelp("enter", "new line"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// queryEditor edits a long query over several lines, a qualifier per line,
+// lines wrap when a qualifier is wider than the editor
+type queryEditor struct {
+	ctx   *context.ProgramContext
+	input textarea.Model
+	open  bool
+}
+
+func newQueryEditor(ctx *context.ProgramContext) queryEditor {
+	ta := textarea.New()
+	ta.ShowLineNumbers = false
+	ta.Prompt = ""
+	ta.KeyMap.InsertNewline = EditorKeys.Newline
+	ta.FocusedStyle.Base = lipgloss.NewStyle()
+	ta.FocusedStyle.CursorLine = lipgloss.NewStyle()
+	ta.FocusedStyle.Text = lipgloss.NewStyle().Foreground(ctx.Theme.PrimaryText)
+	ta.FocusedStyle.EndOfBuffer = lipgloss.NewStyle().Foreground(ctx.Theme.FaintText)
+
+	return queryEditor{ctx: ctx, input: ta}
+}
+
+// Open shows the editor with query, a qualifier per line
+func (e *queryEditor) Open(query string) tea.Cmd {
+	e.open = true
+	lines := splitQualifiers(query)
+	e.resize(len(lines))
+	e.input.SetValue(strings.Join(lines, "\n"))
+	return e.input.Focus()
+}
+
+func (e *queryEditor) Close() {
+	e.open = false
+	e.input.Blur()
+}
+
+func (e *queryEditor) resize(lines int) {
+	e.input.SetWidth(e.width() - 6)
+	e.input.SetHeight(min(max(lines, minEditorLines), maxEditorLines))
+}
+
+func (e queryEditor) width() int {
+	return max(20, min(maxEditorWidth, e.ctx.MainContentWidth-8))
+}
+
+// Update handles a message, returning the edited query when it was applied
+func (e queryEditor) Update(msg tea.Msg) (queryEditor, string, bool, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(keyMsg, EditorKeys.Apply):
+			e.Close()
+			return e, joinQualifiers(e.input.Value()), true, nil
+		case key.Matches(keyMsg, EditorKeys.Cancel):
+			e.Close()
+			return e, "", false, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	e.input, cmd = e.input.Update(msg)
+	e.resize(e.input.LineCount())
+	return e, "", false, cmd
+}
+
+func (e queryEditor) View() string {
+	if !e.open {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(lipgloss.NewStyle().
+		Bold(true).
+		Foreground(e.ctx.Theme.PrimaryText).
+		Render("Edit Search"))
+	b.WriteString("\n\n")
+	b.WriteString(e.input.View())
+	b.WriteString("\n\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render(
+		"Enter: new line • Ctrl+d: apply • Esc: cancel"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(e.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(e.width()).
+		Render(b.String())
+}
+
+func (e *queryEditor) UpdateProgramContext(ctx *context.ProgramContext) {
+	e.ctx = ctx
+	if e.open {
+		e.resize(e.input.LineCount())
+	}
+}
+
+// splitQualifiers splits query on the spaces between its qualifiers, the
+// spaces of quoted values and of templates are kept, e.g. label:"good first
+// issue" or updated:>={{ nowModify "-2w" }}
+func splitQualifiers(query string) []string {
+	var qualifiers []string
+	var current strings.Builder
+	inQuotes, inTemplate := false, false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case !inQuotes && strings.HasPrefix(query[i:], "{{"):
+			inTemplate = true
+		case inTemplate && strings.HasPrefix(query[i:], "}}"):
+			inTemplate = false
+		case c == '"' && !inTemplate:
+			inQuotes = !inQuotes
+		case (c == ' ' || c == '\t' || c == '\n') && !inQuotes && !inTemplate:
+			if current.Len() > 0 {
+				qualifiers = append(qualifiers, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		current.WriteByte(c)
+	}
+	if current.Len() > 0 {
+		qualifiers = append(qualifiers, current.String())
+	}
+	return qualifiers
+}
+
+// joinQualifiers joins the lines of the editor back into a query, leaving
+// out the blank ones
+func joinQualifiers(value string) string {
+	var lines []string
+	for line := range strings.Lines(value) {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, " ")
+}
@@ -0,0 +1,220 @@
+package search
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/repopicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// maxVisibleQueries is how many queries the picker lists at once, the list
+// scrolls to keep the cursor in view
+const maxVisibleQueries = 10
+
+// PickerKeyMap defines keybindings for the history picker
+type PickerKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Cancel key.Binding
+}
+
+var PickerKeys = PickerKeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "ctrl+p"),
+		key.WithHelp("↑/ctrl+p", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "ctrl+n", "ctrl+r"),
+		key.WithHelp("↓/ctrl+n", "down"),
+	),
+	Select: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "select"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// historyPicker lists the previous searches, filtered fuzzily by what's typed
+type historyPicker struct {
+	ctx     *context.ProgramContext
+	input   textinput.Model
+	queries []string
+	matches []string
+	cursor  int
+	width   int
+	open    bool
+}
+
+func newHistoryPicker(ctx *context.ProgramContext) historyPicker {
+	ti := textinput.New()
+	ti.Placeholder = "type to filter"
+	ti.Prompt = "> "
+	ti.Width = 50
+
+	return historyPicker{
+		ctx:   ctx,
+		input: ti,
+		width: 60,
+	}
+}
+
+// Open shows the picker listing queries, newest first
+func (p *historyPicker) Open(queries []string) tea.Cmd {
+	p.open = true
+	p.queries = queries
+	p.input.SetValue("")
+	p.applyFilter()
+	return p.input.Focus()
+}
+
+func (p *historyPicker) Close() {
+	p.open = false
+	p.input.Blur()
+}
+
+func (p *historyPicker) applyFilter() {
+	p.matches = filterHistory(p.input.Value(), p.queries)
+	p.cursor = 0
+}
+
+// filterHistory returns the queries matching pattern, best match first.
+// Queries matching equally well keep their order, so recent ones come first.
+func filterHistory(pattern string, queries []string) []string {
+	type scored struct {
+		query string
+		score int
+	}
+
+	matches := make([]scored, 0, len(queries))
+	for _, q := range queries {
+		if score, ok := repopicker.FuzzyScore(pattern, q); ok {
+			matches = append(matches, scored{query: q, score: score})
+		}
+	}
+	if pattern != "" {
+		slices.SortStableFunc(matches, func(a, b scored) int {
+			return b.score - a.score
+		})
+	}
+
+	res := make([]string, 0, len(matches))
+	for _, m := range matches {
+		res = append(res, m.query)
+	}
+	return res
+}
+
+// Update handles a message, returning the picked query when one was selected
+func (p historyPicker) Update(msg tea.Msg) (historyPicker, string, bool, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		p.input, cmd = p.input.Update(msg)
+		return p, "", false, cmd
+	}
+
+	switch {
+	case key.Matches(keyMsg, PickerKeys.Up):
+		if p.cursor > 0 {
+			p.cursor--
+		}
+		return p, "", false, nil
+	case key.Matches(keyMsg, PickerKeys.Down):
+		if p.cursor < len(p.matches)-1 {
+			p.cursor++
+		}
+		return p, "", false, nil
+	case key.Matches(keyMsg, PickerKeys.Select):
+		p.Close()
+		if len(p.matches) == 0 {
+			return p, "", false, nil
+		}
+		return p, p.matches[p.cursor], true, nil
+	case key.Matches(keyMsg, PickerKeys.Cancel):
+		p.Close()
+		return p, "", false, nil
+	}
+
+	var cmd tea.Cmd
+	prev := p.input.Value()
+	p.input, cmd = p.input.Update(msg)
+	if p.input.Value() != prev {
+		p.applyFilter()
+	}
+	return p, "", false, cmd
+}
+
+func (p historyPicker) View() string {
+	if !p.open {
+		return ""
+	}
+
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(p.ctx.Theme.PrimaryText)
+	faintStyle := lipgloss.NewStyle().Foreground(p.ctx.Theme.FaintText)
+
+	b.WriteString(titleStyle.Render("Search History"))
+	b.WriteString("\n\n")
+	b.WriteString(p.input.View())
+	b.WriteString("\n\n")
+
+	start := 0
+	if p.cursor >= maxVisibleQueries {
+		start = p.cursor - maxVisibleQueries + 1
+	}
+	end := min(len(p.matches), start+maxVisibleQueries)
+
+	for i := start; i < end; i++ {
+		cursor := "  "
+		style := faintStyle
+		if i == p.cursor {
+			cursor = "> "
+			style = lipgloss.NewStyle().
+				Foreground(p.ctx.Theme.PrimaryText).
+				Bold(true)
+		}
+		b.WriteString(style.Render(cursor + p.matches[i]))
+		b.WriteString("\n")
+	}
+
+	switch {
+	case len(p.queries) == 0:
+		b.WriteString(faintStyle.Render("  No searches submitted yet"))
+		b.WriteString("\n")
+	case len(p.matches) == 0:
+		b.WriteString(faintStyle.Render("  No matching searches"))
+		b.WriteString("\n")
+	case len(p.matches) > end:
+		b.WriteString(faintStyle.Render(fmt.Sprintf("  … %d more", len(p.matches)-end)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render(
+		"type to filter • ↑/↓: navigate • Enter: select • Esc: cancel"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(p.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(p.width).
+		Render(b.String())
+}
+
+func (p *historyPicker) UpdateProgramContext(ctx *context.ProgramContext) {
+	p.ctx = ctx
+}
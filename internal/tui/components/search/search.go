@@ -3,9 +3,11 @@ package search
 import (
 	"fmt"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
 )
@@ -14,12 +16,55 @@ type Model struct {
 	ctx          *context.ProgramContext
 	initialValue string
 	textInput    textinput.Model
+	historyKey   string
+	// historyIdx is the history entry shown while cycling through it, -1
+	// while showing what was typed
+	historyIdx int
+	draft      string
+	picker     historyPicker
+	editor     queryEditor
+	// chip is shown after the input while a filter rewrites the search, e.g.
+	// a time slice
+	chip string
+	// warning is shown after the chip while the results may be stale, e.g.
+	// offline
+	warning string
 }
 
 type SearchOptions struct {
 	Prefix       string
 	InitialValue string
 	Placeholder  string
+	// HistoryKey groups the submitted queries, e.g. by section type. The
+	// history is disabled when it's empty.
+	HistoryKey string
+}
+
+// KeyMap defines keybindings for the search history and the query editor
+type KeyMap struct {
+	PrevQuery   key.Binding
+	NextQuery   key.Binding
+	OpenHistory key.Binding
+	EditQuery   key.Binding
+}
+
+var Keys = KeyMap{
+	PrevQuery: key.NewBinding(
+		key.WithKeys("up"),
+		key.WithHelp("↑", "previous search"),
+	),
+	NextQuery: key.NewBinding(
+		key.WithKeys("down"),
+		key.WithHelp("↓", "next search"),
+	),
+	OpenHistory: key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "search history"),
+	),
+	EditQuery: key.NewBinding(
+		key.WithKeys("ctrl+o"),
+		key.WithHelp("ctrl+o", "edit in lines"),
+	),
 }
 
 func NewModel(ctx *context.ProgramContext, opts SearchOptions) Model {
@@ -41,6 +86,10 @@ func NewModel(ctx *context.ProgramContext, opts SearchOptions) Model {
 		ctx:          ctx,
 		textInput:    ti,
 		initialValue: opts.InitialValue,
+		historyKey:   opts.HistoryKey,
+		historyIdx:   -1,
+		picker:       newHistoryPicker(ctx),
+		editor:       newQueryEditor(ctx),
 	}
 }
 
@@ -51,26 +100,115 @@ func (m Model) Init() tea.Cmd {
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	var cmd tea.Cmd
 
+	if m.picker.open {
+		var selected string
+		var ok bool
+		m.picker, selected, ok, cmd = m.picker.Update(msg)
+		if ok {
+			m.setValueFromHistory(selected)
+		}
+		return m, cmd
+	}
+
+	if m.editor.open {
+		var edited string
+		var ok bool
+		m.editor, edited, ok, cmd = m.editor.Update(msg)
+		if ok {
+			m.historyIdx = -1
+			m.textInput.SetValue(edited)
+			m.textInput.CursorEnd()
+		}
+		return m, cmd
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.textInput.Focused() &&
+		key.Matches(keyMsg, Keys.EditQuery) {
+		return m, m.editor.Open(m.textInput.Value())
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.textInput.Focused() && m.historyKey != "" {
+		switch {
+		case key.Matches(keyMsg, Keys.PrevQuery):
+			m.cycleHistory(1)
+			return m, nil
+		case key.Matches(keyMsg, Keys.NextQuery):
+			m.cycleHistory(-1)
+			return m, nil
+		case key.Matches(keyMsg, Keys.OpenHistory):
+			return m, m.picker.Open(m.history())
+		default:
+			// editing a recalled query makes it the new draft
+			m.historyIdx = -1
+		}
+	}
+
 	m.textInput.Width = m.getInputWidth(m.ctx)
 	m.textInput, cmd = m.textInput.Update(msg)
 	return m, cmd
 }
 
 func (m Model) View(ctx *context.ProgramContext) string {
+	input := m.textInput.View()
+	if m.chip != "" {
+		input = lipgloss.JoinHorizontal(lipgloss.Top, input, " ", m.chipView())
+	}
+	if m.warning != "" {
+		input = lipgloss.JoinHorizontal(lipgloss.Top, input, " ", m.warningView())
+	}
 	return lipgloss.NewStyle().
 		Width(ctx.MainContentWidth - 4).
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(m.ctx.Theme.PrimaryBorder).
-		Render(m.textInput.View())
+		Render(input)
+}
+
+func (m Model) chipView() string {
+	return lipgloss.NewStyle().
+		Foreground(m.ctx.Theme.PrimaryText).
+		Background(m.ctx.Theme.SelectedBackground).
+		Padding(0, 1).
+		Render(m.chip)
+}
+
+func (m Model) warningView() string {
+	return lipgloss.NewStyle().
+		Foreground(m.ctx.Theme.WarningText).
+		Background(m.ctx.Theme.SelectedBackground).
+		Padding(0, 1).
+		Render(m.warning)
+}
+
+// SetChip shows chip after the input, nothing when it's empty
+func (m *Model) SetChip(chip string) {
+	m.chip = chip
+	if m.ctx != nil {
+		m.textInput.Width = m.getInputWidth(m.ctx)
+	}
+}
+
+// SetWarning shows warning after the chip, nothing when it's empty
+func (m *Model) SetWarning(warning string) {
+	if warning == m.warning {
+		return
+	}
+	m.warning = warning
+	if m.ctx != nil {
+		m.textInput.Width = m.getInputWidth(m.ctx)
+	}
 }
 
 func (m *Model) Focus() {
+	m.historyIdx = -1
 	m.textInput.TextStyle = m.textInput.TextStyle.Faint(false)
 	m.textInput.CursorEnd()
 	m.textInput.Focus()
 }
 
 func (m *Model) Blur() {
+	m.picker.Close()
+	m.editor.Close()
+	m.historyIdx = -1
 	m.textInput.TextStyle = m.textInput.TextStyle.Faint(true)
 	m.textInput.CursorStart()
 	m.textInput.Blur()
@@ -81,6 +219,9 @@ func (m *Model) SetValue(val string) {
 }
 
 func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+	m.picker.UpdateProgramContext(ctx)
+	m.editor.UpdateProgramContext(ctx)
 	oldWidth := m.textInput.Width
 	m.textInput.Width = m.getInputWidth(ctx)
 	if m.textInput.Width != oldWidth {
@@ -93,9 +234,82 @@ func (m *Model) getInputWidth(ctx *context.ProgramContext) int {
 	// - deduce 4 - 2 for the padding, 2 for the borders
 	// - deduce 1 for the cursor
 	// - deduce 1 for the spacing between the prompt and text
-	return max(2, ctx.MainContentWidth-lipgloss.Width(m.textInput.Prompt)-4-1-1) // borders + cursor
+	// - deduce the chip and the warning, and the space before them
+	chipWidth := 0
+	for _, chip := range []string{m.chip, m.warning} {
+		if chip != "" {
+			chipWidth += lipgloss.Width(chip) + 2 + 1
+		}
+	}
+	return max(2, ctx.MainContentWidth-lipgloss.Width(m.textInput.Prompt)-4-1-1-chipWidth) // borders + cursor
 }
 
 func (m Model) Value() string {
 	return m.textInput.Value()
 }
+
+func (m Model) history() []string {
+	if m.ctx.SearchHistory == nil || m.historyKey == "" {
+		return nil
+	}
+	return m.ctx.SearchHistory.Get(m.historyKey)
+}
+
+// cycleHistory moves by delta entries through the history, positive deltas
+// go back to older searches
+func (m *Model) cycleHistory(delta int) {
+	queries := m.history()
+	idx := m.historyIdx + delta
+	if idx < -1 || idx >= len(queries) {
+		return
+	}
+
+	if m.historyIdx == -1 {
+		m.draft = m.textInput.Value()
+	}
+	m.historyIdx = idx
+	if idx == -1 {
+		m.textInput.SetValue(m.draft)
+	} else {
+		m.textInput.SetValue(queries[idx])
+	}
+	m.textInput.CursorEnd()
+}
+
+func (m *Model) setValueFromHistory(query string) {
+	m.historyIdx = -1
+	m.textInput.SetValue(query)
+	m.textInput.CursorEnd()
+}
+
+// AddToHistory records a submitted query and saves the history in the
+// background
+func (m *Model) AddToHistory(query string) tea.Cmd {
+	h := m.ctx.SearchHistory
+	if h == nil || m.historyKey == "" {
+		return nil
+	}
+
+	h.Add(m.historyKey, query)
+	return func() tea.Msg {
+		if err := h.Save(); err != nil {
+			log.Error("Failed saving search history", "err", err)
+		}
+		return nil
+	}
+}
+
+// IsOverlayOpen returns whether the history picker or the query editor is
+// open, they capture every key until they're closed
+func (m Model) IsOverlayOpen() bool {
+	return m.picker.open || m.editor.open
+}
+
+// OverlayView renders the history picker or the query editor, whichever is
+// open
+func (m Model) OverlayView() string {
+	if m.editor.open {
+		return m.editor.View()
+	}
+	return m.picker.View()
+}
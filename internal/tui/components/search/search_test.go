@@ -0,0 +1,165 @@
+package search
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+func TestFilterHistory(t *testing.T) {
+	queries := []string{"is:open author:@me", "label:bug", "is:closed", "review-requested:@me"}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+	}{
+		{
+			name:    "empty pattern keeps the order",
+			pattern: "",
+			want:    queries,
+		},
+		{
+			name:    "fuzzy match",
+			pattern: "bug",
+			want:    []string{"label:bug"},
+		},
+		{
+			name:    "best match first",
+			pattern: "@me",
+			want:    []string{"is:open author:@me", "review-requested:@me"},
+		},
+		{
+			name:    "no matches",
+			pattern: "xyz",
+			want:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filterHistory(tt.pattern, queries); !slices.Equal(got, tt.want) {
+				t.Errorf("filterHistory() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycleHistory(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []tea.KeyType
+		want string
+	}{
+		{
+			name: "up recalls the last search",
+			keys: []tea.KeyType{tea.KeyUp},
+			want: "label:bug",
+		},
+		{
+			name: "up again goes further back",
+			keys: []tea.KeyType{tea.KeyUp, tea.KeyUp},
+			want: "is:open",
+		},
+		{
+			name: "stops at the oldest search",
+			keys: []tea.KeyType{tea.KeyUp, tea.KeyUp, tea.KeyUp},
+			want: "is:open",
+		},
+		{
+			name: "down goes back to what was typed",
+			keys: []tea.KeyType{tea.KeyUp, tea.KeyUp, tea.KeyDown, tea.KeyDown},
+			want: "draft",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := state.NewSearchHistory(t.TempDir())
+			history.Add("pr", "is:open")
+			history.Add("pr", "label:bug")
+			ctx := &context.ProgramContext{Theme: *theme.DefaultTheme, SearchHistory: history}
+
+			m := NewModel(ctx, SearchOptions{Prefix: "is:pr", InitialValue: "draft", HistoryKey: "pr"})
+			m.Focus()
+			for _, k := range tt.keys {
+				m, _ = m.Update(tea.KeyMsg{Type: k})
+			}
+			if got := m.Value(); got != tt.want {
+				t.Errorf("Value() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitQualifiers(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{
+			name:  "one per qualifier",
+			query: "is:open  author:@me -label:wip",
+			want:  []string{"is:open", "author:@me", "-label:wip"},
+		},
+		{
+			name:  "quoted values",
+			query: `is:open label:"good first issue" repo:cli/cli`,
+			want:  []string{"is:open", `label:"good first issue"`, "repo:cli/cli"},
+		},
+		{
+			name:  "templates",
+			query: `updated:>={{ nowModify "-2w" }} is:open`,
+			want:  []string{`updated:>={{ nowModify "-2w" }}`, "is:open"},
+		},
+		{
+			name:  "empty",
+			query: " ",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitQualifiers(tt.query)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("splitQualifiers() = %q, want %q", got, tt.want)
+			}
+			if joined := joinQualifiers(strings.Join(got, "\n\n")); joined != strings.Join(tt.want, " ") {
+				t.Errorf("joinQualifiers() = %q, want %q", joined, strings.Join(tt.want, " "))
+			}
+		})
+	}
+}
+
+func TestEditQuery(t *testing.T) {
+	ctx := &context.ProgramContext{Theme: *theme.DefaultTheme, MainContentWidth: 80}
+	m := NewModel(ctx, SearchOptions{Prefix: "is:pr", InitialValue: "is:open author:@me"})
+	m.Focus()
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if !m.IsOverlayOpen() {
+		t.Fatal("ctrl+o didn't open the editor")
+	}
+	for _, msg := range []tea.KeyMsg{
+		{Type: tea.KeyEnter},
+		{Type: tea.KeyRunes, Runes: []rune("label:bug")},
+		{Type: tea.KeyCtrlD},
+	} {
+		m, _ = m.Update(msg)
+	}
+
+	if m.IsOverlayOpen() {
+		t.Error("ctrl+d didn't close the editor")
+	}
+	if got, want := m.Value(), "is:open author:@me label:bug"; got != want {
+		t.Errorf("Value() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,159 @@
+package section
+
+import (
+	"text/template"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// computedErrorValue is shown in the cells of computed columns whose
+// template fails, the error is logged
+const computedErrorValue = "!"
+
+// computedKey identifies the version of a row the values of the computed
+// columns were computed for
+type computedKey struct {
+	url       string
+	updatedAt time.Time
+}
+
+// ComputedColumns returns the columns of the computed columns, they come after
+// the project field columns
+func ComputedColumns(columns []config.ComputedColumn) []table.Column {
+	tableColumns := make([]table.Column, 0, len(columns))
+	for _, c := range columns {
+		width := c.Width
+		if width == nil {
+			width = utils.IntPtr(max(lipgloss.Width(c.Title)+2, minProjectColumnWidth))
+		}
+		tableColumns = append(tableColumns, table.Column{
+			Title: c.Title,
+			Width: width,
+			Align: columnAlign(c.Align),
+		})
+	}
+	return tableColumns
+}
+
+func columnAlign(align string) lipgloss.Position {
+	switch align {
+	case "center":
+		return lipgloss.Center
+	case "right":
+		return lipgloss.Right
+	}
+	return lipgloss.Left
+}
+
+// parseComputedTemplates parses the templates of the computed columns, the
+// ones that fail parsing are nil
+func parseComputedTemplates(sectionId int, columns []config.ComputedColumn) []*template.Template {
+	templates := make([]*template.Template, 0, len(columns))
+	for _, c := range columns {
+		tmpl, err := utils.ParseColumnTemplate(c.Title, c.Template)
+		if err != nil {
+			log.Error("Failed parsing computed column template", "section", sectionId,
+				"column", c.Title, "err", err)
+			tmpl = nil
+		}
+		templates = append(templates, tmpl)
+	}
+	return templates
+}
+
+// parseComputedColors parses the color templates of the computed columns, the
+// ones that are empty or fail parsing are nil
+func parseComputedColors(sectionId int, columns []config.ComputedColumn) []*template.Template {
+	templates := make([]*template.Template, 0, len(columns))
+	for _, c := range columns {
+		var tmpl *template.Template
+		if c.Color != "" {
+			var err error
+			tmpl, err = utils.ParseColumnTemplate(c.Title, c.Color)
+			if err != nil {
+				log.Error("Failed parsing computed column color", "section", sectionId,
+					"column", c.Title, "err", err)
+				tmpl = nil
+			}
+		}
+		templates = append(templates, tmpl)
+	}
+	return templates
+}
+
+// ComputedValues returns the values of the computed columns of the row with
+// url, in the order of their columns
+func (m *BaseModel) ComputedValues(
+	url string,
+	updatedAt time.Time,
+	fields func() map[string]any,
+) []string {
+	cells := m.ComputedCells(url, updatedAt, fields)
+	values := make([]string, 0, len(cells))
+	for _, cell := range cells {
+		values = append(values, cell.Value)
+	}
+	return values
+}
+
+// ComputedCells returns the values of the computed columns of the row with
+// url with their colors, in the order of their columns. The templates are
+// executed with the row's fields plus Mine, whether the current user authored
+// the row. The cells are cached until the row is updated or the rows are
+// refetched.
+func (m *BaseModel) ComputedCells(
+	url string,
+	updatedAt time.Time,
+	fields func() map[string]any,
+) []table.Cell {
+	if len(m.computedTemplates) == 0 {
+		return nil
+	}
+
+	key := computedKey{url: url, updatedAt: updatedAt}
+	if cells, ok := m.computedValues[key]; ok {
+		return cells
+	}
+
+	rowFields := fields()
+	rowFields["Mine"] = m.Ctx.User != "" && rowFields["Author"] == m.Ctx.User
+	cells := make([]table.Cell, 0, len(m.computedTemplates))
+	for i, tmpl := range m.computedTemplates {
+		if tmpl == nil {
+			cells = append(cells, table.Cell{Value: computedErrorValue})
+			continue
+		}
+		value, err := utils.ExecuteColumnTemplate(tmpl, rowFields)
+		if err != nil {
+			log.Error("Failed executing computed column template", "section", m.Id,
+				"column", tmpl.Name(), "url", url, "err", err)
+			value = computedErrorValue
+		}
+		cell := table.Cell{Value: value}
+		if i < len(m.computedColors) && m.computedColors[i] != nil {
+			// a color that fails is logged and the cell keeps the row's color
+			cell.Color, err = utils.ExecuteColumnTemplate(m.computedColors[i], rowFields)
+			if err != nil {
+				log.Error("Failed executing computed column color", "section", m.Id,
+					"column", tmpl.Name(), "url", url, "err", err)
+			}
+		}
+		cells = append(cells, cell)
+	}
+	m.computedValues[key] = cells
+	return cells
+}
+
+// ResetComputedValues drops the cached values of the computed columns and of
+// the sort, e.g. when the rows are refetched so that the values depending on
+// the time are recomputed
+func (m *BaseModel) ResetComputedValues() {
+	clear(m.computedValues)
+	clear(m.sortValues)
+}
@@ -0,0 +1,60 @@
+package section
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func TestComputedCells(t *testing.T) {
+	columns := []config.ComputedColumn{
+		{Title: "Comments", Template: "{{ .Comments }}", Color: "#ff0000"},
+		{
+			Title:    "Busy",
+			Template: "{{ if .Mine }}mine{{ end }}",
+			Color:    `{{ if gt .Comments 3 }}1{{ end }}`,
+		},
+		{Title: "Broken", Template: "{{ .Comments ", Color: "#00ff00"},
+	}
+	m := BaseModel{
+		Ctx:               &context.ProgramContext{User: "dlvhdr"},
+		computedTemplates: parseComputedTemplates(0, columns),
+		computedColors:    parseComputedColors(0, columns),
+		computedValues:    map[computedKey][]table.Cell{},
+	}
+
+	issue := testIssue(1, 5, 0, time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC))
+	issue.Author.Login = "dlvhdr"
+	got := m.ComputedCells(issue.Url, issue.UpdatedAt, issue.ColumnFields)
+	want := []table.Cell{
+		{Value: "5", Color: "#ff0000"},
+		{Value: "mine", Color: "1"},
+		{Value: computedErrorValue},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("ComputedCells() = %v, want %v", got, want)
+	}
+	if values := m.ComputedValues(issue.Url, issue.UpdatedAt, issue.ColumnFields); !slices.Equal(
+		values, []string{"5", "mine", computedErrorValue}) {
+		t.Errorf("ComputedValues() = %v, want the values of the cells", values)
+	}
+}
+
+func TestComputedColumnsAlign(t *testing.T) {
+	columns := ComputedColumns([]config.ComputedColumn{
+		{Title: "Size", Align: "right"},
+		{Title: "Risk", Align: "center"},
+		{Title: "Age"},
+	})
+	var got []float64
+	for _, c := range columns {
+		got = append(got, float64(c.Align))
+	}
+	if want := []float64{1, 0.5, 0}; !slices.Equal(got, want) {
+		t.Errorf("ComputedColumns() aligns = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package section
+
+import (
+	"slices"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/export"
+)
+
+// Exporter is implemented by the sections whose rows can be exported
+type Exporter interface {
+	ExportRows() export.Table
+}
+
+// ExportColumns returns the columns of the exported rows: the fields of the
+// rows, followed by the section's project field and computed columns
+func (m *BaseModel) ExportColumns(fields []string) []string {
+	columns := slices.Clone(fields)
+	columns = append(columns, m.Config.ProjectFields...)
+	for _, c := range m.Config.ComputedColumns {
+		columns = append(columns, c.Title)
+	}
+	return columns
+}
+
+// ExportRow returns the fields of the row with url for exporting, with the
+// values of the section's project field and computed columns
+func (m *BaseModel) ExportRow(
+	url string,
+	updatedAt time.Time,
+	fields func() map[string]any,
+) map[string]any {
+	row := fields()
+	for i, value := range m.ProjectFieldValues(url) {
+		row[m.Config.ProjectFields[i]] = value
+	}
+	for i, value := range m.ComputedValues(url, updatedAt, fields) {
+		row[m.Config.ComputedColumns[i].Title] = value
+	}
+	return row
+}
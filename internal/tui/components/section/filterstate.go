@@ -0,0 +1,286 @@
+package section
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// FilterState is the repo and author filtering of a section. Its methods are
+// pure, they return the next state instead of changing it, so the transitions
+// can be tested without a program context.
+type FilterState struct {
+	Target FilterTarget
+	// Remote is the remote filtered by when Target is FilterTargetRemote
+	Remote string
+	// CustomRepo is a repo filter picked by hand, it overrides Target
+	CustomRepo string
+	// IsFiltered mirrors whether a repo filter is applied, it's kept for the
+	// smart filtering toggle
+	IsFiltered bool
+	// IsAuthorRemoved drops author:@me from the search
+	IsAuthorRemoved bool
+}
+
+// FilterEnv is what the search query depends on besides the state
+type FilterEnv struct {
+	// Remotes are the repos of the git remotes, origin first, upstream second
+	// and the others by remote name
+	Remotes []context.RemoteRepo
+	// HasRepoInConfig is set when the configured filters already name a repo,
+	// smart filtering is then disabled
+	HasRepoInConfig bool
+	IsGitHub        bool
+}
+
+func (env FilterEnv) remote(name string) (context.RemoteRepo, bool) {
+	i := slices.IndexFunc(env.Remotes, func(remote context.RemoteRepo) bool {
+		return remote.Remote == name
+	})
+	if i < 0 {
+		return context.RemoteRepo{}, false
+	}
+	return env.Remotes[i], true
+}
+
+// TargetRepo returns the repo of the target as owner/name, if its remote
+// exists
+func (s FilterState) TargetRepo(env FilterEnv) (string, bool) {
+	var name string
+	switch s.Target {
+	case FilterTargetOrigin:
+		name = "origin"
+	case FilterTargetUpstream:
+		name = "upstream"
+	case FilterTargetRemote:
+		name = s.Remote
+	default:
+		return "", false
+	}
+	if remote, ok := env.remote(name); ok {
+		return remote.NameWithOwner(), true
+	}
+	return "", false
+}
+
+// Query derives the query sent to GitHub from the search typed in the search
+// bar
+func (s FilterState) Query(env FilterEnv, search string) string {
+	if !env.IsGitHub {
+		return search
+	}
+
+	// A repo picked by hand wins over everything else
+	if s.CustomRepo != "" {
+		return s.withRepo(search, s.CustomRepo)
+	}
+
+	origin, hasOrigin := env.remote("origin")
+	if !hasOrigin || env.HasRepoInConfig || s.hasManualRepo(env, search) {
+		return s.withoutAuthor(search)
+	}
+
+	repo, ok := s.TargetRepo(env)
+	if !ok && s.Target != FilterTargetNone {
+		// The upstream or the remote is gone, fall back to origin
+		repo = origin.NameWithOwner()
+	}
+	rest := StripRepoFilterTokens(search)
+	if repo == "" {
+		return s.withoutAuthor(rest)
+	}
+	return s.withoutAuthor(fmt.Sprintf("repo:%s %s", repo, rest))
+}
+
+// hasManualRepo returns whether the first repo token of search was typed by
+// the user rather than added for the target
+func (s FilterState) hasManualRepo(env FilterEnv, search string) bool {
+	repo, ok := getRepoFilterTokenValue(search)
+	if !ok {
+		return false
+	}
+	if _, hasOrigin := env.remote("origin"); !hasOrigin {
+		return true
+	}
+	targetRepo, ok := s.TargetRepo(env)
+	return !ok || repo != targetRepo
+}
+
+// withRepo replaces the repo tokens of search with repo
+func (s FilterState) withRepo(search, repo string) string {
+	rest := StripRepoFilterTokens(search)
+	switch {
+	case repo == "":
+		return s.withoutAuthor(rest)
+	case rest == "":
+		return s.withoutAuthor("repo:" + repo)
+	default:
+		return s.withoutAuthor(fmt.Sprintf("repo:%s %s", repo, rest))
+	}
+}
+
+// withoutAuthor removes author:@me from search when the author filter is
+// removed
+func (s FilterState) withoutAuthor(search string) string {
+	if !s.IsAuthorRemoved {
+		return search
+	}
+	var tokens []string
+	for token := range strings.FieldsSeq(search) {
+		if token != "author:@me" {
+			tokens = append(tokens, token)
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// withRemote targets the repo of remote
+func (s FilterState) withRemote(remote string) FilterState {
+	s.Remote = ""
+	switch remote {
+	case "origin":
+		s.Target = FilterTargetOrigin
+	case "upstream":
+		s.Target = FilterTargetUpstream
+	default:
+		s.Target = FilterTargetRemote
+		s.Remote = remote
+	}
+	return s
+}
+
+// isTarget returns whether remote is the one targeted
+func (s FilterState) isTarget(remote string) bool {
+	switch s.Target {
+	case FilterTargetOrigin:
+		return remote == "origin"
+	case FilterTargetUpstream:
+		return remote == "upstream"
+	case FilterTargetRemote:
+		return remote == s.Remote
+	}
+	return false
+}
+
+// Toggle cycles through the repos of the remotes and then no repo filter:
+// Origin -> Upstream -> other remotes -> None -> Origin
+func (s FilterState) Toggle(env FilterEnv) FilterState {
+	if env.HasRepoInConfig || !env.IsGitHub {
+		return s
+	}
+
+	current := slices.IndexFunc(env.Remotes, func(remote context.RemoteRepo) bool {
+		return s.isTarget(remote.Remote)
+	})
+	switch {
+	case s.Target == FilterTargetNone:
+		s.Target = FilterTargetOrigin
+	case current+1 < len(env.Remotes):
+		s = s.withRemote(env.Remotes[current+1].Remote)
+	default:
+		s.Target = FilterTargetNone
+		s.Remote = ""
+	}
+	s.IsFiltered = s.Target != FilterTargetNone
+	return s
+}
+
+// SelectRepo filters by repo, through the first remote whose repo it is or
+// else as a custom repo. An empty repo removes the repo filter.
+func (s FilterState) SelectRepo(env FilterEnv, repo string) FilterState {
+	if repo == "" {
+		s.CustomRepo = ""
+		s.Target = FilterTargetNone
+		s.Remote = ""
+		s.IsFiltered = false
+		return s
+	}
+
+	for _, remote := range env.Remotes {
+		if remote.NameWithOwner() == repo {
+			s = s.withRemote(remote.Remote)
+			s.CustomRepo = ""
+			s.IsFiltered = true
+			return s
+		}
+	}
+
+	s.CustomRepo = repo
+	s.Target = FilterTargetNone
+	s.Remote = ""
+	s.IsFiltered = true
+	return s
+}
+
+// SyncFromSearch makes the state match the first repo token of search, the
+// search bar being the source of truth
+func (s FilterState) SyncFromSearch(env FilterEnv, search string) FilterState {
+	repo, _ := getRepoFilterTokenValue(search)
+	return s.SelectRepo(env, repo)
+}
+
+// WithCustomRepo overrides the target with repo, an empty repo only clears
+// the custom repo
+func (s FilterState) WithCustomRepo(repo string) FilterState {
+	s.CustomRepo = repo
+	if repo != "" {
+		s.Target = FilterTargetNone
+		s.Remote = ""
+		s.IsFiltered = true
+	}
+	return s
+}
+
+// ToggleAuthor toggles whether author:@me is dropped from the search
+func (s FilterState) ToggleAuthor() FilterState {
+	s.IsAuthorRemoved = !s.IsAuthorRemoved
+	return s
+}
+
+// Label is a short description of the repo filtered by
+func (s FilterState) Label(env FilterEnv) string {
+	if s.CustomRepo != "" {
+		return s.CustomRepo
+	}
+	if repo, ok := s.TargetRepo(env); ok {
+		return repo
+	}
+	switch s.Target {
+	case FilterTargetOrigin:
+		return "origin"
+	case FilterTargetUpstream:
+		return "upstream"
+	case FilterTargetRemote:
+		return s.Remote
+	default:
+		return "all"
+	}
+}
+
+func (t FilterTarget) String() string {
+	switch t {
+	case FilterTargetOrigin:
+		return "origin"
+	case FilterTargetUpstream:
+		return "upstream"
+	case FilterTargetNone:
+		return "none"
+	case FilterTargetRemote:
+		return "remote"
+	default:
+		return fmt.Sprintf("FilterTarget(%d)", int(t))
+	}
+}
+
+// FilterDebug is the filter state of a section along with what it derives,
+// for the filters debug overlay
+type FilterDebug struct {
+	State FilterState
+	Env   FilterEnv
+	// Search is the search typed in the search bar
+	Search string
+	// Query is the query derived from it
+	Query string
+}
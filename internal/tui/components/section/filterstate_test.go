@@ -0,0 +1,290 @@
+package section
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+var testRemotes = []context.RemoteRepo{
+	{Remote: "origin", Owner: "me", Name: "gh-dash"},
+	{Remote: "upstream", Owner: "dlvhdr", Name: "gh-dash"},
+	{Remote: "alice", Owner: "alice", Name: "gh-dash"},
+}
+
+var testEnv = FilterEnv{Remotes: testRemotes, IsGitHub: true}
+
+// testStates are states of every target, custom repo and author filter
+func testStates() []FilterState {
+	var states []FilterState
+	for _, author := range []bool{false, true} {
+		for _, s := range []FilterState{
+			{Target: FilterTargetNone},
+			{Target: FilterTargetOrigin, IsFiltered: true},
+			{Target: FilterTargetUpstream, IsFiltered: true},
+			{Target: FilterTargetRemote, Remote: "alice", IsFiltered: true},
+			{Target: FilterTargetRemote, Remote: "removed", IsFiltered: true},
+			{Target: FilterTargetNone, CustomRepo: "cli/cli", IsFiltered: true},
+		} {
+			s.IsAuthorRemoved = author
+			states = append(states, s)
+		}
+	}
+	return states
+}
+
+var testSearches = []string{
+	"",
+	"is:open",
+	"is:open author:@me",
+	"repo:me/gh-dash is:open",
+	"repo:dlvhdr/gh-dash author:@me is:open",
+	"repo:alice/gh-dash",
+	"is:open repo:cli/cli repo:me/gh-dash",
+	"repo: is:open",
+	"  is:open   author:@me  ",
+}
+
+func TestFilterStateQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		state  FilterState
+		env    FilterEnv
+		search string
+		want   string
+	}{
+		{
+			name:   "not GitHub",
+			state:  FilterState{Target: FilterTargetOrigin, IsAuthorRemoved: true},
+			env:    FilterEnv{Remotes: testRemotes},
+			search: "is:open author:@me",
+			want:   "is:open author:@me",
+		},
+		{
+			name:   "origin",
+			state:  FilterState{Target: FilterTargetOrigin},
+			env:    testEnv,
+			search: "is:open",
+			want:   "repo:me/gh-dash is:open",
+		},
+		{
+			name:   "upstream replaces origin",
+			state:  FilterState{Target: FilterTargetUpstream},
+			env:    testEnv,
+			search: "repo:me/gh-dash is:open",
+			want:   "repo:me/gh-dash is:open",
+		},
+		{
+			name:   "upstream",
+			state:  FilterState{Target: FilterTargetUpstream},
+			env:    testEnv,
+			search: "repo:dlvhdr/gh-dash is:open",
+			want:   "repo:dlvhdr/gh-dash is:open",
+		},
+		{
+			name:   "upstream without an upstream remote",
+			state:  FilterState{Target: FilterTargetUpstream},
+			env:    FilterEnv{Remotes: testRemotes[:1], IsGitHub: true},
+			search: "is:open",
+			want:   "repo:me/gh-dash is:open",
+		},
+		{
+			name:   "another remote",
+			state:  FilterState{Target: FilterTargetRemote, Remote: "alice"},
+			env:    testEnv,
+			search: "is:open",
+			want:   "repo:alice/gh-dash is:open",
+		},
+		{
+			name:   "removed remote",
+			state:  FilterState{Target: FilterTargetRemote, Remote: "bob"},
+			env:    testEnv,
+			search: "is:open",
+			want:   "repo:me/gh-dash is:open",
+		},
+		{
+			name:   "no target",
+			state:  FilterState{Target: FilterTargetNone},
+			env:    testEnv,
+			search: "is:open",
+			want:   "is:open",
+		},
+		{
+			name:   "manual repo",
+			state:  FilterState{Target: FilterTargetOrigin},
+			env:    testEnv,
+			search: "repo:cli/cli is:open",
+			want:   "repo:cli/cli is:open",
+		},
+		{
+			name:   "custom repo",
+			state:  FilterState{Target: FilterTargetNone, CustomRepo: "cli/cli"},
+			env:    testEnv,
+			search: "repo:me/gh-dash is:open",
+			want:   "repo:cli/cli is:open",
+		},
+		{
+			name:   "custom repo alone",
+			state:  FilterState{Target: FilterTargetNone, CustomRepo: "cli/cli"},
+			env:    testEnv,
+			search: "",
+			want:   "repo:cli/cli",
+		},
+		{
+			name:   "repo in config",
+			state:  FilterState{Target: FilterTargetOrigin},
+			env:    FilterEnv{Remotes: testRemotes, HasRepoInConfig: true, IsGitHub: true},
+			search: "is:open",
+			want:   "is:open",
+		},
+		{
+			name:   "no origin",
+			state:  FilterState{Target: FilterTargetOrigin, IsAuthorRemoved: true},
+			env:    FilterEnv{IsGitHub: true},
+			search: "is:open author:@me",
+			want:   "is:open",
+		},
+		{
+			name:   "author removed",
+			state:  FilterState{Target: FilterTargetOrigin, IsAuthorRemoved: true},
+			env:    testEnv,
+			search: "author:@me is:open",
+			want:   "repo:me/gh-dash is:open",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.state.Query(tt.env, tt.search); got != tt.want {
+				t.Errorf("Query(%q) = %q, want %q", tt.search, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterStateToggle(t *testing.T) {
+	s := FilterState{Target: FilterTargetNone}
+	want := []string{"me/gh-dash", "dlvhdr/gh-dash", "alice/gh-dash", "all", "me/gh-dash"}
+	for _, label := range want {
+		s = s.Toggle(testEnv)
+		if got := s.Label(testEnv); got != label {
+			t.Fatalf("Label() after Toggle() = %q, want %q", got, label)
+		}
+		if s.IsFiltered != (s.Target != FilterTargetNone) {
+			t.Errorf("IsFiltered = %v with target %v", s.IsFiltered, s.Target)
+		}
+	}
+
+	configured := FilterEnv{Remotes: testRemotes, HasRepoInConfig: true, IsGitHub: true}
+	if got := s.Toggle(configured); got != s {
+		t.Errorf("Toggle() with a repo in config = %+v, want %+v", got, s)
+	}
+}
+
+func TestFilterStateSelectRepo(t *testing.T) {
+	tests := []struct {
+		repo string
+		want FilterState
+	}{
+		{repo: "", want: FilterState{Target: FilterTargetNone}},
+		{repo: "me/gh-dash", want: FilterState{Target: FilterTargetOrigin, IsFiltered: true}},
+		{repo: "alice/gh-dash", want: FilterState{Target: FilterTargetRemote, Remote: "alice", IsFiltered: true}},
+		{repo: "cli/cli", want: FilterState{Target: FilterTargetNone, CustomRepo: "cli/cli", IsFiltered: true}},
+	}
+	for _, tt := range tests {
+		for _, s := range testStates() {
+			tt.want.IsAuthorRemoved = s.IsAuthorRemoved
+			if got := s.SelectRepo(testEnv, tt.repo); got != tt.want {
+				t.Errorf("%+v.SelectRepo(%q) = %+v, want %+v", s, tt.repo, got, tt.want)
+			}
+		}
+	}
+}
+
+// The properties below hold for every state and search, they're checked
+// exhaustively here and fuzzed below
+
+func TestFilterStateQueryIsIdempotent(t *testing.T) {
+	for _, s := range testStates() {
+		for _, search := range testSearches {
+			checkQueryIsIdempotent(t, s, search)
+		}
+	}
+}
+
+func TestFilterStateSyncRoundTrip(t *testing.T) {
+	for _, s := range testStates() {
+		for _, search := range testSearches {
+			checkSyncRoundTrip(t, s, search)
+		}
+	}
+}
+
+func TestFilterStateSelectThenSync(t *testing.T) {
+	for _, repo := range []string{"me/gh-dash", "dlvhdr/gh-dash", "alice/gh-dash", "cli/cli"} {
+		for _, s := range testStates() {
+			selected := s.SelectRepo(testEnv, repo)
+			query := selected.Query(testEnv, "is:open")
+			if got := s.SyncFromSearch(testEnv, query); got != selected {
+				t.Errorf("SyncFromSearch(%q) = %+v, want the selected state %+v", query, got, selected)
+			}
+		}
+	}
+}
+
+func FuzzFilterStateQuery(f *testing.F) {
+	for _, search := range testSearches {
+		f.Add(search, uint8(FilterTargetOrigin), "", false)
+	}
+	f.Add("is:open author:@me", uint8(FilterTargetRemote), "", true)
+	f.Add("repo:x/y", uint8(FilterTargetNone), "cli/cli", true)
+
+	remotes := []string{"origin", "upstream", "alice", "removed"}
+	f.Fuzz(func(t *testing.T, search string, target uint8, customRepo string, authorRemoved bool) {
+		if strings.ContainsFunc(customRepo, unicode.IsSpace) {
+			t.Skip("the repo picker doesn't allow spaces")
+		}
+		s := FilterState{
+			Target:          FilterTarget(target % 4),
+			CustomRepo:      customRepo,
+			IsAuthorRemoved: authorRemoved,
+		}
+		if s.Target == FilterTargetRemote {
+			s.Remote = remotes[int(target/4)%len(remotes)]
+		}
+		checkQueryIsIdempotent(t, s, search)
+		checkSyncRoundTrip(t, s, search)
+	})
+}
+
+// checkQueryIsIdempotent checks that deriving the query of a query changes
+// nothing, the search bar shows the query and it's searched again
+func checkQueryIsIdempotent(t *testing.T, s FilterState, search string) {
+	t.Helper()
+	query := s.Query(testEnv, search)
+	if again := s.Query(testEnv, query); again != query {
+		t.Errorf("%+v: Query(%q) = %q but Query(%q) = %q", s, search, query, query, again)
+	}
+	if s.IsAuthorRemoved && strings.Contains(" "+query+" ", " author:@me ") {
+		t.Errorf("%+v: Query(%q) = %q, want author:@me removed", s, search, query)
+	}
+	if nonGitHub := s.Query(FilterEnv{Remotes: testRemotes}, search); nonGitHub != search {
+		t.Errorf("%+v: Query(%q) outside GitHub = %q, want it unchanged", s, search, nonGitHub)
+	}
+}
+
+// checkSyncRoundTrip checks that syncing the state from the query it derives
+// is a fixed point, the state doesn't drift as the search is edited
+func checkSyncRoundTrip(t *testing.T, s FilterState, search string) {
+	t.Helper()
+	synced := s.SyncFromSearch(testEnv, search)
+	query := synced.Query(testEnv, search)
+	if again := synced.SyncFromSearch(testEnv, query); again != synced {
+		t.Errorf("%+v: SyncFromSearch(%q) = %+v but syncing its query %q = %+v",
+			s, search, synced, query, again)
+	}
+	if synced.IsFiltered != (synced.Target != FilterTargetNone || synced.CustomRepo != "") {
+		t.Errorf("%+v: SyncFromSearch(%q) = %+v, IsFiltered is out of sync", s, search, synced)
+	}
+}
@@ -0,0 +1,38 @@
+package section
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// noLabelGroup is the group of the rows without labels in sections grouped
+// by label
+const noLabelGroup = "No label"
+
+// GroupTitles returns the title of the group of each row in sections grouped
+// by repo, author or label, nil when the section isn't grouped. Rows are
+// grouped by their first label, fields returns the fields of a row like the
+// ones of computed columns.
+func GroupTitles[T data.RowData](m *BaseModel, rows []T, fields func(T) map[string]any) []string {
+	if m.Config.GroupBy == "" {
+		return nil
+	}
+
+	titles := make([]string, 0, len(rows))
+	for _, row := range rows {
+		switch m.Config.GroupBy {
+		case "repo":
+			titles = append(titles, row.GetRepoNameWithOwner())
+		case "author":
+			author, _ := fields(row)["Author"].(string)
+			titles = append(titles, author)
+		case "label":
+			labels, _ := fields(row)["Labels"].([]string)
+			if len(labels) == 0 {
+				titles = append(titles, noLabelGroup)
+			} else {
+				titles = append(titles, labels[0])
+			}
+		}
+	}
+	return titles
+}
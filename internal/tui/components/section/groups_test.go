@@ -0,0 +1,39 @@
+package section
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func TestGroupTitles(t *testing.T) {
+	first := data.IssueData{Number: 1}
+	first.Repository.NameWithOwner = "dlvhdr/gh-dash"
+	first.Author.Login = "dlvhdr"
+	first.Labels.Nodes = []data.Label{{Name: "bug"}, {Name: "ui"}}
+	second := data.IssueData{Number: 2}
+	second.Repository.NameWithOwner = "charmbracelet/bubbletea"
+	second.Author.Login = "meowgorithm"
+	issues := []data.IssueData{first, second}
+
+	tests := []struct {
+		groupBy string
+		want    []string
+	}{
+		{groupBy: "", want: nil},
+		{groupBy: "repo", want: []string{"dlvhdr/gh-dash", "charmbracelet/bubbletea"}},
+		{groupBy: "author", want: []string{"dlvhdr", "meowgorithm"}},
+		{groupBy: "label", want: []string{"bug", noLabelGroup}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.groupBy, func(t *testing.T) {
+			m := BaseModel{Config: config.SectionConfig{GroupBy: tt.groupBy}}
+			got := GroupTitles(&m, issues, data.IssueData.ColumnFields)
+			if !slices.Equal(got, tt.want) || (got == nil) != (tt.want == nil) {
+				t.Errorf("GroupTitles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package section
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// minProjectColumnWidth is the width of the columns of project fields with
+// short names
+const minProjectColumnWidth = 12
+
+// ProjectColumns returns the columns of the project fields, they come after
+// the section's own columns
+func ProjectColumns(fields []string) []table.Column {
+	columns := make([]table.Column, 0, len(fields))
+	for _, field := range fields {
+		columns = append(columns, table.Column{
+			Title: field,
+			Width: utils.IntPtr(max(lipgloss.Width(field)+2, minProjectColumnWidth)),
+		})
+	}
+	return columns
+}
+
+// FetchProjectFields fetches the configured project fields of the rows with
+// ids. It's nil when the section shows no project fields or they couldn't be
+// fetched, the rows are shown without them then.
+func (m *BaseModel) FetchProjectFields(ids []string) map[string]data.ProjectFields {
+	if len(m.Config.ProjectFields) == 0 && !m.FetchesEstimates && !m.fetchesSprints() ||
+		len(ids) == 0 || !m.Config.IsGitHub() {
+		return nil
+	}
+
+	fields, err := data.FetchProjectFields(ids, data.ProjectFromSearch(m.GetFilters()))
+	if err != nil {
+		log.Error("Failed fetching project fields, the token may lack the read:project scope",
+			"section", m.Id, "err", err)
+		return nil
+	}
+	return fields
+}
+
+// SetProjectFields stores the project fields of fetched rows, the ones of the
+// previous rows are dropped unless they're added to
+func (m *BaseModel) SetProjectFields(fields map[string]data.ProjectFields, add bool) {
+	if !add || m.ProjectFields == nil {
+		m.ProjectFields = make(map[string]data.ProjectFields, len(fields))
+	}
+	for url, f := range fields {
+		m.ProjectFields[url] = f
+	}
+}
+
+// ProjectFieldValues returns the values of the configured project fields of
+// the row with url, in the order of their columns
+func (m *BaseModel) ProjectFieldValues(url string) []string {
+	if len(m.Config.ProjectFields) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(m.Config.ProjectFields))
+	fields := m.ProjectFields[url]
+	for _, field := range m.Config.ProjectFields {
+		values = append(values, fields[field])
+	}
+	return values
+}
+
+// EstimateValue returns the value of the estimate field of the row with url
+// in repo, empty if it has none or it isn't fetched
+func (m *BaseModel) EstimateValue(url, repo string) string {
+	field := m.Ctx.Config.Estimate.ForRepo(repo).Field
+	if !m.FetchesEstimates || field == "" {
+		return ""
+	}
+	for name, value := range m.ProjectFields[url] {
+		if strings.EqualFold(name, field) {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+package section
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// StackQualifiers returns searchValue with the qualifiers of query appended
+func StackQualifiers(searchValue, query string) string {
+	return strings.Join(append(strings.Fields(searchValue), strings.Fields(query)...), " ")
+}
+
+// UnstackQualifiers returns searchValue without the qualifiers of query, the
+// last run of them in a row. It's false when searchValue doesn't have them.
+func UnstackQualifiers(searchValue, query string) (string, bool) {
+	tokens, qualifiers := strings.Fields(searchValue), strings.Fields(query)
+	i := stackedAt(tokens, qualifiers)
+	if i < 0 {
+		return searchValue, false
+	}
+	return strings.Join(slices.Delete(tokens, i, i+len(qualifiers)), " "), true
+}
+
+// HasQualifiers returns whether searchValue has the qualifiers of query in a
+// row, the way StackQualifiers appends them
+func HasQualifiers(searchValue, query string) bool {
+	return stackedAt(strings.Fields(searchValue), strings.Fields(query)) >= 0
+}
+
+// stackedAt returns the index of the last run of qualifiers in tokens, -1
+// when there's none
+func stackedAt(tokens, qualifiers []string) int {
+	if len(qualifiers) == 0 {
+		return -1
+	}
+	for i := len(tokens) - len(qualifiers); i >= 0; i-- {
+		if slices.Equal(tokens[i:i+len(qualifiers)], qualifiers) {
+			return i
+		}
+	}
+	return -1
+}
+
+// AppliedSavedFilters returns the names of the saved filters of the config
+// the search has, sorted. They're read from the search so the ones edited out
+// of it are no longer applied.
+func (m *BaseModel) AppliedSavedFilters() []string {
+	var names []string
+	for _, name := range slices.Sorted(maps.Keys(m.Ctx.Config.SavedFilters)) {
+		if HasQualifiers(m.SearchValue, m.Ctx.Config.SavedFilters[name]) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// SetSavedFilters stacks the saved filters names on the search, over the
+// other transforms of the query, and takes the other applied ones off it. It
+// returns whether the search changed, it never does in sections of other
+// providers than GitHub, their searches have another syntax.
+func (m *BaseModel) SetSavedFilters(names []string) bool {
+	if !m.Config.IsGitHub() {
+		return false
+	}
+
+	searchValue := m.SearchValue
+	applied := m.AppliedSavedFilters()
+	for _, name := range applied {
+		if !slices.Contains(names, name) {
+			searchValue, _ = UnstackQualifiers(searchValue, m.Ctx.Config.SavedFilters[name])
+		}
+	}
+	for _, name := range names {
+		query, ok := m.Ctx.Config.SavedFilters[name]
+		if ok && !slices.Contains(applied, name) {
+			searchValue = StackQualifiers(searchValue, query)
+		}
+	}
+
+	if searchValue == m.SearchValue {
+		return false
+	}
+	m.SearchValue = searchValue
+	m.SearchBar.SetValue(searchValue)
+	return true
+}
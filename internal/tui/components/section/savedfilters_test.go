@@ -0,0 +1,89 @@
+package section
+
+import "testing"
+
+func TestStackQualifiers(t *testing.T) {
+	tests := []struct {
+		name        string
+		searchValue string
+		query       string
+		want        string
+	}{
+		{
+			name:        "appended",
+			searchValue: "is:open author:@me",
+			query:       "review:required -label:blocked",
+			want:        "is:open author:@me review:required -label:blocked",
+		},
+		{
+			name:  "empty search",
+			query: " review:required  -label:blocked ",
+			want:  "review:required -label:blocked",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StackQualifiers(tt.searchValue, tt.query); got != tt.want {
+				t.Errorf("StackQualifiers() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnstackQualifiers(t *testing.T) {
+	tests := []struct {
+		name        string
+		searchValue string
+		query       string
+		want        string
+		wantOk      bool
+	}{
+		{
+			name:        "stacked last",
+			searchValue: "is:open review:required -label:blocked",
+			query:       "review:required -label:blocked",
+			want:        "is:open",
+			wantOk:      true,
+		},
+		{
+			name:        "followed by other transforms",
+			searchValue: "is:open review:required -label:blocked sprint:@current updated:>=2024-01-01",
+			query:       "review:required -label:blocked",
+			want:        "is:open sprint:@current updated:>=2024-01-01",
+			wantOk:      true,
+		},
+		{
+			name:        "last run removed",
+			searchValue: "label:bug is:open label:bug",
+			query:       "label:bug",
+			want:        "label:bug is:open",
+			wantOk:      true,
+		},
+		{
+			name:        "quoted value",
+			searchValue: `is:open label:"good first issue"`,
+			query:       `label:"good first issue"`,
+			want:        "is:open",
+			wantOk:      true,
+		},
+		{
+			name:        "not in a row",
+			searchValue: "review:required is:open -label:blocked",
+			query:       "review:required -label:blocked",
+			want:        "review:required is:open -label:blocked",
+		},
+		{
+			name:        "empty query",
+			searchValue: "is:open",
+			want:        "is:open",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := UnstackQualifiers(tt.searchValue, tt.query)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("UnstackQualifiers() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -1,9 +1,9 @@
 package section
 
 import (
-	"bytes"
 	"fmt"
-	"log/slog"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -12,12 +12,10 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
-	"github.com/go-sprout/sprout"
-	timeregistry "github.com/go-sprout/sprout/registry/time"
 
+	"github.com/dlvhdr/gh-dash/v4/internal/cache"
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
-	"github.com/dlvhdr/gh-dash/v4/internal/git"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prompt"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/repopicker"
@@ -25,6 +23,7 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
 
@@ -35,6 +34,7 @@ const (
 	FilterTargetOrigin   FilterTarget = iota // Filter by origin (current fork)
 	FilterTargetUpstream                     // Filter by upstream (parent repo)
 	FilterTargetNone                         // No repo filter applied
+	FilterTargetRemote                       // Filter by another remote, like a teammate's fork
 )
 
 type BaseModel struct {
@@ -43,7 +43,6 @@ type BaseModel struct {
 	Ctx                       *context.ProgramContext
 	Spinner                   spinner.Model
 	SearchBar                 search.Model
-	IsSearching               bool
 	SearchValue               string
 	Table                     table.Model
 	Type                      string
@@ -53,23 +52,74 @@ type BaseModel struct {
 	TotalCount                int
 	PageInfo                  *data.PageInfo
 	PromptConfirmationBox     prompt.Model
-	IsPromptConfirmationShown bool
 	PromptConfirmationAction  string
 	LastFetchTaskId           string
 	IsSearchSupported         bool
 	ShowAuthorIcon            bool
 	IsFilteredByCurrentRemote bool
 	IsLoading                 bool
-	// FilterTarget indicates which repo to filter by (origin, upstream, or none)
+	// IsRefreshing is set while the rows are refetched in the background, the
+	// rows fetched before stay shown meanwhile
+	IsRefreshing bool
+	// IsOffline is set when the last fetch failed because GitHub couldn't be
+	// reached, the rows shown are the ones fetched or cached before
+	IsOffline bool
+	// FilterTarget indicates which repo to filter by (origin, upstream, another
+	// remote or none)
 	FilterTarget FilterTarget
+	// FilterRemote is the remote filtered by when FilterTarget is
+	// FilterTargetRemote
+	FilterRemote string
 	// IsAuthorFilterRemoved indicates if the author:@me filter has been removed
 	IsAuthorFilterRemoved bool
 	// CustomRepoFilter is a manually specified repo filter that overrides FilterTarget
 	CustomRepoFilter string
-	// IsRepoPickerShown indicates if the repo picker is currently shown
-	IsRepoPickerShown bool
+	// TimeSlice restricts the section to the items updated recently
+	TimeSlice TimeSlice
+	// timeSliceQualifier is the qualifier added to the search for TimeSlice
+	timeSliceQualifier string
+	// timeQualifiers are the time qualifiers the search had before slicing
+	// it, restored when the slice is cleared
+	timeQualifiers []string
 	// RepoPicker is the repo picker component
 	RepoPicker repopicker.Model
+	// Focus holds the search bar, prompt and picker layers opened over the
+	// table, the top one receives key presses
+	Focus focus.Stack
+	// ProjectFields are the values of the configured project fields of the
+	// rows, by their URL
+	ProjectFields map[string]data.ProjectFields
+	// FetchesEstimates fetches the project fields of the rows for their
+	// estimate even if no project field is shown
+	FetchesEstimates bool
+	// Iterations are the iterations of the sprint field, fetched in sections
+	// grouped by sprint
+	Iterations data.Iterations
+	// MergesRepoFilters merges the filters of the repos block of the config
+	// into the search, in the sections searching PRs, issues or discussions
+	MergesRepoFilters bool
+
+	// computedTemplates are the parsed templates of the computed columns, nil
+	// for the ones that failed parsing
+	computedTemplates []*template.Template
+	// computedColors are the parsed color templates of the computed columns,
+	// nil for the ones without a color or that failed parsing
+	computedColors []*template.Template
+	// computedValues caches the cells of the computed columns of the rows
+	computedValues map[computedKey][]table.Cell
+
+	// Sort orders the rows client-side, starting with the configured sort
+	Sort config.SortConfig
+	// sortTemplate is the parsed sort template, nil without one
+	sortTemplate *template.Template
+	// sortValues caches the values the rows are sorted by
+	sortValues map[computedKey]sortValue
+
+	// restoredCursor is the row the cursor is moved to once the rows are
+	// fetched, when the section was restored from the last session
+	restoredCursor int
+	// initialSearch is the search the section was created with
+	initialSearch string
 }
 
 type NewSectionOptions struct {
@@ -86,22 +136,22 @@ type NewSectionOptions struct {
 
 func (options NewSectionOptions) GetConfigFiltersWithCurrentRemoteAdded(ctx *context.ProgramContext) string {
 	searchValue := options.Config.Filters
-	if !ctx.Config.SmartFilteringAtLaunch {
+	// the remotes are GitHub repos, smart filtering doesn't apply to other forges
+	if !ctx.Config.SmartFilteringAtLaunch || !options.Config.IsGitHub() {
 		return searchValue
 	}
 
 	// Get origin from git remote directly, not repository.Current()
 	// which may resolve to the upstream/parent repo instead of the fork
-	repoDir := "."
-	if ctx != nil && ctx.RepoPath != "" {
-		repoDir = ctx.RepoPath
+	if ctx == nil {
+		return searchValue
 	}
-	originUrl, err := git.GetOriginUrl(repoDir)
-	if err != nil {
+	origin, hasOrigin := ctx.GetRemoteRepo("origin")
+	if !hasOrigin {
 		return searchValue
 	}
-	owner, name, err := git.ParseGitHubRepoFromUrl(originUrl)
-	if err != nil {
+	// the repo would filter out every row of a section of another host
+	if !data.SameHost(origin.Host, ctx.Config.SectionHost(options.Config)) {
 		return searchValue
 	}
 
@@ -110,7 +160,7 @@ func (options NewSectionOptions) GetConfigFiltersWithCurrentRemoteAdded(ctx *con
 			return searchValue
 		}
 	}
-	return fmt.Sprintf("repo:%s/%s %s", owner, name, searchValue)
+	return fmt.Sprintf("repo:%s %s", origin.NameWithOwner(), searchValue)
 }
 
 func NewModel(
@@ -134,9 +184,10 @@ func NewModel(
 		SearchBar: search.NewModel(ctx, search.SearchOptions{
 			Prefix:       fmt.Sprintf("is:%s", options.Type),
 			InitialValue: filters,
+			HistoryKey:   options.Type,
 		}),
 		SearchValue:               filters,
-		IsSearching:               false,
+		initialSearch:             filters,
 		IsFilteredByCurrentRemote: filters != options.Config.Filters,
 		TotalCount:                0,
 		PageInfo:                  nil,
@@ -145,13 +196,27 @@ func NewModel(
 		FilterTarget:              filterTarget,
 		IsAuthorFilterRemoved:     false,
 		CustomRepoFilter:          "",
-		IsRepoPickerShown:         false,
 		RepoPicker:                repopicker.NewModel(ctx),
+		computedTemplates:         parseComputedTemplates(options.Id, options.Config.ComputedColumns),
+		computedColors:            parseComputedColors(options.Id, options.Config.ComputedColumns),
+		computedValues:            map[computedKey][]table.Cell{},
+		sortTemplate:              parseSortTemplate(options.Id, options.Config.Sort),
+		sortValues:                map[computedKey]sortValue{},
+	}
+	if options.Config.Sort != nil {
+		m.Sort = *options.Config.Sort
+		m.SearchBar.SetChip(m.chip())
 	}
 	if !ctx.Config.SmartFilteringAtLaunch {
 		m.IsFilteredByCurrentRemote = false
 		m.FilterTarget = FilterTargetNone
 	}
+	if ctx.RestoredSession != nil {
+		if restored, ok := ctx.RestoredSession.TakeSection(options.Type, options.Id,
+			options.Config.Title, options.Config.Filters); ok {
+			m.restoreSession(restored)
+		}
+	}
 	m.Table = table.NewModel(
 		*ctx,
 		m.GetDimensions(),
@@ -178,6 +243,7 @@ type Section interface {
 	Table
 	Search
 	PromptConfirmation
+	FocusedMode() focus.Mode
 	GetConfig() config.SectionConfig
 	UpdateProgramContext(ctx *context.ProgramContext)
 	MakeSectionCmd(cmd tea.Cmd) tea.Cmd
@@ -205,11 +271,47 @@ type Table interface {
 	PrevRow() int
 	FirstItem() int
 	LastItem() int
+	SelectRow(i int)
+	RowAt(msg tea.MouseMsg) (int, bool)
 	FetchNextPageSectionRows() []tea.Cmd
 	BuildRows() []table.Row
 	ResetRows()
 	GetIsLoading() bool
 	SetIsLoading(val bool)
+	GetIsRefreshing() bool
+	SetIsRefreshing(val bool)
+	LastUpdated() time.Time
+}
+
+// Inbox is implemented by the sections whose rows can be marked read, they're
+// only tracked when the section's Inbox option is set
+type Inbox interface {
+	IsInbox() bool
+	// UnreadRows returns the indexes of the section's unread rows
+	UnreadRows() []int
+	SelectRow(i int)
+	// SyncReadRows renders the rows again after some were marked read or
+	// unread
+	SyncReadRows()
+}
+
+// Snoozable is implemented by the sections hiding the snoozed rows
+type Snoozable interface {
+	// DropSnoozed removes the rows snoozed since they were fetched
+	DropSnoozed()
+}
+
+// Selection is implemented by the sections whose rows can be selected, the
+// actions that support it then run on every selected row
+type Selection interface {
+	HasSelection() bool
+}
+
+// SavedFilters is implemented by the sections the saved filters of the config
+// can be stacked on
+type SavedFilters interface {
+	AppliedSavedFilters() []string
+	SetSavedFilters(names []string) bool
 }
 
 type Search interface {
@@ -219,6 +321,7 @@ type Search interface {
 	GetFilters() string
 	ResetPageInfo()
 	IsFilteringByClone() bool
+	GetFilterDebug() FilterDebug
 }
 
 type PromptConfirmation interface {
@@ -240,6 +343,11 @@ func (m *BaseModel) GetConfig() config.SectionConfig {
 	return m.Config
 }
 
+// Provider returns the forge the section's rows are fetched from
+func (m *BaseModel) Provider() (data.Provider, error) {
+	return data.GetProvider(m.Config.Provider, m.Ctx.Config.SectionHost(m.Config))
+}
+
 func (m *BaseModel) HasRepoNameInConfiguredFilter() bool {
 	filters := m.Config.Filters
 	for token := range strings.FieldsSeq(filters) {
@@ -250,91 +358,47 @@ func (m *BaseModel) HasRepoNameInConfiguredFilter() bool {
 	return false
 }
 
-func (m *BaseModel) GetSearchValue() string {
-	searchValue := m.enrichSearchWithTemplateVars()
-
-	// If there's a custom repo filter set via the picker, use it
-	if m.CustomRepoFilter != "" {
-		return m.applyRepoFilter(searchValue, m.CustomRepoFilter)
-	}
-
-	// Get origin repo from git remote (not repository.Current() which may resolve to upstream)
-	originOwner, originName, hasOrigin := m.GetOriginRepo()
-	if !hasOrigin {
-		return m.applyAuthorFilter(searchValue)
+// filterState returns the repo and author filtering of the section
+func (m *BaseModel) filterState() FilterState {
+	return FilterState{
+		Target:          m.FilterTarget,
+		Remote:          m.FilterRemote,
+		CustomRepo:      m.CustomRepoFilter,
+		IsFiltered:      m.IsFilteredByCurrentRemote,
+		IsAuthorRemoved: m.IsAuthorFilterRemoved,
 	}
+}
 
-	if m.HasRepoNameInConfiguredFilter() {
-		return m.applyAuthorFilter(searchValue)
-	}
+func (m *BaseModel) setFilterState(s FilterState) {
+	m.FilterTarget = s.Target
+	m.FilterRemote = s.Remote
+	m.CustomRepoFilter = s.CustomRepo
+	m.IsFilteredByCurrentRemote = s.IsFiltered
+	m.IsAuthorFilterRemoved = s.IsAuthorRemoved
+}
 
-	// Check if the user manually added a repo: filter in the search
-	if m.hasManualRepoFilter(searchValue) {
-		// User has manually specified a repo filter, respect it
-		return m.applyAuthorFilter(searchValue)
+// filterEnv returns what the search query of the section depends on
+func (m *BaseModel) filterEnv() FilterEnv {
+	return FilterEnv{
+		Remotes:         m.GetRemoteRepos(),
+		HasRepoInConfig: m.HasRepoNameInConfiguredFilter(),
+		IsGitHub:        m.Config.IsGitHub(),
 	}
-
-	// Remove any existing repo filters from the search value
-	var tokensWithoutRepoFilter []string
-	for token := range strings.FieldsSeq(searchValue) {
-		if !strings.HasPrefix(token, "repo:") {
-			tokensWithoutRepoFilter = append(tokensWithoutRepoFilter, token)
-		}
-	}
-	searchValueWithoutRepoFilter := strings.Join(tokensWithoutRepoFilter, " ")
-
-	// Apply the appropriate repo filter based on FilterTarget
-	var result string
-	switch m.FilterTarget {
-	case FilterTargetOrigin:
-		result = fmt.Sprintf("repo:%s/%s %s", originOwner, originName, searchValueWithoutRepoFilter)
-	case FilterTargetUpstream:
-		upstreamOwner, upstreamName, hasUpstream := m.GetUpstreamRepo()
-		if hasUpstream {
-			result = fmt.Sprintf("repo:%s/%s %s", upstreamOwner, upstreamName, searchValueWithoutRepoFilter)
-		} else {
-			// No upstream found, fall back to origin
-			result = fmt.Sprintf("repo:%s/%s %s", originOwner, originName, searchValueWithoutRepoFilter)
-		}
-	default:
-		result = searchValueWithoutRepoFilter
-	}
-
-	return m.applyAuthorFilter(result)
 }
 
-// hasManualRepoFilter checks if the search value contains a manually-entered repo filter
-// that differs from what would be auto-applied by FilterTarget
-func (m *BaseModel) hasManualRepoFilter(searchValue string) bool {
-	for token := range strings.FieldsSeq(searchValue) {
-		if strings.HasPrefix(token, "repo:") {
-			// There's a repo filter - check if it matches our auto-applied filters
-			repoValue := strings.TrimPrefix(token, "repo:")
-
-			// Get origin from git remote (not repository.Current())
-			originOwner, originName, hasOrigin := m.GetOriginRepo()
-			if !hasOrigin {
-				return true // Can't determine, assume it's manual
-			}
-
-			originRepo := fmt.Sprintf("%s/%s", originOwner, originName)
-			if repoValue == originRepo && m.FilterTarget == FilterTargetOrigin {
-				return false // Matches auto-applied origin
-			}
-
-			upstreamOwner, upstreamName, hasUpstream := m.GetUpstreamRepo()
-			if hasUpstream {
-				upstreamRepo := fmt.Sprintf("%s/%s", upstreamOwner, upstreamName)
-				if repoValue == upstreamRepo && m.FilterTarget == FilterTargetUpstream {
-					return false // Matches auto-applied upstream
-				}
-			}
+func (m *BaseModel) GetSearchValue() string {
+	return m.filterState().Query(m.filterEnv(), m.enrichSearchWithTemplateVars())
+}
 
-			// It's a different repo filter, treat as manual
-			return true
-		}
+// GetFilterDebug returns the filter state of the section and the query it
+// derives
+func (m *BaseModel) GetFilterDebug() FilterDebug {
+	return FilterDebug{
+		State:  m.filterState(),
+		Env:    m.filterEnv(),
+		Search: m.SearchValue,
+		Query:  m.withRepoFilters(m.GetSearchValue()),
 	}
-	return false
 }
 
 // StripRepoFilterTokens removes any repo:... tokens from a search string.
@@ -357,78 +421,20 @@ func getRepoFilterTokenValue(searchValue string) (string, bool) {
 	return "", false
 }
 
-// applyRepoFilter applies a specific repo filter to the search value
-func (m *BaseModel) applyRepoFilter(searchValue, repoFilter string) string {
-	// Remove any existing repo filters
-	var tokensWithoutRepoFilter []string
-	for token := range strings.FieldsSeq(searchValue) {
-		if !strings.HasPrefix(token, "repo:") {
-			tokensWithoutRepoFilter = append(tokensWithoutRepoFilter, token)
-		}
-	}
-	searchValueWithoutRepoFilter := strings.Join(tokensWithoutRepoFilter, " ")
-
-	var result string
-	switch {
-	case repoFilter == "":
-		result = searchValueWithoutRepoFilter
-	case searchValueWithoutRepoFilter == "":
-		result = fmt.Sprintf("repo:%s", repoFilter)
-	default:
-		result = fmt.Sprintf("repo:%s %s", repoFilter, searchValueWithoutRepoFilter)
-	}
-
-	return m.applyAuthorFilter(result)
-}
-
-// applyAuthorFilter removes author:@me from the search if IsAuthorFilterRemoved is true
-func (m *BaseModel) applyAuthorFilter(searchValue string) string {
-	if !m.IsAuthorFilterRemoved {
-		return searchValue
-	}
-
-	var tokensWithoutAuthor []string
-	for token := range strings.FieldsSeq(searchValue) {
-		if token != "author:@me" {
-			tokensWithoutAuthor = append(tokensWithoutAuthor, token)
-		}
-	}
-	return strings.Join(tokensWithoutAuthor, " ")
-}
-
-// getRepoDir returns the directory to use for git operations.
-// Uses m.Ctx.RepoPath if available, otherwise falls back to ".".
-func (m *BaseModel) getRepoDir() string {
-	if m.Ctx != nil && m.Ctx.RepoPath != "" {
-		return m.Ctx.RepoPath
-	}
-	return "."
-}
-
 // GetOriginRepo returns the owner and name of the origin repository
 func (m *BaseModel) GetOriginRepo() (owner, name string, hasOrigin bool) {
-	originUrl, err := git.GetOriginUrl(m.getRepoDir())
-	if err != nil {
+	if m.Ctx == nil {
 		return "", "", false
 	}
-	owner, name, err = git.ParseGitHubRepoFromUrl(originUrl)
-	if err != nil {
-		return "", "", false
-	}
-	return owner, name, true
+	return m.Ctx.GetOriginRepo()
 }
 
 // GetUpstreamRepo returns the owner and name of the upstream repository, if available
 func (m *BaseModel) GetUpstreamRepo() (owner, name string, hasUpstream bool) {
-	upstreamUrl, err := git.GetUpstreamUrl(m.getRepoDir())
-	if err != nil {
+	if m.Ctx == nil {
 		return "", "", false
 	}
-	owner, name, err = git.ParseGitHubRepoFromUrl(upstreamUrl)
-	if err != nil {
-		return "", "", false
-	}
-	return owner, name, true
+	return m.Ctx.GetUpstreamRepo()
 }
 
 // HasUpstreamRemote returns true if an upstream remote is configured
@@ -437,70 +443,55 @@ func (m *BaseModel) HasUpstreamRemote() bool {
 	return hasUpstream
 }
 
-// ToggleFilterTarget cycles through filter targets: Origin -> Upstream -> None -> Origin
-func (m *BaseModel) ToggleFilterTarget() {
-	if m.HasRepoNameInConfiguredFilter() {
-		return // Don't toggle if repo is explicitly set in config
-	}
-
-	hasUpstream := m.HasUpstreamRemote()
-
-	switch m.FilterTarget {
-	case FilterTargetOrigin:
-		if hasUpstream {
-			m.FilterTarget = FilterTargetUpstream
-		} else {
-			m.FilterTarget = FilterTargetNone
-		}
-	case FilterTargetUpstream:
-		m.FilterTarget = FilterTargetNone
-	case FilterTargetNone:
-		m.FilterTarget = FilterTargetOrigin
+// GetRemoteRepos returns the repos of every git remote, origin first,
+// upstream second and the others by remote name
+func (m *BaseModel) GetRemoteRepos() []context.RemoteRepo {
+	if m.Ctx == nil {
+		return nil
 	}
+	return m.Ctx.GetRemoteRepos()
+}
 
-	// Keep IsFilteredByCurrentRemote in sync for backward compatibility
-	m.IsFilteredByCurrentRemote = m.FilterTarget != FilterTargetNone
+// ToggleFilterTarget cycles through the repos of the remotes and then no
+// repo filter: Origin -> Upstream -> other remotes -> None -> Origin
+func (m *BaseModel) ToggleFilterTarget() {
+	m.setFilterState(m.filterState().Toggle(m.filterEnv()))
 }
 
 // ToggleAuthorFilter toggles whether the author:@me filter is removed
 func (m *BaseModel) ToggleAuthorFilter() {
-	m.IsAuthorFilterRemoved = !m.IsAuthorFilterRemoved
+	m.setFilterState(m.filterState().ToggleAuthor())
 }
 
 // ShowRepoPicker shows the repo picker with available options
 func (m *BaseModel) ShowRepoPicker() tea.Cmd {
 	options := m.buildRepoPickerOptions()
 	m.RepoPicker.SetOptions(options)
-	m.RepoPicker.SetWidth(50)
+	m.RepoPicker.SetWidth(60)
+	focusCmd := m.RepoPicker.Focus()
 
 	// Set the current selection based on the search query (source of truth)
 	currentRepo, _ := getRepoFilterTokenValue(m.SearchValue)
 	m.RepoPicker.SetSelectedValue(currentRepo)
 
-	m.RepoPicker.Focus()
-	m.IsRepoPickerShown = true
-	return nil
+	m.Focus.Push(focus.Picker)
+	return tea.Batch(focusCmd, m.MakeSectionCmd(m.RepoPicker.FetchRepos()))
 }
 
 // HideRepoPicker hides the repo picker
 func (m *BaseModel) HideRepoPicker() {
 	m.RepoPicker.Blur()
-	m.IsRepoPickerShown = false
+	m.Focus.Remove(focus.Picker)
 }
 
 // IsRepoPickerFocused returns true if the repo picker is focused
 func (m *BaseModel) IsRepoPickerFocused() bool {
-	return m.IsRepoPickerShown
+	return m.Focus.Top() == focus.Picker
 }
 
 // SetCustomRepoFilter sets a custom repo filter
 func (m *BaseModel) SetCustomRepoFilter(repo string) {
-	m.CustomRepoFilter = repo
-	// Clear the filter target since we're using a custom filter
-	if repo != "" {
-		m.FilterTarget = FilterTargetNone
-		m.IsFilteredByCurrentRemote = true
-	}
+	m.setFilterState(m.filterState().WithCustomRepo(repo))
 }
 
 // ClearCustomRepoFilter clears the custom repo filter
@@ -509,66 +500,37 @@ func (m *BaseModel) ClearCustomRepoFilter() {
 }
 
 func (m *BaseModel) SyncRepoFilterStateFromSearchValue() {
-	repoValue, ok := getRepoFilterTokenValue(m.SearchValue)
-	if !ok || repoValue == "" {
-		m.CustomRepoFilter = ""
-		m.FilterTarget = FilterTargetNone
-		m.IsFilteredByCurrentRemote = false
-		return
-	}
-
-	originOwner, originName, hasOrigin := m.GetOriginRepo()
-	if hasOrigin {
-		originRepo := fmt.Sprintf("%s/%s", originOwner, originName)
-		if repoValue == originRepo {
-			m.CustomRepoFilter = ""
-			m.FilterTarget = FilterTargetOrigin
-			m.IsFilteredByCurrentRemote = true
-			return
-		}
-	}
-
-	upstreamOwner, upstreamName, hasUpstream := m.GetUpstreamRepo()
-	if hasUpstream {
-		upstreamRepo := fmt.Sprintf("%s/%s", upstreamOwner, upstreamName)
-		if repoValue == upstreamRepo {
-			m.CustomRepoFilter = ""
-			m.FilterTarget = FilterTargetUpstream
-			m.IsFilteredByCurrentRemote = true
-			return
-		}
-	}
-
-	m.CustomRepoFilter = repoValue
-	m.FilterTarget = FilterTargetNone
-	m.IsFilteredByCurrentRemote = true
+	m.setFilterState(m.filterState().SyncFromSearch(m.filterEnv(), m.SearchValue))
 }
 
 // buildRepoPickerOptions builds the list of repo options for the picker
 func (m *BaseModel) buildRepoPickerOptions() []repopicker.RepoOption {
 	var options []repopicker.RepoOption
 
-	// Add origin (current fork) - use git remote directly, not repository.Current()
-	// which may resolve to the upstream/parent repo
-	originOwner, originName, hasOrigin := m.GetOriginRepo()
-	if hasOrigin {
-		originRepo := fmt.Sprintf("%s/%s", originOwner, originName)
-		options = append(options, repopicker.RepoOption{
-			Label: fmt.Sprintf("Origin: %s", originRepo),
-			Value: originRepo,
-			Desc:  "Your fork / current repo",
-		})
-	}
-
-	// Add upstream if available
-	upstreamOwner, upstreamName, hasUpstream := m.GetUpstreamRepo()
-	if hasUpstream {
-		upstreamRepo := fmt.Sprintf("%s/%s", upstreamOwner, upstreamName)
-		options = append(options, repopicker.RepoOption{
-			Label: fmt.Sprintf("Upstream: %s", upstreamRepo),
-			Value: upstreamRepo,
-			Desc:  "Parent repository",
-		})
+	// Add the repo of every remote, origin (current fork) first and upstream
+	// second - use git remotes directly, not repository.Current() which may
+	// resolve to the upstream/parent repo
+	for _, remote := range m.GetRemoteRepos() {
+		repo := remote.NameWithOwner()
+		if slices.ContainsFunc(options, func(option repopicker.RepoOption) bool {
+			return option.Value == repo
+		}) {
+			continue
+		}
+		option := repopicker.RepoOption{
+			Label: fmt.Sprintf("%s: %s", remote.Remote, repo),
+			Value: repo,
+			Desc:  fmt.Sprintf("The %s remote", remote.Remote),
+		}
+		switch remote.Remote {
+		case "origin":
+			option.Label = fmt.Sprintf("Origin: %s", repo)
+			option.Desc = "Your fork / current repo"
+		case "upstream":
+			option.Label = fmt.Sprintf("Upstream: %s", repo)
+			option.Desc = "Parent repository"
+		}
+		options = append(options, option)
 	}
 
 	// Add "All repos" option
@@ -581,114 +543,28 @@ func (m *BaseModel) buildRepoPickerOptions() []repopicker.RepoOption {
 	return options
 }
 
-// getCurrentRepoFilter returns the currently active repo filter value
-func (m *BaseModel) getCurrentRepoFilter() string {
-	if m.CustomRepoFilter != "" {
-		return m.CustomRepoFilter
-	}
-
-	switch m.FilterTarget {
-	case FilterTargetOrigin:
-		owner, name, hasOrigin := m.GetOriginRepo()
-		if hasOrigin {
-			return fmt.Sprintf("%s/%s", owner, name)
-		}
-	case FilterTargetUpstream:
-		owner, name, hasUpstream := m.GetUpstreamRepo()
-		if hasUpstream {
-			return fmt.Sprintf("%s/%s", owner, name)
-		}
-	}
-
-	return ""
-}
-
 // HandleRepoSelected handles when a repo is selected from the picker
 func (m *BaseModel) HandleRepoSelected(value string, isCustom bool) {
 	m.HideRepoPicker()
-
-	if value == "" {
-		// "All repos" selected
-		m.CustomRepoFilter = ""
-		m.FilterTarget = FilterTargetNone
-		m.IsFilteredByCurrentRemote = false
-	} else {
-		// Check if value matches origin or upstream
-		originOwner, originName, hasOrigin := m.GetOriginRepo()
-		if hasOrigin {
-			originRepo := fmt.Sprintf("%s/%s", originOwner, originName)
-			if value == originRepo {
-				m.CustomRepoFilter = ""
-				m.FilterTarget = FilterTargetOrigin
-				m.IsFilteredByCurrentRemote = true
-				return
-			}
-		}
-
-		upstreamOwner, upstreamName, hasUpstream := m.GetUpstreamRepo()
-		if hasUpstream {
-			upstreamRepo := fmt.Sprintf("%s/%s", upstreamOwner, upstreamName)
-			if value == upstreamRepo {
-				m.CustomRepoFilter = ""
-				m.FilterTarget = FilterTargetUpstream
-				m.IsFilteredByCurrentRemote = true
-				return
-			}
-		}
-
-		// It's a custom repo
-		m.CustomRepoFilter = value
-		m.FilterTarget = FilterTargetNone
-		m.IsFilteredByCurrentRemote = true
-	}
+	m.setFilterState(m.filterState().SelectRepo(m.filterEnv(), value))
 }
 
 // GetFilterTargetLabel returns a human-readable label for the current filter target
 func (m *BaseModel) GetFilterTargetLabel() string {
-	// If there's a custom repo filter, show it
-	if m.CustomRepoFilter != "" {
-		return m.CustomRepoFilter
-	}
-
-	switch m.FilterTarget {
-	case FilterTargetOrigin:
-		owner, name, hasOrigin := m.GetOriginRepo()
-		if hasOrigin {
-			return fmt.Sprintf("%s/%s", owner, name)
-		}
-		return "origin"
-	case FilterTargetUpstream:
-		owner, name, hasUpstream := m.GetUpstreamRepo()
-		if hasUpstream {
-			return fmt.Sprintf("%s/%s", owner, name)
-		}
-		return "upstream"
-	default:
-		return "all"
-	}
+	return m.filterState().Label(m.filterEnv())
 }
 
 func (m *BaseModel) enrichSearchWithTemplateVars() string {
-	searchValue := m.SearchValue
-	searchVars := struct{ Now time.Time }{
-		Now: time.Now(),
+	// the variables run git, only look them up for searches using them
+	if m.Ctx == nil || !strings.Contains(m.SearchValue, "{{") {
+		return utils.ExpandSearchTemplate(m.SearchValue)
 	}
-	sl := slog.New(log.Default())
-	handler := sprout.New(sprout.WithRegistries(timeregistry.NewRegistry(), utils.NewRegistry()), sprout.WithLogger(sl))
-	funcs := handler.Build()
-
-	tmpl, err := template.New("search").Funcs(funcs).Parse(searchValue)
-	if err != nil {
-		log.Error("bad template", "err", err)
-		return searchValue
+	// the viewers of a shared dashboard see the queries, so it never reads
+	// the environment
+	if m.Ctx.ReadOnly {
+		return utils.ExpandSearchTemplateWithVars(m.SearchValue, m.Ctx.SearchVars())
 	}
-	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, searchVars)
-	if err != nil {
-		return searchValue
-	}
-
-	return buf.String()
+	return utils.ExpandFiltersTemplate(m.SearchValue, m.Config.Filters, m.Ctx.SearchVars())
 }
 
 func (m *BaseModel) UpdateProgramContext(ctx *context.ProgramContext) {
@@ -698,9 +574,12 @@ func (m *BaseModel) UpdateProgramContext(ctx *context.ProgramContext) {
 		Height: max(0, newDimensions.Height-2),
 		Width:  max(0, newDimensions.Width),
 	}
-	m.Table.SetDimensions(tableDimensions)
 	m.Table.UpdateProgramContext(ctx)
-	m.Table.SyncViewPortContent()
+	// Re-rendering every row is expensive, only do it when the layout actually changed
+	if m.Table.GetDimensions() != tableDimensions {
+		m.Table.SetDimensions(tableDimensions)
+		m.Table.SyncViewPortContent()
+	}
 	m.SearchBar.UpdateProgramContext(ctx)
 	m.RepoPicker.UpdateProgramContext(ctx)
 }
@@ -742,20 +621,62 @@ func (m *BaseModel) LastItem() int {
 	return m.Table.LastItem()
 }
 
+func (m *BaseModel) SelectRow(i int) {
+	m.Table.SelectItem(i)
+}
+
+// RowAt returns the index of the row under the mouse, and false when it's
+// not over one
+func (m *BaseModel) RowAt(msg tea.MouseMsg) (int, bool) {
+	return m.Table.RowAt(msg)
+}
+
+// IsInbox returns whether the section tracks which of its rows were read
+func (m *BaseModel) IsInbox() bool {
+	return m.Config.Inbox && m.Ctx.ReadItems != nil
+}
+
+// IsUnread returns whether row wasn't read since it was last updated, rows of
+// sections that aren't inboxes are never unread
+func (m *BaseModel) IsUnread(row data.RowData) bool {
+	return m.IsInbox() && m.Ctx.ReadItems.IsUnread(row.GetUrl(), row.GetUpdatedAt())
+}
+
+// IsSnoozed returns whether row is snoozed and hidden from the section, none
+// is while the snoozed items are shown
+func (m *BaseModel) IsSnoozed(row data.RowData) bool {
+	return !m.Ctx.ShowSnoozed && m.Ctx.Snoozed != nil &&
+		m.Ctx.Snoozed.IsSnoozed(row.GetUrl(), row.GetUpdatedAt(), time.Now())
+}
+
 func (m *BaseModel) IsSearchFocused() bool {
-	return m.IsSearching
+	return m.Focus.Top() == focus.Search
+}
+
+// FocusedMode returns what receives key presses in the section
+func (m *BaseModel) FocusedMode() focus.Mode {
+	return m.Focus.Top()
 }
 
 func (m *BaseModel) GetIsLoading() bool {
 	return m.IsLoading
 }
 
+func (m *BaseModel) GetIsRefreshing() bool {
+	return m.IsRefreshing
+}
+
+func (m *BaseModel) SetIsRefreshing(val bool) {
+	m.IsRefreshing = val
+}
+
 func (m *BaseModel) SetIsSearching(val bool) tea.Cmd {
-	m.IsSearching = val
 	if val {
+		m.Focus.Push(focus.Search)
 		m.SearchBar.Focus()
 		return m.SearchBar.Init()
 	} else {
+		m.Focus.Remove(focus.Search)
 		m.SearchBar.Blur()
 		return nil
 	}
@@ -765,21 +686,28 @@ func (m *BaseModel) ResetFilters() {
 	m.SearchBar.SetValue(m.GetSearchValue())
 }
 
+// IsSearchEdited returns whether the search changed since the section was
+// created, or the search bar has a search that wasn't submitted
+func (m *BaseModel) IsSearchEdited() bool {
+	return m.SearchValue != m.initialSearch || m.SearchBar.Value() != m.SearchValue
+}
+
 func (m *BaseModel) ResetPageInfo() {
 	m.PageInfo = nil
 }
 
 func (m *BaseModel) IsPromptConfirmationFocused() bool {
-	return m.IsPromptConfirmationShown
+	return m.Focus.Top() == focus.Confirm
 }
 
 func (m *BaseModel) SetIsPromptConfirmationShown(val bool) tea.Cmd {
-	m.IsPromptConfirmationShown = val
 	if val {
+		m.Focus.Push(focus.Confirm)
 		m.PromptConfirmationBox.Focus()
 		return m.PromptConfirmationBox.Init()
 	}
 
+	m.Focus.Remove(focus.Confirm)
 	m.PromptConfirmationBox.Blur()
 	return nil
 }
@@ -813,8 +741,134 @@ func (m *BaseModel) MakeSectionCmd(cmd tea.Cmd) tea.Cmd {
 	}
 }
 
+func (m *BaseModel) cacheKey(limit int) string {
+	if !m.Config.IsGitHub() {
+		return cache.Key(m.Type, m.Config.Provider, m.Config.Host, m.GetFilters(), strconv.Itoa(limit))
+	}
+	key := []string{m.Type, m.GetFilters()}
+	if host := m.Ctx.Config.SectionHost(m.Config); !data.IsDefaultHost(host) {
+		key = append(key, host)
+	}
+	if sprint := m.SprintFilter(); sprint != SprintAll {
+		// the rows of a sprint are filtered from the ones of the search
+		key = append(key, sprint.Qualifier())
+	}
+	return cache.Key(append(key, strconv.Itoa(limit))...)
+}
+
+// ReadCachedRows returns a cmd that loads the rows cached for the current
+// filters of the section and passes them to toMsg. It sends nothing when
+// caching is disabled or nothing was cached.
+func ReadCachedRows[T any](m *BaseModel, limit int, toMsg func(rows T, savedAt time.Time) tea.Msg) tea.Cmd {
+	cfg := m.Ctx.Config.Cache
+	if cfg.Disabled {
+		return nil
+	}
+
+	key := m.cacheKey(limit)
+	id, sType := m.Id, m.Type
+	return func() tea.Msg {
+		dir, err := cache.Dir(cfg.Dir)
+		if err != nil {
+			return nil
+		}
+		rows, savedAt, err := cache.Read[T](dir, key, cfg.MaxAge())
+		if err != nil {
+			log.Debug("No cached rows", "section", id, "type", sType, "err", err)
+			return nil
+		}
+		return SectionMsg{Id: id, Type: sType, InternalMsg: toMsg(rows, savedAt)}
+	}
+}
+
+// ReadOfflineRows returns the rows cached for the current filters of the
+// section whatever their age, to show while GitHub can't be reached
+func ReadOfflineRows[T any](m *BaseModel, limit int) (T, time.Time, bool) {
+	var rows T
+	cfg := m.Ctx.Config.Cache
+	if cfg.Disabled {
+		return rows, time.Time{}, false
+	}
+
+	dir, err := cache.Dir(cfg.Dir)
+	if err != nil {
+		return rows, time.Time{}, false
+	}
+	rows, savedAt, err := cache.Read[T](dir, m.cacheKey(limit), 0)
+	if err != nil {
+		log.Debug("No cached rows to show offline", "section", m.Id, "type", m.Type, "err", err)
+		return rows, time.Time{}, false
+	}
+	return rows, savedAt, true
+}
+
+// OfflineWarning returns the label shown next to the search while the section
+// is offline, empty otherwise
+func (m *BaseModel) OfflineWarning() string {
+	if !m.IsOffline {
+		return ""
+	}
+	lastUpdated := m.LastUpdated()
+	if lastUpdated.IsZero() || len(m.Table.Rows) == 0 {
+		return "offline — no cached data"
+	}
+	elapsed := utils.TimeElapsed(lastUpdated)
+	if elapsed == "now" {
+		return "offline — data from just now"
+	}
+	return fmt.Sprintf("offline — data from %s ago", elapsed)
+}
+
+// WriteCachedRows stores the rows fetched for the current filters of the
+// section so they can be shown on the next launch
+func WriteCachedRows[T any](m *BaseModel, limit int, rows T) {
+	cfg := m.Ctx.Config.Cache
+	if cfg.Disabled {
+		return
+	}
+
+	dir, err := cache.Dir(cfg.Dir)
+	if err == nil {
+		err = cache.Write(dir, m.cacheKey(limit), rows)
+	}
+	if err != nil {
+		log.Debug("Failed caching rows", "section", m.Id, "type", m.Type, "err", err)
+	}
+}
+
+// GetFilters returns the search sent to GitHub, with the filters of the repos
+// it searches and without the sprint: and size: qualifiers GitHub doesn't know
 func (m *BaseModel) GetFilters() string {
-	return m.GetSearchValue()
+	filters, _, _ := SearchFilters(m.Ctx.Config, m.Config, m.MergesRepoFilters, m.GetSearchValue())
+	return filters
+}
+
+// SearchFilters splits searchValue, the expanded search of section s, into
+// the filters the section is fetched with and the sprint and size filters
+// its fetched rows are filtered with. The filters of the repos block of cfg
+// are merged in when mergesRepoFilters is set.
+func SearchFilters(
+	cfg *config.Config,
+	s config.SectionConfig,
+	mergesRepoFilters bool,
+	searchValue string,
+) (string, SprintFilter, SizeFilter) {
+	filters, sprint := SplitSprintQualifier(mergeRepoFilters(cfg, s, mergesRepoFilters, searchValue))
+	filters, sizes := SplitSizeQualifier(filters)
+	return filters, sprint, sizes
+}
+
+// withRepoFilters merges the filters of the repos block of the config into
+// searchValue, in the sections of GitHub merging them
+func (m *BaseModel) withRepoFilters(searchValue string) string {
+	return mergeRepoFilters(m.Ctx.Config, m.Config, m.MergesRepoFilters, searchValue)
+}
+
+func mergeRepoFilters(cfg *config.Config, s config.SectionConfig, merges bool, searchValue string) string {
+	if !merges || !s.IsGitHub() {
+		return searchValue
+	}
+	return cfg.MergeRepoFilters(searchValue)
 }
 
 func (m *BaseModel) IsFilteringByClone() bool {
@@ -843,12 +897,23 @@ func (m *BaseModel) GetMainContent() string {
 }
 
 func (m *BaseModel) View() string {
+	// set on every render so the age of the data stays current
+	m.SearchBar.SetWarning(m.OfflineWarning())
 	search := m.SearchBar.View(m.Ctx)
 
 	mainContent := m.GetMainContent()
 
 	// If repo picker is shown, overlay it on the main content
-	if m.IsRepoPickerShown {
+	if m.SearchBar.IsOverlayOpen() {
+		d := m.GetDimensions()
+		mainContent = lipgloss.Place(
+			d.Width,
+			d.Height,
+			lipgloss.Center,
+			lipgloss.Top,
+			m.SearchBar.OverlayView(),
+		)
+	} else if m.Focus.Has(focus.Picker) {
 		pickerView := m.RepoPicker.View()
 		// Center the picker over the content
 		d := m.GetDimensions()
@@ -872,6 +937,7 @@ func (m *BaseModel) View() string {
 
 func (m *BaseModel) ResetRows() {
 	m.Table.Rows = nil
+	m.Table.ClearSelection()
 	m.ResetPageInfo()
 	m.Table.ResetCurrItem()
 }
@@ -888,10 +954,49 @@ func (m *BaseModel) UpdateTotalItemsCount(count int) {
 	m.Table.UpdateTotalItemsCount(count)
 }
 
+// HasSelection returns whether rows of the section are selected for a bulk
+// action
+func (m *BaseModel) HasSelection() bool {
+	return m.Table.HasSelection()
+}
+
+// bulkPrompt returns the prompt of the action when it runs on every selected
+// row, empty when it doesn't
+func (m *BaseModel) bulkPrompt() string {
+	if m.Ctx.View != config.PRsView && m.Ctx.View != config.IssuesView {
+		return ""
+	}
+	n := len(m.Table.SelectedItems())
+	items := "issues"
+	if m.Ctx.View == config.PRsView {
+		items = "PRs"
+	}
+	if n == 1 {
+		items = strings.TrimSuffix(items, "s")
+	}
+	switch m.PromptConfirmationAction {
+	case "close":
+		return fmt.Sprintf("Are you sure you want to close the %d selected %s? (Y/n) ", n, items)
+	case "merge":
+		if m.Ctx.View != config.PRsView {
+			return ""
+		}
+		return fmt.Sprintf("Merge the %d selected %s with (m)erge, (s)quash or (r)ebase? (m/s/r/n) ", n, items)
+	case "assign":
+		return fmt.Sprintf("Assign the %d selected %s to (space separated users): ", n, items)
+	case "label":
+		return fmt.Sprintf("Add labels to the %d selected %s (space separated): ", n, items)
+	}
+	return ""
+}
+
 func (m *BaseModel) GetPromptConfirmation() string {
-	if m.IsPromptConfirmationShown {
+	if m.Focus.Has(focus.Confirm) {
 		var prompt string
 		switch {
+		case m.Table.HasSelection() && m.bulkPrompt() != "":
+			prompt = m.bulkPrompt()
+
 		case m.PromptConfirmationAction == "close" && m.Ctx.View == config.PRsView:
 			prompt = "Are you sure you want to close this PR? (Y/n) "
 
@@ -904,8 +1009,19 @@ func (m *BaseModel) GetPromptConfirmation() string {
 		case m.PromptConfirmationAction == "merge" && m.Ctx.View == config.PRsView:
 			prompt = "Are you sure you want to merge this PR? (Y/n) "
 
-		case m.PromptConfirmationAction == "update" && m.Ctx.View == config.PRsView:
-			prompt = "Are you sure you want to update this PR? (Y/n) "
+		case m.PromptConfirmationAction == "update" &&
+			(m.Ctx.View == config.PRsView || m.Ctx.View == config.RepoView):
+			if m.Ctx.Config.Defaults.PrUpdateMethod == data.BranchUpdateRebase {
+				prompt = "Are you sure you want to rebase this PR on its base branch? (Y/n, m to merge) "
+			} else {
+				prompt = "Are you sure you want to update this PR with a merge commit? (Y/n, r to rebase) "
+			}
+
+		case m.PromptConfirmationAction == "enqueue" && m.Ctx.View == config.PRsView:
+			prompt = "Are you sure you want to add this PR to the merge queue? (Y/n) "
+
+		case m.PromptConfirmationAction == "dequeue" && m.Ctx.View == config.PRsView:
+			prompt = "Are you sure you want to remove this PR from the merge queue? (Y/n) "
 
 		case m.PromptConfirmationAction == "close" && m.Ctx.View == config.IssuesView:
 			prompt = "Are you sure you want to close this issue? (Y/n) "
@@ -918,6 +1034,8 @@ func (m *BaseModel) GetPromptConfirmation() string {
 			prompt = "Enter branch name: "
 		case m.PromptConfirmationAction == "create_pr" && m.Ctx.View == config.RepoView:
 			prompt = "Enter PR title: "
+		case m.PromptConfirmationAction == "create_draft_pr" && m.Ctx.View == config.RepoView:
+			prompt = "Enter draft PR title: "
 		}
 
 		m.PromptConfirmationBox.SetPrompt(prompt)
@@ -0,0 +1,117 @@
+package section
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func TestEnrichSearchWithTemplateVars(t *testing.T) {
+	t.Setenv("GH_DASH_TEAM", "platform")
+	t.Setenv("GH_DASH_SECRET", "hunter2")
+	filters := `is:open team:{{ env "GH_DASH_TEAM" }}`
+
+	tests := []struct {
+		name        string
+		readOnly    bool
+		searchValue string
+		want        string
+	}{
+		{name: "configured filters", searchValue: filters, want: "is:open team:platform"},
+		{
+			name:        "typed search",
+			searchValue: `team:{{ env "GH_DASH_SECRET" }}`,
+			want:        "team:",
+		},
+		{
+			name:        "qualifiers typed after the configured filters",
+			searchValue: filters + " label:bug",
+			want:        "is:open team:platform label:bug",
+		},
+		{
+			name:        "configured filters of a read-only dashboard",
+			readOnly:    true,
+			searchValue: filters,
+			want:        filters,
+		},
+		{
+			name:        "typed search of a read-only dashboard",
+			readOnly:    true,
+			searchValue: `team:{{ env "GH_DASH_SECRET" }}`,
+			want:        `team:{{ env "GH_DASH_SECRET" }}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := BaseModel{
+				Ctx: &context.ProgramContext{
+					ReadOnly: tt.readOnly,
+					RepoPath: t.TempDir(),
+					Repo:     &context.RepoContext{},
+				},
+				Config:      config.SectionConfig{Filters: filters},
+				SearchValue: tt.searchValue,
+			}
+			if got := m.enrichSearchWithTemplateVars(); got != tt.want {
+				t.Errorf("enrichSearchWithTemplateVars() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchFilters(t *testing.T) {
+	cfg := &config.Config{RepoDefaults: map[string]config.RepoDefaults{
+		"dlvhdr/gh-dash": {Filters: "-label:wontfix"},
+	}}
+
+	tests := []struct {
+		name        string
+		section     config.SectionConfig
+		merges      bool
+		searchValue string
+		want        string
+		wantSprint  SprintFilter
+		wantSizes   SizeFilter
+	}{
+		{
+			name:        "repo filters merged",
+			merges:      true,
+			searchValue: "repo:dlvhdr/gh-dash is:open",
+			want:        "repo:dlvhdr/gh-dash is:open -label:wontfix",
+			wantSprint:  SprintAll,
+		},
+		{
+			name:        "repo filters not merged",
+			searchValue: "repo:dlvhdr/gh-dash is:open",
+			want:        "repo:dlvhdr/gh-dash is:open",
+			wantSprint:  SprintAll,
+		},
+		{
+			name:        "repo filters of another provider",
+			section:     config.SectionConfig{Provider: "gitlab"},
+			merges:      true,
+			searchValue: "repo:dlvhdr/gh-dash is:open",
+			want:        "repo:dlvhdr/gh-dash is:open",
+			wantSprint:  SprintAll,
+		},
+		{
+			name:        "sprint and size split off",
+			merges:      true,
+			searchValue: "is:open sprint:@current size:XS,S",
+			want:        "is:open",
+			wantSprint:  SprintCurrent,
+			wantSizes:   SizeFilter{"XS", "S"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, sprint, sizes := SearchFilters(cfg, tt.section, tt.merges, tt.searchValue)
+			if got != tt.want || sprint != tt.wantSprint || !slices.Equal(sizes, tt.wantSizes) {
+				t.Errorf("SearchFilters() = %q, %v, %v, want %q, %v, %v",
+					got, sprint, sizes, tt.want, tt.wantSprint, tt.wantSizes)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package section
+
+import (
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+)
+
+// SessionState returns the search, repo filter and cursor of the section, for
+// the next launch to restore them
+func (m *BaseModel) SessionState() state.SectionSession {
+	return state.SectionSession{
+		Type:          m.Type,
+		Id:            m.Id,
+		Title:         m.Config.Title,
+		Filters:       m.Config.Filters,
+		Search:        m.SearchValue,
+		FilterTarget:  int(m.FilterTarget),
+		FilterRemote:  m.FilterRemote,
+		CustomRepo:    m.CustomRepoFilter,
+		AuthorRemoved: m.IsAuthorFilterRemoved,
+		Cursor:        max(m.CurrRow(), 0),
+	}
+}
+
+// restoreSession restores the search and repo filter of the section from the
+// last session, and moves the cursor back once the rows are fetched
+func (m *BaseModel) restoreSession(restored state.SectionSession) {
+	m.SearchValue = restored.Search
+	m.SearchBar.SetValue(restored.Search)
+	m.setFilterState(FilterState{
+		Target:          FilterTarget(restored.FilterTarget),
+		Remote:          restored.FilterRemote,
+		CustomRepo:      restored.CustomRepo,
+		IsFiltered:      restored.CustomRepo != "" || FilterTarget(restored.FilterTarget) != FilterTargetNone,
+		IsAuthorRemoved: restored.AuthorRemoved,
+	})
+	m.restoredCursor = restored.Cursor
+}
+
+// RestoreCursor moves the cursor back to the row it was on in the last
+// session, once the section has rows
+func (m *BaseModel) RestoreCursor() {
+	if m.restoredCursor <= 0 || len(m.Table.Rows) == 0 {
+		return
+	}
+	m.SelectRow(min(m.restoredCursor, len(m.Table.Rows)-1))
+	m.restoredCursor = 0
+}
@@ -0,0 +1,85 @@
+package section
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// SizeFilter restricts a PR section to the PRs of some sizes, with a size:
+// qualifier in its search, e.g. size:S, size:XS,S or size:<=M. GitHub's
+// search doesn't know the qualifier, the fetched PRs are filtered instead.
+// It's the sizes the PRs are kept of, nil keeps all of them.
+type SizeFilter []string
+
+// SplitSizeQualifier returns searchValue without its size: qualifiers, and
+// the filter of the last one. Unknown sizes are left out of the filter.
+func SplitSizeQualifier(searchValue string) (string, SizeFilter) {
+	var rest []string
+	var filter SizeFilter
+	for token := range strings.FieldsSeq(searchValue) {
+		value, ok := strings.CutPrefix(token, "size:")
+		if !ok {
+			rest = append(rest, token)
+			continue
+		}
+		filter = parseSizes(value)
+	}
+	return strings.Join(rest, " "), filter
+}
+
+// parseSizes parses the value of a size: qualifier, sizes separated by commas
+// or a size compared with <, <=, > or >=
+func parseSizes(value string) SizeFilter {
+	value = strings.ToUpper(value)
+	for _, op := range []string{"<=", ">=", "<", ">"} {
+		size, ok := strings.CutPrefix(value, op)
+		if !ok {
+			continue
+		}
+		i := slices.Index(data.PrSizes, size)
+		if i < 0 {
+			return nil
+		}
+		switch op {
+		case "<=":
+			return slices.Clone(data.PrSizes[:i+1])
+		case ">=":
+			return slices.Clone(data.PrSizes[i:])
+		case "<":
+			return slices.Clone(data.PrSizes[:i])
+		default:
+			return slices.Clone(data.PrSizes[i+1:])
+		}
+	}
+
+	filter := SizeFilter{}
+	for size := range strings.SplitSeq(value, ",") {
+		if slices.Contains(data.PrSizes, size) && !slices.Contains(filter, size) {
+			filter = append(filter, size)
+		}
+	}
+	if len(filter) == 0 {
+		return nil
+	}
+	return filter
+}
+
+// Filter returns the PRs of the sizes of the filter, all of them when it
+// keeps all sizes
+func (f SizeFilter) Filter(prs []data.PullRequestData, cfg config.PrSizeConfig) []data.PullRequestData {
+	if f == nil {
+		return prs
+	}
+	return slices.DeleteFunc(prs, func(pr data.PullRequestData) bool {
+		return !slices.Contains(f, pr.Size(cfg))
+	})
+}
+
+// SizeFilter returns the filter of the size: qualifier of the search
+func (m *BaseModel) SizeFilter() SizeFilter {
+	_, filter := SplitSizeQualifier(m.SearchValue)
+	return filter
+}
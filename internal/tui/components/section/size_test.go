@@ -0,0 +1,75 @@
+package section
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplitSizeQualifier(t *testing.T) {
+	tests := []struct {
+		name        string
+		searchValue string
+		wantRest    string
+		wantFilter  SizeFilter
+	}{
+		{
+			name:        "no qualifier",
+			searchValue: "is:open review-requested:@me",
+			wantRest:    "is:open review-requested:@me",
+			wantFilter:  nil,
+		},
+		{
+			name:        "one size",
+			searchValue: "is:open size:s",
+			wantRest:    "is:open",
+			wantFilter:  SizeFilter{"S"},
+		},
+		{
+			name:        "sizes",
+			searchValue: "size:XS,S,XS is:open",
+			wantRest:    "is:open",
+			wantFilter:  SizeFilter{"XS", "S"},
+		},
+		{
+			name:        "at most",
+			searchValue: "is:open size:<=M",
+			wantRest:    "is:open",
+			wantFilter:  SizeFilter{"XS", "S", "M"},
+		},
+		{
+			name:        "larger than",
+			searchValue: "is:open size:>L",
+			wantRest:    "is:open",
+			wantFilter:  SizeFilter{"XL"},
+		},
+		{
+			name:        "less than the smallest",
+			searchValue: "is:open size:<XS",
+			wantRest:    "is:open",
+			wantFilter:  SizeFilter{},
+		},
+		{
+			name:        "last qualifier wins",
+			searchValue: "is:open size:XL size:>=L",
+			wantRest:    "is:open",
+			wantFilter:  SizeFilter{"L", "XL"},
+		},
+		{
+			name:        "unknown size",
+			searchValue: "is:open size:huge",
+			wantRest:    "is:open",
+			wantFilter:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rest, filter := SplitSizeQualifier(tt.searchValue)
+			if rest != tt.wantRest {
+				t.Errorf("SplitSizeQualifier() rest = %q, want %q", rest, tt.wantRest)
+			}
+			if !slices.Equal(filter, tt.wantFilter) || (filter == nil) != (tt.wantFilter == nil) {
+				t.Errorf("SplitSizeQualifier() filter = %#v, want %#v", filter, tt.wantFilter)
+			}
+		})
+	}
+}
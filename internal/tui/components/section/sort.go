@@ -0,0 +1,213 @@
+package section
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// sortKeys are the keys CycleSort goes through, the template one comes last
+// in sections that have a sort template
+var sortKeys = []string{"updated", "created", "comments", "reactions", "ci", "size"}
+
+// ciRanks orders the CI states of PRs, the failing ones come first when
+// sorting descending
+var ciRanks = map[string]int{
+	"EXPECTED": 1,
+	"PENDING":  1,
+	"SUCCESS":  2,
+	"ERROR":    3,
+	"FAILURE":  3,
+}
+
+// sortValue is the value of a row its section is sorted by, numbers sort
+// before text
+type sortValue struct {
+	num   float64
+	str   string
+	isNum bool
+}
+
+func compareSortValues(a, b sortValue) int {
+	switch {
+	case a.isNum && b.isNum:
+		return cmp.Compare(a.num, b.num)
+	case a.isNum:
+		return -1
+	case b.isNum:
+		return 1
+	}
+	return strings.Compare(a.str, b.str)
+}
+
+func numberSortValue(v any) sortValue {
+	switch v := v.(type) {
+	case int:
+		return sortValue{num: float64(v), isNum: true}
+	case time.Time:
+		return sortValue{num: float64(v.Unix()), isNum: true}
+	}
+	return sortValue{isNum: true}
+}
+
+// parseSortTemplate parses the sort template of a section, it's nil when
+// there's none or it fails parsing
+func parseSortTemplate(sectionId int, sort *config.SortConfig) *template.Template {
+	if sort == nil || sort.Template == "" {
+		return nil
+	}
+	tmpl, err := utils.ParseColumnTemplate("sort", sort.Template)
+	if err != nil {
+		log.Error("Failed parsing sort template", "section", sectionId, "err", err)
+		return nil
+	}
+	return tmpl
+}
+
+// sortValueOf returns the value the row with url is sorted by, it's cached
+// until the row is updated, the rows are refetched or the sort changes
+func (m *BaseModel) sortValueOf(url string, updatedAt time.Time, fields func() map[string]any) sortValue {
+	key := computedKey{url: url, updatedAt: updatedAt}
+	if value, ok := m.sortValues[key]; ok {
+		return value
+	}
+
+	rowFields := fields()
+	var value sortValue
+	switch m.Sort.By {
+	case "updated":
+		value = numberSortValue(rowFields["UpdatedAt"])
+	case "created":
+		value = numberSortValue(rowFields["CreatedAt"])
+	case "comments":
+		value = numberSortValue(rowFields["Comments"])
+	case "reactions":
+		value = numberSortValue(rowFields["Reactions"])
+	case "ci":
+		ci, _ := rowFields["Ci"].(string)
+		value = numberSortValue(ciRanks[ci])
+	case "size":
+		// PRs of the same size are told apart by the lines they change
+		additions, _ := rowFields["Additions"].(int)
+		deletions, _ := rowFields["Deletions"].(int)
+		value = numberSortValue(additions + deletions)
+	case "template":
+		value = m.templateSortValue(url, rowFields)
+	}
+	if m.sortValues != nil {
+		m.sortValues[key] = value
+	}
+	return value
+}
+
+// templateSortValue executes the sort template over the fields of a row, its
+// value is sorted as a number when it's one
+func (m *BaseModel) templateSortValue(url string, rowFields map[string]any) sortValue {
+	if m.sortTemplate == nil {
+		return sortValue{}
+	}
+	rowFields["Mine"] = m.Ctx != nil && m.Ctx.User != "" && rowFields["Author"] == m.Ctx.User
+	s, err := utils.ExecuteColumnTemplate(m.sortTemplate, rowFields)
+	if err != nil {
+		log.Error("Failed executing sort template", "section", m.Id, "url", url, "err", err)
+		return sortValue{}
+	}
+	if num, err := strconv.ParseFloat(s, 64); err == nil {
+		return sortValue{num: num, isNum: true}
+	}
+	return sortValue{str: s}
+}
+
+// SortRows orders rows by the sort of the section, rows of equal values keep
+// their order. Sections grouped by sprint stay grouped, their rows are sorted
+// within each sprint. Without a sort the rows are left in the order they were
+// fetched in.
+func SortRows[T data.RowData](m *BaseModel, rows []T, fields func(T) map[string]any) {
+	if m.Sort.By == "" {
+		return
+	}
+
+	values := make(map[string]sortValue, len(rows))
+	for _, row := range rows {
+		values[row.GetUrl()] = m.sortValueOf(row.GetUrl(), row.GetUpdatedAt(), func() map[string]any {
+			return fields(row)
+		})
+	}
+	desc := m.Sort.Direction != "asc"
+	slices.SortStableFunc(rows, func(a, b T) int {
+		c := compareSortValues(values[a.GetUrl()], values[b.GetUrl()])
+		if desc {
+			return -c
+		}
+		return c
+	})
+	GroupBySprint(m, rows)
+}
+
+// CycleSort sorts by the next of the sort keys and then back by GitHub's
+// order. It returns whether it's back to GitHub's order, the rows have to be
+// refetched to get it back.
+func (m *BaseModel) CycleSort() bool {
+	keys := sortKeys
+	if m.sortTemplate != nil {
+		keys = append(slices.Clone(sortKeys), "template")
+	}
+
+	i := slices.Index(keys, m.Sort.By)
+	switch {
+	case m.Sort.By == "" || i < 0:
+		m.Sort.By = keys[0]
+	case i+1 < len(keys):
+		m.Sort.By = keys[i+1]
+	default:
+		m.Sort.By = ""
+	}
+	clear(m.sortValues)
+	m.SearchBar.SetChip(m.chip())
+	return m.Sort.By == ""
+}
+
+// ReverseSort flips the direction of the sort, it returns whether there's a
+// sort to reverse
+func (m *BaseModel) ReverseSort() bool {
+	if m.Sort.By == "" {
+		return false
+	}
+	if m.Sort.Direction == "asc" {
+		m.Sort.Direction = "desc"
+	} else {
+		m.Sort.Direction = "asc"
+	}
+	m.SearchBar.SetChip(m.chip())
+	return true
+}
+
+// SortChip returns the label shown next to the search while the rows are
+// sorted, empty otherwise
+func (m *BaseModel) SortChip() string {
+	if m.Sort.By == "" {
+		return ""
+	}
+	arrow := "↓"
+	if m.Sort.Direction == "asc" {
+		arrow = "↑"
+	}
+	return "sorted by " + m.Sort.By + " " + arrow
+}
+
+// chip returns the labels shown next to the search
+func (m *BaseModel) chip() string {
+	return strings.Join(slices.DeleteFunc(
+		[]string{m.TimeSliceChip(), m.SortChip()},
+		func(s string) bool { return s == "" },
+	), " · ")
+}
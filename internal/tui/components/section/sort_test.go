@@ -0,0 +1,93 @@
+package section
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+func testIssue(number, comments, reactions int, created time.Time) data.IssueData {
+	issue := data.IssueData{
+		Number:    number,
+		Url:       fmt.Sprintf("https://github.com/dlvhdr/gh-dash/issues/%d", number),
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+	issue.Comments.TotalCount = comments
+	issue.Reactions.TotalCount = reactions
+	return issue
+}
+
+func TestSortRows(t *testing.T) {
+	day := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	issues := []data.IssueData{
+		testIssue(1, 5, 0, day),
+		testIssue(2, 1, 9, day.AddDate(0, 0, 2)),
+		testIssue(3, 5, 3, day.AddDate(0, 0, 1)),
+	}
+
+	tests := []struct {
+		name string
+		sort config.SortConfig
+		want []int
+	}{
+		{name: "no sort", sort: config.SortConfig{}, want: []int{1, 2, 3}},
+		{name: "created", sort: config.SortConfig{By: "created"}, want: []int{2, 3, 1}},
+		{name: "created ascending", sort: config.SortConfig{By: "created", Direction: "asc"}, want: []int{1, 3, 2}},
+		{name: "comments keep the order of ties", sort: config.SortConfig{By: "comments"}, want: []int{1, 3, 2}},
+		{name: "reactions", sort: config.SortConfig{By: "reactions"}, want: []int{2, 3, 1}},
+		{
+			name: "template",
+			sort: config.SortConfig{By: "template", Template: "{{ add .Comments .Reactions }}"},
+			want: []int{2, 3, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := BaseModel{
+				Sort:         tt.sort,
+				sortTemplate: parseSortTemplate(0, &tt.sort),
+				sortValues:   map[computedKey]sortValue{},
+			}
+			rows := slices.Clone(issues)
+			SortRows(&m, rows, data.IssueData.ColumnFields)
+
+			var got []int
+			for _, row := range rows {
+				got = append(got, row.Number)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SortRows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycleSort(t *testing.T) {
+	sort := config.SortConfig{Template: "{{ .Comments }}"}
+	m := BaseModel{sortTemplate: parseSortTemplate(0, &sort), sortValues: map[computedKey]sortValue{}}
+
+	for _, want := range append(slices.Clone(sortKeys), "template") {
+		if m.CycleSort() {
+			t.Fatalf("CycleSort() went back to GitHub's order before %s", want)
+		}
+		if m.Sort.By != want {
+			t.Fatalf("Sort.By = %q, want %q", m.Sort.By, want)
+		}
+	}
+	if !m.CycleSort() || m.Sort.By != "" {
+		t.Errorf("CycleSort() after the last key = %q, want GitHub's order", m.Sort.By)
+	}
+
+	if m.ReverseSort() {
+		t.Error("ReverseSort() without a sort reversed it")
+	}
+	m.CycleSort()
+	if !m.ReverseSort() || m.SortChip() != "sorted by updated ↑" {
+		t.Errorf("SortChip() after ReverseSort() = %q, want sorted by updated ↑", m.SortChip())
+	}
+}
@@ -0,0 +1,171 @@
+package section
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// SprintFilter restricts a section to the items of an iteration of the
+// sprint field, with a sprint: qualifier in its search. GitHub's search
+// doesn't know the qualifier, the fetched rows are filtered instead.
+type SprintFilter int
+
+const (
+	SprintAll SprintFilter = iota
+	SprintCurrent
+	SprintNext
+)
+
+// Qualifier returns the search qualifier of the filter, empty for SprintAll
+func (f SprintFilter) Qualifier() string {
+	switch f {
+	case SprintCurrent:
+		return "sprint:@current"
+	case SprintNext:
+		return "sprint:@next"
+	default:
+		return ""
+	}
+}
+
+// SplitSprintQualifier returns searchValue without its sprint: qualifiers,
+// and the filter of the last one
+func SplitSprintQualifier(searchValue string) (string, SprintFilter) {
+	var rest []string
+	filter := SprintAll
+	for token := range strings.FieldsSeq(searchValue) {
+		value, ok := strings.CutPrefix(token, "sprint:")
+		if !ok {
+			rest = append(rest, token)
+			continue
+		}
+		switch value {
+		case "@current":
+			filter = SprintCurrent
+		case "@next":
+			filter = SprintNext
+		default:
+			filter = SprintAll
+		}
+	}
+	return strings.Join(rest, " "), filter
+}
+
+// SprintFilter returns the filter of the sprint: qualifier of the search
+func (m *BaseModel) SprintFilter() SprintFilter {
+	_, filter := SplitSprintQualifier(m.SearchValue)
+	return filter
+}
+
+// ToggleCurrentSprint adds the sprint:@current qualifier to the search, or
+// removes the sprint: qualifier if there's one. It returns whether the
+// search changed, it never does when no sprint field is configured.
+func (m *BaseModel) ToggleCurrentSprint() bool {
+	if m.Ctx.Config.Sprint.Field == "" || !m.Config.IsGitHub() {
+		return false
+	}
+
+	rest, filter := SplitSprintQualifier(m.SearchValue)
+	if filter == SprintAll {
+		rest = strings.TrimSpace(rest + " " + SprintCurrent.Qualifier())
+	}
+	m.SearchValue = rest
+	return true
+}
+
+// fetchesSprints returns whether the rows need their sprint field, to filter
+// or group them
+func (m *BaseModel) fetchesSprints() bool {
+	if m.Ctx.Config.Sprint.Field == "" || !m.Config.IsGitHub() {
+		return false
+	}
+	return m.Config.GroupBySprint || m.SprintFilter() != SprintAll
+}
+
+// FetchIterations fetches the iterations of the sprint field when the rows
+// need it. It's nil otherwise, and the error is only returned when the rows
+// must be filtered by sprint, the rows are shown ungrouped otherwise.
+func (m *BaseModel) FetchIterations() (data.Iterations, error) {
+	if !m.fetchesSprints() {
+		return nil, nil
+	}
+
+	sprint := m.Ctx.Config.Sprint
+	project := sprint.Project
+	if project == "" {
+		project = data.ProjectFromSearch(m.GetFilters())
+	}
+	var err error
+	var iterations data.Iterations
+	if project == "" {
+		err = errors.New("no project is configured for the sprint field")
+	} else {
+		iterations, err = data.FetchIterations(project, sprint.Field)
+	}
+	if err != nil {
+		log.Error("Failed fetching iterations of the sprint field", "section", m.Id, "err", err)
+		if m.SprintFilter() == SprintAll {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return iterations, nil
+}
+
+// SprintOf returns the title of the iteration of the row with url
+func (m *BaseModel) SprintOf(fields map[string]data.ProjectFields, url string) string {
+	field := m.Ctx.Config.Sprint.Field
+	for name, value := range fields[url] {
+		if strings.EqualFold(name, field) {
+			return value
+		}
+	}
+	return ""
+}
+
+// FilterBySprint returns the rows in the iteration of the sprint: qualifier
+// of the search on the day of now, all of them without one
+func FilterBySprint[T data.RowData](
+	m *BaseModel,
+	rows []T,
+	fields map[string]data.ProjectFields,
+	iterations data.Iterations,
+	now time.Time,
+) []T {
+	var iteration data.Iteration
+	var ok bool
+	switch m.SprintFilter() {
+	case SprintCurrent:
+		iteration, ok = iterations.Current(now)
+	case SprintNext:
+		iteration, ok = iterations.Next(now)
+	default:
+		return rows
+	}
+	if !ok {
+		return rows[:0]
+	}
+	return slices.DeleteFunc(rows, func(row T) bool {
+		return m.SprintOf(fields, row.GetUrl()) != iteration.Title
+	})
+}
+
+// GroupBySprint orders the rows by the iterations of their sprint field, the
+// ones without one last, in sections grouped by sprint
+func GroupBySprint[T data.RowData](m *BaseModel, rows []T) {
+	if !m.Config.GroupBySprint || len(m.Iterations) == 0 {
+		return
+	}
+	slices.SortStableFunc(rows, func(a, b T) int {
+		return m.Iterations.CompareTitles(
+			m.SprintOf(m.ProjectFields, a.GetUrl()),
+			m.SprintOf(m.ProjectFields, b.GetUrl()),
+		)
+	})
+}
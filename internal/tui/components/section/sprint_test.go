@@ -0,0 +1,54 @@
+package section
+
+import "testing"
+
+func TestSplitSprintQualifier(t *testing.T) {
+	tests := []struct {
+		name        string
+		searchValue string
+		wantRest    string
+		wantFilter  SprintFilter
+	}{
+		{
+			name:        "no qualifier",
+			searchValue: "is:open author:@me",
+			wantRest:    "is:open author:@me",
+			wantFilter:  SprintAll,
+		},
+		{
+			name:        "current sprint",
+			searchValue: "is:open sprint:@current project:cli/1",
+			wantRest:    "is:open project:cli/1",
+			wantFilter:  SprintCurrent,
+		},
+		{
+			name:        "next sprint",
+			searchValue: "sprint:@next is:open",
+			wantRest:    "is:open",
+			wantFilter:  SprintNext,
+		},
+		{
+			name:        "last qualifier wins",
+			searchValue: "is:open sprint:@current sprint:@next",
+			wantRest:    "is:open",
+			wantFilter:  SprintNext,
+		},
+		{
+			name:        "unknown sprint",
+			searchValue: "is:open sprint:@previous",
+			wantRest:    "is:open",
+			wantFilter:  SprintAll,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rest, filter := SplitSprintQualifier(tt.searchValue)
+			if rest != tt.wantRest {
+				t.Errorf("SplitSprintQualifier() rest = %q, want %q", rest, tt.wantRest)
+			}
+			if filter != tt.wantFilter {
+				t.Errorf("SplitSprintQualifier() filter = %v, want %v", filter, tt.wantFilter)
+			}
+		})
+	}
+}
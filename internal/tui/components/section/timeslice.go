@@ -0,0 +1,141 @@
+package section
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// TimeSlice restricts a section to the items updated recently, by rewriting
+// the updated: and created: qualifiers of its search
+type TimeSlice int
+
+const (
+	TimeSliceAll TimeSlice = iota
+	TimeSliceToday
+	TimeSliceWeek
+	TimeSliceMonth
+)
+
+func (s TimeSlice) String() string {
+	switch s {
+	case TimeSliceToday:
+		return "today"
+	case TimeSliceWeek:
+		return "this week"
+	case TimeSliceMonth:
+		return "this month"
+	default:
+		return "all time"
+	}
+}
+
+// Qualifier returns the search qualifier of the slice on the day of now,
+// empty for TimeSliceAll
+func (s TimeSlice) Qualifier(now time.Time) string {
+	var duration string
+	switch s {
+	case TimeSliceToday:
+		duration = "0d"
+	case TimeSliceWeek:
+		duration = "-1w"
+	case TimeSliceMonth:
+		duration = "-1mo"
+	default:
+		return ""
+	}
+
+	date, err := utils.ModifyDate(now, duration)
+	if err != nil {
+		return ""
+	}
+	return "updated:>=" + date
+}
+
+func isTimeQualifier(token string) bool {
+	token = strings.TrimPrefix(token, "-")
+	return strings.HasPrefix(token, "updated:") || strings.HasPrefix(token, "created:")
+}
+
+// SplitTimeQualifiers returns searchValue without its updated: and created:
+// qualifiers, and the qualifiers. A qualifier whose value is a template, e.g.
+// updated:>={{ nowModify "-2w" }}, is kept whole.
+func SplitTimeQualifiers(searchValue string) (string, []string) {
+	var rest, qualifiers []string
+	tokens := strings.Fields(searchValue)
+	for i := 0; i < len(tokens); i++ {
+		if !isTimeQualifier(tokens[i]) {
+			rest = append(rest, tokens[i])
+			continue
+		}
+
+		qualifier := []string{tokens[i]}
+		if strings.Contains(tokens[i], "{{") {
+			for !strings.Contains(tokens[i], "}}") && i+1 < len(tokens) {
+				i++
+				qualifier = append(qualifier, tokens[i])
+			}
+		}
+		qualifiers = append(qualifiers, strings.Join(qualifier, " "))
+	}
+	return strings.Join(rest, " "), qualifiers
+}
+
+// SetTimeSlice rewrites the time qualifiers of the search to the one of
+// slice, the ones configured are restored when it's TimeSliceAll. It returns
+// whether the search changed, it never does in sections of other providers
+// than GitHub, their searches have another syntax.
+func (m *BaseModel) SetTimeSlice(slice TimeSlice) bool {
+	if slice == m.TimeSlice || !m.Config.IsGitHub() {
+		return false
+	}
+
+	rest, qualifiers := SplitTimeQualifiers(m.SearchValue)
+	if m.TimeSlice == TimeSliceAll {
+		m.timeQualifiers = qualifiers
+	}
+
+	m.TimeSlice = slice
+	m.timeSliceQualifier = slice.Qualifier(time.Now())
+	if slice == TimeSliceAll {
+		qualifiers = m.timeQualifiers
+		m.timeQualifiers = nil
+	} else {
+		qualifiers = []string{m.timeSliceQualifier}
+	}
+
+	m.SearchValue = strings.Join(slices.DeleteFunc(
+		append([]string{rest}, qualifiers...),
+		func(s string) bool { return s == "" },
+	), " ")
+	m.SearchBar.SetChip(m.chip())
+	return true
+}
+
+// TimeSliceChip returns the label shown next to the search while a time slice
+// is active, empty otherwise
+func (m *BaseModel) TimeSliceChip() string {
+	if m.TimeSlice == TimeSliceAll {
+		return ""
+	}
+	return "updated " + m.TimeSlice.String()
+}
+
+// SyncTimeSliceFromSearchValue clears the time slice when its qualifier was
+// edited out of the search
+func (m *BaseModel) SyncTimeSliceFromSearchValue() {
+	if m.TimeSlice == TimeSliceAll {
+		return
+	}
+
+	_, qualifiers := SplitTimeQualifiers(m.SearchValue)
+	if slices.Contains(qualifiers, m.timeSliceQualifier) {
+		return
+	}
+	m.TimeSlice = TimeSliceAll
+	m.timeSliceQualifier = ""
+	m.timeQualifiers = nil
+	m.SearchBar.SetChip(m.chip())
+}
@@ -0,0 +1,110 @@
+package section
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSplitTimeQualifiers(t *testing.T) {
+	tests := []struct {
+		name           string
+		searchValue    string
+		wantRest       string
+		wantQualifiers []string
+	}{
+		{
+			name:        "no qualifiers",
+			searchValue: "is:open author:@me",
+			wantRest:    "is:open author:@me",
+		},
+		{
+			name:           "updated and created",
+			searchValue:    "is:open updated:>=2024-01-01 created:<2024-02-01 -updated:2023-12-24",
+			wantRest:       "is:open",
+			wantQualifiers: []string{"updated:>=2024-01-01", "created:<2024-02-01", "-updated:2023-12-24"},
+		},
+		{
+			name:           "template",
+			searchValue:    `is:open updated:>={{ nowModify "-2w" }} sort:updated-desc`,
+			wantRest:       "is:open sort:updated-desc",
+			wantQualifiers: []string{`updated:>={{ nowModify "-2w" }}`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rest, qualifiers := SplitTimeQualifiers(tt.searchValue)
+			if rest != tt.wantRest {
+				t.Errorf("SplitTimeQualifiers() rest = %q, want %q", rest, tt.wantRest)
+			}
+			if !slices.Equal(qualifiers, tt.wantQualifiers) {
+				t.Errorf("SplitTimeQualifiers() qualifiers = %q, want %q", qualifiers, tt.wantQualifiers)
+			}
+		})
+	}
+}
+
+func TestTimeSliceQualifier(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		slice TimeSlice
+		want  string
+	}{
+		{slice: TimeSliceAll, want: ""},
+		{slice: TimeSliceToday, want: "updated:>=2024-03-10"},
+		{slice: TimeSliceWeek, want: "updated:>=2024-03-03"},
+		{slice: TimeSliceMonth, want: "updated:>=2024-02-09"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.slice.String(), func(t *testing.T) {
+			if got := tt.slice.Qualifier(now); got != tt.want {
+				t.Errorf("Qualifier() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTimeSlice(t *testing.T) {
+	m := BaseModel{SearchValue: "is:open created:>=2024-01-01"}
+
+	if !m.SetTimeSlice(TimeSliceWeek) {
+		t.Fatal("SetTimeSlice(TimeSliceWeek) didn't change the search")
+	}
+	want := "is:open " + TimeSliceWeek.Qualifier(time.Now())
+	if m.SearchValue != want {
+		t.Errorf("SearchValue = %q, want %q", m.SearchValue, want)
+	}
+	if m.TimeSliceChip() != "updated this week" {
+		t.Errorf("TimeSliceChip() = %q, want %q", m.TimeSliceChip(), "updated this week")
+	}
+
+	m.SetTimeSlice(TimeSliceToday)
+	want = "is:open " + TimeSliceToday.Qualifier(time.Now())
+	if m.SearchValue != want {
+		t.Errorf("SearchValue = %q, want %q", m.SearchValue, want)
+	}
+
+	m.SetTimeSlice(TimeSliceAll)
+	if m.SearchValue != "is:open created:>=2024-01-01" {
+		t.Errorf("SearchValue = %q, want the configured qualifiers back", m.SearchValue)
+	}
+	if m.TimeSliceChip() != "" {
+		t.Errorf("TimeSliceChip() = %q, want none", m.TimeSliceChip())
+	}
+}
+
+func TestSyncTimeSliceFromSearchValue(t *testing.T) {
+	m := BaseModel{SearchValue: "is:open"}
+	m.SetTimeSlice(TimeSliceMonth)
+
+	m.SyncTimeSliceFromSearchValue()
+	if m.TimeSlice != TimeSliceMonth {
+		t.Errorf("TimeSlice = %v, want it kept while its qualifier is searched", m.TimeSlice)
+	}
+
+	m.SearchValue = "is:open updated:>=2020-01-01"
+	m.SyncTimeSliceFromSearchValue()
+	if m.TimeSlice != TimeSliceAll {
+		t.Errorf("TimeSlice = %v, want it cleared once its qualifier is edited out", m.TimeSlice)
+	}
+}
@@ -0,0 +1,614 @@
+package sectioneditor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/itemform"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// previewLimit is how many rows the preview of a draft lists
+const previewLimit = 5
+
+// field is an input of the form editing a section
+type field int
+
+const (
+	fieldTitle field = iota
+	fieldType
+	fieldFilters
+	fieldLimit
+	fieldHidden
+)
+
+var fields = []field{fieldTitle, fieldType, fieldFilters, fieldLimit, fieldHidden}
+
+func (f field) label() string {
+	switch f {
+	case fieldTitle:
+		return "Title"
+	case fieldType:
+		return "Type"
+	case fieldFilters:
+		return "Filters"
+	case fieldLimit:
+		return "Limit"
+	default:
+		return "Hidden columns"
+	}
+}
+
+func (f field) placeholder() string {
+	switch f {
+	case fieldType:
+		return "prs or issues"
+	case fieldFilters:
+		return "is:open author:@me"
+	case fieldLimit:
+		return "the default limit"
+	case fieldHidden:
+		return "repo, updatedAt"
+	default:
+		return ""
+	}
+}
+
+// KeyMap defines keybindings for the editor
+type KeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	MoveUp   key.Binding
+	MoveDown key.Binding
+	New      key.Binding
+	Edit     key.Binding
+	Delete   key.Binding
+	Next     key.Binding
+	Prev     key.Binding
+	Preview  key.Binding
+	Save     key.Binding
+	Cancel   key.Binding
+}
+
+var Keys = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	MoveUp: key.NewBinding(
+		key.WithKeys("shift+up", "K"),
+		key.WithHelp("K", "move up"),
+	),
+	MoveDown: key.NewBinding(
+		key.WithKeys("shift+down", "J"),
+		key.WithHelp("J", "move down"),
+	),
+	New: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "new"),
+	),
+	Edit: key.NewBinding(
+		key.WithKeys("enter", "e"),
+		key.WithHelp("enter", "edit"),
+	),
+	Delete: key.NewBinding(
+		key.WithKeys("D"),
+		key.WithHelp("D", "delete"),
+	),
+	Next: key.NewBinding(
+		key.WithKeys("tab", "down"),
+		key.WithHelp("tab", "next"),
+	),
+	Prev: key.NewBinding(
+		key.WithKeys("shift+tab", "up"),
+		key.WithHelp("shift+tab", "back"),
+	),
+	Preview: key.NewBinding(
+		key.WithKeys("ctrl+p"),
+		key.WithHelp("ctrl+p", "preview"),
+	),
+	Save: key.NewBinding(
+		key.WithKeys("ctrl+s", "enter"),
+		key.WithHelp("ctrl+s", "save"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// SavedMsg is sent when a section is created or edited, Index is -1 for a
+// new one
+type SavedMsg struct {
+	View  config.ViewType
+	Index int
+	Draft config.SectionDraft
+}
+
+// DeletedMsg is sent when the section of View at Index is deleted
+type DeletedMsg struct {
+	View  config.ViewType
+	Index int
+}
+
+// MovedMsg is sent when the section of View at From is moved to To
+type MovedMsg struct {
+	View     config.ViewType
+	From, To int
+}
+
+// PreviewFetchedMsg is sent once the rows of a draft were fetched
+type PreviewFetchedMsg struct {
+	id     int
+	Total  int
+	Titles []string
+	Err    error
+}
+
+// Model is an overlay listing the PR or issues sections of the config, to
+// create, edit, reorder and delete them. The changes are sent as messages,
+// the dashboard writes them to the config file.
+type Model struct {
+	ctx      *context.ProgramContext
+	view     config.ViewType
+	sections []config.SectionDraft
+	cursor   int
+	focused  bool
+	width    int
+	// confirmDelete is set when the section under the cursor is deleted
+	// once confirmed
+	confirmDelete bool
+
+	// the form, editing is the index of the edited section, -1 for a new
+	// one
+	isEditing bool
+	editing   int
+	curr      int
+	inputs    map[field]textinput.Model
+	draft     config.SectionDraft
+	err       string
+
+	previewId      int
+	isPreviewing   bool
+	previewTotal   int
+	previewTitles  []string
+	previewErr     error
+	previewFetched bool
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:   ctx,
+		width: 80,
+	}
+}
+
+// Open lists sections, the ones of view
+func (m *Model) Open(view config.ViewType, sections []config.SectionDraft, cursor int) {
+	m.view = view
+	m.focused = true
+	m.isEditing = false
+	m.confirmDelete = false
+	m.err = ""
+	m.SetSections(sections, cursor)
+}
+
+// SetSections lists sections once the config was written, the cursor moves
+// to the section at cursor
+func (m *Model) SetSections(sections []config.SectionDraft, cursor int) {
+	m.sections = sections
+	m.cursor = max(0, min(cursor, len(sections)-1))
+}
+
+// SetError shows why the last change failed
+func (m *Model) SetError(err error) {
+	m.err = ""
+	if err != nil {
+		m.err = err.Error()
+	}
+}
+
+func (m *Model) Close() {
+	m.focused = false
+	m.isEditing = false
+	for f, ti := range m.inputs {
+		ti.Blur()
+		m.inputs[f] = ti
+	}
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m *Model) SetWidth(w int) {
+	m.width = w
+	for f, ti := range m.inputs {
+		ti.Width = w - 10
+		m.inputs[f] = ti
+	}
+}
+
+// edit opens the form with the section at index, or an empty one when index
+// is -1
+func (m *Model) edit(index int) tea.Cmd {
+	m.isEditing = true
+	m.editing = index
+	m.curr = 0
+	m.err = ""
+	m.resetPreview()
+
+	m.draft = config.SectionDraft{View: m.view}
+	if index >= 0 {
+		m.draft = m.sections[index]
+	}
+	limit := ""
+	if m.draft.Limit != nil {
+		limit = strconv.Itoa(*m.draft.Limit)
+	}
+	values := map[field]string{
+		fieldTitle:   m.draft.Title,
+		fieldType:    string(m.draft.View),
+		fieldFilters: m.draft.Filters,
+		fieldLimit:   limit,
+		fieldHidden:  strings.Join(m.draft.Hidden, ", "),
+	}
+
+	m.inputs = make(map[field]textinput.Model, len(fields))
+	for _, f := range fields {
+		ti := textinput.New()
+		ti.Prompt = "> "
+		ti.Placeholder = f.placeholder()
+		ti.Width = m.width - 10
+		ti.SetValue(values[f])
+		m.inputs[f] = ti
+	}
+	return m.focusField()
+}
+
+func (m *Model) focusField() tea.Cmd {
+	var cmd tea.Cmd
+	for f, ti := range m.inputs {
+		if f == fields[m.curr] {
+			cmd = ti.Focus()
+		} else {
+			ti.Blur()
+		}
+		m.inputs[f] = ti
+	}
+	return cmd
+}
+
+func (m Model) value(f field) string {
+	return strings.TrimSpace(m.inputs[f].Value())
+}
+
+// Draft returns the section described by the form, the settings the form
+// doesn't cover are the edited section's
+func (m Model) Draft() (config.SectionDraft, error) {
+	draft := m.draft
+	draft.Title = m.value(fieldTitle)
+	draft.Filters = m.value(fieldFilters)
+	draft.Hidden = itemform.SplitList(m.value(fieldHidden))
+	draft.Limit = nil
+	if limit := m.value(fieldLimit); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			return draft, fmt.Errorf("the limit should be a number, not %q", limit)
+		}
+		draft.Limit = &n
+	}
+	view, err := config.ParseViewType(m.value(fieldType))
+	if err != nil {
+		return draft, err
+	}
+	draft.View = view
+	return draft, draft.Validate()
+}
+
+func (m *Model) resetPreview() {
+	m.previewId++
+	m.isPreviewing = false
+	m.previewFetched = false
+	m.previewTotal = 0
+	m.previewTitles = nil
+	m.previewErr = nil
+}
+
+// preview fetches the first rows of the draft, as its section would
+func (m *Model) preview() tea.Cmd {
+	draft, err := m.Draft()
+	if err != nil {
+		m.err = err.Error()
+		return nil
+	}
+	m.err = ""
+	m.resetPreview()
+	m.isPreviewing = true
+
+	id := m.previewId
+	vars := m.ctx.SearchVars()
+	sectionCfg := config.SectionConfig{Provider: draft.Provider, Host: draft.Host}
+	host := m.ctx.Config.SectionHost(sectionCfg)
+	prSize := m.ctx.Config.PrSize
+	cfg := *m.ctx.Config
+	return func() tea.Msg {
+		filters, _, sizes := section.SearchFilters(&cfg, sectionCfg, true,
+			utils.ExpandSearchTemplateWithVars(draft.Filters, vars))
+		provider, err := data.GetProvider(draft.Provider, host)
+		if err != nil {
+			return PreviewFetchedMsg{id: id, Err: err}
+		}
+		msg := PreviewFetchedMsg{id: id}
+		if draft.View == config.PRsView {
+			res, err := provider.FetchPullRequests(filters, previewLimit, nil)
+			msg.Total, msg.Err = res.TotalCount, err
+			fetched := len(res.Prs)
+			res.Prs = sizes.Filter(res.Prs, prSize)
+			msg.Total -= fetched - len(res.Prs)
+			for _, pr := range res.Prs {
+				msg.Titles = append(msg.Titles, fmt.Sprintf("#%d %s", pr.Number, pr.Title))
+			}
+			return msg
+		}
+		res, err := provider.FetchIssues(filters, previewLimit, nil)
+		msg.Total, msg.Err = res.TotalCount, err
+		for _, issue := range res.Issues {
+			msg.Titles = append(msg.Titles, fmt.Sprintf("#%d %s", issue.Number, issue.Title))
+		}
+		return msg
+	}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(PreviewFetchedMsg); ok {
+		if msg.id != m.previewId {
+			return m, nil
+		}
+		m.isPreviewing = false
+		m.previewFetched = true
+		m.previewTotal, m.previewTitles, m.previewErr = msg.Total, msg.Titles, msg.Err
+		return m, nil
+	}
+
+	if !m.focused {
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	if m.isEditing {
+		return m.updateForm(keyMsg)
+	}
+	return m.updateList(keyMsg)
+}
+
+func (m Model) updateList(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.confirmDelete {
+		m.confirmDelete = false
+		if msg.String() != "y" || len(m.sections) == 0 {
+			return m, nil
+		}
+		deleted := DeletedMsg{View: m.view, Index: m.cursor}
+		return m, func() tea.Msg { return deleted }
+	}
+
+	switch {
+	case key.Matches(msg, Keys.Cancel):
+		m.Close()
+	case key.Matches(msg, Keys.Up):
+		m.cursor = max(0, m.cursor-1)
+	case key.Matches(msg, Keys.Down):
+		m.cursor = max(0, min(m.cursor+1, len(m.sections)-1))
+	case key.Matches(msg, Keys.MoveUp), key.Matches(msg, Keys.MoveDown):
+		to := m.cursor - 1
+		if key.Matches(msg, Keys.MoveDown) {
+			to = m.cursor + 1
+		}
+		if to < 0 || to >= len(m.sections) {
+			return m, nil
+		}
+		moved := MovedMsg{View: m.view, From: m.cursor, To: to}
+		return m, func() tea.Msg { return moved }
+	case key.Matches(msg, Keys.New):
+		return m, m.edit(-1)
+	case key.Matches(msg, Keys.Edit):
+		if len(m.sections) > 0 {
+			return m, m.edit(m.cursor)
+		}
+	case key.Matches(msg, Keys.Delete):
+		m.confirmDelete = len(m.sections) > 0
+	}
+	return m, nil
+}
+
+func (m Model) updateForm(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, Keys.Cancel):
+		m.isEditing = false
+		m.err = ""
+		return m, nil
+	case key.Matches(msg, Keys.Preview):
+		return m, m.preview()
+	case key.Matches(msg, Keys.Save):
+		draft, err := m.Draft()
+		if err != nil {
+			m.err = err.Error()
+			return m, nil
+		}
+		m.isEditing = false
+		m.err = ""
+		saved := SavedMsg{View: m.view, Index: m.editing, Draft: draft}
+		return m, func() tea.Msg { return saved }
+	case key.Matches(msg, Keys.Next):
+		m.curr = (m.curr + 1) % len(fields)
+		return m, m.focusField()
+	case key.Matches(msg, Keys.Prev):
+		m.curr = (m.curr + len(fields) - 1) % len(fields)
+		return m, m.focusField()
+	}
+
+	f := fields[m.curr]
+	var cmd tea.Cmd
+	m.inputs[f], cmd = m.inputs[f].Update(msg)
+	return m, cmd
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	content := m.viewList()
+	if m.isEditing {
+		content = m.viewForm()
+	}
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width)
+
+	return boxStyle.Render(content)
+}
+
+func (m Model) viewList() string {
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText).
+		MarginBottom(1)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Bold(true)
+
+	kind := "PR"
+	if m.view == config.IssuesView {
+		kind = "Issues"
+	}
+	b.WriteString(titleStyle.Render(fmt.Sprintf("%s sections", kind)))
+	b.WriteString("\n\n")
+
+	// the box has a padding of 2 on both sides
+	lineWidth := m.width - 4
+	if len(m.sections) == 0 {
+		b.WriteString(faintStyle.Render("  No sections yet"))
+		b.WriteString("\n")
+	}
+	for i, s := range m.sections {
+		cursor, style := "  ", faintStyle
+		if i == m.cursor {
+			cursor, style = "> ", selectedStyle
+		}
+		line := style.Render(fmt.Sprintf("%s%d. %s", cursor, i+1, s.Title)) +
+			faintStyle.Italic(true).Render("  "+s.Filters)
+		b.WriteString(ansi.Truncate(line, lineWidth, constants.Ellipsis))
+		b.WriteString("\n")
+	}
+
+	b.WriteString(m.viewError())
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	help := "n: new • enter: edit • J/K: move • D: delete • esc: close"
+	if m.confirmDelete && len(m.sections) > 0 {
+		help = fmt.Sprintf("Delete %s? y to confirm", m.sections[m.cursor].Title)
+		helpStyle = lipgloss.NewStyle().Foreground(m.ctx.Theme.WarningText)
+	}
+	b.WriteString(helpStyle.Render(help))
+	return b.String()
+}
+
+func (m Model) viewForm() string {
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	labelStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText)
+
+	heading := "New section"
+	if m.editing >= 0 {
+		heading = fmt.Sprintf("Edit %s", m.draft.Title)
+	}
+	b.WriteString(titleStyle.Render(heading))
+	b.WriteString("\n\n")
+
+	for i, f := range fields {
+		if i == m.curr {
+			b.WriteString(titleStyle.Render(f.label()))
+		} else {
+			b.WriteString(labelStyle.Render(f.label()))
+		}
+		b.WriteString("\n")
+		b.WriteString(m.inputs[f].View())
+		b.WriteString("\n")
+	}
+	if fields[m.curr] == fieldHidden {
+		b.WriteString(faintStyle.Render(ansi.Wrap(
+			strings.Join(config.LayoutColumns(config.ViewType(m.value(fieldType))), ", "), m.width-6, "")))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.viewPreview())
+	b.WriteString(m.viewError())
+	b.WriteString("\n")
+	helpStyle := lipgloss.NewStyle().Faint(true)
+	b.WriteString(helpStyle.Render("tab: next • ctrl+p: preview • enter: save • esc: back"))
+	return b.String()
+}
+
+func (m Model) viewPreview() string {
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	lineWidth := m.width - 4
+	switch {
+	case m.isPreviewing:
+		return faintStyle.Render("Fetching the preview...") + "\n"
+	case !m.previewFetched:
+		return ""
+	case m.previewErr != nil:
+		return lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(
+			ansi.Truncate(fmt.Sprintf("Failed fetching the preview: %v", m.previewErr), lineWidth, constants.Ellipsis)) + "\n"
+	}
+
+	var b strings.Builder
+	b.WriteString(lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText).Render(
+		fmt.Sprintf("%d matching", m.previewTotal)))
+	b.WriteString("\n")
+	for _, title := range m.previewTitles {
+		b.WriteString(ansi.Truncate(faintStyle.Render("  "+title), lineWidth, constants.Ellipsis))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func (m Model) viewError() string {
+	if m.err == "" {
+		return ""
+	}
+	return "\n" + lipgloss.NewStyle().Foreground(m.ctx.Theme.ErrorText).Render(
+		ansi.Wrap(m.err, m.width-6, "")) + "\n"
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
@@ -0,0 +1,72 @@
+package sectioneditor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+func TestDraft(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[field]string
+		want    config.SectionDraft
+		wantErr bool
+	}{
+		{
+			name: "edited section",
+			values: map[field]string{
+				fieldTitle:   " Bugs ",
+				fieldType:    "Issues",
+				fieldFilters: "label:bug",
+				fieldLimit:   "15",
+				fieldHidden:  "creator, , repo",
+			},
+			want: config.SectionDraft{
+				View:     config.IssuesView,
+				Title:    "Bugs",
+				Filters:  "label:bug",
+				Limit:    utils.IntPtr(15),
+				Hidden:   []string{"creator", "repo"},
+				Provider: "gitlab",
+			},
+		},
+		{
+			name:    "limit isn't a number",
+			values:  map[field]string{fieldTitle: "Bugs", fieldType: "prs", fieldFilters: "label:bug", fieldLimit: "ten"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			values:  map[field]string{fieldTitle: "Bugs", fieldType: "bugs", fieldFilters: "label:bug"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewModel(&context.ProgramContext{})
+			m.Open(config.PRsView, []config.SectionDraft{
+				{View: config.PRsView, Title: "Mine", Filters: "author:@me", Provider: "gitlab"},
+			}, 0)
+			m.edit(0)
+			for f, value := range tt.values {
+				ti := m.inputs[f]
+				ti.SetValue(value)
+				m.inputs[f] = ti
+			}
+
+			got, err := m.Draft()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
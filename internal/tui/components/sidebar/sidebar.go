@@ -12,6 +12,9 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 )
 
+// ZoneId marks the sidebar in the view, to scroll it with the mouse
+const ZoneId = "sidebar"
+
 type Model struct {
 	IsOpen     bool
 	data       string
@@ -36,11 +39,12 @@ func NewModel() Model {
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		keyMap := keys.UseView(m.ctx.Keys, m.ctx.View)
 		switch {
-		case key.Matches(msg, keys.Keys.PageDown):
+		case key.Matches(msg, keyMap.PageDown):
 			m.viewport.HalfPageDown()
 
-		case key.Matches(msg, keys.Keys.PageUp):
+		case key.Matches(msg, keyMap.PageUp):
 			m.viewport.HalfPageUp()
 		}
 	}
@@ -65,12 +69,12 @@ func (m Model) View() string {
 		)
 	}
 
-	return style.Render(lipgloss.JoinVertical(
+	return m.ctx.Zone.Mark(ZoneId, style.Render(lipgloss.JoinVertical(
 		lipgloss.Top,
 		m.viewport.View(),
 		m.ctx.Styles.Sidebar.PagerStyle.
 			Render(fmt.Sprintf("%d%%", int(m.viewport.ScrollPercent()*100))),
-	))
+	)))
 }
 
 func (m *Model) SetContent(data string) {
@@ -93,6 +97,21 @@ func (m *Model) ScrollToBottom() {
 	m.viewport.GotoBottom()
 }
 
+// ScrollUp scrolls the content up by n lines
+func (m *Model) ScrollUp(n int) {
+	m.viewport.ScrollUp(n)
+}
+
+// ScrollDown scrolls the content down by n lines
+func (m *Model) ScrollDown(n int) {
+	m.viewport.ScrollDown(n)
+}
+
+// ScrollTo scrolls the content so that line is at the top
+func (m *Model) ScrollTo(line int) {
+	m.viewport.SetYOffset(line)
+}
+
 func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
 	if ctx == nil {
 		return
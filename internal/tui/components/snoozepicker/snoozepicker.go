@@ -0,0 +1,164 @@
+package snoozepicker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// KeyMap defines keybindings for the picker
+type KeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Cancel key.Binding
+}
+
+var Keys = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Select: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "snooze"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// SnoozedMsg is sent when an option is picked for Row
+type SnoozedMsg struct {
+	Row    data.RowData
+	Option state.SnoozeOption
+}
+
+// Model is an overlay listing how long the row can be snoozed for
+type Model struct {
+	ctx     *context.ProgramContext
+	row     data.RowData
+	cursor  int
+	focused bool
+	width   int
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:   ctx,
+		width: 50,
+	}
+}
+
+// Open lists the snooze options of row
+func (m *Model) Open(row data.RowData) {
+	m.row = row
+	m.cursor = 0
+	m.focused = true
+}
+
+func (m *Model) Close() {
+	m.focused = false
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, Keys.Cancel):
+		m.Close()
+	case key.Matches(keyMsg, Keys.Up):
+		m.cursor = max(0, m.cursor-1)
+	case key.Matches(keyMsg, Keys.Down):
+		m.cursor = min(m.cursor+1, len(state.SnoozeOptions)-1)
+	case key.Matches(keyMsg, Keys.Select):
+		m.Close()
+		snoozed := SnoozedMsg{Row: m.row, Option: state.SnoozeOptions[m.cursor]}
+		return m, func() tea.Msg { return snoozed }
+	}
+	return m, nil
+}
+
+// describe returns when an item snoozed for option as of now wakes up
+func describe(option state.SnoozeOption, now time.Time) string {
+	until := option.Until(now)
+	switch {
+	case until.IsZero():
+		return "on its next update"
+	case option == state.SnoozeHour:
+		return "at " + until.Format(time.Kitchen)
+	}
+	return "on " + until.Format("Mon, Jan 2")
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Bold(true)
+
+	b.WriteString(titleStyle.Render("Snooze"))
+	b.WriteString("\n")
+	title := fmt.Sprintf("#%d %s", m.row.GetNumber(), m.row.GetTitle())
+	b.WriteString(faintStyle.Render(ansi.Truncate(title, max(0, m.width-6), "…")))
+	b.WriteString("\n\n")
+	now := time.Now()
+	for i, option := range state.SnoozeOptions {
+		cursor, style := "  ", faintStyle
+		if i == m.cursor {
+			cursor, style = "> ", selectedStyle
+		}
+		b.WriteString(style.Render(cursor + string(option)))
+		b.WriteString("  ")
+		b.WriteString(faintStyle.Render("wakes up " + describe(option, now)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render("enter: snooze • esc: close"))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width)
+	return boxStyle.Render(b.String())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
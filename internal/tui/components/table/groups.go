@@ -0,0 +1,169 @@
+package table
+
+import "fmt"
+
+// item is a line of the table, a row or the header of a group of rows
+type item struct {
+	row int
+	// group is the title of the group of the item, empty when the rows
+	// aren't grouped
+	group  string
+	header bool
+	// count is the number of rows of the group of a header
+	count int
+}
+
+// SetGroupedRows sets the rows under the headers of their groups, groups
+// holding the title of the group of each row. Groups are shown in the order
+// of their first row, and rows keep their order within their group. The
+// rows aren't grouped when groups is nil.
+func (m *Model) SetGroupedRows(rows []Row, groups []string) {
+	m.groups = groups
+	m.SetRows(rows)
+}
+
+// IsGrouped returns whether the rows are shown under the headers of their
+// groups
+func (m *Model) IsGrouped() bool {
+	return m.groups != nil
+}
+
+// OnGroupHeader returns whether the current line is the header of a group
+func (m *Model) OnGroupHeader() bool {
+	curr := m.rowsViewport.GetCurrItem()
+	return curr < len(m.items) && m.items[curr].header
+}
+
+// ToggleGroup folds the group of the current line to its header, or unfolds
+// it when it's folded
+func (m *Model) ToggleGroup() {
+	curr := m.rowsViewport.GetCurrItem()
+	if !m.IsGrouped() || curr >= len(m.items) {
+		return
+	}
+	group := m.items[curr].group
+	m.folded[group] = !m.folded[group]
+	m.layoutItems()
+	m.selectHeader(group)
+}
+
+// ToggleAllGroups folds every group when one is unfolded, and unfolds them
+// all otherwise
+func (m *Model) ToggleAllGroups() {
+	if !m.IsGrouped() || len(m.items) == 0 {
+		return
+	}
+	fold := false
+	for _, it := range m.items {
+		if it.header && !m.folded[it.group] {
+			fold = true
+			break
+		}
+	}
+	group := m.items[m.rowsViewport.GetCurrItem()].group
+	for _, it := range m.items {
+		if it.header {
+			m.folded[it.group] = fold
+		}
+	}
+	m.layoutItems()
+	m.selectHeader(group)
+}
+
+// selectHeader moves the selection to the header of group
+func (m *Model) selectHeader(group string) {
+	for i, it := range m.items {
+		if it.header && it.group == group {
+			m.rowsViewport.SelectItem(i)
+			break
+		}
+	}
+	m.SyncViewPortContent()
+}
+
+// layoutItems lays the rows out under the headers of their groups, without
+// the rows of the folded groups
+func (m *Model) layoutItems() {
+	m.items = m.items[:0]
+	if m.groups == nil {
+		for i := range m.Rows {
+			m.items = append(m.items, item{row: i})
+		}
+		m.rowsViewport.SetNumItems(len(m.items))
+		return
+	}
+
+	var order []string
+	members := map[string][]int{}
+	for i := range m.Rows {
+		group := ""
+		if i < len(m.groups) {
+			group = m.groups[i]
+		}
+		if _, ok := members[group]; !ok {
+			order = append(order, group)
+		}
+		members[group] = append(members[group], i)
+	}
+	for _, group := range order {
+		rows := members[group]
+		m.items = append(m.items, item{row: rows[0], group: group, header: true, count: len(rows)})
+		if m.folded[group] {
+			continue
+		}
+		for _, row := range rows {
+			m.items = append(m.items, item{row: row, group: group})
+		}
+	}
+	m.rowsViewport.SetNumItems(len(m.items))
+}
+
+// itemOfRow returns the line of row, the header of its group when it's
+// folded
+func (m *Model) itemOfRow(row int) int {
+	header := -1
+	for i, it := range m.items {
+		if it.row != row {
+			continue
+		}
+		if !it.header {
+			return i
+		}
+		header = i
+	}
+	if header < 0 {
+		return row
+	}
+	return header
+}
+
+func (m *Model) renderGroupHeader(it item, isCurr bool) string {
+	style := m.ctx.Styles.Table.CellStyle
+	if isCurr {
+		style = m.ctx.Styles.Table.SelectedCellStyle
+	}
+	height := 1
+	if !m.ctx.Config.Theme.Ui.Table.Compact {
+		height = 2
+	}
+
+	icon := "▾"
+	if m.folded[it.group] {
+		icon = "▸"
+	}
+	title := it.group
+	if title == "" {
+		title = "Other"
+	}
+	return m.ctx.Styles.Table.RowStyle.
+		BorderBottom(m.ctx.Config.Theme.Ui.Table.ShowSeparator).
+		MaxWidth(m.dimensions.Width).
+		Render(style.
+			Bold(true).
+			Foreground(m.ctx.Theme.SecondaryText).
+			Width(m.dimensions.Width).
+			MaxWidth(m.dimensions.Width).
+			Height(height).
+			MaxHeight(height).
+			Render(fmt.Sprintf("%s %s (%d)", icon, title, it.count)))
+}
@@ -2,6 +2,8 @@ package table
 
 import (
 	"fmt"
+	"slices"
+	"sync/atomic"
 	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
@@ -25,18 +27,46 @@ type Model struct {
 	loadingSpinner spinner.Model
 	dimensions     constants.Dimensions
 	rowsViewport   listviewport.Model
+	// selected are the indexes of the rows selected for a bulk action
+	selected map[int]bool
+	// selectionAnchor is the row the selection was last toggled on, a range
+	// is selected from it. It's -1 when there's none.
+	selectionAnchor int
+	// groups are the titles of the groups of the rows, nil when the rows
+	// aren't grouped
+	groups []string
+	// folded are the titles of the groups whose rows are hidden, they stay
+	// folded when the rows are set again
+	folded map[string]bool
+	// items are the lines shown, the rows and the headers of their groups.
+	// The viewport's items are the lines, not the rows.
+	items []item
+	// zoneId marks the rows in the view, to find the row under the mouse
+	zoneId string
 }
 
+// numTables numbers the tables to give each one its own zone
+var numTables atomic.Int64
+
 type Column struct {
 	Title         string
 	Hidden        *bool
 	Width         *int
 	ComputedWidth int
 	Grow          *bool
+	// Align is the alignment of the column's title and cells, left by default
+	Align lipgloss.Position
 }
 
 type Row []string
 
+// Cell is the value of a cell rendered with its own color, like the ones of
+// computed columns. The row's text color is used when Color is empty.
+type Cell struct {
+	Value string
+	Color string
+}
+
 func NewModel(
 	ctx context.ProgramContext,
 	dimensions constants.Dimensions,
@@ -61,15 +91,19 @@ func NewModel(
 	loadingSpinner.Spinner = spinner.Dot
 	loadingSpinner.Style = lipgloss.NewStyle().Foreground(ctx.Theme.SecondaryText)
 
-	return Model{
-		ctx:            ctx,
-		Columns:        columns,
-		Rows:           rows,
-		EmptyState:     emptyState,
-		loadingMessage: loadingMessage,
-		isLoading:      isLoading,
-		loadingSpinner: loadingSpinner,
-		dimensions:     dimensions,
+	m := Model{
+		ctx:             ctx,
+		Columns:         columns,
+		Rows:            rows,
+		EmptyState:      emptyState,
+		loadingMessage:  loadingMessage,
+		isLoading:       isLoading,
+		loadingSpinner:  loadingSpinner,
+		dimensions:      dimensions,
+		selected:        map[int]bool{},
+		selectionAnchor: -1,
+		folded:          map[string]bool{},
+		zoneId:          fmt.Sprintf("table_%d", numTables.Add(1)),
 		rowsViewport: listviewport.NewModel(
 			ctx,
 			dimensions,
@@ -80,6 +114,8 @@ func NewModel(
 			itemHeight,
 		),
 	}
+	m.layoutItems()
+	return m
 }
 
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
@@ -113,40 +149,138 @@ func (m *Model) SetDimensions(dimensions constants.Dimensions) {
 	})
 }
 
+func (m *Model) GetDimensions() constants.Dimensions {
+	return m.dimensions
+}
+
 func (m *Model) ResetCurrItem() {
 	m.rowsViewport.ResetCurrItem()
 }
 
+// GetCurrItem returns the index of the current row, the first row of the
+// group when the current line is a group's header
 func (m *Model) GetCurrItem() int {
-	return m.rowsViewport.GetCurrItem()
+	curr := m.rowsViewport.GetCurrItem()
+	if curr >= len(m.items) {
+		return curr
+	}
+	return m.items[curr].row
 }
 
 func (m *Model) PrevItem() int {
-	currItem := m.rowsViewport.PrevItem()
+	m.rowsViewport.PrevItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) NextItem() int {
-	currItem := m.rowsViewport.NextItem()
+	m.rowsViewport.NextItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) FirstItem() int {
-	currItem := m.rowsViewport.FirstItem()
+	m.rowsViewport.FirstItem()
 	m.SyncViewPortContent()
 
-	return currItem
+	return m.GetCurrItem()
 }
 
 func (m *Model) LastItem() int {
-	currItem := m.rowsViewport.LastItem()
+	m.rowsViewport.LastItem()
+	m.SyncViewPortContent()
+
+	return m.GetCurrItem()
+}
+
+// SelectItem moves the selection to row i, or to the header of its group
+// when it's folded
+func (m *Model) SelectItem(i int) int {
+	m.rowsViewport.SelectItem(m.itemOfRow(i))
+	m.SyncViewPortContent()
+
+	return m.GetCurrItem()
+}
+
+// ToggleSelection selects the current row for a bulk action, or unselects it
+// RowAt returns the index of the row under the mouse, and false when it's
+// not over one
+func (m *Model) RowAt(msg tea.MouseMsg) (int, bool) {
+	if m.isLoading || len(m.Rows) == 0 {
+		return 0, false
+	}
+	_, y := m.ctx.Zone.Get(m.zoneId).Pos(msg)
+	if y < 0 {
+		return 0, false
+	}
+	i, ok := m.rowsViewport.ItemAt(y)
+	if !ok || i >= len(m.items) || m.items[i].header {
+		return 0, false
+	}
+	return m.items[i].row, true
+}
+
+func (m *Model) ToggleSelection() {
+	if len(m.Rows) == 0 || m.OnGroupHeader() {
+		return
+	}
+	curr := m.GetCurrItem()
+	if m.selected[curr] {
+		delete(m.selected, curr)
+	} else {
+		m.selected[curr] = true
+	}
+	m.selectionAnchor = curr
+	m.SyncViewPortContent()
+}
+
+// SelectRange selects the rows between the one the selection was last
+// toggled on and the current one, both included
+func (m *Model) SelectRange() {
+	if len(m.Rows) == 0 {
+		return
+	}
+	curr := m.GetCurrItem()
+	from := m.selectionAnchor
+	if from < 0 {
+		from = curr
+	}
+	// the range is the one shown, rows are in the order of their groups
+	from, to := m.itemOfRow(from), m.itemOfRow(curr)
+	for i := min(from, to); i <= max(from, to) && i < len(m.items); i++ {
+		if !m.items[i].header {
+			m.selected[m.items[i].row] = true
+		}
+	}
+	m.selectionAnchor = curr
+	m.SyncViewPortContent()
+}
+
+// ClearSelection unselects every row
+func (m *Model) ClearSelection() {
+	if len(m.selected) == 0 && m.selectionAnchor < 0 {
+		return
+	}
+	clear(m.selected)
+	m.selectionAnchor = -1
 	m.SyncViewPortContent()
+}
 
-	return currItem
+// HasSelection returns whether some rows are selected for a bulk action
+func (m *Model) HasSelection() bool {
+	return len(m.selected) > 0
+}
+
+// SelectedItems returns the indexes of the selected rows in order
+func (m *Model) SelectedItems() []int {
+	items := make([]int, 0, len(m.selected))
+	for i := range m.selected {
+		items = append(items, i)
+	}
+	slices.Sort(items)
+	return items
 }
 
 func (m *Model) cacheColumnWidths() {
@@ -162,9 +296,14 @@ func (m *Model) cacheColumnWidths() {
 func (m *Model) SyncViewPortContent() {
 	headerColumns := m.renderHeaderColumns()
 	m.cacheColumnWidths()
-	renderedRows := make([]string, 0, len(m.Rows))
-	for i := range m.Rows {
-		renderedRows = append(renderedRows, m.renderRow(i, headerColumns))
+	curr := m.rowsViewport.GetCurrItem()
+	renderedRows := make([]string, 0, len(m.items))
+	for i, it := range m.items {
+		if it.header {
+			renderedRows = append(renderedRows, m.renderGroupHeader(it, curr == i))
+		} else {
+			renderedRows = append(renderedRows, m.renderRow(it.row, curr == i, headerColumns))
+		}
 	}
 
 	m.rowsViewport.SyncViewPort(
@@ -174,7 +313,15 @@ func (m *Model) SyncViewPortContent() {
 
 func (m *Model) SetRows(rows []Row) {
 	m.Rows = rows
-	m.rowsViewport.SetNumItems(len(m.Rows))
+	for i := range m.selected {
+		if i >= len(rows) {
+			delete(m.selected, i)
+		}
+	}
+	if m.selectionAnchor >= len(rows) {
+		m.selectionAnchor = -1
+	}
+	m.layoutItems()
 	m.SyncViewPortContent()
 }
 
@@ -213,6 +360,7 @@ func (m *Model) renderHeaderColumns() []string {
 			renderedColumns[i] = m.ctx.Styles.Table.TitleCellStyle.
 				Width(*column.Width).
 				MaxWidth(*column.Width).
+				Align(column.Align).
 				Render(column.Title)
 			takenWidth += *column.Width
 			continue
@@ -271,13 +419,13 @@ func (m *Model) renderBody() string {
 		return bodyStyle.Render(*m.EmptyState)
 	}
 
-	return m.rowsViewport.View()
+	return m.ctx.Zone.Mark(m.zoneId, m.rowsViewport.View())
 }
 
-func (m *Model) renderRow(rowId int, headerColumns []string) string {
+func (m *Model) renderRow(rowId int, isCurr bool, headerColumns []string) string {
 	var style lipgloss.Style
 
-	if m.rowsViewport.GetCurrItem() == rowId {
+	if isCurr {
 		style = m.ctx.Styles.Table.SelectedCellStyle
 	} else {
 		style = m.ctx.Styles.Table.CellStyle
@@ -297,11 +445,23 @@ func (m *Model) renderRow(rowId int, headerColumns []string) string {
 			colHeight = 2
 		}
 		col := m.Rows[rowId][i]
-		renderedCol := style.
-			Width(colWidth).
+		cellStyle := style
+		contentWidth := colWidth
+		if headerColId == 0 && m.selected[rowId] {
+			// selected rows are marked by a bar taking the place of the
+			// padding of their first cell
+			cellStyle = style.
+				PaddingLeft(0).
+				Border(lipgloss.ThickBorder(), false, false, false, true).
+				BorderForeground(m.ctx.Theme.SuccessText)
+			contentWidth -= cellStyle.GetHorizontalBorderSize()
+		}
+		renderedCol := cellStyle.
+			Width(contentWidth).
 			MaxWidth(colWidth).
 			Height(colHeight).
 			MaxHeight(colHeight).
+			Align(column.Align).
 			Render(col)
 
 		renderedColumns = append(renderedColumns, renderedCol)
@@ -129,6 +129,9 @@ func (m *Model) UpdateTabTitles() {
 		// handle search section
 		if i == 0 {
 			// noop
+		} else if tab.section.GetIsRefreshing() {
+			title = fmt.Sprintf("%s %s", title, lipgloss.NewStyle().
+				Foreground(m.ctx.Theme.FaintText).Render(constants.RefreshingIcon))
 		} else if tab.section.GetIsLoading() {
 			title = fmt.Sprintf("%s %s", title, m.sectionTabs[i].spinner.View())
 		} else if m.ctx.Config.Theme.Ui.SectionsShowCount {
@@ -1,6 +1,8 @@
 package testdata
 
 import (
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
@@ -8,6 +10,7 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
 )
 
 type TestSection struct {
@@ -60,6 +63,21 @@ func (t *TestSection) GetIsLoading() bool {
 	return t.loading
 }
 
+// GetIsRefreshing implements section.Section.
+func (t *TestSection) GetIsRefreshing() bool {
+	return false
+}
+
+// SetIsRefreshing implements section.Section.
+func (t *TestSection) SetIsRefreshing(val bool) {
+	panic("unimplemented")
+}
+
+// LastUpdated implements section.Section.
+func (t *TestSection) LastUpdated() time.Time {
+	panic("unimplemented")
+}
+
 // GetItemPluralForm implements section.Section.
 func (t *TestSection) GetItemPluralForm() string {
 	panic("unimplemented")
@@ -100,11 +118,21 @@ func (t *TestSection) IsFilteringByClone() bool {
 	panic("unimplemented")
 }
 
+// GetFilterDebug implements section.Section.
+func (t *TestSection) GetFilterDebug() section.FilterDebug {
+	panic("unimplemented")
+}
+
 // IsPromptConfirmationFocused implements section.Section.
 func (t *TestSection) IsPromptConfirmationFocused() bool {
 	panic("unimplemented")
 }
 
+// FocusedMode implements section.Section.
+func (t *TestSection) FocusedMode() focus.Mode {
+	panic("unimplemented")
+}
+
 // IsSearchFocused implements section.Section.
 func (t *TestSection) IsSearchFocused() bool {
 	panic("unimplemented")
@@ -115,6 +143,16 @@ func (t *TestSection) LastItem() int {
 	panic("unimplemented")
 }
 
+// SelectRow implements section.Section.
+func (t *TestSection) SelectRow(i int) {
+	panic("unimplemented")
+}
+
+// RowAt implements section.Section.
+func (t *TestSection) RowAt(msg tea.MouseMsg) (int, bool) {
+	panic("unimplemented")
+}
+
 // MakeSectionCmd implements section.Section.
 func (t *TestSection) MakeSectionCmd(cmd tea.Cmd) tea.Cmd {
 	panic("unimplemented")
@@ -0,0 +1,76 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// BulkItem is a row a bulk action runs a gh command on
+type BulkItem struct {
+	// Name identifies the row in errors, e.g. owner/repo#12
+	Name string
+	Args []string
+	// Msg is sent to the section when the command succeeded on the row
+	Msg tea.Msg
+}
+
+// BulkUpdateMsg holds the msgs of the rows a bulk action succeeded on
+type BulkUpdateMsg struct {
+	Msgs []tea.Msg
+}
+
+// BulkTask is an action run on every selected row of a section as a single
+// task
+type BulkTask struct {
+	Id           string
+	Section      SectionIdentifier
+	StartText    string
+	FinishedText string
+	Items        []BulkItem
+}
+
+// RunBulk runs the commands of the items of task one after the other. The
+// progress of the task is how many of them are done, it fails with the errors
+// of the rows that failed while the others are still updated.
+func RunBulk(ctx *context.ProgramContext, task BulkTask) tea.Cmd {
+	startCmd := ctx.StartTask(context.Task{
+		Id:           task.Id,
+		StartText:    task.StartText,
+		FinishedText: task.FinishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	})
+
+	progress := make(chan string, len(task.Items))
+	run := func() tea.Msg {
+		defer close(progress)
+		var msgs []tea.Msg
+		var errs []error
+		for i, item := range task.Items {
+			if _, err := runGh(item.Args); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", item.Name, err))
+			} else {
+				msgs = append(msgs, item.Msg)
+			}
+			progress <- fmt.Sprintf("%d/%d", i+1, len(task.Items))
+		}
+
+		var err error
+		if len(errs) > 0 {
+			err = fmt.Errorf("%d of %d failed: %w", len(errs), len(task.Items), errors.Join(errs...))
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   task.Section.Id,
+			SectionType: task.Section.Type,
+			TaskId:      task.Id,
+			Err:         err,
+			Msg:         BulkUpdateMsg{Msgs: msgs},
+		}
+	}
+	return tea.Batch(startCmd, run, ListenForProgress(task.Id, progress))
+}
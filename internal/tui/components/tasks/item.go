@@ -0,0 +1,49 @@
+package tasks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// ItemCreatedMsg is sent to the section the new PR or issue is inserted
+// into, Row is either a *data.PullRequestData or a *data.IssueData
+type ItemCreatedMsg struct {
+	Row data.RowData
+}
+
+// CreateItem creates the PR or issue through the API
+func CreateItem(ctx *context.ProgramContext, section SectionIdentifier, item data.NewItem) tea.Cmd {
+	kind, noun := "Issue", "issue"
+	if item.IsPR {
+		kind, noun = "PR", "PR"
+	}
+	taskId := fmt.Sprintf("create_%s_%d", strings.ToLower(kind), time.Now().UnixNano())
+	startCmd := ctx.StartTask(context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf(`Creating %s "%s" in %s`, noun, item.Title, item.Repo),
+		FinishedText: fmt.Sprintf(`%s "%s" has been created`, kind, item.Title),
+		State:        context.TaskStart,
+	})
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		row, err := data.CreateItem(item)
+		var msg tea.Msg
+		if err == nil {
+			msg = ItemCreatedMsg{Row: row}
+		}
+		return constants.TaskFinishedMsg{
+			TaskId:      taskId,
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			Err:         err,
+			Msg:         msg,
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package tasks
 
 import (
+	"bytes"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -23,10 +24,25 @@ type UpdatePRMsg struct {
 	PrNumber         int
 	IsClosed         *bool
 	NewComment       *data.Comment
+	OlderComments    *data.CommentsWithBody
 	ReadyForReview   *bool
 	IsMerged         *bool
+	IsQueued         *bool
+	MergeQueueEntry  *data.MergeQueueEntry
+	Mergeable        *string
+	ReviewDecision   *string
 	AddedAssignees   *data.Assignees
 	RemovedAssignees *data.Assignees
+	Labels           *data.PRLabels
+	HasMilestone     *bool
+	Milestone        *data.ItemMilestone
+	ReviewThreadId   string
+	NewThreadReply   *data.ReviewComment
+	IsThreadResolved *bool
+	// RequestedReviewers are the reviewers whose review was requested
+	RequestedReviewers []string
+	// DismissedReviewIds are the ids of the reviews dismissed
+	DismissedReviewIds []string
 }
 
 type UpdateBranchMsg struct {
@@ -58,11 +74,7 @@ func fireTask(ctx *context.ProgramContext, task GitHubTask) tea.Cmd {
 	}
 
 	startCmd := ctx.StartTask(start)
-	return tea.Batch(startCmd, func() tea.Msg {
-		log.Info("Running task", "cmd", "gh "+strings.Join(task.Args, " "))
-		c := exec.Command("gh", task.Args...)
-
-		err := c.Run()
+	return RunQueueable(startCmd, task.Args, func(c *exec.Cmd, err error) constants.TaskFinishedMsg {
 		return constants.TaskFinishedMsg{
 			TaskId:      task.Id,
 			SectionId:   task.Section.Id,
@@ -73,6 +85,43 @@ func fireTask(ctx *context.ProgramContext, task GitHubTask) tea.Cmd {
 	})
 }
 
+// RunQueueable runs the gh command of a task that changes something on
+// GitHub. When the request couldn't be sent, the msg returned by finished is
+// sent without its Msg and with Retry set, so that the task is queued instead
+// of failing. Other errors, like a timeout waiting for GitHub to answer, fail
+// the task since GitHub may have carried it out already.
+func RunQueueable(
+	startCmd tea.Cmd,
+	args []string,
+	finished func(c *exec.Cmd, err error) constants.TaskFinishedMsg,
+) tea.Cmd {
+	var run tea.Cmd
+	run = func() tea.Msg {
+		c, err := runGh(args)
+		msg := finished(c, err)
+		if data.IsUnsent(err) {
+			msg.Msg = nil
+			msg.Retry = tea.Batch(startCmd, run)
+		}
+		return msg
+	}
+	return tea.Batch(startCmd, run)
+}
+
+// runGh runs gh with args, its error has what gh printed to stderr
+func runGh(args []string) (*exec.Cmd, error) {
+	log.Info("Running task", "cmd", "gh "+strings.Join(args, " "))
+	c := exec.Command("gh", args...)
+	var stderr bytes.Buffer
+	c.Stderr = &stderr
+
+	err := c.Run()
+	if err != nil && stderr.Len() > 0 {
+		err = fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return c, err
+}
+
 func OpenBranchPR(ctx *context.ProgramContext, section SectionIdentifier, branch string) tea.Cmd {
 	return fireTask(ctx, GitHubTask{
 		Id: fmt.Sprintf("branch_open_%s", branch),
@@ -102,7 +151,7 @@ func ReopenPR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Ro
 			"reopen",
 			fmt.Sprint(prNumber),
 			"-R",
-			pr.GetRepoNameWithOwner(),
+			data.RepoArg(pr),
 		},
 		Section:      section,
 		StartText:    fmt.Sprintf("Reopening PR #%d", prNumber),
@@ -125,7 +174,7 @@ func ClosePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 			"close",
 			fmt.Sprint(prNumber),
 			"-R",
-			pr.GetRepoNameWithOwner(),
+			data.RepoArg(pr),
 		},
 		Section:      section,
 		StartText:    fmt.Sprintf("Closing PR #%d", prNumber),
@@ -148,7 +197,7 @@ func PRReady(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 			"ready",
 			fmt.Sprint(prNumber),
 			"-R",
-			pr.GetRepoNameWithOwner(),
+			data.RepoArg(pr),
 		},
 		Section:      section,
 		StartText:    fmt.Sprintf("Marking PR #%d as ready for review", prNumber),
@@ -170,7 +219,7 @@ func MergePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 		"merge",
 		fmt.Sprint(prNumber),
 		"-R",
-		pr.GetRepoNameWithOwner(),
+		data.RepoArg(pr),
 	)
 
 	taskId := fmt.Sprintf("merge_%d", prNumber)
@@ -199,59 +248,149 @@ func MergePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.Row
 	}))
 }
 
-func CreatePR(ctx *context.ProgramContext, section SectionIdentifier, branchName string, title string) tea.Cmd {
-	c := exec.Command(
-		"gh",
-		"pr",
-		"create",
-		"--title",
-		title,
-		"-R",
-		ctx.RepoUrl,
-	)
-
-	taskId := fmt.Sprintf("create_pr_%s", title)
+// EnqueuePR adds the PR to the merge queue of its base branch
+func EnqueuePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+	prNumber := pr.GetNumber()
+	url := pr.GetUrl()
+	taskId := buildTaskId("pr_enqueue", prNumber)
 	task := context.Task{
 		Id:           taskId,
-		StartText:    fmt.Sprintf(`Creating PR "%s"`, title),
-		FinishedText: fmt.Sprintf(`PR "%s" has been created`, title),
+		StartText:    fmt.Sprintf("Adding PR #%d to the merge queue", prNumber),
+		FinishedText: fmt.Sprintf("PR #%d has been added to the merge queue", prNumber),
 		State:        context.TaskStart,
 		Error:        nil,
 	}
 	startCmd := ctx.StartTask(task)
 
-	return tea.Batch(startCmd, tea.ExecProcess(c, func(err error) tea.Msg {
-		isCreated := err == nil && c.ProcessState.ExitCode() == 0
-
+	return tea.Batch(startCmd, func() tea.Msg {
+		entry, err := data.EnqueuePullRequest(url)
+		msg := UpdatePRMsg{PrNumber: prNumber}
+		if err == nil {
+			msg.IsQueued = utils.BoolPtr(true)
+			msg.MergeQueueEntry = entry
+		}
 		return constants.TaskFinishedMsg{
 			SectionId:   section.Id,
 			SectionType: section.Type,
 			TaskId:      taskId,
-			Err:         nil,
-			Msg:         UpdateBranchMsg{Name: branchName, IsCreated: &isCreated},
+			Err:         err,
+			Msg:         msg,
 		}
-	}))
+	})
 }
 
-func UpdatePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
+// DequeuePR removes the PR from the merge queue of its base branch
+func DequeuePR(ctx *context.ProgramContext, section SectionIdentifier, pr data.RowData) tea.Cmd {
 	prNumber := pr.GetNumber()
+	url := pr.GetUrl()
+	taskId := buildTaskId("pr_dequeue", prNumber)
+	task := context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Removing PR #%d from the merge queue", prNumber),
+		FinishedText: fmt.Sprintf("PR #%d has been removed from the merge queue", prNumber),
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		err := data.DequeuePullRequest(url)
+		msg := UpdatePRMsg{PrNumber: prNumber}
+		if err == nil {
+			msg.IsQueued = utils.BoolPtr(false)
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         msg,
+		}
+	})
+}
+
+// CreatePROptions describes a PR to create from a pushed branch
+type CreatePROptions struct {
+	Branch string
+	Title  string
+	Body   string
+	Base   string
+	Draft  bool
+}
+
+func CreatePR(ctx *context.ProgramContext, section SectionIdentifier, opts CreatePROptions) tea.Cmd {
+	args := []string{
+		"pr",
+		"create",
+		"--title",
+		opts.Title,
+		"--body",
+		opts.Body,
+		"--head",
+		opts.Branch,
+		"-R",
+		ctx.RepoUrl,
+	}
+	if opts.Base != "" {
+		args = append(args, "--base", opts.Base)
+	}
+	kind := "PR"
+	if opts.Draft {
+		args = append(args, "--draft")
+		kind = "Draft PR"
+	}
+
 	return fireTask(ctx, GitHubTask{
-		Id: buildTaskId("pr_update", prNumber),
-		Args: []string{
-			"pr",
-			"update-branch",
-			fmt.Sprint(prNumber),
-			"-R",
-			pr.GetRepoNameWithOwner(),
-		},
+		Id:           fmt.Sprintf("create_pr_%s", opts.Branch),
+		Args:         args,
 		Section:      section,
-		StartText:    fmt.Sprintf("Updating PR #%d", prNumber),
-		FinishedText: fmt.Sprintf("PR #%d has been updated", prNumber),
+		StartText:    fmt.Sprintf(`Creating %s "%s"`, strings.ToLower(kind), opts.Title),
+		FinishedText: fmt.Sprintf(`%s "%s" has been created`, kind, opts.Title),
 		Msg: func(c *exec.Cmd, err error) tea.Msg {
-			return UpdatePRMsg{
-				PrNumber: prNumber,
-				IsClosed: utils.BoolPtr(true),
-			}
+			isCreated := err == nil
+			return UpdateBranchMsg{Name: opts.Branch, IsCreated: &isCreated}
 		},
 	})
 }
+
+// UpdatePR updates the branch of the PR from its base branch, with a merge
+// commit or by rebasing it depending on method
+func UpdatePR(
+	ctx *context.ProgramContext,
+	section SectionIdentifier,
+	pr data.RowData,
+	method string,
+) tea.Cmd {
+	prNumber := pr.GetNumber()
+	url := pr.GetUrl()
+	taskId := buildTaskId("pr_update", prNumber)
+	startText, finishedText := fmt.Sprintf("Updating PR #%d", prNumber),
+		fmt.Sprintf("PR #%d has been updated", prNumber)
+	if method == data.BranchUpdateRebase {
+		startText, finishedText = fmt.Sprintf("Rebasing PR #%d", prNumber),
+			fmt.Sprintf("PR #%d has been rebased", prNumber)
+	}
+	task := context.Task{
+		Id:           taskId,
+		StartText:    startText,
+		FinishedText: finishedText,
+		State:        context.TaskStart,
+		Error:        nil,
+	}
+	startCmd := ctx.StartTask(task)
+
+	return tea.Batch(startCmd, func() tea.Msg {
+		mergeable, err := data.UpdatePullRequestBranch(url, method)
+		msg := UpdatePRMsg{PrNumber: prNumber}
+		if err == nil && mergeable != "" {
+			msg.Mergeable = &mergeable
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   section.Id,
+			SectionType: section.Type,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         msg,
+		}
+	})
+}
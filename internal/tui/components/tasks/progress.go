@@ -0,0 +1,23 @@
+package tasks
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+// ListenForProgress sends each line received on lines as the progress of
+// task taskId, until lines is closed
+func ListenForProgress(taskId string, lines <-chan string) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-lines
+		if !ok {
+			return nil
+		}
+		return constants.TaskProgressMsg{
+			TaskId: taskId,
+			Text:   line,
+			Next:   ListenForProgress(taskId, lines),
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"fmt"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// UpdateRunMsg is sent to the workflows section once a run was re-run or
+// cancelled, with its new status
+type UpdateRunMsg struct {
+	RunId  int64
+	Status *string
+}
+
+// RerunWorkflow re-runs run, or only its failed jobs with failedOnly
+func RerunWorkflow(ctx *context.ProgramContext, section SectionIdentifier, run data.WorkflowRunData, failedOnly bool) tea.Cmd {
+	args := []string{"run", "rerun", fmt.Sprint(run.Id), "-R", data.RepoArg(run)}
+	what := "run"
+	if failedOnly {
+		args = append(args, "--failed")
+		what = "failed jobs of run"
+	}
+
+	return fireTask(ctx, GitHubTask{
+		Id:           fmt.Sprintf("workflow_rerun_%d", run.Id),
+		Args:         args,
+		Section:      section,
+		StartText:    fmt.Sprintf("Re-running %s %s #%d", what, run.Name, run.RunNumber),
+		FinishedText: fmt.Sprintf("Re-run of %s #%d has been requested", run.Name, run.RunNumber),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			return UpdateRunMsg{
+				RunId:  run.Id,
+				Status: utils.StringPtr("queued"),
+			}
+		},
+	})
+}
+
+// CancelWorkflow cancels run
+func CancelWorkflow(ctx *context.ProgramContext, section SectionIdentifier, run data.WorkflowRunData) tea.Cmd {
+	return fireTask(ctx, GitHubTask{
+		Id:           fmt.Sprintf("workflow_cancel_%d", run.Id),
+		Args:         []string{"run", "cancel", fmt.Sprint(run.Id), "-R", data.RepoArg(run)},
+		Section:      section,
+		StartText:    fmt.Sprintf("Cancelling %s #%d", run.Name, run.RunNumber),
+		FinishedText: fmt.Sprintf("%s #%d has been cancelled", run.Name, run.RunNumber),
+		Msg: func(c *exec.Cmd, err error) tea.Msg {
+			return UpdateRunMsg{
+				RunId:  run.Id,
+				Status: utils.StringPtr("cancelling"),
+			}
+		},
+	})
+}
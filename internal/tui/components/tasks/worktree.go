@@ -0,0 +1,76 @@
+package tasks
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// defaultWorktreeOpenCommand starts a shell in the worktree when no
+// repo.worktrees.openCommand is configured, commands run from the worktree
+const defaultWorktreeOpenCommand = `exec "${SHELL:-sh}"`
+
+// WorktreePath is where the worktree of branch of the repo at repoPath is
+// created, following the repo.worktrees.dir config
+func WorktreePath(ctx *context.ProgramContext, repoPath, branch string) string {
+	return git.WorktreePath(expandHome(repoPath), expandHome(ctx.Config.Repo.Worktrees.Dir), branch)
+}
+
+// OpenWorktree runs the configured command opening the worktree at path, the
+// program is suspended until it exits
+func OpenWorktree(ctx *context.ProgramContext, repoPath, path, branch string) tea.Cmd {
+	commandTemplate := ctx.Config.Repo.Worktrees.OpenCommand
+	if commandTemplate == "" {
+		commandTemplate = defaultWorktreeOpenCommand
+	}
+
+	tmpl, err := template.New("worktree_open_command").Option("missingkey=error").Parse(commandTemplate)
+	if err != nil {
+		return func() tea.Msg {
+			return constants.ErrMsg{Err: fmt.Errorf("failed parsing repo.worktrees.openCommand: %w", err)}
+		}
+	}
+	var buff bytes.Buffer
+	err = tmpl.Execute(&buff, map[string]any{
+		"WorktreePath": path,
+		"BranchName":   branch,
+		"RepoPath":     expandHome(repoPath),
+	})
+	if err != nil {
+		return func() tea.Msg {
+			return constants.ErrMsg{Err: fmt.Errorf("failed executing repo.worktrees.openCommand: %w", err)}
+		}
+	}
+
+	log.Debug("Opening worktree", "path", path, "cmd", buff.String())
+	shell := os.Getenv("SHELL")
+	if shell == "" {
+		shell = "sh"
+	}
+	c := exec.Command(shell, "-c", buff.String())
+	c.Dir = path
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		if err != nil {
+			return constants.ErrMsg{Err: fmt.Errorf("failed opening the worktree at %s: %w", path, err)}
+		}
+		return nil
+	})
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~") {
+		return path
+	}
+	home, _ := os.UserHomeDir()
+	return strings.Replace(path, "~", home, 1)
+}
@@ -0,0 +1,164 @@
+package themepicker
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+// defaultTheme is the option of the theme without a palette
+const defaultTheme = "default"
+
+// KeyMap defines keybindings for the picker
+type KeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Cancel key.Binding
+}
+
+var Keys = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "down"),
+	),
+	Select: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "apply"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("esc", "ctrl+c"),
+		key.WithHelp("esc", "cancel"),
+	),
+}
+
+// ThemeSelectedMsg is sent when a theme is picked, Name is empty for the
+// default one
+type ThemeSelectedMsg struct {
+	Name string
+}
+
+// Model is an overlay listing the palettes of the themes directory, the
+// picked one is applied right away
+type Model struct {
+	ctx     *context.ProgramContext
+	names   []string
+	current string
+	cursor  int
+	focused bool
+	width   int
+}
+
+func NewModel(ctx *context.ProgramContext) Model {
+	return Model{
+		ctx:   ctx,
+		width: 50,
+	}
+}
+
+// Open lists the default theme and names, the cursor on current
+func (m *Model) Open(names []string, current string) {
+	m.names = append([]string{""}, names...)
+	m.current = current
+	m.cursor = 0
+	for i, name := range m.names {
+		if name == current {
+			m.cursor = i
+		}
+	}
+	m.focused = true
+}
+
+func (m *Model) Close() {
+	m.focused = false
+}
+
+func (m Model) Focused() bool {
+	return m.focused
+}
+
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focused {
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, Keys.Cancel):
+		m.Close()
+	case key.Matches(keyMsg, Keys.Up):
+		m.cursor = max(0, m.cursor-1)
+	case key.Matches(keyMsg, Keys.Down):
+		m.cursor = min(m.cursor+1, len(m.names)-1)
+	case key.Matches(keyMsg, Keys.Select):
+		m.Close()
+		selected := ThemeSelectedMsg{Name: m.names[m.cursor]}
+		return m, func() tea.Msg { return selected }
+	}
+	return m, nil
+}
+
+func (m Model) View() string {
+	if !m.focused {
+		return ""
+	}
+
+	var b strings.Builder
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(m.ctx.Theme.PrimaryText).
+		MarginBottom(1)
+	faintStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+	selectedStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.PrimaryText).Bold(true)
+
+	b.WriteString(titleStyle.Render("Themes"))
+	b.WriteString("\n\n")
+	for i, name := range m.names {
+		cursor, style := "  ", faintStyle
+		if i == m.cursor {
+			cursor, style = "> ", selectedStyle
+		}
+		label := name
+		if name == "" {
+			label = defaultTheme
+		}
+		if name == m.current {
+			label += " (current)"
+		}
+		b.WriteString(style.Render(cursor + label))
+		b.WriteString("\n")
+	}
+	if len(m.names) == 1 {
+		b.WriteString(faintStyle.Italic(true).Render("  Add palettes to the themes directory to pick them"))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render("enter: apply • esc: close"))
+
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(m.width)
+	return boxStyle.Render(b.String())
+}
+
+func (m *Model) UpdateProgramContext(ctx *context.ProgramContext) {
+	m.ctx = ctx
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
 )
 
 func FormatNumber(num int) string {
@@ -28,11 +30,54 @@ func GetIssueTextStyle(
 	return lipgloss.NewStyle().Foreground(ctx.Theme.PrimaryText)
 }
 
+// GetRowState returns the state the title of the row of a PR or an issue is
+// colored by, closed and merged rows first, then the open ones failing
+// checks, stale and drafts
+func GetRowState(
+	ctx *context.ProgramContext,
+	state string,
+	isDraft bool,
+	failingChecks bool,
+	updatedAt time.Time,
+) theme.RowState {
+	switch state {
+	case "MERGED":
+		return theme.RowMerged
+	case "CLOSED":
+		return theme.RowClosed
+	}
+	staleDays := ctx.Config.Theme.Ui.Table.StaleDays
+	switch {
+	case failingChecks:
+		return theme.RowFailingChecks
+	case staleDays > 0 && !updatedAt.IsZero() &&
+		time.Since(updatedAt) > time.Duration(staleDays)*24*time.Hour:
+		return theme.RowStale
+	case isDraft:
+		return theme.RowDraft
+	default:
+		return theme.RowOpen
+	}
+}
+
 func RenderIssueTitle(
 	ctx *context.ProgramContext,
 	state string,
 	title string,
 	number int,
+	unread bool,
+) string {
+	return RenderRowTitle(ctx, theme.RowDefault, state, title, number, unread)
+}
+
+// RenderRowTitle renders the title of a row colored by rowState
+func RenderRowTitle(
+	ctx *context.ProgramContext,
+	rowState theme.RowState,
+	state string,
+	title string,
+	number int,
+	unread bool,
 ) string {
 	prNumber := ""
 	if ctx.Config.Theme.Ui.Table.Compact {
@@ -49,7 +94,7 @@ func RenderIssueTitle(
 		prNumber = strings.ReplaceAll(prNumber, "\x1b[0m", "")
 	}
 
-	rTitle := GetIssueTextStyle(ctx).Render(title)
+	rTitle := GetIssueTextStyle(ctx).Foreground(ctx.Theme.RowText(rowState)).Bold(unread).Render(title)
 
 	res := fmt.Sprintf("%s%s", prNumber, rTitle)
 	return res
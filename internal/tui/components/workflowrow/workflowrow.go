@@ -0,0 +1,171 @@
+package workflowrow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+type Run struct {
+	Ctx  *context.ProgramContext
+	Data data.WorkflowRunData
+}
+
+func (run *Run) ToTableRow() table.Row {
+	return table.Row{
+		run.renderStatus(),
+		run.renderRepoName(),
+		run.renderWorkflow(),
+		run.renderTitle(),
+		run.renderBranch(),
+		run.renderEvent(),
+		run.renderConclusion(),
+		run.renderDuration(),
+		run.renderUpdateAt(),
+	}
+}
+
+func (run *Run) getTextStyle() lipgloss.Style {
+	return components.GetIssueTextStyle(run.Ctx)
+}
+
+func (run *Run) renderStatus() string {
+	style := lipgloss.NewStyle()
+	if !run.Data.IsCompleted() {
+		return style.Foreground(run.Ctx.Theme.WarningText).Render(run.Ctx.Styles.Common.WaitingGlyph)
+	}
+
+	switch run.Data.Conclusion {
+	case "success":
+		return style.Foreground(run.Ctx.Theme.SuccessText).Render(constants.SuccessIcon)
+	case "failure", "timed_out", "startup_failure":
+		return style.Foreground(run.Ctx.Theme.ErrorText).Render(constants.FailureIcon)
+	default:
+		return style.Foreground(run.Ctx.Theme.FaintText).Render(constants.EmptyIcon)
+	}
+}
+
+func (run *Run) renderRepoName() string {
+	return run.getTextStyle().Render(run.Data.Repository.Name)
+}
+
+func (run *Run) renderWorkflow() string {
+	return run.getTextStyle().Render(run.Data.Name)
+}
+
+func (run *Run) renderTitle() string {
+	state := "OPEN"
+	if run.Data.IsCompleted() {
+		state = "CLOSED"
+	}
+	return components.RenderIssueTitle(run.Ctx, state, run.Data.DisplayTitle, run.Data.RunNumber, false)
+}
+
+func (run *Run) renderBranch() string {
+	return run.getTextStyle().Render(run.Data.HeadBranch)
+}
+
+func (run *Run) renderEvent() string {
+	return run.getTextStyle().Render(run.Data.Event)
+}
+
+func (run *Run) renderConclusion() string {
+	if !run.Data.IsCompleted() {
+		return run.getTextStyle().Foreground(run.Ctx.Theme.WarningText).Render(
+			strings.ReplaceAll(run.Data.Status, "_", " "))
+	}
+	style := run.getTextStyle()
+	switch run.Data.Conclusion {
+	case "success":
+		style = style.Foreground(run.Ctx.Theme.SuccessText)
+	case "failure", "timed_out", "startup_failure":
+		style = style.Foreground(run.Ctx.Theme.ErrorText)
+	default:
+		style = style.Foreground(run.Ctx.Theme.FaintText)
+	}
+	return style.Render(strings.ReplaceAll(run.Data.Conclusion, "_", " "))
+}
+
+func (run *Run) renderDuration() string {
+	return run.getTextStyle().Render(FormatDuration(run.Data.Duration()))
+}
+
+func (run *Run) renderUpdateAt() string {
+	timeFormat := run.Ctx.Config.Defaults.DateFormat
+
+	updatedAtOutput := ""
+	if timeFormat == "" || timeFormat == "relative" {
+		updatedAtOutput = utils.TimeElapsed(run.Data.UpdatedAt)
+	} else {
+		updatedAtOutput = run.Data.UpdatedAt.Format(timeFormat)
+	}
+
+	return run.getTextStyle().Render(updatedAtOutput)
+}
+
+// FormatDuration renders d with its two most significant units, e.g. "1h23m"
+// or "4m12s"
+func FormatDuration(d time.Duration) string {
+	if d <= 0 {
+		return "-"
+	}
+	d = d.Round(time.Second)
+	h := int(d.Hours())
+	m := int(d.Minutes()) % 60
+	s := int(d.Seconds()) % 60
+	switch {
+	case h > 0:
+		return fmt.Sprintf("%dh%02dm", h, m)
+	case m > 0:
+		return fmt.Sprintf("%dm%02ds", m, s)
+	default:
+		return fmt.Sprintf("%ds", s)
+	}
+}
+
+// RenderDetails renders a summary of the run for the sidebar
+func (run *Run) RenderDetails(width int) string {
+	labelStyle := lipgloss.NewStyle().Foreground(run.Ctx.Theme.FaintText).Width(12)
+	valueStyle := run.getTextStyle()
+
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(run.Ctx.Theme.PrimaryText).
+		Width(width).
+		Render(fmt.Sprintf("%s #%d", run.Data.DisplayTitle, run.Data.RunNumber))
+
+	fields := [][2]string{
+		{"Workflow", run.Data.Name},
+		{"Repo", run.Data.Repository.FullName},
+		{"Branch", run.Data.HeadBranch},
+		{"Commit", shortSha(run.Data.HeadSha)},
+		{"Event", run.Data.Event},
+		{"Actor", run.Data.Actor.Login},
+		{"Attempt", fmt.Sprint(run.Data.RunAttempt)},
+		{"Duration", FormatDuration(run.Data.Duration())},
+	}
+
+	lines := []string{title, "", run.renderStatus() + " " + run.renderConclusion(), ""}
+	for _, f := range fields {
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			labelStyle.Render(f[0]), valueStyle.Render(f[1])))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func shortSha(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,54 @@
+package workflowssection
+
+import (
+	"fmt"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+func (m *Model) rerun(failedOnly bool) tea.Cmd {
+	run := m.getCurrRun()
+	if run == nil {
+		return nil
+	}
+	return tasks.RerunWorkflow(m.Ctx, tasks.SectionIdentifier{Id: m.Id, Type: SectionType}, *run, failedOnly)
+}
+
+func (m *Model) cancel() tea.Cmd {
+	run := m.getCurrRun()
+	if run == nil {
+		return nil
+	}
+	return tasks.CancelWorkflow(m.Ctx, tasks.SectionIdentifier{Id: m.Id, Type: SectionType}, *run)
+}
+
+// logs opens the run's logs in the configured pager
+func (m *Model) logs() tea.Cmd {
+	run := m.getCurrRun()
+	if run == nil {
+		return nil
+	}
+
+	c := exec.Command(
+		"gh",
+		"run",
+		"view",
+		fmt.Sprint(run.Id),
+		"--log",
+		"-R",
+		data.RepoArg(run),
+	)
+	c.Env = m.Ctx.Config.GetFullScreenDiffPagerEnv()
+
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		if err != nil {
+			return constants.ErrMsg{Err: err}
+		}
+		return nil
+	})
+}
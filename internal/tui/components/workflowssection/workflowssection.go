@@ -0,0 +1,426 @@
+package workflowssection
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/table"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/workflowrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+const SectionType = "workflow"
+
+type Model struct {
+	section.BaseModel
+	Runs []data.WorkflowRunData
+}
+
+func NewModel(
+	id int,
+	ctx *context.ProgramContext,
+	cfg config.WorkflowsSectionConfig,
+	lastUpdated time.Time,
+	createdAt time.Time,
+) Model {
+	m := Model{}
+	m.BaseModel = section.NewModel(
+		ctx,
+		section.NewSectionOptions{
+			Id:          id,
+			Config:      cfg.ToSectionConfig(),
+			Type:        SectionType,
+			Columns:     GetSectionColumns(cfg, ctx),
+			Singular:    m.GetItemSingularForm(),
+			Plural:      m.GetItemPluralForm(),
+			LastUpdated: lastUpdated,
+			CreatedAt:   createdAt,
+		},
+	)
+	m.Runs = []data.WorkflowRunData{}
+
+	return m
+}
+
+func (m *Model) Update(msg tea.Msg) (section.Section, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+
+		if m.IsSearchFocused() {
+			if m.SearchBar.IsOverlayOpen() {
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+
+			switch msg.Type {
+			case tea.KeyCtrlC, tea.KeyEsc:
+				m.SearchBar.SetValue(m.SearchValue)
+				blinkCmd := m.SetIsSearching(false)
+				return m, blinkCmd
+
+			case tea.KeyEnter:
+				m.SearchValue = m.SearchBar.Value()
+				historyCmd := m.SearchBar.AddToHistory(m.SearchValue)
+				m.SetIsSearching(false)
+				m.ResetRows()
+				return m, tea.Batch(append(m.FetchNextPageSectionRows(), historyCmd)...)
+
+			default:
+				var searchCmd tea.Cmd
+				m.SearchBar, searchCmd = m.SearchBar.Update(msg)
+				return m, searchCmd
+			}
+		}
+
+		if m.IsPromptConfirmationFocused() {
+			switch msg.Type {
+			case tea.KeyCtrlC, tea.KeyEsc:
+				m.PromptConfirmationBox.Reset()
+				cmd = m.SetIsPromptConfirmationShown(false)
+				return m, cmd
+
+			case tea.KeyEnter:
+				input := m.PromptConfirmationBox.Value()
+				action := m.GetPromptConfirmationAction()
+				if input == "Y" || input == "y" {
+					switch action {
+					case "cancel":
+						cmd = m.cancel()
+					}
+				}
+
+				m.PromptConfirmationBox.Reset()
+				blinkCmd := m.SetIsPromptConfirmationShown(false)
+
+				return m, tea.Batch(cmd, blinkCmd)
+			}
+			break
+		}
+
+		switch {
+		case key.Matches(msg, keys.WorkflowKeys.Rerun):
+			cmd = m.rerun(false)
+
+		case key.Matches(msg, keys.WorkflowKeys.RerunFailed):
+			cmd = m.rerun(true)
+
+		case key.Matches(msg, keys.WorkflowKeys.Cancel):
+			if run := m.getCurrRun(); run != nil && !run.IsCompleted() {
+				m.SetPromptConfirmationAction("cancel")
+				cmd = m.SetIsPromptConfirmationShown(true)
+			}
+
+		case key.Matches(msg, keys.WorkflowKeys.Logs):
+			cmd = m.logs()
+		}
+
+	case tasks.UpdateRunMsg:
+		for i, currRun := range m.Runs {
+			if currRun.Id == msg.RunId {
+				if msg.Status != nil {
+					currRun.Status = *msg.Status
+					currRun.Conclusion = ""
+				}
+				m.Runs[i] = currRun
+				m.Table.SetRows(m.BuildRows())
+				break
+			}
+		}
+
+	case SectionWorkflowRunsFetchedMsg:
+		if m.LastFetchTaskId == msg.TaskId {
+			if m.PageInfo != nil {
+				m.Runs = append(m.Runs, msg.Runs...)
+			} else {
+				m.Runs = msg.Runs
+			}
+			m.TotalCount = msg.TotalCount
+			m.SetIsLoading(false)
+			m.IsRefreshing = false
+			m.PageInfo = &msg.PageInfo
+			m.Table.SetRows(m.BuildRows())
+			m.UpdateLastUpdated(time.Now())
+			m.UpdateTotalItemsCount(m.TotalCount)
+		}
+	}
+
+	search, searchCmd := m.SearchBar.Update(msg)
+	m.SearchBar = search
+
+	prompt, promptCmd := m.PromptConfirmationBox.Update(msg)
+	m.PromptConfirmationBox = prompt
+
+	table, tableCmd := m.Table.Update(msg)
+	m.Table = table
+
+	return m, tea.Batch(cmd, searchCmd, promptCmd, tableCmd)
+}
+
+func GetSectionColumns(
+	cfg config.WorkflowsSectionConfig,
+	ctx *context.ProgramContext,
+) []table.Column {
+	dLayout := ctx.Config.Defaults.Layout.Workflows
+	sLayout := cfg.Layout
+
+	updatedAtLayout := config.MergeColumnConfigs(
+		dLayout.UpdatedAt,
+		sLayout.UpdatedAt,
+	)
+	statusLayout := config.MergeColumnConfigs(dLayout.Status, sLayout.Status)
+	repoLayout := config.MergeColumnConfigs(dLayout.Repo, sLayout.Repo)
+	workflowLayout := config.MergeColumnConfigs(dLayout.Workflow, sLayout.Workflow)
+	titleLayout := config.MergeColumnConfigs(dLayout.Title, sLayout.Title)
+	branchLayout := config.MergeColumnConfigs(dLayout.Branch, sLayout.Branch)
+	eventLayout := config.MergeColumnConfigs(dLayout.Event, sLayout.Event)
+	conclusionLayout := config.MergeColumnConfigs(
+		dLayout.Conclusion,
+		sLayout.Conclusion,
+	)
+	durationLayout := config.MergeColumnConfigs(
+		dLayout.Duration,
+		sLayout.Duration,
+	)
+
+	return []table.Column{
+		{
+			Title:  "",
+			Width:  statusLayout.Width,
+			Hidden: statusLayout.Hidden,
+		},
+		{
+			Title:  "",
+			Width:  repoLayout.Width,
+			Hidden: repoLayout.Hidden,
+		},
+		{
+			Title:  "Workflow",
+			Width:  workflowLayout.Width,
+			Hidden: workflowLayout.Hidden,
+		},
+		{
+			Title:  "Title",
+			Grow:   utils.BoolPtr(true),
+			Hidden: titleLayout.Hidden,
+		},
+		{
+			Title:  "",
+			Width:  branchLayout.Width,
+			Hidden: branchLayout.Hidden,
+		},
+		{
+			Title:  "Event",
+			Width:  eventLayout.Width,
+			Hidden: eventLayout.Hidden,
+		},
+		{
+			Title:  "Conclusion",
+			Width:  conclusionLayout.Width,
+			Hidden: conclusionLayout.Hidden,
+		},
+		{
+			Title:  "󱎫",
+			Width:  durationLayout.Width,
+			Hidden: durationLayout.Hidden,
+		},
+		{
+			Title:  "󱦻",
+			Width:  updatedAtLayout.Width,
+			Hidden: updatedAtLayout.Hidden,
+		},
+	}
+}
+
+func (m Model) BuildRows() []table.Row {
+	var rows []table.Row
+	for _, currRun := range m.Runs {
+		runModel := workflowrow.Run{Ctx: m.Ctx, Data: currRun}
+		rows = append(rows, runModel.ToTableRow())
+	}
+
+	if rows == nil {
+		rows = []table.Row{}
+	}
+
+	return rows
+}
+
+func (m *Model) NumRows() int {
+	return len(m.Runs)
+}
+
+func (m *Model) getCurrRun() *data.WorkflowRunData {
+	i := m.Table.GetCurrItem()
+	if i < 0 || i >= len(m.Runs) {
+		return nil
+	}
+	run := m.Runs[i]
+	return &run
+}
+
+func (m *Model) GetCurrRow() data.RowData {
+	run := m.getCurrRun()
+	if run == nil {
+		return nil
+	}
+	return run
+}
+
+func (m *Model) FetchNextPageSectionRows() []tea.Cmd {
+	if m == nil {
+		return nil
+	}
+
+	if m.PageInfo != nil && !m.PageInfo.HasNextPage {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+
+	startCursor := time.Now().String()
+	if m.PageInfo != nil {
+		startCursor = m.PageInfo.StartCursor
+	}
+	taskId := fmt.Sprintf("fetching_workflow_runs_%d_%s", m.Id, startCursor)
+	m.LastFetchTaskId = taskId
+	task := context.Task{
+		Id:        taskId,
+		StartText: fmt.Sprintf(`Fetching workflow runs for "%s"`, m.Config.Title),
+		FinishedText: fmt.Sprintf(
+			`Workflow runs for "%s" have been fetched`,
+			m.Config.Title,
+		),
+		State: context.TaskStart,
+		Error: nil,
+	}
+	startCmd := m.Ctx.StartTask(task)
+	cmds = append(cmds, startCmd)
+
+	fetchCmd := func() tea.Msg {
+		limit := m.Config.Limit
+		if limit == nil {
+			limit = &m.Ctx.Config.Defaults.WorkflowsLimit
+		}
+		res, err := data.FetchWorkflowRuns(m.GetFilters(), *limit, m.PageInfo)
+		if err != nil {
+			return constants.TaskFinishedMsg{
+				SectionId:   m.Id,
+				SectionType: m.Type,
+				TaskId:      taskId,
+				Err:         err,
+			}
+		}
+
+		return constants.TaskFinishedMsg{
+			SectionId:   m.Id,
+			SectionType: m.Type,
+			TaskId:      taskId,
+			Msg: SectionWorkflowRunsFetchedMsg{
+				Runs:       res.Runs,
+				TotalCount: res.TotalCount,
+				PageInfo:   res.PageInfo,
+				TaskId:     taskId,
+			},
+		}
+	}
+	cmds = append(cmds, fetchCmd)
+
+	return cmds
+}
+
+func (m *Model) UpdateLastUpdated(t time.Time) {
+	m.Table.UpdateLastUpdated(t)
+}
+
+func (m *Model) ResetRows() {
+	m.Runs = nil
+	m.BaseModel.ResetRows()
+}
+
+func FetchAllSections(
+	ctx *context.ProgramContext,
+) (sections []section.Section, fetchAllCmd tea.Cmd) {
+	sectionConfigs := ctx.Config.WorkflowsSections
+	fetchRunsCmds := make([]tea.Cmd, 0, len(sectionConfigs))
+	sections = make([]section.Section, 0, len(sectionConfigs))
+	for i, sectionConfig := range sectionConfigs {
+		sectionModel := NewModel(
+			i+1, // 0 is the search section
+			ctx,
+			sectionConfig,
+			time.Now(),
+			time.Now(),
+		)
+		sections = append(sections, &sectionModel)
+		fetchRunsCmds = append(
+			fetchRunsCmds,
+			sectionModel.FetchNextPageSectionRows()...)
+	}
+	return sections, tea.Batch(fetchRunsCmds...)
+}
+
+type SectionWorkflowRunsFetchedMsg struct {
+	Runs       []data.WorkflowRunData
+	TotalCount int
+	PageInfo   data.PageInfo
+	TaskId     string
+}
+
+func (m Model) GetItemSingularForm() string {
+	return "Run"
+}
+
+func (m Model) GetItemPluralForm() string {
+	return "Runs"
+}
+
+func (m Model) GetTotalCount() int {
+	return m.TotalCount
+}
+
+func (m *Model) GetIsLoading() bool {
+	return m.IsLoading
+}
+
+func (m *Model) SetIsLoading(val bool) {
+	m.IsLoading = val
+	m.Table.SetIsLoading(val)
+}
+
+func (m *Model) GetPromptConfirmation() string {
+	if m.IsPromptConfirmationFocused() && m.PromptConfirmationAction == "cancel" {
+		m.PromptConfirmationBox.SetPrompt("Are you sure you want to cancel this run? (Y/n) ")
+		return m.Ctx.Styles.ListViewPort.PagerStyle.Render(m.PromptConfirmationBox.View())
+	}
+	return m.BaseModel.GetPromptConfirmation()
+}
+
+func (m Model) GetPagerContent() string {
+	pagerContent := ""
+	if m.TotalCount > 0 {
+		pagerContent = fmt.Sprintf(
+			"%v %v • %v %v/%v • Fetched %v",
+			constants.WaitingIcon,
+			m.LastUpdated().Format("01/02 15:04:05"),
+			m.SingularForm,
+			m.Table.GetCurrItem()+1,
+			m.TotalCount,
+			len(m.Table.Rows),
+		)
+	}
+	pager := m.Ctx.Styles.ListViewPort.PagerStyle.Render(pagerContent)
+	return pager
+}
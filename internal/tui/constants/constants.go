@@ -44,6 +44,12 @@ const (
 	ClosedIcon   = ""
 	DonateIcon   = "󱃱"
 
+	// ConflictIcon marks a PR conflicting with its base branch
+	ConflictIcon = "" // \uf071 nf-fa-warning
+
+	// RefreshingIcon marks a section being refetched in the background
+	RefreshingIcon = "󰑓" // \udb81\udc53 nf-md-refresh
+
 	// New contributors: users who created a PR for the repo for the first time
 	NewContributorIcon = "󰎔" // \udb80\udf94 nf-md-new_box
 
@@ -62,5 +68,17 @@ const (
 
 	UnknownRoleIcon = "󱐡" // \udb85\udc21 nf-md-incognito_circle
 
+	// The branch the repo's origin/HEAD points to
+	DefaultBranchIcon = "󰋜" // \udb80\udedc nf-md-home
+
+	// A branch matching one of the repo's branch protection rules
+	ProtectedBranchIcon = "󰌾" // \udb80\udf3e nf-md-lock
+
+	// A stash of uncommitted changes
+	StashIcon = "󰀼" // \udb80\udc3c nf-md-archive
+
+	// A branch checked out in another worktree
+	WorktreeIcon = "󰙅" // \udb81\ude45 nf-md-file_tree
+
 	Logo = `shuvdash`
 )
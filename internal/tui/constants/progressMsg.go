@@ -8,6 +8,17 @@ type TaskFinishedMsg struct {
 	SectionType string
 	Err         error
 	Msg         tea.Msg
+	// Retry runs the task again, it's set when the task failed because GitHub
+	// couldn't be reached, to queue it until it can be
+	Retry tea.Cmd
+}
+
+// TaskProgressMsg updates the status line of a running task, e.g. with the
+// latest output of a git command. Next keeps listening for more progress.
+type TaskProgressMsg struct {
+	TaskId string
+	Text   string
+	Next   tea.Cmd
 }
 
 type ClearTaskMsg struct {
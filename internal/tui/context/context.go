@@ -4,8 +4,12 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
 	"github.com/dlvhdr/gh-dash/v4/internal/utils"
 )
@@ -16,11 +20,16 @@ const (
 	TaskStart State = iota
 	TaskFinished
 	TaskError
+	// TaskQueued is a task that failed because GitHub couldn't be reached,
+	// it's retried once it can be
+	TaskQueued
 )
 
 type Task struct {
-	Id           string
-	StartText    string
+	Id        string
+	StartText string
+	// Progress is shown after StartText while the task runs
+	Progress     string
 	FinishedText string
 	State        State
 	Error        error
@@ -44,6 +53,60 @@ type ProgramContext struct {
 	StartTask         func(task Task) tea.Cmd
 	Theme             theme.Theme
 	Styles            Styles
+	Repo              *RepoContext
+	// Keys are the universal keybindings of the dashboard, its own copy of
+	// keys.Keys
+	Keys *keys.KeyMap
+	// Zone tracks where the mouse zones of the dashboard's view are, each
+	// dashboard served over SSH has its own
+	Zone          *zone.Manager
+	SearchHistory *state.SearchHistory
+	// ReadItems tracks the rows read in inbox sections, it's nil when they
+	// can't be saved
+	ReadItems *state.ReadItems
+	// ReviewPlan holds the review requests planned for today, tomorrow or
+	// later, nil when it can't be saved
+	ReviewPlan *state.ReviewPlan
+	// Snoozed holds the PRs and issues hidden from the sections for a while,
+	// it's nil when they can't be saved
+	Snoozed *state.Snoozed
+	// ShowSnoozed shows the snoozed PRs and issues in the sections again
+	ShowSnoozed bool
+	// Archive holds the PRs and issues that left the sections once merged or
+	// closed, it's nil when the dashboard is read-only
+	Archive *state.Archive
+	// CheckFilters holds the sources of checks hidden in each repo, it's nil
+	// when the dashboard is read-only
+	CheckFilters *state.CheckFilters
+	// RestoredSession is the session restored at launch, its sections are
+	// taken as they're created. It's nil unless restoreSession is on.
+	RestoredSession *state.SavedSession
+	// ReadOnly blocks every key that acts on GitHub or the machine running
+	// the dashboard, it's shared with others
+	ReadOnly bool
+	// Mini lays the dashboard out for a narrow pane: a single section without
+	// tabs, dense rows and a minimal footer
+	Mini bool
+	// Profile is the config profile picked with --profile, the profiles
+	// matching the terminal are applied when it's empty
+	Profile string
+	// NewVersion is the version of a newer release of gh-dash, empty when
+	// there's none or updates aren't checked
+	NewVersion string
+}
+
+// ArchiveEnabled reports whether the items leaving the sections are archived
+// and the archive view is shown
+func (ctx *ProgramContext) ArchiveEnabled() bool {
+	return ctx.Archive != nil && ctx.Config.Archive.Enabled()
+}
+
+// ReadingRenderer returns the renderer of the text of a preview whose
+// content is width wide, e.g. of the body of a PR, following the reading
+// width and overflow of the preview
+func (ctx *ProgramContext) ReadingRenderer(width int) markdown.Renderer {
+	preview := ctx.Config.Defaults.Preview
+	return markdown.GetReadingRenderer(preview.TextWidth(width), preview.Overflow == "truncate")
 }
 
 func (ctx *ProgramContext) GetViewSectionsConfig() []config.SectionConfig {
@@ -61,11 +124,72 @@ func (ctx *ProgramContext) GetViewSectionsConfig() []config.SectionConfig {
 		for _, cfg := range ctx.Config.PRSections {
 			configs = append(configs, cfg.ToSectionConfig())
 		}
+		for _, s := range ctx.PlanSections() {
+			configs = append(configs, s.Config.ToSectionConfig())
+		}
 	case config.IssuesView:
 		for _, cfg := range ctx.Config.IssuesSections {
 			configs = append(configs, cfg.ToSectionConfig())
 		}
+	case config.WorkflowsView:
+		for _, cfg := range ctx.Config.WorkflowsSections {
+			configs = append(configs, cfg.ToSectionConfig())
+		}
+	case config.FeedsView:
+		for _, cfg := range ctx.Config.FeedsSections {
+			configs = append(configs, cfg.ToSectionConfig())
+		}
+	case config.DiscussionsView:
+		for _, cfg := range ctx.Config.DiscussionsSections {
+			configs = append(configs, cfg.ToSectionConfig())
+		}
+	case config.ReleasesView:
+		for _, cfg := range ctx.Config.ReleasesSections {
+			configs = append(configs, cfg.ToSectionConfig())
+		}
+	case config.DependenciesView:
+		for _, cfg := range ctx.Config.DependenciesSections {
+			configs = append(configs, cfg.ToSectionConfig())
+		}
+	case config.ArchiveView:
+		configs = append(configs, config.ArchiveSections...)
 	}
 
 	return append([]config.SectionConfig{{Title: ""}}, configs...)
 }
+
+// planSectionFilters are the filters of the plan sections, planned reviews
+// drop out of them once reviewed
+const planSectionFilters = "is:open review-requested:@me"
+
+// PlanSection is a section of the PRs view listing the review requests
+// planned in one bucket of the review plan
+type PlanSection struct {
+	Bucket state.PlanBucket
+	Config config.PrsSectionConfig
+}
+
+// PlanSections returns the sections of the buckets of the review plan that
+// have reviews, they're shown after the configured ones
+func (ctx *ProgramContext) PlanSections() []PlanSection {
+	if ctx.ReviewPlan == nil {
+		return nil
+	}
+
+	var sections []PlanSection
+	now := time.Now()
+	for _, bucket := range state.PlanBuckets {
+		if len(ctx.ReviewPlan.Reviews(bucket, now)) == 0 {
+			continue
+		}
+		sections = append(sections, PlanSection{
+			Bucket: bucket,
+			Config: config.PrsSectionConfig{
+				Title:   "Review " + string(bucket),
+				Filters: planSectionFilters,
+				Limit:   utils.IntPtr(100),
+			},
+		})
+	}
+	return sections
+}
@@ -0,0 +1,167 @@
+package context
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/utils"
+)
+
+// RemoteRepo is a GitHub repository parsed from a git remote URL
+type RemoteRepo struct {
+	// Remote is the name of the git remote
+	Remote string
+	// Host is the host of the repository, e.g. github.com or a GitHub
+	// Enterprise host
+	Host  string
+	Owner string
+	Name  string
+}
+
+// NameWithOwner returns the repository as owner/name
+func (r RemoteRepo) NameWithOwner() string {
+	return fmt.Sprintf("%s/%s", r.Owner, r.Name)
+}
+
+// RepoContext caches the remotes of the local repository so that view code
+// can query them without spawning git on every render.
+// Remotes are read lazily on first access. A nil RepoContext reads them
+// uncached on every call.
+type RepoContext struct {
+	mu       sync.Mutex
+	loaded   bool
+	dir      string
+	origin   *RemoteRepo
+	upstream *RemoteRepo
+	// remotes are all the remotes, origin first, upstream second and the
+	// others by name
+	remotes []RemoteRepo
+}
+
+// Invalidate drops the cached remotes, they will be read again on next access
+func (rc *RepoContext) Invalidate() {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.loaded = false
+	rc.origin = nil
+	rc.upstream = nil
+	rc.remotes = nil
+}
+
+func (rc *RepoContext) load(dir string) {
+	if rc.loaded && rc.dir == dir {
+		return
+	}
+
+	rc.loaded = true
+	rc.dir = dir
+	rc.origin = nil
+	rc.upstream = nil
+	rc.remotes = nil
+
+	remotes, err := git.GetRemotes(dir)
+	if err != nil {
+		return
+	}
+	for _, remote := range remotes {
+		repo := RemoteRepo{Remote: remote.Name, Host: remote.Host, Owner: remote.Owner, Name: remote.Repo}
+		rc.remotes = append(rc.remotes, repo)
+		switch remote.Name {
+		case "origin":
+			rc.origin = &repo
+		case "upstream":
+			rc.upstream = &repo
+		}
+	}
+}
+
+func (rc *RepoContext) get(dir string, upstream bool) *RemoteRepo {
+	if rc == nil {
+		rc = &RepoContext{}
+	} else {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+	}
+	rc.load(dir)
+	if upstream {
+		return rc.upstream
+	}
+	return rc.origin
+}
+
+func (rc *RepoContext) all(dir string) []RemoteRepo {
+	if rc == nil {
+		rc = &RepoContext{}
+	} else {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+	}
+	rc.load(dir)
+	return rc.remotes
+}
+
+// getRepoDir returns the repository directory to read remotes from.
+// Uses ctx.RepoPath if available, otherwise falls back to ".".
+func (ctx *ProgramContext) getRepoDir() string {
+	if ctx.RepoPath != "" {
+		return ctx.RepoPath
+	}
+	return "."
+}
+
+// GetOriginRepo returns the cached owner and name of the origin remote
+func (ctx *ProgramContext) GetOriginRepo() (owner, name string, hasOrigin bool) {
+	repo := ctx.Repo.get(ctx.getRepoDir(), false)
+	if repo == nil {
+		return "", "", false
+	}
+	return repo.Owner, repo.Name, true
+}
+
+// GetUpstreamRepo returns the cached owner and name of the upstream remote
+func (ctx *ProgramContext) GetUpstreamRepo() (owner, name string, hasUpstream bool) {
+	repo := ctx.Repo.get(ctx.getRepoDir(), true)
+	if repo == nil {
+		return "", "", false
+	}
+	return repo.Owner, repo.Name, true
+}
+
+// GetRemoteRepos returns the cached repositories of every remote, origin
+// first, upstream second and the others by remote name
+func (ctx *ProgramContext) GetRemoteRepos() []RemoteRepo {
+	return ctx.Repo.all(ctx.getRepoDir())
+}
+
+// GetRemoteRepo returns the cached repository of the remote called remote
+func (ctx *ProgramContext) GetRemoteRepo(remote string) (RemoteRepo, bool) {
+	for _, repo := range ctx.GetRemoteRepos() {
+		if repo.Remote == remote {
+			return repo, true
+		}
+	}
+	return RemoteRepo{}, false
+}
+
+// SearchVars returns the variables the search templates of the sections can
+// use, e.g. {{ .UpstreamRepo }}
+func (ctx *ProgramContext) SearchVars() utils.SearchVars {
+	vars := utils.SearchVars{Now: time.Now(), CurrentUser: ctx.User}
+	if origin, ok := ctx.GetRemoteRepo("origin"); ok {
+		vars.OriginRepo = origin.NameWithOwner()
+		vars.CurrentRepo = vars.OriginRepo
+		vars.UpstreamRepo = vars.OriginRepo
+	}
+	if upstream, ok := ctx.GetRemoteRepo("upstream"); ok {
+		vars.UpstreamRepo = upstream.NameWithOwner()
+	}
+	if branch, err := git.GetCurrentBranch(ctx.getRepoDir()); err == nil {
+		vars.CurrentBranch = branch
+	}
+	return vars
+}
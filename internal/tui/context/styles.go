@@ -14,6 +14,7 @@ type Styles struct {
 		ClosedIssue lipgloss.AdaptiveColor
 		SuccessText lipgloss.AdaptiveColor
 		OpenPR      lipgloss.AdaptiveColor
+		DraftPR     lipgloss.AdaptiveColor
 		ClosedPR    lipgloss.AdaptiveColor
 		MergedPR    lipgloss.AdaptiveColor
 	}
@@ -89,27 +90,20 @@ const (
 func InitStyles(theme theme.Theme) Styles {
 	var s Styles
 
-	s.Colors.OpenIssue = lipgloss.AdaptiveColor{
-		Light: "#42A0FA",
-		Dark:  "#42A0FA",
-	}
-	s.Colors.ClosedIssue = lipgloss.AdaptiveColor{
-		Light: "#C38080",
-		Dark:  "#C38080",
-	}
+	s.Colors.OpenIssue = theme.OpenIssueColor
+	s.Colors.ClosedIssue = theme.ClosedIssueColor
 	s.Colors.SuccessText = lipgloss.AdaptiveColor{
 		Light: "#3DF294",
 		Dark:  "#3DF294",
 	}
-	s.Colors.OpenPR = s.Colors.OpenIssue
-	s.Colors.ClosedPR = lipgloss.AdaptiveColor{
-		Light: "#656C76",
-		Dark:  "#656C76",
-	}
-	s.Colors.MergedPR = lipgloss.AdaptiveColor{
-		Light: "#A371F7",
-		Dark:  "#A371F7",
+	s.Colors.OpenPR = theme.OpenPRColor
+	s.Colors.DraftPR = theme.DraftPRColor
+	if s.Colors.DraftPR == (lipgloss.AdaptiveColor{}) {
+		// drafts are faint unless the theme colors them
+		s.Colors.DraftPR = theme.FaintText
 	}
+	s.Colors.ClosedPR = theme.ClosedPRColor
+	s.Colors.MergedPR = theme.MergedPRColor
 
 	s.Common = common.BuildStyles(theme)
 
@@ -0,0 +1,173 @@
+package tui
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"slices"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+)
+
+const (
+	// maxRecentMsgs is the number of messages a crash report lists
+	maxRecentMsgs = 50
+	// maxRecentErrors is the number of errors a bug report lists
+	maxRecentErrors = 10
+)
+
+// crashRecorder keeps what goes in a crash report, and the recent errors of a
+// bug report. It's shared by the copies of the model so the report survives
+// the copy that panicked.
+type crashRecorder struct {
+	mu           sync.Mutex
+	recentMsgs   []string
+	recentErrors []string
+	// session is the session of the last update that didn't panic
+	session state.Session
+	report  *state.CrashReport
+	path    string
+}
+
+func (r *crashRecorder) record(msg tea.Msg) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recentMsgs = append(r.recentMsgs, fmt.Sprintf("%T", msg))
+	if len(r.recentMsgs) > maxRecentMsgs {
+		r.recentMsgs = r.recentMsgs[len(r.recentMsgs)-maxRecentMsgs:]
+	}
+
+	var err error
+	switch msg := msg.(type) {
+	case constants.ErrMsg:
+		err = msg.Err
+	case constants.TaskFinishedMsg:
+		err = msg.Err
+	}
+	if err != nil {
+		r.recentErrors = append(r.recentErrors,
+			fmt.Sprintf("%s %s", time.Now().Format(time.TimeOnly), err))
+		if len(r.recentErrors) > maxRecentErrors {
+			r.recentErrors = r.recentErrors[len(r.recentErrors)-maxRecentErrors:]
+		}
+	}
+}
+
+// lastErrors returns the recent errors, oldest first
+func (r *crashRecorder) lastErrors() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.recentErrors)
+}
+
+func (r *crashRecorder) setSession(session state.Session) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.session = session
+}
+
+func (r *crashRecorder) hasCrashed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.report != nil
+}
+
+// save writes the report of the panic p, the first panic wins as the later
+// ones are likely caused by it
+func (r *crashRecorder) save(version string, cfg *config.Config, p any, stack []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.report != nil {
+		return
+	}
+
+	r.report = &state.CrashReport{
+		Time:         time.Now(),
+		Version:      version,
+		Panic:        fmt.Sprint(p),
+		Stack:        string(stack),
+		RecentMsgs:   r.recentMsgs,
+		ConfigDigest: configDigest(cfg),
+		Session:      r.session,
+	}
+	log.Error("Recovered from a panic", "panic", p, "stack", string(stack))
+	dir, err := state.Dir()
+	if err == nil {
+		r.path, err = state.SaveCrashReport(dir, *r.report)
+	}
+	if err != nil {
+		log.Error("Failed saving the crash report", "err", err)
+	}
+}
+
+// configDigest is the hash of the config, it tells whether two crashes ran
+// with the same config without disclosing it
+func configDigest(cfg *config.Config) string {
+	if cfg == nil {
+		return ""
+	}
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Crash returns the report of the panic that stopped the dashboard and the
+// path it was saved at, nil when it didn't panic. err is the one the program
+// returned, a panic Bubble Tea caught instead, e.g. in a command, is reported
+// without its stack as Bubble Tea prints it.
+func (m Model) Crash(err error) (*state.CrashReport, string) {
+	if !m.crash.hasCrashed() {
+		if !errors.Is(err, tea.ErrProgramPanic) {
+			return nil, ""
+		}
+		m.crash.save(m.ctx.Version, m.ctx.Config, "caught by Bubble Tea, its stack is printed above", nil)
+	}
+	m.crash.mu.Lock()
+	defer m.crash.mu.Unlock()
+	return m.crash.report, m.crash.path
+}
+
+// session is where the dashboard is at, for it to be restarted there after
+// a crash
+func (m *Model) session() state.Session {
+	session := state.Session{
+		View:     string(m.ctx.View),
+		RepoPath: m.ctx.RepoPath,
+	}
+	if m.ctx.View == config.RepoView {
+		return session
+	}
+	session.Section = m.currSectionId
+	if s := m.getCurrSection(); s != nil && s.GetFilters() != s.GetConfig().Filters {
+		session.Filters = s.GetFilters()
+	}
+	return session
+}
+
+// recoverUpdate saves the report of a panic in Update and quits, Bubble Tea
+// then restores the terminal as it would on any quit
+func (m *Model) recoverUpdate(p any) (tea.Model, tea.Cmd) {
+	m.crash.save(m.ctx.Version, m.ctx.Config, p, debug.Stack())
+	return m, tea.Quit
+}
+
+// recoverView saves the report of a panic in View, the next update quits
+func (m *Model) recoverView(p any) string {
+	m.crash.save(m.ctx.Version, m.ctx.Config, p, debug.Stack())
+	return lipgloss.Place(m.ctx.ScreenWidth, m.ctx.ScreenHeight, lipgloss.Center, lipgloss.Center,
+		"gh-dash crashed, press any key to quit")
+}
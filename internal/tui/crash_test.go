@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+func TestCrashRecorderKeepsRecentMsgs(t *testing.T) {
+	r := &crashRecorder{}
+	for range maxRecentMsgs {
+		r.record(tea.KeyMsg{})
+	}
+	r.record(tea.WindowSizeMsg{})
+
+	if len(r.recentMsgs) != maxRecentMsgs {
+		t.Errorf("kept %d messages, want %d", len(r.recentMsgs), maxRecentMsgs)
+	}
+	if last := r.recentMsgs[len(r.recentMsgs)-1]; last != "tea.WindowSizeMsg" {
+		t.Errorf("last message = %q, want tea.WindowSizeMsg", last)
+	}
+}
+
+func TestRecoverUpdate(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	m := NewModel(config.Location{ConfigFlag: "../config/testdata/test-config.yml"})
+	if report, _ := m.Crash(nil); report != nil {
+		t.Fatalf("Crash() before any panic = %+v, want nil", report)
+	}
+
+	_, cmd := m.recoverUpdate("boom")
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Errorf("recoverUpdate() cmd doesn't quit")
+	}
+
+	report, path := m.Crash(nil)
+	if report == nil || report.Panic != "boom" || report.Stack == "" {
+		t.Fatalf("Crash() = %+v, want the report of the panic", report)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("crash report not saved at %s: %v", path, err)
+	}
+
+	// the dashboard quits on the next update instead of running on
+	if _, cmd := m.Update(tea.KeyMsg{}); cmd == nil {
+		t.Error("Update() after a panic doesn't quit")
+	} else if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("Update() after a panic doesn't quit")
+	}
+}
+
+func TestCrashCaughtByBubbleTea(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	m := NewModel(config.Location{ConfigFlag: "../config/testdata/test-config.yml"})
+
+	if report, _ := m.Crash(errors.New("some error")); report != nil {
+		t.Errorf("Crash() of an error = %+v, want nil", report)
+	}
+	report, _ := m.Crash(tea.ErrProgramPanic)
+	if report == nil || report.Stack != "" {
+		t.Errorf("Crash() of a caught panic = %+v, want a report without stack", report)
+	}
+}
@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/export"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// exportSection writes the rows of the current section to a file, in the
+// format and directory of the export config
+func (m *Model) exportSection() tea.Cmd {
+	currSection := m.getCurrSection()
+	exporter, ok := currSection.(section.Exporter)
+	if currSection == nil || !ok {
+		return m.notifyErr("Only PR and issue sections can be exported")
+	}
+
+	cfg := m.ctx.Config.Export
+	format := export.JSON
+	if cfg.Format != "" {
+		format = export.Format(cfg.Format)
+	}
+	dir := cfg.Dir
+	if strings.HasPrefix(dir, "~") {
+		home, _ := os.UserHomeDir()
+		dir = strings.Replace(dir, "~", home, 1)
+	}
+	path := filepath.Join(dir, exportFileName(currSection.GetConfig().Title, format, time.Now()))
+
+	table := exporter.ExportRows()
+	if err := writeExport(path, table, format); err != nil {
+		log.Error("Failed exporting section", "path", path, "err", err)
+		return m.notifyErr(fmt.Sprintf("Failed exporting the section: %v", err))
+	}
+	return m.notify(fmt.Sprintf("Exported %d rows to %s", len(table.Rows), path))
+}
+
+func writeExport(path string, table export.Table, format export.Format) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := export.Write(f, table, format); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// exportFileName returns the name of the file a section titled title is
+// exported to at now, e.g. needs-my-review-20240501-123000.json
+func exportFileName(title string, format export.Format, now time.Time) string {
+	slug := strings.Join(strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), "-")
+	if slug == "" {
+		slug = "section"
+	}
+	return fmt.Sprintf("%s-%s.%s", slug, now.Format("20060102-150405"), format)
+}
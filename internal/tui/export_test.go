@@ -0,0 +1,29 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/export"
+)
+
+func TestExportFileName(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 30, 0, 0, time.Local)
+	tests := []struct {
+		title  string
+		format export.Format
+		want   string
+	}{
+		{title: "Needs My Review", format: export.JSON, want: "needs-my-review-20240501-123000.json"},
+		{title: " Bugs: P0 / P1 ", format: export.CSV, want: "bugs-p0-p1-20240501-123000.csv"},
+		{title: "", format: export.JSON, want: "section-20240501-123000.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			if got := exportFileName(tt.title, tt.format, now); got != tt.want {
+				t.Errorf("exportFileName(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
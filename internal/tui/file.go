@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"reflect"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// viewFile opens a file of the repo of the selected row in the sidebar. The
+// file the row references, like the manifest of a dependency or the workflow
+// of a run, is shown right away, the path of any other file is asked for.
+func (m *Model) viewFile() tea.Cmd {
+	row := m.getCurrRowData()
+	if row == nil || reflect.ValueOf(row).IsNil() || row.GetRepoNameWithOwner() == "" {
+		return m.notifyErr("Current selection isn't associated with a repo")
+	}
+	repo := row.GetRepoNameWithOwner()
+
+	m.sidebar.IsOpen = true
+	m.syncMainContentWidth()
+	m.sidebar.ScrollToTop()
+
+	if referrer, ok := row.(data.FileReferrer); ok && referrer.GetFilePath() != "" &&
+		(!m.fileView.IsOpen() || m.fileView.Repo() != repo) {
+		cmd := m.fileView.Fetch(repo, referrer.GetFilePath())
+		m.syncSidebar()
+		return cmd
+	}
+
+	path := ""
+	if m.fileView.IsOpen() && m.fileView.Repo() == repo {
+		path = m.fileView.Path()
+	}
+	cmd := m.focusSidebar(m.fileView.Open(repo, path))
+	m.syncSidebar()
+	return cmd
+}
@@ -0,0 +1,104 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// updateFocused sends a key press to the focused layer, if one is opened over
+// the table. The layer captures every key until it's closed, unwinding back to
+// the layer below it.
+func (m Model) updateFocused(msg tea.KeyMsg, currSection section.Section) (bool, tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch m.focus.Top() {
+	case focus.Table:
+		if currSection == nil || currSection.FocusedMode() == focus.Table {
+			return false, m, nil
+		}
+		cmd = m.updateSection(currSection.GetId(), currSection.GetType(), msg)
+
+	case focus.Sidebar:
+		switch {
+		case m.prView.IsTextInputBoxFocused():
+			m.prView, cmd = m.prView.Update(msg)
+		case m.issueSidebar.IsTextInputBoxFocused():
+			m.issueSidebar, cmd = m.issueSidebar.Update(msg)
+		case m.discussionSidebar.IsTextInputBoxFocused():
+			m.discussionSidebar, cmd = m.discussionSidebar.Update(msg)
+		case m.fileView.Focused():
+			m.fileView, cmd = m.fileView.Update(msg)
+		default:
+			// the input was closed without us knowing
+			m.focus.Remove(focus.Sidebar)
+			return m.updateFocused(msg, currSection)
+		}
+		if !m.prView.IsTextInputBoxFocused() && !m.issueSidebar.IsTextInputBoxFocused() &&
+			!m.discussionSidebar.IsTextInputBoxFocused() && !m.fileView.Focused() {
+			m.focus.Remove(focus.Sidebar)
+		}
+		m.syncSidebar()
+
+	case focus.Confirm:
+		cmd = m.updateQuitConfirm(msg)
+
+	case focus.Form:
+		m.itemForm, cmd = m.itemForm.Update(msg)
+		if !m.itemForm.Focused() {
+			m.focus.Remove(focus.Form)
+		}
+
+	case focus.Palette:
+		switch {
+		case m.palette.Focused():
+			m.palette, cmd = m.palette.Update(msg)
+		case m.planner.Focused():
+			m.planner, cmd = m.planner.Update(msg)
+		case m.labelPicker.Focused():
+			m.labelPicker, cmd = m.labelPicker.Update(msg)
+		case m.milestonePicker.Focused():
+			m.milestonePicker, cmd = m.milestonePicker.Update(msg)
+		case m.sectionEditor.Focused():
+			m.sectionEditor, cmd = m.sectionEditor.Update(msg)
+		case m.themePicker.Focused():
+			m.themePicker, cmd = m.themePicker.Update(msg)
+		case m.filterPicker.Focused():
+			m.filterPicker, cmd = m.filterPicker.Update(msg)
+		case m.snoozePicker.Focused():
+			m.snoozePicker, cmd = m.snoozePicker.Update(msg)
+		case m.filterDebug.Focused():
+			m.filterDebug, cmd = m.filterDebug.Update(msg)
+		case m.releaseNotes.Focused():
+			m.releaseNotes, cmd = m.releaseNotes.Update(msg)
+		default:
+			m.historyOverlay, cmd = m.historyOverlay.Update(msg)
+		}
+		if !m.palette.Focused() && !m.planner.Focused() && !m.labelPicker.Focused() &&
+			!m.milestonePicker.Focused() && !m.sectionEditor.Focused() && !m.themePicker.Focused() &&
+			!m.filterPicker.Focused() && !m.snoozePicker.Focused() && !m.filterDebug.Focused() &&
+			!m.releaseNotes.Focused() && !m.historyOverlay.Focused() {
+			m.focus.Remove(focus.Palette)
+		}
+
+	default:
+		// a layer nothing handles would swallow every key
+		log.Error("No handler for focused layer, closing it", "mode", m.focus.Top())
+		m.focus.Pop()
+		return m.updateFocused(msg, currSection)
+	}
+
+	return true, m, cmd
+}
+
+// focusSidebar pushes the sidebar layer if opening one of its text inputs
+// succeeded, there's nothing to comment on without a selected row
+func (m *Model) focusSidebar(cmd tea.Cmd) tea.Cmd {
+	if m.prView.IsTextInputBoxFocused() || m.issueSidebar.IsTextInputBoxFocused() ||
+		m.discussionSidebar.IsTextInputBoxFocused() || m.fileView.Focused() {
+		m.focus.Push(focus.Sidebar)
+	}
+	return cmd
+}
@@ -0,0 +1,99 @@
+package focus
+
+// Mode is what receives key presses
+type Mode int
+
+const (
+	// Table is the section's table, the mode when nothing else is focused
+	Table Mode = iota
+	Search
+	// Sidebar is a text input in the sidebar, e.g. when commenting
+	Sidebar
+	Picker
+	Form
+	Palette
+	Confirm
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Search:
+		return "search"
+	case Sidebar:
+		return "sidebar"
+	case Picker:
+		return "picker"
+	case Form:
+		return "form"
+	case Palette:
+		return "palette"
+	case Confirm:
+		return "confirm"
+	default:
+		return "table"
+	}
+}
+
+// Stack holds the modes layered over the table, the top one captures input.
+// Closing a layer unwinds back to the one it was opened from.
+type Stack struct {
+	modes []Mode
+}
+
+// Top returns the mode receiving input
+func (s Stack) Top() Mode {
+	if len(s.modes) == 0 {
+		return Table
+	}
+	return s.modes[len(s.modes)-1]
+}
+
+// Has returns whether mode is anywhere on the stack
+func (s Stack) Has(mode Mode) bool {
+	if mode == Table {
+		return true
+	}
+	for _, m := range s.modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Stack) Len() int {
+	return len(s.modes)
+}
+
+// Push layers mode over the current one, unless it's already on top
+func (s *Stack) Push(mode Mode) {
+	if mode == Table || s.Top() == mode {
+		return
+	}
+	s.modes = append(s.modes, mode)
+}
+
+// Pop closes the top layer and returns it
+func (s *Stack) Pop() Mode {
+	if len(s.modes) == 0 {
+		return Table
+	}
+	top := s.modes[len(s.modes)-1]
+	s.modes = s.modes[:len(s.modes)-1]
+	return top
+}
+
+// Remove closes the topmost layer of mode along with the layers opened over it
+func (s *Stack) Remove(mode Mode) {
+	for i := len(s.modes) - 1; i >= 0; i-- {
+		if s.modes[i] == mode {
+			s.modes = s.modes[:i]
+			return
+		}
+	}
+}
+
+// Reset closes every layer
+func (s *Stack) Reset() {
+	s.modes = nil
+}
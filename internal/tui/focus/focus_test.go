@@ -0,0 +1,82 @@
+package focus
+
+import (
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	tests := []struct {
+		name    string
+		ops     func(s *Stack)
+		wantTop Mode
+		wantLen int
+	}{
+		{
+			name:    "empty stack is the table",
+			ops:     func(s *Stack) {},
+			wantTop: Table,
+			wantLen: 0,
+		},
+		{
+			name:    "push layers over the table",
+			ops:     func(s *Stack) { s.Push(Search); s.Push(Picker) },
+			wantTop: Picker,
+			wantLen: 2,
+		},
+		{
+			name:    "pushing the top again is a no-op",
+			ops:     func(s *Stack) { s.Push(Search); s.Push(Search) },
+			wantTop: Search,
+			wantLen: 1,
+		},
+		{
+			name:    "table can't be pushed",
+			ops:     func(s *Stack) { s.Push(Table) },
+			wantTop: Table,
+			wantLen: 0,
+		},
+		{
+			name:    "pop unwinds one layer",
+			ops:     func(s *Stack) { s.Push(Search); s.Push(Picker); s.Pop() },
+			wantTop: Search,
+			wantLen: 1,
+		},
+		{
+			name:    "pop on empty stack stays on the table",
+			ops:     func(s *Stack) { s.Pop() },
+			wantTop: Table,
+			wantLen: 0,
+		},
+		{
+			name:    "remove closes the layers opened over it",
+			ops:     func(s *Stack) { s.Push(Sidebar); s.Push(Picker); s.Push(Confirm); s.Remove(Picker) },
+			wantTop: Sidebar,
+			wantLen: 1,
+		},
+		{
+			name:    "remove a mode that isn't on the stack",
+			ops:     func(s *Stack) { s.Push(Search); s.Remove(Palette) },
+			wantTop: Search,
+			wantLen: 1,
+		},
+		{
+			name:    "reset",
+			ops:     func(s *Stack) { s.Push(Search); s.Push(Palette); s.Reset() },
+			wantTop: Table,
+			wantLen: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s Stack
+			tt.ops(&s)
+			if s.Top() != tt.wantTop {
+				t.Errorf("Top() = %s, want %s", s.Top(), tt.wantTop)
+			}
+			if s.Len() != tt.wantLen {
+				t.Errorf("Len() = %d, want %d", s.Len(), tt.wantLen)
+			}
+		})
+	}
+}
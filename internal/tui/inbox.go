@@ -0,0 +1,132 @@
+package tui
+
+import (
+	"reflect"
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// currInbox returns the current section if it's an inbox
+func (m *Model) currInbox() (section.Inbox, bool) {
+	inbox, ok := m.getCurrSection().(section.Inbox)
+	if !ok || !inbox.IsInbox() {
+		return nil, false
+	}
+	return inbox, true
+}
+
+// markViewedRowRead marks the selected row read once it's shown in the
+// preview of an inbox section
+func (m *Model) markViewedRowRead() tea.Cmd {
+	if !m.sidebar.IsOpen || m.linkedRow != nil {
+		return nil
+	}
+	inbox, ok := m.currInbox()
+	if !ok {
+		return nil
+	}
+	row := m.getCurrRowData()
+	if row == nil || reflect.ValueOf(row).IsNil() {
+		return nil
+	}
+
+	if !m.ctx.ReadItems.MarkRead(row.GetUrl(), row.GetUpdatedAt()) {
+		return nil
+	}
+	inbox.SyncReadRows()
+	return m.saveReadItems()
+}
+
+// toggleRead marks the selected row of an inbox section read, or unread if it
+// was read
+func (m *Model) toggleRead() tea.Cmd {
+	inbox, ok := m.currInbox()
+	if !ok {
+		return m.notifyErr("This section isn't an inbox, set inbox: true in its config")
+	}
+	row := m.getCurrRowData()
+	if row == nil || reflect.ValueOf(row).IsNil() {
+		return nil
+	}
+
+	if !m.ctx.ReadItems.MarkRead(row.GetUrl(), row.GetUpdatedAt()) {
+		m.ctx.ReadItems.MarkUnread(row.GetUrl())
+	}
+	inbox.SyncReadRows()
+	return m.saveReadItems()
+}
+
+// goToNextUnread selects the first unread row after the selected one, in the
+// current section or else the next inbox section with unread rows
+func (m *Model) goToNextUnread() tea.Cmd {
+	sections := m.getCurrentViewSections()
+	unread := make([][]int, len(sections))
+	hasInbox := false
+	for i, s := range sections {
+		if inbox, ok := s.(section.Inbox); ok && inbox.IsInbox() {
+			hasInbox = true
+			unread[i] = inbox.UnreadRows()
+		}
+	}
+	if !hasInbox {
+		return m.notifyErr("None of this view's sections is an inbox")
+	}
+
+	currRow := -1
+	if curr := m.getCurrSection(); curr != nil {
+		currRow = curr.CurrRow()
+	}
+	sectionId, row, ok := nextUnread(unread, m.currSectionId, currRow)
+	if !ok {
+		return m.notify("No unread items")
+	}
+
+	m.linkedRow = nil
+	m.setCurrSectionId(sectionId)
+	sections[sectionId].(section.Inbox).SelectRow(row)
+	return m.onViewedRowChanged()
+}
+
+// nextUnread finds the first unread row after currRow in the section at
+// currSection, moving on to the next sections and wrapping around to the
+// rows up to currRow. unread holds the indexes of each section's unread rows
+// in ascending order.
+func nextUnread(unread [][]int, currSection, currRow int) (sectionId, row int, ok bool) {
+	if len(unread) == 0 {
+		return 0, 0, false
+	}
+	currSection = max(0, min(currSection, len(unread)-1))
+
+	for i := range len(unread) + 1 {
+		id := (currSection + i) % len(unread)
+		rows := unread[id]
+		if len(rows) == 0 {
+			continue
+		}
+		switch i {
+		case 0:
+			if idx, _ := slices.BinarySearch(rows, currRow+1); idx < len(rows) {
+				return id, rows[idx], true
+			}
+		default:
+			// past the current section, or back at it for the rows up to the
+			// selected one
+			return id, rows[0], true
+		}
+	}
+	return 0, 0, false
+}
+
+func (m *Model) saveReadItems() tea.Cmd {
+	readItems := m.ctx.ReadItems
+	return func() tea.Msg {
+		if err := readItems.Save(); err != nil {
+			log.Error("Failed saving read items", "err", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,71 @@
+package tui
+
+import "testing"
+
+func TestNextUnread(t *testing.T) {
+	tests := []struct {
+		name        string
+		unread      [][]int
+		currSection int
+		currRow     int
+		wantSection int
+		wantRow     int
+		wantOk      bool
+	}{
+		{
+			name:        "next row in the current section",
+			unread:      [][]int{nil, {1, 4, 7}},
+			currSection: 1,
+			currRow:     4,
+			wantSection: 1,
+			wantRow:     7,
+			wantOk:      true,
+		},
+		{
+			name:        "first row of the next section with unread rows",
+			unread:      [][]int{nil, {1}, nil, {2, 3}},
+			currSection: 1,
+			currRow:     1,
+			wantSection: 3,
+			wantRow:     2,
+			wantOk:      true,
+		},
+		{
+			name:        "wraps around to the first sections",
+			unread:      [][]int{nil, {0}, {}, nil},
+			currSection: 2,
+			currRow:     0,
+			wantSection: 1,
+			wantRow:     0,
+			wantOk:      true,
+		},
+		{
+			name:        "wraps around to the rows before the selected one",
+			unread:      [][]int{nil, {2, 5}, nil},
+			currSection: 1,
+			currRow:     6,
+			wantSection: 1,
+			wantRow:     2,
+			wantOk:      true,
+		},
+		{
+			name:        "nothing unread",
+			unread:      [][]int{nil, {}, nil},
+			currSection: 1,
+			currRow:     0,
+			wantOk:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSection, gotRow, gotOk := nextUnread(tt.unread, tt.currSection, tt.currRow)
+			if gotOk != tt.wantOk {
+				t.Fatalf("nextUnread() ok = %v, want %v", gotOk, tt.wantOk)
+			}
+			if gotOk && (gotSection != tt.wantSection || gotRow != tt.wantRow) {
+				t.Errorf("nextUnread() = (%d, %d), want (%d, %d)", gotSection, gotRow, tt.wantSection, tt.wantRow)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package keys
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type ArchiveKeyMap struct {
+	ViewPRs key.Binding
+}
+
+var ArchiveKeys = ArchiveKeyMap{
+	ViewPRs: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch view"),
+	),
+}
+
+func ArchiveFullHelp() []key.Binding {
+	return []key.Binding{
+		ArchiveKeys.ViewPRs,
+	}
+}
@@ -10,15 +10,25 @@ import (
 )
 
 type BranchKeyMap struct {
-	Checkout    key.Binding
-	New         key.Binding
-	CreatePr    key.Binding
-	FastForward key.Binding
-	Push        key.Binding
-	ForcePush   key.Binding
-	Delete      key.Binding
-	UpdatePr    key.Binding
-	ViewPRs     key.Binding
+	Checkout      key.Binding
+	New           key.Binding
+	CreatePr      key.Binding
+	CreateDraftPr key.Binding
+	FastForward   key.Binding
+	Rebase        key.Binding
+	ResetUpstream key.Binding
+	Push          key.Binding
+	ForcePush     key.Binding
+	Delete        key.Binding
+	UpdatePr      key.Binding
+	ViewPr        key.Binding
+	ViewPRs       key.Binding
+	ToggleStashes key.Binding
+	Stash         key.Binding
+	ApplyStash    key.Binding
+	PopStash      key.Binding
+	AddWorktree   key.Binding
+	OpenWorktree  key.Binding
 }
 
 var BranchKeys = BranchKeyMap{
@@ -34,10 +44,22 @@ var BranchKeys = BranchKeyMap{
 		key.WithKeys("O"),
 		key.WithHelp("O", "create PR"),
 	),
+	CreateDraftPr: key.NewBinding(
+		key.WithKeys("D"),
+		key.WithHelp("D", "create draft PR"),
+	),
 	FastForward: key.NewBinding(
 		key.WithKeys("f"),
 		key.WithHelp("f", "fast-forward"),
 	),
+	Rebase: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "rebase onto default branch"),
+	),
+	ResetUpstream: key.NewBinding(
+		key.WithKeys("X"),
+		key.WithHelp("X", "reset to upstream"),
+	),
 	Push: key.NewBinding(
 		key.WithKeys("P"),
 		key.WithHelp("P", "push"),
@@ -54,23 +76,61 @@ var BranchKeys = BranchKeyMap{
 		key.WithKeys("u"),
 		key.WithHelp("u", "update PR"),
 	),
+	ViewPr: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "view PR"),
+	),
 	ViewPRs: key.NewBinding(
 		key.WithKeys("s"),
 		key.WithHelp("s", "Switch to PRs"),
 	),
+	ToggleStashes: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "toggle stashes"),
+	),
+	Stash: key.NewBinding(
+		key.WithKeys("z"),
+		key.WithHelp("z", "stash changes"),
+	),
+	ApplyStash: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "apply stash"),
+	),
+	PopStash: key.NewBinding(
+		key.WithKeys("A"),
+		key.WithHelp("A", "pop stash"),
+	),
+	AddWorktree: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "create worktree"),
+	),
+	OpenWorktree: key.NewBinding(
+		key.WithKeys("W"),
+		key.WithHelp("W", "open worktree"),
+	),
 }
 
 func BranchFullHelp() []key.Binding {
 	return []key.Binding{
 		BranchKeys.Checkout,
 		BranchKeys.FastForward,
+		BranchKeys.Rebase,
+		BranchKeys.ResetUpstream,
 		BranchKeys.Push,
 		BranchKeys.ForcePush,
 		BranchKeys.New,
 		BranchKeys.CreatePr,
+		BranchKeys.CreateDraftPr,
 		BranchKeys.Delete,
 		BranchKeys.UpdatePr,
+		BranchKeys.ViewPr,
 		BranchKeys.ViewPRs,
+		BranchKeys.ToggleStashes,
+		BranchKeys.Stash,
+		BranchKeys.ApplyStash,
+		BranchKeys.PopStash,
+		BranchKeys.AddWorktree,
+		BranchKeys.OpenWorktree,
 	}
 }
 
@@ -105,6 +165,8 @@ func rebindBranchKeys(keys []config.Keybinding) error {
 			key = &BranchKeys.New
 		case "createPr":
 			key = &BranchKeys.CreatePr
+		case "createDraftPr":
+			key = &BranchKeys.CreateDraftPr
 		case "delete":
 			key = &BranchKeys.Delete
 		case "push":
@@ -113,17 +175,41 @@ func rebindBranchKeys(keys []config.Keybinding) error {
 			key = &BranchKeys.ForcePush
 		case "fastForward":
 			key = &BranchKeys.FastForward
+		case "rebase":
+			key = &BranchKeys.Rebase
+		case "resetToUpstream":
+			key = &BranchKeys.ResetUpstream
 		case "checkout":
 			key = &BranchKeys.Checkout
+		case "viewPr":
+			key = &BranchKeys.ViewPr
 		case "viewPRs":
 			key = &BranchKeys.ViewPRs
 		case "updatePr":
 			key = &BranchKeys.UpdatePr
+		case "toggleStashes":
+			key = &BranchKeys.ToggleStashes
+		case "stash":
+			key = &BranchKeys.Stash
+		case "applyStash":
+			key = &BranchKeys.ApplyStash
+		case "popStash":
+			key = &BranchKeys.PopStash
+		case "addWorktree":
+			key = &BranchKeys.AddWorktree
+		case "openWorktree":
+			key = &BranchKeys.OpenWorktree
 		default:
+			if universal := universalBinding(branchKey.Builtin); universal != nil {
+				addViewOverride(config.RepoView, universal, branchKey)
+				continue
+			}
 			return fmt.Errorf("unknown built-in branch key: '%s'", branchKey.Builtin)
 		}
 
 		key.SetKeys(branchKey.Key)
+		configuredBuiltins = append(configuredBuiltins,
+			configuredBuiltin{view: config.RepoView, name: branchKey.Builtin, binding: key})
 
 		helpDesc := key.Help().Desc
 		if branchKey.Name != "" {
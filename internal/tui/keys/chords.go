@@ -0,0 +1,78 @@
+package keys
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// ChordSeparator separates the keys of a chord in a binding, e.g. "g p"
+const ChordSeparator = " "
+
+// ChordHint is a key that completes a pending chord, or continues it
+type ChordHint struct {
+	Key  string
+	Desc string
+}
+
+// ViewBindings returns every binding that's active in the view
+func ViewBindings(viewType config.ViewType) []key.Binding {
+	k := *Keys
+	k.viewType = viewType
+
+	var bindings []key.Binding
+	for _, group := range k.FullHelp() {
+		bindings = append(bindings, group...)
+	}
+	return bindings
+}
+
+// ChordHints returns the keys that can follow the pending keys of a chord in
+// bindings. The second result reports whether the pending keys are a complete
+// binding on their own.
+func ChordHints(pending []string, bindings []key.Binding) (hints []ChordHint, complete bool) {
+	prefix := strings.Join(pending, ChordSeparator)
+	seen := map[string]bool{}
+
+	for _, binding := range bindings {
+		if !binding.Enabled() {
+			continue
+		}
+		for _, k := range binding.Keys() {
+			if k == prefix {
+				complete = true
+				continue
+			}
+
+			rest, ok := strings.CutPrefix(k, prefix+ChordSeparator)
+			if !ok || rest == "" {
+				continue
+			}
+
+			next, _, _ := strings.Cut(rest, ChordSeparator)
+			desc := binding.Help().Desc
+			if next != rest {
+				desc = "+more"
+			}
+			if seen[next] {
+				continue
+			}
+			seen[next] = true
+			hints = append(hints, ChordHint{Key: next, Desc: desc})
+		}
+	}
+
+	return hints, complete
+}
+
+// ChordMsg turns the keys of a completed chord into a key message that
+// matches the chord's binding
+func ChordMsg(keys []string) tea.KeyMsg {
+	return tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune(strings.Join(keys, ChordSeparator)),
+	}
+}
@@ -0,0 +1,68 @@
+package keys
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+func TestChordHints(t *testing.T) {
+	bindings := []key.Binding{
+		key.NewBinding(key.WithKeys("g", "home"), key.WithHelp("g", "first item")),
+		key.NewBinding(key.WithKeys("g p"), key.WithHelp("g p", "go to PRs")),
+		key.NewBinding(key.WithKeys("g i"), key.WithHelp("g i", "go to issues")),
+		key.NewBinding(key.WithKeys("space d x"), key.WithHelp("space d x", "delete")),
+		key.NewBinding(key.WithKeys("space d y"), key.WithHelp("space d y", "copy")),
+		key.NewBinding(key.WithKeys("g r"), key.WithHelp("g r", "go to repo"), key.WithDisabled()),
+	}
+
+	tests := []struct {
+		name         string
+		pending      []string
+		wantHints    []ChordHint
+		wantComplete bool
+	}{
+		{
+			name:    "first key of chords that is also bound on its own",
+			pending: []string{"g"},
+			wantHints: []ChordHint{
+				{Key: "p", Desc: "go to PRs"},
+				{Key: "i", Desc: "go to issues"},
+			},
+			wantComplete: true,
+		},
+		{
+			name:      "nested chords",
+			pending:   []string{"space"},
+			wantHints: []ChordHint{{Key: "d", Desc: "+more"}},
+		},
+		{
+			name:    "second key of nested chords",
+			pending: []string{"space", "d"},
+			wantHints: []ChordHint{
+				{Key: "x", Desc: "delete"},
+				{Key: "y", Desc: "copy"},
+			},
+		},
+		{
+			name:         "completed chord",
+			pending:      []string{"g", "p"},
+			wantComplete: true,
+		},
+		{
+			name:    "not a chord",
+			pending: []string{"j"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hints, complete := ChordHints(tt.pending, bindings)
+			if !reflect.DeepEqual(hints, tt.wantHints) || complete != tt.wantComplete {
+				t.Errorf("ChordHints(%q) = %v, %v, want %v, %v",
+					tt.pending, hints, complete, tt.wantHints, tt.wantComplete)
+			}
+		})
+	}
+}
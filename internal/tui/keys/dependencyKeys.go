@@ -0,0 +1,22 @@
+package keys
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type DependencyKeyMap struct {
+	ViewPRs key.Binding
+}
+
+var DependencyKeys = DependencyKeyMap{
+	ViewPRs: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch view"),
+	),
+}
+
+func DependencyFullHelp() []key.Binding {
+	return []key.Binding{
+		DependencyKeys.ViewPRs,
+	}
+}
@@ -0,0 +1,34 @@
+package keys
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type DiscussionKeyMap struct {
+	Comment    key.Binding
+	MarkAnswer key.Binding
+	ViewPRs    key.Binding
+}
+
+var DiscussionKeys = DiscussionKeyMap{
+	Comment: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "comment"),
+	),
+	MarkAnswer: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "mark answer"),
+	),
+	ViewPRs: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch view"),
+	),
+}
+
+func DiscussionFullHelp() []key.Binding {
+	return []key.Binding{
+		DiscussionKeys.Comment,
+		DiscussionKeys.MarkAnswer,
+		DiscussionKeys.ViewPRs,
+	}
+}
@@ -0,0 +1,22 @@
+package keys
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type FeedKeyMap struct {
+	ViewPRs key.Binding
+}
+
+var FeedKeys = FeedKeyMap{
+	ViewPRs: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch view"),
+	),
+}
+
+func FeedFullHelp() []key.Binding {
+	return []key.Binding{
+		FeedKeys.ViewPRs,
+	}
+}
@@ -11,15 +11,35 @@ import (
 
 type IssueKeyMap struct {
 	Label                key.Binding
+	Milestone            key.Binding
+	Estimate             key.Binding
 	Assign               key.Binding
+	AutoAssign           key.Binding
 	Unassign             key.Binding
 	Comment              key.Binding
+	LoadOlderComments    key.Binding
+	ToggleBotComments    key.Binding
 	Close                key.Binding
 	Reopen               key.Binding
 	ToggleSmartFiltering key.Binding
 	ToggleRepoFilter     key.Binding
 	ToggleAuthorFilter   key.Binding
+	SliceToday           key.Binding
+	SliceWeek            key.Binding
+	SliceMonth           key.Binding
+	SliceAllTime         key.Binding
+	ToggleCurrentSprint  key.Binding
+	PickSavedFilters     key.Binding
+	Snooze               key.Binding
+	ToggleSnoozed        key.Binding
+	CycleSort            key.Binding
+	ReverseSort          key.Binding
+	ToggleGroup          key.Binding
+	ToggleAllGroups      key.Binding
 	OpenRepoPicker       key.Binding
+	ToggleSelection      key.Binding
+	SelectRange          key.Binding
+	New                  key.Binding
 	ViewPRs              key.Binding
 }
 
@@ -28,14 +48,34 @@ var IssueKeys = IssueKeyMap{
 		key.WithKeys("L"),
 		key.WithHelp("L", "label"),
 	),
+	Milestone: key.NewBinding(
+		key.WithKeys("%"),
+		key.WithHelp("%", "milestone"),
+	),
+	Estimate: key.NewBinding(
+		key.WithKeys("E"),
+		key.WithHelp("E", "estimate"),
+	),
 	Unassign: key.NewBinding(
 		key.WithKeys("A"),
 		key.WithHelp("A", "unassign"),
 	),
+	AutoAssign: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "auto-assign least loaded"),
+	),
 	Comment: key.NewBinding(
 		key.WithKeys("c"),
 		key.WithHelp("c", "comment"),
 	),
+	LoadOlderComments: key.NewBinding(
+		key.WithKeys("O"),
+		key.WithHelp("O", "load older comments"),
+	),
+	ToggleBotComments: key.NewBinding(
+		key.WithKeys("B"),
+		key.WithHelp("B", "toggle bot comments"),
+	),
 	Close: key.NewBinding(
 		key.WithKeys("x"),
 		key.WithHelp("x", "close"),
@@ -50,16 +90,76 @@ var IssueKeys = IssueKeyMap{
 	),
 	ToggleRepoFilter: key.NewBinding(
 		key.WithKeys("T"),
-		key.WithHelp("T", "cycle repo filter (origin/upstream/all)"),
+		key.WithHelp("T", "cycle repo filter (remotes/all)"),
 	),
 	ToggleAuthorFilter: key.NewBinding(
 		key.WithKeys("F"),
 		key.WithHelp("F", "toggle author filter"),
 	),
+	SliceToday: key.NewBinding(
+		key.WithKeys("z t"),
+		key.WithHelp("z t", "updated today"),
+	),
+	SliceWeek: key.NewBinding(
+		key.WithKeys("z w"),
+		key.WithHelp("z w", "updated this week"),
+	),
+	SliceMonth: key.NewBinding(
+		key.WithKeys("z m"),
+		key.WithHelp("z m", "updated this month"),
+	),
+	SliceAllTime: key.NewBinding(
+		key.WithKeys("z a"),
+		key.WithHelp("z a", "updated any time"),
+	),
+	ToggleCurrentSprint: key.NewBinding(
+		key.WithKeys("z s"),
+		key.WithHelp("z s", "toggle current sprint"),
+	),
+	PickSavedFilters: key.NewBinding(
+		key.WithKeys("z p"),
+		key.WithHelp("z p", "pick saved filters"),
+	),
+	Snooze: key.NewBinding(
+		key.WithKeys("z z"),
+		key.WithHelp("z z", "snooze/wake up"),
+	),
+	ToggleSnoozed: key.NewBinding(
+		key.WithKeys("z Z"),
+		key.WithHelp("z Z", "show/hide snoozed"),
+	),
+	CycleSort: key.NewBinding(
+		key.WithKeys("z o"),
+		key.WithHelp("z o", "cycle sort order"),
+	),
+	ReverseSort: key.NewBinding(
+		key.WithKeys("z O"),
+		key.WithHelp("z O", "reverse sort order"),
+	),
+	ToggleGroup: key.NewBinding(
+		key.WithKeys("z f"),
+		key.WithHelp("z f", "fold/unfold group"),
+	),
+	ToggleAllGroups: key.NewBinding(
+		key.WithKeys("z F"),
+		key.WithHelp("z F", "fold/unfold all groups"),
+	),
 	OpenRepoPicker: key.NewBinding(
 		key.WithKeys("R"),
 		key.WithHelp("R", "select repo filter"),
 	),
+	ToggleSelection: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "select"),
+	),
+	SelectRange: key.NewBinding(
+		key.WithKeys("V"),
+		key.WithHelp("V", "select range"),
+	),
+	New: key.NewBinding(
+		key.WithKeys("+"),
+		key.WithHelp("+", "new issue"),
+	),
 	ViewPRs: key.NewBinding(
 		key.WithKeys("s"),
 		key.WithHelp("s", "switch to PRs"),
@@ -69,15 +169,35 @@ var IssueKeys = IssueKeyMap{
 func IssueFullHelp() []key.Binding {
 	return []key.Binding{
 		IssueKeys.Label,
+		IssueKeys.Milestone,
+		IssueKeys.Estimate,
 		IssueKeys.Assign,
+		IssueKeys.AutoAssign,
 		IssueKeys.Unassign,
 		IssueKeys.Comment,
+		IssueKeys.LoadOlderComments,
+		IssueKeys.ToggleBotComments,
 		IssueKeys.Close,
 		IssueKeys.Reopen,
 		IssueKeys.ToggleSmartFiltering,
 		IssueKeys.ToggleRepoFilter,
 		IssueKeys.ToggleAuthorFilter,
+		IssueKeys.SliceToday,
+		IssueKeys.SliceWeek,
+		IssueKeys.SliceMonth,
+		IssueKeys.SliceAllTime,
+		IssueKeys.ToggleCurrentSprint,
+		IssueKeys.PickSavedFilters,
+		IssueKeys.Snooze,
+		IssueKeys.ToggleSnoozed,
+		IssueKeys.CycleSort,
+		IssueKeys.ReverseSort,
+		IssueKeys.ToggleGroup,
+		IssueKeys.ToggleAllGroups,
 		IssueKeys.OpenRepoPicker,
+		IssueKeys.ToggleSelection,
+		IssueKeys.SelectRange,
+		IssueKeys.New,
 		IssueKeys.ViewPRs,
 	}
 }
@@ -111,29 +231,77 @@ func rebindIssueKeys(keys []config.Keybinding) error {
 		switch issueKey.Builtin {
 		case "label":
 			key = &IssueKeys.Label
+		case "milestone":
+			key = &IssueKeys.Milestone
+		case "estimate":
+			key = &IssueKeys.Estimate
 		case "assign":
 			key = &IssueKeys.Assign
+		case "autoAssign":
+			key = &IssueKeys.AutoAssign
 		case "unassign":
 			key = &IssueKeys.Unassign
 		case "comment":
 			key = &IssueKeys.Comment
+		case "loadOlderComments":
+			key = &IssueKeys.LoadOlderComments
+		case "toggleBotComments":
+			key = &IssueKeys.ToggleBotComments
 		case "close":
 			key = &IssueKeys.Close
 		case "reopen":
 			key = &IssueKeys.Reopen
+		case "new":
+			key = &IssueKeys.New
 		case "viewPrs":
 			key = &IssueKeys.ViewPRs
+		case "toggleSelection":
+			key = &IssueKeys.ToggleSelection
+		case "selectRange":
+			key = &IssueKeys.SelectRange
+		case "toggleSmartFiltering":
+			key = &IssueKeys.ToggleSmartFiltering
 		case "toggleRepoFilter":
 			key = &IssueKeys.ToggleRepoFilter
 		case "toggleAuthorFilter":
 			key = &IssueKeys.ToggleAuthorFilter
+		case "sliceToday":
+			key = &IssueKeys.SliceToday
+		case "sliceWeek":
+			key = &IssueKeys.SliceWeek
+		case "sliceMonth":
+			key = &IssueKeys.SliceMonth
+		case "sliceAllTime":
+			key = &IssueKeys.SliceAllTime
+		case "toggleCurrentSprint":
+			key = &IssueKeys.ToggleCurrentSprint
+		case "pickSavedFilters":
+			key = &IssueKeys.PickSavedFilters
+		case "snooze":
+			key = &IssueKeys.Snooze
+		case "toggleSnoozed":
+			key = &IssueKeys.ToggleSnoozed
+		case "cycleSort":
+			key = &IssueKeys.CycleSort
+		case "reverseSort":
+			key = &IssueKeys.ReverseSort
+		case "toggleGroup":
+			key = &IssueKeys.ToggleGroup
+		case "toggleAllGroups":
+			key = &IssueKeys.ToggleAllGroups
 		case "openRepoPicker":
 			key = &IssueKeys.OpenRepoPicker
 		default:
+			if universal := universalBinding(issueKey.Builtin); universal != nil {
+				addViewOverride(config.IssuesView, universal, issueKey)
+				continue
+			}
 			return fmt.Errorf("unknown built-in issue key: '%s'", issueKey.Builtin)
 		}
 
 		key.SetKeys(issueKey.Key)
+		configuredBuiltins = append(configuredBuiltins,
+			configuredBuiltin{view: config.IssuesView, name: issueKey.Builtin, binding: key})
 
 		helpDesc := key.Help().Desc
 		if issueKey.Name != "" {
@@ -11,30 +11,54 @@ import (
 )
 
 type KeyMap struct {
-	viewType      config.ViewType
-	Up            key.Binding
-	Down          key.Binding
-	FirstLine     key.Binding
-	LastLine      key.Binding
-	TogglePreview key.Binding
-	OpenGithub    key.Binding
-	Refresh       key.Binding
-	RefreshAll    key.Binding
-	Redraw        key.Binding
-	PageDown      key.Binding
-	PageUp        key.Binding
-	NextSection   key.Binding
-	PrevSection   key.Binding
-	Search        key.Binding
-	CopyUrl       key.Binding
-	CopyNumber    key.Binding
-	Help          key.Binding
-	Quit          key.Binding
+	viewType         config.ViewType
+	Up               key.Binding
+	Down             key.Binding
+	FirstLine        key.Binding
+	LastLine         key.Binding
+	TogglePreview    key.Binding
+	WidenPreview     key.Binding
+	NarrowPreview    key.Binding
+	OpenGithub       key.Binding
+	Refresh          key.Binding
+	RefreshAll       key.Binding
+	Redraw           key.Binding
+	PageDown         key.Binding
+	PageUp           key.Binding
+	NextSection      key.Binding
+	PrevSection      key.Binding
+	Search           key.Binding
+	CopyUrl          key.Binding
+	CopyNumber       key.Binding
+	RepeatLast       key.Binding
+	History          key.Binding
+	Palette          key.Binding
+	GoToPRs          key.Binding
+	GoToIssues       key.Binding
+	GoToActions      key.Binding
+	GoToFeeds        key.Binding
+	GoToDiscussions  key.Binding
+	GoToReleases     key.Binding
+	GoToDependencies key.Binding
+	GoToArchive      key.Binding
+	GoToRepo         key.Binding
+	ToggleRead       key.Binding
+	NextUnread       key.Binding
+	ViewFile         key.Binding
+	CompareSections  key.Binding
+	ExportSection    key.Binding
+	EditSections     key.Binding
+	PickTheme        key.Binding
+	DebugFilters     key.Binding
+	ReportBug        key.Binding
+	ReleaseNotes     key.Binding
+	SwitchPane       key.Binding
+	Help             key.Binding
+	Quit             key.Binding
 }
 
-func CreateKeyMapForView(viewType config.ViewType) help.KeyMap {
-	Keys.viewType = viewType
-	return Keys
+func CreateKeyMapForView(keyMap *KeyMap, viewType config.ViewType) help.KeyMap {
+	return UseView(keyMap, viewType)
 }
 
 func (k KeyMap) ShortHelp() []key.Binding {
@@ -56,6 +80,19 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 	case config.RepoView:
 		additionalKeys = BranchFullHelp()
 		customKeys = append(customKeys, CustomBranchBindings...)
+	case config.WorkflowsView:
+		additionalKeys = WorkflowFullHelp()
+		customKeys = append(customKeys, CustomWorkflowBindings...)
+	case config.FeedsView:
+		additionalKeys = FeedFullHelp()
+	case config.DiscussionsView:
+		additionalKeys = DiscussionFullHelp()
+	case config.ReleasesView:
+		additionalKeys = ReleaseFullHelp()
+	case config.DependenciesView:
+		additionalKeys = DependencyFullHelp()
+	case config.ArchiveView:
+		additionalKeys = ArchiveFullHelp()
 	default:
 		additionalKeys = IssueFullHelp()
 		customKeys = append(customKeys, CustomIssueBindings...)
@@ -82,6 +119,8 @@ func (k KeyMap) NavigationKeys() []key.Binding {
 		k.Down,
 		k.PrevSection,
 		k.NextSection,
+		k.CompareSections,
+		k.SwitchPane,
 		k.FirstLine,
 		k.LastLine,
 		k.PageDown,
@@ -94,10 +133,33 @@ func (k KeyMap) AppKeys() []key.Binding {
 		k.Refresh,
 		k.RefreshAll,
 		k.TogglePreview,
+		k.WidenPreview,
+		k.NarrowPreview,
 		k.OpenGithub,
 		k.CopyNumber,
 		k.CopyUrl,
 		k.Search,
+		k.RepeatLast,
+		k.History,
+		k.Palette,
+		k.GoToPRs,
+		k.GoToIssues,
+		k.GoToActions,
+		k.GoToFeeds,
+		k.GoToDiscussions,
+		k.GoToReleases,
+		k.GoToDependencies,
+		k.GoToArchive,
+		k.GoToRepo,
+		k.ToggleRead,
+		k.NextUnread,
+		k.ViewFile,
+		k.ExportSection,
+		k.EditSections,
+		k.PickTheme,
+		k.DebugFilters,
+		k.ReportBug,
+		k.ReleaseNotes,
 	}
 }
 
@@ -126,6 +188,14 @@ var Keys = &KeyMap{
 		key.WithKeys("p"),
 		key.WithHelp("p", "open in Preview"),
 	),
+	WidenPreview: key.NewBinding(
+		key.WithKeys("<"),
+		key.WithHelp("<", "widen preview"),
+	),
+	NarrowPreview: key.NewBinding(
+		key.WithKeys(">"),
+		key.WithHelp(">", "narrow preview"),
+	),
 	OpenGithub: key.NewBinding(
 		key.WithKeys("o"),
 		key.WithHelp("o", "open in GitHub"),
@@ -166,6 +236,98 @@ var Keys = &KeyMap{
 		key.WithKeys("Y"),
 		key.WithHelp("Y", "copy url"),
 	),
+	RepeatLast: key.NewBinding(
+		key.WithKeys("."),
+		key.WithHelp(".", "repeat last action"),
+	),
+	History: key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("Ctrl+r", "recent actions"),
+	),
+	Palette: key.NewBinding(
+		key.WithKeys("ctrl+p"),
+		key.WithHelp("Ctrl+p", "command palette"),
+	),
+	GoToPRs: key.NewBinding(
+		key.WithKeys("g p"),
+		key.WithHelp("g p", "go to PRs"),
+	),
+	GoToIssues: key.NewBinding(
+		key.WithKeys("g i"),
+		key.WithHelp("g i", "go to issues"),
+	),
+	GoToActions: key.NewBinding(
+		key.WithKeys("g a"),
+		key.WithHelp("g a", "go to actions"),
+	),
+	GoToFeeds: key.NewBinding(
+		key.WithKeys("g f"),
+		key.WithHelp("g f", "go to feeds"),
+	),
+	GoToDiscussions: key.NewBinding(
+		key.WithKeys("g d"),
+		key.WithHelp("g d", "go to discussions"),
+	),
+	GoToReleases: key.NewBinding(
+		key.WithKeys("g t"),
+		key.WithHelp("g t", "go to release trains"),
+	),
+	GoToDependencies: key.NewBinding(
+		key.WithKeys("g m"),
+		key.WithHelp("g m", "go to dependency matrix"),
+	),
+	GoToArchive: key.NewBinding(
+		key.WithKeys("g x"),
+		key.WithHelp("g x", "go to archive"),
+	),
+	GoToRepo: key.NewBinding(
+		key.WithKeys("g r"),
+		key.WithHelp("g r", "go to repo"),
+	),
+	ToggleRead: key.NewBinding(
+		key.WithKeys("U"),
+		key.WithHelp("U", "toggle read"),
+	),
+	NextUnread: key.NewBinding(
+		key.WithKeys("g u"),
+		key.WithHelp("g u", "next unread"),
+	),
+	ViewFile: key.NewBinding(
+		key.WithKeys("g v"),
+		key.WithHelp("g v", "view repo file"),
+	),
+	CompareSections: key.NewBinding(
+		key.WithKeys("g c"),
+		key.WithHelp("g c", "compare sections"),
+	),
+	ExportSection: key.NewBinding(
+		key.WithKeys("g e"),
+		key.WithHelp("g e", "export section"),
+	),
+	EditSections: key.NewBinding(
+		key.WithKeys("g s"),
+		key.WithHelp("g s", "edit sections"),
+	),
+	PickTheme: key.NewBinding(
+		key.WithKeys("g T"),
+		key.WithHelp("g T", "pick theme"),
+	),
+	DebugFilters: key.NewBinding(
+		key.WithKeys("g F"),
+		key.WithHelp("g F", "debug section filters"),
+	),
+	ReportBug: key.NewBinding(
+		key.WithKeys("g B"),
+		key.WithHelp("g B", "report gh-dash bug"),
+	),
+	ReleaseNotes: key.NewBinding(
+		key.WithKeys("g U"),
+		key.WithHelp("g U", "show release notes"),
+	),
+	SwitchPane: key.NewBinding(
+		key.WithKeys("tab"),
+		key.WithHelp("tab", "switch pane"),
+	),
 	Help: key.NewBinding(
 		key.WithKeys("?"),
 		key.WithHelp("?", "help"),
@@ -176,8 +338,194 @@ var Keys = &KeyMap{
 	),
 }
 
+// RepeatableActions returns the bindings of the given view that act on the
+// selected row and can be replayed with RepeatLast
+func RepeatableActions(viewType config.ViewType) []key.Binding {
+	switch viewType {
+	case config.PRsView:
+		return append([]key.Binding{
+			PRKeys.Approve,
+			PRKeys.Review,
+			PRKeys.Assign,
+			PRKeys.Unassign,
+			PRKeys.Comment,
+			PRKeys.Diff,
+			PRKeys.Checkout,
+			PRKeys.Close,
+			PRKeys.Ready,
+			PRKeys.Reopen,
+			PRKeys.Merge,
+			PRKeys.MergeQueue,
+			PRKeys.Update,
+			PRKeys.WatchChecks,
+		}, CustomPRBindings...)
+	case config.RepoView:
+		return append([]key.Binding{
+			BranchKeys.Checkout,
+			BranchKeys.FastForward,
+			BranchKeys.Rebase,
+			BranchKeys.ResetUpstream,
+			BranchKeys.Push,
+			BranchKeys.ForcePush,
+			BranchKeys.CreatePr,
+			BranchKeys.CreateDraftPr,
+			BranchKeys.Delete,
+			BranchKeys.UpdatePr,
+		}, CustomBranchBindings...)
+	case config.WorkflowsView:
+		return append([]key.Binding{
+			WorkflowKeys.Rerun,
+			WorkflowKeys.RerunFailed,
+			WorkflowKeys.Cancel,
+			WorkflowKeys.Logs,
+		}, CustomWorkflowBindings...)
+	case config.FeedsView, config.ReleasesView, config.DependenciesView, config.ArchiveView:
+		return nil
+	case config.DiscussionsView:
+		return []key.Binding{
+			DiscussionKeys.Comment,
+			DiscussionKeys.MarkAnswer,
+		}
+	default:
+		return append([]key.Binding{
+			IssueKeys.Label,
+			IssueKeys.Milestone,
+			IssueKeys.Estimate,
+			IssueKeys.Assign,
+			IssueKeys.Unassign,
+			IssueKeys.Comment,
+			IssueKeys.Close,
+			IssueKeys.Reopen,
+		}, CustomIssueBindings...)
+	}
+}
+
+// ReadOnlyKeys returns the bindings of the given view that only browse the
+// dashboard, the ones allowed when it's shared read-only
+func ReadOnlyKeys(viewType config.ViewType) []key.Binding {
+	bindings := []key.Binding{
+		Keys.Up,
+		Keys.Down,
+		Keys.FirstLine,
+		Keys.LastLine,
+		Keys.PageDown,
+		Keys.PageUp,
+		Keys.PrevSection,
+		Keys.NextSection,
+		Keys.CompareSections,
+		Keys.PickTheme,
+		Keys.DebugFilters,
+		Keys.ReleaseNotes,
+		Keys.SwitchPane,
+		Keys.TogglePreview,
+		Keys.WidenPreview,
+		Keys.NarrowPreview,
+		Keys.Refresh,
+		Keys.RefreshAll,
+		Keys.Redraw,
+		Keys.Search,
+		Keys.Palette,
+		Keys.GoToPRs,
+		Keys.GoToIssues,
+		Keys.GoToActions,
+		Keys.GoToFeeds,
+		Keys.GoToDiscussions,
+		Keys.GoToReleases,
+		Keys.GoToDependencies,
+		Keys.GoToArchive,
+		Keys.ViewFile,
+		Keys.Help,
+		Keys.Quit,
+	}
+
+	switch viewType {
+	case config.PRsView:
+		return append(bindings,
+			PRKeys.PrevSidebarTab,
+			PRKeys.NextSidebarTab,
+			PRKeys.NextDiffFile,
+			PRKeys.PrevDiffFile,
+			PRKeys.NextDiffHunk,
+			PRKeys.PrevDiffHunk,
+			PRKeys.NextCheck,
+			PRKeys.PrevCheck,
+			PRKeys.TailCheckLog,
+			PRKeys.ToggleCheckJobs,
+			PRKeys.ShowHiddenChecks,
+			PRKeys.SummaryViewMore,
+			PRKeys.LoadOlderComments,
+			PRKeys.ToggleBotComments,
+			PRKeys.ToggleTimelineEvents,
+			PRKeys.CollapseActivity,
+			PRKeys.JumpToLatest,
+			PRKeys.ToggleSmartFiltering,
+			PRKeys.ToggleRepoFilter,
+			PRKeys.ToggleAuthorFilter,
+			PRKeys.SliceToday,
+			PRKeys.SliceWeek,
+			PRKeys.SliceMonth,
+			PRKeys.SliceAllTime,
+			PRKeys.ToggleCurrentSprint,
+			PRKeys.CycleSort,
+			PRKeys.ReverseSort,
+			PRKeys.ToggleGroup,
+			PRKeys.ToggleAllGroups,
+			PRKeys.OpenRepoPicker,
+			PRKeys.ViewIssues,
+		)
+	case config.IssuesView:
+		return append(bindings,
+			IssueKeys.LoadOlderComments,
+			IssueKeys.ToggleBotComments,
+			IssueKeys.ToggleSmartFiltering,
+			IssueKeys.ToggleRepoFilter,
+			IssueKeys.ToggleAuthorFilter,
+			IssueKeys.SliceToday,
+			IssueKeys.SliceWeek,
+			IssueKeys.SliceMonth,
+			IssueKeys.SliceAllTime,
+			IssueKeys.ToggleCurrentSprint,
+			IssueKeys.CycleSort,
+			IssueKeys.ReverseSort,
+			IssueKeys.ToggleGroup,
+			IssueKeys.ToggleAllGroups,
+			IssueKeys.OpenRepoPicker,
+			IssueKeys.ViewPRs,
+		)
+	case config.WorkflowsView:
+		return append(bindings, WorkflowKeys.ViewPRs)
+	case config.FeedsView:
+		return append(bindings, FeedKeys.ViewPRs)
+	case config.DiscussionsView:
+		return append(bindings, DiscussionKeys.ViewPRs)
+	case config.ReleasesView:
+		return append(bindings, ReleaseKeys.ViewPRs)
+	case config.DependenciesView:
+		return append(bindings, DependencyKeys.ViewPRs)
+	case config.ArchiveView:
+		return append(bindings, ArchiveKeys.ViewPRs)
+	default:
+		return bindings
+	}
+}
+
+// BrowsingKeys are the keys that work in the GitLab and Gitea sections of
+// viewType, which can be browsed but not acted on
+func BrowsingKeys(viewType config.ViewType) []key.Binding {
+	return append(ReadOnlyKeys(viewType),
+		Keys.OpenGithub,
+		Keys.CopyUrl,
+		Keys.CopyNumber,
+		Keys.ToggleRead,
+		Keys.NextUnread,
+	)
+}
+
 // Rebind will update our saved keybindings from configuration values.
-func Rebind(universal, issueKeys, prKeys, branchKeys []config.Keybinding) error {
+func Rebind(universal, issueKeys, prKeys, branchKeys, workflowKeys []config.Keybinding) error {
+	resetViewOverrides()
+	configuredBuiltins = nil
+
 	err := rebindUniversal(universal)
 	if err != nil {
 		return err
@@ -193,6 +541,11 @@ func Rebind(universal, issueKeys, prKeys, branchKeys []config.Keybinding) error
 		return err
 	}
 
+	err = rebindWorkflowKeys(workflowKeys)
+	if err != nil {
+		return err
+	}
+
 	return rebindIssueKeys(issueKeys)
 }
 
@@ -202,6 +555,7 @@ var (
 	CustomPRBindings        []key.Binding
 	CustomIssueBindings     []key.Binding
 	CustomBranchBindings    []key.Binding
+	CustomWorkflowBindings  []key.Binding
 )
 
 func rebindUniversal(universal []config.Keybinding) error {
@@ -230,50 +584,14 @@ func rebindUniversal(universal []config.Keybinding) error {
 
 		log.Debug("Rebinding universal key", "builtin", kb.Builtin, "key", kb.Key)
 
-		var key *key.Binding
-
-		switch kb.Builtin {
-		case "up":
-			key = &Keys.Up
-		case "down":
-			key = &Keys.Down
-		case "firstLine":
-			key = &Keys.FirstLine
-		case "lastLine":
-			key = &Keys.LastLine
-		case "togglePreview":
-			key = &Keys.TogglePreview
-		case "openGithub":
-			key = &Keys.OpenGithub
-		case "refresh":
-			key = &Keys.Refresh
-		case "refreshAll":
-			key = &Keys.RefreshAll
-		case "redraw":
-			key = &Keys.Redraw
-		case "pageDown":
-			key = &Keys.PageDown
-		case "pageUp":
-			key = &Keys.PageUp
-		case "nextSection":
-			key = &Keys.NextSection
-		case "prevSection":
-			key = &Keys.PrevSection
-		case "search":
-			key = &Keys.Search
-		case "copyurl":
-			key = &Keys.CopyUrl
-		case "copyNumber":
-			key = &Keys.CopyNumber
-		case "help":
-			key = &Keys.Help
-		case "quit":
-			key = &Keys.Quit
-		default:
+		key := universalBinding(kb.Builtin)
+		if key == nil {
 			return fmt.Errorf("unknown built-in universal key: '%s'", kb.Builtin)
 		}
 
 		key.SetKeys(kb.Key)
+		configuredBuiltins = append(configuredBuiltins,
+			configuredBuiltin{name: kb.Builtin, binding: key})
 
 		helpDesc := key.Help().Desc
 		if kb.Name != "" {
@@ -286,3 +604,98 @@ func rebindUniversal(universal []config.Keybinding) error {
 
 	return nil
 }
+
+// universalBinding returns the universal binding of the builtin named name,
+// nil if there's none
+func universalBinding(name string) *key.Binding {
+	switch name {
+	case "up":
+		return &Keys.Up
+	case "down":
+		return &Keys.Down
+	case "firstLine":
+		return &Keys.FirstLine
+	case "lastLine":
+		return &Keys.LastLine
+	case "togglePreview":
+		return &Keys.TogglePreview
+	case "widenPreview":
+		return &Keys.WidenPreview
+	case "narrowPreview":
+		return &Keys.NarrowPreview
+	case "openGithub":
+		return &Keys.OpenGithub
+	case "refresh":
+		return &Keys.Refresh
+	case "refreshAll":
+		return &Keys.RefreshAll
+	case "redraw":
+		return &Keys.Redraw
+	case "pageDown":
+		return &Keys.PageDown
+	case "pageUp":
+		return &Keys.PageUp
+	case "nextSection":
+		return &Keys.NextSection
+	case "prevSection":
+		return &Keys.PrevSection
+	case "search":
+		return &Keys.Search
+	case "copyurl":
+		return &Keys.CopyUrl
+	case "copyNumber":
+		return &Keys.CopyNumber
+	case "repeatLast":
+		return &Keys.RepeatLast
+	case "history":
+		return &Keys.History
+	case "commandPalette":
+		return &Keys.Palette
+	case "goToPrs":
+		return &Keys.GoToPRs
+	case "goToIssues":
+		return &Keys.GoToIssues
+	case "goToActions":
+		return &Keys.GoToActions
+	case "goToFeeds":
+		return &Keys.GoToFeeds
+	case "goToDiscussions":
+		return &Keys.GoToDiscussions
+	case "goToReleases":
+		return &Keys.GoToReleases
+	case "goToDependencies":
+		return &Keys.GoToDependencies
+	case "goToArchive":
+		return &Keys.GoToArchive
+	case "goToRepo":
+		return &Keys.GoToRepo
+	case "toggleRead":
+		return &Keys.ToggleRead
+	case "nextUnread":
+		return &Keys.NextUnread
+	case "viewFile":
+		return &Keys.ViewFile
+	case "compareSections":
+		return &Keys.CompareSections
+	case "exportSection":
+		return &Keys.ExportSection
+	case "editSections":
+		return &Keys.EditSections
+	case "pickTheme":
+		return &Keys.PickTheme
+	case "debugFilters":
+		return &Keys.DebugFilters
+	case "reportBug":
+		return &Keys.ReportBug
+	case "releaseNotes":
+		return &Keys.ReleaseNotes
+	case "switchPane":
+		return &Keys.SwitchPane
+	case "help":
+		return &Keys.Help
+	case "quit":
+		return &Keys.Quit
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,167 @@
+package keys
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+func TestReadOnlyKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		view    config.ViewType
+		msg     tea.KeyMsg
+		allowed bool
+	}{
+		{
+			name:    "moving down",
+			view:    config.PRsView,
+			msg:     tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")},
+			allowed: true,
+		},
+		{
+			name:    "searching",
+			view:    config.IssuesView,
+			msg:     tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")},
+			allowed: true,
+		},
+		{
+			name:    "switching sidebar tabs",
+			view:    config.PRsView,
+			msg:     tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("]")},
+			allowed: true,
+		},
+		{
+			name:    "going to PRs",
+			view:    config.IssuesView,
+			msg:     ChordMsg([]string{"g", "p"}),
+			allowed: true,
+		},
+		{
+			name:    "going to the repo",
+			view:    config.PRsView,
+			msg:     ChordMsg([]string{"g", "r"}),
+			allowed: false,
+		},
+		{
+			name:    "merging a PR",
+			view:    config.PRsView,
+			msg:     tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")},
+			allowed: false,
+		},
+		{
+			name:    "commenting on an issue",
+			view:    config.IssuesView,
+			msg:     tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")},
+			allowed: false,
+		},
+		{
+			name:    "opening in the browser",
+			view:    config.PRsView,
+			msg:     tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")},
+			allowed: false,
+		},
+		{
+			name:    "reporting a bug",
+			view:    config.IssuesView,
+			msg:     ChordMsg([]string{"g", "B"}),
+			allowed: false,
+		},
+		{
+			name:    "cancelling a run",
+			view:    config.WorkflowsView,
+			msg:     tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")},
+			allowed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := key.Matches(tt.msg, ReadOnlyKeys(tt.view)...)
+			if got != tt.allowed {
+				t.Errorf("key %q allowed = %t, want %t", tt.msg.String(), got, tt.allowed)
+			}
+		})
+	}
+}
+
+// restoreKeys restores the keymaps once the test rebinding them is done
+func restoreKeys(t *testing.T) {
+	t.Helper()
+	universal, prKeys, issueKeys := *Keys, PRKeys, IssueKeys
+	t.Cleanup(func() {
+		resetViewOverrides()
+		configuredBuiltins = nil
+		*Keys, PRKeys, IssueKeys = universal, prKeys, issueKeys
+	})
+}
+
+func TestViewOverrides(t *testing.T) {
+	restoreKeys(t)
+
+	err := Rebind(
+		[]config.Keybinding{{Key: "ctrl+n", Builtin: "down"}},
+		[]config.Keybinding{{Key: "g g", Builtin: "firstLine"}},
+		[]config.Keybinding{{Key: "J", Builtin: "down", Name: "next PR"}},
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		view     config.ViewType
+		binding  func(k *KeyMap) key.Binding
+		wantKeys []string
+		wantHelp string
+	}{
+		{view: config.PRsView, binding: func(k *KeyMap) key.Binding { return k.Down }, wantKeys: []string{"J"}, wantHelp: "next PR"},
+		{view: config.IssuesView, binding: func(k *KeyMap) key.Binding { return k.Down }, wantKeys: []string{"ctrl+n"}, wantHelp: "move down"},
+		{view: config.IssuesView, binding: func(k *KeyMap) key.Binding { return k.FirstLine }, wantKeys: []string{"g g"}, wantHelp: "first item"},
+		{view: config.PRsView, binding: func(k *KeyMap) key.Binding { return k.FirstLine }, wantKeys: []string{"g", "home"}, wantHelp: "first item"},
+		{view: config.WorkflowsView, binding: func(k *KeyMap) key.Binding { return k.Down }, wantKeys: []string{"ctrl+n"}, wantHelp: "move down"},
+	}
+	for _, tt := range tests {
+		binding := tt.binding(UseView(Keys, tt.view))
+		if got := binding.Keys(); !slices.Equal(got, tt.wantKeys) {
+			t.Errorf("in %s, keys = %v, want %v", tt.view, got, tt.wantKeys)
+		}
+		if got := binding.Help().Desc; got != tt.wantHelp {
+			t.Errorf("in %s, help = %q, want %q", tt.view, got, tt.wantHelp)
+		}
+	}
+
+	if got := Keys.Down.Keys(); !slices.Equal(got, []string{"ctrl+n"}) {
+		t.Errorf("Keys.Down keys = %v, want the universal ctrl+n", got)
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	restoreKeys(t)
+
+	err := Rebind(
+		[]config.Keybinding{{Key: "r", Builtin: "search"}},
+		[]config.Keybinding{{Key: "x", Builtin: "assign"}},
+		[]config.Keybinding{{Key: "d", Builtin: "merge"}, {Key: "k", Builtin: "help"}},
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`universal: key "r" is bound to both builtin "search" and "refresh"`,
+		`prs: key "d" is bound to both builtin "merge" and "diff"`,
+		`prs: key "k" is bound to both builtin "help" and "move up"`,
+		`issues: key "x" is bound to both builtin "assign" and "close"`,
+	}
+	if got := Conflicts(); !slices.Equal(got, want) {
+		t.Errorf("Conflicts() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,191 @@
+package keys
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/charmbracelet/bubbles/key"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// viewOverride rebinds a universal binding in a single view, when a view's
+// keybindings list a universal builtin
+type viewOverride struct {
+	binding *key.Binding
+	keys    []string
+	help    key.Help
+}
+
+// configuredBuiltin is a builtin rebound in the config, the view is empty for
+// universal ones
+type configuredBuiltin struct {
+	view    config.ViewType
+	name    string
+	binding *key.Binding
+}
+
+var (
+	viewOverrides = map[config.ViewType][]viewOverride{}
+
+	configuredBuiltins []configuredBuiltin
+)
+
+func addViewOverride(viewType config.ViewType, binding *key.Binding, kb config.Keybinding) {
+	desc := binding.Help().Desc
+	if kb.Name != "" {
+		desc = kb.Name
+	}
+	viewOverrides[viewType] = append(viewOverrides[viewType], viewOverride{
+		binding: binding,
+		keys:    []string{kb.Key},
+		help:    key.Help{Key: kb.Key, Desc: desc},
+	})
+	configuredBuiltins = append(configuredBuiltins,
+		configuredBuiltin{view: viewType, name: kb.Builtin, binding: binding})
+}
+
+// UseView returns a copy of keyMap, Keys when it's nil, with the overrides of
+// the universal bindings of viewType applied instead of the ones of the view
+// it was in. Keys itself is never changed, so that the dashboards served over
+// SSH can each be in a view of their own.
+func UseView(keyMap *KeyMap, viewType config.ViewType) *KeyMap {
+	if keyMap == nil {
+		keyMap = Keys
+	}
+	km := *keyMap
+	km.viewType = viewType
+	base, bindings := bindingFields(Keys), bindingFields(&km)
+	for _, overrides := range viewOverrides {
+		for _, o := range overrides {
+			if i := slices.Index(base, o.binding); i >= 0 {
+				bindings[i].SetKeys(base[i].Keys()...)
+				bindings[i].SetHelp(base[i].Help().Key, base[i].Help().Desc)
+			}
+		}
+	}
+	for _, o := range viewOverrides[viewType] {
+		if i := slices.Index(base, o.binding); i >= 0 {
+			bindings[i].SetKeys(o.keys...)
+			bindings[i].SetHelp(o.help.Key, o.help.Desc)
+		}
+	}
+	return &km
+}
+
+func resetViewOverrides() {
+	viewOverrides = map[config.ViewType][]viewOverride{}
+}
+
+// builtinBindings returns the universal builtin bindings of universal
+// followed by the ones of viewType
+func builtinBindings(viewType config.ViewType, universal *KeyMap) []*key.Binding {
+	bindings := bindingFields(universal)
+	switch viewType {
+	case config.PRsView:
+		bindings = append(bindings, bindingFields(&PRKeys)...)
+	case config.IssuesView:
+		bindings = append(bindings, bindingFields(&IssueKeys)...)
+	case config.RepoView:
+		bindings = append(bindings, bindingFields(&BranchKeys)...)
+	case config.WorkflowsView:
+		bindings = append(bindings, bindingFields(&WorkflowKeys)...)
+	case config.FeedsView:
+		bindings = append(bindings, bindingFields(&FeedKeys)...)
+	case config.DiscussionsView:
+		bindings = append(bindings, bindingFields(&DiscussionKeys)...)
+	case config.ReleasesView:
+		bindings = append(bindings, bindingFields(&ReleaseKeys)...)
+	case config.DependenciesView:
+		bindings = append(bindings, bindingFields(&DependencyKeys)...)
+	case config.ArchiveView:
+		bindings = append(bindings, bindingFields(&ArchiveKeys)...)
+	}
+	return bindings
+}
+
+// bindingFields returns the bindings of the fields of the keymap keyMap
+// points to
+func bindingFields(keyMap any) []*key.Binding {
+	v := reflect.ValueOf(keyMap).Elem()
+	bindingType := reflect.TypeFor[key.Binding]()
+	bindings := make([]*key.Binding, 0, v.NumField())
+	for i := range v.NumField() {
+		if v.Type().Field(i).Type == bindingType {
+			bindings = append(bindings, v.Field(i).Addr().Interface().(*key.Binding))
+		}
+	}
+	return bindings
+}
+
+// Conflicts returns a description of every key the config binds to a builtin
+// that's also the key of another builtin of the same view. The builtin
+// handled first would shadow the other one.
+func Conflicts() []string {
+	base := bindingFields(Keys)
+	reported := map[[2]*key.Binding]bool{}
+	var conflicts []string
+	for _, view := range []config.ViewType{
+		config.PRsView,
+		config.IssuesView,
+		config.RepoView,
+		config.WorkflowsView,
+		config.FeedsView,
+		config.DiscussionsView,
+		config.ReleasesView,
+		config.DependenciesView,
+		config.ArchiveView,
+	} {
+		bindings := builtinBindings(view, UseView(Keys, view))
+		// the universal bindings of the view are copies of the ones of Keys,
+		// at the same positions
+		canonical := func(i int) *key.Binding {
+			if i < len(base) {
+				return base[i]
+			}
+			return bindings[i]
+		}
+		for _, c := range configuredBuiltins {
+			if c.view != "" && c.view != view {
+				continue
+			}
+			binding := c.binding
+			if i := slices.Index(base, c.binding); i >= 0 {
+				binding = bindings[i]
+			}
+			for j, other := range bindings {
+				otherBase := canonical(j)
+				if otherBase == c.binding || !other.Enabled() ||
+					reported[[2]*key.Binding{c.binding, otherBase}] ||
+					reported[[2]*key.Binding{otherBase, c.binding}] {
+					continue
+				}
+				i := slices.IndexFunc(binding.Keys(), func(k string) bool {
+					return slices.Contains(other.Keys(), k)
+				})
+				if i < 0 {
+					continue
+				}
+				reported[[2]*key.Binding{c.binding, otherBase}] = true
+
+				scope := viewListName(view)
+				if c.view == "" && j < len(base) {
+					scope = "universal"
+				}
+				conflicts = append(conflicts, fmt.Sprintf(
+					"%s: key %q is bound to both builtin %q and %q",
+					scope, binding.Keys()[i], c.name, other.Help().Desc))
+			}
+		}
+	}
+	return conflicts
+}
+
+// viewListName returns the name of the config's keybindings list of viewType
+func viewListName(viewType config.ViewType) string {
+	if viewType == config.RepoView {
+		return "branches"
+	}
+	return string(viewType)
+}
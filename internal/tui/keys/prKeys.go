@@ -12,23 +12,63 @@ import (
 type PRKeyMap struct {
 	PrevSidebarTab       key.Binding
 	NextSidebarTab       key.Binding
+	NextDiffFile         key.Binding
+	PrevDiffFile         key.Binding
+	NextDiffHunk         key.Binding
+	PrevDiffHunk         key.Binding
+	NextCheck            key.Binding
+	PrevCheck            key.Binding
+	RerunFailedChecks    key.Binding
+	TailCheckLog         key.Binding
+	ToggleCheckJobs      key.Binding
+	ToggleCheckSource    key.Binding
+	ShowHiddenChecks     key.Binding
+	ResolveThread        key.Binding
 	Approve              key.Binding
+	Review               key.Binding
+	RequestReview        key.Binding
+	DismissReview        key.Binding
 	Assign               key.Binding
+	Label                key.Binding
+	Milestone            key.Binding
 	Unassign             key.Binding
 	Comment              key.Binding
 	Diff                 key.Binding
 	Checkout             key.Binding
+	CheckoutWorktree     key.Binding
 	Close                key.Binding
 	SummaryViewMore      key.Binding
+	LoadOlderComments    key.Binding
+	ToggleBotComments    key.Binding
+	ToggleTimelineEvents key.Binding
+	CollapseActivity     key.Binding
+	JumpToLatest         key.Binding
 	Ready                key.Binding
 	Reopen               key.Binding
 	Merge                key.Binding
+	MergeQueue           key.Binding
 	Update               key.Binding
 	WatchChecks          key.Binding
 	ToggleSmartFiltering key.Binding
 	ToggleRepoFilter     key.Binding
 	ToggleAuthorFilter   key.Binding
+	SliceToday           key.Binding
+	SliceWeek            key.Binding
+	SliceMonth           key.Binding
+	SliceAllTime         key.Binding
+	ToggleCurrentSprint  key.Binding
+	PickSavedFilters     key.Binding
+	Snooze               key.Binding
+	ToggleSnoozed        key.Binding
+	CycleSort            key.Binding
+	ReverseSort          key.Binding
+	ToggleGroup          key.Binding
+	ToggleAllGroups      key.Binding
 	OpenRepoPicker       key.Binding
+	PlanReviews          key.Binding
+	ToggleSelection      key.Binding
+	SelectRange          key.Binding
+	New                  key.Binding
 	ViewIssues           key.Binding
 }
 
@@ -41,10 +81,78 @@ var PRKeys = PRKeyMap{
 		key.WithKeys("]"),
 		key.WithHelp("]", "next sidebar tab"),
 	),
+	NextDiffFile: key.NewBinding(
+		key.WithKeys("}"),
+		key.WithHelp("}", "next file in diff"),
+	),
+	PrevDiffFile: key.NewBinding(
+		key.WithKeys("{"),
+		key.WithHelp("{", "previous file in diff"),
+	),
+	NextDiffHunk: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "next hunk in diff"),
+	),
+	PrevDiffHunk: key.NewBinding(
+		key.WithKeys("N"),
+		key.WithHelp("N", "previous hunk in diff"),
+	),
+	NextCheck: key.NewBinding(
+		key.WithKeys(")"),
+		key.WithHelp(")", "next check"),
+	),
+	PrevCheck: key.NewBinding(
+		key.WithKeys("("),
+		key.WithHelp("(", "previous check"),
+	),
+	RerunFailedChecks: key.NewBinding(
+		key.WithKeys("E"),
+		key.WithHelp("E", "re-run failed jobs of check"),
+	),
+	TailCheckLog: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "tail check log"),
+	),
+	ToggleCheckJobs: key.NewBinding(
+		key.WithKeys("*"),
+		key.WithHelp("*", "expand/collapse matrix jobs"),
+	),
+	ToggleCheckSource: key.NewBinding(
+		key.WithKeys("-"),
+		key.WithHelp("-", "hide/show checks of source"),
+	),
+	ShowHiddenChecks: key.NewBinding(
+		key.WithKeys("="),
+		key.WithHelp("=", "show hidden checks"),
+	),
+	ResolveThread: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "resolve thread"),
+	),
 	Approve: key.NewBinding(
 		key.WithKeys("v"),
 		key.WithHelp("v", "approve"),
 	),
+	Review: key.NewBinding(
+		key.WithKeys("P"),
+		key.WithHelp("P", "review"),
+	),
+	RequestReview: key.NewBinding(
+		key.WithKeys("Q"),
+		key.WithHelp("Q", "re-request review"),
+	),
+	DismissReview: key.NewBinding(
+		key.WithKeys("D"),
+		key.WithHelp("D", "dismiss review"),
+	),
+	Label: key.NewBinding(
+		key.WithKeys("#"),
+		key.WithHelp("#", "label"),
+	),
+	Milestone: key.NewBinding(
+		key.WithKeys("%"),
+		key.WithHelp("%", "milestone"),
+	),
 	Assign: key.NewBinding(
 		key.WithKeys("a"),
 		key.WithHelp("a", "assign"),
@@ -62,8 +170,12 @@ var PRKeys = PRKeyMap{
 		key.WithHelp("d", "diff"),
 	),
 	Checkout: key.NewBinding(
-		key.WithKeys("C", " "),
-		key.WithHelp("C/Space", "checkout"),
+		key.WithKeys("C"),
+		key.WithHelp("C", "checkout"),
+	),
+	CheckoutWorktree: key.NewBinding(
+		key.WithKeys("K"),
+		key.WithHelp("K", "checkout in worktree"),
 	),
 	Close: key.NewBinding(
 		key.WithKeys("x"),
@@ -73,6 +185,26 @@ var PRKeys = PRKeyMap{
 		key.WithKeys("e"),
 		key.WithHelp("e", "expand description"),
 	),
+	LoadOlderComments: key.NewBinding(
+		key.WithKeys("O"),
+		key.WithHelp("O", "load older comments"),
+	),
+	ToggleBotComments: key.NewBinding(
+		key.WithKeys("B"),
+		key.WithHelp("B", "toggle bot comments"),
+	),
+	ToggleTimelineEvents: key.NewBinding(
+		key.WithKeys("H"),
+		key.WithHelp("H", "toggle timeline events"),
+	),
+	CollapseActivity: key.NewBinding(
+		key.WithKeys("Z"),
+		key.WithHelp("Z", "collapse comments"),
+	),
+	JumpToLatest: key.NewBinding(
+		key.WithKeys("J"),
+		key.WithHelp("J", "jump to latest activity"),
+	),
 	Reopen: key.NewBinding(
 		key.WithKeys("X"),
 		key.WithHelp("X", "reopen"),
@@ -85,6 +217,10 @@ var PRKeys = PRKeyMap{
 		key.WithKeys("m"),
 		key.WithHelp("m", "merge"),
 	),
+	MergeQueue: key.NewBinding(
+		key.WithKeys("M"),
+		key.WithHelp("M", "add to/remove from merge queue"),
+	),
 	Update: key.NewBinding(
 		key.WithKeys("u"),
 		key.WithHelp("u", "update pr from base branch"),
@@ -99,16 +235,80 @@ var PRKeys = PRKeyMap{
 	),
 	ToggleRepoFilter: key.NewBinding(
 		key.WithKeys("T"),
-		key.WithHelp("T", "cycle repo filter (origin/upstream/all)"),
+		key.WithHelp("T", "cycle repo filter (remotes/all)"),
 	),
 	ToggleAuthorFilter: key.NewBinding(
 		key.WithKeys("F"),
 		key.WithHelp("F", "toggle author filter"),
 	),
+	SliceToday: key.NewBinding(
+		key.WithKeys("z t"),
+		key.WithHelp("z t", "updated today"),
+	),
+	SliceWeek: key.NewBinding(
+		key.WithKeys("z w"),
+		key.WithHelp("z w", "updated this week"),
+	),
+	SliceMonth: key.NewBinding(
+		key.WithKeys("z m"),
+		key.WithHelp("z m", "updated this month"),
+	),
+	SliceAllTime: key.NewBinding(
+		key.WithKeys("z a"),
+		key.WithHelp("z a", "updated any time"),
+	),
+	ToggleCurrentSprint: key.NewBinding(
+		key.WithKeys("z s"),
+		key.WithHelp("z s", "toggle current sprint"),
+	),
+	PickSavedFilters: key.NewBinding(
+		key.WithKeys("z p"),
+		key.WithHelp("z p", "pick saved filters"),
+	),
+	Snooze: key.NewBinding(
+		key.WithKeys("z z"),
+		key.WithHelp("z z", "snooze/wake up"),
+	),
+	ToggleSnoozed: key.NewBinding(
+		key.WithKeys("z Z"),
+		key.WithHelp("z Z", "show/hide snoozed"),
+	),
+	CycleSort: key.NewBinding(
+		key.WithKeys("z o"),
+		key.WithHelp("z o", "cycle sort order"),
+	),
+	ReverseSort: key.NewBinding(
+		key.WithKeys("z O"),
+		key.WithHelp("z O", "reverse sort order"),
+	),
+	ToggleGroup: key.NewBinding(
+		key.WithKeys("z f"),
+		key.WithHelp("z f", "fold/unfold group"),
+	),
+	ToggleAllGroups: key.NewBinding(
+		key.WithKeys("z F"),
+		key.WithHelp("z F", "fold/unfold all groups"),
+	),
 	OpenRepoPicker: key.NewBinding(
 		key.WithKeys("R"),
 		key.WithHelp("R", "select repo filter"),
 	),
+	PlanReviews: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "plan reviews"),
+	),
+	ToggleSelection: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "select"),
+	),
+	SelectRange: key.NewBinding(
+		key.WithKeys("V"),
+		key.WithHelp("V", "select range"),
+	),
+	New: key.NewBinding(
+		key.WithKeys("+"),
+		key.WithHelp("+", "new PR"),
+	),
 	ViewIssues: key.NewBinding(
 		key.WithKeys("s"),
 		key.WithHelp("s", "switch to issues"),
@@ -119,22 +319,62 @@ func PRFullHelp() []key.Binding {
 	return []key.Binding{
 		PRKeys.PrevSidebarTab,
 		PRKeys.NextSidebarTab,
+		PRKeys.NextDiffFile,
+		PRKeys.PrevDiffFile,
+		PRKeys.NextDiffHunk,
+		PRKeys.PrevDiffHunk,
+		PRKeys.NextCheck,
+		PRKeys.PrevCheck,
+		PRKeys.RerunFailedChecks,
+		PRKeys.TailCheckLog,
+		PRKeys.ToggleCheckJobs,
+		PRKeys.ToggleCheckSource,
+		PRKeys.ShowHiddenChecks,
+		PRKeys.ResolveThread,
 		PRKeys.Approve,
+		PRKeys.Review,
+		PRKeys.RequestReview,
+		PRKeys.DismissReview,
 		PRKeys.Assign,
+		PRKeys.Label,
+		PRKeys.Milestone,
 		PRKeys.Unassign,
 		PRKeys.Comment,
 		PRKeys.Diff,
 		PRKeys.Checkout,
+		PRKeys.CheckoutWorktree,
 		PRKeys.Close,
 		PRKeys.Ready,
 		PRKeys.Reopen,
 		PRKeys.Merge,
+		PRKeys.MergeQueue,
 		PRKeys.Update,
 		PRKeys.WatchChecks,
+		PRKeys.LoadOlderComments,
+		PRKeys.ToggleBotComments,
+		PRKeys.ToggleTimelineEvents,
+		PRKeys.CollapseActivity,
+		PRKeys.JumpToLatest,
 		PRKeys.ToggleSmartFiltering,
 		PRKeys.ToggleRepoFilter,
 		PRKeys.ToggleAuthorFilter,
+		PRKeys.SliceToday,
+		PRKeys.SliceWeek,
+		PRKeys.SliceMonth,
+		PRKeys.SliceAllTime,
+		PRKeys.ToggleCurrentSprint,
+		PRKeys.PickSavedFilters,
+		PRKeys.Snooze,
+		PRKeys.ToggleSnoozed,
+		PRKeys.CycleSort,
+		PRKeys.ReverseSort,
+		PRKeys.ToggleGroup,
+		PRKeys.ToggleAllGroups,
 		PRKeys.OpenRepoPicker,
+		PRKeys.PlanReviews,
+		PRKeys.ToggleSelection,
+		PRKeys.SelectRange,
+		PRKeys.New,
 		PRKeys.ViewIssues,
 	}
 }
@@ -170,10 +410,44 @@ func rebindPRKeys(keys []config.Keybinding) error {
 			key = &PRKeys.PrevSidebarTab
 		case "nextSidebarTab":
 			key = &PRKeys.NextSidebarTab
+		case "nextDiffFile":
+			key = &PRKeys.NextDiffFile
+		case "prevDiffFile":
+			key = &PRKeys.PrevDiffFile
+		case "nextDiffHunk":
+			key = &PRKeys.NextDiffHunk
+		case "prevDiffHunk":
+			key = &PRKeys.PrevDiffHunk
+		case "nextCheck":
+			key = &PRKeys.NextCheck
+		case "prevCheck":
+			key = &PRKeys.PrevCheck
+		case "rerunFailedChecks":
+			key = &PRKeys.RerunFailedChecks
+		case "tailCheckLog":
+			key = &PRKeys.TailCheckLog
+		case "toggleCheckJobs":
+			key = &PRKeys.ToggleCheckJobs
+		case "toggleCheckSource":
+			key = &PRKeys.ToggleCheckSource
+		case "showHiddenChecks":
+			key = &PRKeys.ShowHiddenChecks
+		case "resolveThread":
+			key = &PRKeys.ResolveThread
 		case "approve":
 			key = &PRKeys.Approve
+		case "review":
+			key = &PRKeys.Review
+		case "requestReview":
+			key = &PRKeys.RequestReview
+		case "dismissReview":
+			key = &PRKeys.DismissReview
 		case "assign":
 			key = &PRKeys.Assign
+		case "label":
+			key = &PRKeys.Label
+		case "milestone":
+			key = &PRKeys.Milestone
 		case "unassign":
 			key = &PRKeys.Unassign
 		case "comment":
@@ -182,6 +456,8 @@ func rebindPRKeys(keys []config.Keybinding) error {
 			key = &PRKeys.Diff
 		case "checkout":
 			key = &PRKeys.Checkout
+		case "checkoutWorktree":
+			key = &PRKeys.CheckoutWorktree
 		case "close":
 			key = &PRKeys.Close
 		case "ready":
@@ -190,25 +466,77 @@ func rebindPRKeys(keys []config.Keybinding) error {
 			key = &PRKeys.Reopen
 		case "merge":
 			key = &PRKeys.Merge
+		case "mergeQueue":
+			key = &PRKeys.MergeQueue
 		case "update":
 			key = &PRKeys.Update
 		case "watchChecks":
 			key = &PRKeys.WatchChecks
+		case "new":
+			key = &PRKeys.New
 		case "viewIssues":
 			key = &PRKeys.ViewIssues
 		case "summaryViewMore":
 			key = &PRKeys.SummaryViewMore
+		case "loadOlderComments":
+			key = &PRKeys.LoadOlderComments
+		case "toggleBotComments":
+			key = &PRKeys.ToggleBotComments
+		case "toggleTimelineEvents":
+			key = &PRKeys.ToggleTimelineEvents
+		case "collapseActivity":
+			key = &PRKeys.CollapseActivity
+		case "jumpToLatest":
+			key = &PRKeys.JumpToLatest
+		case "toggleSmartFiltering":
+			key = &PRKeys.ToggleSmartFiltering
 		case "toggleRepoFilter":
 			key = &PRKeys.ToggleRepoFilter
 		case "toggleAuthorFilter":
 			key = &PRKeys.ToggleAuthorFilter
+		case "sliceToday":
+			key = &PRKeys.SliceToday
+		case "sliceWeek":
+			key = &PRKeys.SliceWeek
+		case "sliceMonth":
+			key = &PRKeys.SliceMonth
+		case "sliceAllTime":
+			key = &PRKeys.SliceAllTime
+		case "toggleCurrentSprint":
+			key = &PRKeys.ToggleCurrentSprint
+		case "pickSavedFilters":
+			key = &PRKeys.PickSavedFilters
+		case "snooze":
+			key = &PRKeys.Snooze
+		case "toggleSnoozed":
+			key = &PRKeys.ToggleSnoozed
+		case "cycleSort":
+			key = &PRKeys.CycleSort
+		case "reverseSort":
+			key = &PRKeys.ReverseSort
+		case "toggleGroup":
+			key = &PRKeys.ToggleGroup
+		case "toggleAllGroups":
+			key = &PRKeys.ToggleAllGroups
 		case "openRepoPicker":
 			key = &PRKeys.OpenRepoPicker
+		case "planReviews":
+			key = &PRKeys.PlanReviews
+		case "toggleSelection":
+			key = &PRKeys.ToggleSelection
+		case "selectRange":
+			key = &PRKeys.SelectRange
 		default:
+			if universal := universalBinding(prKey.Builtin); universal != nil {
+				addViewOverride(config.PRsView, universal, prKey)
+				continue
+			}
 			return fmt.Errorf("unknown built-in pr key: '%s'", prKey.Builtin)
 		}
 
 		key.SetKeys(prKey.Key)
+		configuredBuiltins = append(configuredBuiltins,
+			configuredBuiltin{view: config.PRsView, name: prKey.Builtin, binding: key})
 
 		helpDesc := key.Help().Desc
 		if prKey.Name != "" {
@@ -0,0 +1,22 @@
+package keys
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+)
+
+type ReleaseKeyMap struct {
+	ViewPRs key.Binding
+}
+
+var ReleaseKeys = ReleaseKeyMap{
+	ViewPRs: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch view"),
+	),
+}
+
+func ReleaseFullHelp() []key.Binding {
+	return []key.Binding{
+		ReleaseKeys.ViewPRs,
+	}
+}
@@ -0,0 +1,126 @@
+package keys
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// repoScopes are the scopes that let a token change pull requests, issues and
+// workflow runs, public_repo only in public repos
+var repoScopes = []string{"repo", "public_repo"}
+
+// scopedBinding is a binding whose action needs the token to have one of
+// scopes
+type scopedBinding struct {
+	binding *key.Binding
+	scopes  []string
+}
+
+// scopedBindings returns the bindings of viewType whose actions need a scope
+// of the token
+func scopedBindings(viewType config.ViewType) []scopedBinding {
+	withScopes := func(scopes []string, bindings ...*key.Binding) []scopedBinding {
+		scoped := make([]scopedBinding, 0, len(bindings))
+		for _, binding := range bindings {
+			scoped = append(scoped, scopedBinding{binding: binding, scopes: scopes})
+		}
+		return scoped
+	}
+
+	switch viewType {
+	case config.PRsView:
+		return withScopes(repoScopes,
+			&PRKeys.Approve,
+			&PRKeys.Review,
+			&PRKeys.RequestReview,
+			&PRKeys.DismissReview,
+			&PRKeys.Assign,
+			&PRKeys.Unassign,
+			&PRKeys.Label,
+			&PRKeys.Milestone,
+			&PRKeys.Comment,
+			&PRKeys.Close,
+			&PRKeys.Reopen,
+			&PRKeys.Ready,
+			&PRKeys.Merge,
+			&PRKeys.MergeQueue,
+			&PRKeys.Update,
+			&PRKeys.ResolveThread,
+			&PRKeys.RerunFailedChecks,
+			&PRKeys.New,
+		)
+	case config.IssuesView:
+		return append(withScopes(repoScopes,
+			&IssueKeys.Label,
+			&IssueKeys.Milestone,
+			&IssueKeys.Assign,
+			&IssueKeys.AutoAssign,
+			&IssueKeys.Unassign,
+			&IssueKeys.Comment,
+			&IssueKeys.Close,
+			&IssueKeys.Reopen,
+			&IssueKeys.New,
+		), withScopes([]string{"project"}, &IssueKeys.Estimate)...)
+	case config.WorkflowsView:
+		return withScopes(repoScopes,
+			&WorkflowKeys.Rerun,
+			&WorkflowKeys.RerunFailed,
+			&WorkflowKeys.Cancel,
+		)
+	case config.DiscussionsView:
+		return withScopes(append([]string{"write:discussion"}, repoScopes...),
+			&DiscussionKeys.Comment,
+			&DiscussionKeys.MarkAnswer,
+		)
+	default:
+		return nil
+	}
+}
+
+// MissingScopes returns the binding of viewType msg triggers and the scopes
+// its action needs when the token has none of them, has tells whether the
+// token has one of the given scopes
+func MissingScopes(viewType config.ViewType, msg tea.KeyMsg,
+	has func(scopes ...string) bool,
+) (key.Binding, []string) {
+	for _, scoped := range scopedBindings(viewType) {
+		if key.Matches(msg, *scoped.binding) && !has(scoped.scopes...) {
+			return *scoped.binding, scoped.scopes
+		}
+	}
+	return key.Binding{}, nil
+}
+
+// MarkUnavailable marks in the help the bindings whose actions the token
+// can't do, with the first scope they need
+func MarkUnavailable(has func(scopes ...string) bool) {
+	views := []config.ViewType{
+		config.PRsView,
+		config.IssuesView,
+		config.WorkflowsView,
+		config.DiscussionsView,
+	}
+	for _, view := range views {
+		for _, scoped := range scopedBindings(view) {
+			if has(scoped.scopes...) {
+				continue
+			}
+			help := scoped.binding.Help()
+			mark := " (needs " + scoped.scopes[0] + ")"
+			if !strings.HasSuffix(help.Desc, mark) {
+				scoped.binding.SetHelp(help.Key, help.Desc+mark)
+			}
+		}
+	}
+}
+
+// HelpDesc returns the description of binding without the mark of
+// MarkUnavailable
+func HelpDesc(binding key.Binding) string {
+	desc, _, _ := strings.Cut(binding.Help().Desc, " (needs ")
+	return desc
+}
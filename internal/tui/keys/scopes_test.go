@@ -0,0 +1,100 @@
+package keys
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+// hasScopes returns a check of whether a token with scopes has one of the
+// needed ones
+func hasScopes(scopes ...string) func(...string) bool {
+	return func(needed ...string) bool {
+		for _, scope := range needed {
+			if slices.Contains(scopes, scope) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func TestMissingScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		view   config.ViewType
+		msg    tea.KeyMsg
+		scopes []string
+		want   []string
+	}{
+		{
+			name:   "merging with repo",
+			view:   config.PRsView,
+			msg:    tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")},
+			scopes: []string{"repo"},
+			want:   nil,
+		},
+		{
+			name:   "merging without repo",
+			view:   config.PRsView,
+			msg:    tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")},
+			scopes: []string{"read:org"},
+			want:   repoScopes,
+		},
+		{
+			name:   "moving without repo",
+			view:   config.PRsView,
+			msg:    tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")},
+			scopes: nil,
+			want:   nil,
+		},
+		{
+			name:   "estimating without project",
+			view:   config.IssuesView,
+			msg:    tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("E")},
+			scopes: []string{"repo"},
+			want:   []string{"project"},
+		},
+		{
+			name:   "commenting on a discussion",
+			view:   config.DiscussionsView,
+			msg:    tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")},
+			scopes: []string{"write:discussion"},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got := MissingScopes(tt.view, tt.msg, hasScopes(tt.scopes...))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("MissingScopes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkUnavailable(t *testing.T) {
+	restoreKeys(t)
+	workflowKeys, discussionKeys := WorkflowKeys, DiscussionKeys
+	t.Cleanup(func() {
+		WorkflowKeys, DiscussionKeys = workflowKeys, discussionKeys
+	})
+
+	has := hasScopes("repo")
+	MarkUnavailable(has)
+	MarkUnavailable(has)
+
+	if got := PRKeys.Merge.Help().Desc; got != "merge" {
+		t.Errorf("merge help = %q, want it unmarked", got)
+	}
+	if got := IssueKeys.Estimate.Help().Desc; got != "estimate (needs project)" {
+		t.Errorf("estimate help = %q, want it marked once", got)
+	}
+	if got := HelpDesc(IssueKeys.Estimate); got != "estimate" {
+		t.Errorf("HelpDesc() = %q, want %q", got, "estimate")
+	}
+}
@@ -0,0 +1,110 @@
+package keys
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+type WorkflowKeyMap struct {
+	Rerun       key.Binding
+	RerunFailed key.Binding
+	Cancel      key.Binding
+	Logs        key.Binding
+	ViewPRs     key.Binding
+}
+
+var WorkflowKeys = WorkflowKeyMap{
+	Rerun: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "re-run"),
+	),
+	RerunFailed: key.NewBinding(
+		key.WithKeys("E"),
+		key.WithHelp("E", "re-run failed jobs"),
+	),
+	Cancel: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "cancel"),
+	),
+	Logs: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "view logs"),
+	),
+	ViewPRs: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "switch view"),
+	),
+}
+
+func WorkflowFullHelp() []key.Binding {
+	return []key.Binding{
+		WorkflowKeys.Rerun,
+		WorkflowKeys.RerunFailed,
+		WorkflowKeys.Cancel,
+		WorkflowKeys.Logs,
+		WorkflowKeys.ViewPRs,
+	}
+}
+
+func rebindWorkflowKeys(keys []config.Keybinding) error {
+	CustomWorkflowBindings = []key.Binding{}
+
+	for _, workflowKey := range keys {
+		if workflowKey.Builtin == "" {
+			// Handle custom commands
+			if workflowKey.Command != "" {
+				name := workflowKey.Name
+				if workflowKey.Name == "" {
+					name = config.TruncateCommand(workflowKey.Command)
+				}
+
+				customBinding := key.NewBinding(
+					key.WithKeys(workflowKey.Key),
+					key.WithHelp(workflowKey.Key, name),
+				)
+
+				CustomWorkflowBindings = append(CustomWorkflowBindings, customBinding)
+			}
+			continue
+		}
+
+		log.Debug("Rebinding workflow key", "builtin", workflowKey.Builtin, "key", workflowKey.Key)
+
+		var key *key.Binding
+
+		switch workflowKey.Builtin {
+		case "rerun":
+			key = &WorkflowKeys.Rerun
+		case "rerunFailed":
+			key = &WorkflowKeys.RerunFailed
+		case "cancel":
+			key = &WorkflowKeys.Cancel
+		case "logs":
+			key = &WorkflowKeys.Logs
+		case "viewPrs":
+			key = &WorkflowKeys.ViewPRs
+		default:
+			if universal := universalBinding(workflowKey.Builtin); universal != nil {
+				addViewOverride(config.WorkflowsView, universal, workflowKey)
+				continue
+			}
+			return fmt.Errorf("unknown built-in workflow key: '%s'", workflowKey.Builtin)
+		}
+
+		key.SetKeys(workflowKey.Key)
+		configuredBuiltins = append(configuredBuiltins,
+			configuredBuiltin{view: config.WorkflowsView, name: workflowKey.Builtin, binding: key})
+
+		helpDesc := key.Help().Desc
+		if workflowKey.Name != "" {
+			helpDesc = workflowKey.Name
+		}
+		key.SetHelp(workflowKey.Key, helpDesc)
+	}
+
+	return nil
+}
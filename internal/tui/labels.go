@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/labelpicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// openLabelPicker shows the overlay picking the labels of the selected PR or
+// issue
+func (m *Model) openLabelPicker() tea.Cmd {
+	var target labelpicker.Target
+	switch row := m.getCurrRowData().(type) {
+	case *prrow.Data:
+		if row == nil || row.Primary == nil {
+			return nil
+		}
+		target = labelpicker.Target{
+			Repo:   row.Primary.GetRepoNameWithOwner(),
+			Number: row.Primary.Number,
+			IsPR:   true,
+			Labels: row.Primary.Labels.Nodes,
+		}
+	case *data.IssueData:
+		if row == nil {
+			return nil
+		}
+		target = labelpicker.Target{
+			Repo:   row.GetRepoNameWithOwner(),
+			Number: row.Number,
+			Labels: row.Labels.Nodes,
+		}
+	default:
+		return nil
+	}
+
+	cmd := m.labelPicker.Open(target)
+	m.focus.Push(focus.Palette)
+	return cmd
+}
+
+// applyLabels adds and removes the labels picked for a PR or an issue
+func (m *Model) applyLabels(msg labelpicker.AppliedMsg) tea.Cmd {
+	currSection := m.getCurrSection()
+	if currSection == nil {
+		return nil
+	}
+
+	target := msg.Target
+	kind, noun := "issue", "Issue"
+	if target.IsPR {
+		kind, noun = "pr", "PR"
+	}
+	args := []string{kind, "edit", fmt.Sprint(target.Number), "-R", target.Repo}
+	var changes []string
+	for _, label := range msg.Added {
+		args = append(args, "--add-label", label)
+		changes = append(changes, "+"+label)
+	}
+	for _, label := range msg.Removed {
+		args = append(args, "--remove-label", label)
+		changes = append(changes, "-"+label)
+	}
+
+	taskId := fmt.Sprintf("%s_label_%d", kind, target.Number)
+	startCmd := m.ctx.StartTask(context.Task{
+		Id:           taskId,
+		StartText:    fmt.Sprintf("Labeling %s #%d with %s", noun, target.Number, strings.Join(changes, " ")),
+		FinishedText: fmt.Sprintf("%s #%d has been labeled", noun, target.Number),
+		State:        context.TaskStart,
+	})
+
+	sectionId, sectionType := currSection.GetId(), currSection.GetType()
+	labels := msg.Labels
+	return tasks.RunQueueable(startCmd, args, func(c *exec.Cmd, err error) constants.TaskFinishedMsg {
+		var update tea.Msg
+		if target.IsPR {
+			update = tasks.UpdatePRMsg{
+				PrNumber: target.Number,
+				Labels:   &data.PRLabels{Nodes: labels},
+			}
+		} else {
+			update = issuessection.UpdateIssueMsg{
+				IssueNumber: target.Number,
+				Labels:      &data.IssueLabels{Nodes: labels},
+			}
+		}
+		if err != nil {
+			update = nil
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   sectionId,
+			SectionType: sectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         update,
+		}
+	})
+}
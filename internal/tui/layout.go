@@ -0,0 +1,57 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+)
+
+// previewStep is how many columns the preview pane is widened or narrowed by
+const previewStep = 5
+
+// the preview pane is kept wide enough to read, and the sections wide enough
+// to tell their rows apart
+const (
+	minPreviewWidth     = 30
+	minMainContentWidth = 40
+)
+
+// resizePreview widens the preview pane by delta columns, or narrows it when
+// delta is negative, and saves its width for the next launches
+func (m *Model) resizePreview(delta int) tea.Cmd {
+	if !m.sidebar.IsOpen {
+		return nil
+	}
+
+	curr := m.ctx.Config.Defaults.Preview.Width
+	width := max(minPreviewWidth, min(m.ctx.ScreenWidth-minMainContentWidth, curr+delta))
+	if width == curr {
+		return nil
+	}
+	m.ctx.Config.Defaults.Preview.Width = width
+	m.baseConfig.Defaults.Preview.Width = width
+	m.layout.PreviewWidth = width
+	m.syncMainContentWidth()
+	m.syncProgramContext()
+	return tea.Batch(m.syncSidebar(), m.saveLayout())
+}
+
+func (m *Model) saveLayout() tea.Cmd {
+	// a shared dashboard doesn't get to change the layout of its owner
+	if m.ctx.ReadOnly {
+		return nil
+	}
+	layout := m.layout
+	return func() tea.Msg {
+		dir, err := state.Dir()
+		if err != nil {
+			log.Error("Failed resolving state dir, the layout isn't saved", "err", err)
+			return nil
+		}
+		if err := state.SaveLayout(dir, layout); err != nil {
+			log.Error("Failed saving the layout", "err", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,58 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+)
+
+type linkedItemFetchedMsg struct {
+	row data.RowData
+	err error
+}
+
+// openLink switches to the view of the PR or issue gh-dash was launched
+// with, returning the cmd fetching it. It returns nil if there's no link.
+func (m *Model) openLink() tea.Cmd {
+	if m.linkUrl == "" {
+		return nil
+	}
+
+	link, err := data.ParseItemUrl(m.linkUrl)
+	if err != nil {
+		log.Error("Invalid link to open", "url", m.linkUrl, "err", err)
+		return func() tea.Msg {
+			return linkedItemFetchedMsg{err: err}
+		}
+	}
+
+	if link.IsPR {
+		m.ctx.View = config.PRsView
+	} else {
+		m.ctx.View = config.IssuesView
+	}
+
+	return func() tea.Msg {
+		row, err := data.FetchItem(link)
+		if err != nil {
+			return linkedItemFetchedMsg{err: err}
+		}
+		// the PR sidebar works on rows of the PR sections
+		if pr, ok := row.(*data.PullRequestData); ok {
+			row = &prrow.Data{Primary: pr}
+		}
+		return linkedItemFetchedMsg{row: row}
+	}
+}
+
+// viewBranchPr switches to the PRs view showing pr, the PR of a branch of the
+// repo view, until the selection moves like a PR gh-dash was launched with
+func (m *Model) viewBranchPr(pr *data.PullRequestData) tea.Cmd {
+	m.sidebar.IsOpen = true
+	cmd := m.goToView(config.PRsView)
+	m.linkedRow = &prrow.Data{Primary: pr}
+	return tea.Batch(cmd, m.onViewedRowChanged())
+}
@@ -1,12 +1,19 @@
 package markdown
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/glamour/ansi"
 	"github.com/charmbracelet/glamour/styles"
+	xansi "github.com/charmbracelet/x/ansi"
 )
 
-var markdownStyle *ansi.StyleConfig
+var (
+	markdownStyle *ansi.StyleConfig
+	syntaxStyle   = "monokai"
+)
 
 func InitializeMarkdownStyle(hasDarkBackground bool) {
 	if markdownStyle != nil {
@@ -16,14 +23,87 @@ func InitializeMarkdownStyle(hasDarkBackground bool) {
 		markdownStyle = &CustomDarkStyleConfig
 	} else {
 		markdownStyle = &styles.LightStyleConfig
+		syntaxStyle = "github"
+	}
+}
+
+// SyntaxStyle is the name of the chroma style used to highlight code outside
+// of markdown, e.g. in diffs
+func SyntaxStyle() string {
+	return syntaxStyle
+}
+
+// Renderer renders markdown for the terminal, the URLs in it made clickable
+type Renderer struct {
+	glamour.TermRenderer
+	// fitWidth is the width the lines of the text are fit to, they're left
+	// as rendered when it's 0
+	fitWidth int
+	truncate bool
+}
+
+func (r Renderer) Render(in string) (string, error) {
+	out, err := r.TermRenderer.Render(in)
+	if err != nil {
+		return out, err
 	}
+	if r.fitWidth > 0 {
+		out = Fit(out, r.fitWidth, r.truncate)
+	}
+	return Hyperlinks(out), nil
 }
 
-func GetMarkdownRenderer(width int) glamour.TermRenderer {
+func GetMarkdownRenderer(width int) Renderer {
 	markdownRenderer, _ := glamour.NewTermRenderer(
 		glamour.WithStyles(*markdownStyle),
 		glamour.WithWordWrap(width),
 	)
 
-	return *markdownRenderer
+	return Renderer{TermRenderer: *markdownRenderer}
+}
+
+// GetReadingRenderer returns a renderer wrapping the text at width, whose
+// lines still wider than width, like the ones of code blocks and long links,
+// are soft-wrapped, or truncated when truncate is set
+func GetReadingRenderer(width int, truncate bool) Renderer {
+	r := GetMarkdownRenderer(width)
+	r.fitWidth, r.truncate = width, truncate
+	return r
+}
+
+// Fit soft-wraps the lines of s wider than width, or truncates them with an
+// ellipsis when truncate is set
+func Fit(s string, width int, truncate bool) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if xansi.StringWidth(line) <= width {
+			continue
+		}
+		// glamour pads the lines it wraps, the padding isn't worth a line of
+		// its own
+		if trimmed := strings.TrimRight(line, " "); xansi.StringWidth(trimmed) <= width {
+			lines[i] = trimmed
+			continue
+		}
+		if truncate {
+			lines[i] = xansi.Truncate(line, width, "…")
+		} else {
+			lines[i] = xansi.Hardwrap(line, width, true)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// urlRegex matches the URLs of rendered text, they end at a space or at the
+// escape sequence styling them
+var urlRegex = regexp.MustCompile(`https?://[^\s\x1b<>"]+`)
+
+// Hyperlinks wraps the URLs of s in OSC 8 escape sequences, so terminals
+// supporting them open the URLs on a click. The others ignore the sequences.
+func Hyperlinks(s string) string {
+	return urlRegex.ReplaceAllStringFunc(s, func(url string) string {
+		trimmed := strings.TrimRight(url, ".,;:!?)]'")
+		return "\x1b]8;;" + trimmed + "\x1b\\" + trimmed + "\x1b]8;;\x1b\\" +
+			url[len(trimmed):]
+	})
 }
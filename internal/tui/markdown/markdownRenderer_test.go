@@ -0,0 +1,88 @@
+package markdown
+
+import "testing"
+
+func TestHyperlinks(t *testing.T) {
+	link := func(url string) string {
+		return "\x1b]8;;" + url + "\x1b\\" + url + "\x1b]8;;\x1b\\"
+	}
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "no urls",
+			in:   "nothing to link here",
+			want: "nothing to link here",
+		},
+		{
+			name: "url in text",
+			in:   "see https://github.com/dlvhdr/gh-dash for more",
+			want: "see " + link("https://github.com/dlvhdr/gh-dash") + " for more",
+		},
+		{
+			name: "trailing punctuation",
+			in:   "fixed in (https://github.com/dlvhdr/gh-dash/pull/1).",
+			want: "fixed in (" + link("https://github.com/dlvhdr/gh-dash/pull/1") + ").",
+		},
+		{
+			name: "styled url",
+			in:   "\x1b[4mhttp://example.com/a?b=c\x1b[0m",
+			want: "\x1b[4m" + link("http://example.com/a?b=c") + "\x1b[0m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Hyperlinks(tt.in); got != tt.want {
+				t.Errorf("Hyperlinks() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		width    int
+		truncate bool
+		want     string
+	}{
+		{
+			name:  "short lines are kept",
+			in:    "a line\nanother",
+			width: 10,
+			want:  "a line\nanother",
+		},
+		{
+			name:  "padding past the width is dropped",
+			in:    "a line        ",
+			width: 10,
+			want:  "a line",
+		},
+		{
+			name:  "long lines are wrapped",
+			in:    "func main() {}",
+			width: 6,
+			want:  "func m\nain() \n{}",
+		},
+		{
+			name:     "long lines are truncated",
+			in:       "func main() {}\nok",
+			width:    6,
+			truncate: true,
+			want:     "func …\nok",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Fit(tt.in, tt.width, tt.truncate); got != tt.want {
+				t.Errorf("Fit() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/cache"
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+)
+
+// metadataCache returns the directory the metadata of repos and orgs is
+// persisted in, empty when caching is disabled, and the max ages set for
+// each kind of metadata
+func metadataCache(cfg config.CacheConfig) (string, map[data.MetadataKind]time.Duration) {
+	maxAges := map[data.MetadataKind]time.Duration{}
+	for kind, minutes := range map[data.MetadataKind]*int{
+		data.MetadataLabels:     cfg.Metadata.Labels,
+		data.MetadataMilestones: cfg.Metadata.Milestones,
+		data.MetadataMembers:    cfg.Metadata.Members,
+		data.MetadataTeams:      cfg.Metadata.Teams,
+	} {
+		if minutes != nil {
+			maxAges[kind] = time.Duration(*minutes) * time.Minute
+		}
+	}
+
+	if cfg.Disabled {
+		return "", maxAges
+	}
+	dir, err := cache.Dir(cfg.Dir)
+	if err != nil {
+		log.Debug("Failed finding the cache directory, metadata is kept in memory", "err", err)
+		return "", maxAges
+	}
+	return dir, maxAges
+}
@@ -0,0 +1,112 @@
+package tui
+
+import (
+	"fmt"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/milestonepicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// openMilestonePicker shows the overlay picking the milestone of the
+// selected PR or issue
+func (m *Model) openMilestonePicker() tea.Cmd {
+	var target milestonepicker.Target
+	switch row := m.getCurrRowData().(type) {
+	case *prrow.Data:
+		if row == nil || row.Primary == nil {
+			return nil
+		}
+		target = milestonepicker.Target{
+			Repo:      row.Primary.GetRepoNameWithOwner(),
+			Number:    row.Primary.Number,
+			IsPR:      true,
+			Milestone: row.Primary.Milestone,
+		}
+	case *data.IssueData:
+		if row == nil {
+			return nil
+		}
+		target = milestonepicker.Target{
+			Repo:      row.GetRepoNameWithOwner(),
+			Number:    row.Number,
+			Milestone: row.Milestone,
+		}
+	default:
+		return nil
+	}
+
+	cmd := m.milestonePicker.Open(target)
+	m.focus.Push(focus.Palette)
+	return cmd
+}
+
+// applyMilestone sets or clears the milestone picked for a PR or an issue
+func (m *Model) applyMilestone(msg milestonepicker.AppliedMsg) tea.Cmd {
+	currSection := m.getCurrSection()
+	if currSection == nil {
+		return nil
+	}
+
+	target := msg.Target
+	kind, noun := "issue", "Issue"
+	if target.IsPR {
+		kind, noun = "pr", "PR"
+	}
+	args := []string{kind, "edit", fmt.Sprint(target.Number), "-R", target.Repo}
+	startText := fmt.Sprintf("Removing %s #%d from its milestone", noun, target.Number)
+	finishedText := fmt.Sprintf("%s #%d has been removed from its milestone", noun, target.Number)
+	if msg.Milestone != nil {
+		args = append(args, "--milestone", msg.Milestone.Title)
+		startText = fmt.Sprintf("Adding %s #%d to %s", noun, target.Number, msg.Milestone.Title)
+		finishedText = fmt.Sprintf("%s #%d has been added to %s", noun, target.Number, msg.Milestone.Title)
+	} else {
+		args = append(args, "--remove-milestone")
+	}
+
+	taskId := fmt.Sprintf("%s_milestone_%d", kind, target.Number)
+	startCmd := m.ctx.StartTask(context.Task{
+		Id:           taskId,
+		StartText:    startText,
+		FinishedText: finishedText,
+		State:        context.TaskStart,
+	})
+
+	sectionId, sectionType := currSection.GetId(), currSection.GetType()
+	milestone := msg.Milestone
+	hasMilestone := milestone != nil
+	return tasks.RunQueueable(startCmd, args, func(c *exec.Cmd, err error) constants.TaskFinishedMsg {
+		var update tea.Msg
+		if target.IsPR {
+			update = tasks.UpdatePRMsg{
+				PrNumber:     target.Number,
+				HasMilestone: &hasMilestone,
+				Milestone:    milestone,
+			}
+		} else {
+			update = issuessection.UpdateIssueMsg{
+				IssueNumber:  target.Number,
+				HasMilestone: &hasMilestone,
+				Milestone:    milestone,
+			}
+		}
+		if err != nil {
+			update = nil
+		}
+		return constants.TaskFinishedMsg{
+			SectionId:   sectionId,
+			SectionType: sectionType,
+			TaskId:      taskId,
+			Err:         err,
+			Msg:         update,
+		}
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	log "github.com/charmbracelet/log"
@@ -18,10 +19,12 @@ import (
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/history"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/markdown"
 )
 
@@ -34,6 +37,9 @@ func (m *Model) getCurrSection() section.Section {
 }
 
 func (m *Model) getCurrRowData() data.RowData {
+	if m.linkedRow != nil {
+		return m.linkedRow
+	}
 	section := m.getCurrSection()
 	if section == nil {
 		return nil
@@ -41,6 +47,42 @@ func (m *Model) getCurrRowData() data.RowData {
 	return section.GetCurrRow()
 }
 
+// hasSelection returns whether rows of s are selected, the actions that
+// support it then prompt once for all of them
+func (m *Model) hasSelection(s section.Section) bool {
+	selection, ok := s.(section.Selection)
+	return ok && selection.HasSelection()
+}
+
+// recordAction adds msg to the action history if it's bound to an action on
+// the selected row
+func (m *Model) recordAction(msg tea.KeyMsg, row data.RowData) {
+	if row == nil {
+		return
+	}
+	if v := reflect.ValueOf(row); v.Kind() == reflect.Pointer && v.IsNil() {
+		return
+	}
+
+	for _, binding := range keys.RepeatableActions(m.ctx.View) {
+		if !key.Matches(msg, binding) {
+			continue
+		}
+		target := row.GetTitle()
+		if row.GetNumber() > 0 {
+			target = fmt.Sprintf("#%d %s", row.GetNumber(), target)
+		}
+		m.history.Push(history.Entry{
+			View:   m.ctx.View,
+			Key:    msg,
+			Desc:   binding.Help().Desc,
+			Target: target,
+			At:     time.Now(),
+		})
+		return
+	}
+}
+
 func (m *Model) getSectionAt(id int) section.Section {
 	sections := m.getCurrentViewSections()
 	if len(sections) <= id {
@@ -49,12 +91,31 @@ func (m *Model) getSectionAt(id int) section.Section {
 	return sections[id]
 }
 
+// getPrevSectionId returns the section before the current one, skipping the
+// one shown in the other pane of compare mode
 func (m *Model) getPrevSectionId() int {
-	return max(0, (m.currSectionId - 1))
+	id := m.currSectionId - 1
+	if m.getComparedSection() != nil && id == m.compared.id {
+		id--
+	}
+	if id < 0 {
+		return m.currSectionId
+	}
+	return id
 }
 
+// getNextSectionId returns the section after the current one, skipping the
+// one shown in the other pane of compare mode
 func (m *Model) getNextSectionId() int {
-	return min((m.currSectionId + 1), len(m.ctx.GetViewSectionsConfig())-1)
+	last := len(m.ctx.GetViewSectionsConfig()) - 1
+	id := m.currSectionId + 1
+	if m.getComparedSection() != nil && id == m.compared.id {
+		id++
+	}
+	if id > last {
+		return min(m.currSectionId, last)
+	}
+	return id
 }
 
 type IssueCommandTemplateInput struct {
@@ -67,52 +128,38 @@ type IssueCommandTemplateInput struct {
 func (m *Model) executeKeybinding(key string) tea.Cmd {
 	currRowData := m.getCurrRowData()
 
-	for _, keybinding := range m.ctx.Config.Keybindings.Universal {
-		if keybinding.Key != key {
-			continue
-		}
+	keybinding, list, ok := m.findUserKeybinding(key)
+	if !ok {
+		return nil
+	}
 
+	if list == 0 {
 		log.Info("executing keybind", "key", keybinding.Key, "command", keybinding.Command)
 		return m.runCustomUniversalCommand(keybinding.Command)
 	}
 
+	log.Debug("executing keybind", "key", keybinding.Key, "command", keybinding.Command)
+
 	switch m.ctx.View {
 	case config.IssuesView:
-		for _, keybinding := range m.ctx.Config.Keybindings.Issues {
-			if keybinding.Key != key {
-				continue
-			}
-
-			switch data := currRowData.(type) {
-			case *data.IssueData:
-				return m.runCustomIssueCommand(keybinding.Command, data)
-			}
+		switch data := currRowData.(type) {
+		case *data.IssueData:
+			return m.runCustomIssueCommand(keybinding.Command, data)
 		}
 	case config.PRsView:
-		for _, keybinding := range m.ctx.Config.Keybindings.Prs {
-			if keybinding.Key != key || keybinding.Command == "" {
-				continue
-			}
-
-			log.Debug("executing keybind", "key", keybinding.Key, "command", keybinding.Command)
-
-			switch data := currRowData.(type) {
-			case *prrow.Data:
-				return m.runCustomPRCommand(keybinding.Command, data)
-			}
+		switch data := currRowData.(type) {
+		case *prrow.Data:
+			return m.runCustomPRCommand(keybinding.Command, data)
 		}
 	case config.RepoView:
-		for _, keybinding := range m.ctx.Config.Keybindings.Branches {
-			if keybinding.Key != key || keybinding.Command == "" {
-				continue
-			}
-
-			log.Debug("executing keybind", "key", keybinding.Key, "command", keybinding.Command)
-
-			switch data := currRowData.(type) {
-			case *prrow.Data:
-				return m.runCustomBranchCommand(keybinding.Command, data)
-			}
+		switch data := currRowData.(type) {
+		case *prrow.Data:
+			return m.runCustomBranchCommand(keybinding.Command, data)
+		}
+	case config.WorkflowsView:
+		switch data := currRowData.(type) {
+		case *data.WorkflowRunData:
+			return m.runCustomWorkflowCommand(keybinding.Command, data)
 		}
 	default:
 		// Not a valid case - ignore it
@@ -121,6 +168,29 @@ func (m *Model) executeKeybinding(key string) tea.Cmd {
 	return nil
 }
 
+// findUserKeybinding returns the custom command bound to key in the current
+// section. list is 0 for universal commands and 1 for commands of the view.
+func (m *Model) findUserKeybinding(key string) (keybinding config.Keybinding, list int, ok bool) {
+	var viewKeybindings []config.Keybinding
+	switch m.ctx.View {
+	case config.IssuesView:
+		viewKeybindings = m.ctx.Config.Keybindings.Issues
+	case config.PRsView:
+		viewKeybindings = m.ctx.Config.Keybindings.Prs
+	case config.RepoView:
+		viewKeybindings = m.ctx.Config.Keybindings.Branches
+	case config.WorkflowsView:
+		viewKeybindings = m.ctx.Config.Keybindings.Workflows
+	}
+
+	return config.FindKeybinding(
+		key,
+		m.currSectionId,
+		m.ctx.Config.Keybindings.Universal,
+		viewKeybindings,
+	)
+}
+
 // runCustomCommand executes a user-defined command.
 // commandTemplate is a template string that will be parsed with the input data.
 // contextData is a map of key-value pairs of data specific to the context the command is being run in.
@@ -180,6 +250,17 @@ func (m *Model) runCustomIssueCommand(commandTemplate string, issueData *data.Is
 	)
 }
 
+func (m *Model) runCustomWorkflowCommand(commandTemplate string, runData *data.WorkflowRunData) tea.Cmd {
+	return m.runCustomCommand(commandTemplate,
+		&map[string]any{
+			"RepoName":   runData.GetRepoNameWithOwner(),
+			"RunId":      runData.Id,
+			"HeadBranch": runData.HeadBranch,
+			"HeadSha":    runData.HeadSha,
+		},
+	)
+}
+
 func (m *Model) runCustomBranchCommand(commandTemplate string, branchData *prrow.Data) tea.Cmd {
 	if reflect.ValueOf(branchData).IsNil() {
 		return m.executeCustomCommand(commandTemplate)
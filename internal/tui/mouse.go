@@ -0,0 +1,111 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/reposection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/sidebar"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+const (
+	// doubleClickInterval is the most time between two clicks on a row for
+	// them to open the sidebar
+	doubleClickInterval = 400 * time.Millisecond
+	// wheelLines are the lines of the sidebar scrolled by a turn of the wheel
+	wheelLines = 3
+)
+
+// rowClick is the last row clicked, to tell a double click
+type rowClick struct {
+	sectionId int
+	row       int
+	at        time.Time
+}
+
+// onMouse selects the row clicked, opening the sidebar on a double click, and
+// scrolls the rows or the sidebar under the wheel. The mouse is ignored while
+// a layer is opened over the table.
+func (m *Model) onMouse(msg tea.MouseMsg) tea.Cmd {
+	if m.focus.Top() != focus.Table {
+		return nil
+	}
+	currSection := m.getCurrSection()
+	if currSection == nil || currSection.FocusedMode() != focus.Table {
+		return nil
+	}
+
+	if tea.MouseEvent(msg).IsWheel() && m.sidebar.IsOpen && m.ctx.Zone.Get(sidebar.ZoneId).InBounds(msg) {
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.sidebar.ScrollUp(wheelLines)
+		case tea.MouseButtonWheelDown:
+			m.sidebar.ScrollDown(wheelLines)
+		}
+		return nil
+	}
+
+	// the click or wheel may be over the other pane of compare mode, it
+	// becomes the current one
+	var cmd tea.Cmd
+	row, ok := currSection.RowAt(msg)
+	if !ok {
+		compared := m.getComparedSection()
+		if compared == nil {
+			return nil
+		}
+		if row, ok = compared.RowAt(msg); !ok {
+			return nil
+		}
+		cmd = m.switchPane()
+		currSection = compared
+	}
+
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		currSection.PrevRow()
+		return tea.Batch(cmd, m.onViewedRowChanged())
+
+	case msg.Button == tea.MouseButtonWheelDown:
+		return tea.Batch(cmd, m.nextRow(currSection), m.onViewedRowChanged())
+
+	case msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionRelease:
+		click := rowClick{sectionId: currSection.GetId(), row: row, at: time.Now()}
+		last := m.lastClick
+		m.lastClick = click
+		if last.sectionId == click.sectionId && last.row == click.row &&
+			click.at.Sub(last.at) <= doubleClickInterval {
+			m.lastClick = rowClick{}
+			if m.sidebar.IsOpen {
+				return cmd
+			}
+			m.sidebar.IsOpen = true
+			m.syncMainContentWidth()
+			return tea.Batch(cmd, m.markViewedRowRead())
+		}
+		if row == currSection.CurrRow() {
+			return cmd
+		}
+		currSection.SelectRow(row)
+		return tea.Batch(cmd, m.onViewedRowChanged())
+	}
+
+	return cmd
+}
+
+// nextRow moves to the next row of s, fetching more rows when it's the last
+// one
+func (m *Model) nextRow(s section.Section) tea.Cmd {
+	prevRow := s.CurrRow()
+	nextRow := s.NextRow()
+	if prevRow == nextRow || nextRow != s.NumRows()-1 {
+		return nil
+	}
+	if repo, ok := s.(*reposection.Model); ok {
+		return tea.Batch(repo.LoadMoreBranches()...)
+	}
+	return tea.Batch(s.FetchNextPageSectionRows()...)
+}
@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"fmt"
+	"reflect"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tasks"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// openItemForm opens the form creating a PR or an issue, in the repo of the
+// selected row or else the one we're in
+func (m *Model) openItemForm(isPR bool) tea.Cmd {
+	repo := ""
+	if row := m.getCurrRowData(); row != nil && !reflect.ValueOf(row).IsNil() {
+		repo = row.GetRepoNameWithOwner()
+	} else if owner, name, ok := m.ctx.GetOriginRepo(); ok {
+		repo = fmt.Sprintf("%s/%s", owner, name)
+	}
+
+	m.itemForm.SetWidth(min(80, m.ctx.ScreenWidth-4))
+	cmd := m.itemForm.Open(isPR, repo)
+	m.focus.Push(focus.Form)
+	return cmd
+}
+
+// createItem creates the item of the submitted form, inserting it into the
+// current section once it's created
+func (m *Model) createItem(item data.NewItem) tea.Cmd {
+	currSection := m.getCurrSection()
+	if currSection == nil {
+		return m.notifyErr("There's no section to add the new item to")
+	}
+	sid := tasks.SectionIdentifier{Id: currSection.GetId(), Type: currSection.GetType()}
+	return tasks.CreateItem(m.ctx, sid, item)
+}
@@ -0,0 +1,194 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+	"github.com/gen2brain/beeep"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+)
+
+// watchedSection is what a section with notify listed so far
+type watchedSection struct {
+	filters string
+	seen    map[string]bool
+}
+
+// watcher tracks the rows of the sections with notify, by view and title, to
+// tell which rows of a refresh are new
+type watcher map[string]*watchedSection
+
+// diff records the rows a section listed and returns the indices of the ones
+// it never listed before. Nothing is new the first time the section lists its
+// rows, or after its filters changed.
+func (w watcher) diff(key, filters string, urls []string) []int {
+	watched, ok := w[key]
+	if !ok || watched.filters != filters {
+		watched = &watchedSection{filters: filters, seen: map[string]bool{}}
+		w[key] = watched
+		for _, url := range urls {
+			watched.seen[url] = true
+		}
+		return nil
+	}
+
+	var fresh []int
+	for i, url := range urls {
+		if !watched.seen[url] {
+			watched.seen[url] = true
+			fresh = append(fresh, i)
+		}
+	}
+	return fresh
+}
+
+// newRow is a row announced by a notification
+type newRow struct {
+	Section string
+	Type    string
+	Repo    string
+	Number  int
+	Title   string
+	Author  string
+	Url     string
+}
+
+// notifyNewRows announces the rows that showed up in a section with notify
+// when it was refreshed in the background. It has to see the fetched rows
+// before the section does, while the section is still refreshing.
+func (m *Model) notifyNewRows(id int, msg tea.Msg) tea.Cmd {
+	if m.ctx.ReadOnly || id == 0 {
+		return nil
+	}
+
+	var (
+		view                        config.ViewType
+		cfg                         config.SectionConfig
+		taskId, lastTaskId, filters string
+		refreshing                  bool
+		rows                        []newRow
+	)
+	switch msg := msg.(type) {
+	case prssection.SectionPullRequestsFetchedMsg:
+		s, ok := m.prs[id].(*prssection.Model)
+		if !ok || !s.Config.Notify || msg.IsCached() || msg.Offline {
+			return nil
+		}
+		view, cfg, taskId, lastTaskId, filters = config.PRsView, s.Config, msg.TaskId,
+			s.LastFetchTaskId, s.GetFilters()
+		refreshing = s.GetIsRefreshing()
+		for _, pr := range msg.Prs {
+			if pr.Primary == nil {
+				continue
+			}
+			rows = append(rows, newRow{
+				Type:   "pr",
+				Repo:   pr.Primary.GetRepoNameWithOwner(),
+				Number: pr.Primary.Number,
+				Title:  pr.Primary.Title,
+				Author: pr.Primary.Author.Login,
+				Url:    pr.Primary.Url,
+			})
+		}
+	case issuessection.SectionIssuesFetchedMsg:
+		s, ok := m.issues[id].(*issuessection.Model)
+		if !ok || !s.Config.Notify || msg.IsCached() || msg.Offline {
+			return nil
+		}
+		view, cfg, taskId, lastTaskId, filters = config.IssuesView, s.Config, msg.TaskId,
+			s.LastFetchTaskId, s.GetFilters()
+		refreshing = s.GetIsRefreshing()
+		for _, issue := range msg.Issues {
+			rows = append(rows, newRow{
+				Type:   "issue",
+				Repo:   issue.GetRepoNameWithOwner(),
+				Number: issue.Number,
+				Title:  issue.Title,
+				Author: issue.Author.Login,
+				Url:    issue.Url,
+			})
+		}
+	default:
+		return nil
+	}
+	if taskId != lastTaskId {
+		return nil
+	}
+
+	urls := make([]string, len(rows))
+	for i, row := range rows {
+		urls[i] = row.Url
+	}
+	fresh := m.watched.diff(string(view)+"/"+cfg.Title, filters, urls)
+	// the rows fetched when the section is first loaded, searched or paged
+	// through were asked for, only a refresh brings news
+	if !refreshing || len(fresh) == 0 {
+		return nil
+	}
+
+	newRows := make([]newRow, 0, len(fresh))
+	for _, i := range fresh {
+		row := rows[i]
+		row.Section = cfg.Title
+		newRows = append(newRows, row)
+	}
+	log.Info("New rows in a section", "section", cfg.Title, "count", len(newRows))
+
+	text := fmt.Sprintf("%d new in %s", len(newRows), cfg.Title)
+	if len(newRows) == 1 {
+		text = fmt.Sprintf("New in %s: %s#%d", cfg.Title, newRows[0].Repo, newRows[0].Number)
+	}
+	return tea.Batch(m.notify(text), sendNotifications(m.ctx.Config.Notifications, newRows))
+}
+
+// sendNotifications announces rows the ways the config asks for
+func sendNotifications(cfg config.NotificationsConfig, rows []newRow) tea.Cmd {
+	return func() tea.Msg {
+		if cfg.Bell {
+			// the bell is rung once for all the rows, on stderr to stay out
+			// of the way of the program's rendering
+			fmt.Fprint(os.Stderr, "\a")
+		}
+		for _, row := range rows {
+			if cfg.IsDesktop() {
+				body := fmt.Sprintf("%s#%d %s\nby %s", row.Repo, row.Number, row.Title, row.Author)
+				if err := beeep.Notify("gh-dash: "+row.Section, body, ""); err != nil {
+					log.Error("Failed sending a desktop notification", "err", err)
+				}
+			}
+			if cfg.Hook != "" {
+				runNotifyHook(cfg.Hook, row)
+			}
+		}
+		return nil
+	}
+}
+
+// runNotifyHook runs the hook of the config with row in its environment
+func runNotifyHook(hook string, row newRow) {
+	shell := os.Getenv("SHELL")
+	if shell == "" {
+		shell = "sh"
+	}
+	c := exec.Command(shell, "-c", hook)
+	c.Env = append(os.Environ(),
+		"GH_DASH_SECTION="+row.Section,
+		"GH_DASH_TYPE="+row.Type,
+		"GH_DASH_REPO="+row.Repo,
+		"GH_DASH_NUMBER="+strconv.Itoa(row.Number),
+		"GH_DASH_TITLE="+row.Title,
+		"GH_DASH_AUTHOR="+row.Author,
+		"GH_DASH_URL="+row.Url,
+	)
+	if out, err := c.CombinedOutput(); err != nil {
+		log.Error("Failed running the notification hook", "hook", hook, "err", err,
+			"output", string(out))
+	}
+}
@@ -0,0 +1,60 @@
+package tui
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWatcherDiff(t *testing.T) {
+	type fetch struct {
+		filters string
+		urls    []string
+		want    []int
+	}
+	tests := []struct {
+		name    string
+		fetches []fetch
+	}{
+		{
+			name: "first fetch is the baseline",
+			fetches: []fetch{
+				{filters: "is:open", urls: []string{"a", "b"}},
+			},
+		},
+		{
+			name: "rows not seen before are new",
+			fetches: []fetch{
+				{filters: "is:open", urls: []string{"a", "b"}},
+				{filters: "is:open", urls: []string{"c", "a", "d"}, want: []int{0, 2}},
+			},
+		},
+		{
+			name: "rows that left and came back aren't new",
+			fetches: []fetch{
+				{filters: "is:open", urls: []string{"a", "b"}},
+				{filters: "is:open", urls: []string{"a"}},
+				{filters: "is:open", urls: []string{"b", "a"}},
+			},
+		},
+		{
+			name: "changed filters start a new baseline",
+			fetches: []fetch{
+				{filters: "is:open", urls: []string{"a"}},
+				{filters: "is:closed", urls: []string{"b", "c"}},
+				{filters: "is:closed", urls: []string{"a", "b"}, want: []int{0}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := watcher{}
+			for i, f := range tt.fetches {
+				got := w.diff("prs/Mine", f.filters, f.urls)
+				if !slices.Equal(got, f.want) {
+					t.Errorf("fetch %d: diff() = %v, want %v", i, got, f.want)
+				}
+			}
+		})
+	}
+}
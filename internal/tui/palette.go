@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/palette"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+// paletteRecentCommands is how many recent actions the palette lists first
+const paletteRecentCommands = 5
+
+// paletteCommands returns the commands of the current view: the recent
+// actions, the sections to jump to and every action bound to a key
+func (m *Model) paletteCommands() []palette.Command {
+	var commands []palette.Command
+
+	recent := m.history.ForView(m.ctx.View)
+	for _, e := range recent[:min(len(recent), paletteRecentCommands)] {
+		commands = append(commands, palette.Command{
+			Kind:  palette.Recent,
+			Title: e.Desc,
+			Key:   e.Key,
+			Desc:  e.Target,
+		})
+	}
+
+	for _, s := range m.getCurrentViewSections() {
+		title := s.GetConfig().Title
+		if title == "" {
+			continue
+		}
+		commands = append(commands, palette.Command{
+			Kind:      palette.Section,
+			Title:     title,
+			SectionId: s.GetId(),
+		})
+	}
+
+	for _, binding := range keys.ViewBindings(m.ctx.View) {
+		if !binding.Enabled() || binding.Help().Desc == "" ||
+			slices.Equal(binding.Keys(), m.keys.Palette.Keys()) {
+			continue
+		}
+		commands = append(commands, palette.Command{
+			Kind:  palette.Action,
+			Title: binding.Help().Desc,
+			Key:   keys.ChordMsg(strings.Split(binding.Keys()[0], keys.ChordSeparator)),
+		})
+	}
+
+	return commands
+}
+
+// runPaletteCommand jumps to the section of the command, or handles its key
+// as if it was pressed
+func (m Model) runPaletteCommand(command palette.Command) (tea.Model, tea.Cmd) {
+	if command.Kind == palette.Section {
+		m.setCurrSectionId(command.SectionId)
+		return m, m.onViewedRowChanged()
+	}
+
+	log.Info("Running command from palette", "key", command.Key.String(), "title", command.Title)
+	return m.replayKey(command.Key)
+}
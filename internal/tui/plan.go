@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/planner"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// openPlanner shows the overlay planning the review requests of the PRs view
+func (m *Model) openPlanner() tea.Cmd {
+	if m.ctx.ReviewPlan == nil {
+		return m.notifyErr("The review plan can't be saved, so it's disabled")
+	}
+	m.planner.Open(m.reviewRequests())
+	m.focus.Push(focus.Palette)
+	return nil
+}
+
+// reviewRequests returns the PRs of the sections searching for review
+// requests, or of the current section if none does
+func (m *Model) reviewRequests() []state.PlannedReview {
+	var reviews []state.PlannedReview
+	seen := map[string]bool{}
+	add := func(s *prssection.Model) {
+		for _, pr := range s.Prs {
+			if pr.Primary == nil || seen[pr.Primary.Url] {
+				continue
+			}
+			seen[pr.Primary.Url] = true
+			reviews = append(reviews, state.PlannedReview{
+				Url:    pr.Primary.Url,
+				Title:  pr.Primary.Title,
+				Repo:   pr.Primary.GetRepoNameWithOwner(),
+				Number: pr.Primary.Number,
+			})
+		}
+	}
+
+	for _, s := range m.prs {
+		prs, ok := s.(*prssection.Model)
+		if !ok || prs.PlanBucket != "" || !strings.Contains(prs.GetFilters(), "review-requested:") {
+			continue
+		}
+		add(prs)
+	}
+	if len(reviews) == 0 {
+		if prs, ok := m.getCurrSection().(*prssection.Model); ok {
+			add(prs)
+		}
+	}
+	return reviews
+}
+
+// onPlannerClosed saves the review plan and rebuilds the PR sections, the
+// plan sections depend on it
+func (m *Model) onPlannerClosed(msg planner.ClosedMsg) tea.Cmd {
+	if !msg.Changed {
+		return nil
+	}
+
+	reviewPlan := m.ctx.ReviewPlan
+	save := func() tea.Msg {
+		if err := reviewPlan.Save(); err != nil {
+			log.Error("Failed saving review plan", "err", err)
+		}
+		return nil
+	}
+	if m.ctx.View != config.PRsView {
+		return save
+	}
+
+	newSections, fetchCmd := m.fetchAllViewSections()
+	setCmd := m.setCurrentViewSections(newSections)
+	// the plan section that was shown may be gone
+	if m.currSectionId >= len(m.prs) {
+		m.setCurrSectionId(len(m.prs) - 1)
+	}
+	return tea.Batch(save, fetchCmd, setCmd)
+}
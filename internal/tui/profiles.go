@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
+)
+
+// applyProfiles applies the profiles matching the terminal, or the one
+// picked with --profile, over the base config. It returns whether the
+// applied profiles changed.
+func (m *Model) applyProfiles() bool {
+	term := config.CurrentTerminal(m.ctx.ScreenWidth, m.ctx.ScreenHeight)
+	// an unknown --profile was reported when the config was parsed
+	profiles, _ := m.baseConfig.ActiveProfiles(m.ctx.Profile, term)
+	cfg, err := m.baseConfig.WithProfiles(profiles)
+	if err != nil {
+		log.Error("Failed applying the config profiles, ignoring them", "err", err)
+		cfg, profiles = m.baseConfig, nil
+	}
+
+	names := make([]string, 0, len(profiles))
+	for _, profile := range profiles {
+		names = append(names, profile.Name)
+	}
+	if m.ctx.Config != nil && slices.Equal(names, m.profiles) {
+		return false
+	}
+
+	log.Info("Applying config profiles", "profiles", names)
+	m.profiles = names
+	m.ctx.Config = &cfg
+	m.ctx.Theme = theme.ParseTheme(m.ctx.Config)
+	m.ctx.Styles = context.InitStyles(m.ctx.Theme)
+	return true
+}
+
+// syncProfiles applies the profiles matching the resized terminal, the
+// sections are built again when they changed as their columns may have too
+func (m *Model) syncProfiles() tea.Cmd {
+	// the config isn't loaded yet, it's resolved once it is
+	if m.ctx.Config == nil {
+		return nil
+	}
+
+	previewOpen := m.ctx.Config.Defaults.Preview.Open
+	if !m.applyProfiles() {
+		return nil
+	}
+	if !m.ctx.Mini && m.ctx.Config.Defaults.Preview.Open != previewOpen {
+		m.sidebar.IsOpen = m.ctx.Config.Defaults.Preview.Open
+	}
+	m.syncMainContentWidth()
+	return m.rebuildSections()
+}
+
+// setBaseConfig sets the config parsed from the config file, the one the
+// profiles are applied over, with the preview as wide as it was resized to
+func (m *Model) setBaseConfig(cfg config.Config) {
+	m.baseConfig = cfg
+	// the profiles can still size the preview for the terminals they match
+	if m.layout.PreviewWidth > 0 {
+		m.baseConfig.Defaults.Preview.Width = m.layout.PreviewWidth
+	}
+	if m.ctx.Mini {
+		m.baseConfig.Theme.Ui.Table.Compact = true
+		m.baseConfig.Theme.Ui.Table.ShowSeparator = false
+	}
+}
+
+// rebuildSections builds the sections of the current view again from the
+// config, and fetches their rows
+func (m *Model) rebuildSections() tea.Cmd {
+	// the other views build their sections again when they're switched to
+	m.prs, m.issues, m.workflows, m.feeds = nil, nil, nil, nil
+	m.discussions, m.releases, m.dependencies, m.archive = nil, nil, nil, nil
+	newSections, fetchSectionsCmds := m.fetchAllViewSections()
+	return tea.Batch(fetchSectionsCmds, m.setCurrentViewSections(newSections))
+}
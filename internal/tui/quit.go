@@ -0,0 +1,193 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// fetchTaskPrefix starts the ids of the tasks fetching rows, nothing is lost
+// when they're cut short
+const fetchTaskPrefix = "fetching_"
+
+// quitPrompt lists what quitting would lose, it's shown instead of quitting
+// right away
+type quitPrompt struct {
+	// drafts are the comments and reviews being written
+	drafts []string
+	// searches are the titles of the sections whose edited search the next
+	// launch won't restore
+	searches []string
+	// waiting is set once asked to quit when the tasks are done
+	waiting bool
+}
+
+// pendingTasks returns the tasks changing something that are still running
+// or queued until GitHub can be reached, oldest first
+func pendingTasks(tasks map[string]context.Task) []context.Task {
+	var pending []context.Task
+	for _, task := range tasks {
+		if task.State != context.TaskStart && task.State != context.TaskQueued ||
+			strings.HasPrefix(task.Id, fetchTaskPrefix) {
+			continue
+		}
+		pending = append(pending, task)
+	}
+	slices.SortFunc(pending, func(a, b context.Task) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	return pending
+}
+
+// newQuitPrompt lists the drafts and the edited searches quitting would lose
+func (m *Model) newQuitPrompt() quitPrompt {
+	var prompt quitPrompt
+	if m.prView.HasDraft() {
+		prompt.drafts = append(prompt.drafts, "A comment on the PR in the sidebar")
+	}
+	if m.issueSidebar.HasDraft() {
+		prompt.drafts = append(prompt.drafts, "A comment on the issue in the sidebar")
+	}
+	if m.discussionSidebar.HasDraft() {
+		prompt.drafts = append(prompt.drafts, "A comment on the discussion in the sidebar")
+	}
+	for _, s := range m.prs {
+		if r, ok := s.(interface{ HasReviewDraft() bool }); ok && r.HasReviewDraft() {
+			prompt.drafts = append(prompt.drafts, "A review in "+s.GetConfig().Title)
+		}
+	}
+
+	// the searches are kept when the session is restored
+	if m.ctx.Config.RestoreSession && !m.ctx.ReadOnly {
+		return prompt
+	}
+	for _, view := range config.ViewTypes {
+		if view == config.RepoView {
+			continue
+		}
+		for _, s := range m.getViewSections(view) {
+			if edited, ok := s.(interface{ IsSearchEdited() bool }); ok && edited.IsSearchEdited() {
+				prompt.searches = append(prompt.searches, s.GetConfig().Title)
+			}
+		}
+	}
+	return prompt
+}
+
+// quit quits the dashboard, unless running tasks, drafts or edited searches
+// would be lost or confirmQuit is on, it then asks first
+func (m *Model) quit() tea.Cmd {
+	prompt := m.newQuitPrompt()
+	if len(pendingTasks(m.tasks)) == 0 && len(prompt.drafts) == 0 && len(prompt.searches) == 0 {
+		if !m.ctx.Config.ConfirmQuit {
+			return tea.Quit
+		}
+		m.footer.SetShowConfirmQuit(true)
+		m.focus.Push(focus.Confirm)
+		return nil
+	}
+
+	m.quitPrompt = &prompt
+	m.focus.Push(focus.Confirm)
+	return nil
+}
+
+// updateQuitConfirm handles a key pressed while quitting waits for a
+// confirmation. Waiting for the tasks or discarding what would be lost
+// quits, any other key cancels.
+func (m *Model) updateQuitConfirm(msg tea.KeyMsg) tea.Cmd {
+	if m.quitPrompt == nil {
+		m.focus.Remove(focus.Confirm)
+		m.footer.SetShowConfirmQuit(false)
+		if msg.String() == "y" || msg.String() == "enter" {
+			return tea.Quit
+		}
+		return nil
+	}
+
+	switch msg.String() {
+	case "w":
+		if !m.quitPrompt.waiting && len(pendingTasks(m.tasks)) > 0 {
+			log.Info("Quitting once the tasks are done", "tasks", len(pendingTasks(m.tasks)))
+			m.quitPrompt.waiting = true
+			return nil
+		}
+	case "d":
+		log.Info("Quitting, discarding the running tasks and drafts")
+		return tea.Quit
+	}
+	m.quitPrompt = nil
+	m.focus.Remove(focus.Confirm)
+	return nil
+}
+
+// quitWhenIdle quits once the tasks the quit prompt waits for are done
+func (m *Model) quitWhenIdle() tea.Cmd {
+	if m.quitPrompt == nil || !m.quitPrompt.waiting || len(pendingTasks(m.tasks)) > 0 {
+		return nil
+	}
+	return tea.Quit
+}
+
+func (m *Model) renderQuitPrompt() string {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(m.ctx.Theme.PrimaryText)
+	headerStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.SecondaryText)
+	itemStyle := lipgloss.NewStyle().Foreground(m.ctx.Theme.FaintText)
+
+	var b strings.Builder
+	writeList := func(header string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		b.WriteString("\n")
+		b.WriteString(headerStyle.Render(header))
+		b.WriteString("\n")
+		for _, item := range items {
+			b.WriteString(itemStyle.Render("  • " + item))
+			b.WriteString("\n")
+		}
+	}
+
+	tasks := pendingTasks(m.tasks)
+	taskTexts := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		text := task.StartText
+		if task.State == context.TaskQueued {
+			text += " (queued)"
+		}
+		taskTexts = append(taskTexts, text)
+	}
+
+	help := "d: discard and quit • esc: cancel"
+	if m.quitPrompt.waiting {
+		b.WriteString(titleStyle.Render("Quitting once the tasks are done"))
+		b.WriteString("\n")
+		writeList("Running", taskTexts)
+		help = "d: quit now • esc: cancel"
+	} else {
+		b.WriteString(titleStyle.Render("Quit? This will be lost"))
+		b.WriteString("\n")
+		writeList("Running tasks", taskTexts)
+		writeList("Drafts", m.quitPrompt.drafts)
+		writeList("Edited searches", m.quitPrompt.searches)
+		if len(tasks) > 0 {
+			help = "w: wait for the tasks • " + help
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(lipgloss.NewStyle().Faint(true).Render(help))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(m.ctx.Theme.PrimaryBorder).
+		Padding(1, 2).
+		Width(min(70, m.ctx.ScreenWidth-4)).
+		Render(b.String())
+}
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+)
+
+func TestPendingTasks(t *testing.T) {
+	now := time.Now()
+	tasks := map[string]context.Task{
+		"pr_merge_12": {
+			Id:        "pr_merge_12",
+			State:     context.TaskStart,
+			StartTime: now.Add(-time.Second),
+		},
+		"issue_close_3": {
+			Id:        "issue_close_3",
+			State:     context.TaskQueued,
+			StartTime: now.Add(-time.Minute),
+		},
+		"pr_approve_7": {
+			Id:        "pr_approve_7",
+			State:     context.TaskFinished,
+			StartTime: now.Add(-time.Hour),
+		},
+		"pr_label_9": {
+			Id:        "pr_label_9",
+			State:     context.TaskError,
+			StartTime: now.Add(-time.Hour),
+		},
+		"fetching_prs_1_": {
+			Id:        "fetching_prs_1_",
+			State:     context.TaskStart,
+			StartTime: now,
+		},
+	}
+
+	var ids []string
+	for _, task := range pendingTasks(tasks) {
+		ids = append(ids, task.Id)
+	}
+	if want := []string{"issue_close_3", "pr_merge_12"}; !slices.Equal(ids, want) {
+		t.Errorf("pendingTasks() = %v, want %v", ids, want)
+	}
+}
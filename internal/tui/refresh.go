@@ -0,0 +1,142 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/archivesection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/dependenciessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/feedssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releasessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/workflowssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// sectionRefreshRetry is how long to wait before trying again to refresh a
+// section the user is busy in, e.g. searching
+const sectionRefreshRetry = 30 * time.Second
+
+type sectionRefreshMsg struct {
+	view      config.ViewType
+	sectionId int
+	gen       int
+}
+
+// refetchInterval returns how often the section is refetched, its own
+// refetchIntervalMinutes overrides the default one. Zero disables refetching.
+func (m *Model) refetchInterval(s section.Section) time.Duration {
+	minutes := m.ctx.Config.Defaults.RefetchIntervalMinutes
+	if cfg := s.GetConfig(); cfg.RefetchIntervalMinutes != nil {
+		minutes = *cfg.RefetchIntervalMinutes
+	}
+	return time.Duration(max(minutes, 0)) * time.Minute
+}
+
+// scheduleSectionRefreshes starts the refresh timers of the sections of the
+// current view, the timers of the sections they replaced are stopped
+func (m *Model) scheduleSectionRefreshes() tea.Cmd {
+	// the repo view refetches its branches on its own
+	if m.ctx.View == config.RepoView {
+		return nil
+	}
+
+	m.refreshGen[m.ctx.View]++
+	var cmds []tea.Cmd
+	for _, s := range m.getCurrentViewSections() {
+		if s == nil {
+			continue
+		}
+		cmds = append(cmds, m.scheduleSectionRefresh(m.ctx.View, s,
+			m.refetchInterval(s)-time.Since(s.LastUpdated())))
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m *Model) scheduleSectionRefresh(view config.ViewType, s section.Section, after time.Duration) tea.Cmd {
+	if m.refetchInterval(s) == 0 {
+		return nil
+	}
+
+	msg := sectionRefreshMsg{view: view, sectionId: s.GetId(), gen: m.refreshGen[view]}
+	return tea.Tick(max(after, 0), func(time.Time) tea.Msg {
+		return msg
+	})
+}
+
+// refreshSection refetches the section's rows in the background, keeping its
+// current rows shown until the fetch completes
+func (m *Model) refreshSection(msg sectionRefreshMsg) tea.Cmd {
+	// the timer restarts when the user switches back to the view
+	if msg.gen != m.refreshGen[msg.view] || msg.view != m.ctx.View {
+		return nil
+	}
+	sections := m.getViewSections(msg.view)
+	if msg.sectionId >= len(sections) || sections[msg.sectionId] == nil {
+		return nil
+	}
+
+	s := sections[msg.sectionId]
+	interval := m.refetchInterval(s)
+	if interval == 0 {
+		return nil
+	}
+
+	// debounce sections that were fetched since, e.g. by a manual refresh
+	if remaining := interval - time.Since(s.LastUpdated()); remaining > time.Second {
+		return m.scheduleSectionRefresh(msg.view, s, remaining)
+	}
+
+	// spare the API quota until it resets
+	if until, throttled := data.ThrottledUntil(); throttled {
+		log.Debug("Pausing section refresh until the rate limit resets", "view", msg.view,
+			"id", s.GetId(), "until", until)
+		return m.scheduleSectionRefresh(msg.view, s, time.Until(until)+time.Second)
+	}
+
+	// don't swap the rows under a user who's searching or answering a prompt
+	if s.FocusedMode() != focus.Table {
+		return m.scheduleSectionRefresh(msg.view, s, sectionRefreshRetry)
+	}
+
+	log.Debug("Refreshing section", "view", msg.view, "id", s.GetId())
+	s.SetIsRefreshing(true)
+	s.ResetPageInfo()
+	cmds := s.FetchNextPageSectionRows()
+	cmds = append(cmds, m.scheduleSectionRefresh(msg.view, s, interval))
+	return tea.Batch(cmds...)
+}
+
+// stopRefreshing clears the refreshing indicator of a section whose fetch
+// failed, the next refresh tries again
+func (m *Model) stopRefreshing(sectionId int, sectionType string) {
+	var sections []section.Section
+	switch sectionType {
+	case prssection.SectionType:
+		sections = m.prs
+	case issuessection.SectionType:
+		sections = m.issues
+	case workflowssection.SectionType:
+		sections = m.workflows
+	case feedssection.SectionType:
+		sections = m.feeds
+	case discussionssection.SectionType:
+		sections = m.discussions
+	case releasessection.SectionType:
+		sections = m.releases
+	case dependenciessection.SectionType:
+		sections = m.dependencies
+	case archivesection.SectionType:
+		sections = m.archive
+	}
+	if sectionId < len(sections) && sections[sectionId] != nil {
+		sections[sectionId].SetIsRefreshing(false)
+	}
+}
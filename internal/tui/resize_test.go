@@ -0,0 +1,61 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+)
+
+func TestResizeBurst(t *testing.T) {
+	setupTest(t)
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	m := NewModel(config.Location{})
+
+	// the first size lays the screen out right away
+	m.debounceWindowSizeChanged(tea.WindowSizeMsg{Width: 100, Height: 40})
+	if m.ctx.ScreenWidth != 100 || m.ctx.ScreenHeight != 40 {
+		t.Fatalf("screen = %dx%d, want the first size 100x40", m.ctx.ScreenWidth, m.ctx.ScreenHeight)
+	}
+	frame := m.View()
+
+	var ticks []resizeDebouncedMsg
+	for _, size := range []tea.WindowSizeMsg{
+		{Width: 110, Height: 41},
+		{Width: 120, Height: 42},
+		{Width: 130, Height: 43},
+	} {
+		ticks = append(ticks, m.debounceWindowSizeChanged(size)().(resizeDebouncedMsg))
+		if m.ctx.ScreenWidth != 100 || m.ctx.ScreenHeight != 40 {
+			t.Fatalf("screen = %dx%d during the burst, want it laid out once it settles",
+				m.ctx.ScreenWidth, m.ctx.ScreenHeight)
+		}
+		if m.View() != frame {
+			t.Fatal("View() rendered the screen again during the burst, want the last frame")
+		}
+	}
+
+	// the ticks of the earlier sizes don't lay the screen out
+	for _, tick := range ticks[:len(ticks)-1] {
+		m.applyPendingResize(tick)
+		if m.ctx.ScreenWidth != 100 {
+			t.Fatalf("screen width = %d after a stale tick, want 100", m.ctx.ScreenWidth)
+		}
+	}
+
+	m.applyPendingResize(ticks[len(ticks)-1])
+	if m.ctx.ScreenWidth != 130 || m.ctx.ScreenHeight != 43 {
+		t.Errorf("screen = %dx%d, want the final size 130x43", m.ctx.ScreenWidth, m.ctx.ScreenHeight)
+	}
+	if m.View() == frame {
+		t.Error("View() = the frame of the first size after the burst, want it rendered again")
+	}
+
+	// the final tick is applied once
+	m.ctx.ScreenWidth = 0
+	m.applyPendingResize(ticks[len(ticks)-1])
+	if m.ctx.ScreenWidth != 0 {
+		t.Error("the final size was laid out twice")
+	}
+}
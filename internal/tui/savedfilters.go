@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// openFilterPicker shows the overlay stacking the saved filters of the config
+// on the search of the current section
+func (m *Model) openFilterPicker() tea.Cmd {
+	s, ok := m.getCurrSection().(section.SavedFilters)
+	if !ok {
+		return nil
+	}
+	m.filterPicker.SetWidth(min(60, m.ctx.ScreenWidth-4))
+	m.filterPicker.Open(s.AppliedSavedFilters())
+	m.focus.Push(focus.Palette)
+	return nil
+}
+
+// applySavedFilters stacks the saved filters names on the search of the
+// current section, taking the other ones off, and refetches it
+func (m *Model) applySavedFilters(names []string) tea.Cmd {
+	currSection := m.getCurrSection()
+	s, ok := currSection.(section.SavedFilters)
+	if !ok || !s.SetSavedFilters(names) {
+		return nil
+	}
+
+	log.Info("Applied saved filters", "section", currSection.GetConfig().Title, "filters", names)
+	text := "Cleared the saved filters"
+	if len(names) > 0 {
+		text = fmt.Sprintf("Applied %s", strings.Join(names, ", "))
+	}
+	currSection.SetIsSearching(false)
+	currSection.ResetRows()
+	return tea.Batch(append(currSection.FetchNextPageSectionRows(), m.notify(text))...)
+}
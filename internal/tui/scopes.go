@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
+)
+
+// tokenScopesMsg carries the scopes of the token the dashboard uses
+type tokenScopesMsg struct {
+	scopes data.TokenScopes
+	err    error
+}
+
+func fetchTokenScopes() tea.Msg {
+	scopes, err := data.FetchTokenScopes()
+	return tokenScopesMsg{scopes: scopes, err: err}
+}
+
+func (m *Model) onTokenScopes(msg tokenScopesMsg) {
+	if msg.err != nil {
+		// the actions stay available, GitHub refuses the ones not allowed
+		log.Error("Failed fetching the token scopes", "err", msg.err)
+		return
+	}
+	if !msg.scopes.Known {
+		log.Debug("The token doesn't tell its scopes, every action stays available")
+		return
+	}
+
+	log.Info("Token scopes", "scopes", msg.scopes.Scopes)
+	m.tokenScopes = msg.scopes
+	keys.MarkUnavailable(m.tokenScopes.HasAny)
+}
+
+// checkTokenScopes tells why the action of msg can't be done when the token
+// lacks the scope it needs, nil when it has it
+func (m *Model) checkTokenScopes(msg tea.KeyMsg) tea.Cmd {
+	binding, missing := keys.MissingScopes(m.ctx.View, msg, m.tokenScopes.HasAny)
+	if missing == nil {
+		return nil
+	}
+	return m.notifyErr(fmt.Sprintf("Your token lacks the %s scope needed to %s, run gh auth refresh -s %s",
+		strings.Join(missing, " or "), keys.HelpDesc(binding), missing[0]))
+}
+
+// explainForbidden adds a hint to the errors of the actions GitHub refused
+// because of the token's permissions, instead of a bare 403
+func explainForbidden(err error) error {
+	if !data.IsForbidden(err) {
+		return err
+	}
+	return fmt.Errorf("the token isn't allowed to do this, check its scopes with gh auth status: %w", err)
+}
@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/sectioneditor"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// configLocation returns the location the config is parsed at, with the
+// overrides passed on the command line
+func (m *Model) configLocation() config.Location {
+	return config.Location{
+		RepoPath:   m.ctx.RepoPath,
+		ConfigFlag: m.ctx.ConfigFlag,
+		Section:    m.startSection,
+		View:       m.startView,
+		Filters:    m.startFilters,
+	}
+}
+
+// openSectionEditor shows the overlay editing the sections of the PRs or
+// issues view, the cursor on the current section
+func (m *Model) openSectionEditor() tea.Cmd {
+	view := m.ctx.View
+	if view != config.PRsView && view != config.IssuesView {
+		return m.notifyErr("Only PR and issue sections can be edited")
+	}
+	m.sectionEditor.SetWidth(min(90, m.ctx.ScreenWidth-4))
+	m.sectionEditor.Open(view, m.baseConfig.SectionDrafts(view), m.currSectionId-1)
+	m.focus.Push(focus.Palette)
+	return nil
+}
+
+func (m *Model) saveSection(msg sectioneditor.SavedMsg) tea.Cmd {
+	return m.editConfigSections(msg.View, func(f *config.ConfigFile) (int, error) {
+		index, err := f.SaveSection(msg.View, msg.Index, msg.Draft)
+		if msg.Draft.View != msg.View {
+			// the section left the listed view, the cursor stays in place
+			index = msg.Index
+		}
+		return index, err
+	})
+}
+
+func (m *Model) deleteSection(msg sectioneditor.DeletedMsg) tea.Cmd {
+	return m.editConfigSections(msg.View, func(f *config.ConfigFile) (int, error) {
+		return msg.Index, f.DeleteSection(msg.View, msg.Index)
+	})
+}
+
+func (m *Model) moveSection(msg sectioneditor.MovedMsg) tea.Cmd {
+	return m.editConfigSections(msg.View, func(f *config.ConfigFile) (int, error) {
+		return msg.To, f.MoveSection(msg.View, msg.From, msg.To)
+	})
+}
+
+// editConfigSections writes the edit of the sections of view to the config
+// file and reloads the config, the file is restored when it no longer
+// parses. edit returns the index of the section the cursor moves to.
+func (m *Model) editConfigSections(view config.ViewType, edit func(f *config.ConfigFile) (int, error)) tea.Cmd {
+	cursor, err := m.writeConfigSections(edit)
+	if err != nil {
+		log.Error("Failed editing the sections", "err", err)
+		m.sectionEditor.SetError(err)
+		return nil
+	}
+	m.sectionEditor.SetError(nil)
+	m.sectionEditor.SetSections(m.baseConfig.SectionDrafts(view), cursor)
+
+	if m.ctx.View == view {
+		count := len(m.baseConfig.SectionDrafts(view))
+		m.setCurrSectionId(max(1, min(cursor+1, count)))
+	}
+	return m.rebuildSections()
+}
+
+func (m *Model) writeConfigSections(edit func(f *config.ConfigFile) (int, error)) (int, error) {
+	// the file is edited without the overrides of the command line
+	location := config.Location{RepoPath: m.ctx.RepoPath, ConfigFlag: m.ctx.ConfigFlag}
+	path, err := config.ConfigPath(location)
+	if err != nil {
+		return 0, err
+	}
+	cfg, err := config.ParseConfig(location)
+	if err != nil {
+		return 0, err
+	}
+	original, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	f, err := config.OpenConfigFile(path, cfg)
+	if err != nil {
+		return 0, err
+	}
+	cursor, err := edit(f)
+	if err != nil {
+		return 0, err
+	}
+	if err := f.Write(); err != nil {
+		return 0, err
+	}
+
+	reloaded, err := config.ParseConfig(m.configLocation())
+	if err != nil {
+		if restoreErr := os.WriteFile(path, original, 0o644); restoreErr != nil {
+			log.Error("Failed restoring the config file", "path", path, "err", restoreErr)
+		}
+		return 0, fmt.Errorf("the edited config is invalid: %w", err)
+	}
+	log.Info("Edited the sections of the config file", "path", path)
+
+	m.setBaseConfig(reloaded)
+	// the profiles are applied again over the reloaded config
+	m.ctx.Config = nil
+	m.applyProfiles()
+	return cursor, nil
+}
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+)
+
+// restoreSession moves the dashboard to the view the last session in the
+// repo ended in and returns its section, 0 when nothing was restored. The
+// searches and cursors of the sections are restored as they're created. A
+// view, section or link passed on the command line wins over the session.
+func (m *Model) restoreSession() int {
+	if !m.ctx.Config.RestoreSession || m.ctx.ReadOnly || m.linkUrl != "" ||
+		m.startView != "" || m.startSection > 0 || m.startFilters != "" {
+		return 0
+	}
+
+	dir, err := state.Dir()
+	if err != nil {
+		log.Error("Failed resolving state dir, the last session isn't restored", "err", err)
+		return 0
+	}
+	session, ok := state.LoadSession(dir, m.ctx.RepoPath)
+	if !ok {
+		return 0
+	}
+	view, err := config.ParseViewType(session.View)
+	if err == nil {
+		err = m.ctx.Config.CheckView(view)
+	}
+	if err != nil {
+		log.Warn("Not restoring the last session", "view", session.View, "err", err)
+		return 0
+	}
+
+	log.Info("Restoring the last session", "view", view, "section", session.Section)
+	m.ctx.View = view
+	m.ctx.RestoredSession = &session
+	return session.Section
+}
+
+// SaveSession saves where the dashboard is at, the view, the section and the
+// searches and cursors of the sections, for the next launch to restore it
+// when restoreSession is on
+func (m Model) SaveSession() {
+	if m.ctx.Config == nil || !m.ctx.Config.RestoreSession || m.ctx.ReadOnly {
+		return
+	}
+
+	saved := state.SavedSession{SavedAt: time.Now(), Session: state.Session{
+		View:    string(m.ctx.View),
+		Section: m.currSectionId,
+	}}
+	for _, view := range config.ViewTypes {
+		if view == config.RepoView {
+			continue
+		}
+		for _, s := range m.getViewSections(view) {
+			if s, ok := s.(interface{ SessionState() state.SectionSession }); ok {
+				saved.Sections = append(saved.Sections, s.SessionState())
+			}
+		}
+	}
+	if m.ctx.RestoredSession != nil {
+		// the sections of the views that weren't shown keep their state
+		saved.Sections = append(saved.Sections, m.ctx.RestoredSession.Sections...)
+	}
+
+	dir, err := state.Dir()
+	if err == nil {
+		err = state.SaveSession(dir, m.ctx.RepoPath, saved)
+	}
+	if err != nil {
+		log.Error("Failed saving the session", "err", err)
+	}
+}
+
+// restoreCursor moves the cursor of s back to the row it was on in the last
+// session, once it has rows
+func restoreCursor(s section.Section) {
+	if s, ok := s.(interface{ RestoreCursor() }); ok {
+		s.RestoreCursor()
+	}
+}
@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/snoozepicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// snoozeCurrRow shows the overlay snoozing the selected row, or wakes it up
+// if it's a snoozed one shown by toggleShowSnoozed
+func (m *Model) snoozeCurrRow() tea.Cmd {
+	if m.ctx.Snoozed == nil {
+		return m.notifyErr("Snoozed items can't be saved, so snoozing is disabled")
+	}
+	if _, ok := m.getCurrSection().(section.Snoozable); !ok {
+		return nil
+	}
+	row := m.getCurrRowData()
+	if row == nil || reflect.ValueOf(row).IsNil() {
+		return nil
+	}
+
+	if m.ctx.Snoozed.IsSnoozed(row.GetUrl(), row.GetUpdatedAt(), time.Now()) {
+		m.ctx.Snoozed.Wake(row.GetUrl())
+		log.Info("Woke up snoozed item", "url", row.GetUrl())
+		return tea.Batch(m.saveSnoozed(), m.notify(fmt.Sprintf("Woke up #%d", row.GetNumber())))
+	}
+
+	m.snoozePicker.SetWidth(min(60, m.ctx.ScreenWidth-4))
+	m.snoozePicker.Open(row)
+	m.focus.Push(focus.Palette)
+	return nil
+}
+
+// snooze hides the row of msg from every PR and issue section until its
+// snooze ends or it's updated
+func (m *Model) snooze(msg snoozepicker.SnoozedMsg) tea.Cmd {
+	now := time.Now()
+	m.ctx.Snoozed.Snooze(msg.Row.GetUrl(), msg.Row.GetUpdatedAt(), msg.Option, now)
+	log.Info("Snoozed item", "url", msg.Row.GetUrl(), "option", msg.Option)
+
+	for _, s := range slices.Concat(m.prs, m.issues) {
+		if snoozable, ok := s.(section.Snoozable); ok {
+			snoozable.DropSnoozed()
+		}
+	}
+	text := fmt.Sprintf("Snoozed #%d until it's updated", msg.Row.GetNumber())
+	if until := msg.Option.Until(now); !until.IsZero() {
+		text = fmt.Sprintf("Snoozed #%d until %s", msg.Row.GetNumber(), until.Format("Mon, Jan 2 15:04"))
+	}
+	return tea.Batch(m.saveSnoozed(), m.onViewedRowChanged(), m.notify(text))
+}
+
+// toggleShowSnoozed shows the snoozed items in the PR and issue sections, or
+// hides them again, and refetches the sections
+func (m *Model) toggleShowSnoozed() tea.Cmd {
+	if m.ctx.Snoozed == nil {
+		return m.notifyErr("Snoozed items can't be saved, so snoozing is disabled")
+	}
+	m.ctx.ShowSnoozed = !m.ctx.ShowSnoozed
+
+	var cmds []tea.Cmd
+	for _, s := range slices.Concat(m.prs, m.issues) {
+		if _, ok := s.(section.Snoozable); !ok {
+			continue
+		}
+		s.ResetRows()
+		s.SetIsLoading(true)
+		cmds = append(cmds, s.FetchNextPageSectionRows()...)
+	}
+	m.syncSidebar()
+
+	text := "Hiding the snoozed items"
+	if m.ctx.ShowSnoozed {
+		text = "Showing the snoozed items"
+	}
+	return tea.Batch(append(cmds, m.notify(text))...)
+}
+
+func (m *Model) saveSnoozed() tea.Cmd {
+	snoozed := m.ctx.Snoozed
+	return func() tea.Msg {
+		if err := snoozed.Save(); err != nil {
+			log.Error("Failed saving snoozed items", "err", err)
+		}
+		return nil
+	}
+}
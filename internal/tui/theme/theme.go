@@ -2,6 +2,7 @@ package theme
 
 import (
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
@@ -25,6 +26,18 @@ type Theme struct {
 	MemberIconColor         lipgloss.AdaptiveColor // config.Theme.Colors.Icon.Member
 	OwnerIconColor          lipgloss.AdaptiveColor // config.Theme.Colors.Icon.Owner
 	UnknownRoleIconColor    lipgloss.AdaptiveColor // config.Theme.Colors.Icon.UnknownRole
+	OpenPRColor             lipgloss.AdaptiveColor // config.Theme.Colors.State.OpenPR
+	DraftPRColor            lipgloss.AdaptiveColor // config.Theme.Colors.State.DraftPR
+	MergedPRColor           lipgloss.AdaptiveColor // config.Theme.Colors.State.MergedPR
+	ClosedPRColor           lipgloss.AdaptiveColor // config.Theme.Colors.State.ClosedPR
+	OpenIssueColor          lipgloss.AdaptiveColor // config.Theme.Colors.State.OpenIssue
+	ClosedIssueColor        lipgloss.AdaptiveColor // config.Theme.Colors.State.ClosedIssue
+	OpenRowText             lipgloss.AdaptiveColor // config.Theme.Colors.Rows.Open
+	DraftRowText            lipgloss.AdaptiveColor // config.Theme.Colors.Rows.Draft
+	MergedRowText           lipgloss.AdaptiveColor // config.Theme.Colors.Rows.Merged
+	ClosedRowText           lipgloss.AdaptiveColor // config.Theme.Colors.Rows.Closed
+	FailingChecksRowText    lipgloss.AdaptiveColor // config.Theme.Colors.Rows.FailingChecks
+	StaleRowText            lipgloss.AdaptiveColor // config.Theme.Colors.Rows.Stale
 	NewContributorIcon      string                 // config.Theme.Icons.NewContributor
 	ContributorIcon         string                 // config.Theme.Icons.Contributor
 	CollaboratorIcon        string                 // config.Theme.Icons.Collaborator
@@ -51,6 +64,11 @@ var DefaultTheme = &Theme{
 	MemberIconColor:         lipgloss.AdaptiveColor{Light: "178", Dark: "178"},
 	OwnerIconColor:          lipgloss.AdaptiveColor{Light: "178", Dark: "178"},
 	UnknownRoleIconColor:    lipgloss.AdaptiveColor{Light: "178", Dark: "178"},
+	OpenPRColor:             lipgloss.AdaptiveColor{Light: "#42A0FA", Dark: "#42A0FA"},
+	MergedPRColor:           lipgloss.AdaptiveColor{Light: "#A371F7", Dark: "#A371F7"},
+	ClosedPRColor:           lipgloss.AdaptiveColor{Light: "#656C76", Dark: "#656C76"},
+	OpenIssueColor:          lipgloss.AdaptiveColor{Light: "#42A0FA", Dark: "#42A0FA"},
+	ClosedIssueColor:        lipgloss.AdaptiveColor{Light: "#C38080", Dark: "#C38080"},
 	NewContributorIcon:      constants.NewContributorIcon,
 	ContributorIcon:         constants.ContributorIcon,
 	CollaboratorIcon:        constants.CollaboratorIcon,
@@ -59,7 +77,62 @@ var DefaultTheme = &Theme{
 	UnknownRoleIcon:         constants.UnknownRoleIcon,
 }
 
+// RowState is the state the title of the row of a PR or an issue is colored
+// by
+type RowState int
+
+const (
+	// RowDefault rows keep the primary text color
+	RowDefault RowState = iota
+	RowOpen
+	RowDraft
+	RowMerged
+	RowClosed
+	RowFailingChecks
+	RowStale
+)
+
+// RowText returns the color of the titles of the rows in state, the primary
+// text color when the theme doesn't set one
+func (t Theme) RowText(state RowState) lipgloss.AdaptiveColor {
+	var color lipgloss.AdaptiveColor
+	switch state {
+	case RowOpen:
+		color = t.OpenRowText
+	case RowDraft:
+		color = t.DraftRowText
+	case RowMerged:
+		color = t.MergedRowText
+	case RowClosed:
+		color = t.ClosedRowText
+	case RowFailingChecks:
+		color = t.FailingChecksRowText
+	case RowStale:
+		color = t.StaleRowText
+	}
+	if color == (lipgloss.AdaptiveColor{}) {
+		return t.PrimaryText
+	}
+	return color
+}
+
+// ParseTheme returns the default theme with the colors and icons of the
+// config, over the ones of its palette when it names one
 func ParseTheme(cfg *config.Config) Theme {
+	t := *DefaultTheme
+	if cfg.Theme == nil {
+		return t
+	}
+	themeCfg := *cfg.Theme
+	if themeCfg.Name != "" {
+		palette, err := config.LoadThemePalette(themeCfg.Name)
+		if err != nil {
+			log.Error("Failed loading the theme palette, ignoring it", "theme", themeCfg.Name, "err", err)
+		} else {
+			themeCfg = themeCfg.WithPalette(palette)
+		}
+	}
+
 	_shimHex := func(hex config.HexColor, fallback lipgloss.AdaptiveColor) lipgloss.AdaptiveColor {
 		if hex == "" {
 			return fallback
@@ -73,99 +146,151 @@ func ParseTheme(cfg *config.Config) Theme {
 		return fallback
 	}
 
-	if cfg.Theme.Colors != nil {
-		DefaultTheme.SelectedBackground = _shimHex(
-			cfg.Theme.Colors.Inline.Background.Selected,
-			DefaultTheme.SelectedBackground,
+	if themeCfg.Colors != nil {
+		t.SelectedBackground = _shimHex(
+			themeCfg.Colors.Inline.Background.Selected,
+			t.SelectedBackground,
+		)
+		t.PrimaryBorder = _shimHex(
+			themeCfg.Colors.Inline.Border.Primary,
+			t.PrimaryBorder,
+		)
+		t.FaintBorder = _shimHex(
+			themeCfg.Colors.Inline.Border.Faint,
+			t.FaintBorder,
+		)
+		t.SecondaryBorder = _shimHex(
+			themeCfg.Colors.Inline.Border.Secondary,
+			t.SecondaryBorder,
+		)
+		t.FaintText = _shimHex(
+			themeCfg.Colors.Inline.Text.Faint,
+			t.FaintText,
+		)
+		t.PrimaryText = _shimHex(
+			themeCfg.Colors.Inline.Text.Primary,
+			t.PrimaryText,
+		)
+		t.SecondaryText = _shimHex(
+			themeCfg.Colors.Inline.Text.Secondary,
+			t.SecondaryText,
+		)
+		t.InvertedText = _shimHex(
+			themeCfg.Colors.Inline.Text.Inverted,
+			t.InvertedText,
+		)
+		t.SuccessText = _shimHex(
+			themeCfg.Colors.Inline.Text.Success,
+			t.SuccessText,
+		)
+		t.WarningText = _shimHex(
+			themeCfg.Colors.Inline.Text.Warning,
+			t.WarningText,
+		)
+		t.ErrorText = _shimHex(
+			themeCfg.Colors.Inline.Text.Error,
+			t.ErrorText,
+		)
+		t.NewContributorIconColor = _shimHex(
+			themeCfg.Colors.Inline.Icon.NewContributor,
+			t.NewContributorIconColor,
+		)
+		t.ContributorIconColor = _shimHex(
+			themeCfg.Colors.Inline.Icon.Contributor,
+			t.ContributorIconColor,
+		)
+		t.CollaboratorIconColor = _shimHex(
+			themeCfg.Colors.Inline.Icon.Collaborator,
+			t.CollaboratorIconColor,
 		)
-		DefaultTheme.PrimaryBorder = _shimHex(
-			cfg.Theme.Colors.Inline.Border.Primary,
-			DefaultTheme.PrimaryBorder,
+		t.MemberIconColor = _shimHex(
+			themeCfg.Colors.Inline.Icon.Member,
+			t.MemberIconColor,
 		)
-		DefaultTheme.FaintBorder = _shimHex(
-			cfg.Theme.Colors.Inline.Border.Faint,
-			DefaultTheme.FaintBorder,
+		t.OwnerIconColor = _shimHex(
+			themeCfg.Colors.Inline.Icon.Owner,
+			t.OwnerIconColor,
 		)
-		DefaultTheme.SecondaryBorder = _shimHex(
-			cfg.Theme.Colors.Inline.Border.Secondary,
-			DefaultTheme.SecondaryBorder,
+		t.UnknownRoleIconColor = _shimHex(
+			themeCfg.Colors.Inline.Icon.UnknownRole,
+			t.UnknownRoleIconColor,
 		)
-		DefaultTheme.FaintText = _shimHex(
-			cfg.Theme.Colors.Inline.Text.Faint,
-			DefaultTheme.FaintText,
+		t.OpenPRColor = _shimHex(
+			themeCfg.Colors.Inline.State.OpenPR,
+			t.OpenPRColor,
 		)
-		DefaultTheme.PrimaryText = _shimHex(
-			cfg.Theme.Colors.Inline.Text.Primary,
-			DefaultTheme.PrimaryText,
+		t.DraftPRColor = _shimHex(
+			themeCfg.Colors.Inline.State.DraftPR,
+			t.DraftPRColor,
 		)
-		DefaultTheme.SecondaryText = _shimHex(
-			cfg.Theme.Colors.Inline.Text.Secondary,
-			DefaultTheme.SecondaryText,
+		t.MergedPRColor = _shimHex(
+			themeCfg.Colors.Inline.State.MergedPR,
+			t.MergedPRColor,
 		)
-		DefaultTheme.InvertedText = _shimHex(
-			cfg.Theme.Colors.Inline.Text.Inverted,
-			DefaultTheme.InvertedText,
+		t.ClosedPRColor = _shimHex(
+			themeCfg.Colors.Inline.State.ClosedPR,
+			t.ClosedPRColor,
 		)
-		DefaultTheme.SuccessText = _shimHex(
-			cfg.Theme.Colors.Inline.Text.Success,
-			DefaultTheme.SuccessText,
+		t.OpenIssueColor = _shimHex(
+			themeCfg.Colors.Inline.State.OpenIssue,
+			t.OpenIssueColor,
 		)
-		DefaultTheme.WarningText = _shimHex(
-			cfg.Theme.Colors.Inline.Text.Warning,
-			DefaultTheme.WarningText,
+		t.ClosedIssueColor = _shimHex(
+			themeCfg.Colors.Inline.State.ClosedIssue,
+			t.ClosedIssueColor,
 		)
-		DefaultTheme.ErrorText = _shimHex(
-			cfg.Theme.Colors.Inline.Text.Error,
-			DefaultTheme.ErrorText,
+		t.OpenRowText = _shimHex(
+			themeCfg.Colors.Inline.Rows.Open,
+			t.OpenRowText,
 		)
-		DefaultTheme.NewContributorIconColor = _shimHex(
-			cfg.Theme.Colors.Inline.Icon.NewContributor,
-			DefaultTheme.NewContributorIconColor,
+		t.DraftRowText = _shimHex(
+			themeCfg.Colors.Inline.Rows.Draft,
+			t.DraftRowText,
 		)
-		DefaultTheme.ContributorIconColor = _shimHex(
-			cfg.Theme.Colors.Inline.Icon.Contributor,
-			DefaultTheme.ContributorIconColor,
+		t.MergedRowText = _shimHex(
+			themeCfg.Colors.Inline.Rows.Merged,
+			t.MergedRowText,
 		)
-		DefaultTheme.CollaboratorIconColor = _shimHex(
-			cfg.Theme.Colors.Inline.Icon.Collaborator,
-			DefaultTheme.CollaboratorIconColor,
+		t.ClosedRowText = _shimHex(
+			themeCfg.Colors.Inline.Rows.Closed,
+			t.ClosedRowText,
 		)
-		DefaultTheme.MemberIconColor = _shimHex(
-			cfg.Theme.Colors.Inline.Icon.Member,
-			DefaultTheme.MemberIconColor,
+		t.FailingChecksRowText = _shimHex(
+			themeCfg.Colors.Inline.Rows.FailingChecks,
+			t.FailingChecksRowText,
 		)
-		DefaultTheme.OwnerIconColor = _shimHex(
-			cfg.Theme.Colors.Inline.Icon.Owner,
-			DefaultTheme.OwnerIconColor,
+		t.StaleRowText = _shimHex(
+			themeCfg.Colors.Inline.Rows.Stale,
+			t.StaleRowText,
 		)
 	}
 
-	if cfg.ShowAuthorIcons && cfg.Theme.Icons != nil {
-		DefaultTheme.NewContributorIcon = _shimIcon(
-			cfg.Theme.Icons.Inline.NewContributor,
-			DefaultTheme.NewContributorIcon,
+	if cfg.ShowAuthorIcons && themeCfg.Icons != nil {
+		t.NewContributorIcon = _shimIcon(
+			themeCfg.Icons.Inline.NewContributor,
+			t.NewContributorIcon,
 		)
-		DefaultTheme.ContributorIcon = _shimIcon(
-			cfg.Theme.Icons.Inline.Contributor,
-			DefaultTheme.ContributorIcon,
+		t.ContributorIcon = _shimIcon(
+			themeCfg.Icons.Inline.Contributor,
+			t.ContributorIcon,
 		)
-		DefaultTheme.CollaboratorIcon = _shimIcon(
-			cfg.Theme.Icons.Inline.Collaborator,
-			DefaultTheme.CollaboratorIcon,
+		t.CollaboratorIcon = _shimIcon(
+			themeCfg.Icons.Inline.Collaborator,
+			t.CollaboratorIcon,
 		)
-		DefaultTheme.MemberIcon = _shimIcon(
-			cfg.Theme.Icons.Inline.Member,
-			DefaultTheme.MemberIcon,
+		t.MemberIcon = _shimIcon(
+			themeCfg.Icons.Inline.Member,
+			t.MemberIcon,
 		)
-		DefaultTheme.OwnerIcon = _shimIcon(
-			cfg.Theme.Icons.Inline.Owner,
-			DefaultTheme.OwnerIcon,
+		t.OwnerIcon = _shimIcon(
+			themeCfg.Icons.Inline.Owner,
+			t.OwnerIcon,
 		)
-		DefaultTheme.UnknownRoleIcon = _shimIcon(
-			cfg.Theme.Icons.Inline.UnknownRole,
-			DefaultTheme.UnknownRoleIcon,
+		t.UnknownRoleIcon = _shimIcon(
+			themeCfg.Icons.Inline.UnknownRole,
+			t.UnknownRoleIcon,
 		)
 	}
 
-	return *DefaultTheme
+	return t
 }
@@ -39,3 +39,20 @@ func TestTheme(t *testing.T) {
 		require.Equal(t, "#FF0000", parsed.PrimaryText.Dark)
 	})
 }
+
+func TestRowText(t *testing.T) {
+	colors := config.ColorThemeConfig{
+		Inline: config.ColorTheme{
+			Text: config.ColorThemeText{Primary: "#FFFFFF"},
+			Rows: config.ColorThemeRows{Merged: "#A371F7"},
+		},
+	}
+	cfg := config.Config{Theme: &config.ThemeConfig{Colors: &colors}}
+
+	parsed := ParseTheme(&cfg)
+	require.Equal(t, "#A371F7", parsed.RowText(RowMerged).Dark)
+	require.Equal(t, "#FFFFFF", parsed.RowText(RowStale).Dark)
+	require.Equal(t, "#FFFFFF", parsed.RowText(RowDefault).Dark)
+	// the default theme is left as is
+	require.NotEqual(t, "#FFFFFF", DefaultTheme.PrimaryText.Dark)
+}
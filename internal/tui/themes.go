@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	log "github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/config"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// openThemePicker shows the overlay listing the palettes of the themes
+// directory
+func (m *Model) openThemePicker() tea.Cmd {
+	names, err := config.ThemeNames()
+	if err != nil {
+		log.Error("Failed listing the themes", "err", err)
+		return m.notifyErr("Failed listing the themes")
+	}
+	current := ""
+	if m.ctx.Config.Theme != nil {
+		current = m.ctx.Config.Theme.Name
+	}
+	m.themePicker.SetWidth(min(50, m.ctx.ScreenWidth-4))
+	m.themePicker.Open(names, current)
+	m.focus.Push(focus.Palette)
+	return nil
+}
+
+// applyTheme switches to the palette name, or to the default theme when
+// it's empty. The config file is left as is, the theme lasts until the
+// dashboard quits.
+func (m *Model) applyTheme(name string) tea.Cmd {
+	if name != "" {
+		if _, err := config.LoadThemePalette(name); err != nil {
+			log.Error("Failed loading the theme", "theme", name, "err", err)
+			return m.notifyErr(fmt.Sprintf("Failed loading the %s theme", name))
+		}
+	}
+
+	var themeCfg config.ThemeConfig
+	if m.baseConfig.Theme != nil {
+		themeCfg = *m.baseConfig.Theme
+	}
+	themeCfg.Name = name
+	m.baseConfig.Theme = &themeCfg
+	// the profiles are applied again over the new theme
+	m.ctx.Config = nil
+	m.applyProfiles()
+	m.syncProgramContext()
+
+	if name == "" {
+		name = "default"
+	}
+	log.Info("Switched theme", "theme", name)
+	// the rows are rendered again with the new colors
+	return tea.Batch(m.notify(fmt.Sprintf("Switched to the %s theme", name)), m.rebuildSections())
+}
@@ -0,0 +1,35 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
+)
+
+// assignedIssueCounts returns how many of the open issues listed by the issue
+// sections are assigned to each user
+func (m *Model) assignedIssueCounts() map[string]int {
+	var issues []data.IssueData
+	for _, s := range m.issues {
+		if issuesSection, ok := s.(*issuessection.Model); ok {
+			issues = append(issues, issuesSection.Issues...)
+		}
+	}
+	return data.AssignedIssueCounts(issues)
+}
+
+// autoAssign assigns the selected issue to the least loaded member of the
+// triage team
+func (m *Model) autoAssign() tea.Cmd {
+	if len(m.ctx.Config.Triage.Team) == 0 {
+		return m.notifyErr("Set triage.team in the config to auto-assign issues")
+	}
+	m.syncSidebar()
+	m.issueSidebar.SetAssignedIssueCounts(m.assignedIssueCounts())
+	cmd, ok := m.issueSidebar.AutoAssign()
+	if !ok {
+		return m.notifyErr("The whole triage team is already assigned")
+	}
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -15,66 +16,192 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	log "github.com/charmbracelet/log"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/cli/go-gh/v2/pkg/browser"
 	zone "github.com/lrstanley/bubblezone"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
 	"github.com/dlvhdr/gh-dash/v4/internal/git"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/common"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/archiverow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/archivesection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branch"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/branchsidebar"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/dependenciessection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/dependencyrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/discussionview"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/feedrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/feedssection"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/fileview"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/filterdebug"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/filterpicker"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/footer"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/history"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issuessection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/issueview"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/itemform"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/labelpicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/milestonepicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/palette"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/planner"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prrow"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prssection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/prview"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releasenotes"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releaserow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/releasessection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/reposection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/section"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/sectioneditor"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/sidebar"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/snoozepicker"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/tabs"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/themepicker"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/workflowrow"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/components/workflowssection"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/constants"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/context"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
 	"github.com/dlvhdr/gh-dash/v4/internal/tui/keys"
-	"github.com/dlvhdr/gh-dash/v4/internal/tui/theme"
 )
 
 type Model struct {
-	keys          *keys.KeyMap
-	sidebar       sidebar.Model
-	prView        prview.Model
-	issueSidebar  issueview.Model
-	branchSidebar branchsidebar.Model
-	currSectionId int
-	footer        footer.Model
-	repo          section.Section
-	prs           []section.Section
-	issues        []section.Section
-	tabs          tabs.Model
-	ctx           *context.ProgramContext
-	taskSpinner   spinner.Model
-	tasks         map[string]context.Task
+	keys              *keys.KeyMap
+	sidebar           sidebar.Model
+	prView            prview.Model
+	issueSidebar      issueview.Model
+	discussionSidebar discussionview.Model
+	fileView          fileview.Model
+	branchSidebar     branchsidebar.Model
+	currSectionId     int
+	footer            footer.Model
+	repo              section.Section
+	prs               []section.Section
+	issues            []section.Section
+	workflows         []section.Section
+	feeds             []section.Section
+	discussions       []section.Section
+	releases          []section.Section
+	dependencies      []section.Section
+	archive           []section.Section
+	tabs              tabs.Model
+	ctx               *context.ProgramContext
+	taskSpinner       spinner.Model
+	tasks             map[string]context.Task
+	// queuedTasks are the retries of the tasks that failed while GitHub was
+	// unreachable, by task id
+	queuedTasks   map[string]tea.Cmd
+	pendingResize *tea.WindowSizeMsg
+	resizeId      int
+	// frame is the last rendered screen, shown again while a resize settles
+	frame           *frameCache
+	history         history.History
+	historyOverlay  history.Model
+	planner         planner.Model
+	labelPicker     labelpicker.Model
+	milestonePicker milestonepicker.Model
+	sectionEditor   sectioneditor.Model
+	themePicker     themepicker.Model
+	filterPicker    filterpicker.Model
+	snoozePicker    snoozepicker.Model
+	filterDebug     filterdebug.Model
+	releaseNotes    releasenotes.Model
+	palette         palette.Model
+	itemForm        itemform.Model
+	// focus holds the overlays opened over the sections, the top one receives
+	// key presses
+	focus focus.Stack
+	// quitPrompt lists what quitting would lose while it waits for a
+	// confirmation, nil otherwise
+	quitPrompt *quitPrompt
+	// pendingChord holds the keys pressed so far of a multi-key binding
+	pendingChord []tea.KeyMsg
+	chordId      int
+	skipChord    bool
+	// refreshGen is bumped when a view's sections are replaced, stopping the
+	// refresh timers of the old ones
+	refreshGen map[config.ViewType]int
+	// watched are the rows listed so far by the sections with notify
+	watched watcher
+
+	// linkUrl is the PR or issue to show once the config is loaded, linkedRow
+	// is shown in the sidebar instead of the selected row until the
+	// selection moves
+	linkUrl   string
+	linkedRow data.RowData
+	// startSection is the section of the default view shown first, from 1,
+	// 0 for the first one
+	startSection int
+	// startView and startFilters override the default view and the filters
+	// of the section shown first, see config.Location
+	startView    config.ViewType
+	startFilters string
+	// compared is the section shown next to the current one in compare
+	// mode, nil when the mode is off
+	compared *comparedSection
+	// lastClick is the last row clicked with the mouse
+	lastClick rowClick
+	// baseConfig is the config before the profiles are applied over it,
+	// profiles are the names of the applied ones
+	baseConfig config.Config
+	profiles   []string
+	// crash records what a crash report would need, in case of a panic
+	crash *crashRecorder
+	// latestRelease is the latest release of gh-dash, nil until it's fetched
+	latestRelease *data.DashRelease
+	// tokenScopes are the scopes of the token, unknown until they're fetched
+	tokenScopes data.TokenScopes
+	// layout is how the panes were resized, the preview's width overrides
+	// the config's
+	layout state.Layout
+	// shared is whether Configure applied the settings shared by every
+	// dashboard of the process, e.g. for the ones served over SSH
+	shared bool
 }
 
 func NewModel(location config.Location) Model {
 	taskSpinner := spinner.Model{Spinner: spinner.Dot}
+	keyMap := *keys.Keys
 	m := Model{
-		keys:        keys.Keys,
+		keys:        &keyMap,
+		shared:      location.Shared,
 		sidebar:     sidebar.NewModel(),
 		taskSpinner: taskSpinner,
 		tasks:       map[string]context.Task{},
+		queuedTasks: map[string]tea.Cmd{},
+		history:     history.New(history.MaxEntries),
+		refreshGen:  map[config.ViewType]int{},
+		watched:     watcher{},
+		crash:       &crashRecorder{},
+		frame:       &frameCache{},
 	}
 
-	version := "dev"
-	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Sum != "" {
-		version = info.Main.Version
+	version := location.Version
+	if version == "" || version == "dev" {
+		version = "dev"
+		if info, ok := debug.ReadBuildInfo(); ok && info.Main.Sum != "" {
+			version = info.Main.Version
+		}
 	}
 
+	m.linkUrl = location.OpenUrl
+	m.startSection = location.Section
+	m.startView = location.View
+	m.startFilters = location.Filters
+
 	m.ctx = &context.ProgramContext{
 		RepoPath:   location.RepoPath,
 		ConfigFlag: location.ConfigFlag,
+		ReadOnly:   location.ReadOnly,
+		Mini:       location.Mini,
+		Profile:    location.Profile,
 		Version:    version,
+		Repo:       &context.RepoContext{},
+		Keys:       m.keys,
+		Zone:       zone.New(),
 		StartTask: func(task context.Task) tea.Cmd {
 			log.Info("Starting task", "id", task.Id)
 			task.StartTime = time.Now()
@@ -84,6 +211,21 @@ func NewModel(location config.Location) Model {
 			return m.taskSpinner.Tick
 		},
 	}
+	if stateDir, err := state.Dir(); err != nil {
+		log.Error("Failed resolving state dir, search history is disabled", "err", err)
+	} else {
+		// a shared dashboard doesn't get to see its owner's searches or mark
+		// anything read for them
+		if !m.ctx.ReadOnly {
+			m.ctx.SearchHistory = state.LoadSearchHistory(stateDir)
+			m.ctx.ReadItems = state.LoadReadItems(stateDir)
+			m.ctx.ReviewPlan = state.LoadReviewPlan(stateDir)
+			m.ctx.Snoozed = state.LoadSnoozed(stateDir)
+			m.ctx.Archive = state.LoadArchive(stateDir)
+			m.ctx.CheckFilters = state.LoadCheckFilters(stateDir)
+			m.layout = state.LoadLayout(stateDir)
+		}
+	}
 
 	m.taskSpinner.Style = lipgloss.NewStyle().
 		Background(m.ctx.Theme.SelectedBackground)
@@ -91,8 +233,22 @@ func NewModel(location config.Location) Model {
 	m.footer = footer.NewModel(m.ctx)
 	m.prView = prview.NewModel(m.ctx)
 	m.issueSidebar = issueview.NewModel(m.ctx)
+	m.discussionSidebar = discussionview.NewModel(m.ctx)
+	m.fileView = fileview.NewModel(m.ctx)
 	m.branchSidebar = branchsidebar.NewModel(m.ctx)
 	m.tabs = tabs.NewModel(m.ctx)
+	m.historyOverlay = history.NewModel(m.ctx)
+	m.planner = planner.NewModel(m.ctx)
+	m.labelPicker = labelpicker.NewModel(m.ctx)
+	m.milestonePicker = milestonepicker.NewModel(m.ctx)
+	m.sectionEditor = sectioneditor.NewModel(m.ctx)
+	m.themePicker = themepicker.NewModel(m.ctx)
+	m.filterPicker = filterpicker.NewModel(m.ctx)
+	m.snoozePicker = snoozepicker.NewModel(m.ctx)
+	m.filterDebug = filterdebug.NewModel(m.ctx)
+	m.releaseNotes = releasenotes.NewModel(m.ctx)
+	m.palette = palette.NewModel(m.ctx)
+	m.itemForm = itemform.NewModel(m.ctx)
 
 	return m
 }
@@ -122,12 +278,20 @@ func (m *Model) initScreen() tea.Msg {
 			)
 	}
 
-	cfg, err := config.ParseConfig(config.Location{RepoPath: m.ctx.RepoPath, ConfigFlag: m.ctx.ConfigFlag})
+	cfg, err := config.ParseConfig(m.configLocation())
 	if err != nil {
 		showError(err)
 		return initMsg{Config: cfg}
 	}
 
+	if _, err := cfg.ActiveProfiles(m.ctx.Profile, config.Terminal{}); err != nil {
+		showError(err)
+	}
+
+	if !m.shared {
+		git.Configure(gitOptionsFromConfig(cfg.Git))
+	}
+
 	var url string
 	if config.IsFeatureEnabled(config.FF_REPO_VIEW) && m.ctx.RepoPath != "" {
 		res, err := git.GetOriginUrl(m.ctx.RepoPath)
@@ -138,64 +302,140 @@ func (m *Model) initScreen() tea.Msg {
 		url = res
 	}
 
-	err = keys.Rebind(
+	if !m.shared {
+		if err := rebindKeys(cfg); err != nil {
+			showError(err)
+		}
+	}
+
+	conflicts := append(cfg.Keybindings.Conflicts(), keys.Conflicts()...)
+	for _, conflict := range conflicts {
+		log.Warn("Keybinding conflict", "conflict", conflict)
+	}
+
+	return initMsg{Config: cfg, RepoUrl: url, KeyConflicts: conflicts}
+}
+
+// Configure applies the settings of cfg shared by every dashboard of the
+// process, how git and the requests are run and the keybindings, for the
+// dashboards created with a shared Location. The others apply them on their
+// own.
+func Configure(cfg config.Config) error {
+	git.Configure(gitOptionsFromConfig(cfg.Git))
+	configureData(cfg)
+	return rebindKeys(cfg)
+}
+
+// rebindKeys rebinds the keys the config binds
+func rebindKeys(cfg config.Config) error {
+	return keys.Rebind(
 		cfg.Keybindings.Universal,
 		cfg.Keybindings.Issues,
 		cfg.Keybindings.Prs,
 		cfg.Keybindings.Branches,
+		cfg.Keybindings.Workflows,
 	)
-	if err != nil {
-		showError(err)
+}
+
+// configureData sets up the requests made from now on with cfg
+func configureData(cfg config.Config) {
+	data.SetRateLimitThreshold(cfg.RateLimit.GetThreshold())
+	data.Configure(cfg)
+	data.SetMetadataCache(metadataCache(cfg.Cache))
+	data.SetTrimmedFields(cfg.ListQueries.Trim)
+}
+
+// gitOptionsFromConfig returns the options git commands are run with for cfg
+func gitOptionsFromConfig(cfg config.GitConfig) git.Options {
+	timeouts := make(map[string]time.Duration, len(cfg.Timeouts))
+	for subcommand, seconds := range cfg.Timeouts {
+		timeouts[subcommand] = time.Duration(seconds) * time.Second
 	}
+	return git.Options{
+		Binary:   cfg.Binary,
+		Timeout:  time.Duration(cfg.TimeoutSeconds) * time.Second,
+		Timeouts: timeouts,
+		Env:      cfg.Env,
+	}
+}
 
-	return initMsg{Config: cfg, RepoUrl: url}
+// Close stops what the dashboard runs in the background once its program is
+// done
+func (m Model) Close() {
+	m.ctx.Zone.Close()
 }
 
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(m.initScreen, tea.EnterAltScreen)
 }
 
-func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (m Model) Update(msg tea.Msg) (model tea.Model, cmd tea.Cmd) {
+	if m.crash.hasCrashed() {
+		return m, tea.Quit
+	}
+	m.crash.record(msg)
+	defer func() {
+		if p := recover(); p != nil {
+			model, cmd = m.recoverUpdate(p)
+		}
+	}()
+
+	model, cmd = m.update(msg)
+	if updated, ok := model.(Model); ok {
+		m.crash.setSession(updated.session())
+	}
+	return model, cmd
+}
+
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var (
-		cmd             tea.Cmd
-		tabsCmd         tea.Cmd
-		sidebarCmd      tea.Cmd
-		prViewCmd       tea.Cmd
-		issueSidebarCmd tea.Cmd
-		footerCmd       tea.Cmd
-		cmds            []tea.Cmd
-		currSection     = m.getCurrSection()
-		currRowData     = m.getCurrRowData()
+		cmd                  tea.Cmd
+		tabsCmd              tea.Cmd
+		sidebarCmd           tea.Cmd
+		prViewCmd            tea.Cmd
+		issueSidebarCmd      tea.Cmd
+		discussionSidebarCmd tea.Cmd
+		footerCmd            tea.Cmd
+		cmds                 []tea.Cmd
+		currSection          = m.getCurrSection()
+		currRowData          = m.getCurrRowData()
 	)
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		log.Info("Key pressed", "key", msg.String())
 		m.ctx.Error = nil
+		*m.keys = *keys.UseView(m.keys, m.ctx.View)
 
-		if currSection != nil && (currSection.IsSearchFocused() ||
-			currSection.IsPromptConfirmationFocused()) {
-			cmd = m.updateSection(currSection.GetId(), currSection.GetType(), msg)
-			return m, cmd
+		if handled, model, cmd := m.updateFocused(msg, currSection); handled {
+			return model, cmd
 		}
 
-		if m.prView.IsTextInputBoxFocused() {
-			m.prView, cmd = m.prView.Update(msg)
-			m.syncSidebar()
-			return m, cmd
+		if !m.skipChord {
+			if handled, model, cmd := m.updateChord(msg); handled {
+				return model, cmd
+			}
 		}
 
-		if m.issueSidebar.IsTextInputBoxFocused() {
-			m.issueSidebar, cmd = m.issueSidebar.Update(msg)
-			m.syncSidebar()
+		if m.ctx.ReadOnly && !key.Matches(msg, keys.ReadOnlyKeys(m.ctx.View)...) {
+			return m, m.notifyErr("This dashboard is read-only")
+		}
+
+		if cmd := m.checkTokenScopes(msg); cmd != nil {
 			return m, cmd
 		}
 
-		if m.footer.ShowConfirmQuit && (msg.String() == "y" || msg.String() == "enter") {
-			return m, tea.Quit
-		} else if m.footer.ShowConfirmQuit {
-			m.footer.SetShowConfirmQuit(false)
-			return m, nil
+		if currSection != nil && m.linkedRow == nil && !currSection.GetConfig().IsGitHub() &&
+			!key.Matches(msg, keys.BrowsingKeys(m.ctx.View)...) {
+			return m, m.notifyErr("Only browsing is supported in GitLab and Gitea sections")
+		}
+
+		m.recordAction(msg, currRowData)
+
+		if m.linkedRow != nil && key.Matches(msg, m.keys.PrevSection, m.keys.NextSection,
+			m.keys.Down, m.keys.Up, m.keys.FirstLine, m.keys.LastLine) {
+			// moving the selection goes back to the rows of the sections
+			m.linkedRow = nil
 		}
 
 		switch {
@@ -219,11 +459,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case key.Matches(msg, m.keys.Down):
-			prevRow := currSection.CurrRow()
-			nextRow := currSection.NextRow()
-			if prevRow != nextRow && nextRow == currSection.NumRows()-1 && m.ctx.View != config.RepoView {
-				cmds = append(cmds, currSection.FetchNextPageSectionRows()...)
-			}
+			cmds = append(cmds, m.nextRow(currSection))
 			cmd = m.onViewedRowChanged()
 
 		case key.Matches(msg, m.keys.Up):
@@ -241,11 +477,46 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			currSection.LastItem()
 			cmd = m.onViewedRowChanged()
 
+		case key.Matches(msg, m.keys.CompareSections):
+			cmd = m.toggleCompare()
+
+		case key.Matches(msg, m.keys.ExportSection):
+			cmd = m.exportSection()
+
+		case key.Matches(msg, m.keys.EditSections):
+			cmd = m.openSectionEditor()
+
+		case key.Matches(msg, m.keys.PickTheme):
+			cmd = m.openThemePicker()
+
+		case key.Matches(msg, m.keys.DebugFilters):
+			if currSection != nil {
+				m.filterDebug.Open(currSection.GetFilterDebug())
+				m.focus.Push(focus.Palette)
+			}
+
+		case key.Matches(msg, m.keys.ReportBug):
+			cmd = m.reportBug()
+
+		case key.Matches(msg, m.keys.ReleaseNotes):
+			cmd = m.showReleaseNotes()
+
+		case key.Matches(msg, m.keys.SwitchPane):
+			cmd = m.switchPane()
+
 		case key.Matches(msg, m.keys.TogglePreview):
 			m.sidebar.IsOpen = !m.sidebar.IsOpen
 			m.syncMainContentWidth()
+			cmd = m.markViewedRowRead()
+
+		case key.Matches(msg, m.keys.WidenPreview):
+			cmd = m.resizePreview(previewStep)
+
+		case key.Matches(msg, m.keys.NarrowPreview):
+			cmd = m.resizePreview(-previewStep)
 
 		case key.Matches(msg, m.keys.Refresh):
+			m.ctx.Repo.Invalidate()
 			currSection.ResetFilters()
 			currSection.ResetRows()
 			m.syncSidebar()
@@ -253,9 +524,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, currSection.FetchNextPageSectionRows()...)
 
 		case key.Matches(msg, m.keys.RefreshAll):
+			m.ctx.Repo.Invalidate()
 			newSections, fetchSectionsCmds := m.fetchAllViewSections()
-			m.setCurrentViewSections(newSections)
-			cmds = append(cmds, fetchSectionsCmds)
+			cmds = append(cmds, fetchSectionsCmds, m.setCurrentViewSections(newSections))
 
 		case key.Matches(msg, m.keys.Redraw):
 			// can't find a way to just ask to send bubbletea's internal repaintMsg{},
@@ -268,6 +539,60 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, cmd
 			}
 
+		case key.Matches(msg, m.keys.RepeatLast):
+			entry, ok := m.history.Last(m.ctx.View)
+			if !ok {
+				return m, m.notify("No action to repeat yet")
+			}
+			log.Info("Repeating last action", "key", entry.Key.String(), "desc", entry.Desc)
+			return m.Update(entry.Key)
+
+		case key.Matches(msg, m.keys.History):
+			m.historyOverlay.Open(m.history.ForView(m.ctx.View))
+			m.focus.Push(focus.Palette)
+			return m, nil
+
+		case key.Matches(msg, m.keys.Palette):
+			cmd = m.palette.Open(m.paletteCommands())
+			m.focus.Push(focus.Palette)
+			return m, cmd
+
+		case key.Matches(msg, m.keys.GoToPRs):
+			return m, m.goToView(config.PRsView)
+
+		case key.Matches(msg, m.keys.GoToIssues):
+			return m, m.goToView(config.IssuesView)
+
+		case key.Matches(msg, m.keys.GoToActions):
+			return m, m.goToView(config.WorkflowsView)
+
+		case key.Matches(msg, m.keys.GoToFeeds):
+			return m, m.goToView(config.FeedsView)
+
+		case key.Matches(msg, m.keys.GoToDiscussions):
+			return m, m.goToView(config.DiscussionsView)
+
+		case key.Matches(msg, m.keys.GoToReleases):
+			return m, m.goToView(config.ReleasesView)
+
+		case key.Matches(msg, m.keys.GoToDependencies):
+			return m, m.goToView(config.DependenciesView)
+
+		case key.Matches(msg, m.keys.GoToArchive):
+			return m, m.goToView(config.ArchiveView)
+
+		case key.Matches(msg, m.keys.GoToRepo):
+			return m, m.goToView(config.RepoView)
+
+		case key.Matches(msg, m.keys.ToggleRead):
+			return m, m.toggleRead()
+
+		case key.Matches(msg, m.keys.NextUnread):
+			return m, m.goToNextUnread()
+
+		case key.Matches(msg, m.keys.ViewFile):
+			return m, m.viewFile()
+
 		case key.Matches(msg, m.keys.Help):
 			if !m.footer.ShowAll {
 				m.ctx.MainContentHeight = m.ctx.MainContentHeight +
@@ -309,20 +634,38 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 
 		case key.Matches(msg, m.keys.Quit):
-			if !m.ctx.Config.ConfirmQuit {
-				return m, tea.Quit
-			}
-
-			m.footer.SetShowConfirmQuit(true)
+			return m, m.quit()
 
 		case m.ctx.View == config.RepoView:
 			switch {
 			case key.Matches(msg, m.keys.OpenGithub):
 				cmds = append(cmds, m.repo.(*reposection.Model).OpenGithub())
 
+			case key.Matches(msg, keys.BranchKeys.ViewPr):
+				if row, ok := currRowData.(branch.BranchData); ok && row.PR != nil {
+					cmd = m.viewBranchPr(row.PR)
+				}
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.NextDiffFile), key.Matches(msg, keys.PRKeys.PrevDiffFile):
+				if _, ok := currRowData.(branch.StashData); ok {
+					if key.Matches(msg, keys.PRKeys.NextDiffFile) {
+						m.branchSidebar.NextFile()
+					} else {
+						m.branchSidebar.PrevFile()
+					}
+					m.sidebar.SetContent(m.branchSidebar.View())
+					m.sidebar.ScrollToTop()
+				}
+				return m, nil
+
 			case key.Matches(msg, keys.BranchKeys.Delete):
 				if currSection != nil {
-					currSection.SetPromptConfirmationAction("delete")
+					action := "delete"
+					if repo, ok := m.repo.(*reposection.Model); ok && repo.IsViewingStashes() {
+						action = "drop_stash"
+					}
+					currSection.SetPromptConfirmationAction(action)
 					cmd = currSection.SetIsPromptConfirmationShown(true)
 				}
 				return m, cmd
@@ -341,7 +684,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, cmd
 
+			case key.Matches(msg, keys.BranchKeys.CreateDraftPr):
+				if currSection != nil {
+					currSection.SetPromptConfirmationAction("create_draft_pr")
+					cmd = currSection.SetIsPromptConfirmationShown(true)
+				}
+				return m, cmd
+
 			case key.Matches(msg, keys.BranchKeys.ViewPRs):
+				if repo, ok := m.repo.(*reposection.Model); ok {
+					repo.CancelGitOps()
+				}
 				m.ctx.View = m.switchSelectedView()
 				m.syncMainContentWidth()
 				m.setCurrSectionId(m.getCurrentViewDefaultSection())
@@ -352,8 +705,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					currSections = newSections
 					cmd = fetchSectionsCmds
 				}
-				m.setCurrentViewSections(currSections)
-				cmds = append(cmds, m.onViewedRowChanged())
+				cmds = append(cmds, m.setCurrentViewSections(currSections), m.onViewedRowChanged())
 			}
 		case m.ctx.View == config.PRsView:
 			switch {
@@ -366,22 +718,54 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.syncSidebar()
 				return m, tea.Batch(scmds...)
 
+			case m.prView.IsViewingDiff() && (key.Matches(msg, keys.PRKeys.NextDiffFile) ||
+				key.Matches(msg, keys.PRKeys.PrevDiffFile) ||
+				key.Matches(msg, keys.PRKeys.NextDiffHunk) ||
+				key.Matches(msg, keys.PRKeys.PrevDiffHunk)):
+				m.prView, cmd = m.prView.Update(msg)
+				m.syncSidebar()
+				m.sidebar.ScrollTo(m.prView.DiffOffset())
+				return m, cmd
+
+			case m.prView.IsViewingChecks() && (key.Matches(msg, keys.PRKeys.NextCheck) ||
+				key.Matches(msg, keys.PRKeys.PrevCheck) ||
+				key.Matches(msg, keys.PRKeys.RerunFailedChecks) ||
+				key.Matches(msg, keys.PRKeys.TailCheckLog) ||
+				key.Matches(msg, keys.PRKeys.ToggleCheckJobs) ||
+				key.Matches(msg, keys.PRKeys.ToggleCheckSource) ||
+				key.Matches(msg, keys.PRKeys.ShowHiddenChecks)):
+				m.prView, cmd = m.prView.Update(msg)
+				m.syncSidebar()
+				return m, cmd
+
+			case m.prView.IsViewingThreads() && (key.Matches(msg, keys.PRKeys.NextCheck) ||
+				key.Matches(msg, keys.PRKeys.PrevCheck) ||
+				key.Matches(msg, keys.PRKeys.ResolveThread)):
+				m.prView, cmd = m.prView.Update(msg)
+				m.syncSidebar()
+				m.sidebar.ScrollTo(m.prView.SelectedThreadOffset())
+				return m, cmd
+
 			case key.Matches(msg, m.keys.OpenGithub):
 				cmds = append(cmds, m.openBrowser())
 
 			case key.Matches(msg, keys.PRKeys.Approve):
 				m.prView.GoToFirstTab()
 				m.sidebar.IsOpen = true
-				cmd = m.prView.SetIsApproving(true)
+				cmd = m.focusSidebar(m.prView.SetIsApproving(true))
 				m.syncMainContentWidth()
 				m.syncSidebar()
 				m.sidebar.ScrollToBottom()
 				return m, cmd
 
+			case key.Matches(msg, keys.PRKeys.Assign) && m.hasSelection(currSection):
+				currSection.SetPromptConfirmationAction("assign")
+				return m, currSection.SetIsPromptConfirmationShown(true)
+
 			case key.Matches(msg, keys.PRKeys.Assign):
 				m.prView.GoToFirstTab()
 				m.sidebar.IsOpen = true
-				cmd = m.prView.SetIsAssigning(true)
+				cmd = m.focusSidebar(m.prView.SetIsAssigning(true))
 				m.syncMainContentWidth()
 				m.syncSidebar()
 				m.sidebar.ScrollToBottom()
@@ -390,21 +774,96 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case key.Matches(msg, keys.PRKeys.Unassign):
 				m.prView.GoToFirstTab()
 				m.sidebar.IsOpen = true
-				cmd = m.prView.SetIsUnassigning(true)
+				cmd = m.focusSidebar(m.prView.SetIsUnassigning(true))
+				m.syncMainContentWidth()
+				m.syncSidebar()
+				m.sidebar.ScrollToBottom()
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.RequestReview):
+				m.prView.GoToFirstTab()
+				m.sidebar.IsOpen = true
+				cmd = m.focusSidebar(m.prView.SetIsRequestingReview(true))
+				m.syncMainContentWidth()
+				m.syncSidebar()
+				m.sidebar.ScrollToBottom()
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.DismissReview):
+				m.prView.GoToFirstTab()
+				m.sidebar.IsOpen = true
+				cmd = m.focusSidebar(m.prView.SetIsDismissing(true))
 				m.syncMainContentWidth()
 				m.syncSidebar()
 				m.sidebar.ScrollToBottom()
 				return m, cmd
 
+			case key.Matches(msg, keys.PRKeys.Comment) && m.prView.IsViewingThreads():
+				m.sidebar.IsOpen = true
+				cmd = m.focusSidebar(m.prView.SetIsReplying(true))
+				m.syncMainContentWidth()
+				m.syncSidebar()
+				m.sidebar.ScrollTo(m.prView.SelectedThreadOffset())
+				return m, cmd
+
 			case key.Matches(msg, keys.PRKeys.Comment):
 				m.prView.GoToFirstTab()
 				m.sidebar.IsOpen = true
-				cmd = m.prView.SetIsCommenting(true)
+				cmd = m.focusSidebar(m.prView.SetIsCommenting(true))
 				m.syncMainContentWidth()
 				m.syncSidebar()
 				m.sidebar.ScrollToBottom()
 				return m, cmd
 
+			case key.Matches(msg, keys.PRKeys.LoadOlderComments):
+				cmd = m.prView.LoadOlderComments()
+				return m, cmd
+
+			case key.Matches(msg, keys.PRKeys.ToggleTimelineEvents):
+				m.prView.ToggleTimelineEvents()
+				m.syncSidebar()
+				return m, nil
+
+			case key.Matches(msg, keys.PRKeys.CollapseActivity):
+				m.prView.ToggleCollapsedActivity()
+				m.syncSidebar()
+				return m, nil
+
+			case key.Matches(msg, keys.PRKeys.JumpToLatest):
+				m.prView.GoToActivityTab()
+				m.sidebar.IsOpen = true
+				m.syncMainContentWidth()
+				m.syncSidebar()
+				m.sidebar.ScrollTo(m.prView.LatestActivityOffset())
+				return m, m.markViewedRowRead()
+
+			case key.Matches(msg, keys.PRKeys.ToggleBotComments):
+				m.prView.ToggleBotComments()
+				m.syncSidebar()
+				return m, nil
+
+			case key.Matches(msg, keys.PRKeys.PlanReviews):
+				return m, m.openPlanner()
+
+			case key.Matches(msg, keys.PRKeys.Label) && m.hasSelection(currSection):
+				currSection.SetPromptConfirmationAction("label")
+				return m, currSection.SetIsPromptConfirmationShown(true)
+
+			case key.Matches(msg, keys.PRKeys.Label):
+				return m, m.openLabelPicker()
+
+			case key.Matches(msg, keys.PRKeys.Milestone):
+				return m, m.openMilestonePicker()
+
+			case key.Matches(msg, keys.PRKeys.PickSavedFilters):
+				return m, m.openFilterPicker()
+
+			case key.Matches(msg, keys.PRKeys.Snooze):
+				return m, m.snoozeCurrRow()
+
+			case key.Matches(msg, keys.PRKeys.ToggleSnoozed):
+				return m, m.toggleShowSnoozed()
+
 			case key.Matches(msg, keys.PRKeys.Close):
 				if currRowData != nil && currSection != nil {
 					currSection.SetPromptConfirmationAction("close")
@@ -412,6 +871,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, cmd
 
+			case key.Matches(msg, keys.PRKeys.Review):
+				if currRowData != nil && currSection != nil {
+					currSection.SetPromptConfirmationAction("review")
+					cmd = currSection.SetIsPromptConfirmationShown(true)
+				}
+				return m, cmd
+
 			case key.Matches(msg, keys.PRKeys.Ready):
 				if currRowData != nil && currSection != nil {
 					currSection.SetPromptConfirmationAction("ready")
@@ -433,6 +899,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, cmd
 
+			case key.Matches(msg, keys.PRKeys.MergeQueue):
+				pr, ok := currRowData.(*prrow.Data)
+				if !ok || pr == nil || currSection == nil {
+					return m, nil
+				}
+				switch {
+				case pr.Primary.MergeQueueEntry != nil:
+					currSection.SetPromptConfirmationAction("dequeue")
+				case pr.Primary.IsMergeQueueEnabled:
+					currSection.SetPromptConfirmationAction("enqueue")
+				default:
+					return m, m.notifyErr("The base branch of this PR has no merge queue")
+				}
+				cmd = currSection.SetIsPromptConfirmationShown(true)
+				return m, cmd
+
 			case key.Matches(msg, keys.PRKeys.Update):
 				if currRowData != nil && currSection != nil {
 					currSection.SetPromptConfirmationAction("update")
@@ -440,6 +922,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, cmd
 
+			case key.Matches(msg, keys.PRKeys.New):
+				return m, m.openItemForm(true)
+
 			case key.Matches(msg, keys.PRKeys.ViewIssues):
 				m.ctx.View = m.switchSelectedView()
 				m.syncMainContentWidth()
@@ -452,8 +937,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					cmds = append(cmds, m.tabs.SetAllLoading()...)
 					cmd = fetchSectionsCmds
 				}
-				m.setCurrentViewSections(currSections)
-				cmds = append(cmds, m.onViewedRowChanged())
+				cmds = append(cmds, m.setCurrentViewSections(currSections), m.onViewedRowChanged())
 
 			case key.Matches(msg, keys.PRKeys.SummaryViewMore):
 				m.prView.SetSummaryViewMore()
@@ -465,25 +949,60 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case key.Matches(msg, m.keys.OpenGithub):
 				cmds = append(cmds, m.openBrowser())
 
+			case key.Matches(msg, keys.IssueKeys.Label) && m.hasSelection(currSection):
+				currSection.SetPromptConfirmationAction("label")
+				return m, currSection.SetIsPromptConfirmationShown(true)
+
 			case key.Matches(msg, keys.IssueKeys.Label):
+				return m, m.openLabelPicker()
+
+			case key.Matches(msg, keys.IssueKeys.Milestone):
+				return m, m.openMilestonePicker()
+
+			case key.Matches(msg, keys.IssueKeys.PickSavedFilters):
+				return m, m.openFilterPicker()
+
+			case key.Matches(msg, keys.IssueKeys.Snooze):
+				return m, m.snoozeCurrRow()
+
+			case key.Matches(msg, keys.IssueKeys.ToggleSnoozed):
+				return m, m.toggleShowSnoozed()
+
+			case key.Matches(msg, keys.IssueKeys.Estimate):
+				row := m.getCurrRowData()
+				if row == nil {
+					return m, nil
+				}
+				if m.ctx.Config.Estimate.ForRepo(row.GetRepoNameWithOwner()).Field == "" {
+					return m, m.notifyErr("No estimate field is configured for " + row.GetRepoNameWithOwner())
+				}
 				m.sidebar.IsOpen = true
-				cmd = m.issueSidebar.SetIsLabeling(true)
+				m.syncSidebar()
+				cmd = m.focusSidebar(m.issueSidebar.SetIsEstimating(true))
 				m.syncMainContentWidth()
 				m.syncSidebar()
 				m.sidebar.ScrollToBottom()
 				return m, cmd
 
+			case key.Matches(msg, keys.IssueKeys.Assign) && m.hasSelection(currSection):
+				currSection.SetPromptConfirmationAction("assign")
+				return m, currSection.SetIsPromptConfirmationShown(true)
+
 			case key.Matches(msg, keys.IssueKeys.Assign):
 				m.sidebar.IsOpen = true
-				cmd = m.issueSidebar.SetIsAssigning(true)
+				m.issueSidebar.SetAssignedIssueCounts(m.assignedIssueCounts())
+				cmd = m.focusSidebar(m.issueSidebar.SetIsAssigning(true))
 				m.syncMainContentWidth()
 				m.syncSidebar()
 				m.sidebar.ScrollToBottom()
 				return m, cmd
 
+			case key.Matches(msg, keys.IssueKeys.AutoAssign):
+				return m, m.autoAssign()
+
 			case key.Matches(msg, keys.IssueKeys.Unassign):
 				m.sidebar.IsOpen = true
-				cmd = m.issueSidebar.SetIsUnassigning(true)
+				cmd = m.focusSidebar(m.issueSidebar.SetIsUnassigning(true))
 				m.syncMainContentWidth()
 				m.syncSidebar()
 				m.sidebar.ScrollToBottom()
@@ -491,12 +1010,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			case key.Matches(msg, keys.IssueKeys.Comment):
 				m.sidebar.IsOpen = true
-				cmd = m.issueSidebar.SetIsCommenting(true)
+				cmd = m.focusSidebar(m.issueSidebar.SetIsCommenting(true))
 				m.syncMainContentWidth()
 				m.syncSidebar()
 				m.sidebar.ScrollToBottom()
 				return m, cmd
 
+			case key.Matches(msg, keys.IssueKeys.LoadOlderComments):
+				cmd = m.issueSidebar.LoadOlderComments()
+				return m, cmd
+
+			case key.Matches(msg, keys.IssueKeys.ToggleBotComments):
+				m.issueSidebar.ToggleBotComments()
+				m.syncSidebar()
+				return m, nil
+
 			case key.Matches(msg, keys.IssueKeys.Close):
 				if currRowData != nil && currSection != nil {
 					currSection.SetPromptConfirmationAction("close")
@@ -511,6 +1039,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, cmd
 
+			case key.Matches(msg, keys.IssueKeys.New):
+				return m, m.openItemForm(false)
+
 			case key.Matches(msg, keys.IssueKeys.ViewPRs):
 				m.ctx.View = m.switchSelectedView()
 				m.syncMainContentWidth()
@@ -522,44 +1053,264 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					currSections = newSections
 					cmds = append(cmds, m.tabs.SetAllLoading()...)
 					cmd = fetchSectionsCmds
+				} else if repo, ok := m.repo.(*reposection.Model); ok && m.ctx.View == config.RepoView {
+					cmds = append(cmds, repo.ReloadRepo()...)
+				}
+				cmds = append(cmds, m.setCurrentViewSections(currSections), m.onViewedRowChanged())
+			}
+		case m.ctx.View == config.WorkflowsView:
+			switch {
+			case key.Matches(msg, m.keys.OpenGithub):
+				cmds = append(cmds, m.openBrowser())
+
+			case key.Matches(msg, keys.WorkflowKeys.ViewPRs):
+				cmds = append(cmds, m.goToView(m.switchSelectedView()))
+			}
+		case m.ctx.View == config.FeedsView:
+			switch {
+			case key.Matches(msg, m.keys.OpenGithub):
+				cmds = append(cmds, m.openBrowser())
+
+			case key.Matches(msg, keys.FeedKeys.ViewPRs):
+				m.ctx.View = m.switchSelectedView()
+				m.syncMainContentWidth()
+				m.setCurrSectionId(m.getCurrentViewDefaultSection())
+
+				currSections := m.getCurrentViewSections()
+				if len(currSections) == 0 {
+					newSections, fetchSectionsCmds := m.fetchAllViewSections()
+					currSections = newSections
+					cmds = append(cmds, m.tabs.SetAllLoading()...)
+					cmd = fetchSectionsCmds
+				} else if repo, ok := m.repo.(*reposection.Model); ok && m.ctx.View == config.RepoView {
+					cmds = append(cmds, repo.ReloadRepo()...)
+				}
+				cmds = append(cmds, m.setCurrentViewSections(currSections), m.onViewedRowChanged())
+			}
+		case m.ctx.View == config.ReleasesView || m.ctx.View == config.DependenciesView ||
+			m.ctx.View == config.ArchiveView:
+			switch {
+			case key.Matches(msg, m.keys.OpenGithub):
+				cmds = append(cmds, m.openBrowser())
+
+			case key.Matches(msg, keys.ReleaseKeys.ViewPRs, keys.DependencyKeys.ViewPRs,
+				keys.ArchiveKeys.ViewPRs):
+				m.ctx.View = m.switchSelectedView()
+				m.syncMainContentWidth()
+				m.setCurrSectionId(m.getCurrentViewDefaultSection())
+
+				currSections := m.getCurrentViewSections()
+				if len(currSections) == 0 {
+					newSections, fetchSectionsCmds := m.fetchAllViewSections()
+					currSections = newSections
+					cmds = append(cmds, m.tabs.SetAllLoading()...)
+					cmd = fetchSectionsCmds
+				} else if repo, ok := m.repo.(*reposection.Model); ok && m.ctx.View == config.RepoView {
+					cmds = append(cmds, repo.ReloadRepo()...)
+				}
+				cmds = append(cmds, m.setCurrentViewSections(currSections), m.onViewedRowChanged())
+			}
+		case m.ctx.View == config.DiscussionsView:
+			switch {
+			case key.Matches(msg, m.keys.OpenGithub):
+				cmds = append(cmds, m.openBrowser())
+
+			case key.Matches(msg, keys.DiscussionKeys.Comment):
+				m.sidebar.IsOpen = true
+				cmd = m.focusSidebar(m.discussionSidebar.SetIsCommenting(true))
+				m.syncMainContentWidth()
+				m.syncSidebar()
+				m.sidebar.ScrollToBottom()
+				return m, cmd
+
+			case key.Matches(msg, keys.DiscussionKeys.MarkAnswer):
+				m.sidebar.IsOpen = true
+				cmd = m.focusSidebar(m.discussionSidebar.SetIsMarkingAnswer(true))
+				m.syncMainContentWidth()
+				m.syncSidebar()
+				m.sidebar.ScrollToBottom()
+				return m, cmd
+
+			case key.Matches(msg, keys.DiscussionKeys.ViewPRs):
+				m.ctx.View = m.switchSelectedView()
+				m.syncMainContentWidth()
+				m.setCurrSectionId(m.getCurrentViewDefaultSection())
+
+				currSections := m.getCurrentViewSections()
+				if len(currSections) == 0 {
+					newSections, fetchSectionsCmds := m.fetchAllViewSections()
+					currSections = newSections
+					cmds = append(cmds, m.tabs.SetAllLoading()...)
+					cmd = fetchSectionsCmds
+				} else if repo, ok := m.repo.(*reposection.Model); ok && m.ctx.View == config.RepoView {
+					cmds = append(cmds, repo.ReloadRepo()...)
 				}
-				m.setCurrentViewSections(currSections)
-				cmds = append(cmds, m.onViewedRowChanged())
+				cmds = append(cmds, m.setCurrentViewSections(currSections), m.onViewedRowChanged())
 			}
 		}
 
 	case initMsg:
-		m.ctx.Config = &msg.Config
+		m.setBaseConfig(msg.Config)
+		m.applyProfiles()
 		m.ctx.RepoUrl = msg.RepoUrl
-		m.ctx.Theme = theme.ParseTheme(m.ctx.Config)
-		m.ctx.Styles = context.InitStyles(m.ctx.Theme)
 		m.ctx.View = m.ctx.Config.Defaults.View
+		if !m.shared {
+			configureData(*m.ctx.Config)
+		}
+		linkCmd := m.openLink()
+		*m.keys = *keys.Keys
+		m.keys.GoToActions.SetEnabled(len(m.ctx.Config.WorkflowsSections) > 0)
+		m.keys.GoToFeeds.SetEnabled(len(m.ctx.Config.FeedsSections) > 0)
+		m.keys.GoToDiscussions.SetEnabled(len(m.ctx.Config.DiscussionsSections) > 0)
+		m.keys.GoToReleases.SetEnabled(len(m.ctx.Config.ReleasesSections) > 0)
+		m.keys.GoToDependencies.SetEnabled(len(m.ctx.Config.DependenciesSections) > 0)
+		m.keys.GoToArchive.SetEnabled(m.ctx.ArchiveEnabled())
+		m.pruneArchive()
+		m.keys.GoToRepo.SetEnabled(config.IsFeatureEnabled(config.FF_REPO_VIEW))
+		restoredSection := m.restoreSession()
 		m.currSectionId = m.getCurrentViewDefaultSection()
-		m.sidebar.IsOpen = msg.Config.Defaults.Preview.Open
+		if restoredSection > 0 && m.ctx.View != config.RepoView &&
+			restoredSection < len(m.ctx.GetViewSectionsConfig()) {
+			m.currSectionId = restoredSection
+		}
+		if m.startSection > 0 && m.ctx.View != config.RepoView {
+			if m.startSection < len(m.ctx.GetViewSectionsConfig()) {
+				m.currSectionId = m.startSection
+			} else {
+				log.Warn("No such section, showing the first one", "section", m.startSection)
+			}
+		}
+		m.sidebar.IsOpen = m.ctx.Config.Defaults.Preview.Open || linkCmd != nil
+		if m.ctx.Mini {
+			// the preview pane starts closed, it would take most of a narrow pane
+			m.sidebar.IsOpen = linkCmd != nil
+		}
 		m.syncMainContentWidth()
 
 		newSections, fetchSectionsCmds := m.fetchAllViewSections()
-		m.setCurrentViewSections(newSections)
-		m.tabs.SetCurrSectionId(1)
-		cmds = append(cmds, fetchSectionsCmds, m.tabs.Init(), fetchUser,
-			m.doRefreshAtInterval(), m.doUpdateFooterAtInterval())
+		refreshCmd := m.setCurrentViewSections(newSections)
+		m.tabs.SetCurrSectionId(m.currSectionId)
+		cmds = append(cmds, fetchSectionsCmds, refreshCmd, m.tabs.Init(), fetchUser,
+			fetchTokenScopes, m.doUpdateFooterAtInterval(), linkCmd, m.checkForUpdate())
+
+		if conflicts := msg.KeyConflicts; len(conflicts) > 0 {
+			text := conflicts[0]
+			if len(conflicts) > 1 {
+				text = fmt.Sprintf("%s (and %d more keybinding conflicts, see the log)", text, len(conflicts)-1)
+			}
+			cmds = append(cmds, m.notifyErr(text))
+		}
 
-	case intervalRefresh:
-		newSections, fetchSectionsCmds := m.fetchAllViewSections()
-		m.setCurrentViewSections(newSections)
-		cmds = append(cmds, fetchSectionsCmds, m.doRefreshAtInterval())
+	case linkedItemFetchedMsg:
+		if msg.err != nil {
+			cmds = append(cmds, m.notifyErr(fmt.Sprintf("Failed opening %s: %v", m.linkUrl, msg.err)))
+			break
+		}
+		m.linkedRow = msg.row
+		cmds = append(cmds, m.onViewedRowChanged())
+
+	case sectionRefreshMsg:
+		cmds = append(cmds, m.refreshSection(msg))
 
 	case userFetchedMsg:
 		m.ctx.User = msg.user
 
+	case releaseFetchedMsg:
+		cmds = append(cmds, m.onReleaseFetched(msg))
+
+	case tokenScopesMsg:
+		m.onTokenScopes(msg)
+
+	case archiveItemsMsg:
+		cmds = append(cmds, m.onArchiveItems(msg))
+
+	case chordTimeoutMsg:
+		if msg.id == m.chordId && len(m.pendingChord) > 0 {
+			return m.flushChord()
+		}
+
+	case itemform.BodyEditedMsg:
+		m.itemForm, cmd = m.itemForm.Update(msg)
+		return m, cmd
+
+	case itemform.SubmittedMsg:
+		return m, m.createItem(msg.Item)
+
+	case history.SelectedMsg:
+		log.Info("Running recent action", "key", msg.Entry.Key.String(), "desc", msg.Entry.Desc)
+		return m.Update(msg.Entry.Key)
+
+	case palette.SelectedMsg:
+		return m.runPaletteCommand(msg.Command)
+
+	case planner.ClosedMsg:
+		cmds = append(cmds, m.onPlannerClosed(msg))
+
+	case labelpicker.FetchedMsg:
+		m.labelPicker, cmd = m.labelPicker.Update(msg)
+		return m, cmd
+
+	case labelpicker.AppliedMsg:
+		return m, m.applyLabels(msg)
+
+	case milestonepicker.FetchedMsg:
+		m.milestonePicker, cmd = m.milestonePicker.Update(msg)
+		return m, cmd
+
+	case milestonepicker.AppliedMsg:
+		return m, m.applyMilestone(msg)
+
+	case sectioneditor.PreviewFetchedMsg:
+		m.sectionEditor, cmd = m.sectionEditor.Update(msg)
+		return m, cmd
+
+	case sectioneditor.SavedMsg:
+		return m, m.saveSection(msg)
+
+	case sectioneditor.DeletedMsg:
+		return m, m.deleteSection(msg)
+
+	case sectioneditor.MovedMsg:
+		return m, m.moveSection(msg)
+
+	case themepicker.ThemeSelectedMsg:
+		return m, m.applyTheme(msg.Name)
+
+	case filterpicker.AppliedMsg:
+		return m, m.applySavedFilters(msg.Names)
+
+	case snoozepicker.SnoozedMsg:
+		return m, m.snooze(msg)
+
+	case constants.TaskProgressMsg:
+		if task, ok := m.tasks[msg.TaskId]; ok && task.State == context.TaskStart {
+			task.Progress = msg.Text
+			m.tasks[msg.TaskId] = task
+			m.footer.SetRightSection(m.renderRunningTask())
+		}
+		cmds = append(cmds, msg.Next)
+
 	case constants.TaskFinishedMsg:
 		task, ok := m.tasks[msg.TaskId]
 		if ok {
 			log.Info("Task finished", "id", task.Id)
+			if msg.Retry != nil {
+				log.Warn("GitHub is unreachable, task queued for retry", "id", task.Id, "err", msg.Err)
+				task.State = context.TaskQueued
+				task.Error = msg.Err
+				m.tasks[msg.TaskId] = task
+				if len(m.queuedTasks) == 0 {
+					cmds = append(cmds, m.doRetryQueuedTasksAfterInterval())
+				}
+				m.queuedTasks[msg.TaskId] = msg.Retry
+				m.footer.SetRightSection(m.renderRunningTask())
+				break
+			}
 			if msg.Err != nil {
 				log.Error("Task finished with error", "id", task.Id, "err", msg.Err)
 				task.State = context.TaskError
-				task.Error = msg.Err
+				task.Error = explainForbidden(msg.Err)
+				m.stopRefreshing(msg.SectionId, msg.SectionType)
 			} else {
 				task.State = context.TaskFinished
 			}
@@ -575,13 +1326,67 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, scmd)
 
 			syncCmd := m.syncSidebar()
-			cmds = append(cmds, syncCmd)
+			cmds = append(cmds, syncCmd, m.quitWhenIdle())
+		}
+
+	case fileview.FetchedMsg:
+		m.fileView, cmd = m.fileView.Update(msg)
+		m.syncSidebar()
+		return m, cmd
+
+	case prview.DiffFetchedMsg:
+		if msg.Err != nil {
+			log.Error("failed fetching pr diff", "err", msg.Err)
+		}
+		m.prView.SetDiff(msg)
+		syncCmd := m.syncSidebar()
+		cmds = append(cmds, syncCmd)
+
+	case branchsidebar.StashDiffFetchedMsg:
+		if msg.Err != nil {
+			log.Error("failed reading stash diff", "err", msg.Err)
 		}
+		m.branchSidebar.SetStashDiff(msg)
+		syncCmd := m.syncSidebar()
+		cmds = append(cmds, syncCmd)
+
+	case prview.ChecksPollMsg:
+		cmds = append(cmds, m.prView.PollChecks(msg))
+
+	case prview.ChecksFetchedMsg:
+		cmds = append(cmds, m.prView.SetChecks(msg))
+		syncCmd := m.syncSidebar()
+		cmds = append(cmds, syncCmd)
+
+	case prview.CheckLogFetchedMsg:
+		if msg.Err != nil {
+			log.Error("failed fetching check log", "err", msg.Err)
+		}
+		m.prView.SetCheckLog(msg)
+		syncCmd := m.syncSidebar()
+		cmds = append(cmds, syncCmd)
+
+	case issueview.IssueDetailsMsg:
+		if msg.Err != nil {
+			log.Error("failed fetching issue details", "err", msg.Err)
+			break
+		}
+		if msg.SectionId < len(m.issues) {
+			if s, ok := m.issues[msg.SectionId].(*issuessection.Model); ok {
+				s.RestoreTrimmed(msg.Details)
+			}
+		}
+		syncCmd := m.syncSidebar()
+		cmds = append(cmds, syncCmd)
 
 	case prview.EnrichedPrMsg:
 		if msg.Err == nil {
 			m.prView.SetEnrichedPR(msg.Data)
-			m.prs[msg.Id].(*prssection.Model).EnrichPR(msg.Data)
+			if msg.Id < len(m.prs) {
+				if s, ok := m.prs[msg.Id].(*prssection.Model); ok {
+					s.EnrichPR(msg.Data)
+				}
+			}
 			syncCmd := m.syncSidebar()
 			cmds = append(cmds, syncCmd)
 		} else {
@@ -597,6 +1402,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmd = internalTickCmd
 		}
 
+	case retryQueuedTasksMsg:
+		for id, retry := range m.queuedTasks {
+			if task, ok := m.tasks[id]; ok {
+				task.State = context.TaskStart
+				task.Error = nil
+				m.tasks[id] = task
+			}
+			cmds = append(cmds, retry)
+		}
+		clear(m.queuedTasks)
+		m.footer.SetRightSection(m.renderRunningTask())
+
 	case constants.ClearTaskMsg:
 		m.footer.SetRightSection("")
 		delete(m.tasks, msg.TaskId)
@@ -614,10 +1431,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.MouseMsg:
+		cmds = append(cmds, m.onMouse(msg))
 		if msg.Action != tea.MouseActionRelease || msg.Button != tea.MouseButtonLeft {
-			return m, nil
+			return m, tea.Batch(cmds...)
 		}
-		if zone.Get("donate").InBounds(msg) {
+		if m.ctx.Zone.Get("donate").InBounds(msg) && !m.ctx.ReadOnly {
 			log.Info("Donate clicked", "msg", msg)
 			openCmd := func() tea.Msg {
 				b := browser.New("", os.Stdout, os.Stdin)
@@ -631,7 +1449,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.WindowSizeMsg:
-		m.onWindowSizeChanged(msg)
+		cmd = m.debounceWindowSizeChanged(msg)
+
+	case resizeDebouncedMsg:
+		cmds = append(cmds, m.applyPendingResize(msg))
 
 	case updateFooterMsg:
 		cmds = append(cmds, cmd, m.doUpdateFooterAtInterval())
@@ -658,6 +1479,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.syncSidebar()
 	}
 
+	if m.discussionSidebar.IsTextInputBoxFocused() {
+		m.discussionSidebar, discussionSidebarCmd = m.discussionSidebar.Update(msg)
+		m.syncSidebar()
+	}
+
+	var fileViewCmd tea.Cmd
+	if m.fileView.Focused() {
+		m.fileView, fileViewCmd = m.fileView.Update(msg)
+		m.syncSidebar()
+	}
+
+	var itemFormCmd tea.Cmd
+	if m.focus.Top() == focus.Form {
+		// keys were handled by updateFocused, this keeps the cursor blinking
+		m.itemForm, itemFormCmd = m.itemForm.Update(msg)
+	}
+
 	if currSection != nil {
 		if currSection.IsPromptConfirmationFocused() {
 			m.footer.SetLeftSection(currSection.GetPromptConfirmation())
@@ -681,24 +1519,95 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		sectionCmd,
 		prViewCmd,
 		issueSidebarCmd,
+		discussionSidebarCmd,
+		fileViewCmd,
+		itemFormCmd,
 	)
 
 	return m, tea.Batch(cmds...)
 }
 
-func (m Model) View() string {
+func (m Model) View() (view string) {
+	defer func() {
+		if p := recover(); p != nil {
+			view = m.recoverView(p)
+		}
+	}()
+	if m.pendingResize != nil && m.frame.view != "" {
+		return m.frame.view
+	}
+	view = m.view()
+	m.frame.view = view
+	return view
+}
+
+func (m Model) view() string {
 	if m.ctx.Config == nil {
 		return lipgloss.Place(m.ctx.ScreenWidth, m.ctx.ScreenHeight, lipgloss.Center, lipgloss.Center, "Reading config...")
 	}
 
 	s := strings.Builder{}
-	if m.ctx.View != config.RepoView {
-		s.WriteString(m.tabs.View())
+	if !m.ctx.Mini {
+		if m.ctx.View != config.RepoView {
+			s.WriteString(m.tabs.View())
+		}
+		s.WriteString("\n")
 	}
-	s.WriteString("\n")
 	content := "No sections defined"
 	currSection := m.getCurrSection()
-	if currSection != nil {
+	if m.quitPrompt != nil {
+		content = lipgloss.Place(
+			m.ctx.ScreenWidth,
+			m.ctx.MainContentHeight,
+			lipgloss.Center,
+			lipgloss.Center,
+			m.renderQuitPrompt(),
+		)
+	} else if m.focus.Has(focus.Form) {
+		content = lipgloss.Place(
+			m.ctx.ScreenWidth,
+			m.ctx.MainContentHeight,
+			lipgloss.Center,
+			lipgloss.Center,
+			m.itemForm.View(),
+		)
+	} else if m.focus.Has(focus.Palette) {
+		overlay := m.historyOverlay.View()
+		if m.palette.Focused() {
+			overlay = m.palette.View()
+		} else if m.planner.Focused() {
+			overlay = m.planner.View()
+		} else if m.labelPicker.Focused() {
+			overlay = m.labelPicker.View()
+		} else if m.milestonePicker.Focused() {
+			overlay = m.milestonePicker.View()
+		} else if m.sectionEditor.Focused() {
+			overlay = m.sectionEditor.View()
+		} else if m.themePicker.Focused() {
+			overlay = m.themePicker.View()
+		} else if m.filterPicker.Focused() {
+			overlay = m.filterPicker.View()
+		} else if m.snoozePicker.Focused() {
+			overlay = m.snoozePicker.View()
+		} else if m.filterDebug.Focused() {
+			overlay = m.filterDebug.View()
+		} else if m.releaseNotes.Focused() {
+			overlay = m.releaseNotes.View()
+		}
+		content = lipgloss.Place(
+			m.ctx.ScreenWidth,
+			m.ctx.MainContentHeight,
+			lipgloss.Center,
+			lipgloss.Center,
+			overlay,
+		)
+	} else if compared := m.getComparedSection(); currSection != nil && compared != nil {
+		content = lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.renderPanes(currSection, compared),
+			m.sidebar.View(),
+		)
+	} else if currSection != nil {
 		content = lipgloss.JoinHorizontal(
 			lipgloss.Top,
 			m.getCurrSection().View(),
@@ -722,12 +1631,14 @@ func (m Model) View() string {
 		s.WriteString(m.footer.View())
 	}
 
-	return zone.Scan(s.String())
+	return m.ctx.Zone.Scan(s.String())
 }
 
 type initMsg struct {
 	Config  config.Config
 	RepoUrl string
+	// KeyConflicts describes the keys bound to several actions
+	KeyConflicts []string
 }
 
 func (m *Model) setCurrSectionId(newSectionId int) {
@@ -736,12 +1647,59 @@ func (m *Model) setCurrSectionId(newSectionId int) {
 }
 
 func (m *Model) onViewedRowChanged() tea.Cmd {
+	m.fileView.Close()
 	m.prView.SetSummaryViewLess()
 	m.prView.GoToFirstTab()
 	m.syncSidebar()
 	cmd := m.prView.EnrichCurrRow()
+	var detailsCmd tea.Cmd
+	if s := m.getCurrSection(); s != nil && s.GetConfig().IsGitHub() && m.ctx.View == config.IssuesView {
+		detailsCmd = m.issueSidebar.FetchTrimmedFields()
+	}
 	m.sidebar.ScrollToTop()
-	return cmd
+	return tea.Batch(cmd, detailsCmd, m.markViewedRowRead())
+}
+
+// resizeDebounce is how long we wait for the terminal to stop resizing before
+// re-laying out every section. Dragging a window edge emits a storm of
+// WindowSizeMsgs and re-rendering all tables for each of them causes flicker.
+const resizeDebounce = 50 * time.Millisecond
+
+type resizeDebouncedMsg struct {
+	id int
+}
+
+// frameCache holds the last rendered screen. While a resize burst settles,
+// the messages handled meanwhile aren't rendered one by one at a size that's
+// about to change, they're all rendered in the pass following the layout.
+type frameCache struct {
+	view string
+}
+
+func (m *Model) debounceWindowSizeChanged(msg tea.WindowSizeMsg) tea.Cmd {
+	// The first size is needed to lay out the initial screen so apply it right away
+	if m.ctx.ScreenWidth == 0 && m.ctx.ScreenHeight == 0 {
+		m.onWindowSizeChanged(msg)
+		return m.syncProfiles()
+	}
+
+	m.resizeId++
+	m.pendingResize = &msg
+	id := m.resizeId
+	return tea.Tick(resizeDebounce, func(time.Time) tea.Msg {
+		return resizeDebouncedMsg{id: id}
+	})
+}
+
+// applyPendingResize lays the screen out once for the last size of a resize
+// burst, the ticks of the earlier sizes are ignored
+func (m *Model) applyPendingResize(msg resizeDebouncedMsg) tea.Cmd {
+	if msg.id != m.resizeId || m.pendingResize == nil {
+		return nil
+	}
+	m.onWindowSizeChanged(*m.pendingResize)
+	m.pendingResize = nil
+	return m.syncProfiles()
 }
 
 func (m *Model) onWindowSizeChanged(msg tea.WindowSizeMsg) {
@@ -749,10 +1707,14 @@ func (m *Model) onWindowSizeChanged(msg tea.WindowSizeMsg) {
 	m.footer.SetWidth(msg.Width)
 	m.ctx.ScreenWidth = msg.Width
 	m.ctx.ScreenHeight = msg.Height
+	tabsHeight := common.TabsHeight
+	if m.ctx.Mini {
+		tabsHeight = 0
+	}
 	if m.footer.ShowAll {
-		m.ctx.MainContentHeight = msg.Height - common.TabsHeight - common.ExpandedHelpHeight
+		m.ctx.MainContentHeight = msg.Height - tabsHeight - common.ExpandedHelpHeight
 	} else {
-		m.ctx.MainContentHeight = msg.Height - common.TabsHeight - common.FooterHeight
+		m.ctx.MainContentHeight = msg.Height - tabsHeight - common.FooterHeight
 	}
 	m.syncMainContentWidth()
 }
@@ -766,10 +1728,25 @@ func (m *Model) syncProgramContext() {
 	m.sidebar.UpdateProgramContext(m.ctx)
 	m.prView.UpdateProgramContext(m.ctx)
 	m.issueSidebar.UpdateProgramContext(m.ctx)
+	m.discussionSidebar.UpdateProgramContext(m.ctx)
+	m.fileView.UpdateProgramContext(m.ctx)
 	m.branchSidebar.UpdateProgramContext(m.ctx)
+	m.historyOverlay.UpdateProgramContext(m.ctx)
+	m.planner.UpdateProgramContext(m.ctx)
+	m.labelPicker.UpdateProgramContext(m.ctx)
+	m.milestonePicker.UpdateProgramContext(m.ctx)
+	m.sectionEditor.UpdateProgramContext(m.ctx)
+	m.themePicker.UpdateProgramContext(m.ctx)
+	m.filterPicker.UpdateProgramContext(m.ctx)
+	m.snoozePicker.UpdateProgramContext(m.ctx)
+	m.filterDebug.UpdateProgramContext(m.ctx)
+	m.releaseNotes.UpdateProgramContext(m.ctx)
+	m.palette.UpdateProgramContext(m.ctx)
+	m.itemForm.UpdateProgramContext(m.ctx)
 }
 
 func (m *Model) updateSection(id int, sType string, msg tea.Msg) (cmd tea.Cmd) {
+	notifyCmd := m.notifyNewRows(id, msg)
 	var updatedSection section.Section
 	switch sType {
 	case reposection.SectionType:
@@ -781,6 +1758,28 @@ func (m *Model) updateSection(id int, sType string, msg tea.Msg) (cmd tea.Cmd) {
 	case issuessection.SectionType:
 		updatedSection, cmd = m.issues[id].Update(msg)
 		m.issues[id] = updatedSection
+	case workflowssection.SectionType:
+		updatedSection, cmd = m.workflows[id].Update(msg)
+		m.workflows[id] = updatedSection
+	case feedssection.SectionType:
+		updatedSection, cmd = m.feeds[id].Update(msg)
+		m.feeds[id] = updatedSection
+	case discussionssection.SectionType:
+		updatedSection, cmd = m.discussions[id].Update(msg)
+		m.discussions[id] = updatedSection
+	case releasessection.SectionType:
+		updatedSection, cmd = m.releases[id].Update(msg)
+		m.releases[id] = updatedSection
+	case dependenciessection.SectionType:
+		updatedSection, cmd = m.dependencies[id].Update(msg)
+		m.dependencies[id] = updatedSection
+	case archivesection.SectionType:
+		updatedSection, cmd = m.archive[id].Update(msg)
+		m.archive[id] = updatedSection
+	}
+	cmd = tea.Batch(cmd, m.trackArchive(id, msg))
+	if updatedSection != nil {
+		restoreCursor(updatedSection)
 	}
 
 	currSection := m.getCurrSection()
@@ -790,7 +1789,7 @@ func (m *Model) updateSection(id int, sType string, msg tea.Msg) (cmd tea.Cmd) {
 		}
 	}
 
-	return cmd
+	return tea.Batch(cmd, notifyCmd)
 }
 
 func (m *Model) updateRelevantSection(msg section.SectionMsg) (cmd tea.Cmd) {
@@ -811,6 +1810,10 @@ func (m *Model) syncMainContentWidth() {
 		sideBarOffset = m.ctx.Config.Defaults.Preview.Width
 	}
 	m.ctx.MainContentWidth = m.ctx.ScreenWidth - sideBarOffset
+	if m.getComparedSection() != nil {
+		// both panes get half of the width, minus their separator
+		m.ctx.MainContentWidth = (m.ctx.MainContentWidth - 1) / 2
+	}
 }
 
 func (m *Model) syncSidebar() tea.Cmd {
@@ -818,6 +1821,12 @@ func (m *Model) syncSidebar() tea.Cmd {
 	width := m.sidebar.GetSidebarContentWidth()
 	var cmd tea.Cmd
 
+	if m.fileView.IsOpen() {
+		m.fileView.SetWidth(width)
+		m.sidebar.SetContent(m.fileView.View())
+		return nil
+	}
+
 	if currRowData == nil {
 		m.sidebar.SetContent("")
 		return nil
@@ -827,6 +1836,9 @@ func (m *Model) syncSidebar() tea.Cmd {
 	case branch.BranchData:
 		cmd = m.branchSidebar.SetRow(&row)
 		m.sidebar.SetContent(m.branchSidebar.View())
+	case branch.StashData:
+		cmd = m.branchSidebar.SetStash(&row)
+		m.sidebar.SetContent(m.branchSidebar.View())
 	case *prrow.Data:
 		m.prView.SetSectionId(m.currSectionId)
 		m.prView.SetRow(row)
@@ -835,8 +1847,32 @@ func (m *Model) syncSidebar() tea.Cmd {
 	case *data.IssueData:
 		m.issueSidebar.SetSectionId(m.currSectionId)
 		m.issueSidebar.SetRow(row)
+		m.issueSidebar.SetEstimate("")
+		if s, ok := m.getCurrSection().(interface{ EstimateValue(url, repo string) string }); ok {
+			m.issueSidebar.SetEstimate(s.EstimateValue(row.Url, row.GetRepoNameWithOwner()))
+		}
 		m.issueSidebar.SetWidth(width)
 		m.sidebar.SetContent(m.issueSidebar.View())
+	case *data.WorkflowRunData:
+		run := workflowrow.Run{Ctx: m.ctx, Data: *row}
+		m.sidebar.SetContent(run.RenderDetails(width))
+	case *data.FeedEntry:
+		entry := feedrow.Entry{Ctx: m.ctx, Data: *row}
+		m.sidebar.SetContent(entry.RenderDetails(width))
+	case *data.ReleaseTrainRepo:
+		repo := releaserow.Repo{Ctx: m.ctx, Data: *row}
+		m.sidebar.SetContent(repo.RenderDetails(width))
+	case *data.DependencyStatus:
+		status := dependencyrow.Status{Ctx: m.ctx, Data: *row}
+		m.sidebar.SetContent(status.RenderDetails(width))
+	case *state.ArchivedItem:
+		item := archiverow.Item{Ctx: m.ctx, Data: *row}
+		m.sidebar.SetContent(item.RenderDetails(width))
+	case *data.DiscussionData:
+		m.discussionSidebar.SetSectionId(m.currSectionId)
+		m.discussionSidebar.SetRow(row)
+		m.discussionSidebar.SetWidth(width)
+		m.sidebar.SetContent(m.discussionSidebar.View())
 	}
 
 	return cmd
@@ -857,6 +1893,30 @@ func (m *Model) fetchAllViewSections() ([]section.Section, tea.Cmd) {
 		s, prcmds := prssection.FetchAllSections(m.ctx, m.prs)
 		cmds = append(cmds, prcmds)
 		return s, tea.Batch(cmds...)
+	case config.WorkflowsView:
+		s, workflowcmds := workflowssection.FetchAllSections(m.ctx)
+		cmds = append(cmds, workflowcmds)
+		return s, tea.Batch(cmds...)
+	case config.FeedsView:
+		s, feedcmds := feedssection.FetchAllSections(m.ctx)
+		cmds = append(cmds, feedcmds)
+		return s, tea.Batch(cmds...)
+	case config.DiscussionsView:
+		s, discussioncmds := discussionssection.FetchAllSections(m.ctx)
+		cmds = append(cmds, discussioncmds)
+		return s, tea.Batch(cmds...)
+	case config.ReleasesView:
+		s, releasecmds := releasessection.FetchAllSections(m.ctx)
+		cmds = append(cmds, releasecmds)
+		return s, tea.Batch(cmds...)
+	case config.DependenciesView:
+		s, dependencycmds := dependenciessection.FetchAllSections(m.ctx)
+		cmds = append(cmds, dependencycmds)
+		return s, tea.Batch(cmds...)
+	case config.ArchiveView:
+		s, archivecmds := archivesection.FetchAllSections(m.ctx)
+		cmds = append(cmds, archivecmds)
+		return s, tea.Batch(cmds...)
 	default:
 		s, issuecmds := issuessection.FetchAllSections(m.ctx)
 		cmds = append(cmds, issuecmds)
@@ -865,11 +1925,27 @@ func (m *Model) fetchAllViewSections() ([]section.Section, tea.Cmd) {
 }
 
 func (m *Model) getCurrentViewSections() []section.Section {
-	switch m.ctx.View {
+	return m.getViewSections(m.ctx.View)
+}
+
+func (m *Model) getViewSections(view config.ViewType) []section.Section {
+	switch view {
 	case config.RepoView:
 		return []section.Section{m.repo}
 	case config.PRsView:
 		return m.prs
+	case config.WorkflowsView:
+		return m.workflows
+	case config.FeedsView:
+		return m.feeds
+	case config.DiscussionsView:
+		return m.discussions
+	case config.ReleasesView:
+		return m.releases
+	case config.DependenciesView:
+		return m.dependencies
+	case config.ArchiveView:
+		return m.archive
 	default:
 		return m.issues
 	}
@@ -886,10 +1962,11 @@ func (m *Model) getCurrentViewDefaultSection() int {
 	}
 }
 
-func (m *Model) setCurrentViewSections(newSections []section.Section) {
+func (m *Model) setCurrentViewSections(newSections []section.Section) tea.Cmd {
 	if newSections == nil {
-		return
+		return nil
 	}
+	m.linkedRow = nil
 
 	missingSearchSection := len(newSections) == 0 || (len(newSections) > 0 && newSections[0].GetId() != 0)
 	s := make([]section.Section, 0)
@@ -909,6 +1986,97 @@ func (m *Model) setCurrentViewSections(newSections []section.Section) {
 		}
 		m.prs = append(s, newSections...)
 		newSections = m.prs
+	} else if m.ctx.View == config.WorkflowsView {
+		if missingSearchSection {
+			search := workflowssection.NewModel(
+				0,
+				m.ctx,
+				config.WorkflowsSectionConfig{
+					Title:   "",
+					Filters: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.workflows = append(s, newSections...)
+		newSections = m.workflows
+	} else if m.ctx.View == config.FeedsView {
+		if missingSearchSection {
+			search := feedssection.NewModel(
+				0,
+				m.ctx,
+				config.FeedsSectionConfig{
+					Title: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.feeds = append(s, newSections...)
+		newSections = m.feeds
+	} else if m.ctx.View == config.DiscussionsView {
+		if missingSearchSection {
+			search := discussionssection.NewModel(
+				0,
+				m.ctx,
+				config.DiscussionsSectionConfig{
+					Title: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.discussions = append(s, newSections...)
+		newSections = m.discussions
+	} else if m.ctx.View == config.ReleasesView {
+		if missingSearchSection {
+			search := releasessection.NewModel(
+				0,
+				m.ctx,
+				config.ReleasesSectionConfig{
+					Title: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.releases = append(s, newSections...)
+		newSections = m.releases
+	} else if m.ctx.View == config.DependenciesView {
+		if missingSearchSection {
+			search := dependenciessection.NewModel(
+				0,
+				m.ctx,
+				config.DependenciesSectionConfig{
+					Title: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.dependencies = append(s, newSections...)
+		newSections = m.dependencies
+	} else if m.ctx.View == config.ArchiveView {
+		if missingSearchSection {
+			search := archivesection.NewModel(
+				0,
+				m.ctx,
+				config.SectionConfig{
+					Title: "",
+				},
+				time.Now(),
+				time.Now(),
+			)
+			s = append(s, &search)
+		}
+		m.archive = append(s, newSections...)
+		newSections = m.archive
 	} else {
 		if missingSearchSection {
 			search := issuessection.NewModel(
@@ -928,62 +2096,88 @@ func (m *Model) setCurrentViewSections(newSections []section.Section) {
 	}
 
 	m.tabs.SetSections(newSections)
+	return m.scheduleSectionRefreshes()
 }
 
 func (m *Model) switchSelectedView() config.ViewType {
-	repoFF := config.IsFeatureEnabled(config.FF_REPO_VIEW)
-
-	if repoFF {
-		switch true {
-		case m.ctx.View == config.RepoView:
-			return config.PRsView
-		case m.ctx.View == config.PRsView:
-			return config.IssuesView
-		case m.ctx.View == config.IssuesView:
-			return config.RepoView
-		}
+	views := []config.ViewType{config.PRsView, config.IssuesView}
+	if len(m.ctx.Config.WorkflowsSections) > 0 {
+		views = append(views, config.WorkflowsView)
 	}
-
-	switch true {
-	case m.ctx.View == config.PRsView:
-		return config.IssuesView
-	default:
-		return config.PRsView
+	if len(m.ctx.Config.FeedsSections) > 0 {
+		views = append(views, config.FeedsView)
+	}
+	if len(m.ctx.Config.DiscussionsSections) > 0 {
+		views = append(views, config.DiscussionsView)
 	}
+	if len(m.ctx.Config.ReleasesSections) > 0 {
+		views = append(views, config.ReleasesView)
+	}
+	if len(m.ctx.Config.DependenciesSections) > 0 {
+		views = append(views, config.DependenciesView)
+	}
+	if m.ctx.ArchiveEnabled() {
+		views = append(views, config.ArchiveView)
+	}
+	if config.IsFeatureEnabled(config.FF_REPO_VIEW) && !m.ctx.ReadOnly {
+		views = append(views, config.RepoView)
+	}
+
+	i := slices.Index(views, m.ctx.View)
+	return views[(i+1)%len(views)]
 }
 
-func (m *Model) isUserDefinedKeybinding(msg tea.KeyMsg) bool {
-	for _, keybinding := range m.ctx.Config.Keybindings.Universal {
-		if keybinding.Builtin == "" && keybinding.Key == msg.String() {
-			return true
-		}
+// goToView switches straight to view, unlike switchSelectedView which cycles
+// through the views
+func (m *Model) goToView(view config.ViewType) tea.Cmd {
+	switch {
+	case view == m.ctx.View:
+		return nil
+	case view == config.WorkflowsView && len(m.ctx.Config.WorkflowsSections) == 0:
+		return m.notifyErr("No workflows sections are configured")
+	case view == config.FeedsView && len(m.ctx.Config.FeedsSections) == 0:
+		return m.notifyErr("No feeds sections are configured")
+	case view == config.DiscussionsView && len(m.ctx.Config.DiscussionsSections) == 0:
+		return m.notifyErr("No discussions sections are configured")
+	case view == config.ReleasesView && len(m.ctx.Config.ReleasesSections) == 0:
+		return m.notifyErr("No release trains are configured")
+	case view == config.DependenciesView && len(m.ctx.Config.DependenciesSections) == 0:
+		return m.notifyErr("No dependencies are configured")
+	case view == config.ArchiveView && m.ctx.ReadOnly:
+		return m.notifyErr("The archive isn't available on a read-only dashboard")
+	case view == config.ArchiveView && !m.ctx.ArchiveEnabled():
+		return m.notifyErr("The archive is off, set archive.days to turn it on")
+	case view == config.RepoView && !config.IsFeatureEnabled(config.FF_REPO_VIEW):
+		return m.notifyErr("The repo view is not enabled")
+	case view == config.RepoView && m.ctx.ReadOnly:
+		return m.notifyErr("The repo view isn't available on a read-only dashboard")
 	}
 
-	if m.ctx.View == config.IssuesView {
-		for _, keybinding := range m.ctx.Config.Keybindings.Issues {
-			if keybinding.Builtin == "" && keybinding.Key == msg.String() {
-				return true
-			}
-		}
+	if repo, ok := m.repo.(*reposection.Model); ok && m.ctx.View == config.RepoView {
+		repo.CancelGitOps()
 	}
 
-	if m.ctx.View == config.PRsView {
-		for _, keybinding := range m.ctx.Config.Keybindings.Prs {
-			if keybinding.Builtin == "" && keybinding.Key == msg.String() {
-				return true
-			}
-		}
-	}
+	var cmds []tea.Cmd
+	m.ctx.View = view
+	m.syncMainContentWidth()
+	m.setCurrSectionId(m.getCurrentViewDefaultSection())
 
-	if m.ctx.View == config.RepoView {
-		for _, keybinding := range m.ctx.Config.Keybindings.Branches {
-			if keybinding.Builtin == "" && keybinding.Key == msg.String() {
-				return true
-			}
-		}
+	currSections := m.getCurrentViewSections()
+	if len(currSections) == 0 || currSections[0] == nil {
+		newSections, fetchSectionsCmds := m.fetchAllViewSections()
+		currSections = newSections
+		cmds = append(cmds, fetchSectionsCmds)
+	} else if repo, ok := m.repo.(*reposection.Model); ok && view == config.RepoView {
+		cmds = append(cmds, repo.ReloadRepo()...)
 	}
+	cmds = append(cmds, m.setCurrentViewSections(currSections), m.onViewedRowChanged())
+
+	return tea.Batch(cmds...)
+}
 
-	return false
+func (m *Model) isUserDefinedKeybinding(msg tea.KeyMsg) bool {
+	_, _, ok := m.findUserKeybinding(msg.String())
+	return ok
 }
 
 func (m *Model) renderRunningTask() string {
@@ -1013,10 +2207,16 @@ func (m *Model) renderRunningTask() string {
 			Background(m.ctx.Theme.SelectedBackground).
 			Render(
 				fmt.Sprintf(
-					"%s%s",
+					"%s%s%s",
 					m.taskSpinner.View(),
 					task.StartText,
+					m.renderTaskProgress(task),
 				))
+	case context.TaskQueued:
+		currTaskStatus = lipgloss.NewStyle().
+			Foreground(m.ctx.Theme.WarningText).
+			Background(m.ctx.Theme.SelectedBackground).
+			Render(fmt.Sprintf("%s %s - offline, queued for retry", constants.WaitingIcon, task.StartText))
 	case context.TaskError:
 		currTaskStatus = lipgloss.NewStyle().
 			Foreground(m.ctx.Theme.ErrorText).
@@ -1051,6 +2251,17 @@ func (m *Model) renderRunningTask() string {
 		Render(strings.TrimSpace(lipgloss.JoinHorizontal(lipgloss.Top, stats, currTaskStatus)))
 }
 
+// renderTaskProgress renders the latest progress of a running task, cut to
+// keep the status line on one row
+func (m *Model) renderTaskProgress(task context.Task) string {
+	if task.Progress == "" {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Foreground(m.ctx.Theme.FaintText).
+		Render(": " + ansi.Truncate(task.Progress, 60, constants.Ellipsis))
+}
+
 type userFetchedMsg struct {
 	user string
 }
@@ -1068,19 +2279,15 @@ func fetchUser() tea.Msg {
 	}
 }
 
-type intervalRefresh time.Time
+// retryQueuedTasksMsg re-runs the tasks queued while GitHub was unreachable
+type retryQueuedTasksMsg struct{}
 
-func (m *Model) doRefreshAtInterval() tea.Cmd {
-	if m.ctx.Config.Defaults.RefetchIntervalMinutes == 0 {
-		return nil
-	}
+const queuedTasksRetryInterval = 30 * time.Second
 
-	return tea.Tick(
-		time.Minute*time.Duration(m.ctx.Config.Defaults.RefetchIntervalMinutes),
-		func(t time.Time) tea.Msg {
-			return intervalRefresh(t)
-		},
-	)
+func (m *Model) doRetryQueuedTasksAfterInterval() tea.Cmd {
+	return tea.Tick(queuedTasksRetryInterval, func(t time.Time) tea.Msg {
+		return retryQueuedTasksMsg{}
+	})
 }
 
 type updateFooterMsg struct{}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -14,7 +15,6 @@ import (
 	log "github.com/charmbracelet/log"
 	"github.com/charmbracelet/x/exp/teatest"
 	gh "github.com/cli/go-gh/v2/pkg/api"
-	zone "github.com/lrstanley/bubblezone"
 
 	"github.com/dlvhdr/gh-dash/v4/internal/config"
 	"github.com/dlvhdr/gh-dash/v4/internal/data"
@@ -56,6 +56,69 @@ func TestIssues(t *testing.T) {
 	tm.WaitFinished(t, teatest.WithFinalTimeout(5*time.Second))
 }
 
+func TestChordGoToIssues(t *testing.T) {
+	setupTest(t)
+	m := NewModel(config.Location{RepoPath: "", ConfigFlag: "../config/testdata/test-config.yml"})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(160, 60))
+
+	testutils.WaitForText(t, tm, "Mine")
+
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("g"),
+	})
+	testutils.WaitForText(t, tm, "go to issues")
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("i"),
+	})
+	testutils.WaitForText(t, tm, "[Feature Request] Support notifications", teatest.WithDuration(6*time.Second))
+	tm.Send(tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune("q"),
+	})
+	tm.WaitFinished(t, teatest.WithFinalTimeout(5*time.Second))
+}
+
+// TestSharedModels runs two dashboards at once, like the sessions of serve,
+// one of them switching views. Run with -race, it catches the state they
+// share. Their cmds aren't run, only what the sessions do on their own.
+func TestSharedModels(t *testing.T) {
+	setupTest(t)
+	location := config.Location{ConfigFlag: "../config/testdata/test-config.yml", ReadOnly: true, Shared: true}
+	cfg, err := config.ParseConfig(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Configure(cfg); err != nil {
+		t.Fatal(err)
+	}
+	// the lock of the logger would order what the dashboards do, hiding
+	// their races
+	level := log.GetLevel()
+	log.SetLevel(log.FatalLevel)
+	t.Cleanup(func() { log.SetLevel(level) })
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"s", "j"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m := NewModel(location)
+			defer m.Close()
+
+			var model tea.Model = m
+			model, _ = model.Update(m.initScreen())
+			model, _ = model.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+			for range 20 {
+				model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+				_ = model.View()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 func setupTest(t *testing.T) {
 	if _, debug := os.LookupEnv("DEBUG"); debug {
 		f, _ := os.CreateTemp("", "gh-dash-debug.log")
@@ -65,10 +128,10 @@ func setupTest(t *testing.T) {
 		log.SetLevel(log.DebugLevel)
 	}
 	setMockClient(t)
+	// keep cached responses of one test from leaking into another
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
 
 	markdown.InitializeMarkdownStyle(true)
-	zone.NewGlobal()
-	zone.SetEnabled(false)
 }
 
 // localRoundTripper is an http.RoundTripper that executes HTTP transactions
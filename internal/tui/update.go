@@ -0,0 +1,109 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/log"
+
+	"github.com/dlvhdr/gh-dash/v4/internal/data"
+	"github.com/dlvhdr/gh-dash/v4/internal/state"
+	"github.com/dlvhdr/gh-dash/v4/internal/tui/focus"
+)
+
+// updateCheckInterval is how often the latest release of gh-dash is fetched,
+// the one found by the last check is used in between
+const updateCheckInterval = 24 * time.Hour
+
+// releaseFetchedMsg carries the latest release of gh-dash, its notes are
+// shown when show is set
+type releaseFetchedMsg struct {
+	release data.DashRelease
+	err     error
+	show    bool
+}
+
+// checkForUpdate looks for a newer release of gh-dash when the update check
+// is enabled
+func (m *Model) checkForUpdate() tea.Cmd {
+	if !m.ctx.Config.UpdateCheck.Enabled {
+		return nil
+	}
+	return func() tea.Msg {
+		release, err := latestDashRelease(time.Now())
+		return releaseFetchedMsg{release: release, err: err}
+	}
+}
+
+// showReleaseNotes opens the notes of the latest release of gh-dash, it's
+// fetched first unless it already was
+func (m *Model) showReleaseNotes() tea.Cmd {
+	if m.latestRelease != nil {
+		m.releaseNotes.Open(*m.latestRelease)
+		m.focus.Push(focus.Palette)
+		return nil
+	}
+	return func() tea.Msg {
+		release, err := latestDashRelease(time.Now())
+		return releaseFetchedMsg{release: release, err: err, show: true}
+	}
+}
+
+func (m *Model) onReleaseFetched(msg releaseFetchedMsg) tea.Cmd {
+	if msg.err != nil {
+		log.Error("Failed fetching the latest release of gh-dash", "err", msg.err)
+		if msg.show {
+			return m.notifyErr(fmt.Sprintf("Failed fetching the latest release: %v", msg.err))
+		}
+		return nil
+	}
+
+	m.latestRelease = &msg.release
+	if data.IsNewerVersion(m.ctx.Version, msg.release.Version) {
+		log.Info("A new release of gh-dash is out", "version", msg.release.Version)
+		m.ctx.NewVersion = msg.release.Version
+	}
+	if msg.show {
+		m.releaseNotes.Open(msg.release)
+		m.focus.Push(focus.Palette)
+	}
+	return nil
+}
+
+// latestDashRelease returns the latest release of gh-dash, fetched at most
+// once per updateCheckInterval and saved in between
+func latestDashRelease(now time.Time) (data.DashRelease, error) {
+	dir, err := state.Dir()
+	if err != nil {
+		return data.FetchLatestDashRelease()
+	}
+
+	check := state.LoadUpdateCheck(dir)
+	if !check.IsStale(updateCheckInterval, now) {
+		return data.DashRelease{
+			Version:     check.Version,
+			Name:        check.Name,
+			Url:         check.Url,
+			Notes:       check.Notes,
+			PublishedAt: check.PublishedAt,
+		}, nil
+	}
+
+	release, err := data.FetchLatestDashRelease()
+	if err != nil {
+		return release, err
+	}
+	err = state.SaveUpdateCheck(dir, state.UpdateCheck{
+		CheckedAt:   now,
+		Version:     release.Version,
+		Name:        release.Name,
+		Url:         release.Url,
+		Notes:       release.Notes,
+		PublishedAt: release.PublishedAt,
+	})
+	if err != nil {
+		log.Error("Failed saving the update check", "err", err)
+	}
+	return release, nil
+}
@@ -1,12 +1,23 @@
 package utils
 
 import (
+	"bytes"
+	"log/slog"
 	"regexp"
 	"strings"
+	"text/template"
 	"time"
+	"unicode"
 
 	"github.com/charmbracelet/log"
 	"github.com/go-sprout/sprout"
+	"github.com/go-sprout/sprout/registry/conversion"
+	"github.com/go-sprout/sprout/registry/env"
+	"github.com/go-sprout/sprout/registry/numeric"
+	"github.com/go-sprout/sprout/registry/slices"
+	"github.com/go-sprout/sprout/registry/std"
+	sproutstrings "github.com/go-sprout/sprout/registry/strings"
+	timeregistry "github.com/go-sprout/sprout/registry/time"
 )
 
 type TemplateRegistry struct {
@@ -27,18 +38,37 @@ func (or *TemplateRegistry) LinkHandler(fh sprout.Handler) error {
 }
 
 func (or *TemplateRegistry) NowModify(input string) (string, error) {
-	now := time.Now()
+	return ModifyDate(time.Now(), input)
+}
+
+// ModifyDate returns the day of t moved by the duration input, e.g. "-2w", in
+// the format of the search qualifiers
+func ModifyDate(t time.Time, input string) (string, error) {
 	duration, err := ParseDuration(input)
 	if err != nil {
 		log.Error("failed parsing duration", "input", input)
 		return "", err
 	}
 
-	return now.Add(duration).Format("2006-01-02"), nil
+	return t.Add(duration).Format("2006-01-02"), nil
+}
+
+// BusinessDays returns the number of weekdays from the day of from to
+// today, e.g. 1 for a PR opened on Friday on Monday
+func (or *TemplateRegistry) BusinessDays(from time.Time) int {
+	return BusinessDays(from, time.Now())
+}
+
+// DaysSince returns the number of days from the day of from to today, e.g. 3
+// for a PR reviewed on Friday on Monday
+func (or *TemplateRegistry) DaysSince(from time.Time) int {
+	return DaysSince(from, time.Now())
 }
 
 func (or *TemplateRegistry) RegisterFunctions(funcsMap sprout.FunctionMap) error {
 	sprout.AddFunction(funcsMap, "nowModify", or.NowModify)
+	sprout.AddFunction(funcsMap, "businessDays", or.BusinessDays)
+	sprout.AddFunction(funcsMap, "daysSince", or.DaysSince)
 	return nil
 }
 
@@ -46,6 +76,158 @@ func (or *TemplateRegistry) RegisterAliases(aliasMap sprout.FunctionAliasMap) er
 	return nil
 }
 
+// SearchVars are the variables of a section's search template, e.g.
+// {{ .CurrentUser }}. The repos are given as owner/name.
+type SearchVars struct {
+	Now time.Time
+	// CurrentUser is the login of the GitHub user
+	CurrentUser string
+	// CurrentRepo is the repo of origin, same as OriginRepo
+	CurrentRepo string
+	OriginRepo  string
+	// UpstreamRepo is the repo of upstream, or of origin without an upstream
+	// remote
+	UpstreamRepo string
+	// CurrentBranch is the branch checked out in the local repo
+	CurrentBranch string
+}
+
+// ExpandSearchTemplate executes the template functions of a section's
+// search, e.g. {{ nowModify "-2w" }}. The search is returned as is if it
+// isn't a valid template.
+func ExpandSearchTemplate(searchValue string) string {
+	return ExpandSearchTemplateWithVars(searchValue, SearchVars{})
+}
+
+// ExpandSearchTemplateWithVars is ExpandSearchTemplate with the variables of
+// the dashboard. It doesn't read environment variables, the search may have
+// been typed by anyone looking at the dashboard.
+func ExpandSearchTemplateWithVars(searchValue string, vars SearchVars) string {
+	return expandSearchTemplate(searchValue, vars, false)
+}
+
+// ExpandFiltersTemplate is ExpandSearchTemplateWithVars for a search starting
+// from the filters of the config, environment variables can then be read with
+// {{ env "NAME" }}. They're only read by the template actions of filters, in
+// the other actions of the search env and expandEnv return "" so that a typed
+// action never reads them.
+func ExpandFiltersTemplate(searchValue string, filters string, vars SearchVars) string {
+	searchValue = templateActionRegex.ReplaceAllStringFunc(searchValue, func(action string) string {
+		if strings.Contains(filters, action) {
+			return action
+		}
+		return envFuncRegex.ReplaceAllStringFunc(action, func(call string) string {
+			name := strings.TrimLeftFunc(call, func(r rune) bool { return !unicode.IsLetter(r) })
+			return strings.TrimSuffix(call, name) + untrustedEnvFuncs[name]
+		})
+	})
+	return expandSearchTemplate(searchValue, vars, true)
+}
+
+var (
+	templateActionRegex = regexp.MustCompile(`(?s)\{\{.*?\}\}`)
+	// envFuncRegex matches the calls of the env functions, not the fields or
+	// strings named like them
+	envFuncRegex = regexp.MustCompile(`(?:^|[^\w."$])(?:env|expandEnv)\b`)
+	// untrustedEnvFuncs are the stubs the env functions are replaced with in
+	// the actions that aren't part of the configured filters
+	untrustedEnvFuncs = map[string]string{
+		"env":       "untrustedEnv",
+		"expandEnv": "untrustedExpandEnv",
+	}
+)
+
+func expandSearchTemplate(searchValue string, vars SearchVars, allowEnv bool) string {
+	if vars.Now.IsZero() {
+		vars.Now = time.Now()
+	}
+	registries := []sprout.Registry{timeregistry.NewRegistry(), NewRegistry()}
+	if allowEnv {
+		registries = append(registries, env.NewRegistry())
+	}
+	sl := slog.New(log.Default())
+	handler := sprout.New(sprout.WithRegistries(registries...), sprout.WithLogger(sl))
+	funcs := handler.Build()
+	if allowEnv {
+		for _, stub := range untrustedEnvFuncs {
+			funcs[stub] = func(string) string { return "" }
+		}
+	}
+
+	tmpl, err := template.New("search").Funcs(funcs).Parse(searchValue)
+	if err != nil {
+		log.Error("bad template", "err", err)
+		return searchValue
+	}
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, vars)
+	if err != nil {
+		return searchValue
+	}
+
+	return buf.String()
+}
+
+// ParseColumnTemplate parses the template of a computed column. Besides the
+// search template functions, it can use the std, strings, numeric, slices and
+// conversion functions of sprout, e.g. {{ add .Additions .Deletions }}.
+func ParseColumnTemplate(name string, text string) (*template.Template, error) {
+	sl := slog.New(log.Default())
+	handler := sprout.New(sprout.WithRegistries(
+		std.NewRegistry(),
+		sproutstrings.NewRegistry(),
+		numeric.NewRegistry(),
+		slices.NewRegistry(),
+		conversion.NewRegistry(),
+		timeregistry.NewRegistry(),
+		NewRegistry(),
+	), sprout.WithLogger(sl))
+
+	return template.New(name).Funcs(handler.Build()).Parse(text)
+}
+
+// ExecuteColumnTemplate executes the template of a computed column with the
+// fields of a row, the value is trimmed to fit on a single line
+func ExecuteColumnTemplate(tmpl *template.Template, fields map[string]any) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, fields); err != nil {
+		return "", err
+	}
+	return strings.Join(strings.Fields(buf.String()), " "), nil
+}
+
+// BusinessDays returns the number of weekdays from the day of from to the day
+// of to, in the time zone of from. It's negative when to is before from.
+func BusinessDays(from time.Time, to time.Time) int {
+	fromDay := civilDay(from, from.Location())
+	toDay := civilDay(to, from.Location())
+	if toDay.Before(fromDay) {
+		return -BusinessDays(to.In(from.Location()), from)
+	}
+
+	days := int(toDay.Sub(fromDay).Hours() / 24)
+	count := days / 7 * 5
+	for day := fromDay.AddDate(0, 0, days/7*7); day.Before(toDay); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			count++
+		}
+	}
+	return count
+}
+
+// DaysSince returns the number of days from the day of from to the day of to,
+// in the time zone of from. It's negative when to is before from.
+func DaysSince(from time.Time, to time.Time) int {
+	return int(civilDay(to, from.Location()).Sub(civilDay(from, from.Location())).Hours() / 24)
+}
+
+// civilDay returns the day of t in loc, as midnight UTC so that days are 24
+// hours apart regardless of daylight saving time
+func civilDay(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
 // ParseDuration parses a duration string.
 // examples: "10d", "-1.5w" or "3Y4M5d".
 // Add time units are "d"="D", "w"="W", "mo=M", "y"="Y".
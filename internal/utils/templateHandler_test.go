@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessDays(t *testing.T) {
+	// 2024-01-05 is a Friday
+	friday := time.Date(2024, 1, 5, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want int
+	}{
+		{name: "same day", from: friday, to: friday.Add(time.Hour), want: 0},
+		{name: "over the weekend", from: friday, to: friday.AddDate(0, 0, 3), want: 1},
+		{name: "on the weekend", from: friday, to: friday.AddDate(0, 0, 2), want: 1},
+		{name: "from the weekend", from: friday.AddDate(0, 0, 1), to: friday.AddDate(0, 0, 3), want: 0},
+		{name: "two weeks", from: friday, to: friday.AddDate(0, 0, 14), want: 10},
+		{name: "two weeks and a day", from: friday, to: friday.AddDate(0, 0, 17), want: 11},
+		{name: "backwards", from: friday.AddDate(0, 0, 3), to: friday, want: -1},
+		{
+			name: "across midnight",
+			from: friday,
+			to:   time.Date(2024, 1, 8, 0, 30, 0, 0, time.UTC),
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BusinessDays(tt.from, tt.to); got != tt.want {
+				t.Errorf("BusinessDays() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysSince(t *testing.T) {
+	friday := time.Date(2024, 1, 5, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		to   time.Time
+		want int
+	}{
+		{name: "same day", to: friday.Add(time.Hour), want: 0},
+		{name: "over the weekend", to: friday.AddDate(0, 0, 3), want: 3},
+		{name: "across midnight", to: time.Date(2024, 1, 6, 0, 30, 0, 0, time.UTC), want: 1},
+		{name: "backwards", to: friday.AddDate(0, 0, -2), want: -2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DaysSince(friday, tt.to); got != tt.want {
+				t.Errorf("DaysSince() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnTemplate(t *testing.T) {
+	fields := map[string]any{
+		"Additions": 400,
+		"Deletions": 200,
+		"Ci":        "FAILURE",
+		"Labels":    []string{"bug", "p1"},
+		"Mine":      true,
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  bool
+	}{
+		{name: "field", template: "{{ .Ci }}", want: "FAILURE"},
+		{name: "numeric", template: "{{ add .Additions .Deletions }}", want: "600"},
+		{
+			name:     "risk",
+			template: `{{ if eq .Ci "FAILURE" }}high{{ else if gt (add .Additions .Deletions) 500 }}medium{{ else }}low{{ end }}`,
+			want:     "high",
+		},
+		{name: "boolean", template: `{{ if .Mine }}yes{{ end }}`, want: "yes"},
+		{name: "slices", template: `{{ if has "p1" .Labels }}P1{{ end }}`, want: "P1"},
+		{name: "single line", template: "a\n  b  ", want: "a b"},
+		{name: "bad template", template: "{{ .Ci", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseColumnTemplate(tt.name, tt.template)
+			if err == nil {
+				var got string
+				got, err = ExecuteColumnTemplate(tmpl, fields)
+				if got != tt.want {
+					t.Errorf("value = %q, want %q", got, tt.want)
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpandSearchTemplateWithVars(t *testing.T) {
+	t.Setenv("GH_DASH_TEAM", "platform")
+	vars := SearchVars{
+		Now:           time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC),
+		CurrentUser:   "octocat",
+		CurrentRepo:   "octocat/gh-dash",
+		OriginRepo:    "octocat/gh-dash",
+		UpstreamRepo:  "dlvhdr/gh-dash",
+		CurrentBranch: "fix-typo",
+	}
+
+	tests := []struct {
+		name   string
+		search string
+		want   string
+	}{
+		{name: "no template", search: "is:open author:@me", want: "is:open author:@me"},
+		{
+			name:   "user and repo",
+			search: "involves:{{ .CurrentUser }} repo:{{ .UpstreamRepo }}",
+			want:   "involves:octocat repo:dlvhdr/gh-dash",
+		},
+		{name: "branch", search: "head:{{ .CurrentBranch }}", want: "head:fix-typo"},
+		{name: "env isn't read", search: `team:{{ env "GH_DASH_TEAM" }}`, want: `team:{{ env "GH_DASH_TEAM" }}`},
+		{name: "now", search: `updated:>={{ .Now.Format "2006-01-02" }}`, want: "updated:>=2024-03-10"},
+		{name: "unknown variable", search: "repo:{{ .Nope }}", want: "repo:{{ .Nope }}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandSearchTemplateWithVars(tt.search, vars); got != tt.want {
+				t.Errorf("ExpandSearchTemplateWithVars(%q) = %q, want %q", tt.search, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandFiltersTemplate(t *testing.T) {
+	t.Setenv("GH_DASH_TEAM", "platform")
+	t.Setenv("GH_DASH_SECRET", "hunter2")
+	filters := `is:open team:{{ env "GH_DASH_TEAM" }}`
+	vars := SearchVars{CurrentUser: "octocat"}
+
+	tests := []struct {
+		name   string
+		search string
+		want   string
+	}{
+		{name: "configured filters", search: filters, want: "is:open team:platform"},
+		{
+			name:   "qualifiers typed after the filters",
+			search: filters + " author:{{ .CurrentUser }}",
+			want:   "is:open team:platform author:octocat",
+		},
+		{
+			name:   "typed env action",
+			search: `is:open team:{{ env "GH_DASH_SECRET" }}`,
+			want:   "is:open team:",
+		},
+		{
+			name:   "env action typed next to the filters",
+			search: filters + ` label:{{ expandEnv "$GH_DASH_SECRET" }}`,
+			want:   "is:open team:platform label:",
+		},
+		{
+			name:   "typed string named like env",
+			search: `label:{{ "env" }}`,
+			want:   "label:env",
+		},
+		{name: "no env action", search: "author:{{ .CurrentUser }}", want: "author:octocat"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandFiltersTemplate(tt.search, filters, vars); got != tt.want {
+				t.Errorf("ExpandFiltersTemplate(%q) = %q, want %q", tt.search, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+// Package workflowlint checks GitHub Actions workflow files for the mistakes
+// GitHub would only report once they're pushed: invalid YAML, keys the
+// workflow syntax doesn't have, jobs without a runner, steps doing nothing,
+// unknown needs and unclosed expressions.
+package workflowlint
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Problem is a mistake in a workflow file, Line and Column start at 1
+type Problem struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%d:%d: %s", p.Line, p.Column, p.Message)
+}
+
+// IsWorkflow returns whether the file at filePath of a repo is a workflow
+func IsWorkflow(filePath string) bool {
+	dir, name := path.Split(strings.TrimPrefix(filePath, "./"))
+	ext := path.Ext(name)
+	return dir == ".github/workflows/" && (ext == ".yml" || ext == ".yaml")
+}
+
+var (
+	workflowKeys = []string{
+		"name", "run-name", "on", "permissions", "env", "defaults", "concurrency", "jobs",
+	}
+	jobKeys = []string{
+		"name", "permissions", "needs", "if", "runs-on", "environment", "concurrency",
+		"outputs", "env", "defaults", "steps", "timeout-minutes", "strategy",
+		"continue-on-error", "container", "services", "uses", "with", "secrets",
+	}
+	// reusableJobKeys are the keys of the jobs calling a reusable workflow
+	reusableJobKeys = []string{
+		"name", "permissions", "needs", "if", "uses", "with", "secrets", "strategy",
+		"concurrency",
+	}
+	stepKeys = []string{
+		"id", "if", "name", "uses", "run", "shell", "with", "env", "continue-on-error",
+		"timeout-minutes", "working-directory",
+	}
+	events = []string{
+		"branch_protection_rule", "check_run", "check_suite", "create", "delete",
+		"deployment", "deployment_status", "discussion", "discussion_comment", "fork",
+		"gollum", "issue_comment", "issues", "label", "merge_group", "milestone",
+		"page_build", "public", "pull_request", "pull_request_review",
+		"pull_request_review_comment", "pull_request_target", "push", "registry_package",
+		"release", "repository_dispatch", "schedule", "status", "watch",
+		"workflow_call", "workflow_dispatch", "workflow_run",
+	}
+
+	idPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+	// actionPattern matches the actions used by their repo, a ref is required
+	actionPattern = regexp.MustCompile(`^[^/@\s]+/[^@\s]+@[^@\s]+$`)
+)
+
+type linter struct {
+	problems []Problem
+}
+
+func (l *linter) add(node *yaml.Node, format string, args ...any) {
+	l.problems = append(l.problems, Problem{
+		Line:    node.Line,
+		Column:  node.Column,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Lint returns the problems of the workflow whose content is content, in the
+// order they appear in
+func Lint(content []byte) []Problem {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return []Problem{yamlProblem(err)}
+	}
+	if len(doc.Content) == 0 {
+		return []Problem{{Line: 1, Column: 1, Message: "the workflow is empty"}}
+	}
+
+	l := &linter{}
+	l.lintWorkflow(doc.Content[0])
+	slices.SortStableFunc(l.problems, func(a, b Problem) int {
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+	return l.problems
+}
+
+// yamlLinePattern finds the line in the errors of the YAML parser
+var yamlLinePattern = regexp.MustCompile(`line (\d+)`)
+
+func yamlProblem(err error) Problem {
+	msg := strings.TrimPrefix(err.Error(), "yaml: ")
+	line := 1
+	if m := yamlLinePattern.FindStringSubmatch(msg); m != nil {
+		line, _ = strconv.Atoi(m[1])
+	}
+	return Problem{Line: line, Column: 1, Message: "invalid YAML: " + msg}
+}
+
+// mapping returns the keys and values of node in order, nil if it isn't a
+// mapping
+func mapping(node *yaml.Node) [][2]*yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	pairs := make([][2]*yaml.Node, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		pairs = append(pairs, [2]*yaml.Node{node.Content[i], node.Content[i+1]})
+	}
+	return pairs
+}
+
+func (l *linter) lintWorkflow(root *yaml.Node) {
+	if root.Kind != yaml.MappingNode {
+		l.add(root, "the workflow must be a mapping of keys like on and jobs")
+		return
+	}
+
+	var on, jobs *yaml.Node
+	for _, pair := range mapping(root) {
+		key, value := pair[0], pair[1]
+		switch {
+		case key.Value == "on":
+			on = value
+		case key.Value == "jobs":
+			jobs = value
+		case !slices.Contains(workflowKeys, key.Value):
+			l.add(key, "unexpected key %q in the workflow", key.Value)
+		}
+		l.lintExpressions(value)
+	}
+
+	if on == nil {
+		l.add(root, `the workflow has no "on" trigger`)
+	} else {
+		l.lintOn(on)
+	}
+	if jobs == nil {
+		l.add(root, `the workflow has no "jobs"`)
+	} else {
+		l.lintJobs(jobs)
+	}
+}
+
+func (l *linter) lintOn(on *yaml.Node) {
+	lintEvent := func(node *yaml.Node) {
+		if !slices.Contains(events, node.Value) {
+			l.add(node, "unknown event %q", node.Value)
+		}
+	}
+	switch on.Kind {
+	case yaml.ScalarNode:
+		lintEvent(on)
+	case yaml.SequenceNode:
+		for _, event := range on.Content {
+			lintEvent(event)
+		}
+	case yaml.MappingNode:
+		for _, pair := range mapping(on) {
+			lintEvent(pair[0])
+			if pair[0].Value == "schedule" && pair[1].Kind != yaml.SequenceNode {
+				l.add(pair[1], "schedule must be a list of cron entries")
+			}
+		}
+	}
+}
+
+func (l *linter) lintJobs(jobs *yaml.Node) {
+	pairs := mapping(jobs)
+	if pairs == nil {
+		l.add(jobs, "jobs must be a mapping of job ids to jobs")
+		return
+	}
+	if len(pairs) == 0 {
+		l.add(jobs, "the workflow has no job")
+		return
+	}
+
+	ids := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		ids = append(ids, pair[0].Value)
+	}
+	needs := map[string][]string{}
+	for _, pair := range pairs {
+		id, job := pair[0], pair[1]
+		if !idPattern.MatchString(id.Value) {
+			l.add(id, "invalid job id %q, it must start with a letter or _ and contain only alphanumerics, - or _", id.Value)
+		}
+		needs[id.Value] = l.lintJob(id.Value, job, ids)
+	}
+
+	for _, pair := range pairs {
+		if cycle := findCycle(pair[0].Value, needs); cycle != nil {
+			l.add(pair[0], "job %q is in a cycle of needs: %s", pair[0].Value, strings.Join(cycle, " -> "))
+			return
+		}
+	}
+}
+
+// lintJob lints the job called id and returns the jobs it needs
+func (l *linter) lintJob(id string, job *yaml.Node, ids []string) []string {
+	pairs := mapping(job)
+	if pairs == nil {
+		l.add(job, "job %q must be a mapping", id)
+		return nil
+	}
+
+	values := map[string]*yaml.Node{}
+	for _, pair := range pairs {
+		values[pair[0].Value] = pair[1]
+	}
+	allowed := jobKeys
+	if _, ok := values["uses"]; ok {
+		allowed = reusableJobKeys
+	}
+	for _, pair := range pairs {
+		if !slices.Contains(allowed, pair[0].Value) {
+			if slices.Contains(jobKeys, pair[0].Value) {
+				l.add(pair[0], "key %q can't be used in job %q, which calls a reusable workflow", pair[0].Value, id)
+			} else {
+				l.add(pair[0], "unexpected key %q in job %q", pair[0].Value, id)
+			}
+		}
+	}
+
+	if uses, ok := values["uses"]; ok {
+		if !strings.HasPrefix(uses.Value, "./") && !actionPattern.MatchString(uses.Value) {
+			l.add(uses, "reusable workflow %q must be a local path or owner/repo/path@ref", uses.Value)
+		}
+	} else {
+		if _, ok := values["runs-on"]; !ok {
+			l.add(job, `job %q has no "runs-on"`, id)
+		}
+		if steps, ok := values["steps"]; !ok {
+			l.add(job, `job %q has no "steps"`, id)
+		} else {
+			l.lintSteps(id, steps)
+		}
+	}
+
+	if timeout, ok := values["timeout-minutes"]; ok && timeout.Kind == yaml.ScalarNode &&
+		!strings.Contains(timeout.Value, "${{") {
+		if _, err := strconv.ParseFloat(timeout.Value, 64); err != nil {
+			l.add(timeout, "timeout-minutes must be a number, got %q", timeout.Value)
+		}
+	}
+
+	var needed []string
+	if need, ok := values["needs"]; ok {
+		nodes := []*yaml.Node{need}
+		if need.Kind == yaml.SequenceNode {
+			nodes = need.Content
+		}
+		for _, node := range nodes {
+			switch {
+			case node.Value == id:
+				l.add(node, "job %q needs itself", id)
+			case !slices.Contains(ids, node.Value):
+				l.add(node, "job %q needs unknown job %q", id, node.Value)
+			default:
+				needed = append(needed, node.Value)
+			}
+		}
+	}
+	return needed
+}
+
+func (l *linter) lintSteps(job string, steps *yaml.Node) {
+	if steps.Kind != yaml.SequenceNode {
+		l.add(steps, "steps of job %q must be a list", job)
+		return
+	}
+	if len(steps.Content) == 0 {
+		l.add(steps, "job %q has no step", job)
+	}
+
+	stepIds := map[string]bool{}
+	for i, step := range steps.Content {
+		pairs := mapping(step)
+		if pairs == nil {
+			l.add(step, "step %d of job %q must be a mapping", i+1, job)
+			continue
+		}
+		var uses, run *yaml.Node
+		for _, pair := range pairs {
+			key, value := pair[0], pair[1]
+			switch key.Value {
+			case "uses":
+				uses = value
+			case "run":
+				run = value
+			case "id":
+				if stepIds[value.Value] {
+					l.add(value, "step id %q is used twice in job %q", value.Value, job)
+				}
+				stepIds[value.Value] = true
+			default:
+				if !slices.Contains(stepKeys, key.Value) {
+					l.add(key, "unexpected key %q in step %d of job %q", key.Value, i+1, job)
+				}
+			}
+		}
+
+		switch {
+		case uses == nil && run == nil:
+			l.add(step, `step %d of job %q has neither "uses" nor "run"`, i+1, job)
+		case uses != nil && run != nil:
+			l.add(step, `step %d of job %q can't have both "uses" and "run"`, i+1, job)
+		case uses != nil:
+			l.lintAction(uses)
+		}
+	}
+}
+
+func (l *linter) lintAction(uses *yaml.Node) {
+	action := uses.Value
+	switch {
+	case strings.HasPrefix(action, "./"), strings.HasPrefix(action, "docker://"),
+		strings.Contains(action, "${{"):
+	case !strings.Contains(action, "@"):
+		l.add(uses, "action %q must be pinned to a ref, e.g. %s@v1", action, action)
+	case !actionPattern.MatchString(action):
+		l.add(uses, "action %q must be owner/repo@ref, a local path or docker://image", action)
+	}
+}
+
+// lintExpressions reports the ${{ expressions that aren't closed in the
+// strings of node
+func (l *linter) lintExpressions(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode {
+		rest := node.Value
+		for {
+			start := strings.Index(rest, "${{")
+			if start == -1 {
+				return
+			}
+			end := strings.Index(rest[start:], "}}")
+			if end == -1 {
+				l.add(node, `expression isn't closed with "}}"`)
+				return
+			}
+			rest = rest[start+end+2:]
+		}
+	}
+	for _, child := range node.Content {
+		l.lintExpressions(child)
+	}
+}
+
+// findCycle returns a cycle of needs going through job, nil if there's none
+func findCycle(job string, needs map[string][]string) []string {
+	var path []string
+	visiting := map[string]bool{}
+	var visit func(string) []string
+	visit = func(curr string) []string {
+		if curr == job && len(path) > 0 {
+			return append(slices.Clone(path), curr)
+		}
+		if visiting[curr] {
+			return nil
+		}
+		visiting[curr] = true
+		path = append(path, curr)
+		for _, next := range needs[curr] {
+			if cycle := visit(next); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		return nil
+	}
+	return visit(job)
+}
@@ -0,0 +1,188 @@
+package workflowlint
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow string
+		want     []string
+	}{
+		{
+			name: "valid",
+			workflow: `name: CI
+on:
+  push:
+    branches: [main]
+  pull_request:
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    timeout-minutes: 10
+    steps:
+      - uses: actions/checkout@v4
+      - id: test
+        run: go test ./...
+  release:
+    needs: build
+    uses: ./.github/workflows/release.yml
+    secrets: inherit
+`,
+		},
+		{
+			name: "events list and expressions",
+			workflow: `on: [push]
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ github.sha }}
+`,
+		},
+		{
+			name:     "invalid YAML",
+			workflow: "on: push\njobs:\n  build: [\n",
+			want:     []string{"3:1: invalid YAML: line 3: did not find expected node content"},
+		},
+		{
+			name:     "missing on and jobs",
+			workflow: "name: CI\nenv:\n  A: b\n",
+			want: []string{
+				`1:1: the workflow has no "on" trigger`,
+				`1:1: the workflow has no "jobs"`,
+			},
+		},
+		{
+			name: "unknown keys and events",
+			workflow: `on: [push, pull-request]
+job:
+  build: {}
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    run-on: ubuntu-latest
+    steps:
+      - run: make
+        args: all
+`,
+			want: []string{
+				`1:12: unknown event "pull-request"`,
+				`2:1: unexpected key "job" in the workflow`,
+				`7:5: unexpected key "run-on" in job "build"`,
+				`10:9: unexpected key "args" in step 1 of job "build"`,
+			},
+		},
+		{
+			name: "jobs and steps",
+			workflow: `on: push
+jobs:
+  1build:
+    steps:
+      - name: nothing
+      - uses: actions/checkout
+        run: make
+      - uses: actions/setup-go@v5
+        id: go
+      - uses: checkout@v4
+        id: go
+    timeout-minutes: ten
+  deploy:
+    uses: owner/repo/.github/workflows/deploy.yml@main
+    runs-on: ubuntu-latest
+`,
+			want: []string{
+				`3:3: invalid job id "1build", it must start with a letter or _ and contain only alphanumerics, - or _`,
+				`4:5: job "1build" has no "runs-on"`,
+				`5:9: step 1 of job "1build" has neither "uses" nor "run"`,
+				`6:9: step 2 of job "1build" can't have both "uses" and "run"`,
+				`10:15: action "checkout@v4" must be owner/repo@ref, a local path or docker://image`,
+				`11:13: step id "go" is used twice in job "1build"`,
+				`12:22: timeout-minutes must be a number, got "ten"`,
+				`15:5: key "runs-on" can't be used in job "deploy", which calls a reusable workflow`,
+			},
+		},
+		{
+			name: "unpinned action",
+			workflow: `on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout
+`,
+			want: []string{`6:15: action "actions/checkout" must be pinned to a ref, e.g. actions/checkout@v1`},
+		},
+		{
+			name: "needs",
+			workflow: `on: push
+jobs:
+  a:
+    needs: [b, missing]
+    uses: ./a.yml
+  b:
+    needs: c
+    uses: ./b.yml
+  c:
+    needs: [a, c]
+    uses: ./c.yml
+`,
+			want: []string{
+				`3:3: job "a" is in a cycle of needs: a -> b -> c -> a`,
+				`4:16: job "a" needs unknown job "missing"`,
+				`10:16: job "c" needs itself`,
+			},
+		},
+		{
+			name: "unclosed expression",
+			workflow: `on: push
+jobs:
+  build:
+    if: ${{ github.event_name == 'push' }
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ github.sha }} ${{ github.ref
+`,
+			want: []string{
+				`4:9: expression isn't closed with "}}"`,
+				`7:14: expression isn't closed with "}}"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, problem := range Lint([]byte(tt.workflow)) {
+				got = append(got, problem.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lint() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsWorkflow(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{".github/workflows/ci.yml", true},
+		{"./.github/workflows/ci.yaml", true},
+		{".github/workflows/", false},
+		{".github/workflows/nested/ci.yml", false},
+		{".github/dependabot.yml", false},
+		{"workflows/ci.yml", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := IsWorkflow(tt.path); got != tt.want {
+				t.Errorf("IsWorkflow(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}